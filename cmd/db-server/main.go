@@ -266,6 +266,7 @@ func main() {
 	}
 	hp := handler.New(eng, proc)
 	srv.Register(hp.Process)
+	srv.Register(hp.Cancel)
 
 	err = waitClusterStartup(a, 300*time.Second, int(cfg.CubeConfig.Prophet.Replication.MaxReplicas), int(cfg.ClusterConfig.PreAllocatedGroupNum))
 
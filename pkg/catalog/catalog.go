@@ -399,6 +399,157 @@ func (c *Catalog) DropIndex(epoch, tid, dbid uint64, idxName string) error {
 	return ErrIndexNotExist
 }
 
+//AddColumn adds a column to the end of the columns of the table
+func (c *Catalog) AddColumn(epoch, dbId uint64, tableName string, col aoe.ColumnInfo) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("AddColumn cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	for _, column := range tbl.Columns {
+		if column.Name == col.Name {
+			return ErrColumnExist
+		}
+	}
+	initNextColumnId(tbl)
+	col.SchemaId = dbId
+	col.TableID = tbl.Id
+	col.Id = tbl.NextColumnId
+	col.Epoch = epoch
+	col.PrimaryKey = false
+	tbl.NextColumnId++
+	tbl.Columns = append(tbl.Columns, col)
+	return c.alterTable(epoch, dbId, tbl)
+}
+
+//DropColumn drops a column of the table, the indices on the column are dropped as well
+func (c *Catalog) DropColumn(epoch, dbId uint64, tableName, colName string) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("DropColumn cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	idx := -1
+	for i, column := range tbl.Columns {
+		if column.Name == colName {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return ErrColumnNotExist
+	}
+	if tbl.Columns[idx].PrimaryKey {
+		return ErrDropPrimaryKey
+	}
+	if len(tbl.Columns) == 1 {
+		return ErrDropAllColumns
+	}
+	initNextColumnId(tbl)
+	id := tbl.Columns[idx].Id
+	tbl.Columns = append(tbl.Columns[:idx], tbl.Columns[idx+1:]...)
+	indices := tbl.Indices[:0]
+	for _, indice := range tbl.Indices {
+		dropped := false
+		for _, col := range indice.Columns {
+			if col == id {
+				dropped = true
+				break
+			}
+		}
+		if !dropped {
+			indices = append(indices, indice)
+		}
+	}
+	tbl.Indices = indices
+	return c.alterTable(epoch, dbId, tbl)
+}
+
+//RenameColumn renames a column of the table
+func (c *Catalog) RenameColumn(epoch, dbId uint64, tableName, oldName, newName string) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("RenameColumn cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	idx := -1
+	for i, column := range tbl.Columns {
+		if column.Name == newName {
+			return ErrColumnExist
+		}
+		if column.Name == oldName {
+			idx = i
+		}
+	}
+	if idx == -1 {
+		return ErrColumnNotExist
+	}
+	tbl.Columns[idx].Name = newName
+	for i := range tbl.Indices {
+		for j, name := range tbl.Indices[i].ColumnNames {
+			if name == oldName {
+				tbl.Indices[i].ColumnNames[j] = newName
+			}
+		}
+	}
+	return c.alterTable(epoch, dbId, tbl)
+}
+
+//UpdatePartition replaces the encoded partition definition of the table,
+//the rows of the partitions are kept in their own tables.
+func (c *Catalog) UpdatePartition(epoch, dbId uint64, tableName string, data []byte) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("UpdatePartition cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	tbl.Epoch = epoch
+	tbl.Partition = data
+	return c.updateTableInfo(dbId, tbl)
+}
+
+//initNextColumnId sets the id of the next column of the tables created
+//before the ids of the dropped columns are recorded.
+func initNextColumnId(tbl *aoe.TableInfo) {
+	if tbl.NextColumnId != 0 {
+		return
+	}
+	for _, column := range tbl.Columns {
+		if column.Id >= tbl.NextColumnId {
+			tbl.NextColumnId = column.Id + 1
+		}
+	}
+}
+
+//alterTable alters the tablets of the table to the columns of tbl, and
+//updates the meta of the table.
+func (c *Catalog) alterTable(epoch, dbId uint64, tbl *aoe.TableInfo) error {
+	tablets, err := c.GetTablets(dbId, tbl.Name)
+	if err != nil {
+		return err
+	}
+	tbl.Epoch = epoch
+	for _, tablet := range tablets {
+		if err = c.Driver.AlterTablet(tablet.Name, tablet.ShardId, tbl); err != nil {
+			logutil.Errorf("ErrTabletAlterFailed, %v, %v, %v", tablet.ShardId, tbl, err)
+			return ErrTabletAlterFailed
+		}
+	}
+	return c.updateTableInfo(dbId, tbl)
+}
+
 // ListTables returns all tables meta in database.
 func (c *Catalog) ListTables(dbId uint64) ([]aoe.TableInfo, error) {
 	t0 := time.Now()
@@ -31,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
 
 	"github.com/matrixorigin/matrixcube/raftstore"
 
@@ -72,6 +73,7 @@ func MockTableInfo(colCnt int, i int) *aoe.TableInfo {
 	for i := 0; i < colCnt; i++ {
 		name := fmt.Sprintf("%s%d", prefix, i)
 		colInfo := aoe.ColumnInfo{
+			Id:   uint64(i),
 			Name: name,
 		}
 		if i == 1 {
@@ -196,6 +198,52 @@ func TestCatalogWithUtil(t *testing.T) {
 	err = catalog.DropIndex(0, idxTableInfo.Id, idxTableInfo.SchemaId, "mock_idx")
 	require.Equal(t, ErrIndexNotExist, err)
 
+	//Test AddColumn
+	alterName := testTables[1].Name
+	err = catalog.AddColumn(0, dbids[0], alterName, aoe.ColumnInfo{
+		Name:    "added",
+		Type:    types.Type{Oid: types.T_int32, Size: 4, Width: 4},
+		Default: metadata.MakeDefaultExpr(true, int64(7), false),
+	})
+	require.NoError(t, err)
+	alterTableInfo, _ := catalog.GetTable(dbids[0], alterName)
+	require.Equal(t, colCnt+1, len(alterTableInfo.Columns))
+	require.Equal(t, uint64(colCnt), alterTableInfo.Columns[colCnt].Id)
+	err = catalog.AddColumn(0, dbids[0], alterName, aoe.ColumnInfo{Name: "added"})
+	require.Equal(t, ErrColumnExist, err)
+
+	//Test DropColumn
+	err = catalog.DropColumn(0, dbids[0], alterName, "added")
+	require.NoError(t, err)
+	err = catalog.DropColumn(0, dbids[0], alterName, "added")
+	require.Equal(t, ErrColumnNotExist, err)
+	err = catalog.AddColumn(0, dbids[0], alterName, aoe.ColumnInfo{
+		Name: "added",
+		Type: types.Type{Oid: types.T_int32, Size: 4, Width: 4},
+	})
+	require.NoError(t, err)
+	alterTableInfo, _ = catalog.GetTable(dbids[0], alterName)
+	require.Equal(t, colCnt+1, len(alterTableInfo.Columns))
+	require.Equal(t, uint64(colCnt+1), alterTableInfo.Columns[colCnt].Id)
+
+	//Test RenameColumn
+	err = catalog.RenameColumn(0, dbids[0], alterName, "added", "renamed")
+	require.NoError(t, err)
+	alterTableInfo, _ = catalog.GetTable(dbids[0], alterName)
+	require.Equal(t, "renamed", alterTableInfo.Columns[colCnt].Name)
+	require.Equal(t, uint64(colCnt+1), alterTableInfo.Columns[colCnt].Id)
+	err = catalog.RenameColumn(0, dbids[0], alterName, "renamed", "mock_0")
+	require.Equal(t, ErrColumnExist, err)
+
+	//Test UpdatePartition
+	err = catalog.UpdatePartition(0, dbids[0], alterName, []byte("mock_partition"))
+	require.NoError(t, err)
+	alterTableInfo, _ = catalog.GetTable(dbids[0], alterName)
+	require.Equal(t, []byte("mock_partition"), alterTableInfo.Partition)
+	require.Equal(t, colCnt+1, len(alterTableInfo.Columns))
+	err = catalog.UpdatePartition(0, dbids[0], "wrong_name", nil)
+	require.Equal(t, ErrTableNotExists, err)
+
 	//Test CreateTableExists
 	_, err = catalog.CreateTable(0, dbids[0], *testTables[0])
 	require.Equal(t, ErrTableCreateExists, err, "CreateTable: wrong err")
@@ -278,4 +326,44 @@ func TestCatalogWithUtil(t *testing.T) {
 
 	err = catalog.DropDatabase(0, testDatabaceName+strconv.Itoa(0))
 	require.Equal(t, ErrDBNotExists, err, "DropDatabase: DropDatabase wrong err")
+
+	//Test users and roles
+	err = catalog.CreateRole(RoleInfo{Name: "r1"})
+	require.NoError(t, err, "CreateRole Fail")
+	err = catalog.CreateRole(RoleInfo{Name: "r1"})
+	require.Equal(t, ErrRoleExists, err, "CreateRole: wrong err")
+
+	user := UserInfo{
+		Name:         "u1",
+		Host:         "%",
+		Password:     EncodePassword("111"),
+		Grants:       GrantPrivileges(nil, "db1", "", PrivSelect),
+		Roles:        []string{"r1"},
+		DefaultRoles: []string{"r1"},
+	}
+	err = catalog.CreateUser(user)
+	require.NoError(t, err, "CreateUser Fail")
+	err = catalog.CreateUser(user)
+	require.Equal(t, ErrUserExists, err, "CreateUser: wrong err")
+	_, err = catalog.GetUser("u1", "localhost")
+	require.Equal(t, ErrUserNotExists, err, "GetUser: wrong err")
+
+	u, err := catalog.GetUser("u1", "%")
+	require.NoError(t, err, "GetUser Fail")
+	require.Equal(t, user, *u, "GetUser: wrong user")
+	users, err := catalog.ListUsers()
+	require.NoError(t, err, "ListUsers Fail")
+	require.Equal(t, 1, len(users), "ListUsers: wrong len")
+
+	err = catalog.DropRole("r1")
+	require.NoError(t, err, "DropRole Fail")
+	u, err = catalog.GetUser("u1", "%")
+	require.NoError(t, err, "GetUser Fail")
+	require.Equal(t, 0, len(u.Roles), "DropRole: role is not revoked")
+	require.Equal(t, 0, len(u.DefaultRoles), "DropRole: default role is not removed")
+
+	err = catalog.DropUser("u1", "%")
+	require.NoError(t, err, "DropUser Fail")
+	err = catalog.DropUser("u1", "%")
+	require.Equal(t, ErrUserNotExists, err, "DropUser: wrong err")
 }
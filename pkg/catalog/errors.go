@@ -43,4 +43,20 @@ var (
 	ErrPrimaryKeyNotExist = errors.New("primary key not exist")
 	ErrIndexExist = errors.New("index already exist")
 	ErrIndexNotExist = errors.New("index not exist")
+	// ErrColumnExist is the error for column exists.
+	ErrColumnExist = errors.New("column already exist")
+	// ErrDropPrimaryKey is the error for dropping the column of the primary key.
+	ErrDropPrimaryKey = errors.New("can't drop the column of the primary key")
+	// ErrDropAllColumns is the error for dropping the only column of a table.
+	ErrDropAllColumns = errors.New("can't drop all columns of a table")
+	// ErrTabletAlterFailed is the error for fail in altering tablet.
+	ErrTabletAlterFailed = errors.New("alter tablet failed")
+	// ErrUserExists is the error for user exists.
+	ErrUserExists = errors.New("user already exists")
+	// ErrUserNotExists is the error for user not exists.
+	ErrUserNotExists = errors.New("user not exist")
+	// ErrRoleExists is the error for role exists.
+	ErrRoleExists = errors.New("role already exists")
+	// ErrRoleNotExists is the error for role not exists.
+	ErrRoleNotExists = errors.New("role not exist")
 )
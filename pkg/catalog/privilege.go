@@ -0,0 +1,116 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"crypto/sha1"
+	"strings"
+)
+
+// Privilege is a set of privileges, one bit for each privilege.
+type Privilege uint64
+
+const (
+	PrivSelect Privilege = 1 << iota
+	PrivInsert
+	PrivUpdate
+	PrivDelete
+	PrivCreate
+	PrivDrop
+	PrivAlter
+	PrivIndex
+	PrivCreateUser
+	PrivGrantOption
+)
+
+// PrivAll is what ALL PRIVILEGES stands for, it does not contain the GRANT OPTION.
+const PrivAll = PrivSelect | PrivInsert | PrivUpdate | PrivDelete |
+	PrivCreate | PrivDrop | PrivAlter | PrivIndex | PrivCreateUser
+
+var privNames = []string{
+	"SELECT", "INSERT", "UPDATE", "DELETE", "CREATE",
+	"DROP", "ALTER", "INDEX", "CREATE USER", "GRANT OPTION",
+}
+
+func (p Privilege) String() string {
+	var ns []string
+
+	for i, name := range privNames {
+		if p&(1<<i) != 0 {
+			ns = append(ns, name)
+		}
+	}
+	return strings.Join(ns, ", ")
+}
+
+// Grant is the privileges granted on a database or a table.
+// An empty Db means all the databases and an empty Table means all the tables of Db.
+type Grant struct {
+	Db    string
+	Table string
+	Privs Privilege
+}
+
+// GrantPrivileges adds p to the grant on db.table.
+func GrantPrivileges(gs []Grant, db, table string, p Privilege) []Grant {
+	for i := range gs {
+		if gs[i].Db == db && gs[i].Table == table {
+			gs[i].Privs |= p
+			return gs
+		}
+	}
+	return append(gs, Grant{Db: db, Table: table, Privs: p})
+}
+
+// RevokePrivileges removes p from the grant on db.table,
+// it reports false if there is no grant on db.table.
+func RevokePrivileges(gs []Grant, db, table string, p Privilege) ([]Grant, bool) {
+	for i := range gs {
+		if gs[i].Db == db && gs[i].Table == table {
+			if gs[i].Privs &^= p; gs[i].Privs == 0 {
+				gs = append(gs[:i:i], gs[i+1:]...)
+			}
+			return gs, true
+		}
+	}
+	return gs, false
+}
+
+// Privileges returns the privileges that gs gives on db.table,
+// an empty table asks for the privileges on the database itself.
+func Privileges(gs []Grant, db, table string) Privilege {
+	var p Privilege
+
+	for _, g := range gs {
+		switch {
+		case len(g.Db) == 0:
+			p |= g.Privs
+		case g.Db == db && (len(g.Table) == 0 || (len(table) > 0 && g.Table == table)):
+			p |= g.Privs
+		}
+	}
+	return p
+}
+
+// EncodePassword returns the stage-2 hash SHA1(SHA1(password)) of the mysql_native_password
+// scheme, an empty password has an empty hash.
+func EncodePassword(password string) []byte {
+	if len(password) == 0 {
+		return nil
+	}
+	hash1 := sha1.Sum([]byte(password))
+	hash2 := sha1.Sum(hash1[:])
+	return hash2[:]
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"crypto/sha1"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivileges(t *testing.T) {
+	var gs []Grant
+
+	gs = GrantPrivileges(gs, "db1", "", PrivSelect)
+	gs = GrantPrivileges(gs, "db1", "t1", PrivInsert|PrivUpdate)
+	gs = GrantPrivileges(gs, "db1", "t1", PrivDelete)
+	require.Equal(t, 2, len(gs))
+	require.Equal(t, PrivSelect|PrivInsert|PrivUpdate|PrivDelete, Privileges(gs, "db1", "t1"))
+	require.Equal(t, PrivSelect, Privileges(gs, "db1", "t2"))
+	require.Equal(t, PrivSelect, Privileges(gs, "db1", ""))
+	require.Equal(t, Privilege(0), Privileges(gs, "db2", "t1"))
+
+	gs = GrantPrivileges(gs, "", "", PrivCreate)
+	require.Equal(t, PrivCreate, Privileges(gs, "db2", ""))
+	require.Equal(t, PrivCreate, Privileges(gs, "", ""))
+
+	gs, ok := RevokePrivileges(gs, "db1", "t1", PrivInsert|PrivUpdate|PrivDelete)
+	require.True(t, ok)
+	require.Equal(t, 2, len(gs))
+	require.Equal(t, PrivSelect|PrivCreate, Privileges(gs, "db1", "t1"))
+	_, ok = RevokePrivileges(gs, "db1", "t1", PrivSelect)
+	require.False(t, ok)
+
+	require.Equal(t, "SELECT, CREATE USER", (PrivSelect | PrivCreateUser).String())
+	require.Equal(t, Privilege(0), PrivAll&PrivGrantOption)
+}
+
+func TestEncodePassword(t *testing.T) {
+	require.Nil(t, EncodePassword(""))
+	hash1 := sha1.Sum([]byte("111"))
+	hash2 := sha1.Sum(hash1[:])
+	require.Equal(t, hash2[:], EncodePassword("111"))
+}
@@ -0,0 +1,212 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/codec"
+)
+
+const (
+	cUserPrefix = "User"
+	cRolePrefix = "Role"
+)
+
+// UserInfo is the meta of a user account.
+type UserInfo struct {
+	Name string
+	Host string
+	// Password is the stage-2 hash SHA1(SHA1(password)), empty if the user has no password.
+	Password []byte
+	// Grants are the privileges granted to the user directly.
+	Grants []Grant
+	// Roles are the names of the roles granted to the user.
+	Roles []string
+	// DefaultRoles are the roles activated when the user logs in.
+	DefaultRoles []string
+}
+
+// RoleInfo is the meta of a role.
+type RoleInfo struct {
+	Name   string
+	Grants []Grant
+}
+
+// CreateUser creates a user account, it returns ErrUserExists if the account exists.
+func (c *Catalog) CreateUser(user UserInfo) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("CreateUser finished, user is %v@%v, cost %d ms", user.Name, user.Host, time.Since(t0).Milliseconds())
+	}()
+	if u, _ := c.GetUser(user.Name, user.Host); u != nil {
+		return ErrUserExists
+	}
+	value, _ := json.Marshal(user)
+	return c.Driver.Set(c.userKey(user.Name, user.Host), value)
+}
+
+// UpdateUser overwrites the meta of an existing user account.
+func (c *Catalog) UpdateUser(user UserInfo) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("UpdateUser cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	if _, err = c.GetUser(user.Name, user.Host); err != nil {
+		return err
+	}
+	value, _ := json.Marshal(user)
+	return c.Driver.Set(c.userKey(user.Name, user.Host), value)
+}
+
+// DropUser drops a user account.
+func (c *Catalog) DropUser(name, host string) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("DropUser cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	if _, err = c.GetUser(name, host); err != nil {
+		return err
+	}
+	return c.Driver.Delete(c.userKey(name, host))
+}
+
+// GetUser gets the meta of the account name@host.
+func (c *Catalog) GetUser(name, host string) (*UserInfo, error) {
+	v, err := c.Driver.Get(c.userKey(name, host))
+	if err != nil || v == nil {
+		return nil, ErrUserNotExists
+	}
+	user := UserInfo{}
+	if err = json.Unmarshal(v, &user); err != nil {
+		return nil, ErrUserNotExists
+	}
+	return &user, nil
+}
+
+// ListUsers returns all user accounts.
+func (c *Catalog) ListUsers() ([]UserInfo, error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("ListUsers cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	values, err := c.Driver.PrefixScan(c.userPrefix(), 0)
+	if err != nil {
+		return nil, err
+	}
+	var users []UserInfo
+
+	for i := 1; i < len(values); i = i + 2 {
+		user := UserInfo{}
+		if err = json.Unmarshal(values[i], &user); err != nil {
+			continue
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// CreateRole creates a role, it returns ErrRoleExists if the role exists.
+func (c *Catalog) CreateRole(role RoleInfo) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("CreateRole finished, role is %v, cost %d ms", role.Name, time.Since(t0).Milliseconds())
+	}()
+	if r, _ := c.GetRole(role.Name); r != nil {
+		return ErrRoleExists
+	}
+	value, _ := json.Marshal(role)
+	return c.Driver.Set(c.roleKey(role.Name), value)
+}
+
+// UpdateRole overwrites the meta of an existing role.
+func (c *Catalog) UpdateRole(role RoleInfo) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("UpdateRole cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	if _, err = c.GetRole(role.Name); err != nil {
+		return err
+	}
+	value, _ := json.Marshal(role)
+	return c.Driver.Set(c.roleKey(role.Name), value)
+}
+
+// DropRole drops a role and takes it away from all the users it was granted to.
+func (c *Catalog) DropRole(name string) (err error) {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("DropRole cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	if _, err = c.GetRole(name); err != nil {
+		return err
+	}
+	users, err := c.ListUsers()
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		roles, ok := removeName(user.Roles, name)
+		if !ok {
+			continue
+		}
+		user.Roles = roles
+		user.DefaultRoles, _ = removeName(user.DefaultRoles, name)
+		if err = c.UpdateUser(user); err != nil {
+			return err
+		}
+	}
+	return c.Driver.Delete(c.roleKey(name))
+}
+
+// GetRole gets the meta of a role.
+func (c *Catalog) GetRole(name string) (*RoleInfo, error) {
+	v, err := c.Driver.Get(c.roleKey(name))
+	if err != nil || v == nil {
+		return nil, ErrRoleNotExists
+	}
+	role := RoleInfo{}
+	if err = json.Unmarshal(v, &role); err != nil {
+		return nil, ErrRoleNotExists
+	}
+	return &role, nil
+}
+
+//removeName removes name from names and reports whether it was there.
+func removeName(names []string, name string) ([]string, bool) {
+	for i, n := range names {
+		if n == name {
+			return append(names[:i:i], names[i+1:]...), true
+		}
+	}
+	return names, false
+}
+
+//userKey returns the encoded name and host with prefix "meta1User$len(name)$"
+func (c *Catalog) userKey(name, host string) []byte {
+	return codec.EncodeKey(cPrefix, defaultCatalogId, cUserPrefix, uint64(len(name)), name, host)
+}
+
+//userPrefix returns the prefix "meta1User"
+func (c *Catalog) userPrefix() []byte {
+	return codec.EncodeKey(cPrefix, defaultCatalogId, cUserPrefix)
+}
+
+//roleKey returns the encoded name with prefix "meta1Role"
+func (c *Catalog) roleKey(name string) []byte {
+	return codec.EncodeKey(cPrefix, defaultCatalogId, cRolePrefix, name)
+}
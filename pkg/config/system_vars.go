@@ -398,6 +398,18 @@ type SystemVariables struct{
 	*/
 	loadDataConcurrencyCount    int64
 	
+	/**
+	Name:	localInfile
+	Scope:	[global]
+	Access:	[file]
+	DataType:	bool
+	DomainType:	set
+	Values:	[]
+	Comment:	default is false. The local_infile of the MySQL. It permits the LOAD DATA LOCAL INFILE reading the file from the client.
+	UpdateMode:	dynamic
+	*/
+	localInfile    bool
+	
 	/**
 	Name:	cubeLogLevel
 	Scope:	[global]
@@ -780,6 +792,18 @@ type varsConfig struct{
 	*/
 	LoadDataConcurrencyCount    int64  `toml:"loadDataConcurrencyCount"`
 	
+	/**
+	Name:	localInfile
+	Scope:	[global]
+	Access:	[file]
+	DataType:	bool
+	DomainType:	set
+	Values:	[]
+	Comment:	default is false. The local_infile of the MySQL. It permits the LOAD DATA LOCAL INFILE reading the file from the client.
+	UpdateMode:	dynamic
+	*/
+	LocalInfile    bool  `toml:"localInfile"`
+	
 	/**
 	Name:	cubeLogLevel
 	Scope:	[global]
@@ -891,6 +915,8 @@ func (ap *SystemVariables) PrepareDefinition(){
 	
 	ap.name2definition["loadDataConcurrencyCount"] = "	Name:	loadDataConcurrencyCount	Scope:	[global]	Access:	[file]	DataType:	int64	DomainType:	range	Values:	[16 1 16]	Comment:	default is 16. The count of go routine writing batch into the storage.	UpdateMode:	dynamic	"
 	
+	ap.name2definition["localInfile"] = "	Name:	localInfile	Scope:	[global]	Access:	[file]	DataType:	bool	DomainType:	set	Values:	[]	Comment:	default is false. The local_infile of the MySQL. It permits the LOAD DATA LOCAL INFILE reading the file from the client.	UpdateMode:	dynamic	"
+	
 	ap.name2definition["cubeLogLevel"] = "	Name:	cubeLogLevel	Scope:	[global]	Access:	[file]	DataType:	string	DomainType:	set	Values:	[error info debug warning warn fatal]	Comment:	default is error. The log level for cube.	UpdateMode:	dynamic	"
 	
 	ap.name2definition["cubeMaxEntriesBytes"] = "	Name:	cubeMaxEntriesBytes	Scope:	[global]	Access:	[file]	DataType:	int64	DomainType:	set	Values:	[314572800]	Comment:	default is 300MB. The max entries bytes for the write batch in the cube.	UpdateMode:	dynamic	"
@@ -1357,6 +1383,19 @@ func (ap *SystemVariables) LoadInitialValues()error{
 		}
 	}
 	
+	localInfilechoices :=[]bool {
+		
+	}
+	if len(localInfilechoices) != 0 {
+		if err = ap.setLocalInfile(localInfilechoices[0]) ; err != nil {
+			return fmt.Errorf("set%s failed.error:%v","LocalInfile",err)
+		}
+	} else { 
+		if err = ap.setLocalInfile(false) ; err != nil {
+			return fmt.Errorf("set%s failed.error:%v","LocalInfile",err)
+		}
+	}
+	
 	cubeLogLevelchoices := []string {
 		"error","info","debug","warning","warn","fatal", 
 	}
@@ -1674,6 +1713,15 @@ func (ap * SystemVariables ) GetLoadDataConcurrencyCount() int64 {
 	return ap.loadDataConcurrencyCount
 }
 
+/**
+Get the value of the parameter localInfile
+*/
+func (ap * SystemVariables ) GetLocalInfile() bool {
+	ap.rwlock.RLock()
+	defer ap.rwlock.RUnlock()
+	return ap.localInfile
+}
+
 /**
 Get the value of the parameter cubeLogLevel
 */
@@ -1896,6 +1944,13 @@ func (ap * SystemVariables ) SetLoadDataConcurrencyCount(value int64)error {
 	return  ap.setLoadDataConcurrencyCount(value)
 }
 
+/**
+Set the value of the parameter localInfile
+*/
+func (ap * SystemVariables ) SetLocalInfile(value bool)error {
+	return  ap.setLocalInfile(value)
+}
+
 /**
 Set the value of the parameter cubeLogLevel
 */
@@ -2584,6 +2639,26 @@ func (ap * SystemVariables ) setLoadDataConcurrencyCount(value int64)error {
 	return nil
 }
 
+/**
+Set the value of the parameter localInfile
+*/
+func (ap * SystemVariables ) setLocalInfile(value bool)error {
+	ap.rwlock.Lock()
+	defer ap.rwlock.Unlock()
+	choices :=[]bool {
+				
+		}
+		if len( choices ) != 0{
+			if !isInSliceBool(value, choices){
+				return fmt.Errorf("setLocalInfile,the value %t is not in set %v",value,choices)
+			}
+		}//else means any bool value: true or false
+	
+	
+	ap.localInfile = value
+	return nil
+}
+
 /**
 Set the value of the parameter cubeLogLevel
 */
@@ -2675,6 +2750,7 @@ func (config *varsConfig) resetUpdatedFlags(){
 	config.name2updatedFlags["lengthOfQueryPrinted"] = false
 	config.name2updatedFlags["batchSizeInLoadData"] = false
 	config.name2updatedFlags["loadDataConcurrencyCount"] = false
+	config.name2updatedFlags["localInfile"] = false
 	config.name2updatedFlags["cubeLogLevel"] = false
 	config.name2updatedFlags["cubeMaxEntriesBytes"] = false
 }
@@ -2897,6 +2973,11 @@ func (ap * SystemVariables ) UpdateParametersWithConfiguration(config *varsConfi
 			return fmt.Errorf("update parameter loadDataConcurrencyCount failed.error:%v",err)
 		}
 	}
+	if config.getUpdatedFlag("localInfile"){
+		if err = ap.setLocalInfile(config.LocalInfile); err != nil{
+			return fmt.Errorf("update parameter localInfile failed.error:%v",err)
+		}
+	}
 	if config.getUpdatedFlag("cubeLogLevel"){
 		if err = ap.setCubeLogLevel(config.CubeLogLevel); err != nil{
 			return fmt.Errorf("update parameter cubeLogLevel failed.error:%v",err)
@@ -104,6 +104,9 @@ func isvarsConfigEqual(c1,c2 *varsConfig) bool {
 	if c1.LoadDataConcurrencyCount != c2.LoadDataConcurrencyCount {
 		return false
 	}
+	if c1.LocalInfile != c2.LocalInfile {
+		return false
+	}
 	if c1.CubeLogLevel != c2.CubeLogLevel {
 		return false
 	}
@@ -148,6 +151,7 @@ maxReplicas=1
 lengthOfQueryPrinted=50
 batchSizeInLoadData=40000
 loadDataConcurrencyCount=16
+localInfile= false
 cubeLogLevel= "error"
 cubeMaxEntriesBytes=314572800
 		
@@ -187,6 +191,7 @@ MaxReplicas:1,
 LengthOfQueryPrinted:50,
 BatchSizeInLoadData:40000,
 LoadDataConcurrencyCount:16,
+LocalInfile: false ,
 CubeLogLevel: "error" ,
 CubeMaxEntriesBytes:314572800,
 	
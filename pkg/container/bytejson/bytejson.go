@@ -0,0 +1,503 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bytejson implements the binary encoding of json documents, which
+// are kept in the vectors and the storage as the bytes of the encoding.
+//
+// A json value is encoded as its type code followed by its payload:
+//
+//	literal: one byte of null, true or false
+//	int64, uint64, float64: eight bytes in little endian
+//	string: the uvarint length of the string followed by the string
+//	array: count(uint32) size(uint32) count * (type(uint8) offset(uint32)) values
+//	object: count(uint32) size(uint32) count * (offset(uint32) length(uint16))
+//	        count * (type(uint8) offset(uint32)) keys values
+//
+// The size of an array or an object is the size of its whole payload and the
+// offsets are relative to the start of the payload, so that a member can be
+// located without decoding the others. The keys of an object are sorted by
+// their lengths and then their bytes, and each key appears only once.
+package bytejson
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TpCode is the type code of a json value.
+type TpCode byte
+
+const (
+	TpObject  TpCode = 0x01
+	TpArray   TpCode = 0x03
+	TpLiteral TpCode = 0x04
+	TpInt64   TpCode = 0x09
+	TpUint64  TpCode = 0x0a
+	TpFloat64 TpCode = 0x0b
+	TpString  TpCode = 0x0c
+)
+
+const (
+	LiteralNull  byte = 0x00
+	LiteralTrue  byte = 0x01
+	LiteralFalse byte = 0x02
+)
+
+const (
+	headerSize     = 8 // count and size of an array or an object
+	keyEntrySize   = 6 // offset and length of a key
+	valueEntrySize = 5 // type and offset of a value
+)
+
+var (
+	ErrInvalidJson = errors.New("invalid json text")
+	ErrTooLarge    = errors.New("json document is too large")
+)
+
+// ByteJson is a json value in the binary encoding.
+type ByteJson []byte
+
+// Type returns the type code of the value.
+func (bj ByteJson) Type() TpCode {
+	return TpCode(bj[0])
+}
+
+func (bj ByteJson) payload() []byte {
+	return bj[1:]
+}
+
+// TypeName returns the name of the type of the value as JSON_TYPE does.
+func (bj ByteJson) TypeName() string {
+	switch bj.Type() {
+	case TpObject:
+		return "OBJECT"
+	case TpArray:
+		return "ARRAY"
+	case TpLiteral:
+		switch bj.payload()[0] {
+		case LiteralNull:
+			return "NULL"
+		default:
+			return "BOOLEAN"
+		}
+	case TpInt64:
+		return "INTEGER"
+	case TpUint64:
+		return "UNSIGNED INTEGER"
+	case TpFloat64:
+		return "DOUBLE"
+	case TpString:
+		return "STRING"
+	}
+	return "UNKNOWN"
+}
+
+// Valid reports whether s is a json document.
+func Valid(s []byte) bool {
+	_, err := ParseFromBytes(s)
+	return err == nil
+}
+
+// ParseFromString parses the json document s into its binary encoding.
+func ParseFromString(s string) (ByteJson, error) {
+	return ParseFromBytes([]byte(s))
+}
+
+// ParseFromBytes parses the json document s into its binary encoding.
+func ParseFromBytes(s []byte) (ByteJson, error) {
+	var v interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(s))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, ErrInvalidJson
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, ErrInvalidJson
+	}
+	return CreateByteJson(v)
+}
+
+// CreateByteJson encodes a value decoded by encoding/json with UseNumber,
+// strings, integers, floats and booleans are also accepted.
+func CreateByteJson(v interface{}) (ByteJson, error) {
+	tp, data, err := appendValue(nil, v)
+	if err != nil {
+		return nil, err
+	}
+	return append(ByteJson{byte(tp)}, data...), nil
+}
+
+// NewString returns the json string s.
+func NewString(s string) ByteJson {
+	bj := ByteJson{byte(TpString)}
+	bj = appendUvarint(bj, uint64(len(s)))
+	return append(bj, s...)
+}
+
+// NewArray returns the json array of vs.
+func NewArray(vs []ByteJson) (ByteJson, error) {
+	data, err := appendArray(nil, vs)
+	if err != nil {
+		return nil, err
+	}
+	return append(ByteJson{byte(TpArray)}, data...), nil
+}
+
+func appendValue(buf []byte, v interface{}) (TpCode, []byte, error) {
+	switch x := v.(type) {
+	case nil:
+		return TpLiteral, append(buf, LiteralNull), nil
+	case bool:
+		if x {
+			return TpLiteral, append(buf, LiteralTrue), nil
+		}
+		return TpLiteral, append(buf, LiteralFalse), nil
+	case int64:
+		return TpInt64, appendUint64(buf, uint64(x)), nil
+	case uint64:
+		return TpUint64, appendUint64(buf, x), nil
+	case float64:
+		return TpFloat64, appendUint64(buf, math.Float64bits(x)), nil
+	case json.Number:
+		return appendNumber(buf, string(x))
+	case string:
+		buf = appendUvarint(buf, uint64(len(x)))
+		return TpString, append(buf, x...), nil
+	case []interface{}:
+		vs := make([]ByteJson, len(x))
+		for i, e := range x {
+			tp, data, err := appendValue(nil, e)
+			if err != nil {
+				return 0, nil, err
+			}
+			vs[i] = append(ByteJson{byte(tp)}, data...)
+		}
+		data, err := appendArray(buf, vs)
+		return TpArray, data, err
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keyLess(keys[i], keys[j]) })
+		vs := make([]ByteJson, len(keys))
+		for i, k := range keys {
+			tp, data, err := appendValue(nil, x[k])
+			if err != nil {
+				return 0, nil, err
+			}
+			vs[i] = append(ByteJson{byte(tp)}, data...)
+		}
+		data, err := appendObject(buf, keys, vs)
+		return TpObject, data, err
+	}
+	return 0, nil, fmt.Errorf("unsupported json value %v", v)
+}
+
+// appendNumber keeps a number as an integer if it has neither a fraction nor
+// an exponent and fits in 64 bits, or else as a float.
+func appendNumber(buf []byte, s string) (TpCode, []byte, error) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return TpInt64, appendUint64(buf, uint64(i)), nil
+	}
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return TpUint64, appendUint64(buf, u), nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, nil, ErrInvalidJson
+	}
+	return TpFloat64, appendUint64(buf, math.Float64bits(f)), nil
+}
+
+func appendArray(buf []byte, vs []ByteJson) ([]byte, error) {
+	size := headerSize + len(vs)*valueEntrySize
+	for _, v := range vs {
+		size += len(v) - 1
+	}
+	if size > math.MaxUint32 {
+		return nil, ErrTooLarge
+	}
+	buf = appendUint32(buf, uint32(len(vs)))
+	buf = appendUint32(buf, uint32(size))
+	off := headerSize + len(vs)*valueEntrySize
+	for _, v := range vs {
+		buf = append(buf, v[0])
+		buf = appendUint32(buf, uint32(off))
+		off += len(v) - 1
+	}
+	for _, v := range vs {
+		buf = append(buf, v.payload()...)
+	}
+	return buf, nil
+}
+
+// appendObject appends an object whose keys are sorted and distinct.
+func appendObject(buf []byte, keys []string, vs []ByteJson) ([]byte, error) {
+	size := headerSize + len(keys)*(keyEntrySize+valueEntrySize)
+	for i, k := range keys {
+		if len(k) > math.MaxUint16 {
+			return nil, ErrTooLarge
+		}
+		size += len(k) + len(vs[i]) - 1
+	}
+	if size > math.MaxUint32 {
+		return nil, ErrTooLarge
+	}
+	buf = appendUint32(buf, uint32(len(keys)))
+	buf = appendUint32(buf, uint32(size))
+	off := headerSize + len(keys)*(keyEntrySize+valueEntrySize)
+	for _, k := range keys {
+		buf = appendUint32(buf, uint32(off))
+		buf = appendUint16(buf, uint16(len(k)))
+		off += len(k)
+	}
+	for _, v := range vs {
+		buf = append(buf, v[0])
+		buf = appendUint32(buf, uint32(off))
+		off += len(v) - 1
+	}
+	for _, k := range keys {
+		buf = append(buf, k...)
+	}
+	for _, v := range vs {
+		buf = append(buf, v.payload()...)
+	}
+	return buf, nil
+}
+
+// keyLess orders the keys of an object by their lengths and then their bytes.
+func keyLess(x, y string) bool {
+	if len(x) != len(y) {
+		return len(x) < len(y)
+	}
+	return x < y
+}
+
+// Count returns the number of elements of an array or members of an object.
+func (bj ByteJson) Count() int {
+	return int(binary.LittleEndian.Uint32(bj.payload()))
+}
+
+// Key returns the i-th key of an object.
+func (bj ByteJson) Key(i int) string {
+	data := bj.payload()
+	entry := data[headerSize+i*keyEntrySize:]
+	off := binary.LittleEndian.Uint32(entry)
+	n := binary.LittleEndian.Uint16(entry[4:])
+	return string(data[off : off+uint32(n)])
+}
+
+// Value returns the i-th value of an object.
+func (bj ByteJson) Value(i int) ByteJson {
+	return bj.member(headerSize + bj.Count()*keyEntrySize + i*valueEntrySize)
+}
+
+// Element returns the i-th element of an array.
+func (bj ByteJson) Element(i int) ByteJson {
+	return bj.member(headerSize + i*valueEntrySize)
+}
+
+func (bj ByteJson) member(entry int) ByteJson {
+	data := bj.payload()
+	tp := TpCode(data[entry])
+	off := int(binary.LittleEndian.Uint32(data[entry+1:]))
+	n := payloadSize(tp, data[off:])
+	return append(ByteJson{byte(tp)}, data[off:off+n]...)
+}
+
+// Lookup returns the value of key in an object.
+func (bj ByteJson) Lookup(key string) (ByteJson, bool) {
+	n := bj.Count()
+	i := sort.Search(n, func(i int) bool {
+		return !keyLess(bj.Key(i), key)
+	})
+	if i < n && bj.Key(i) == key {
+		return bj.Value(i), true
+	}
+	return nil, false
+}
+
+func payloadSize(tp TpCode, data []byte) int {
+	switch tp {
+	case TpObject, TpArray:
+		return int(binary.LittleEndian.Uint32(data[4:]))
+	case TpLiteral:
+		return 1
+	case TpInt64, TpUint64, TpFloat64:
+		return 8
+	case TpString:
+		n, k := binary.Uvarint(data)
+		return k + int(n)
+	}
+	return 0
+}
+
+// GetInt64 returns the value of an int64.
+func (bj ByteJson) GetInt64() int64 {
+	return int64(binary.LittleEndian.Uint64(bj.payload()))
+}
+
+// GetUint64 returns the value of an uint64.
+func (bj ByteJson) GetUint64() uint64 {
+	return binary.LittleEndian.Uint64(bj.payload())
+}
+
+// GetFloat64 returns the value of a float64.
+func (bj ByteJson) GetFloat64() float64 {
+	return math.Float64frombits(binary.LittleEndian.Uint64(bj.payload()))
+}
+
+// GetString returns the value of a string.
+func (bj ByteJson) GetString() string {
+	data := bj.payload()
+	n, k := binary.Uvarint(data)
+	return string(data[k : k+int(n)])
+}
+
+// GetLiteral returns the literal of a null, true or false.
+func (bj ByteJson) GetLiteral() byte {
+	return bj.payload()[0]
+}
+
+// IsNull reports whether the value is the json null.
+func (bj ByteJson) IsNull() bool {
+	return bj.Type() == TpLiteral && bj.GetLiteral() == LiteralNull
+}
+
+func (bj ByteJson) String() string {
+	return string(bj.appendText(nil))
+}
+
+// appendText appends the text of the value in the format of mysql, in which
+// the members and the elements are separated by ", " and the keys by ": ".
+func (bj ByteJson) appendText(buf []byte) []byte {
+	switch bj.Type() {
+	case TpObject:
+		buf = append(buf, '{')
+		for i, n := 0, bj.Count(); i < n; i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendQuoted(buf, bj.Key(i))
+			buf = append(buf, ": "...)
+			buf = bj.Value(i).appendText(buf)
+		}
+		return append(buf, '}')
+	case TpArray:
+		buf = append(buf, '[')
+		for i, n := 0, bj.Count(); i < n; i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = bj.Element(i).appendText(buf)
+		}
+		return append(buf, ']')
+	case TpLiteral:
+		switch bj.GetLiteral() {
+		case LiteralTrue:
+			return append(buf, "true"...)
+		case LiteralFalse:
+			return append(buf, "false"...)
+		}
+		return append(buf, "null"...)
+	case TpInt64:
+		return strconv.AppendInt(buf, bj.GetInt64(), 10)
+	case TpUint64:
+		return strconv.AppendUint(buf, bj.GetUint64(), 10)
+	case TpFloat64:
+		return appendFloat(buf, bj.GetFloat64())
+	case TpString:
+		return appendQuoted(buf, bj.GetString())
+	}
+	return buf
+}
+
+// appendFloat formats a float as mysql does, a float in [1e-5, 1e15) is
+// formatted without the exponent and keeps the fraction ".0" if it is an
+// integral value, and the others are formatted with an unsigned exponent.
+func appendFloat(buf []byte, f float64) []byte {
+	if abs := math.Abs(f); abs == 0 || (abs >= 1e-5 && abs < 1e15) {
+		s := strconv.FormatFloat(f, 'f', -1, 64)
+		if strings.IndexByte(s, '.') < 0 {
+			s += ".0"
+		}
+		return append(buf, s...)
+	}
+	return append(buf, strings.Replace(strconv.FormatFloat(f, 'e', -1, 64), "e+", "e", 1)...)
+}
+
+// appendQuoted appends s as a json string, the bytes of the multi-byte
+// characters are kept as they are.
+func appendQuoted(buf []byte, s string) []byte {
+	buf = append(buf, '"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			buf = append(buf, `\"`...)
+		case '\\':
+			buf = append(buf, `\\`...)
+		case '\b':
+			buf = append(buf, `\b`...)
+		case '\f':
+			buf = append(buf, `\f`...)
+		case '\n':
+			buf = append(buf, `\n`...)
+		case '\r':
+			buf = append(buf, `\r`...)
+		case '\t':
+			buf = append(buf, `\t`...)
+		default:
+			if c < 0x20 {
+				buf = append(buf, fmt.Sprintf(`\u%04x`, c)...)
+			} else {
+				buf = append(buf, c)
+			}
+		}
+	}
+	return append(buf, '"')
+}
+
+func appendUint16(buf []byte, v uint16) []byte {
+	var tmp [2]byte
+	binary.LittleEndian.PutUint16(tmp[:], v)
+	return append(buf, tmp[:]...)
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	var tmp [4]byte
+	binary.LittleEndian.PutUint32(tmp[:], v)
+	return append(buf, tmp[:]...)
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var tmp [8]byte
+	binary.LittleEndian.PutUint64(tmp[:], v)
+	return append(buf, tmp[:]...)
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		input  string
+		output string
+	}{
+		{`null`, `null`},
+		{` true `, `true`},
+		{`-12`, `-12`},
+		{`18446744073709551615`, `18446744073709551615`},
+		{`1.50`, `1.5`},
+		{`3.0`, `3.0`},
+		{`1e20`, `1e20`},
+		{`"a\"b\né"`, `"a\"b\né"`},
+		{`[1, "x", [], {}]`, `[1, "x", [], {}]`},
+		{`{"bb": 1, "a": [true, null], "c": {"d": 2.5}, "a": 2}`, `{"a": 2, "c": {"d": 2.5}, "bb": 1}`},
+	}
+	for _, c := range cases {
+		bj, err := ParseFromString(c.input)
+		require.NoError(t, err, c.input)
+		require.Equal(t, c.output, bj.String(), c.input)
+		require.True(t, Valid([]byte(c.input)), c.input)
+	}
+	for _, s := range []string{``, `{`, `[1,]`, `{"a" 1}`, `1 2`, `nul`, `'a'`} {
+		_, err := ParseFromString(s)
+		require.Error(t, err, s)
+		require.False(t, Valid([]byte(s)), s)
+	}
+}
+
+func TestPath(t *testing.T) {
+	bj, err := ParseFromString(`{"a": 1, "b": [10, {"c": "x"}, [20, 30]], "key with space": {"c": "y"}}`)
+	require.NoError(t, err)
+	cases := []struct {
+		paths  []string
+		output string
+	}{
+		{[]string{`$`}, bj.String()},
+		{[]string{`$.a`}, `1`},
+		{[]string{`$.b[1].c`}, `"x"`},
+		{[]string{`$.b[last]`}, `[20, 30]`},
+		{[]string{`$.b[last-2]`}, `10`},
+		{[]string{`$.a[0]`}, `1`},
+		{[]string{`$."key with space".c`}, `"y"`},
+		{[]string{`$.b[2][*]`}, `[20, 30]`},
+		{[]string{`$.*.c`}, `["y"]`},
+		{[]string{`$**.c`}, `["x", "y"]`},
+		{[]string{`$.a`, `$.b[0]`}, `[1, 10]`},
+		{[]string{`$.a`, `$.z`}, `[1]`},
+		{[]string{`$.z`}, ``},
+		{[]string{`$.a[1]`}, ``},
+	}
+	for _, c := range cases {
+		ps := make([]*Path, len(c.paths))
+		for i, s := range c.paths {
+			ps[i], err = ParsePath(s)
+			require.NoError(t, err, s)
+		}
+		r, ok, err := bj.Extract(ps)
+		require.NoError(t, err)
+		if len(c.output) == 0 {
+			require.False(t, ok, c.paths)
+			continue
+		}
+		require.True(t, ok, c.paths)
+		require.Equal(t, c.output, r.String(), c.paths)
+	}
+	for _, s := range []string{``, `a`, `$.`, `$[`, `$[-1]`, `$.1a`, `$**`, `$.a.`, `$[last+1]`} {
+		_, err := ParsePath(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestFuncs(t *testing.T) {
+	parse := func(s string) ByteJson {
+		bj, err := ParseFromString(s)
+		require.NoError(t, err, s)
+		return bj
+	}
+	containCases := []struct {
+		target    string
+		candidate string
+		contains  bool
+	}{
+		{`1`, `1.0`, true},
+		{`1`, `"1"`, false},
+		{`[1, 2, [3]]`, `2`, true},
+		{`[1, 2, [3]]`, `[2, 1]`, true},
+		{`[1, 2, [3]]`, `[3]`, true},
+		{`[1, 2]`, `[1, 4]`, false},
+		{`{"a": 1, "b": [1, 2]}`, `{"b": 1}`, true},
+		{`{"a": 1, "b": [1, 2]}`, `{"a": 1, "c": 1}`, false},
+		{`{"a": 1}`, `1`, false},
+		{`[{"a": 1}, 2]`, `{"a": 1}`, true},
+		{`18446744073709551615`, `-1`, false},
+	}
+	for _, c := range containCases {
+		require.Equal(t, c.contains, parse(c.target).Contains(parse(c.candidate)), c.target+" "+c.candidate)
+	}
+
+	require.Equal(t, int64(1), parse(`"abc"`).Length())
+	require.Equal(t, int64(3), parse(`[1, [2, 3], 4]`).Length())
+	require.Equal(t, int64(2), parse(`{"a": 1, "b": {"c": 2}}`).Length())
+
+	keys, ok, err := parse(`{"b": 1, "a": {"c": 2}, "aa": 3}`).Keys()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, `["a", "b", "aa"]`, keys.String())
+	_, ok, err = parse(`[1]`).Keys()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.Equal(t, `a"b`, parse(`"a\"b"`).Unquote())
+	require.Equal(t, `[1, "a"]`, parse(`[1, "a"]`).Unquote())
+	for input, output := range map[string]string{
+		`abc`:            `abc`,
+		`"abc"`:          `abc`,
+		`"a\tbé\\"`:      "a\tbé\\",
+		`"\ud83d\ude00"`: "\U0001F600",
+		`"a`:             `"a`,
+	} {
+		s, err := UnquoteString(input)
+		require.NoError(t, err, input)
+		require.Equal(t, output, s, input)
+	}
+}
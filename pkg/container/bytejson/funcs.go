@@ -0,0 +1,276 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+var ErrWildcardPath = errors.New("json path with wildcards is not allowed")
+
+// Extract returns the values of bj matched by the paths as JSON_EXTRACT does,
+// the only match is returned as it is if there is only one path without any
+// wildcard, or else all the matches are wrapped into an array. It returns
+// false if nothing is matched.
+func (bj ByteJson) Extract(ps []*Path) (ByteJson, bool, error) {
+	var rs []ByteJson
+
+	for _, p := range ps {
+		rs = bj.query(p.legs, rs)
+	}
+	if len(rs) == 0 {
+		return nil, false, nil
+	}
+	if len(ps) == 1 && !ps[0].HasWildcard() {
+		return rs[0], true, nil
+	}
+	r, err := NewArray(rs)
+	return r, err == nil, err
+}
+
+// Locate returns the only value of bj matched by a path without wildcards.
+func (bj ByteJson) Locate(p *Path) (ByteJson, bool, error) {
+	if p.HasWildcard() {
+		return nil, false, ErrWildcardPath
+	}
+	rs := bj.query(p.legs, nil)
+	if len(rs) == 0 {
+		return nil, false, nil
+	}
+	return rs[0], true, nil
+}
+
+// Unquote returns the text of bj, a string is returned without quotes.
+func (bj ByteJson) Unquote() string {
+	if bj.Type() == TpString {
+		return bj.GetString()
+	}
+	return bj.String()
+}
+
+// UnquoteString unquotes s as JSON_UNQUOTE does for a string argument, s is
+// returned as it is unless it is enclosed by double quotes.
+func UnquoteString(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return s, nil
+	}
+	return unquoteJsonString(s[1 : len(s)-1])
+}
+
+// unquoteJsonString replaces the escape sequences of a json string.
+func unquoteJsonString(s string) (string, error) {
+	if len(s) == 0 || strings.IndexByte(s, '\\') < 0 {
+		return s, nil
+	}
+	buf := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			buf = append(buf, s[i])
+			continue
+		}
+		if i++; i == len(s) {
+			return "", ErrInvalidJson
+		}
+		switch s[i] {
+		case '"', '\\', '/':
+			buf = append(buf, s[i])
+		case 'b':
+			buf = append(buf, '\b')
+		case 'f':
+			buf = append(buf, '\f')
+		case 'n':
+			buf = append(buf, '\n')
+		case 'r':
+			buf = append(buf, '\r')
+		case 't':
+			buf = append(buf, '\t')
+		case 'u':
+			r, n, err := decodeEscapedRune(s[i+1:])
+			if err != nil {
+				return "", err
+			}
+			var tmp [utf8.UTFMax]byte
+			buf = append(buf, tmp[:utf8.EncodeRune(tmp[:], r)]...)
+			i += n
+		default:
+			buf = append(buf, s[i])
+		}
+	}
+	return string(buf), nil
+}
+
+// decodeEscapedRune decodes the hex digits of \uXXXX, which may be followed by
+// the low surrogate \uXXXX, and returns the number of bytes consumed.
+func decodeEscapedRune(s string) (rune, int, error) {
+	if len(s) < 4 {
+		return 0, 0, ErrInvalidJson
+	}
+	r, err := strconv.ParseUint(s[:4], 16, 32)
+	if err != nil {
+		return 0, 0, ErrInvalidJson
+	}
+	if utf16.IsSurrogate(rune(r)) && len(s) >= 10 && s[4] == '\\' && s[5] == 'u' {
+		if r2, err := strconv.ParseUint(s[6:10], 16, 32); err == nil {
+			if c := utf16.DecodeRune(rune(r), rune(r2)); c != utf8.RuneError {
+				return c, 10, nil
+			}
+		}
+	}
+	return rune(r), 4, nil
+}
+
+// Length returns the length of bj as JSON_LENGTH does, which is the number of
+// the elements of an array or the members of an object, or 1 for a scalar.
+func (bj ByteJson) Length() int64 {
+	switch bj.Type() {
+	case TpObject, TpArray:
+		return int64(bj.Count())
+	}
+	return 1
+}
+
+// Keys returns the array of the keys of an object, it returns false if bj is
+// not an object.
+func (bj ByteJson) Keys() (ByteJson, bool, error) {
+	if bj.Type() != TpObject {
+		return nil, false, nil
+	}
+	n := bj.Count()
+	keys := make([]ByteJson, n)
+	for i := 0; i < n; i++ {
+		keys[i] = NewString(bj.Key(i))
+	}
+	r, err := NewArray(keys)
+	return r, err == nil, err
+}
+
+// Contains reports whether bj contains the candidate as JSON_CONTAINS does:
+//
+//	a scalar contains a scalar if they are equal
+//	an array contains an array if it contains every element of the candidate
+//	an array contains a scalar or an object if any of its elements contains it
+//	an object contains an object if every key of the candidate is in the
+//	object and the value of the key contains the value of the candidate
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type() {
+	case TpObject:
+		if candidate.Type() != TpObject {
+			return false
+		}
+		for i, n := 0, candidate.Count(); i < n; i++ {
+			v, ok := bj.Lookup(candidate.Key(i))
+			if !ok || !v.Contains(candidate.Value(i)) {
+				return false
+			}
+		}
+		return true
+	case TpArray:
+		if candidate.Type() == TpArray {
+			for i, n := 0, candidate.Count(); i < n; i++ {
+				if !bj.Contains(candidate.Element(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		for i, n := 0, bj.Count(); i < n; i++ {
+			if bj.Element(i).Contains(candidate) {
+				return true
+			}
+		}
+		return false
+	}
+	return scalarEqual(bj, candidate)
+}
+
+// scalarEqual compares two scalars, the numbers are compared by their values.
+func scalarEqual(x, y ByteJson) bool {
+	if isNumber(x.Type()) && isNumber(y.Type()) {
+		return compareNumber(x, y) == 0
+	}
+	if x.Type() != y.Type() {
+		return false
+	}
+	return bytes.Equal(x, y)
+}
+
+func isNumber(tp TpCode) bool {
+	return tp == TpInt64 || tp == TpUint64 || tp == TpFloat64
+}
+
+func compareNumber(x, y ByteJson) int {
+	switch {
+	case x.Type() == TpInt64 && y.Type() == TpInt64:
+		return compareInt64(x.GetInt64(), y.GetInt64())
+	case x.Type() == TpUint64 && y.Type() == TpUint64:
+		return compareUint64(x.GetUint64(), y.GetUint64())
+	case x.Type() == TpInt64 && y.Type() == TpUint64:
+		if x.GetInt64() < 0 {
+			return -1
+		}
+		return compareUint64(uint64(x.GetInt64()), y.GetUint64())
+	case x.Type() == TpUint64 && y.Type() == TpInt64:
+		return -compareNumber(y, x)
+	}
+	return compareFloat64(x.toFloat64(), y.toFloat64())
+}
+
+func (bj ByteJson) toFloat64() float64 {
+	switch bj.Type() {
+	case TpInt64:
+		return float64(bj.GetInt64())
+	case TpUint64:
+		return float64(bj.GetUint64())
+	case TpFloat64:
+		return bj.GetFloat64()
+	}
+	return math.NaN()
+}
+
+func compareInt64(x, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(x, y uint64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func compareFloat64(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,224 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The legs of a path.
+const (
+	legKey = iota
+	legIndex
+	legKeyWildcard
+	legIndexWildcard
+	legEllipsis
+)
+
+var ErrInvalidPath = errors.New("invalid json path")
+
+type pathLeg struct {
+	typ  int
+	key  string
+	idx  int  // index of the element, counted from the last if last is set
+	last bool // the index is of the form last[-n]
+}
+
+// Path is a json path of mysql, which is '$' followed by the legs of .key,
+// ."key", .*, [n], [last], [last-n], [*] and **.
+type Path struct {
+	legs []pathLeg
+	text string
+}
+
+// ParsePath parses the json path s.
+func ParsePath(s string) (*Path, error) {
+	p := &Path{text: s}
+	s = strings.TrimSpace(s)
+	if len(s) == 0 || s[0] != '$' {
+		return nil, pathError(p.text)
+	}
+	for s = strings.TrimLeftFunc(s[1:], unicode.IsSpace); len(s) > 0; s = strings.TrimLeftFunc(s, unicode.IsSpace) {
+		var err error
+		var leg pathLeg
+
+		switch {
+		case strings.HasPrefix(s, "**"):
+			leg.typ, s = legEllipsis, s[2:]
+		case s[0] == '.':
+			leg, s, err = parseKeyLeg(strings.TrimLeftFunc(s[1:], unicode.IsSpace))
+		case s[0] == '[':
+			leg, s, err = parseIndexLeg(s[1:])
+		default:
+			err = ErrInvalidPath
+		}
+		if err != nil {
+			return nil, pathError(p.text)
+		}
+		p.legs = append(p.legs, leg)
+	}
+	if n := len(p.legs); n > 0 && p.legs[n-1].typ == legEllipsis {
+		return nil, pathError(p.text)
+	}
+	return p, nil
+}
+
+func pathError(s string) error {
+	return fmt.Errorf("%w '%s'", ErrInvalidPath, s)
+}
+
+func parseKeyLeg(s string) (pathLeg, string, error) {
+	switch {
+	case len(s) == 0:
+		return pathLeg{}, s, ErrInvalidPath
+	case s[0] == '*':
+		return pathLeg{typ: legKeyWildcard}, s[1:], nil
+	case s[0] == '"':
+		i := 1
+		for ; i < len(s) && s[i] != '"'; i++ {
+			if s[i] == '\\' {
+				i++
+			}
+		}
+		if i >= len(s) {
+			return pathLeg{}, s, ErrInvalidPath
+		}
+		key, err := strconv.Unquote(s[:i+1])
+		if err != nil {
+			return pathLeg{}, s, ErrInvalidPath
+		}
+		return pathLeg{typ: legKey, key: key}, s[i+1:], nil
+	}
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return !(r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r))
+	})
+	if i < 0 {
+		i = len(s)
+	}
+	if i == 0 || unicode.IsDigit(rune(s[0])) {
+		return pathLeg{}, s, ErrInvalidPath
+	}
+	return pathLeg{typ: legKey, key: s[:i]}, s[i:], nil
+}
+
+func parseIndexLeg(s string) (pathLeg, string, error) {
+	var leg pathLeg
+
+	i := strings.IndexByte(s, ']')
+	if i < 0 {
+		return leg, s, ErrInvalidPath
+	}
+	idx, rest := strings.TrimSpace(s[:i]), s[i+1:]
+	if idx == "*" {
+		leg.typ = legIndexWildcard
+		return leg, rest, nil
+	}
+	leg.typ = legIndex
+	if strings.HasPrefix(idx, "last") {
+		leg.last, idx = true, strings.TrimSpace(idx[4:])
+		if len(idx) == 0 {
+			return leg, rest, nil
+		}
+		if idx[0] != '-' {
+			return leg, s, ErrInvalidPath
+		}
+		idx = strings.TrimSpace(idx[1:])
+	}
+	n, err := strconv.ParseUint(idx, 10, 31)
+	if err != nil {
+		return leg, s, ErrInvalidPath
+	}
+	leg.idx = int(n)
+	return leg, rest, nil
+}
+
+// HasWildcard reports whether the path has any wildcard or ellipsis, whose
+// matches are collected into an array.
+func (p *Path) HasWildcard() bool {
+	for _, leg := range p.legs {
+		if leg.typ != legKey && leg.typ != legIndex {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Path) String() string {
+	return p.text
+}
+
+// Query returns the values of bj matched by the path in document order.
+func (bj ByteJson) Query(p *Path) []ByteJson {
+	return bj.query(p.legs, nil)
+}
+
+func (bj ByteJson) query(legs []pathLeg, rs []ByteJson) []ByteJson {
+	if len(legs) == 0 {
+		return append(rs, bj)
+	}
+	leg := legs[0]
+	switch leg.typ {
+	case legKey:
+		if bj.Type() == TpObject {
+			if v, ok := bj.Lookup(leg.key); ok {
+				rs = v.query(legs[1:], rs)
+			}
+		}
+	case legKeyWildcard:
+		if bj.Type() == TpObject {
+			for i, n := 0, bj.Count(); i < n; i++ {
+				rs = bj.Value(i).query(legs[1:], rs)
+			}
+		}
+	case legIndex:
+		// a value which is not an array is treated as an array of itself
+		if bj.Type() != TpArray {
+			if leg.idx == 0 {
+				rs = bj.query(legs[1:], rs)
+			}
+			break
+		}
+		n, idx := bj.Count(), leg.idx
+		if leg.last {
+			idx = n - 1 - idx
+		}
+		if idx >= 0 && idx < n {
+			rs = bj.Element(idx).query(legs[1:], rs)
+		}
+	case legIndexWildcard:
+		if bj.Type() == TpArray {
+			for i, n := 0, bj.Count(); i < n; i++ {
+				rs = bj.Element(i).query(legs[1:], rs)
+			}
+		}
+	case legEllipsis:
+		rs = bj.query(legs[1:], rs)
+		switch bj.Type() {
+		case TpObject:
+			for i, n := 0, bj.Count(); i < n; i++ {
+				rs = bj.Value(i).query(legs, rs)
+			}
+		case TpArray:
+			for i, n := 0, bj.Count(); i < n; i++ {
+				rs = bj.Element(i).query(legs, rs)
+			}
+		}
+	}
+	return rs
+}
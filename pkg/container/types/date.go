@@ -14,7 +14,13 @@
 
 package types
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 const (
 	daysPer400Years = 365*400 + 97
@@ -43,8 +49,66 @@ func init() {
 	localTZ = int64(offset)
 }
 
+var (
+	ErrInvalidDate     = errors.New("invalid date value")
+	ErrInvalidDatetime = errors.New("invalid datetime value")
+)
+
 func (a Date) String() string {
-	return ""
+	y, m, d, _ := a.Calendar(true)
+	return fmt.Sprintf("%04d-%02d-%02d", y, m, d)
+}
+
+// ParseDate parses a date in the form YYYY-MM-DD or YYYYMMDD, a time part
+// following the date is accepted and dropped.
+func ParseDate(s string) (Date, error) {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexAny(s, " T"); i >= 0 {
+		if _, err := ParseDatetime(s); err != nil {
+			return 0, ErrInvalidDate
+		}
+		s = s[:i]
+	}
+	y, m, d, ok := parseCalendar(s)
+	if !ok {
+		return 0, ErrInvalidDate
+	}
+	return FromCalendar(y, m, d), nil
+}
+
+// parseCalendar parses YYYY-MM-DD or YYYYMMDD into a valid calendar date.
+func parseCalendar(s string) (int32, uint8, uint8, bool) {
+	var ps []string
+
+	if strings.IndexByte(s, '-') >= 0 {
+		ps = strings.Split(s, "-")
+	} else if len(s) == 8 {
+		ps = []string{s[:4], s[4:6], s[6:]}
+	}
+	if len(ps) != 3 || len(ps[0]) != 4 || len(ps[1]) == 0 || len(ps[1]) > 2 || len(ps[2]) == 0 || len(ps[2]) > 2 {
+		return 0, 0, 0, false
+	}
+	y, err := strconv.ParseUint(ps[0], 10, 16)
+	if err != nil || y == 0 {
+		return 0, 0, 0, false
+	}
+	m, err := strconv.ParseUint(ps[1], 10, 8)
+	if err != nil || m < 1 || m > 12 {
+		return 0, 0, 0, false
+	}
+	d, err := strconv.ParseUint(ps[2], 10, 8)
+	if err != nil || d < 1 || d > uint64(daysIn(int32(y), uint8(m))) {
+		return 0, 0, 0, false
+	}
+	return int32(y), uint8(m), uint8(d), true
+}
+
+// daysIn returns the number of days of the month in the year.
+func daysIn(year int32, month uint8) uint16 {
+	if month == 2 && isLeap(year) {
+		return 29
+	}
+	return daysBefore[month] - daysBefore[month-1]
 }
 
 // Holds number of days since January 1, year 1 in Gregorian calendar
@@ -15,6 +15,9 @@
 package types
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 	"unsafe"
 )
@@ -30,7 +33,12 @@ const (
 // calendar, and lower 20 bits holds number of microseconds
 
 func (a Datetime) String() string {
-	return ""
+	y, m, d, _ := a.ToDate().Calendar(true)
+	hour, min, sec := a.Clock()
+	if msec := a.MicroSec(); msec != 0 {
+		return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d.%06d", y, m, d, hour, min, sec, msec)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", y, m, d, hour, min, sec)
 }
 
 const (
@@ -55,8 +63,51 @@ func Now() Datetime {
 	return Datetime((sec << 20) + nsec/1000)
 }
 
-func ParseDatetime(s []byte) Datetime {
-	return 0
+// ParseDatetime parses a datetime in the form YYYY-MM-DD[( |T)HH:MM:SS[.ffffff]],
+// a missing time part is midnight.
+func ParseDatetime(s string) (Datetime, error) {
+	var hour, min, sec, msec uint64
+
+	s = strings.TrimSpace(s)
+	date, clock := s, ""
+	if i := strings.IndexAny(s, " T"); i >= 0 {
+		date, clock = s[:i], strings.TrimSpace(s[i+1:])
+	}
+	y, m, d, ok := parseCalendar(date)
+	if !ok {
+		return 0, ErrInvalidDatetime
+	}
+	if len(clock) > 0 {
+		var err error
+
+		if i := strings.IndexByte(clock, '.'); i >= 0 {
+			frac := clock[i+1:]
+			if len(frac) == 0 || len(frac) > 6 {
+				return 0, ErrInvalidDatetime
+			}
+			if msec, err = strconv.ParseUint(frac, 10, 32); err != nil {
+				return 0, ErrInvalidDatetime
+			}
+			for i := len(frac); i < 6; i++ {
+				msec *= 10
+			}
+			clock = clock[:i]
+		}
+		ps := strings.Split(clock, ":")
+		if len(ps) != 3 {
+			return 0, ErrInvalidDatetime
+		}
+		if hour, err = strconv.ParseUint(ps[0], 10, 8); err != nil || hour > 23 {
+			return 0, ErrInvalidDatetime
+		}
+		if min, err = strconv.ParseUint(ps[1], 10, 8); err != nil || min > 59 {
+			return 0, ErrInvalidDatetime
+		}
+		if sec, err = strconv.ParseUint(ps[2], 10, 8); err != nil || sec > 59 {
+			return 0, ErrInvalidDatetime
+		}
+	}
+	return FromClock(y, m, d, uint8(hour), uint8(min), uint8(sec), uint32(msec)), nil
 }
 
 func (dt Datetime) ToDate() Date {
@@ -80,3 +131,8 @@ func FromClock(year int32, month, day, hour, min, sec uint8, msec uint32) Dateti
 func (dt Datetime) sec() int64 {
 	return int64(dt) >> 20
 }
+
+// MicroSec returns the microseconds of the second.
+func (dt Datetime) MicroSec() int64 {
+	return int64(dt) & (1<<20 - 1)
+}
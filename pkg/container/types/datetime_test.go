@@ -18,6 +18,8 @@ import (
 	"fmt"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 var dayInMonth []int = []int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
@@ -66,3 +68,54 @@ func TestDatetime(t *testing.T) {
 	fmt.Println(dt.ToDate().Calendar(true))
 	fmt.Println(dt.Clock())
 }
+
+func TestParseDate(t *testing.T) {
+	kases := []struct {
+		s   string
+		d   string
+		err bool
+	}{
+		{"2021-08-13", "2021-08-13", false},
+		{"20210813", "2021-08-13", false},
+		{"2020-02-29", "2020-02-29", false},
+		{"2021-08-13 17:55:34", "2021-08-13", false},
+		{"2021-02-29", "", true},
+		{"2021-13-01", "", true},
+		{"2021-08-13 25:00:00", "", true},
+		{"2021/08/13", "", true},
+		{"", "", true},
+	}
+	for _, k := range kases {
+		d, err := ParseDate(k.s)
+		if k.err {
+			require.Error(t, err, k.s)
+			continue
+		}
+		require.NoError(t, err, k.s)
+		require.Equal(t, k.d, d.String(), k.s)
+	}
+}
+
+func TestParseDatetime(t *testing.T) {
+	kases := []struct {
+		s   string
+		dt  string
+		err bool
+	}{
+		{"2021-08-13 17:55:34", "2021-08-13 17:55:34", false},
+		{"2021-08-13T17:55:34.000120", "2021-08-13 17:55:34.000120", false},
+		{"2021-08-13", "2021-08-13 00:00:00", false},
+		{"20210813", "2021-08-13 00:00:00", false},
+		{"2021-08-13 17:60:00", "", true},
+		{"2021-08-32 00:00:00", "", true},
+	}
+	for _, k := range kases {
+		dt, err := ParseDatetime(k.s)
+		if k.err {
+			require.Error(t, err, k.s)
+			continue
+		}
+		require.NoError(t, err, k.s)
+		require.Equal(t, k.dt, dt.String(), k.s)
+	}
+}
@@ -14,6 +14,471 @@
 
 package types
 
-func (a Decimal) String() string {
-	return ""
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+const (
+	MaxDecimal64Precision  = 18
+	MaxDecimal128Precision = 38
+
+	// DefaultDecimalPrecision and DefaultDecimalScale are used when DECIMAL
+	// is declared without (p, s), which is the same as mysql.
+	DefaultDecimalPrecision = 10
+	DefaultDecimalScale     = 0
+)
+
+var (
+	ErrDecimalOverflow  = errors.New("decimal overflow")
+	ErrDecimalDivByZero = errors.New("division by zero")
+	ErrDecimalInvalid   = errors.New("invalid decimal value")
+)
+
+var (
+	pow10Int64   [MaxDecimal64Precision + 1]int64
+	pow10Int128  [MaxDecimal128Precision + 1]Decimal128
+	bigPow10     [MaxDecimal128Precision + 1]*big.Int
+	maxDecimal64 = Decimal64(999999999999999999)
+)
+
+func init() {
+	pow10Int64[0] = 1
+	for i := 1; i <= MaxDecimal64Precision; i++ {
+		pow10Int64[i] = pow10Int64[i-1] * 10
+	}
+	pow10Int128[0] = Decimal128{Lo: 1}
+	bigPow10[0] = big.NewInt(1)
+	ten := big.NewInt(10)
+	for i := 1; i <= MaxDecimal128Precision; i++ {
+		pow10Int128[i] = Decimal128Mul(pow10Int128[i-1], Decimal128{Lo: 10})
+		bigPow10[i] = new(big.Int).Mul(bigPow10[i-1], ten)
+	}
+}
+
+// DecimalType returns the column type for DECIMAL(precision, scale), decimal64
+// is used whenever the precision fits in it.
+func DecimalType(precision, scale int32) Type {
+	if precision <= 0 {
+		precision, scale = DefaultDecimalPrecision, DefaultDecimalScale
+	}
+	if precision <= MaxDecimal64Precision {
+		return Type{Oid: T_decimal64, Size: 8, Width: precision, Precision: scale}
+	}
+	return Type{Oid: T_decimal128, Size: 16, Width: precision, Precision: scale}
+}
+
+// IsDecimal returns true if t is one of the decimal types.
+func IsDecimal(t T) bool {
+	return t == T_decimal64 || t == T_decimal128
+}
+
+// Decimal64
+
+func (a Decimal64) String() string {
+	return strconv.FormatInt(int64(a), 10)
+}
+
+// Format returns the literal of a with scale digits after the decimal point.
+func (a Decimal64) Format(scale int32) string {
+	return formatDecimal(strconv.FormatInt(int64(a), 10), scale)
+}
+
+func (a Decimal64) Compare(b Decimal64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Scale multiplies a by 10^n, n can be negative, in which case the result
+// is rounded half away from zero.
+func (a Decimal64) Scale(n int32) (Decimal64, error) {
+	switch {
+	case n == 0:
+		return a, nil
+	case n > 0:
+		if n > MaxDecimal64Precision {
+			if a == 0 {
+				return 0, nil
+			}
+			return 0, ErrDecimalOverflow
+		}
+		r := int64(a) * pow10Int64[n]
+		if r/pow10Int64[n] != int64(a) || r > int64(maxDecimal64) || r < -int64(maxDecimal64) {
+			return 0, ErrDecimalOverflow
+		}
+		return Decimal64(r), nil
+	default:
+		n = -n
+		if n > MaxDecimal64Precision {
+			return 0, nil
+		}
+		p := pow10Int64[n]
+		q, r := int64(a)/p, int64(a)%p
+		if r >= p/2 {
+			q++
+		} else if -r >= p/2 {
+			q--
+		}
+		return Decimal64(q), nil
+	}
+}
+
+func (a Decimal64) ToDecimal128() Decimal128 {
+	return Decimal128FromInt64(int64(a))
+}
+
+func (a Decimal64) ToFloat64(scale int32) float64 {
+	return float64(a) / math.Pow10(int(scale))
+}
+
+// ToInt64 returns the integral part of a, rounded half away from zero.
+func (a Decimal64) ToInt64(scale int32) int64 {
+	r, _ := a.Scale(-scale)
+	return int64(r)
+}
+
+func Decimal64FromInt64(v int64, scale int32) (Decimal64, error) {
+	return Decimal64(v).Scale(scale)
+}
+
+func Decimal64FromFloat64(v float64, scale int32) (Decimal64, error) {
+	f := math.Round(v * math.Pow10(int(scale)))
+	if math.IsNaN(f) || math.Abs(f) > float64(maxDecimal64) {
+		return 0, ErrDecimalOverflow
+	}
+	return Decimal64(f), nil
+}
+
+// ParseStringToDecimal64 parses s as a DECIMAL(precision, scale) value.
+func ParseStringToDecimal64(s string, precision, scale int32) (Decimal64, error) {
+	if precision <= 0 {
+		precision = MaxDecimal64Precision
+	}
+	v, err := parseDecimal(s, precision, scale)
+	if err != nil {
+		return 0, err
+	}
+	if !v.IsInt64() {
+		return 0, ErrDecimalOverflow
+	}
+	return Decimal64(v.Int64()), nil
+}
+
+// Decimal64Add and Decimal64Sub never overflow int64 since both arguments are
+// less than 10^18, the result is only checked against the precision.
+func Decimal64Add(a, b Decimal64) (Decimal64, error) {
+	r := a + b
+	if r > maxDecimal64 || r < -maxDecimal64 {
+		return 0, ErrDecimalOverflow
+	}
+	return r, nil
+}
+
+func Decimal64Sub(a, b Decimal64) (Decimal64, error) {
+	r := a - b
+	if r > maxDecimal64 || r < -maxDecimal64 {
+		return 0, ErrDecimalOverflow
+	}
+	return r, nil
+}
+
+// Decimal128
+
+func (a Decimal128) String() string {
+	return a.toBig().String()
+}
+
+// Format returns the literal of a with scale digits after the decimal point.
+func (a Decimal128) Format(scale int32) string {
+	return formatDecimal(a.toBig().String(), scale)
+}
+
+func (a Decimal128) Compare(b Decimal128) int {
+	switch {
+	case a.Hi < b.Hi:
+		return -1
+	case a.Hi > b.Hi:
+		return 1
+	case a.Lo < b.Lo:
+		return -1
+	case a.Lo > b.Lo:
+		return 1
+	}
+	return 0
+}
+
+func (a Decimal128) IsNeg() bool {
+	return a.Hi < 0
+}
+
+func (a Decimal128) Neg() Decimal128 {
+	return Decimal128Sub(Decimal128{}, a)
+}
+
+// Scale multiplies a by 10^n, n can be negative, in which case the result
+// is rounded half away from zero.
+func (a Decimal128) Scale(n int32) (Decimal128, error) {
+	switch {
+	case n == 0:
+		return a, nil
+	case n > 0:
+		if n > MaxDecimal128Precision {
+			if a == (Decimal128{}) {
+				return a, nil
+			}
+			return Decimal128{}, ErrDecimalOverflow
+		}
+		return decimal128FromBig(new(big.Int).Mul(a.toBig(), bigPow10[n]))
+	default:
+		if -n > MaxDecimal128Precision {
+			return Decimal128{}, nil
+		}
+		return decimal128FromBig(roundQuo(a.toBig(), bigPow10[-n]))
+	}
+}
+
+// ToDecimal64 narrows a to decimal64, an error is returned if it does not fit.
+func (a Decimal128) ToDecimal64() (Decimal64, error) {
+	if (a.Hi == 0 && a.Lo <= uint64(maxDecimal64)) || (a.Hi == -1 && a.Lo >= uint64(-maxDecimal64)) {
+		return Decimal64(int64(a.Lo)), nil
+	}
+	return 0, ErrDecimalOverflow
+}
+
+func (a Decimal128) ToFloat64(scale int32) float64 {
+	f, _ := new(big.Float).SetInt(a.toBig()).Float64()
+	return f / math.Pow10(int(scale))
+}
+
+// ToInt64 returns the integral part of a, rounded half away from zero.
+func (a Decimal128) ToInt64(scale int32) (int64, error) {
+	r, err := a.Scale(-scale)
+	if err != nil {
+		return 0, err
+	}
+	if (r.Hi == 0 && r.Lo <= math.MaxInt64) || (r.Hi == -1 && r.Lo >= 1<<63) {
+		return int64(r.Lo), nil
+	}
+	return 0, ErrDecimalOverflow
+}
+
+func Decimal128FromInt64(v int64) Decimal128 {
+	return Decimal128{Lo: uint64(v), Hi: v >> 63}
+}
+
+func Decimal128FromUint64(v uint64) Decimal128 {
+	return Decimal128{Lo: v}
+}
+
+func Decimal128FromFloat64(v float64, scale int32) (Decimal128, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return Decimal128{}, ErrDecimalOverflow
+	}
+	f := new(big.Float).SetFloat64(v)
+	f.Mul(f, new(big.Float).SetInt(bigPow10[scale]))
+	if v < 0 {
+		f.Sub(f, big.NewFloat(0.5))
+	} else {
+		f.Add(f, big.NewFloat(0.5))
+	}
+	i, _ := f.Int(nil)
+	return decimal128FromBig(i)
+}
+
+// ParseStringToDecimal128 parses s as a DECIMAL(precision, scale) value.
+func ParseStringToDecimal128(s string, precision, scale int32) (Decimal128, error) {
+	if precision <= 0 {
+		precision = MaxDecimal128Precision
+	}
+	v, err := parseDecimal(s, precision, scale)
+	if err != nil {
+		return Decimal128{}, err
+	}
+	return decimal128FromBig(v)
+}
+
+// Decimal128Add, Decimal128Sub and Decimal128Mul wrap around on overflow,
+// callers which need to detect it should use the checked versions.
+func Decimal128Add(a, b Decimal128) Decimal128 {
+	lo, carry := bits.Add64(a.Lo, b.Lo, 0)
+	return Decimal128{Lo: lo, Hi: a.Hi + b.Hi + int64(carry)}
+}
+
+func Decimal128Sub(a, b Decimal128) Decimal128 {
+	lo, borrow := bits.Sub64(a.Lo, b.Lo, 0)
+	return Decimal128{Lo: lo, Hi: a.Hi - b.Hi - int64(borrow)}
+}
+
+func Decimal128Mul(a, b Decimal128) Decimal128 {
+	hi, lo := bits.Mul64(a.Lo, b.Lo)
+	hi += a.Lo*uint64(b.Hi) + uint64(a.Hi)*b.Lo
+	return Decimal128{Lo: lo, Hi: int64(hi)}
+}
+
+func Decimal128AddChecked(a, b Decimal128) (Decimal128, error) {
+	r := Decimal128Add(a, b)
+	if a.IsNeg() == b.IsNeg() && r.IsNeg() != a.IsNeg() {
+		return r, ErrDecimalOverflow
+	}
+	return r, nil
+}
+
+func Decimal128SubChecked(a, b Decimal128) (Decimal128, error) {
+	r := Decimal128Sub(a, b)
+	if a.IsNeg() != b.IsNeg() && r.IsNeg() != a.IsNeg() {
+		return r, ErrDecimalOverflow
+	}
+	return r, nil
+}
+
+func Decimal128MulChecked(a, b Decimal128) (Decimal128, error) {
+	return decimal128FromBig(new(big.Int).Mul(a.toBig(), b.toBig()))
+}
+
+// Decimal128Div returns a / b with the quotient scaled by 10^n, i.e. the result
+// has n more fractional digits than a - b. It is rounded half away from zero.
+func Decimal128Div(a, b Decimal128, n int32) (Decimal128, error) {
+	if b == (Decimal128{}) {
+		return Decimal128{}, ErrDecimalDivByZero
+	}
+	x := a.toBig()
+	if n > 0 {
+		x.Mul(x, bigPow10[n])
+	}
+	return decimal128FromBig(roundQuo(x, b.toBig()))
+}
+
+func (a Decimal128) toBig() *big.Int {
+	x := new(big.Int).SetUint64(uint64(a.Hi))
+	x.Lsh(x, 64)
+	x.Or(x, new(big.Int).SetUint64(a.Lo))
+	if a.Hi < 0 {
+		x.Sub(x, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+	return x
+}
+
+func decimal128FromBig(x *big.Int) (Decimal128, error) {
+	if x.CmpAbs(bigPow10[MaxDecimal128Precision]) >= 0 {
+		return Decimal128{}, ErrDecimalOverflow
+	}
+	neg := x.Sign() < 0
+	y := new(big.Int).Abs(x)
+	lo := y.Uint64()
+	hi := new(big.Int).Rsh(y, 64).Uint64()
+	r := Decimal128{Lo: lo, Hi: int64(hi)}
+	if neg {
+		r = r.Neg()
+	}
+	return r, nil
+}
+
+// roundQuo returns x / y rounded half away from zero.
+func roundQuo(x, y *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	r.Abs(r).Lsh(r, 1)
+	if r.CmpAbs(y) >= 0 {
+		if x.Sign()*y.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// parseDecimal parses s into an integer holding the value scaled by 10^scale,
+// extra fractional digits are rounded half away from zero.
+func parseDecimal(s string, precision, scale int32) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return nil, ErrDecimalInvalid
+	}
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: '%s'", ErrDecimalInvalid, s)
+		}
+		exp, s = e, s[:i]
+	}
+	ip, fp := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		ip, fp = s[:i], s[i+1:]
+	}
+	if len(ip) == 0 && len(fp) == 0 {
+		return nil, fmt.Errorf("%w: '%s'", ErrDecimalInvalid, s)
+	}
+	digits := ip + fp
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return nil, fmt.Errorf("%w: '%s'", ErrDecimalInvalid, s)
+		}
+	}
+	if len(digits) == 0 {
+		digits = "0"
+	}
+	v, _ := new(big.Int).SetString(digits, 10)
+	// v * 10^shift is the value scaled by 10^scale
+	shift := int(scale) - len(fp) + exp
+	switch {
+	case shift > 0:
+		if shift > MaxDecimal128Precision {
+			if v.Sign() != 0 {
+				return nil, ErrDecimalOverflow
+			}
+			break
+		}
+		v.Mul(v, bigPow10[shift])
+	case shift < 0:
+		if -shift > MaxDecimal128Precision {
+			v.SetInt64(0)
+			break
+		}
+		v = roundQuo(v, bigPow10[-shift])
+	}
+	if v.Cmp(bigPow10[precision]) >= 0 {
+		return nil, ErrDecimalOverflow
+	}
+	if neg {
+		v.Neg(v)
+	}
+	return v, nil
+}
+
+// formatDecimal inserts the decimal point into the integer literal s.
+func formatDecimal(s string, scale int32) string {
+	if scale <= 0 {
+		return s
+	}
+	sign := ""
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+	if n := int(scale) + 1 - len(s); n > 0 {
+		s = strings.Repeat("0", n) + s
+	}
+	i := len(s) - int(scale)
+	return sign + s[:i] + "." + s[i:]
 }
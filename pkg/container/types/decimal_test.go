@@ -0,0 +1,73 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimal64(t *testing.T) {
+	kases := []struct {
+		s         string
+		precision int32
+		scale     int32
+		want      string
+		err       bool
+	}{
+		{"123.45", 10, 2, "123.45", false},
+		{"-0.005", 10, 2, "-0.01", false},
+		{"0.004", 10, 2, "0.00", false},
+		{"1.5e2", 10, 1, "150.0", false},
+		{"12", 5, 2, "12.00", false},
+		{"1234", 5, 2, "", true},
+		{"abc", 5, 2, "", true},
+	}
+	for _, k := range kases {
+		d, err := ParseStringToDecimal64(k.s, k.precision, k.scale)
+		if k.err {
+			require.Error(t, err, k.s)
+			continue
+		}
+		require.NoError(t, err, k.s)
+		require.Equal(t, k.want, d.Format(k.scale))
+	}
+	a, _ := ParseStringToDecimal64("1.25", 10, 2)
+	r, err := a.Scale(-1)
+	require.NoError(t, err)
+	require.Equal(t, "1.3", r.Format(1))
+	_, err = Decimal64(maxDecimal64).Scale(1)
+	require.Error(t, err)
+}
+
+func TestDecimal128(t *testing.T) {
+	a, err := ParseStringToDecimal128("-12345678901234567890.123", 38, 3)
+	require.NoError(t, err)
+	require.Equal(t, "-12345678901234567890.123", a.Format(3))
+	b, err := ParseStringToDecimal128("2", 38, 0)
+	require.NoError(t, err)
+	require.Equal(t, "-24691357802469135780.246", Decimal128Mul(a, b).Format(3))
+	require.Equal(t, "0.000", Decimal128Add(a, a.Neg()).Format(3))
+	require.Equal(t, -1, a.Compare(b))
+	q, err := Decimal128Div(a, b, 4)
+	require.NoError(t, err)
+	require.Equal(t, "-6172839450617283945.0615000", q.Format(7))
+	_, err = Decimal128Div(a, Decimal128{}, 0)
+	require.Error(t, err)
+	d, err := Decimal128FromInt64(-42).ToDecimal64()
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(-42), d)
+}
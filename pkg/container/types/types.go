@@ -34,7 +34,8 @@ const (
 	T_uint64 = 10
 
 	// numeric/decimal family - unsigned attribute is deprecated
-	T_decimal = 11
+	T_decimal64  = 11 // 8 byte, precision <= 18
+	T_decimal128 = 14 // 16 byte, precision <= 38
 
 	// numeric/float family - unsigned attribute is deprecated
 	T_float32 = 12
@@ -75,7 +76,14 @@ type Date int32
 
 type Datetime int64
 
-type Decimal struct {
+// Decimal64 is a fixed-point number whose value is the integer scaled by 10^-scale,
+// the scale of the column is kept in Type.Precision.
+type Decimal64 int64
+
+// Decimal128 is the 128-bit two's complement version of Decimal64.
+type Decimal128 struct {
+	Lo uint64
+	Hi int64
 }
 
 var Types map[string]T = map[string]T{
@@ -91,7 +99,7 @@ var Types map[string]T = map[string]T{
 	"integer unsigned":  T_int32,
 	"bigint unsigned":   T_int64,
 
-	"decimal": T_decimal,
+	"decimal": T_decimal64,
 
 	"float":  T_float32,
 	"double": T_float64,
@@ -138,10 +146,20 @@ func (t T) ToType() Type {
 		typ.Size = 4
 	case T_float64:
 		typ.Size = 8
+	case T_decimal64:
+		typ.Size = 8
+	case T_decimal128:
+		typ.Size = 16
+	case T_date:
+		typ.Size = 4
+	case T_datetime:
+		typ.Size = 8
 	case T_char:
 		typ.Size = 24
 	case T_varchar:
 		typ.Size = 24
+	case T_json:
+		typ.Size = 24
 	case T_sel:
 		typ.Size = 8
 	}
@@ -166,8 +184,10 @@ func (t T) String() string {
 		return "INT UNSIGNED"
 	case T_uint64:
 		return "BIGINT UNSIGNED"
-	case T_decimal:
-		return "DECIMAL"
+	case T_decimal64:
+		return "DECIMAL64"
+	case T_decimal128:
+		return "DECIMAL128"
 	case T_float32:
 		return "FLOAT"
 	case T_float64:
@@ -290,6 +310,10 @@ func (t T) TypeLen() int {
 		return 4
 	case T_float64:
 		return 8
+	case T_decimal64:
+		return 8
+	case T_decimal128:
+		return 16
 	case T_char:
 		return 24
 	case T_varchar:
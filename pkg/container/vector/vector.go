@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
@@ -78,12 +79,6 @@ func New(typ types.Type) *Vector {
 			Col: []uint64{},
 			Nsp: &nulls.Nulls{},
 		}
-	case types.T_decimal:
-		return &Vector{
-			Typ: typ,
-			Col: []types.Decimal{},
-			Nsp: &nulls.Nulls{},
-		}
 	case types.T_float32:
 		return &Vector{
 			Typ: typ,
@@ -96,6 +91,18 @@ func New(typ types.Type) *Vector {
 			Col: []float64{},
 			Nsp: &nulls.Nulls{},
 		}
+	case types.T_decimal64:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Decimal64{},
+			Nsp: &nulls.Nulls{},
+		}
+	case types.T_decimal128:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Decimal128{},
+			Nsp: &nulls.Nulls{},
+		}
 	case types.T_date:
 		return &Vector{
 			Typ: typ,
@@ -222,6 +229,26 @@ func (v *Vector) SetLength(n int) {
 		m := len(vs)
 		v.Col = vs[:n]
 		v.Nsp.RemoveRange(uint64(n), uint64(m))
+	case types.T_decimal64:
+		vs := v.Col.([]types.Decimal64)
+		m := len(vs)
+		v.Col = vs[:n]
+		v.Nsp.RemoveRange(uint64(n), uint64(m))
+	case types.T_decimal128:
+		vs := v.Col.([]types.Decimal128)
+		m := len(vs)
+		v.Col = vs[:n]
+		v.Nsp.RemoveRange(uint64(n), uint64(m))
+	case types.T_date:
+		vs := v.Col.([]types.Date)
+		m := len(vs)
+		v.Col = vs[:n]
+		v.Nsp.RemoveRange(uint64(n), uint64(m))
+	case types.T_datetime:
+		vs := v.Col.([]types.Datetime)
+		m := len(vs)
+		v.Col = vs[:n]
+		v.Nsp.RemoveRange(uint64(n), uint64(m))
 	case types.T_sel:
 		vs := v.Col.([]int64)
 		m := len(vs)
@@ -394,6 +421,66 @@ func (v *Vector) Dup(proc *process.Process) (*Vector, error) {
 			Nsp:  v.Nsp,
 			Ref:  v.Ref,
 		}, nil
+	case types.T_decimal64:
+		vs := v.Col.([]types.Decimal64)
+		data, err := proc.Alloc(int64(len(vs) * 8))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeDecimal64Slice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+		}, nil
+	case types.T_decimal128:
+		vs := v.Col.([]types.Decimal128)
+		data, err := proc.Alloc(int64(len(vs) * 16))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeDecimal128Slice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+		}, nil
+	case types.T_date:
+		vs := v.Col.([]types.Date)
+		data, err := proc.Alloc(int64(len(vs) * 4))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeDateSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+		}, nil
+	case types.T_datetime:
+		vs := v.Col.([]types.Datetime)
+		data, err := proc.Alloc(int64(len(vs) * 8))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeDatetimeSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+		}, nil
 	case types.T_char, types.T_varchar, types.T_json:
 		var err error
 		var data []byte
@@ -458,6 +545,18 @@ func (v *Vector) Window(start, end int, w *Vector) *Vector {
 	case types.T_float64:
 		w.Col = v.Col.([]float64)[start:end]
 		w.Nsp = v.Nsp.Range(uint64(start), uint64(end), w.Nsp)
+	case types.T_decimal64:
+		w.Col = v.Col.([]types.Decimal64)[start:end]
+		w.Nsp = v.Nsp.Range(uint64(start), uint64(end), w.Nsp)
+	case types.T_decimal128:
+		w.Col = v.Col.([]types.Decimal128)[start:end]
+		w.Nsp = v.Nsp.Range(uint64(start), uint64(end), w.Nsp)
+	case types.T_date:
+		w.Col = v.Col.([]types.Date)[start:end]
+		w.Nsp = v.Nsp.Range(uint64(start), uint64(end), w.Nsp)
+	case types.T_datetime:
+		w.Col = v.Col.([]types.Datetime)[start:end]
+		w.Nsp = v.Nsp.Range(uint64(start), uint64(end), w.Nsp)
 	case types.T_sel:
 		w.Col = v.Col.([]int64)[start:end]
 		w.Nsp = v.Nsp.Range(uint64(start), uint64(end), w.Nsp)
@@ -489,12 +588,14 @@ func (v *Vector) Append(arg interface{}) error {
 		v.Col = append(v.Col.([]uint32), arg.([]uint32)...)
 	case types.T_uint64:
 		v.Col = append(v.Col.([]uint64), arg.([]uint64)...)
-	case types.T_decimal:
-		v.Col = append(v.Col.([]types.Decimal), arg.([]types.Decimal)...)
 	case types.T_float32:
 		v.Col = append(v.Col.([]float32), arg.([]float32)...)
 	case types.T_float64:
 		v.Col = append(v.Col.([]float64), arg.([]float64)...)
+	case types.T_decimal64:
+		v.Col = append(v.Col.([]types.Decimal64), arg.([]types.Decimal64)...)
+	case types.T_decimal128:
+		v.Col = append(v.Col.([]types.Decimal128), arg.([]types.Decimal128)...)
 	case types.T_date:
 		v.Col = append(v.Col.([]types.Date), arg.([]types.Date)...)
 	case types.T_datetime:
@@ -591,10 +692,6 @@ func (v *Vector) Shuffle(sels []int64, proc *process.Process) (*Vector, error) {
 		v.Col = shuffle.Ui64Shuffle(vs, ws, sels)
 		v.Nsp = v.Nsp.Filter(sels)
 		proc.Free(data)
-	case types.T_decimal:
-		vs := v.Col.([]types.Decimal)
-		v.Col = shuffle.DecimalShuffle(vs, sels)
-		v.Nsp = v.Nsp.Filter(sels)
 	case types.T_float32:
 		vs := v.Col.([]float32)
 		data, err := proc.Alloc(int64(len(vs) * 4))
@@ -615,14 +712,46 @@ func (v *Vector) Shuffle(sels []int64, proc *process.Process) (*Vector, error) {
 		v.Col = shuffle.Float64Shuffle(vs, ws, sels)
 		v.Nsp = v.Nsp.Filter(sels)
 		proc.Free(data)
+	case types.T_decimal64:
+		vs := v.Col.([]types.Decimal64)
+		data, err := proc.Alloc(int64(len(vs) * 8))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeDecimal64Slice(data)
+		v.Col = shuffle.Decimal64Shuffle(vs, ws, sels)
+		v.Nsp = v.Nsp.Filter(sels)
+		proc.Free(data)
+	case types.T_decimal128:
+		vs := v.Col.([]types.Decimal128)
+		data, err := proc.Alloc(int64(len(vs) * 16))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeDecimal128Slice(data)
+		v.Col = shuffle.Decimal128Shuffle(vs, ws, sels)
+		v.Nsp = v.Nsp.Filter(sels)
+		proc.Free(data)
 	case types.T_date:
 		vs := v.Col.([]types.Date)
-		v.Col = shuffle.DateShuffle(vs, sels)
+		data, err := proc.Alloc(int64(len(vs) * 4))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeDateSlice(data)
+		v.Col = shuffle.DateShuffle(vs, ws, sels)
 		v.Nsp = v.Nsp.Filter(sels)
+		proc.Free(data)
 	case types.T_datetime:
 		vs := v.Col.([]types.Datetime)
-		v.Col = shuffle.DatetimeShuffle(vs, sels)
+		data, err := proc.Alloc(int64(len(vs) * 8))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeDatetimeSlice(data)
+		v.Col = shuffle.DatetimeShuffle(vs, ws, sels)
 		v.Nsp = v.Nsp.Filter(sels)
+		proc.Free(data)
 	case types.T_sel:
 		vs := v.Col.([]int64)
 		data, err := proc.Alloc(int64(len(vs) * 8))
@@ -962,6 +1091,114 @@ func (v *Vector) UnionOne(w *Vector, sel int64, proc *process.Process) error {
 			vs = append(vs, w.Col.([]float64)[sel])
 			v.Col = vs
 		}
+	case types.T_decimal64:
+		if len(v.Data) == 0 {
+			data, err := proc.Alloc(8 * 8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeDecimal64Slice(data)
+			vs[0] = w.Col.([]types.Decimal64)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Decimal64)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := proc.Grow(v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				proc.Free(v.Data)
+				vs = encoding.DecodeDecimal64Slice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Decimal64)[sel])
+			v.Col = vs
+		}
+	case types.T_decimal128:
+		if len(v.Data) == 0 {
+			data, err := proc.Alloc(16 * 8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeDecimal128Slice(data)
+			vs[0] = w.Col.([]types.Decimal128)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Decimal128)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := proc.Grow(v.Data[:n*16], int64(n+1)*16)
+				if err != nil {
+					return err
+				}
+				proc.Free(v.Data)
+				vs = encoding.DecodeDecimal128Slice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Decimal128)[sel])
+			v.Col = vs
+		}
+	case types.T_date:
+		if len(v.Data) == 0 {
+			data, err := proc.Alloc(8 * 4)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeDateSlice(data)
+			vs[0] = w.Col.([]types.Date)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Date)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := proc.Grow(v.Data[:n*4], int64(n+1)*4)
+				if err != nil {
+					return err
+				}
+				proc.Free(v.Data)
+				vs = encoding.DecodeDateSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Date)[sel])
+			v.Col = vs
+		}
+	case types.T_datetime:
+		if len(v.Data) == 0 {
+			data, err := proc.Alloc(8 * 8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeDatetimeSlice(data)
+			vs[0] = w.Col.([]types.Datetime)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Datetime)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := proc.Grow(v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				proc.Free(v.Data)
+				vs = encoding.DecodeDatetimeSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Datetime)[sel])
+			v.Col = vs
+		}
 	case types.T_tuple:
 		v.Ref = w.Ref
 		vs, ws := v.Col.([][]interface{}), w.Col.([][]interface{})
@@ -970,7 +1207,7 @@ func (v *Vector) UnionOne(w *Vector, sel int64, proc *process.Process) error {
 	case types.T_char, types.T_varchar, types.T_json:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		from := ws.Get(sel)
-		if len(v.Data) == 0 {
+		if v.Data == nil {
 			data, err := proc.Alloc(int64(len(from)))
 			if err != nil {
 				return err
@@ -985,8 +1222,7 @@ func (v *Vector) UnionOne(w *Vector, sel int64, proc *process.Process) error {
 			}
 			proc.Free(v.Data)
 			v.Data = data
-			n = len(vs.Offsets)
-			vs.Data = data[:vs.Offsets[n-1]+vs.Lengths[n-1]]
+			vs.Data = data[:n]
 		}
 		vs.Lengths = append(vs.Lengths, uint32(len(from)))
 		{
@@ -1006,6 +1242,53 @@ func (v *Vector) UnionOne(w *Vector, sel int64, proc *process.Process) error {
 	return nil
 }
 
+// UnionNull appends a null value to the vector.
+func (v *Vector) UnionNull(proc *process.Process) error {
+	w := New(v.Typ)
+	w.Ref = v.Ref
+	switch v.Typ.Oid {
+	case types.T_int8:
+		w.Col = make([]int8, 1)
+	case types.T_int16:
+		w.Col = make([]int16, 1)
+	case types.T_int32:
+		w.Col = make([]int32, 1)
+	case types.T_int64:
+		w.Col = make([]int64, 1)
+	case types.T_uint8:
+		w.Col = make([]uint8, 1)
+	case types.T_uint16:
+		w.Col = make([]uint16, 1)
+	case types.T_uint32:
+		w.Col = make([]uint32, 1)
+	case types.T_uint64:
+		w.Col = make([]uint64, 1)
+	case types.T_float32:
+		w.Col = make([]float32, 1)
+	case types.T_float64:
+		w.Col = make([]float64, 1)
+	case types.T_decimal64:
+		w.Col = make([]types.Decimal64, 1)
+	case types.T_decimal128:
+		w.Col = make([]types.Decimal128, 1)
+	case types.T_date:
+		w.Col = make([]types.Date, 1)
+	case types.T_datetime:
+		w.Col = make([]types.Datetime, 1)
+	case types.T_tuple:
+		w.Col = make([][]interface{}, 1)
+	case types.T_char, types.T_varchar, types.T_json:
+		w.Col = &types.Bytes{
+			Offsets: []uint32{0},
+			Lengths: []uint32{0},
+		}
+	default:
+		return fmt.Errorf("unsupport type %s", v.Typ)
+	}
+	w.Nsp.Add(0)
+	return v.UnionOne(w, 0, proc)
+}
+
 func (v *Vector) Show() ([]byte, error) {
 	var buf bytes.Buffer
 
@@ -1106,7 +1389,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeUint64Slice(v.Col.([]uint64)))
 		return buf.Bytes(), nil
-	case types.T_decimal:
+	case types.T_float32:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -1116,9 +1399,9 @@ func (v *Vector) Show() ([]byte, error) {
 		if len(nb) > 0 {
 			buf.Write(nb)
 		}
-		buf.Write(encoding.EncodeDecimalSlice(v.Col.([]types.Decimal)))
+		buf.Write(encoding.EncodeFloat32Slice(v.Col.([]float32)))
 		return buf.Bytes(), nil
-	case types.T_float32:
+	case types.T_float64:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -1128,9 +1411,9 @@ func (v *Vector) Show() ([]byte, error) {
 		if len(nb) > 0 {
 			buf.Write(nb)
 		}
-		buf.Write(encoding.EncodeFloat32Slice(v.Col.([]float32)))
+		buf.Write(encoding.EncodeFloat64Slice(v.Col.([]float64)))
 		return buf.Bytes(), nil
-	case types.T_float64:
+	case types.T_decimal64:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -1140,7 +1423,19 @@ func (v *Vector) Show() ([]byte, error) {
 		if len(nb) > 0 {
 			buf.Write(nb)
 		}
-		buf.Write(encoding.EncodeFloat64Slice(v.Col.([]float64)))
+		buf.Write(encoding.EncodeDecimal64Slice(v.Col.([]types.Decimal64)))
+		return buf.Bytes(), nil
+	case types.T_decimal128:
+		buf.Write(encoding.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(encoding.EncodeDecimal128Slice(v.Col.([]types.Decimal128)))
 		return buf.Bytes(), nil
 	case types.T_date:
 		buf.Write(encoding.EncodeType(v.Typ))
@@ -1313,38 +1608,49 @@ func (v *Vector) Read(data []byte) error {
 			}
 			v.Col = encoding.DecodeUint64Slice(data[size:])
 		}
-	case types.T_decimal:
+	case types.T_float32:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
-			v.Col = encoding.DecodeDecimalSlice(data[4:])
+			v.Col = encoding.DecodeFloat32Slice(data[4:])
 		} else {
 			data = data[4:]
 			if err := v.Nsp.Read(data[:size]); err != nil {
 				return err
 			}
-			v.Col = encoding.DecodeDecimalSlice(data[size:])
+			v.Col = encoding.DecodeFloat32Slice(data[size:])
 		}
-	case types.T_float32:
+	case types.T_float64:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
-			v.Col = encoding.DecodeFloat32Slice(data[4:])
+			v.Col = encoding.DecodeFloat64Slice(data[4:])
 		} else {
 			data = data[4:]
 			if err := v.Nsp.Read(data[:size]); err != nil {
 				return err
 			}
-			v.Col = encoding.DecodeFloat32Slice(data[size:])
+			v.Col = encoding.DecodeFloat64Slice(data[size:])
 		}
-	case types.T_float64:
+	case types.T_decimal64:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
-			v.Col = encoding.DecodeFloat64Slice(data[4:])
+			v.Col = encoding.DecodeDecimal64Slice(data[4:])
 		} else {
 			data = data[4:]
 			if err := v.Nsp.Read(data[:size]); err != nil {
 				return err
 			}
-			v.Col = encoding.DecodeFloat64Slice(data[size:])
+			v.Col = encoding.DecodeDecimal64Slice(data[size:])
+		}
+	case types.T_decimal128:
+		size := encoding.DecodeUint32(data)
+		if size == 0 {
+			v.Col = encoding.DecodeDecimal128Slice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Col = encoding.DecodeDecimal128Slice(data[size:])
 		}
 	case types.T_date:
 		size := encoding.DecodeUint32(data)
@@ -1490,8 +1796,8 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_decimal:
-		col := v.Col.([]types.Decimal)
+	case types.T_float32:
+		col := v.Col.([]float32)
 		if len(col) == 1 {
 			if v.Nsp.Contains(0) {
 				fmt.Print("null")
@@ -1499,8 +1805,8 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_float32:
-		col := v.Col.([]float32)
+	case types.T_float64:
+		col := v.Col.([]float64)
 		if len(col) == 1 {
 			if v.Nsp.Contains(0) {
 				fmt.Print("null")
@@ -1508,13 +1814,22 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_float64:
-		col := v.Col.([]float64)
+	case types.T_decimal64:
+		col := v.Col.([]types.Decimal64)
 		if len(col) == 1 {
 			if v.Nsp.Contains(0) {
-				fmt.Print("null")
+				return "null"
 			} else {
-				return fmt.Sprintf("%v", col[0])
+				return col[0].Format(v.Typ.Precision)
+			}
+		}
+	case types.T_decimal128:
+		col := v.Col.([]types.Decimal128)
+		if len(col) == 1 {
+			if v.Nsp.Contains(0) {
+				return "null"
+			} else {
+				return col[0].Format(v.Typ.Precision)
 			}
 		}
 	case types.T_date:
@@ -1553,7 +1868,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar:
 		col := v.Col.(*types.Bytes)
 		if len(col.Offsets) == 1 {
 			if v.Nsp.Contains(0) {
@@ -1562,6 +1877,27 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%s", col.Data[:col.Lengths[0]])
 			}
 		}
+	case types.T_json:
+		col := v.Col.(*types.Bytes)
+		if len(col.Offsets) == 1 {
+			if v.Nsp.Contains(0) {
+				return "null"
+			} else {
+				return bytejson.ByteJson(col.Get(0)).String()
+			}
+		}
+		var buf bytes.Buffer
+		buf.WriteByte('[')
+		for i := range col.Offsets {
+			if i > 0 {
+				buf.WriteByte(' ')
+			}
+			if data := col.Get(int64(i)); len(data) > 0 {
+				buf.WriteString(bytejson.ByteJson(data).String())
+			}
+		}
+		buf.WriteByte(']')
+		return fmt.Sprintf("%s-%s", buf.String(), v.Nsp)
 	}
 	return fmt.Sprintf("%v-%s", v.Col, v.Nsp)
 }
@@ -23,6 +23,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestVector(t *testing.T) {
@@ -61,3 +63,59 @@ func TestVector(t *testing.T) {
 	w.Free(proc)
 	fmt.Printf("guest: %v, host: %v\n", proc.Size(), proc.HostSize())
 }
+
+// TestUnionOneEmpty appends empty strings and nulls before the others,
+// no memory is left after the vector is freed.
+func TestUnionOneEmpty(t *testing.T) {
+	typ := types.Type{Oid: types.T(types.T_varchar), Size: 24, Width: 0, Precision: 0}
+	v := New(typ)
+	require.NoError(t, v.Append([][]byte{[]byte(""), []byte("ab"), []byte(""), []byte("cde")}))
+	hm := host.New(1 << 20)
+	gm := guest.New(1<<20, hm)
+	proc := process.New(gm)
+	proc.Mp = mempool.New()
+
+	w := New(typ)
+	for i := int64(0); i < 4; i++ {
+		require.NoError(t, w.UnionOne(v, i, proc))
+	}
+	ws := w.Col.(*types.Bytes)
+	for i, s := range []string{"", "ab", "", "cde"} {
+		require.Equal(t, s, string(ws.Get(int64(i))))
+	}
+	w.Ref = 1
+	w.Free(proc)
+	require.Equal(t, int64(0), proc.Size())
+
+	w = New(typ)
+	require.NoError(t, w.UnionNull(proc))
+	require.NoError(t, w.UnionOne(v, 1, proc))
+	require.NoError(t, w.UnionOne(v, 3, proc))
+	ws = w.Col.(*types.Bytes)
+	require.True(t, w.Nsp.Contains(0))
+	require.Equal(t, "ab", string(ws.Get(1)))
+	require.Equal(t, "cde", string(ws.Get(2)))
+	w.Ref = 1
+	w.Free(proc)
+	require.Equal(t, int64(0), proc.Size())
+}
+
+func TestStringNull(t *testing.T) {
+	for _, typ := range []types.Type{
+		{Oid: types.T_decimal64, Size: 8, Width: 10, Precision: 2},
+		{Oid: types.T_decimal128, Size: 16, Width: 30, Precision: 5},
+		{Oid: types.T_json, Size: 24},
+	} {
+		v := New(typ)
+		switch typ.Oid {
+		case types.T_decimal64:
+			v.Col = make([]types.Decimal64, 1)
+		case types.T_decimal128:
+			v.Col = make([]types.Decimal128, 1)
+		case types.T_json:
+			v.Col = &types.Bytes{Offsets: []uint32{0}, Lengths: []uint32{0}}
+		}
+		v.Nsp.Add(0)
+		require.Equal(t, "null", v.String())
+	}
+}
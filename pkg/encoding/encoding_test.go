@@ -142,6 +142,27 @@ func TestEncodeDatetime(t *testing.T) {
 	}
 }
 
+func TestEncodeDecimal(t *testing.T) {
+	xs := []types.Decimal64{math.MinInt64, math.MaxInt64, 0}
+	for _, x := range xs {
+		if DecodeDecimal64(EncodeDecimal64(x)) != x {
+			t.Fatalf("Decimal64 Encoding Error\n")
+		}
+	}
+	ys := []types.Decimal128{{Lo: math.MaxUint64, Hi: math.MinInt64}, types.Decimal128FromInt64(-1), {}}
+	for _, y := range ys {
+		if DecodeDecimal128(EncodeDecimal128(y)) != y {
+			t.Fatalf("Decimal128 Encoding Error\n")
+		}
+	}
+	zs := DecodeDecimal128Slice(EncodeDecimal128Slice(ys))
+	for i := range ys {
+		if zs[i] != ys[i] {
+			t.Fatalf("Decimal128 Slice Encoding Error\n")
+		}
+	}
+}
+
 func TestStringSliceEncoding(t *testing.T) {
 	xs := []string{"a", "bc", "d"}
 	data := EncodeStringSlice(xs)
@@ -0,0 +1,970 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+//the privileges that can be granted and revoked
+var privilegeTypes = map[tree.PrivilegeType]catalog.Privilege{
+	tree.PRIVILEGE_TYPE_STATIC_ALL:          catalog.PrivAll,
+	tree.PRIVILEGE_TYPE_STATIC_SELECT:       catalog.PrivSelect,
+	tree.PRIVILEGE_TYPE_STATIC_INSERT:       catalog.PrivInsert,
+	tree.PRIVILEGE_TYPE_STATIC_UPDATE:       catalog.PrivUpdate,
+	tree.PRIVILEGE_TYPE_STATIC_DELETE:       catalog.PrivDelete,
+	tree.PRIVILEGE_TYPE_STATIC_CREATE:       catalog.PrivCreate,
+	tree.PRIVILEGE_TYPE_STATIC_DROP:         catalog.PrivDrop,
+	tree.PRIVILEGE_TYPE_STATIC_ALTER:        catalog.PrivAlter,
+	tree.PRIVILEGE_TYPE_STATIC_INDEX:        catalog.PrivIndex,
+	tree.PRIVILEGE_TYPE_STATIC_CREATE_USER:  catalog.PrivCreateUser,
+	tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION: catalog.PrivGrantOption,
+	tree.PRIVILEGE_TYPE_STATIC_USAGE:        0,
+}
+
+//privilegeRequest is a privilege the statement needs on db.table
+type privilegeRequest struct {
+	db    string
+	table string
+	priv  catalog.Privilege
+}
+
+//accountName returns the name and the host of the account.
+//the parser keeps the host in the name for user@'host'.
+func accountName(name, host string) (string, string) {
+	if host == "%" {
+		if i := strings.LastIndexByte(name, '@'); i > 0 {
+			name, host = name[:i], name[i+1:]
+		}
+	}
+	return strings.Trim(name, "'`\""), strings.Trim(host, "'`\"")
+}
+
+//accountString returns 'name'@'host'
+func accountString(name, host string) string {
+	return fmt.Sprintf("'%s'@'%s'", name, host)
+}
+
+//userPassword returns the stage-2 hash of the password of the user
+func userPassword(u *tree.User) ([]byte, error) {
+	if len(u.AuthPlugin) > 0 && u.AuthPlugin != "mysql_native_password" {
+		return nil, NewMysqlError(ER_NOT_SUPPORTED_YET, "authentication plugin "+u.AuthPlugin)
+	}
+	if len(u.HashString) == 0 {
+		return catalog.EncodePassword(u.AuthString), nil
+	}
+	//the hash is '*' + HEX( SHA1( SHA1( password ) ) )
+	if len(u.HashString) != 41 || u.HashString[0] != '*' {
+		return nil, fmt.Errorf("invalid password hash '%s'", u.HashString)
+	}
+	hash, err := hex.DecodeString(u.HashString[1:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid password hash '%s'", u.HashString)
+	}
+	return hash, nil
+}
+
+//grantPrivileges returns the privileges in the GRANT or REVOKE
+func grantPrivileges(ps []*tree.Privilege) (catalog.Privilege, error) {
+	var priv catalog.Privilege
+
+	for _, p := range ps {
+		if len(p.ColumnList) > 0 {
+			return 0, NewMysqlError(ER_NOT_SUPPORTED_YET, "column privileges")
+		}
+		v, ok := privilegeTypes[p.Type]
+		if !ok {
+			return 0, NewMysqlError(ER_NOT_SUPPORTED_YET, "privilege "+p.Type.ToString())
+		}
+		priv |= v
+	}
+	return priv, nil
+}
+
+//grantLevel returns the database and the table of the privilege level.
+//the database is the current one when it is omitted.
+func grantLevel(db string, l *tree.PrivilegeLevel, priv catalog.Privilege) (string, string, error) {
+	switch l.Level {
+	case tree.PRIVILEGE_LEVEL_TYPE_GLOBAL:
+		return "", "", nil
+	case tree.PRIVILEGE_LEVEL_TYPE_DATABASE, tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+		if priv&catalog.PrivCreateUser != 0 {
+			return "", "", NewMysqlError(ER_ILLEGAL_GRANT_FOR_TABLE)
+		}
+		if len(l.DbName) > 0 {
+			db = l.DbName
+		}
+		if len(db) == 0 {
+			return "", "", NewMysqlError(ER_NO_DB_ERROR)
+		}
+		return db, l.TabName, nil
+	}
+	return "", "", NewMysqlError(ER_NOT_SUPPORTED_YET, "privilege level")
+}
+
+//containsName checks whether the name is in the names
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+handle the statements of the accounts and the privileges
+*/
+func (mce *MysqlCmdExecutor) handleAccount(stmt tree.Statement) error {
+	var err error = nil
+	proto := mce.routine.GetClientProtocol().(MysqlProtocol)
+
+	if mce.routine.GetSession().Pu.ClusterCatalog == nil {
+		return fmt.Errorf("the accounts are unavailable without the catalog")
+	}
+	switch st := stmt.(type) {
+	case *tree.CreateUser:
+		err = mce.handleCreateUser(st)
+	case *tree.DropUser:
+		err = mce.handleDropUser(st)
+	case *tree.AlterUser:
+		err = mce.handleAlterUser(st)
+	case *tree.SetPassword:
+		err = mce.handleSetPassword(st)
+	case *tree.CreateRole:
+		err = mce.handleCreateRole(st)
+	case *tree.DropRole:
+		err = mce.handleDropRole(st)
+	case *tree.Grant:
+		err = mce.handleGrant(st)
+	case *tree.Revoke:
+		err = mce.handleRevoke(st)
+	case *tree.SetRole:
+		err = mce.handleSetRole(st)
+	case *tree.SetDefaultRole:
+		err = mce.handleSetDefaultRole(st)
+	}
+	if err != nil {
+		return err
+	}
+
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handle CREATE USER
+*/
+func (mce *MysqlCmdExecutor) handleCreateUser(st *tree.CreateUser) error {
+	c := mce.routine.GetSession().Pu.ClusterCatalog
+
+	var roles []string
+	for _, r := range st.Roles {
+		name, host := accountName(r.UserName, r.HostName)
+		if _, err := c.GetRole(name); err != nil {
+			return NewMysqlError(ER_UNKNOWN_AUTHID, name, host)
+		}
+		roles = append(roles, name)
+	}
+	for _, u := range st.Users {
+		name, host := accountName(u.Username, u.Hostname)
+		password, err := userPassword(u)
+		if err != nil {
+			return err
+		}
+		err = c.CreateUser(catalog.UserInfo{
+			Name:         name,
+			Host:         host,
+			Password:     password,
+			DefaultRoles: roles,
+		})
+		switch {
+		case err == catalog.ErrUserExists && st.IfNotExists:
+		case err == catalog.ErrUserExists:
+			return NewMysqlError(ER_CANNOT_USER, "CREATE USER", accountString(name, host))
+		case err != nil:
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+handle DROP USER
+*/
+func (mce *MysqlCmdExecutor) handleDropUser(st *tree.DropUser) error {
+	c := mce.routine.GetSession().Pu.ClusterCatalog
+
+	for _, u := range st.Users {
+		name, host := accountName(u.Username, u.Hostname)
+		err := c.DropUser(name, host)
+		switch {
+		case err == catalog.ErrUserNotExists && st.IfExists:
+		case err == catalog.ErrUserNotExists:
+			return NewMysqlError(ER_CANNOT_USER, "DROP USER", accountString(name, host))
+		case err != nil:
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+handle ALTER USER, only the password can be changed
+*/
+func (mce *MysqlCmdExecutor) handleAlterUser(st *tree.AlterUser) error {
+	c := mce.routine.GetSession().Pu.ClusterCatalog
+
+	if st.IsUserFunc {
+		password, err := userPassword(st.UserFunc)
+		if err != nil {
+			return err
+		}
+		return mce.changePassword(mce.routine.user, mce.routine.host, password)
+	}
+	for _, u := range st.Users {
+		name, host := accountName(u.Username, u.Hostname)
+		user, err := c.GetUser(name, host)
+		switch {
+		case err == catalog.ErrUserNotExists && st.IfExists:
+			continue
+		case err == catalog.ErrUserNotExists:
+			return NewMysqlError(ER_CANNOT_USER, "ALTER USER", accountString(name, host))
+		case err != nil:
+			return err
+		}
+		if len(u.AuthString) == 0 && len(u.HashString) == 0 && !u.ByAuth {
+			continue
+		}
+		if user.Password, err = userPassword(u); err != nil {
+			return err
+		}
+		if err = c.UpdateUser(*user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+handle SET PASSWORD
+*/
+func (mce *MysqlCmdExecutor) handleSetPassword(st *tree.SetPassword) error {
+	name, host := mce.routine.user, mce.routine.host
+	if st.User != nil {
+		name, host = accountName(st.User.Username, st.User.Hostname)
+	}
+	return mce.changePassword(name, host, catalog.EncodePassword(st.Password))
+}
+
+//changePassword sets the password hash of the account
+func (mce *MysqlCmdExecutor) changePassword(name, host string, password []byte) error {
+	c := mce.routine.GetSession().Pu.ClusterCatalog
+
+	user, err := c.GetUser(name, host)
+	if err != nil {
+		return NewMysqlError(ER_PASSWORD_NO_MATCH)
+	}
+	user.Password = password
+	return c.UpdateUser(*user)
+}
+
+/*
+handle CREATE ROLE
+*/
+func (mce *MysqlCmdExecutor) handleCreateRole(st *tree.CreateRole) error {
+	c := mce.routine.GetSession().Pu.ClusterCatalog
+
+	for _, r := range st.Roles {
+		name, host := accountName(r.UserName, r.HostName)
+		err := c.CreateRole(catalog.RoleInfo{Name: name})
+		switch {
+		case err == catalog.ErrRoleExists && st.IfNotExists:
+		case err == catalog.ErrRoleExists:
+			return NewMysqlError(ER_CANNOT_USER, "CREATE ROLE", accountString(name, host))
+		case err != nil:
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+handle DROP ROLE
+*/
+func (mce *MysqlCmdExecutor) handleDropRole(st *tree.DropRole) error {
+	c := mce.routine.GetSession().Pu.ClusterCatalog
+
+	for _, r := range st.Roles {
+		name, host := accountName(r.UserName, r.HostName)
+		err := c.DropRole(name)
+		switch {
+		case err == catalog.ErrRoleNotExists && st.IfExists:
+		case err == catalog.ErrRoleNotExists:
+			return NewMysqlError(ER_CANNOT_USER, "DROP ROLE", accountString(name, host))
+		case err != nil:
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+handle GRANT privileges or roles
+*/
+func (mce *MysqlCmdExecutor) handleGrant(st *tree.Grant) error {
+	c := mce.routine.GetSession().Pu.ClusterCatalog
+
+	if st.IsProxy {
+		return NewMysqlError(ER_NOT_SUPPORTED_YET, "GRANT PROXY")
+	}
+	if st.IsGrantRole {
+		var roles []string
+		for _, r := range st.RolesInGrantRole {
+			name, host := accountName(r.UserName, r.HostName)
+			if _, err := c.GetRole(name); err != nil {
+				return NewMysqlError(ER_UNKNOWN_AUTHID, name, host)
+			}
+			roles = append(roles, name)
+		}
+		for _, u := range st.Users {
+			name, host := accountName(u.Username, u.Hostname)
+			user, err := c.GetUser(name, host)
+			if err != nil {
+				return NewMysqlError(ER_UNKNOWN_AUTHID, name, host)
+			}
+			for _, role := range roles {
+				if !containsName(user.Roles, role) {
+					user.Roles = append(user.Roles, role)
+				}
+			}
+			if err = c.UpdateUser(*user); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	priv, err := grantPrivileges(st.Privileges)
+	if err != nil {
+		return err
+	}
+	if st.GrantOption {
+		priv |= catalog.PrivGrantOption
+	}
+	db, table, err := grantLevel(mce.routine.db, st.Level, priv)
+	if err != nil {
+		return err
+	}
+	//the grantee is a user or a role
+	for _, u := range st.Users {
+		name, host := accountName(u.Username, u.Hostname)
+		if user, err := c.GetUser(name, host); err == nil {
+			user.Grants = catalog.GrantPrivileges(user.Grants, db, table, priv)
+			if err = c.UpdateUser(*user); err != nil {
+				return err
+			}
+			continue
+		}
+		role, err := c.GetRole(name)
+		if err != nil {
+			return NewMysqlError(ER_CANT_CREATE_USER_WITH_GRANT)
+		}
+		role.Grants = catalog.GrantPrivileges(role.Grants, db, table, priv)
+		if err = c.UpdateRole(*role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+handle REVOKE privileges or roles
+*/
+func (mce *MysqlCmdExecutor) handleRevoke(st *tree.Revoke) error {
+	c := mce.routine.GetSession().Pu.ClusterCatalog
+
+	if st.IsRevokeRole {
+		for _, u := range st.Users {
+			name, host := accountName(u.Username, u.Hostname)
+			user, err := c.GetUser(name, host)
+			if err != nil {
+				return NewMysqlError(ER_UNKNOWN_AUTHID, name, host)
+			}
+			for _, r := range st.RolesInRevokeRole {
+				role, roleHost := accountName(r.UserName, r.HostName)
+				roles, ok := removeRole(user.Roles, role)
+				if !ok {
+					return NewMysqlError(ER_ROLE_NOT_GRANTED, role, roleHost, name, host)
+				}
+				user.Roles = roles
+				user.DefaultRoles, _ = removeRole(user.DefaultRoles, role)
+			}
+			if err = c.UpdateUser(*user); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	priv, err := grantPrivileges(st.Privileges)
+	if err != nil {
+		return err
+	}
+	db, table, err := grantLevel(mce.routine.db, st.Level, priv)
+	if err != nil {
+		return err
+	}
+	//REVOKE ALL also takes the grant option away
+	if priv&catalog.PrivAll == catalog.PrivAll {
+		priv |= catalog.PrivGrantOption
+	}
+	for _, u := range st.Users {
+		var ok bool
+
+		name, host := accountName(u.Username, u.Hostname)
+		if user, err := c.GetUser(name, host); err == nil {
+			if user.Grants, ok = catalog.RevokePrivileges(user.Grants, db, table, priv); !ok {
+				return NewMysqlError(ER_NONEXISTING_GRANT, name, host)
+			}
+			if err = c.UpdateUser(*user); err != nil {
+				return err
+			}
+			continue
+		}
+		role, err := c.GetRole(name)
+		if err != nil {
+			return NewMysqlError(ER_NONEXISTING_GRANT, name, host)
+		}
+		if role.Grants, ok = catalog.RevokePrivileges(role.Grants, db, table, priv); !ok {
+			return NewMysqlError(ER_NONEXISTING_GRANT, name, host)
+		}
+		if err = c.UpdateRole(*role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//removeRole removes the role from the roles and reports whether it was there
+func removeRole(roles []string, role string) ([]string, bool) {
+	for i, r := range roles {
+		if r == role {
+			return append(roles[:i:i], roles[i+1:]...), true
+		}
+	}
+	return roles, false
+}
+
+/*
+handle SET ROLE, it changes the active roles of the session
+*/
+func (mce *MysqlCmdExecutor) handleSetRole(st *tree.SetRole) error {
+	routine := mce.routine
+	ses := routine.GetSession()
+
+	//the dump user has all the privileges
+	if routine.user == ses.Pu.SV.GetDumpuser() {
+		return nil
+	}
+	user, err := ses.Pu.ClusterCatalog.GetUser(routine.user, routine.host)
+	if err != nil {
+		return NewMysqlError(ER_PASSWORD_NO_MATCH)
+	}
+	var roles []string
+	for _, r := range st.Roles {
+		name, host := accountName(r.UserName, r.HostName)
+		if !containsName(user.Roles, name) {
+			return NewMysqlError(ER_ROLE_NOT_GRANTED, name, host, user.Name, user.Host)
+		}
+		roles = append(roles, name)
+	}
+	switch st.Type {
+	case tree.SET_ROLE_TYPE_NORMAL:
+		routine.roles = roles
+	case tree.SET_ROLE_TYPE_DEFAULT:
+		routine.roles = user.DefaultRoles
+	case tree.SET_ROLE_TYPE_NONE:
+		routine.roles = nil
+	case tree.SET_ROLE_TYPE_ALL:
+		routine.roles = user.Roles
+	case tree.SET_ROLE_TYPE_ALL_EXCEPT:
+		routine.roles = nil
+		for _, r := range user.Roles {
+			if !containsName(roles, r) {
+				routine.roles = append(routine.roles, r)
+			}
+		}
+	}
+	return nil
+}
+
+/*
+handle SET DEFAULT ROLE
+*/
+func (mce *MysqlCmdExecutor) handleSetDefaultRole(st *tree.SetDefaultRole) error {
+	c := mce.routine.GetSession().Pu.ClusterCatalog
+
+	for _, u := range st.Users {
+		name, host := accountName(u.Username, u.Hostname)
+		user, err := c.GetUser(name, host)
+		if err != nil {
+			return NewMysqlError(ER_UNKNOWN_AUTHID, name, host)
+		}
+		switch st.Type {
+		case tree.SET_DEFAULT_ROLE_TYPE_NONE:
+			user.DefaultRoles = nil
+		case tree.SET_DEFAULT_ROLE_TYPE_ALL:
+			user.DefaultRoles = user.Roles
+		case tree.SET_DEFAULT_ROLE_TYPE_NORMAL:
+			user.DefaultRoles = nil
+			for _, r := range st.Roles {
+				role, roleHost := accountName(r.UserName, r.HostName)
+				if !containsName(user.Roles, role) {
+					return NewMysqlError(ER_ROLE_NOT_GRANTED, role, roleHost, name, host)
+				}
+				user.DefaultRoles = append(user.DefaultRoles, role)
+			}
+		}
+		if err = c.UpdateUser(*user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+checkPrivilege checks that the current user has the privileges the statement needs.
+The dump user has all the privileges.
+*/
+func (mce *MysqlCmdExecutor) checkPrivilege(stmt tree.Statement) error {
+	routine := mce.routine
+	ses := routine.GetSession()
+
+	if ses.Pu.ClusterCatalog == nil || routine.user == ses.Pu.SV.GetDumpuser() {
+		return nil
+	}
+	rs, err := statementPrivileges(routine.db, routine.user, routine.host, stmt, nil)
+	if err != nil {
+		return err
+	}
+	if len(rs) == 0 {
+		return nil
+	}
+	gs, err := mce.activeGrants()
+	if err != nil {
+		return err
+	}
+	for _, r := range rs {
+		missing := r.priv &^ catalog.Privileges(gs, r.db, r.table)
+		switch {
+		case missing == 0:
+		case len(r.db) == 0:
+			return NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, missing.String())
+		case len(r.table) == 0:
+			return NewMysqlError(ER_DBACCESS_DENIED_ERROR, routine.user, routine.host, r.db)
+		default:
+			return NewMysqlError(ER_TABLEACCESS_DENIED_ERROR, missing.String(), routine.user, routine.host, r.table)
+		}
+	}
+	return nil
+}
+
+//activeGrants returns the grants of the current user and of its active roles.
+//they are read for every statement, so that a GRANT or REVOKE takes effect at once.
+func (mce *MysqlCmdExecutor) activeGrants() ([]catalog.Grant, error) {
+	routine := mce.routine
+	c := routine.GetSession().Pu.ClusterCatalog
+
+	user, err := c.GetUser(routine.user, routine.host)
+	if err != nil {
+		return nil, NewMysqlError(ER_PASSWORD_NO_MATCH)
+	}
+	gs := user.Grants
+	for _, name := range routine.roles {
+		//the role may have been revoked
+		if !containsName(user.Roles, name) {
+			continue
+		}
+		if role, err := c.GetRole(name); err == nil {
+			gs = append(gs, role.Grants...)
+		}
+	}
+	return gs, nil
+}
+
+//statementPrivileges returns the privileges the statement needs,
+//the statements it doesn't know are refused
+func statementPrivileges(db, user, host string, stmt tree.Statement, rs []privilegeRequest) ([]privilegeRequest, error) {
+	switch st := stmt.(type) {
+	case *tree.Select:
+		return selectPrivileges(db, st, rs)
+	case *tree.Insert:
+		rs, err := tablePrivileges(db, st.Table, catalog.PrivInsert, rs)
+		if err != nil || st.Rows == nil {
+			return rs, err
+		}
+		return selectPrivileges(db, st.Rows, rs)
+	case *tree.Update:
+		rs, err := tablePrivileges(db, st.Table, catalog.PrivUpdate, rs)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range st.Exprs {
+			if rs, err = exprPrivileges(db, e.Expr, rs); err != nil {
+				return nil, err
+			}
+		}
+		if st.Where != nil {
+			return exprPrivileges(db, st.Where.Expr, rs)
+		}
+		return rs, nil
+	case *tree.Delete:
+		rs, err := tablePrivileges(db, st.Table, catalog.PrivDelete, rs)
+		if err != nil || st.Where == nil {
+			return rs, err
+		}
+		return exprPrivileges(db, st.Where.Expr, rs)
+	case *tree.Load:
+		return tablePrivileges(db, st.Table, catalog.PrivInsert, rs)
+	case *tree.CreateTable:
+		return tablePrivileges(db, &st.Table, catalog.PrivCreate, rs)
+	case *tree.DropTable:
+		var err error
+		for _, name := range st.Names {
+			if rs, err = tablePrivileges(db, name, catalog.PrivDrop, rs); err != nil {
+				return nil, err
+			}
+		}
+		return rs, nil
+	case *tree.CreateIndex:
+		return tablePrivileges(db, &st.Table, catalog.PrivIndex, rs)
+	case *tree.DropIndex:
+		return tablePrivileges(db, &st.TableName, catalog.PrivIndex, rs)
+	case *tree.AlterTable:
+		return tablePrivileges(db, &st.Table, catalog.PrivAlter, rs)
+	case *tree.AnalyzeTable:
+		var err error
+		for _, name := range st.Tables {
+			if rs, err = tablePrivileges(db, name, catalog.PrivSelect|catalog.PrivInsert, rs); err != nil {
+				return nil, err
+			}
+		}
+		return rs, nil
+	case *tree.ShowStats:
+		return tablePrivileges(db, &st.Table, catalog.PrivSelect, rs)
+	case *tree.ShowColumns:
+		if len(st.DBName) > 0 {
+			db = st.DBName
+		}
+		return tablePrivileges(db, objectTableName(st.Table), catalog.PrivSelect, rs)
+	case *tree.ShowCreate:
+		return tablePrivileges(db, objectTableName(st.Name), catalog.PrivSelect, rs)
+	case *tree.ShowIndex:
+		return tablePrivileges(db, &st.TableName, catalog.PrivSelect, rs)
+	case *tree.ShowCreateDatabase:
+		return append(rs, privilegeRequest{db: st.Name, priv: catalog.PrivSelect}), nil
+	case *tree.Use:
+		if len(st.Name) == 0 {
+			return rs, nil
+		}
+		return append(rs, privilegeRequest{db: st.Name, priv: catalog.PrivSelect}), nil
+	case *tree.ExplainStmt:
+		return statementPrivileges(db, user, host, st.Statement, rs)
+	case *tree.ExplainAnalyze:
+		return statementPrivileges(db, user, host, st.Statement, rs)
+	//the statements which read nothing the user may not see, EXECUTE checks
+	//the prepared statement as a query and KILL checks the connection itself
+	case *tree.ShowDatabases, *tree.ShowTables, *tree.ShowVariables, *tree.ShowStatus,
+		*tree.ShowWarnings, *tree.ShowErrors, *tree.ShowProcessList,
+		*tree.SetVar, *tree.SetRole, *tree.Kill,
+		*tree.PrepareStmt, *tree.Execute, *tree.Deallocate,
+		*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+		return rs, nil
+	case *tree.CreateDatabase:
+		return append(rs, privilegeRequest{db: string(st.Name), priv: catalog.PrivCreate}), nil
+	case *tree.DropDatabase:
+		return append(rs, privilegeRequest{db: string(st.Name), priv: catalog.PrivDrop}), nil
+	case *tree.CreateUser, *tree.DropUser, *tree.CreateRole, *tree.DropRole:
+		return append(rs, privilegeRequest{priv: catalog.PrivCreateUser}), nil
+	case *tree.AlterUser:
+		if st.IsUserFunc {
+			return rs, nil
+		}
+		return append(rs, privilegeRequest{priv: catalog.PrivCreateUser}), nil
+	case *tree.SetPassword:
+		if st.User == nil {
+			return rs, nil
+		}
+		if name, h := accountName(st.User.Username, st.User.Hostname); name == user && h == host {
+			return rs, nil
+		}
+		return append(rs, privilegeRequest{priv: catalog.PrivCreateUser}), nil
+	case *tree.SetDefaultRole:
+		for _, u := range st.Users {
+			if name, h := accountName(u.Username, u.Hostname); name != user || h != host {
+				return append(rs, privilegeRequest{priv: catalog.PrivCreateUser}), nil
+			}
+		}
+		return rs, nil
+	case *tree.Grant:
+		if st.IsGrantRole || st.IsProxy {
+			return append(rs, privilegeRequest{priv: catalog.PrivCreateUser}), nil
+		}
+		return grantRequest(db, st.Privileges, st.Level, rs)
+	case *tree.Revoke:
+		if st.IsRevokeRole {
+			return append(rs, privilegeRequest{priv: catalog.PrivCreateUser}), nil
+		}
+		return grantRequest(db, st.Privileges, st.Level, rs)
+	}
+	return nil, fmt.Errorf("unsupported statement '%s' in the privilege check", tree.String(stmt, dialect.MYSQL))
+}
+
+//objectTableName returns the table of a name of the parser,
+//whose parts are in the order they are written
+func objectTableName(n *tree.UnresolvedObjectName) *tree.TableName {
+	var prefix tree.ObjectNamePrefix
+	if n.NumParts > 1 {
+		prefix.SchemaName = tree.Identifier(n.Parts[n.NumParts-2])
+		prefix.ExplicitSchema = true
+	}
+	return tree.NewTableName(tree.Identifier(n.Parts[n.NumParts-1]), prefix)
+}
+
+//grantRequest returns the privileges a GRANT or REVOKE needs:
+//the privileges themselves and the GRANT OPTION on the same level
+func grantRequest(db string, ps []*tree.Privilege, l *tree.PrivilegeLevel, rs []privilegeRequest) ([]privilegeRequest, error) {
+	priv, err := grantPrivileges(ps)
+	if err != nil {
+		return nil, err
+	}
+	db, table, err := grantLevel(db, l, priv)
+	if err != nil {
+		return nil, err
+	}
+	return append(rs, privilegeRequest{db: db, table: table, priv: priv | catalog.PrivGrantOption}), nil
+}
+
+//selectPrivileges returns the SELECT privileges on the tables the query reads
+func selectPrivileges(db string, stmt *tree.Select, rs []privilegeRequest) ([]privilegeRequest, error) {
+	rs, err := selectStatementPrivileges(db, stmt.Select, rs)
+	if err != nil {
+		return nil, err
+	}
+	return orderPrivileges(db, stmt.OrderBy, rs)
+}
+
+func selectStatementPrivileges(db string, n tree.SelectStatement, rs []privilegeRequest) ([]privilegeRequest, error) {
+	var err error
+
+	switch stmt := n.(type) {
+	case *tree.Select:
+		return selectPrivileges(db, stmt, rs)
+	case *tree.ParenSelect:
+		return selectPrivileges(db, stmt.Select, rs)
+	case *tree.UnionClause:
+		if rs, err = selectStatementPrivileges(db, stmt.Left, rs); err != nil {
+			return nil, err
+		}
+		return selectStatementPrivileges(db, stmt.Right, rs)
+	case *tree.ValuesClause:
+		for _, row := range stmt.Rows {
+			if rs, err = exprsPrivileges(db, row, rs); err != nil {
+				return nil, err
+			}
+		}
+		return rs, nil
+	case *tree.SelectClause:
+		if stmt.From != nil {
+			for _, t := range stmt.From.Tables {
+				if rs, err = tablePrivileges(db, t, catalog.PrivSelect, rs); err != nil {
+					return nil, err
+				}
+			}
+		}
+		for _, e := range stmt.Exprs {
+			if rs, err = exprPrivileges(db, e.Expr, rs); err != nil {
+				return nil, err
+			}
+		}
+		if stmt.Where != nil {
+			if rs, err = exprPrivileges(db, stmt.Where.Expr, rs); err != nil {
+				return nil, err
+			}
+		}
+		if stmt.GroupBy != nil {
+			if rs, err = exprsPrivileges(db, tree.Exprs(stmt.GroupBy), rs); err != nil {
+				return nil, err
+			}
+		}
+		if stmt.Having != nil {
+			return exprPrivileges(db, stmt.Having.Expr, rs)
+		}
+		return rs, nil
+	}
+	return nil, fmt.Errorf("unsupported query '%s' in the privilege check", tree.String(n, dialect.MYSQL))
+}
+
+//tablePrivileges returns the privilege priv on the tables of n,
+//and the SELECT privileges on the tables of its subqueries
+func tablePrivileges(db string, n tree.TableExpr, priv catalog.Privilege, rs []privilegeRequest) ([]privilegeRequest, error) {
+	var err error
+
+	switch t := n.(type) {
+	case *tree.TableName:
+		if len(t.Schema()) > 0 {
+			db = string(t.Schema())
+		}
+		return append(rs, privilegeRequest{db: db, table: string(t.Name()), priv: priv}), nil
+	case *tree.AliasedTableExpr:
+		return tablePrivileges(db, t.Expr, priv, rs)
+	case *tree.ParenTableExpr:
+		return tablePrivileges(db, t.Expr, priv, rs)
+	case *tree.JoinTableExpr:
+		if t.Left != nil {
+			if rs, err = tablePrivileges(db, t.Left, priv, rs); err != nil {
+				return nil, err
+			}
+		}
+		if t.Right != nil {
+			if rs, err = tablePrivileges(db, t.Right, priv, rs); err != nil {
+				return nil, err
+			}
+		}
+		if cond, ok := t.Cond.(*tree.OnJoinCond); ok {
+			return exprPrivileges(db, cond.Expr, rs)
+		}
+		return rs, nil
+	case *tree.Subquery:
+		return exprPrivileges(db, t, rs)
+	}
+	return nil, fmt.Errorf("unsupported table '%s' in the privilege check", tree.String(n, dialect.MYSQL))
+}
+
+//exprPrivileges returns the SELECT privileges on the tables of the subqueries in n,
+//the expressions it doesn't know are refused as they may hide a subquery
+func exprPrivileges(db string, n tree.Expr, rs []privilegeRequest) ([]privilegeRequest, error) {
+	var err error
+
+	if n == nil || n == tree.DNull {
+		return rs, nil
+	}
+	switch e := n.(type) {
+	case *tree.UnresolvedName, tree.UnqualifiedStar, *tree.UnqualifiedStar, *tree.NumVal, *tree.StrVal, *tree.DBool,
+		*tree.DefaultVal, *tree.MaxValue, *tree.ParamExpr, *tree.VarExpr:
+		return rs, nil
+	case *tree.Subquery:
+		return selectStatementPrivileges(db, e.Select, rs)
+	case *tree.ParenExpr:
+		return exprPrivileges(db, e.Expr, rs)
+	case *tree.OrExpr:
+		return exprsPrivileges(db, tree.Exprs{e.Left, e.Right}, rs)
+	case *tree.NotExpr:
+		return exprPrivileges(db, e.Expr, rs)
+	case *tree.AndExpr:
+		return exprsPrivileges(db, tree.Exprs{e.Left, e.Right}, rs)
+	case *tree.XorExpr:
+		return exprsPrivileges(db, tree.Exprs{e.Left, e.Right}, rs)
+	case *tree.UnaryExpr:
+		return exprPrivileges(db, e.Expr, rs)
+	case *tree.BinaryExpr:
+		return exprsPrivileges(db, tree.Exprs{e.Left, e.Right}, rs)
+	case *tree.ComparisonExpr:
+		return exprsPrivileges(db, tree.Exprs{e.Left, e.Right, e.Escape}, rs)
+	case *tree.RangeCond:
+		return exprsPrivileges(db, tree.Exprs{e.Left, e.From, e.To}, rs)
+	case *tree.IsNullExpr:
+		return exprPrivileges(db, e.Expr, rs)
+	case *tree.IsNotNullExpr:
+		return exprPrivileges(db, e.Expr, rs)
+	case *tree.Tuple:
+		return exprsPrivileges(db, e.Exprs, rs)
+	case *tree.ExprList:
+		return exprsPrivileges(db, e.Exprs, rs)
+	case *tree.SelectExpr:
+		return exprPrivileges(db, e.Expr, rs)
+	case *tree.CastExpr:
+		return exprPrivileges(db, e.Expr, rs)
+	case *tree.IntervalExpr:
+		return exprPrivileges(db, e.Expr, rs)
+	case *tree.CaseExpr:
+		if rs, err = exprPrivileges(db, e.Expr, rs); err != nil {
+			return nil, err
+		}
+		for _, w := range e.Whens {
+			if rs, err = exprsPrivileges(db, tree.Exprs{w.Cond, w.Val}, rs); err != nil {
+				return nil, err
+			}
+		}
+		return exprPrivileges(db, e.Else, rs)
+	case *tree.FuncExpr:
+		if rs, err = exprsPrivileges(db, e.Exprs, rs); err != nil {
+			return nil, err
+		}
+		if rs, err = orderPrivileges(db, e.OrderBy, rs); err != nil {
+			return nil, err
+		}
+		if w := e.WindowSpec; w != nil {
+			if rs, err = exprsPrivileges(db, w.PartitionBy, rs); err != nil {
+				return nil, err
+			}
+			if rs, err = orderPrivileges(db, w.OrderBy, rs); err != nil {
+				return nil, err
+			}
+			if f := w.Frame; f != nil {
+				for _, b := range []*tree.FrameBound{f.Start, f.End} {
+					if b == nil {
+						continue
+					}
+					if rs, err = exprPrivileges(db, b.Expr, rs); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		return rs, nil
+	}
+	return nil, fmt.Errorf("unsupported expression '%s' in the privilege check", tree.String(n, dialect.MYSQL))
+}
+
+func exprsPrivileges(db string, es tree.Exprs, rs []privilegeRequest) ([]privilegeRequest, error) {
+	var err error
+
+	for _, e := range es {
+		if rs, err = exprPrivileges(db, e, rs); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func orderPrivileges(db string, os tree.OrderBy, rs []privilegeRequest) ([]privilegeRequest, error) {
+	var err error
+
+	for _, o := range os {
+		if rs, err = exprPrivileges(db, o.Expr, rs); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
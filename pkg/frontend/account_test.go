@@ -0,0 +1,163 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/sha1"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkPasswordHash(t *testing.T) {
+	mp := &MysqlProtocolImpl{}
+	salt := []byte("01234567890123456789")
+
+	//the client scrambles the password with the salt
+	scramble := func(password string) []byte {
+		hash1 := sha1.Sum([]byte(password))
+		hash2 := sha1.Sum(hash1[:])
+		hash3 := sha1.Sum(append(append([]byte{}, salt...), hash2[:]...))
+		for i := range hash1 {
+			hash1[i] ^= hash3[i]
+		}
+		return hash1[:]
+	}
+	hash := catalog.EncodePassword("111")
+	require.True(t, mp.checkPassword([]byte("111"), salt, scramble("111")))
+	require.True(t, mp.checkPasswordHash(hash, salt, scramble("111")))
+	require.False(t, mp.checkPasswordHash(hash, salt, scramble("112")))
+	require.False(t, mp.checkPasswordHash(hash, salt, nil))
+	require.True(t, mp.checkPasswordHash(nil, salt, nil))
+	require.False(t, mp.checkPasswordHash(nil, salt, scramble("111")))
+}
+
+func Test_accountName(t *testing.T) {
+	cases := [...]struct {
+		name, host string
+		wname      string
+		whost      string
+	}{
+		{"u1", "%", "u1", "%"},
+		{"u1@'localhost'", "%", "u1", "localhost"},
+		{"'u1'", "'127.0.0.1'", "u1", "127.0.0.1"},
+	}
+	for _, c := range cases {
+		name, host := accountName(c.name, c.host)
+		require.Equal(t, c.wname, name)
+		require.Equal(t, c.whost, host)
+	}
+}
+
+func Test_statementPrivileges(t *testing.T) {
+	cases := [...]struct {
+		sql string
+		rs  []privilegeRequest
+	}{
+		{"select * from t1", []privilegeRequest{{"db", "t1", catalog.PrivSelect}}},
+		{"select a from db2.t1 where a in (select b from t2)", []privilegeRequest{
+			{"db2", "t1", catalog.PrivSelect}, {"db", "t2", catalog.PrivSelect},
+		}},
+		{"select * from t1 join t2 on t1.a = t2.a", []privilegeRequest{
+			{"db", "t1", catalog.PrivSelect}, {"db", "t2", catalog.PrivSelect},
+		}},
+		{"insert into t1 values (1)", []privilegeRequest{{"db", "t1", catalog.PrivInsert}}},
+		{"insert into t1 select * from t2", []privilegeRequest{
+			{"db", "t1", catalog.PrivInsert}, {"db", "t2", catalog.PrivSelect},
+		}},
+		{"update t1 set a = 1 where exists (select * from t2)", []privilegeRequest{
+			{"db", "t1", catalog.PrivUpdate}, {"db", "t2", catalog.PrivSelect},
+		}},
+		{"select case a when (select b from t2) then (select c from t3) else (select d from t4) end from t1", []privilegeRequest{
+			{"db", "t1", catalog.PrivSelect}, {"db", "t2", catalog.PrivSelect},
+			{"db", "t3", catalog.PrivSelect}, {"db", "t4", catalog.PrivSelect},
+		}},
+		{"select * from t1 where case when a in (select b from t2) then 1 else 0 end = 1", []privilegeRequest{
+			{"db", "t1", catalog.PrivSelect}, {"db", "t2", catalog.PrivSelect},
+		}},
+		{"select rank() over (partition by (select b from t2) order by (select c from t3)) from t1", []privilegeRequest{
+			{"db", "t1", catalog.PrivSelect}, {"db", "t2", catalog.PrivSelect}, {"db", "t3", catalog.PrivSelect},
+		}},
+		{"select group_concat(a order by (select b from t2)) from t1", []privilegeRequest{
+			{"db", "t1", catalog.PrivSelect}, {"db", "t2", catalog.PrivSelect},
+		}},
+		{"delete from t1", []privilegeRequest{{"db", "t1", catalog.PrivDelete}}},
+		{"create table t1 (a int)", []privilegeRequest{{"db", "t1", catalog.PrivCreate}}},
+		{"drop table t1, db2.t2", []privilegeRequest{
+			{"db", "t1", catalog.PrivDrop}, {"db2", "t2", catalog.PrivDrop},
+		}},
+		{"create database db3", []privilegeRequest{{"db3", "", catalog.PrivCreate}}},
+		{"create user u2", []privilegeRequest{{"", "", catalog.PrivCreateUser}}},
+		{"grant select on db2.* to u2", []privilegeRequest{
+			{"db2", "", catalog.PrivSelect | catalog.PrivGrantOption},
+		}},
+		{"grant r1 to u2", []privilegeRequest{{"", "", catalog.PrivCreateUser}}},
+		{"set password = 'abc'", nil},
+		{"set password for u1 = 'abc'", nil},
+		{"set password for u2 = 'abc'", []privilegeRequest{{"", "", catalog.PrivCreateUser}}},
+		{"set role r1", nil},
+		{"show columns from t1", []privilegeRequest{{"db", "t1", catalog.PrivSelect}}},
+		{"show columns from t1 from db2", []privilegeRequest{{"db2", "t1", catalog.PrivSelect}}},
+		{"show create table db2.t1", []privilegeRequest{{"db2", "t1", catalog.PrivSelect}}},
+		{"show index from t1", []privilegeRequest{{"db", "t1", catalog.PrivSelect}}},
+		{"use db2", []privilegeRequest{{"db2", "", catalog.PrivSelect}}},
+		{"explain select * from t1", []privilegeRequest{{"db", "t1", catalog.PrivSelect}}},
+		{"explain analyze delete from t1", []privilegeRequest{{"db", "t1", catalog.PrivDelete}}},
+		{"show tables", nil},
+		{"set @a = 1", nil},
+	}
+	for _, c := range cases {
+		stmt, err := mysql.ParseOne(c.sql)
+		require.NoError(t, err)
+		rs, err := statementPrivileges("db", "u1", "%", stmt, nil)
+		require.NoError(t, err)
+		require.Equal(t, c.rs, rs, c.sql)
+	}
+}
+
+//unknownExpr is an expression the privilege check doesn't know
+type unknownExpr struct {
+	tree.Expr
+}
+
+func (e *unknownExpr) Format(ctx *tree.FmtCtx) {
+	ctx.WriteString("unknown")
+}
+
+//unknownStatement is a statement the privilege check doesn't know
+type unknownStatement struct {
+	tree.Statement
+}
+
+func (st *unknownStatement) Format(ctx *tree.FmtCtx) {
+	ctx.WriteString("unknown")
+}
+
+func Test_statementPrivilegesRefuseUnknown(t *testing.T) {
+	_, err := statementPrivileges("db", "u1", "%", &unknownStatement{}, nil)
+	require.EqualError(t, err, "unsupported statement 'unknown' in the privilege check")
+	_, err = statementPrivileges("db", "u1", "%", tree.NewExplainStmt(&unknownStatement{}, ""), nil)
+	require.Error(t, err)
+}
+
+func Test_exprPrivilegesRefuseUnknown(t *testing.T) {
+	_, err := exprPrivileges("db", &unknownExpr{}, nil)
+	require.Error(t, err)
+	_, err = exprPrivileges("db", tree.NewAndExpr(tree.NewNumValWithResInt(nil, "1", false, 1), &unknownExpr{}), nil)
+	require.Error(t, err)
+}
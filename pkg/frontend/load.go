@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"github.com/matrixorigin/simdcsv"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -26,7 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
-	"os"
+	"io"
 	"runtime"
 	"strconv"
 	"sync"
@@ -309,13 +310,18 @@ func (plh *ParseLineHandler) getLineOutFromSimdCsvRoutine() error {
 }
 
 func (plh *ParseLineHandler) close() {
-	plh.closeOnceGetParsedLinesChan.Do(func() {
-		close(plh.simdCsvGetParsedLinesChan)
-	})
+	//the loading of the binary formats does not use the simdcsv
+	if plh.simdCsvGetParsedLinesChan != nil {
+		plh.closeOnceGetParsedLinesChan.Do(func() {
+			close(plh.simdCsvGetParsedLinesChan)
+		})
+	}
 	plh.closeOnce.Do(func() {
 		close(plh.simdCsvBatchPool)
 		close(plh.simdCsvNotiyEventChan)
-		plh.simdCsvReader.Close()
+		if plh.simdCsvReader != nil {
+			plh.simdCsvReader.Close()
+		}
 	})
 	plh.closeRef.Close()
 }
@@ -354,7 +360,15 @@ func makeBatch(handler *ParseLineHandler) *PoolElement  {
 			vec.Col = make([]float32, batchSize)
 		case types.T_float64:
 			vec.Col = make([]float64, batchSize)
-		case types.T_char, types.T_varchar:
+		case types.T_decimal64:
+			vec.Col = make([]types.Decimal64, batchSize)
+		case types.T_decimal128:
+			vec.Col = make([]types.Decimal128, batchSize)
+		case types.T_date:
+			vec.Col = make([]types.Date, batchSize)
+		case types.T_datetime:
+			vec.Col = make([]types.Datetime, batchSize)
+		case types.T_char, types.T_varchar, types.T_json:
 			vBytes := &types.Bytes{
 				Offsets: make([]uint32,batchSize),
 				Lengths: make([]uint32,batchSize),
@@ -445,7 +459,7 @@ func releaseBatch(handler *ParseLineHandler,pl *PoolElement) {
 	for _, vec := range pl.bat.Vecs {
 		vec.Nsp = &nulls.Nulls{}
 		switch vec.Typ.Oid {
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json:
 			vBytes := vec.Col.(*types.Bytes)
 			vBytes.Data = vBytes.Data[:0]
 		}
@@ -791,6 +805,93 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool)
 						}
 						cols[rowIdx] = d
 					}
+				case types.T_decimal64:
+					cols := vec.Col.([]types.Decimal64)
+					if isNullOrEmpty {
+						vec.Nsp.Add(uint64(rowIdx))
+					} else {
+						d, err := types.ParseStringToDecimal64(field, vec.Typ.Width, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(),field,vecAttr,base,offset)
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_decimal128:
+					cols := vec.Col.([]types.Decimal128)
+					if isNullOrEmpty {
+						vec.Nsp.Add(uint64(rowIdx))
+					} else {
+						d, err := types.ParseStringToDecimal128(field, vec.Typ.Width, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(),field,vecAttr,base,offset)
+							}
+							result.Warnings++
+							d = types.Decimal128{}
+							//break
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_date:
+					cols := vec.Col.([]types.Date)
+					if isNullOrEmpty {
+						vec.Nsp.Add(uint64(rowIdx))
+					} else {
+						d, err := types.ParseDate(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(),field,vecAttr,base,offset)
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_datetime:
+					cols := vec.Col.([]types.Datetime)
+					if isNullOrEmpty {
+						vec.Nsp.Add(uint64(rowIdx))
+					} else {
+						d, err := types.ParseDatetime(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(),field,vecAttr,base,offset)
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_json:
+					vBytes := vec.Col.(*types.Bytes)
+					vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
+					if isNullOrEmpty {
+						vec.Nsp.Add(uint64(rowIdx))
+						vBytes.Lengths[rowIdx] = 0
+					} else {
+						bj, err := bytejson.ParseFromString(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(),field,vecAttr,base,offset)
+							}
+							result.Warnings++
+							vec.Nsp.Add(uint64(rowIdx))
+						}
+						vBytes.Data = append(vBytes.Data, bj...)
+						vBytes.Lengths[rowIdx] = uint32(len(bj))
+					}
 				case types.T_char, types.T_varchar:
 					vBytes := vec.Col.(*types.Bytes)
 					if isNullOrEmpty {
@@ -814,7 +915,7 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool)
 				if 0 == columnFLags[k] {
 					vec := batchData.Vecs[k]
 					switch vec.Typ.Oid {
-					case types.T_char, types.T_varchar:
+					case types.T_char, types.T_varchar, types.T_json:
 						vBytes := vec.Col.(*types.Bytes)
 						vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
 						vBytes.Lengths[rowIdx] = uint32(0)
@@ -1075,6 +1176,118 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool)
 						cols[i] = d
 					}
 				}
+			case types.T_decimal64:
+				cols := vec.Col.([]types.Decimal64)
+				//row
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						vec.Nsp.Add(uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseStringToDecimal64(field, vec.Typ.Width, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_decimal128:
+				cols := vec.Col.([]types.Decimal128)
+				//row
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						vec.Nsp.Add(uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseStringToDecimal128(field, vec.Typ.Width, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = types.Decimal128{}
+							//break
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_date:
+				cols := vec.Col.([]types.Date)
+				//row
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						vec.Nsp.Add(uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseDate(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_datetime:
+				cols := vec.Col.([]types.Datetime)
+				//row
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						vec.Nsp.Add(uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseDatetime(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_json:
+				vBytes := vec.Col.(*types.Bytes)
+				//row
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					vBytes.Offsets[i] = uint32(len(vBytes.Data))
+					if j >= len(line) || len(line[j]) == 0 {
+						vec.Nsp.Add(uint64(i))
+						vBytes.Lengths[i] = 0
+					} else {
+						field := line[j]
+						bj, err := bytejson.ParseFromString(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							vec.Nsp.Add(uint64(i))
+						}
+						vBytes.Data = append(vBytes.Data, bj...)
+						vBytes.Lengths[i] = uint32(len(bj))
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vBytes := vec.Col.(*types.Bytes)
 				//row
@@ -1105,7 +1318,7 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool)
 				//row
 				for i := 0; i < countOfLineArray; i++ {
 					switch vec.Typ.Oid {
-					case types.T_char, types.T_varchar:
+					case types.T_char, types.T_varchar, types.T_json:
 						vBytes := vec.Col.(*types.Bytes)
 						vBytes.Offsets[i] = uint32(len(vBytes.Data))
 						vBytes.Lengths[i] = uint32(0)
@@ -1201,7 +1414,7 @@ func writeBatchToStorage(handler *WriteBatchHandler,force bool) error {
 		for _, vec := range handler.batchData.Vecs {
 			vec.Nsp = &nulls.Nulls{}
 			switch vec.Typ.Oid {
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json:
 				vBytes := vec.Col.(*types.Bytes)
 				vBytes.Data = vBytes.Data[:0]
 			}
@@ -1253,7 +1466,19 @@ func writeBatchToStorage(handler *WriteBatchHandler,force bool) error {
 					case types.T_float64:
 						cols := vec.Col.([]float64)
 						vec.Col = cols[:needLen]
-					case types.T_char, types.T_varchar://bytes is different
+					case types.T_decimal64:
+						cols := vec.Col.([]types.Decimal64)
+						vec.Col = cols[:needLen]
+					case types.T_decimal128:
+						cols := vec.Col.([]types.Decimal128)
+						vec.Col = cols[:needLen]
+					case types.T_date:
+						cols := vec.Col.([]types.Date)
+						vec.Col = cols[:needLen]
+					case types.T_datetime:
+						cols := vec.Col.([]types.Datetime)
+						vec.Col = cols[:needLen]
+					case types.T_char, types.T_varchar, types.T_json://bytes is different
 						vBytes := vec.Col.(*types.Bytes)
 						//fmt.Printf("saveBatchToStorage before data %s \n",vBytes.String())
 						if len(vBytes.Offsets) > needLen{
@@ -1321,44 +1546,12 @@ func saveLinesToStorage(handler *ParseLineHandler, force bool) error {
 }
 
 /*
-LoadLoop reads data from stream, extracts the fields, and saves into the table
+newParseLineHandler makes the handler shared by the loading of all formats
  */
-func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database, tableHandler engine.Relation) (*LoadResult, error) {
-	defer func() {
-		if er := recover(); er != nil{
-			logutil.Errorf("loadLoop panic")
-		}
-	}()
+func (mce *MysqlCmdExecutor) newParseLineHandler(load *tree.Load, dbHandler engine.Database, tableHandler engine.Relation, result *LoadResult) *ParseLineHandler {
 	ses := mce.routine.GetSession()
 
-	//begin:=  time.Now()
-	//defer func() {
-	//	fmt.Printf("-----load loop exit %s\n",time.Since(begin))
-	//}()
-
-	result := &LoadResult{}
-
-	/*
-	step1 : read block from file
-	 */
-	dataFile,err := os.Open(load.File)
-	if err != nil {
-		logutil.Errorf("open file failed. err:%v",err)
-		return nil, err
-	}
-	defer func() {
-		err := dataFile.Close()
-		if err != nil{
-			logutil.Errorf("close file failed. err:%v",err)
-		}
-	}()
-
-	//processTime := time.Now()
-	process_block := time.Duration(0)
-
 	curBatchSize := int(ses.Pu.SV.GetBatchSizeInLoadData())
-	channelSize := 100
-	//simdcsv
 	handler := &ParseLineHandler{
 		SharePart:SharePart{
 			load: load,
@@ -1371,7 +1564,6 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 			result: result,
 			maxEntryBytesForCube: ses.Pu.SV.GetCubeMaxEntriesBytes(),
 		},
-		simdCsvGetParsedLinesChan:           make(chan simdcsv.LineOut,channelSize),
 		simdCsvWaitWriteRoutineToQuit:       &sync.WaitGroup{},
 	}
 
@@ -1403,21 +1595,48 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 	//put closeRef into the executor
 	mce.loadDataClose = handler.closeRef
 
+	/*
+	error channel
+	 */
+	handler.simdCsvNotiyEventChan = make(chan *notifyEvent,notifyChanSize)
+	return handler
+}
+
+/*
+LoadLoop reads data from stream, extracts the fields, and saves into the table
+ */
+func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database, tableHandler engine.Relation, dataFile io.Reader) (*LoadResult, error) {
+	defer func() {
+		if er := recover(); er != nil{
+			logutil.Errorf("loadLoop panic")
+		}
+	}()
+
+	//begin:=  time.Now()
+	//defer func() {
+	//	fmt.Printf("-----load loop exit %s\n",time.Since(begin))
+	//}()
+
+	result := &LoadResult{}
+
+	//processTime := time.Now()
+	process_block := time.Duration(0)
+
+	channelSize := 100
+	//simdcsv
+	handler := mce.newParseLineHandler(load, dbHandler, tableHandler, result)
+	handler.simdCsvGetParsedLinesChan = make(chan simdcsv.LineOut,channelSize)
+
 	handler.simdCsvReader = simdcsv.NewReaderWithOptions(dataFile,
 		rune(load.Fields.Terminated[0]),
 		'#',
 		false,
 		false)
 
-	/*
-	error channel
-	 */
-	handler.simdCsvNotiyEventChan = make(chan *notifyEvent,notifyChanSize)
-
 	//release resources of handler
 	defer handler.close()
 
-	err = initParseLineHandler(handler)
+	err := initParseLineHandler(handler)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,632 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
+)
+
+var errFormatValue = errors.New("the value can not be converted to the type of the column")
+
+/*
+formatReader reads the rows of the file in a self-describing format.
+The values of a row are in the order of the columns of the table, nil is NULL.
+ */
+type formatReader interface {
+	//next returns the next row and the bytes of it. it returns io.EOF at the end.
+	next() ([]interface{}, uint64, error)
+	close() error
+}
+
+/*
+checkLoadFormat checks the format of the file before the file is read
+ */
+func checkLoadFormat(load *tree.Load) error {
+	switch load.FileFormat {
+	case "":
+		return nil
+	case tree.LOAD_FORMAT_PARQUET, tree.LOAD_FORMAT_JSONLINES:
+		//the columns are mapped by the name
+		if len(load.ColumnList) != 0 || len(load.Assignments) != 0 {
+			return NewMysqlError(ER_NOT_SUPPORTED_YET, "the column list or SET in LOAD DATA FORMAT "+load.FileFormat)
+		}
+		return nil
+	default:
+		return NewMysqlError(ER_NOT_SUPPORTED_YET, "LOAD DATA FORMAT "+load.FileFormat)
+	}
+}
+
+/*
+newFormatReader makes the reader for the format of the file
+ */
+func newFormatReader(load *tree.Load, cols []metadata.Attribute, dataFile io.Reader, maxLineBytes int64) (formatReader, error) {
+	switch load.FileFormat {
+	case tree.LOAD_FORMAT_PARQUET:
+		return newParquetReader(dataFile, cols, load.IgnoredLines)
+	case tree.LOAD_FORMAT_JSONLINES:
+		return newJsonLinesReader(dataFile, cols, load.IgnoredLines, maxLineBytes), nil
+	default:
+		return nil, NewMysqlError(ER_NOT_SUPPORTED_YET, "LOAD DATA FORMAT "+load.FileFormat)
+	}
+}
+
+/*
+columnIndexByName maps the lower case name of the column to its index in the table
+ */
+func columnIndexByName(cols []metadata.Attribute) map[string]int {
+	m := make(map[string]int, len(cols))
+	for i, col := range cols {
+		m[strings.ToLower(col.Name)] = i
+	}
+	return m
+}
+
+/*
+LoadFormatLoop reads the rows from the file in the parquet or the json lines format,
+puts the values into the batch directly and saves the batch into the table
+ */
+func (mce *MysqlCmdExecutor) LoadFormatLoop(load *tree.Load, dbHandler engine.Database, tableHandler engine.Relation, dataFile io.Reader) (*LoadResult, error) {
+	defer func() {
+		if er := recover(); er != nil{
+			logutil.Errorf("loadFormatLoop panic")
+		}
+	}()
+
+	result := &LoadResult{}
+	handler := mce.newParseLineHandler(load, dbHandler, tableHandler, result)
+	handler.lineCount = load.IgnoredLines
+
+	//release resources of handler
+	defer handler.close()
+
+	err := initParseLineHandler(handler)
+	if err != nil {
+		return nil, err
+	}
+
+	fr, err := newFormatReader(load, handler.cols, dataFile, handler.maxEntryBytesForCube)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := fr.close(); err != nil {
+			logutil.Errorf("close the reader of the file failed. err:%v", err)
+		}
+	}()
+
+	wg := sync.WaitGroup{}
+
+	/*
+	read rows from the file, make a batch,
+	deliver it to async routine writing batch
+	 */
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := handler.readFormatRoutine(fr)
+		if err != nil {
+			logutil.Errorf("read rows from the file failed. err:%v",err)
+			handler.simdCsvNotiyEventChan <- newNotifyEvent(NOTIFY_EVENT_READ_SIMDCSV_ERROR,err,nil)
+		}
+	}()
+
+	/*
+		collect statistics from every batch.
+	*/
+	var ne *notifyEvent = nil
+	for quit := false; !quit; {
+		select {
+		case <- handler.closeRef.stopLoadData:
+			//get obvious cancel
+			quit = true
+		case ne = <- handler.simdCsvNotiyEventChan:
+			switch ne.neType {
+			case NOTIFY_EVENT_WRITE_BATCH_RESULT:
+				collectWriteBatchResult(handler,ne.wbh)
+			case NOTIFY_EVENT_END:
+				err = nil
+				quit = true
+			case NOTIFY_EVENT_READ_SIMDCSV_ERROR:
+				//the reading routine has quit
+				err = ne.err
+				quit = true
+			case NOTIFY_EVENT_WRITE_BATCH_ERROR:
+				if !errorCanBeIgnored(ne.err) {
+					err = ne.err
+					quit = true
+				}
+			default:
+				logutil.Errorf("get unsupported notify event %d",ne.neType)
+				quit = true
+			}
+		}
+	}
+
+	//stop the reading routine
+	handler.closeRef.Close()
+	wg.Wait()
+
+	//wait write to quit
+	handler.simdCsvWaitWriteRoutineToQuit.Wait()
+
+	/*
+		drain event channel
+	*/
+	for quit := false; !quit; {
+		select {
+		case ne = <- handler.simdCsvNotiyEventChan:
+			if ne.neType == NOTIFY_EVENT_WRITE_BATCH_RESULT {
+				collectWriteBatchResult(handler,ne.wbh)
+			}
+		default:
+			quit = true
+		}
+	}
+
+	return result, err
+}
+
+/*
+readFormatRoutine fills the batches with the rows from the reader.
+A batch is delivered to the storage when it is full or its bytes reach the maxEntryBytesForCube.
+ */
+func (plh *ParseLineHandler) readFormatRoutine(fr formatReader) error {
+	wait_a := time.Now()
+	defer func() {
+		plh.asyncChan += time.Since(wait_a)
+	}()
+
+	var row []interface{}
+	var bytes uint64
+	var err error
+	for end := false; !end; {
+		wHandler := &WriteBatchHandler{}
+		err = initWriteBatchHandler(plh,wHandler)
+		if err != nil {
+			return err
+		}
+
+		batchBytes := uint64(0)
+		for wHandler.batchFilled < wHandler.batchSize {
+			select {
+			case <- plh.closeRef.stopLoadData:
+				releaseBatch(plh,wHandler.pl)
+				return nil
+			default:
+			}
+
+			//the row may be left by the previous batch
+			if row == nil {
+				row, bytes, err = fr.next()
+				if err == io.EOF {
+					end = true
+					break
+				}
+				if err != nil {
+					releaseBatch(plh,wHandler.pl)
+					return err
+				}
+
+				//max entries for the cube
+				if bytes > uint64(plh.maxEntryBytesForCube) {
+					releaseBatch(plh,wHandler.pl)
+					return fmt.Errorf("bytes of line %d > maxEntryBytesForCube %d",bytes,plh.maxEntryBytesForCube)
+				}
+			}
+
+			if batchBytes + bytes > uint64(plh.maxEntryBytesForCube) {
+				break
+			}
+
+			plh.lineCount++
+			err = saveFormatRowToBatch(wHandler,row,plh.lineCount)
+			if err != nil {
+				releaseBatch(plh,wHandler.pl)
+				return err
+			}
+			wHandler.batchFilled++
+			batchBytes += bytes
+			row = nil
+		}
+
+		saveFormatBatchToStorage(plh,wHandler)
+	}
+
+	//the END is the last event after all batches are written
+	plh.simdCsvWaitWriteRoutineToQuit.Wait()
+	plh.simdCsvNotiyEventChan <- newNotifyEvent(NOTIFY_EVENT_END,nil,nil)
+	return nil
+}
+
+/*
+saveFormatBatchToStorage writes the batch into the storage asynchronously.
+The batch is not full at the end of the file or when its bytes are too many.
+ */
+func saveFormatBatchToStorage(handler *ParseLineHandler, writeHandler *WriteBatchHandler) {
+	handler.simdCsvWaitWriteRoutineToQuit.Add(1)
+	go func() {
+		defer handler.simdCsvWaitWriteRoutineToQuit.Done()
+
+		full := writeHandler.batchFilled == writeHandler.batchSize
+		err := writeBatchToStorage(writeHandler,!full)
+		writeHandler.simdCsvErr = err
+
+		if full {
+			releaseBatch(handler, writeHandler.pl)
+		}else{
+			//the vectors are cut by the writing, the pool needs a new batch
+			handler.simdCsvBatchPool <- makeBatch(handler)
+		}
+		writeHandler.batchData = nil
+		writeHandler.pl = nil
+
+		if err != nil {
+			handler.simdCsvNotiyEventChan <- newNotifyEvent(NOTIFY_EVENT_WRITE_BATCH_ERROR,err,writeHandler)
+		}else{
+			handler.simdCsvNotiyEventChan <- newNotifyEvent(NOTIFY_EVENT_WRITE_BATCH_RESULT,nil,writeHandler)
+		}
+	}()
+}
+
+/*
+saveFormatRowToBatch puts the values of the row into the row batchFilled of the batch.
+The value that can not be converted is NULL with a warning, or an error if the errors are not ignored.
+ */
+func saveFormatRowToBatch(handler *WriteBatchHandler, row []interface{}, line uint64) error {
+	rowIdx := handler.batchFilled
+	for i, vec := range handler.batchData.Vecs {
+		var v interface{}
+		if i < len(row) {
+			v = row[i]
+		}
+		err := setFormatValue(vec, rowIdx, v)
+		if err == nil {
+			continue
+		}
+		logutil.Errorf("convert value[%v] err:%v", v, err)
+		if !handler.ignoreFieldError {
+			return makeParsedFailedError(vec.Typ.String(), formatValueToString(v), handler.batchData.Attrs[i], line, 0)
+		}
+		//mysql warning ER_TRUNCATED_WRONG_VALUE_FOR_FIELD
+		handler.result.Warnings++
+		err = setFormatValue(vec, rowIdx, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+setFormatValue puts the value into the row of the vector.
+The value is one of nil, bool, int64, uint64, float32, float64, string, json.Number,
+types.Date, types.Datetime, and the objects and the arrays decoded by encoding/json.
+ */
+func setFormatValue(vec *vector.Vector, rowIdx int, v interface{}) error {
+	if v == nil {
+		switch vec.Typ.Oid {
+		case types.T_char, types.T_varchar, types.T_json:
+			vBytes := vec.Col.(*types.Bytes)
+			vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
+			vBytes.Lengths[rowIdx] = 0
+		}
+		vec.Nsp.Add(uint64(rowIdx))
+		return nil
+	}
+
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		d, err := formatValueToInt64(v, 8)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]int8)[rowIdx] = int8(d)
+	case types.T_int16:
+		d, err := formatValueToInt64(v, 16)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]int16)[rowIdx] = int16(d)
+	case types.T_int32:
+		d, err := formatValueToInt64(v, 32)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]int32)[rowIdx] = int32(d)
+	case types.T_int64:
+		d, err := formatValueToInt64(v, 64)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]int64)[rowIdx] = d
+	case types.T_uint8:
+		d, err := formatValueToUint64(v, 8)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]uint8)[rowIdx] = uint8(d)
+	case types.T_uint16:
+		d, err := formatValueToUint64(v, 16)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]uint16)[rowIdx] = uint16(d)
+	case types.T_uint32:
+		d, err := formatValueToUint64(v, 32)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]uint32)[rowIdx] = uint32(d)
+	case types.T_uint64:
+		d, err := formatValueToUint64(v, 64)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]uint64)[rowIdx] = d
+	case types.T_float32:
+		//keep the float32 from the file as it is
+		if x, ok := v.(float32); ok {
+			vec.Col.([]float32)[rowIdx] = x
+			break
+		}
+		d, err := formatValueToFloat64(v, 32)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]float32)[rowIdx] = float32(d)
+	case types.T_float64:
+		d, err := formatValueToFloat64(v, 64)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]float64)[rowIdx] = d
+	case types.T_decimal64:
+		if !isFormatNumber(v) {
+			return errFormatValue
+		}
+		d, err := types.ParseStringToDecimal64(formatValueToString(v), vec.Typ.Width, vec.Typ.Precision)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]types.Decimal64)[rowIdx] = d
+	case types.T_decimal128:
+		if !isFormatNumber(v) {
+			return errFormatValue
+		}
+		d, err := types.ParseStringToDecimal128(formatValueToString(v), vec.Typ.Width, vec.Typ.Precision)
+		if err != nil {
+			return err
+		}
+		vec.Col.([]types.Decimal128)[rowIdx] = d
+	case types.T_date:
+		var d types.Date
+		switch x := v.(type) {
+		case types.Date:
+			d = x
+		case types.Datetime:
+			d = x.ToDate()
+		case string:
+			var err error
+			if d, err = types.ParseDate(x); err != nil {
+				return err
+			}
+		default:
+			return errFormatValue
+		}
+		vec.Col.([]types.Date)[rowIdx] = d
+	case types.T_datetime:
+		var d types.Datetime
+		switch x := v.(type) {
+		case types.Datetime:
+			d = x
+		case types.Date:
+			d = x.ToTime()
+		case string:
+			var err error
+			if d, err = types.ParseDatetime(x); err != nil {
+				return err
+			}
+		default:
+			return errFormatValue
+		}
+		vec.Col.([]types.Datetime)[rowIdx] = d
+	case types.T_json:
+		var bj bytejson.ByteJson
+		var err error
+		switch x := v.(type) {
+		case types.Date, types.Datetime:
+			bj = bytejson.NewString(formatValueToString(x))
+		case float32:
+			bj, err = bytejson.CreateByteJson(float64(x))
+		default:
+			bj, err = bytejson.CreateByteJson(x)
+		}
+		if err != nil {
+			return err
+		}
+		vBytes := vec.Col.(*types.Bytes)
+		vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
+		vBytes.Data = append(vBytes.Data, bj...)
+		vBytes.Lengths[rowIdx] = uint32(len(bj))
+	case types.T_char, types.T_varchar:
+		field := formatValueToString(v)
+		vBytes := vec.Col.(*types.Bytes)
+		vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
+		vBytes.Data = append(vBytes.Data, field...)
+		vBytes.Lengths[rowIdx] = uint32(len(field))
+	default:
+		panic("unsupported oid")
+	}
+	return nil
+}
+
+func isFormatNumber(v interface{}) bool {
+	switch v.(type) {
+	case int64, uint64, float32, float64, json.Number, string:
+		return true
+	}
+	return false
+}
+
+/*
+formatValueToInt64 converts the value into the signed integer of the bitSize
+ */
+func formatValueToInt64(v interface{}, bitSize uint) (int64, error) {
+	var d int64
+	switch x := v.(type) {
+	case bool:
+		if x {
+			d = 1
+		}
+	case int64:
+		d = x
+	case uint64:
+		if x > math.MaxInt64 {
+			return 0, errFormatValue
+		}
+		d = int64(x)
+	case float32, float64:
+		f, _ := formatValueToFloat64(x, 64)
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+			return 0, errFormatValue
+		}
+		d = int64(f)
+	case json.Number:
+		return strconv.ParseInt(string(x), 10, int(bitSize))
+	case string:
+		return strconv.ParseInt(x, 10, int(bitSize))
+	default:
+		return 0, errFormatValue
+	}
+	//out of the range of the bitSize
+	if d<<(64-bitSize)>>(64-bitSize) != d {
+		return 0, errFormatValue
+	}
+	return d, nil
+}
+
+/*
+formatValueToUint64 converts the value into the unsigned integer of the bitSize
+ */
+func formatValueToUint64(v interface{}, bitSize uint) (uint64, error) {
+	var d uint64
+	switch x := v.(type) {
+	case bool:
+		if x {
+			d = 1
+		}
+	case int64:
+		if x < 0 {
+			return 0, errFormatValue
+		}
+		d = uint64(x)
+	case uint64:
+		d = x
+	case float32, float64:
+		f, _ := formatValueToFloat64(x, 64)
+		if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+			return 0, errFormatValue
+		}
+		d = uint64(f)
+	case json.Number:
+		return strconv.ParseUint(string(x), 10, int(bitSize))
+	case string:
+		return strconv.ParseUint(x, 10, int(bitSize))
+	default:
+		return 0, errFormatValue
+	}
+	//out of the range of the bitSize
+	if d<<(64-bitSize)>>(64-bitSize) != d {
+		return 0, errFormatValue
+	}
+	return d, nil
+}
+
+/*
+formatValueToFloat64 converts the value into the float of the bitSize
+ */
+func formatValueToFloat64(v interface{}, bitSize int) (float64, error) {
+	switch x := v.(type) {
+	case bool:
+		if x {
+			return 1, nil
+		}
+		return 0, nil
+	case int64:
+		return float64(x), nil
+	case uint64:
+		return float64(x), nil
+	case float32:
+		return float64(x), nil
+	case float64:
+		return x, nil
+	case json.Number:
+		return strconv.ParseFloat(string(x), bitSize)
+	case string:
+		return strconv.ParseFloat(x, bitSize)
+	}
+	return 0, errFormatValue
+}
+
+/*
+formatValueToString converts the value into the text.
+The objects and the arrays are in the json.
+ */
+func formatValueToString(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case json.Number:
+		return string(x)
+	case bool:
+		return strconv.FormatBool(x)
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case uint64:
+		return strconv.FormatUint(x, 10)
+	case float32:
+		return strconv.FormatFloat(float64(x), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case types.Date:
+		return x.String()
+	case types.Datetime:
+		return x.String()
+	default:
+		data, err := json.Marshal(x)
+		if err != nil {
+			return fmt.Sprintf("%v", x)
+		}
+		return string(data)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
+)
+
+/*
+jsonLinesReader reads a json object from every line of the file.
+The keys of the object are matched with the names of the columns case-insensitively.
+The keys without the column are ignored, and the columns without the key are NULL.
+ */
+type jsonLinesReader struct {
+	scanner *bufio.Scanner
+	maxLineBytes int64
+	ignoredLines uint64
+	//the number of the line read
+	line uint64
+
+	name2ColumnId map[string]int
+	row []interface{}
+}
+
+func newJsonLinesReader(dataFile io.Reader, cols []metadata.Attribute, ignoredLines uint64, maxLineBytes int64) *jsonLinesReader {
+	//the max size of the token is the larger one of the capacity of the buffer and the max
+	maxTokenSize := int(maxLineBytes) + 1
+	scanner := bufio.NewScanner(dataFile)
+	scanner.Buffer(make([]byte, 0, Min(64*1024, maxTokenSize)), maxTokenSize)
+	return &jsonLinesReader{
+		scanner: scanner,
+		maxLineBytes: maxLineBytes,
+		ignoredLines: ignoredLines,
+		name2ColumnId: columnIndexByName(cols),
+		row: make([]interface{}, len(cols)),
+	}
+}
+
+func (jlr *jsonLinesReader) next() ([]interface{}, uint64, error) {
+	for jlr.scanner.Scan() {
+		jlr.line++
+		data := jlr.scanner.Bytes()
+		//skip dropped lines and blank lines
+		if jlr.line <= jlr.ignoredLines || len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+
+		var obj map[string]interface{}
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&obj); err != nil {
+			return nil, 0, fmt.Errorf("line %d is not a json object. err:%v", jlr.line, err)
+		}
+		if _, err := dec.Token(); err != io.EOF {
+			return nil, 0, fmt.Errorf("line %d has more than one json object", jlr.line)
+		}
+
+		for i := range jlr.row {
+			jlr.row[i] = nil
+		}
+		for k, v := range obj {
+			if i, ok := jlr.name2ColumnId[strings.ToLower(k)]; ok {
+				jlr.row[i] = v
+			}
+		}
+		return jlr.row, uint64(len(data)), nil
+	}
+
+	err := jlr.scanner.Err()
+	if err == bufio.ErrTooLong {
+		return nil, 0, fmt.Errorf("line %d is longer than maxEntryBytesForCube %d", jlr.line+1, jlr.maxLineBytes)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return nil, 0, io.EOF
+}
+
+func (jlr *jsonLinesReader) close() error {
+	return nil
+}
@@ -0,0 +1,279 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+	ptypes "github.com/xitongsys/parquet-go/types"
+)
+
+//the count of rows read from a column at a time
+const parquetReadRows = 4096
+
+//the days from January 1, year 1 to January 1, 1970
+var unixEpochDate = types.FromCalendar(1970, 1, 1)
+
+/*
+parquetReader reads the columns of the parquet file in chunks, and returns the rows of the chunk.
+Only the top level columns of the schema are loaded. They are matched with the names of the columns
+of the table case-insensitively. The columns of the table that are not in the file are NULL.
+ */
+type parquetReader struct {
+	pFile source.ParquetFile
+	//the temporary file spooled from the stream
+	tmpFile string
+
+	pr *reader.ParquetReader
+	//the path in the parquet file for the column of the table, empty if the file does not have it
+	paths []string
+	elements []*parquet.SchemaElement
+	//rows left in the file
+	rowsLeft int64
+
+	//the chunk of values of every column
+	values [][]interface{}
+	chunkIdx int
+	chunkLen int
+	row []interface{}
+}
+
+func newParquetReader(dataFile io.Reader, cols []metadata.Attribute, ignoredLines uint64) (*parquetReader, error) {
+	pqr := &parquetReader{
+		paths: make([]string, len(cols)),
+		elements: make([]*parquet.SchemaElement, len(cols)),
+		values: make([][]interface{}, len(cols)),
+		row: make([]interface{}, len(cols)),
+	}
+
+	//the parquet file needs the random access
+	if f, ok := dataFile.(*os.File); ok {
+		pqr.pFile = &local.LocalFile{FilePath: f.Name(), File: f}
+	} else {
+		tmp, err := ioutil.TempFile("", "load-*.parquet")
+		if err != nil {
+			return nil, err
+		}
+		pqr.tmpFile = tmp.Name()
+		pqr.pFile = &local.LocalFile{FilePath: tmp.Name(), File: tmp}
+		if _, err = io.Copy(tmp, dataFile); err != nil {
+			_ = pqr.close()
+			return nil, err
+		}
+	}
+
+	pr, err := reader.NewParquetColumnReader(pqr.pFile, 1)
+	if err != nil {
+		_ = pqr.close()
+		return nil, fmt.Errorf("read the parquet file failed. err:%v", err)
+	}
+	pqr.pr = pr
+	pqr.rowsLeft = pr.GetNumRows()
+
+	name2ColumnId := columnIndexByName(cols)
+	sh := pr.SchemaHandler
+	for _, path := range sh.ValueColumns {
+		//the leaf under the root
+		if len(common.StrToPath(path)) != 2 {
+			continue
+		}
+		idx := sh.MapIndex[path]
+		element := sh.SchemaElements[idx]
+		if element.GetRepetitionType() == parquet.FieldRepetitionType_REPEATED {
+			continue
+		}
+		if i, ok := name2ColumnId[strings.ToLower(sh.Infos[idx].ExName)]; ok {
+			pqr.paths[i] = path
+			pqr.elements[i] = element
+		}
+	}
+
+	//skip dropped rows
+	if ignoredLines > 0 {
+		skipped := int64(ignoredLines)
+		if skipped > pqr.rowsLeft {
+			skipped = pqr.rowsLeft
+		}
+		for _, path := range pqr.paths {
+			if path == "" {
+				continue
+			}
+			if err = pr.SkipRowsByPath(path, skipped); err != nil {
+				_ = pqr.close()
+				return nil, err
+			}
+		}
+		pqr.rowsLeft -= skipped
+	}
+	return pqr, nil
+}
+
+func (pqr *parquetReader) next() ([]interface{}, uint64, error) {
+	if pqr.chunkIdx == pqr.chunkLen {
+		if pqr.rowsLeft == 0 {
+			return nil, 0, io.EOF
+		}
+		if err := pqr.readChunk(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	size := uint64(0)
+	for i, path := range pqr.paths {
+		if path == "" {
+			pqr.row[i] = nil
+			continue
+		}
+		v, err := parquetValue(pqr.elements[i], pqr.values[i][pqr.chunkIdx])
+		if err != nil {
+			return nil, 0, fmt.Errorf("convert the value of the column %s failed. err:%v", pqr.elements[i].GetName(), err)
+		}
+		pqr.row[i] = v
+		if s, ok := v.(string); ok {
+			size += uint64(len(s))
+		} else {
+			size += 8
+		}
+	}
+	pqr.chunkIdx++
+	return pqr.row, size, nil
+}
+
+/*
+readChunk reads the next chunk of rows column by column
+ */
+func (pqr *parquetReader) readChunk() error {
+	num := pqr.rowsLeft
+	if num > parquetReadRows {
+		num = parquetReadRows
+	}
+	for i, path := range pqr.paths {
+		if path == "" {
+			continue
+		}
+		values, _, _, err := pqr.pr.ReadColumnByPath(path, num)
+		if err != nil {
+			return err
+		}
+		if int64(len(values)) != num {
+			return fmt.Errorf("the column %s has %d values, but %d rows are expected", pqr.elements[i].GetName(), len(values), num)
+		}
+		pqr.values[i] = values
+	}
+	pqr.rowsLeft -= num
+	pqr.chunkIdx = 0
+	pqr.chunkLen = int(num)
+	return nil
+}
+
+func (pqr *parquetReader) close() error {
+	if pqr.pr != nil {
+		pqr.pr.ReadStop()
+	}
+	//the file opened by the caller is closed by the caller
+	if pqr.tmpFile == "" {
+		return nil
+	}
+	err := pqr.pFile.Close()
+	if er := os.Remove(pqr.tmpFile); er != nil {
+		logutil.Errorf("remove the temporary file %s failed. err:%v", pqr.tmpFile, er)
+	}
+	return err
+}
+
+/*
+parquetValue converts the value of the physical type and the converted type
+into the value accepted by the setFormatValue
+ */
+func parquetValue(element *parquet.SchemaElement, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	converted := element.IsSetConvertedType()
+	ct := element.GetConvertedType()
+	switch x := v.(type) {
+	case bool, float32, float64:
+		return x, nil
+	case int32:
+		if converted {
+			switch ct {
+			case parquet.ConvertedType_DATE:
+				return unixEpochDate + types.Date(x), nil
+			case parquet.ConvertedType_DECIMAL:
+				return json.Number(ptypes.DECIMAL_INT_ToString(int64(x), int(element.GetPrecision()), int(element.GetScale()))), nil
+			case parquet.ConvertedType_UINT_8, parquet.ConvertedType_UINT_16, parquet.ConvertedType_UINT_32:
+				return uint64(uint32(x)), nil
+			}
+		}
+		return int64(x), nil
+	case int64:
+		if converted {
+			switch ct {
+			case parquet.ConvertedType_TIMESTAMP_MILLIS:
+				return timeToDatetime(ptypes.TIMESTAMP_MILLISToTime(x, true)), nil
+			case parquet.ConvertedType_TIMESTAMP_MICROS:
+				return timeToDatetime(ptypes.TIMESTAMP_MICROSToTime(x, true)), nil
+			case parquet.ConvertedType_DECIMAL:
+				return json.Number(ptypes.DECIMAL_INT_ToString(x, int(element.GetPrecision()), int(element.GetScale()))), nil
+			case parquet.ConvertedType_UINT_64:
+				return uint64(x), nil
+			}
+		}
+		return x, nil
+	case string:
+		if element.GetType() == parquet.Type_INT96 {
+			return timeToDatetime(ptypes.INT96ToTime(x)), nil
+		}
+		if converted {
+			switch ct {
+			case parquet.ConvertedType_DECIMAL:
+				return json.Number(ptypes.DECIMAL_BYTE_ARRAY_ToString([]byte(x), int(element.GetPrecision()), int(element.GetScale()))), nil
+			case parquet.ConvertedType_JSON:
+				var doc interface{}
+				dec := json.NewDecoder(bytes.NewReader([]byte(x)))
+				dec.UseNumber()
+				if err := dec.Decode(&doc); err != nil {
+					return nil, err
+				}
+				return doc, nil
+			}
+		}
+		return x, nil
+	}
+	return nil, fmt.Errorf("unsupported parquet value %v", v)
+}
+
+/*
+timeToDatetime converts the time into the datetime in UTC
+ */
+func timeToDatetime(t time.Time) types.Datetime {
+	secs := int64(unixEpochDate)*24*60*60 + t.Unix()
+	return types.Datetime(secs<<20 + int64(t.Nanosecond()/1000))
+}
@@ -15,11 +15,16 @@
 package frontend
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/require"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -58,4 +63,71 @@ func Test_loadAndProcess(t *testing.T) {
 
 func Test_loadAndProcess2(t *testing.T) {
 
-}
\ No newline at end of file
+}
+func Test_setFormatValue(t *testing.T) {
+	cols := []metadata.Attribute{
+		{Name: "a", Type: types.Type{Oid: types.T_int8, Size: 1}},
+		{Name: "b", Type: types.Type{Oid: types.T_uint32, Size: 4}},
+		{Name: "c", Type: types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Precision: 2}},
+		{Name: "d", Type: types.Type{Oid: types.T_date, Size: 4}},
+		{Name: "e", Type: types.Type{Oid: types.T_json, Size: 24}},
+		{Name: "f", Type: types.Type{Oid: types.T_varchar, Size: 24, Width: 100}},
+	}
+	handler := &ParseLineHandler{SharePart: SharePart{batchSize: 4, cols: cols, attrName: []string{"a", "b", "c", "d", "e", "f"}}}
+	vecs := makeBatch(handler).bat.Vecs
+
+	require.NoError(t, setFormatValue(vecs[0], 0, int64(-128)))
+	require.NoError(t, setFormatValue(vecs[0], 1, json.Number("7")))
+	require.NoError(t, setFormatValue(vecs[0], 2, float64(3)))
+	require.Equal(t, []int8{-128, 7, 3}, vecs[0].Col.([]int8)[:3])
+	require.Error(t, setFormatValue(vecs[0], 3, int64(128)))
+	require.Error(t, setFormatValue(vecs[0], 3, 1.5))
+	require.Error(t, setFormatValue(vecs[0], 3, "x"))
+
+	require.NoError(t, setFormatValue(vecs[1], 0, uint64(1<<32-1)))
+	require.Error(t, setFormatValue(vecs[1], 1, uint64(1<<32)))
+	require.Error(t, setFormatValue(vecs[1], 1, int64(-1)))
+
+	require.NoError(t, setFormatValue(vecs[2], 0, json.Number("12.34")))
+	require.Equal(t, types.Decimal64(1234), vecs[2].Col.([]types.Decimal64)[0])
+	require.Error(t, setFormatValue(vecs[2], 1, true))
+
+	require.NoError(t, setFormatValue(vecs[3], 0, "2021-01-02"))
+	require.NoError(t, setFormatValue(vecs[3], 1, unixEpochDate+18629))
+	require.Equal(t, vecs[3].Col.([]types.Date)[0], vecs[3].Col.([]types.Date)[1])
+
+	obj := map[string]interface{}{"k": []interface{}{json.Number("1"), "v"}}
+	require.NoError(t, setFormatValue(vecs[4], 0, obj))
+	require.NoError(t, setFormatValue(vecs[4], 1, nil))
+	bj, err := bytejson.ParseFromString(`{"k":[1,"v"]}`)
+	require.NoError(t, err)
+	require.Equal(t, []byte(bj), vecs[4].Col.(*types.Bytes).Get(0))
+	require.True(t, vecs[4].Nsp.Contains(1))
+
+	require.NoError(t, setFormatValue(vecs[5], 0, obj))
+	require.NoError(t, setFormatValue(vecs[5], 1, 2.5))
+	require.Equal(t, `{"k":[1,"v"]}`, string(vecs[5].Col.(*types.Bytes).Get(0)))
+	require.Equal(t, "2.5", string(vecs[5].Col.(*types.Bytes).Get(1)))
+}
+
+func Test_jsonLinesReader(t *testing.T) {
+	cols := []metadata.Attribute{{Name: "A"}, {Name: "b"}}
+	data := "skipped\n{\"a\":1,\"B\":\"x\",\"c\":2}\n\n{\"b\":null}\n[1]\n"
+	jlr := newJsonLinesReader(strings.NewReader(data), cols, 1, 1024)
+
+	row, size, err := jlr.next()
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{json.Number("1"), "x"}, row)
+	require.Equal(t, uint64(21), size)
+
+	row, _, err = jlr.next()
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{nil, nil}, row)
+
+	_, _, err = jlr.next()
+	require.EqualError(t, err, "line 5 is not a json object. err:json: cannot unmarshal array into Go value of type map[string]interface {}")
+
+	jlr = newJsonLinesReader(strings.NewReader(`{"a":"`+strings.Repeat("x", 32)+`"}`), cols, 0, 16)
+	_, _, err = jlr.next()
+	require.EqualError(t, err, "line 1 is longer than maxEntryBytesForCube 16")
+}
@@ -15,16 +15,25 @@
 package frontend
 
 import (
+	"context"
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"go/constant"
+	"io"
+	"math"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -88,6 +97,12 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 
 	proto := rt.GetClientProtocol().(MysqlProtocol)
 
+	//the rows of COM_STMT_EXECUTE are in the binary protocol
+	sendRows := proto.SendResultSetTextBatchRow
+	if ses.Cmd == int(COM_STMT_EXECUTE) {
+		sendRows = proto.SendResultSetBinaryBatchRow
+	}
+
 	//Create a new temporary resultset per pipeline thread.
 	mrs := &MysqlResultSet{}
 	//Warning: Don't change ResultColumns in this.
@@ -234,6 +249,54 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 								row[i] = vs[j]
 							}
 						}
+					case types.T_decimal64:
+						if !vec.Nsp.Any() { //all data in this column are not null
+							vs := vec.Col.([]types.Decimal64)
+							row[i] = vs[j].Format(vec.Typ.Precision)
+						} else {
+							if vec.Nsp.Contains(uint64(j)) { //is null
+								row[i] = nil
+							} else {
+								vs := vec.Col.([]types.Decimal64)
+								row[i] = vs[j].Format(vec.Typ.Precision)
+							}
+						}
+					case types.T_decimal128:
+						if !vec.Nsp.Any() { //all data in this column are not null
+							vs := vec.Col.([]types.Decimal128)
+							row[i] = vs[j].Format(vec.Typ.Precision)
+						} else {
+							if vec.Nsp.Contains(uint64(j)) { //is null
+								row[i] = nil
+							} else {
+								vs := vec.Col.([]types.Decimal128)
+								row[i] = vs[j].Format(vec.Typ.Precision)
+							}
+						}
+					case types.T_date:
+						if !vec.Nsp.Any() { //all data in this column are not null
+							vs := vec.Col.([]types.Date)
+							row[i] = vs[j]
+						} else {
+							if vec.Nsp.Contains(uint64(j)) { //is null
+								row[i] = nil
+							} else {
+								vs := vec.Col.([]types.Date)
+								row[i] = vs[j]
+							}
+						}
+					case types.T_datetime:
+						if !vec.Nsp.Any() { //all data in this column are not null
+							vs := vec.Col.([]types.Datetime)
+							row[i] = vs[j]
+						} else {
+							if vec.Nsp.Contains(uint64(j)) { //is null
+								row[i] = nil
+							} else {
+								vs := vec.Col.([]types.Datetime)
+								row[i] = vs[j]
+							}
+						}
 					case types.T_char:
 						if !vec.Nsp.Any() { //all data in this column are not null
 							vs := vec.Col.(*types.Bytes)
@@ -258,6 +321,18 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 								row[i] = vs.Get(j)
 							}
 						}
+					case types.T_json:
+						if !vec.Nsp.Any() { //all data in this column are not null
+							vs := vec.Col.(*types.Bytes)
+							row[i] = bytejson.ByteJson(vs.Get(j))
+						} else {
+							if vec.Nsp.Contains(uint64(j)) { //is null
+								row[i] = nil
+							} else {
+								vs := vec.Col.(*types.Bytes)
+								row[i] = bytejson.ByteJson(vs.Get(j))
+							}
+						}
 					default:
 						logutil.Errorf("getDataFromPipeline : unsupported type %d \n", vec.Typ.Oid)
 						return fmt.Errorf("getDataFromPipeline : unsupported type %d \n", vec.Typ.Oid)
@@ -268,7 +343,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 			//fmt.Printf("row group -+> %v \n", mrs.Data[:r])
 
 			//send group of row
-			if err := proto.SendResultSetTextBatchRow(mrs, r); err != nil {
+			if err := sendRows(mrs, r); err != nil {
 				//return err
 				logutil.Errorf("getDataFromPipeline error %v \n", err)
 				return err
@@ -408,6 +483,54 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 								row[i] = vs[bat.Sels[j]]
 							}
 						}
+					case types.T_decimal64:
+						if !vec.Nsp.Any() { //all data in this column are not null
+							vs := vec.Col.([]types.Decimal64)
+							row[i] = vs[bat.Sels[j]].Format(vec.Typ.Precision)
+						} else {
+							if vec.Nsp.Contains(uint64(bat.Sels[j])) { //is null
+								row[i] = nil
+							} else {
+								vs := vec.Col.([]types.Decimal64)
+								row[i] = vs[bat.Sels[j]].Format(vec.Typ.Precision)
+							}
+						}
+					case types.T_decimal128:
+						if !vec.Nsp.Any() { //all data in this column are not null
+							vs := vec.Col.([]types.Decimal128)
+							row[i] = vs[bat.Sels[j]].Format(vec.Typ.Precision)
+						} else {
+							if vec.Nsp.Contains(uint64(bat.Sels[j])) { //is null
+								row[i] = nil
+							} else {
+								vs := vec.Col.([]types.Decimal128)
+								row[i] = vs[bat.Sels[j]].Format(vec.Typ.Precision)
+							}
+						}
+					case types.T_date:
+						if !vec.Nsp.Any() { //all data in this column are not null
+							vs := vec.Col.([]types.Date)
+							row[i] = vs[bat.Sels[j]]
+						} else {
+							if vec.Nsp.Contains(uint64(bat.Sels[j])) { //is null
+								row[i] = nil
+							} else {
+								vs := vec.Col.([]types.Date)
+								row[i] = vs[bat.Sels[j]]
+							}
+						}
+					case types.T_datetime:
+						if !vec.Nsp.Any() { //all data in this column are not null
+							vs := vec.Col.([]types.Datetime)
+							row[i] = vs[bat.Sels[j]]
+						} else {
+							if vec.Nsp.Contains(uint64(bat.Sels[j])) { //is null
+								row[i] = nil
+							} else {
+								vs := vec.Col.([]types.Datetime)
+								row[i] = vs[bat.Sels[j]]
+							}
+						}
 					case types.T_char:
 						if !vec.Nsp.Any() { //all data in this column are not null
 							vs := vec.Col.(*types.Bytes)
@@ -432,6 +555,18 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 								row[i] = vs.Get(bat.Sels[j])
 							}
 						}
+					case types.T_json:
+						if !vec.Nsp.Any() { //all data in this column are not null
+							vs := vec.Col.(*types.Bytes)
+							row[i] = bytejson.ByteJson(vs.Get(bat.Sels[j]))
+						} else {
+							if vec.Nsp.Contains(uint64(bat.Sels[j])) { //is null
+								row[i] = nil
+							} else {
+								vs := vec.Col.(*types.Bytes)
+								row[i] = bytejson.ByteJson(vs.Get(bat.Sels[j]))
+							}
+						}
 					default:
 						logutil.Errorf("getDataFromPipeline : unsupported type %d \n", vec.Typ.Oid)
 						return fmt.Errorf("getDataFromPipeline : unsupported type %d \n", vec.Typ.Oid)
@@ -442,7 +577,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 			//fmt.Printf("row group -*> %v \n", mrs.DataSource[:r])
 
 			//send row
-			if err := proto.SendResultSetTextBatchRow(mrs, r); err != nil {
+			if err := sendRows(mrs, r); err != nil {
 				//return err
 				logutil.Errorf("getDataFromPipeline error %v \n", err)
 				return err
@@ -519,22 +654,29 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 	logutil.Infof("+++++load data")
 
 	/*
-		TODO:support LOCAL
+		check format
 	*/
-	if load.Local {
-		return fmt.Errorf("LOCAL is unsupported now")
+	if err = checkLoadFormat(load); err != nil {
+		return err
 	}
 
 	/*
 		check file
 	*/
-	exist, isfile, err := PathExists(load.File)
-	if err != nil || !exist {
-		return fmt.Errorf("file %s does exist. err:%v", load.File, err)
-	}
+	if load.Local {
+		//the file is on the client, it is permitted by the local_infile
+		if !routine.ses.Pu.SV.GetLocalInfile() {
+			return NewMysqlError(ER_CLIENT_LOCAL_FILES_DISABLED)
+		}
+	} else {
+		exist, isfile, err := PathExists(load.File)
+		if err != nil || !exist {
+			return fmt.Errorf("file %s does exist. err:%v", load.File, err)
+		}
 
-	if !isfile {
-		return fmt.Errorf("file %s is a directory.", load.File)
+		if !isfile {
+			return fmt.Errorf("file %s is a directory.", load.File)
+		}
 	}
 
 	/*
@@ -576,7 +718,12 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 	/*
 		execute load data
 	*/
-	result, err := mce.LoadLoop(load, dbHandler, tableHandler)
+	var result *LoadResult
+	if load.Local {
+		result, err = mce.loadLocal(load, dbHandler, tableHandler)
+	} else {
+		result, err = mce.loadFile(load, dbHandler, tableHandler)
+	}
 	if err != nil {
 		return err
 	}
@@ -592,6 +739,56 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 	return nil
 }
 
+/*
+loadFile loads the file on the server.
+ */
+func (mce *MysqlCmdExecutor) loadFile(load *tree.Load, dbHandler engine.Database, tableHandler engine.Relation) (*LoadResult, error) {
+	dataFile, err := os.Open(load.File)
+	if err != nil {
+		logutil.Errorf("open file failed. err:%v", err)
+		return nil, err
+	}
+	defer func() {
+		err := dataFile.Close()
+		if err != nil {
+			logutil.Errorf("close file failed. err:%v", err)
+		}
+	}()
+	return mce.loadData(load, dbHandler, tableHandler, dataFile)
+}
+
+/*
+loadData loads the content of the file in the format of the LOAD DATA
+ */
+func (mce *MysqlCmdExecutor) loadData(load *tree.Load, dbHandler engine.Database, tableHandler engine.Relation, dataFile io.Reader) (*LoadResult, error) {
+	if load.FileFormat == "" {
+		return mce.LoadLoop(load, dbHandler, tableHandler, dataFile)
+	}
+	return mce.LoadFormatLoop(load, dbHandler, tableHandler, dataFile)
+}
+
+/*
+loadLocal requests the file from the client, and loads the content of the file streamed back.
+The response of LOAD DATA is sent after the client finishes sending the file, even if the load fails.
+ */
+func (mce *MysqlCmdExecutor) loadLocal(load *tree.Load, dbHandler engine.Database, tableHandler engine.Relation) (*LoadResult, error) {
+	routine := mce.routine
+	proto := routine.GetClientProtocol().(MysqlProtocol)
+
+	reader, done := routine.startLoadLocal()
+	if err := proto.sendLocalInfileRequest(load.File); err != nil {
+		routine.stopLoadLocal()
+		return nil, err
+	}
+
+	result, err := mce.loadData(load, dbHandler, tableHandler, reader)
+
+	//discard the rest of the file
+	_ = reader.CloseWithError(io.ErrClosedPipe)
+	<-done
+	return result, err
+}
+
 /*
 handle cmd CMD_FIELD_LIST
  */
@@ -639,6 +836,9 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 		if err != nil {
 			return err
 		}
+		if types.IsDecimal(c.Type.Oid) {
+			col.SetDecimal(uint8(c.Type.Precision))
+		}
 
 		/*
 			mysql CMD_FIELD_LIST response: send the column definition per column
@@ -664,10 +864,30 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 /*
 handle setvar
  */
-func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
+func (mce *MysqlCmdExecutor) handleSetVar(sv *tree.SetVar) error {
 	var err error = nil
+	ses := mce.routine.GetSession()
 	proto := mce.routine.GetClientProtocol().(MysqlProtocol)
 
+	for _, a := range sv.Assignments {
+		if !a.System {
+			//user variable, only the literal is supported
+			if !isParamLiteral(a.Value) {
+				return NewMysqlError(ER_WRONG_TYPE_FOR_VAR, a.Name)
+			}
+			if ses.userVars == nil {
+				ses.userVars = make(map[string]tree.Expr)
+			}
+			ses.userVars[strings.ToLower(a.Name)] = a.Value
+			continue
+		}
+		if strings.ToLower(strings.TrimPrefix(a.Name, "@@")) == "max_execution_time" {
+			if ses.maxExecutionTime, err = getMaxExecutionTime(a); err != nil {
+				return err
+			}
+		}
+	}
+
 	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -675,8 +895,83 @@ func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
 	return nil
 }
 
+//getMaxExecutionTime returns the milliseconds assigned to the max_execution_time
+func getMaxExecutionTime(a *tree.VarAssignmentExpr) (uint64, error) {
+	switch v := a.Value.(type) {
+	case *tree.DefaultVal:
+		return 0, nil
+	case *tree.NumVal:
+		if v.Value.Kind() == constant.Int {
+			if n, ok := constant.Uint64Val(v.Value); ok && !v.Negative() {
+				return n, nil
+			}
+			return 0, NewMysqlError(ER_WRONG_VALUE_FOR_VAR, a.Name, tree.String(v, dialect.MYSQL))
+		}
+	case *tree.UnaryExpr:
+		if v.Op == tree.UNARY_MINUS {
+			return 0, NewMysqlError(ER_WRONG_VALUE_FOR_VAR, a.Name, tree.String(v, dialect.MYSQL))
+		}
+	}
+	return 0, NewMysqlError(ER_WRONG_TYPE_FOR_VAR, a.Name)
+}
+
+/*
+handle kill
+KILL CONNECTION closes the connection, KILL QUERY only interrupts the running statement of the connection.
+A user can kill its own connections. The connections of other users can only be killed
+by the user who has the global CREATE USER privilege.
+ */
+func (mce *MysqlCmdExecutor) handleKill(k *tree.Kill) error {
+	routine := mce.routine
+	ses := routine.GetSession()
+	proto := routine.GetClientProtocol().(MysqlProtocol)
+
+	if routine.routineMgr == nil || k.ConnectionId > math.MaxUint32 {
+		return NewMysqlError(ER_NO_SUCH_THREAD, k.ConnectionId)
+	}
+	target, ok := routine.routineMgr.getRoutineByConnID(uint32(k.ConnectionId))
+	if !ok {
+		return NewMysqlError(ER_NO_SUCH_THREAD, k.ConnectionId)
+	}
+	if target.user != routine.user && ses.Pu.ClusterCatalog != nil && routine.user != ses.Pu.SV.GetDumpuser() {
+		gs, err := mce.activeGrants()
+		if err != nil {
+			return err
+		}
+		if catalog.Privileges(gs, "", "")&catalog.PrivCreateUser == 0 {
+			return NewMysqlError(ER_KILL_DENIED_ERROR, k.ConnectionId)
+		}
+	}
+	target.cancelQuery()
+	if err := proto.sendOKPacket(0, 0, 0, 0, ""); err != nil {
+		return err
+	}
+	if k.Option == tree.KillConnection && target.io != nil {
+		_ = target.io.Close()
+	}
+	return nil
+}
+
+/*
+convertQueryError converts the error of an interrupted statement into the mysql error.
+ */
+func convertQueryError(proc *process.Process, err error) error {
+	switch proc.Ctx.Err() {
+	case context.DeadlineExceeded:
+		return NewMysqlError(ER_QUERY_TIMEOUT)
+	case context.Canceled:
+		return NewMysqlError(ER_QUERY_INTERRUPTED)
+	}
+	return err
+}
+
 //execute query
 func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
+	return mce.doQuery(sql, nil)
+}
+
+//doQuery executes the query, the params are bound to the placeholders of the prepared statement
+func (mce *MysqlCmdExecutor) doQuery(sql string, params []tree.Expr) error {
 	ses := mce.routine.GetSession()
 	proto := mce.routine.GetClientProtocol().(MysqlProtocol)
 	pdHook := mce.routine.GetPDCallback().(*PDCallbackImpl)
@@ -694,18 +989,24 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
 	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
 	proc.Refer = make(map[string]uint64)
+	defer mce.routine.cancelQuery()
 
 	comp := compile.New(mce.routine.db, sql, mce.routine.user, ses.Pu.StorageEngine, proc)
+	if params != nil {
+		comp.SetParams(params)
+	}
 	execs, err := comp.Build()
 	if err != nil {
 		return NewMysqlError(ER_PARSE_ERROR, err,
 			"--- Check the SQL syntax or the manual that corresponds to your MatrixOne server version for the right syntax to use")
 	}
 
+	//the result set of EXECUTE is nested in the one of the outer statement
+	mrs := ses.Mrs
 	ses.Mrs = &MysqlResultSet{}
 
 	defer func() {
-		ses.Mrs = nil
+		ses.Mrs = mrs
 	}()
 
 	for _, exec := range execs {
@@ -752,12 +1053,22 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			switch stmt.(type) {
 			case *tree.ShowDatabases, *tree.CreateDatabase, *tree.ShowWarnings, *tree.ShowErrors,
 				*tree.ShowStatus, *tree.DropDatabase, *tree.Load,
-				*tree.Use,*tree.SetVar:
+				*tree.Use,*tree.SetVar, *tree.Kill,
+				*tree.PrepareStmt, *tree.Execute, *tree.Deallocate,
+				*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
+				*tree.CreateRole, *tree.DropRole,
+				*tree.Revoke, *tree.Grant,
+				*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword:
 			default:
 				return NewMysqlError(ER_NO_DB_ERROR)
 			}
 		}
 
+		//check privileges
+		if err = mce.checkPrivilege(stmt); err != nil {
+			return err
+		}
+
 		var selfHandle = false
 
 		switch st := stmt.(type) {
@@ -788,6 +1099,39 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			if err != nil {
 				return err
 			}
+		case *tree.Kill:
+			selfHandle = true
+			err = mce.handleKill(st)
+			if err != nil {
+				return err
+			}
+		case *tree.PrepareStmt:
+			selfHandle = true
+			err = mce.handlePrepareStmt(st)
+			if err != nil {
+				return err
+			}
+		case *tree.Execute:
+			selfHandle = true
+			err = mce.handleExecute(st)
+			if err != nil {
+				return err
+			}
+		case *tree.Deallocate:
+			selfHandle = true
+			err = mce.handleDeallocate(st)
+			if err != nil {
+				return err
+			}
+		case *tree.CreateUser, *tree.DropUser, *tree.AlterUser,
+			*tree.CreateRole, *tree.DropRole,
+			*tree.Revoke, *tree.Grant,
+			*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword:
+			selfHandle = true
+			err = mce.handleAccount(st)
+			if err != nil {
+				return err
+			}
 		}
 
 		if selfHandle {
@@ -797,6 +1141,13 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			return err
 		}
 
+		//the children processes copy the cancellation signal during the compilation
+		var timeout time.Duration
+		if _, ok := stmt.(*tree.Select); ok {
+			timeout = time.Duration(ses.maxExecutionTime) * time.Millisecond
+		}
+		mce.routine.startQuery(proc, timeout)
+
 		cmpBegin := time.Now()
 		if err = exec.Compile(mce.routine, getDataFromPipeline); err != nil {
 			return err
@@ -811,7 +1162,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 		case *tree.Select,
 			*tree.ShowCreate, *tree.ShowCreateDatabase, *tree.ShowTables, *tree.ShowDatabases, *tree.ShowColumns,
 			*tree.ShowProcessList, *tree.ShowErrors, *tree.ShowWarnings, *tree.ShowVariables, *tree.ShowStatus,
-			*tree.ShowIndex,
+			*tree.ShowIndex, *tree.ShowStats, *tree.AnalyzeTable,
 			*tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
 			columns := exec.Columns()
 
@@ -861,7 +1212,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				Producing the data row and sending the data row
 			*/
 			if er := exec.Run(epoch); er != nil {
-				return er
+				return convertQueryError(proc, er)
 			}
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
 				logutil.Infof("time of Exec.Run : %s", time.Since(runBegin).String())
@@ -877,7 +1228,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			}
 		//just status, no result set
 		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
-			*tree.CreateIndex, *tree.DropIndex,
+			*tree.CreateIndex, *tree.DropIndex, *tree.AlterTable,
 			*tree.Insert, *tree.Delete, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 			*tree.SetVar,
@@ -891,7 +1242,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				Step 1: Start
 			*/
 			if er := exec.Run(epoch); er != nil {
-				return er
+				return convertQueryError(proc, er)
 			}
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
 				logutil.Infof("time of Exec.Run : %s", time.Since(runBegin).String())
@@ -948,6 +1299,7 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 		}
 	}
 
+	ses.Cmd = req.GetCmd()
 	switch uint8(req.GetCmd()) {
 	case COM_QUIT:
 		/*resp = NewResponse(
@@ -1007,6 +1359,52 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 				fmt.Errorf("wrong format for COM_FIELD_LIST"))
 		}
 
+		return resp, nil
+	case COM_STMT_PREPARE:
+		var query = string(req.GetData().([]byte))
+		mce.addSqlCount(1)
+		logutil.Infof("prepare:%s", SubStringFromBegin(query, int(ses.Pu.SV.GetLengthOfQueryPrinted())))
+		err := mce.handleStmtPrepare(query)
+		if err != nil {
+			resp = NewResponse(
+				ErrorResponse,
+				0,
+				int(COM_STMT_PREPARE),
+				err,
+			)
+		}
+		return resp, nil
+	case COM_STMT_EXECUTE:
+		err := mce.handleStmtExecute(req.GetData().([]byte))
+		if err != nil {
+			resp = NewResponse(
+				ErrorResponse,
+				0,
+				int(COM_STMT_EXECUTE),
+				err,
+			)
+		}
+		return resp, nil
+	case COM_STMT_SEND_LONG_DATA:
+		//no response, the error is reported by the next COM_STMT_EXECUTE
+		if err := mce.handleStmtSendLongData(req.GetData().([]byte)); err != nil {
+			logutil.Errorf("send long data failed. error:%v", err)
+		}
+		return resp, nil
+	case COM_STMT_RESET:
+		err := mce.handleStmtReset(req.GetData().([]byte))
+		if err != nil {
+			resp = NewResponse(
+				ErrorResponse,
+				0,
+				int(COM_STMT_RESET),
+				err,
+			)
+		}
+		return resp, nil
+	case COM_STMT_CLOSE:
+		//no response
+		mce.handleStmtClose(req.GetData().([]byte))
 		return resp, nil
 	case COM_PING:
 		resp = NewResponse(
@@ -1069,10 +1467,18 @@ func convertEngineTypeToMysqlType(engineType uint8, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_FLOAT)
 	case types.T_float64:
 		col.SetColumnType(defines.MYSQL_TYPE_DOUBLE)
+	case types.T_decimal64, types.T_decimal128:
+		col.SetColumnType(defines.MYSQL_TYPE_DECIMAL)
+	case types.T_date:
+		col.SetColumnType(defines.MYSQL_TYPE_DATE)
+	case types.T_datetime:
+		col.SetColumnType(defines.MYSQL_TYPE_DATETIME)
 	case types.T_char:
 		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_varchar:
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	case types.T_json:
+		col.SetColumnType(defines.MYSQL_TYPE_JSON)
 	default:
 		return fmt.Errorf("RunWhileSend : unsupported type %d \n", engineType)
 	}
@@ -1,10 +1,15 @@
 package frontend
 
 import (
+	"database/sql"
 	"fmt"
+	"github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/writer"
 	"io/ioutil"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"os"
 	"reflect"
 	"testing"
 	"time"
@@ -109,6 +114,12 @@ func TestMysqlCmdExecutor(t *testing.T) {
 	//memEngine does ensure sort
 	do_query_resp_resultset(t, db, false, true, "select * from D", mrs5)
 
+	do_prepare_stmt(t,db)
+
+	do_load_local(t, db, fmt.Sprintf(tableFormat, "F"), loadfile1, mrs5)
+
+	do_load_format(t, db)
+
 	//loadFormat2 := "load data " +
 	//	"infile '%s' " +
 	//	"ignore " +
@@ -452,4 +463,142 @@ func MakeValues_insert_1_partial_null(start int,cnt int) (string,*MysqlResultSet
 	s += ";"
 
 	return s,mrs,loadData
-}
\ No newline at end of file
+}
+func do_prepare_stmt(t *testing.T, db *sql.DB) {
+	do_query_resp_states(t, db, false, "create table E (a int, b bigint, c double, d varchar(100))")
+
+	//the placeholders of the binary protocol
+	_, err := db.Exec("insert into E values (?, ?, ?, ?)", 1, int64(-9223372036854775808), 1.5, "aaaaa")
+	require.NoError(t, err)
+	_, err = db.Exec("insert into E values (?, ?, ?, ?)", 2, nil, -2.5, "bbbbb")
+	require.NoError(t, err)
+
+	var a int32
+	var b sql.NullInt64
+	var c float64
+	var d string
+	err = db.QueryRow("select a, b, c, d from E where a = ?", 1).Scan(&a, &b, &c, &d)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), a)
+	require.Equal(t, sql.NullInt64{Int64: -9223372036854775808, Valid: true}, b)
+	require.Equal(t, 1.5, c)
+	require.Equal(t, "aaaaa", d)
+
+	err = db.QueryRow("select a, b, c, d from E where d = ?", "bbbbb").Scan(&a, &b, &c, &d)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), a)
+	require.False(t, b.Valid)
+	require.Equal(t, -2.5, c)
+
+	//the prepared statement of the SQL
+	do_query_resp_states(t, db, false, "set @v = 2")
+	do_query_resp_states(t, db, false, "prepare s1 from 'select d from E where a = ?'")
+	err = db.QueryRow("execute s1 using @v").Scan(&d)
+	require.NoError(t, err)
+	require.Equal(t, "bbbbb", d)
+	do_query_resp_states(t, db, true, "execute s1")
+	do_query_resp_states(t, db, false, "deallocate prepare s1")
+	do_query_resp_states(t, db, true, "execute s1 using @v")
+}
+
+func do_load_local(t *testing.T, db *sql.DB, table, file string, mrs *MysqlResultSet) {
+	do_query_resp_states(t, db, false, table)
+
+	//the file is streamed from the client
+	mysql.RegisterLocalFile(file)
+	defer mysql.DeregisterLocalFile(file)
+	loadF := fmt.Sprintf("load data local infile '%s' ignore into table T.F fields terminated by ','", file)
+	do_query_resp_states(t, db, false, loadF)
+	do_query_resp_resultset(t, db, false, true, "select * from F", mrs)
+
+	//the client refuses to send the file, the connection is still available
+	do_query_resp_states(t, db, true, "load data local infile 'test/none' into table T.F fields terminated by ','")
+	do_query_resp_resultset(t, db, false, true, "select * from F", mrs)
+}
+
+type formatRow struct {
+	A int32   `parquet:"name=A, type=INT32"`
+	B *string `parquet:"name=b, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	C float64 `parquet:"name=c, type=DOUBLE"`
+	D int32   `parquet:"name=d, type=INT32, convertedtype=DATE"`
+	X int64   `parquet:"name=x, type=INT64"`
+}
+
+func do_load_format(t *testing.T, db *sql.DB) {
+	tableFormat := "create table %s (a int, b varchar(20), c double, d date, e bigint)"
+	expected := [][]interface{}{
+		{int64(1), "one", 1.5, "2021-01-02", nil},
+		{int64(2), nil, -2.0, "1970-01-01", nil},
+		{int64(3), "three", 0.0, "2000-02-29", nil},
+	}
+	check := func(table string) {
+		rows, err := db.Query(fmt.Sprintf("select a, b, c, d, e from %s order by a", table))
+		require.NoError(t, err)
+		defer rows.Close()
+		var got [][]interface{}
+		for rows.Next() {
+			var a sql.NullInt64
+			var b, d sql.NullString
+			var c sql.NullFloat64
+			var e sql.NullInt64
+			require.NoError(t, rows.Scan(&a, &b, &c, &d, &e))
+			row := []interface{}{a.Int64, nil, c.Float64, d.String, nil}
+			if b.Valid {
+				row[1] = b.String
+			}
+			require.False(t, e.Valid)
+			got = append(got, row)
+		}
+		require.NoError(t, rows.Err())
+		require.Equal(t, expected, got)
+	}
+
+	//json lines, the keys are matched with the columns by the name
+	jsonFile := "test/loadcase.jsonl"
+	jsonData := `{"a":"header"}
+{"A":1,"b":"one","c":1.5,"d":"2021-01-02","x":1}
+
+{"a":2,"b":null,"c":-2,"d":"1970-01-01"}
+{"a":3,"b":"three","c":0,"d":"2000-02-29","e":null}
+`
+	require.NoError(t, ioutil.WriteFile(jsonFile, []byte(jsonData), 0777))
+	defer os.Remove(jsonFile)
+	do_query_resp_states(t, db, false, fmt.Sprintf(tableFormat, "G"))
+	do_query_resp_states(t, db, false, fmt.Sprintf("load data infile '%s' into table T.G format jsonlines ignore 1 lines", jsonFile))
+	check("G")
+
+	//parquet
+	parquetFile := "test/loadcase.parquet"
+	fw, err := local.NewLocalFileWriter(parquetFile)
+	require.NoError(t, err)
+	defer os.Remove(parquetFile)
+	pw, err := writer.NewParquetWriter(fw, new(formatRow), 1)
+	require.NoError(t, err)
+	one, three := "one", "three"
+	for _, r := range []formatRow{
+		{A: 1, B: &one, C: 1.5, D: 18629, X: 1},
+		{A: 2, C: -2, D: 0},
+		{A: 3, B: &three, C: 0, D: 11016},
+	} {
+		require.NoError(t, pw.Write(r))
+	}
+	require.NoError(t, pw.WriteStop())
+	require.NoError(t, fw.Close())
+
+	do_query_resp_states(t, db, false, fmt.Sprintf(tableFormat, "H"))
+	do_query_resp_states(t, db, false, fmt.Sprintf("load data infile '%s' into table T.H format parquet", parquetFile))
+	check("H")
+
+	//the parquet file from the client is spooled
+	do_query_resp_states(t, db, false, fmt.Sprintf(tableFormat, "I"))
+	mysql.RegisterLocalFile(parquetFile)
+	defer mysql.DeregisterLocalFile(parquetFile)
+	do_query_resp_states(t, db, false, fmt.Sprintf("load data local infile '%s' into table T.I format parquet", parquetFile))
+	check("I")
+
+	//unsupported format and the column list
+	do_query_resp_states(t, db, true, fmt.Sprintf("load data infile '%s' into table T.G format avro", jsonFile))
+	do_query_resp_states(t, db, true, fmt.Sprintf("load data infile '%s' into table T.G format jsonlines (a, b)", jsonFile))
+	do_query_resp_states(t, db, true, fmt.Sprintf("load data infile '%s' into table T.G format parquet", jsonFile))
+	check("G")
+}
@@ -19,10 +19,15 @@ import (
 	"crypto/sha1"
 	"fmt"
 	"github.com/huandu/go-clone"
+	"math"
 	"math/rand"
+	"net"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"strconv"
 	"sync"
 	"time"
@@ -132,6 +137,18 @@ type MysqlProtocol interface {
 
 	//the OK or EOF packet thread safe
 	sendEOFOrOkPacket(warnings uint16, status uint16) error
+
+	//the server send group row of the result set in the binary protocol thread safe
+	SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error
+
+	//SendPrepareResponse the server send the response of COM_STMT_PREPARE
+	SendPrepareResponse(stmt *PrepareStmt) error
+
+	//ParseExecuteData decodes the parameters of COM_STMT_EXECUTE following the statement id
+	ParseExecuteData(stmt *PrepareStmt, data []byte) ([]tree.Expr, error)
+
+	//the server asks the client to send the file of LOAD DATA LOCAL INFILE
+	sendLocalInfileRequest(filename string) error
 }
 
 var _ MysqlProtocol = &MysqlProtocolImpl{}
@@ -369,6 +386,45 @@ func (mp *MysqlProtocolImpl) appendStringLenEnc(data []byte, value string) []byt
 	return mp.appendStringFix(data, value, len(value))
 }
 
+//append a date in the binary protocol format: the length 4, the year, the month and the day
+//return the buffer
+func (mp *MysqlProtocolImpl) appendDate(data []byte, value types.Date) []byte {
+	year, month, day, _ := value.Calendar(true)
+	data = mp.io.AppendUint8(data, 4)
+	data = mp.io.AppendUint16(data, uint16(year))
+	data = mp.io.AppendUint8(data, month)
+	return mp.io.AppendUint8(data, day)
+}
+
+//append a datetime in the binary protocol format, the length is 4, 7 or 11
+//as the time part and the microseconds are zero or not
+//return the buffer
+func (mp *MysqlProtocolImpl) appendDatetime(data []byte, value types.Datetime) []byte {
+	year, month, day, _ := value.ToDate().Calendar(true)
+	hour, min, sec := value.Clock()
+	msec := value.MicroSec()
+	length := uint8(11)
+	if msec == 0 {
+		length = 7
+		if hour == 0 && min == 0 && sec == 0 {
+			length = 4
+		}
+	}
+	data = mp.io.AppendUint8(data, length)
+	data = mp.io.AppendUint16(data, uint16(year))
+	data = mp.io.AppendUint8(data, month)
+	data = mp.io.AppendUint8(data, day)
+	if length > 4 {
+		data = mp.io.AppendUint8(data, uint8(hour))
+		data = mp.io.AppendUint8(data, uint8(min))
+		data = mp.io.AppendUint8(data, uint8(sec))
+	}
+	if length > 7 {
+		data = mp.io.AppendUint32(data, uint32(msec))
+	}
+	return data
+}
+
 //append bytes with length encoded to the buffer
 //return the buffer
 func (mp *MysqlProtocolImpl) appendCountOfBytesLenEnc(data []byte, value []byte) []byte {
@@ -459,25 +515,87 @@ func (mp *MysqlProtocolImpl) checkPassword(password, salt, auth []byte) bool {
 	return bytes.Equal(hash1, auth)
 }
 
+//the server checks the authentication data from the client with the stage-2 hash
+//SHA1( SHA1( password ) ) stored for the account.
+//Algorithm: SHA1( auth XOR SHA1( salt + hash ) ) == hash
+func (mp *MysqlProtocolImpl) checkPasswordHash(hash, salt, auth []byte) bool {
+	//the account has no password
+	if len(hash) == 0 {
+		return len(auth) == 0
+	}
+	if len(auth) != sha1.Size {
+		return false
+	}
+	//hash3 = SHA1(salt + SHA1(SHA1(password)))
+	sha := sha1.New()
+	sha.Write(salt)
+	sha.Write(hash)
+	hash3 := sha.Sum(nil)
+
+	//SHA1(password) = auth XOR SHA1(salt + SHA1(SHA1(password)))
+	hash1 := make([]byte, sha1.Size)
+	for i := range hash1 {
+		hash1[i] = auth[i] ^ hash3[i]
+	}
+
+	sha.Reset()
+	sha.Write(hash1)
+	return bytes.Equal(sha.Sum(nil), hash)
+}
+
 //the server authenticate that the client can connect and use the database
 func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
-	//TODO:check the user and the connection
 	ses := mp.routine.GetSession()
-	//TODO:get the user's password
-	var psw []byte
 	if mp.username == ses.Pu.SV.GetDumpuser() { //the user dump for test
-		psw = []byte(ses.Pu.SV.GetDumppassword())
+		psw := []byte(ses.Pu.SV.GetDumppassword())
+
+		//TO Check password
+		if mp.checkPassword(psw, mp.salt, authResponse) {
+			logutil.Infof("check password succeeded\n")
+		} else {
+			return fmt.Errorf("check password failed\n")
+		}
+		return nil
 	}
 
-	//TO Check password
-	if mp.checkPassword(psw, mp.salt, authResponse) {
-		logutil.Infof("check password succeeded\n")
-	} else {
+	//the accounts are in the catalog
+	if ses.Pu.ClusterCatalog == nil {
+		return fmt.Errorf("check password failed\n")
+	}
+	user, err := mp.lookupAccount(ses.Pu.ClusterCatalog)
+	if err != nil {
 		return fmt.Errorf("check password failed\n")
 	}
+	if !mp.checkPasswordHash(user.Password, mp.salt, authResponse) {
+		return fmt.Errorf("check password failed\n")
+	}
+	logutil.Infof("check password succeeded\n")
+	mp.routine.host = user.Host
+	mp.routine.roles = user.DefaultRoles
 	return nil
 }
 
+//the account of the user from the client host is the one with the same host,
+//or the one with the host 'localhost' for a local client, or the one with the host '%'.
+func (mp *MysqlProtocolImpl) lookupAccount(c *catalog.Catalog) (*catalog.UserInfo, error) {
+	var hosts []string
+
+	if mp.routine.io != nil {
+		host, _ := mp.routine.Peer()
+		hosts = append(hosts, host)
+		if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+			hosts = append(hosts, "localhost")
+		}
+	}
+	hosts = append(hosts, "%")
+	for _, host := range hosts {
+		if user, err := c.GetUser(mp.username, host); err == nil {
+			return user, nil
+		}
+	}
+	return nil, catalog.ErrUserNotExists
+}
+
 func (mp *MysqlProtocolImpl) setSequenceID(value uint8) {
 	mp.sequenceId = value
 }
@@ -1091,7 +1209,11 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(mrs *MysqlResultSet, r uint64)
 
 		switch mysqlColumn.ColumnType() {
 		case defines.MYSQL_TYPE_DECIMAL:
-			return nil, fmt.Errorf("unsupported Decimal")
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
 		case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_YEAR:
 			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
 				return nil, err2
@@ -1132,14 +1254,20 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(mrs *MysqlResultSet, r uint64)
 					data = mp.appendStringLenEncOfInt64(data, value)
 				}
 			}
-		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_JSON:
 			if value, err2 := mrs.GetString(r, i); err2 != nil {
 				return nil, err2
 			} else {
 				data = mp.appendStringLenEnc(data, value)
 			}
-		case defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_TIME:
-			return nil, fmt.Errorf("unsupported DATE/DATETIME/TIMESTAMP/MYSQL_TYPE_TIME")
+		case defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_DATETIME:
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
+		case defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_TIME:
+			return nil, fmt.Errorf("unsupported TIMESTAMP/MYSQL_TYPE_TIME")
 		default:
 			return nil, fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
 		}
@@ -1195,6 +1323,373 @@ func (mp *MysqlProtocolImpl) sendResultSetTextRow(mrs *MysqlResultSet, r uint64)
 	return nil
 }
 
+//the server convert every row of the result set into the binary protocol format
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/binary-protocol-resultset-row.html
+func (mp *MysqlProtocolImpl) makeResultSetBinaryRow(mrs *MysqlResultSet, r uint64) ([]byte, error) {
+	//the header 0x00 and the null bitmap which starts from the bit 2
+	count := mrs.GetColumnCount()
+	data := make([]byte, 1+(count+7+2)/8)
+	for i := uint64(0); i < count; i++ {
+		column, err := mrs.GetColumn(i)
+		if err != nil {
+			return nil, err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return nil, fmt.Errorf("sendColumn need MysqlColumn")
+		}
+
+		if isNil, err1 := mrs.ColumnIsNull(r, i); err1 != nil {
+			return nil, err1
+		} else if isNil {
+			data[1+(i+2)/8] |= 1 << ((i + 2) % 8)
+			continue
+		}
+
+		switch mysqlColumn.ColumnType() {
+		case defines.MYSQL_TYPE_TINY:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.io.AppendUint8(data, uint8(value))
+			}
+		case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.io.AppendUint16(data, uint16(value))
+			}
+		case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.io.AppendUint32(data, uint32(value))
+			}
+		case defines.MYSQL_TYPE_LONGLONG:
+			if uint32(mysqlColumn.Flag())&defines.UNSIGNED_FLAG != 0 {
+				if value, err2 := mrs.GetUint64(r, i); err2 != nil {
+					return nil, err2
+				} else {
+					data = mp.io.AppendUint64(data, value)
+				}
+			} else {
+				if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+					return nil, err2
+				} else {
+					data = mp.io.AppendUint64(data, uint64(value))
+				}
+			}
+		case defines.MYSQL_TYPE_FLOAT:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.io.AppendUint32(data, math.Float32bits(float32(value)))
+			}
+		case defines.MYSQL_TYPE_DOUBLE:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.io.AppendUint64(data, math.Float64bits(value))
+			}
+		case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_JSON:
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
+		case defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_DATETIME:
+			value, err2 := mrs.GetValue(r, i)
+			if err2 != nil {
+				return nil, err2
+			}
+			switch v := value.(type) {
+			case types.Date:
+				data = mp.appendDate(data, v)
+			case types.Datetime:
+				data = mp.appendDatetime(data, v)
+			default:
+				return nil, fmt.Errorf("unsupported date value %v", value)
+			}
+		case defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_TIME:
+			return nil, fmt.Errorf("unsupported TIMESTAMP/MYSQL_TYPE_TIME")
+		default:
+			return nil, fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
+		}
+	}
+	return data, nil
+}
+
+//the server send group row of the result set in the binary protocol
+//thread safe
+func (mp *MysqlProtocolImpl) SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	mp.GetLock().Lock()
+	defer mp.GetLock().Unlock()
+
+	for i := uint64(0); i < cnt; i++ {
+		data, err := mp.makeResultSetBinaryRow(mrs, i)
+		if err != nil {
+			//ERR_Packet in case of error
+			if err1 := mp.sendErrPacket(ER_UNKNOWN_ERROR, DefaultMySQLState, err.Error()); err1 != nil {
+				return err1
+			}
+			return err
+		}
+		if err = mp.writePackets(data); err != nil {
+			return fmt.Errorf("send result set binary row failed. error: %v", err)
+		}
+	}
+	return nil
+}
+
+//the server send COM_STMT_PREPARE_OK and the definitions of the parameters
+//the result set columns are sent by COM_STMT_EXECUTE
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-prepare-response.html
+func (mp *MysqlProtocolImpl) SendPrepareResponse(stmt *PrepareStmt) error {
+	mp.GetLock().Lock()
+	defer mp.GetLock().Unlock()
+
+	data := make([]byte, 0, 12)
+	//int<1> status [00] OK
+	data = mp.io.AppendUint8(data, 0)
+	//int<4> statement_id
+	data = mp.io.AppendUint32(data, stmt.Id)
+	//int<2> num_columns
+	data = mp.io.AppendUint16(data, 0)
+	//int<2> num_params
+	data = mp.io.AppendUint16(data, uint16(stmt.ParamCount))
+	//int<1> reserved_1 [00] filler
+	data = mp.io.AppendUint8(data, 0)
+	//int<2> warning_count
+	data = mp.io.AppendUint16(data, 0)
+	if err := mp.writePackets(data); err != nil {
+		return err
+	}
+
+	if stmt.ParamCount == 0 {
+		return nil
+	}
+	for i := 0; i < stmt.ParamCount; i++ {
+		col := new(MysqlColumn)
+		col.SetName("?")
+		col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
+		if err := mp.writePackets(mp.makeColumnDefinition41Payload(col, int(COM_STMT_PREPARE))); err != nil {
+			return err
+		}
+	}
+	if mp.capability&CLIENT_DEPRECATE_EOF == 0 {
+		return mp.sendEOFPacket(0, 0)
+	}
+	return nil
+}
+
+//the server sends LOCAL INFILE Request to the client, and the client sends
+//the content of the file in packets, ended with an empty packet.
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/com-query-response.html#packet-Protocol::LOCAL_INFILE_Request
+func (mp *MysqlProtocolImpl) sendLocalInfileRequest(filename string) error {
+	mp.GetLock().Lock()
+	defer mp.GetLock().Unlock()
+
+	if mp.capability&CLIENT_LOCAL_FILES == 0 {
+		return NewMysqlError(ER_CLIENT_LOCAL_FILES_DISABLED)
+	}
+
+	data := make([]byte, 0, 1+len(filename))
+	//int<1> 0xfb LOCAL INFILE
+	data = mp.io.AppendUint8(data, 0xFB)
+	//string<EOF> filename
+	data = append(data, filename...)
+	return mp.writePackets(data)
+}
+
+//ParseExecuteData decodes the parameters of COM_STMT_EXECUTE following the statement id,
+//the types of the parameters are kept in the statement for the following executions.
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
+func (mp *MysqlProtocolImpl) ParseExecuteData(stmt *PrepareStmt, data []byte) ([]tree.Expr, error) {
+	var ok bool
+	var flag uint8
+	var nullBitmap, paramTypes []byte
+
+	//int<1> flags, int<4> iteration-count
+	pos := 5
+	n := stmt.ParamCount
+	if n == 0 {
+		return nil, nil
+	}
+	if nullBitmap, pos, ok = mp.readCountOfBytes(data, pos, (n+7)/8); !ok {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	if flag, pos, ok = mp.io.ReadUint8(data, pos); !ok {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	if flag == 1 {
+		if paramTypes, pos, ok = mp.readCountOfBytes(data, pos, n*2); !ok {
+			return nil, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		stmt.paramTypes = append(stmt.paramTypes[:0], paramTypes...)
+	}
+	if len(stmt.paramTypes) != n*2 {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+
+	params := make([]tree.Expr, n)
+	for i := 0; i < n; i++ {
+		if nullBitmap[i/8]&(1<<(i%8)) != 0 {
+			params[i] = nullParam()
+			continue
+		}
+		if v, ok := stmt.longData[i]; ok {
+			params[i] = stringParam(string(v))
+			continue
+		}
+		typ, unsigned := stmt.paramTypes[i*2], stmt.paramTypes[i*2+1]&0x80 != 0
+		switch typ {
+		case defines.MYSQL_TYPE_NULL:
+			params[i] = nullParam()
+		case defines.MYSQL_TYPE_TINY:
+			var v uint8
+			if v, pos, ok = mp.io.ReadUint8(data, pos); !ok {
+				return nil, NewMysqlError(ER_MALFORMED_PACKET)
+			}
+			if unsigned {
+				params[i] = uintParam(uint64(v))
+			} else {
+				params[i] = intParam(int64(int8(v)))
+			}
+		case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+			var v uint16
+			if v, pos, ok = mp.io.ReadUint16(data, pos); !ok {
+				return nil, NewMysqlError(ER_MALFORMED_PACKET)
+			}
+			if unsigned {
+				params[i] = uintParam(uint64(v))
+			} else {
+				params[i] = intParam(int64(int16(v)))
+			}
+		case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+			var v uint32
+			if v, pos, ok = mp.io.ReadUint32(data, pos); !ok {
+				return nil, NewMysqlError(ER_MALFORMED_PACKET)
+			}
+			if unsigned {
+				params[i] = uintParam(uint64(v))
+			} else {
+				params[i] = intParam(int64(int32(v)))
+			}
+		case defines.MYSQL_TYPE_LONGLONG:
+			var v uint64
+			if v, pos, ok = mp.io.ReadUint64(data, pos); !ok {
+				return nil, NewMysqlError(ER_MALFORMED_PACKET)
+			}
+			if unsigned {
+				params[i] = uintParam(v)
+			} else {
+				params[i] = intParam(int64(v))
+			}
+		case defines.MYSQL_TYPE_FLOAT:
+			var v uint32
+			if v, pos, ok = mp.io.ReadUint32(data, pos); !ok {
+				return nil, NewMysqlError(ER_MALFORMED_PACKET)
+			}
+			params[i] = floatParam(float64(math.Float32frombits(v)))
+		case defines.MYSQL_TYPE_DOUBLE:
+			var v uint64
+			if v, pos, ok = mp.io.ReadUint64(data, pos); !ok {
+				return nil, NewMysqlError(ER_MALFORMED_PACKET)
+			}
+			params[i] = floatParam(math.Float64frombits(v))
+		case defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+			var v string
+			if v, pos, ok = mp.readDatetimeParam(data, pos); !ok {
+				return nil, NewMysqlError(ER_MALFORMED_PACKET)
+			}
+			params[i] = stringParam(v)
+		case defines.MYSQL_TYPE_TIME:
+			var v string
+			if v, pos, ok = mp.readTimeParam(data, pos); !ok {
+				return nil, NewMysqlError(ER_MALFORMED_PACKET)
+			}
+			params[i] = stringParam(v)
+		case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL,
+			defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
+			defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB, defines.MYSQL_TYPE_BLOB,
+			defines.MYSQL_TYPE_JSON, defines.MYSQL_TYPE_ENUM, defines.MYSQL_TYPE_SET, defines.MYSQL_TYPE_BIT:
+			var v string
+			if v, pos, ok = mp.readStringLenEnc(data, pos); !ok {
+				return nil, NewMysqlError(ER_MALFORMED_PACKET)
+			}
+			params[i] = stringParam(v)
+		default:
+			return nil, NewMysqlError(ER_UNKNOWN_ERROR, fmt.Sprintf("unsupported parameter type %d", typ))
+		}
+	}
+	return params, nil
+}
+
+//read a date or a datetime of the binary protocol, the length is 0, 4, 7 or 11
+//return the string of the value ; position + the length ; true - succeeded or false - failed
+func (mp *MysqlProtocolImpl) readDatetimeParam(data []byte, pos int) (string, int, bool) {
+	var ok bool
+	var length, month, day, hour, min, sec uint8
+	var year uint16
+	var msec uint32
+
+	if length, pos, ok = mp.io.ReadUint8(data, pos); !ok || pos+int(length) > len(data) {
+		return "", 0, false
+	}
+	if length >= 4 {
+		year, pos, _ = mp.io.ReadUint16(data, pos)
+		month, pos, _ = mp.io.ReadUint8(data, pos)
+		day, pos, _ = mp.io.ReadUint8(data, pos)
+	}
+	if length >= 7 {
+		hour, pos, _ = mp.io.ReadUint8(data, pos)
+		min, pos, _ = mp.io.ReadUint8(data, pos)
+		sec, pos, _ = mp.io.ReadUint8(data, pos)
+	}
+	if length >= 11 {
+		msec, pos, _ = mp.io.ReadUint32(data, pos)
+	}
+	switch {
+	case length < 4:
+		return "0000-00-00 00:00:00", pos, true
+	case length < 7:
+		return fmt.Sprintf("%04d-%02d-%02d", year, month, day), pos, true
+	case length < 11:
+		return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", year, month, day, hour, min, sec), pos, true
+	}
+	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d.%06d", year, month, day, hour, min, sec, msec), pos, true
+}
+
+//read a time of the binary protocol, the length is 0, 8 or 12
+//return the string of the value ; position + the length ; true - succeeded or false - failed
+func (mp *MysqlProtocolImpl) readTimeParam(data []byte, pos int) (string, int, bool) {
+	var ok bool
+	var length, negative, hour, min, sec uint8
+	var days, msec uint32
+
+	if length, pos, ok = mp.io.ReadUint8(data, pos); !ok || pos+int(length) > len(data) {
+		return "", 0, false
+	}
+	if length >= 8 {
+		negative, pos, _ = mp.io.ReadUint8(data, pos)
+		days, pos, _ = mp.io.ReadUint32(data, pos)
+		hour, pos, _ = mp.io.ReadUint8(data, pos)
+		min, pos, _ = mp.io.ReadUint8(data, pos)
+		sec, pos, _ = mp.io.ReadUint8(data, pos)
+	}
+	if length >= 12 {
+		msec, pos, _ = mp.io.ReadUint32(data, pos)
+	}
+	sign := ""
+	if negative == 1 {
+		sign = "-"
+	}
+	if length >= 12 {
+		return fmt.Sprintf("%s%02d:%02d:%02d.%06d", sign, days*24+uint32(hour), min, sec, msec), pos, true
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, days*24+uint32(hour), min, sec), pos, true
+}
+
 //the server send the result set of execution the client
 //the routine follows the article: https://dev.mysql.com/doc/internals/en/com-query-response.html
 func (mp *MysqlProtocolImpl) sendResultSet(set ResultSet, cmd int, warnings, status uint16) error {
@@ -1454,6 +1949,23 @@ func (cp *ChannelProtocol) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt ui
 	return nil
 }
 
+func (cp *ChannelProtocol) SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	return cp.SendResultSetTextBatchRow(mrs, cnt)
+}
+
+func (cp *ChannelProtocol) SendPrepareResponse(stmt *PrepareStmt) error {
+	cp.respOk()
+	return nil
+}
+
+func (cp *ChannelProtocol) ParseExecuteData(stmt *PrepareStmt, data []byte) ([]tree.Expr, error) {
+	return nil, fmt.Errorf("unsupported binary protocol")
+}
+
+func (cp *ChannelProtocol) sendLocalInfileRequest(filename string) error {
+	return NewMysqlError(ER_CLIENT_LOCAL_FILES_DISABLED)
+}
+
 func (cp *ChannelProtocol) SendColumnDefinitionPacket(column Column, cmd int) error {
 	cp.GetLock().Lock()
 	defer cp.GetLock().Unlock()
@@ -6241,8 +6241,8 @@ var errorMsgRefer = map[uint16]errorMsgItem{
 	ER_CANT_DROP_FIELD_OR_KEY:        {1091, []string{"42000"}, "Can't DROP '%-.192s'; check that column/key exists"},
 	ER_INSERT_INFO:                   {1092, []string{"HY000"}, "Records: %ld  Duplicates: %ld  Warnings: %ld"},
 	ER_UPDATE_TABLE_USED:             {1093, []string{"HY000"}, "You can't specify target table '%-.192s' for update in FROM clause"},
-	ER_NO_SUCH_THREAD:                {1094, []string{"HY000"}, "Unknown thread id: %lu"},
-	ER_KILL_DENIED_ERROR:             {1095, []string{"HY000"}, "You are not owner of thread %lu"},
+	ER_NO_SUCH_THREAD:                {1094, []string{"HY000"}, "Unknown thread id: %d"},
+	ER_KILL_DENIED_ERROR:             {1095, []string{"HY000"}, "You are not owner of thread %d"},
 	ER_NO_TABLES_USED:                {1096, []string{"HY000"}, "No tables used"},
 	ER_TOO_BIG_SET:                   {1097, []string{"HY000"}, "Too many strings for column %-.192s and SET"},
 	ER_NO_UNIQUE_LOGFILE:             {1098, []string{"HY000"}, "Can't generate a unique log-filename %-.200s.(1-999)\n"},
@@ -11514,7 +11514,8 @@ type MysqlError struct {
 }
 
 func (me *MysqlError) Error() string {
-	cnt := strings.Count(me.Format, "%")
+	//the precision of %.*s is an argument too
+	cnt := strings.Count(me.Format, "%") + strings.Count(me.Format, ".*s")
 	return fmt.Sprintf(me.Format, me.Args[:cnt]...)
 }
 
@@ -29,6 +29,8 @@ import (
 	"github.com/fagongzi/goetty"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -245,6 +247,44 @@ func TestReadStringLenEnc(t *testing.T) {
 	}
 }
 
+func TestAppendDateAndDatetime(t *testing.T) {
+	client := NewMysqlClientProtocol(NewIOPackage(true), 0)
+	var kases = []struct {
+		data []byte
+		want []byte
+	}{
+		{client.appendDate(nil, types.FromCalendar(2021, 8, 13)), []byte{4, 0xe5, 0x07, 8, 13}},
+		{client.appendDatetime(nil, types.FromClock(2021, 8, 13, 0, 0, 0, 0)), []byte{4, 0xe5, 0x07, 8, 13}},
+		{client.appendDatetime(nil, types.FromClock(2021, 8, 13, 17, 55, 34, 0)), []byte{7, 0xe5, 0x07, 8, 13, 17, 55, 34}},
+		{client.appendDatetime(nil, types.FromClock(2021, 8, 13, 17, 55, 34, 120)), []byte{11, 0xe5, 0x07, 8, 13, 17, 55, 34, 120, 0, 0, 0}},
+	}
+	for i, k := range kases {
+		if !reflect.DeepEqual(k.data, k.want) {
+			t.Errorf("append date %d failed. got %v, want %v", i, k.data, k.want)
+		}
+	}
+}
+
+func TestMakeResultSetTextRowOfJson(t *testing.T) {
+	client := NewMysqlClientProtocol(NewIOPackage(true), 0)
+	mrs := &MysqlResultSet{}
+	col := new(MysqlColumn)
+	col.SetName("j")
+	col.SetColumnType(defines.MYSQL_TYPE_JSON)
+	mrs.AddColumn(col)
+	for _, s := range []string{`{"b": [1, 2.5, null], "a": "x"}`, `"str"`} {
+		bj, err := bytejson.ParseFromString(s)
+		require.NoError(t, err)
+		mrs.AddRow([]interface{}{bj})
+	}
+	var kases = []string{`{"a": "x", "b": [1, 2.5, null]}`, `"str"`}
+	for i, k := range kases {
+		data, err := client.makeResultSetTextRow(mrs, uint64(i))
+		require.NoError(t, err)
+		require.Equal(t, client.appendStringLenEnc(nil, k), data)
+	}
+}
+
 func TestMysqlClientProtocol_Handshake(t *testing.T) {
 	//client connection method: mysql -h 127.0.0.1 -P 6001 --default-auth=mysql_native_password -uroot -p
 	//client connection method: mysql -h 127.0.0.1 -P 6001 -udump -p
@@ -0,0 +1,293 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"go/constant"
+	"strconv"
+	"strings"
+)
+
+// PrepareStmt is a prepared statement of the session.
+// It is prepared by the SQL PREPARE or by COM_STMT_PREPARE of the binary protocol.
+type PrepareStmt struct {
+	//the id of the statement in the binary protocol, zero for the SQL PREPARE
+	Id uint32
+
+	Name string
+
+	//the sql with the ? placeholders
+	Sql string
+
+	//the count of the ? placeholders
+	ParamCount int
+
+	//the types of the parameters sent by the last COM_STMT_EXECUTE, two bytes per parameter
+	paramTypes []byte
+
+	//the values sent by COM_STMT_SEND_LONG_DATA, keyed by the index of the parameter
+	longData map[int][]byte
+}
+
+/*
+the literals bound to the placeholders.
+they are the same as the literals produced by the parser.
+*/
+func nullParam() tree.Expr {
+	return tree.NewNumVal(constant.MakeUnknown(), "", false)
+}
+
+func uintParam(v uint64) tree.Expr {
+	s := strconv.FormatUint(v, 10)
+	return tree.NewNumVal(constant.MakeUint64(v), s, false)
+}
+
+func intParam(v int64) tree.Expr {
+	if v >= 0 {
+		return uintParam(uint64(v))
+	}
+	return tree.NewUnaryExpr(tree.UNARY_MINUS, uintParam(uint64(-v)))
+}
+
+func floatParam(v float64) tree.Expr {
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	return tree.NewNumValWithResFoalt(constant.MakeFloat64(v), s, false, v)
+}
+
+func stringParam(v string) tree.Expr {
+	return tree.NewNumVal(constant.MakeString(v), v, false)
+}
+
+//isParamLiteral checks the value of the user variable is a literal
+func isParamLiteral(e tree.Expr) bool {
+	switch v := e.(type) {
+	case *tree.NumVal:
+		return true
+	case *tree.UnaryExpr:
+		if _, ok := v.Expr.(*tree.NumVal); ok && v.Op == tree.UNARY_MINUS {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+prepareStmt parses the sql and registers the statement into the session.
+The statement with the same name is replaced.
+*/
+func (mce *MysqlCmdExecutor) prepareStmt(name, sql string) (*PrepareStmt, error) {
+	ses := mce.routine.GetSession()
+	stmt, n, err := parsers.Prepare(dialect.MYSQL, sql)
+	if err != nil {
+		return nil, NewMysqlError(ER_PARSE_ERROR, err,
+			"--- Check the SQL syntax or the manual that corresponds to your MatrixOne server version for the right syntax to use")
+	}
+	switch stmt.(type) {
+	case *tree.PrepareStmt, *tree.Execute, *tree.Deallocate:
+		return nil, NewMysqlError(ER_UNSUPPORTED_PS)
+	}
+	if ses.prepareStmts == nil {
+		ses.prepareStmts = make(map[string]*PrepareStmt)
+	}
+	ps := &PrepareStmt{
+		Name:       name,
+		Sql:        sql,
+		ParamCount: n,
+	}
+	ses.prepareStmts[name] = ps
+	return ps, nil
+}
+
+/*
+handle PREPARE name FROM 'sql' or PREPARE name FROM @var
+*/
+func (mce *MysqlCmdExecutor) handlePrepareStmt(st *tree.PrepareStmt) error {
+	ses := mce.routine.GetSession()
+	proto := mce.routine.GetClientProtocol().(MysqlProtocol)
+
+	var sql string
+	switch v := st.Sql.(type) {
+	case *tree.NumVal:
+		sql = constant.StringVal(v.Value)
+	case *tree.VarExpr:
+		val, ok := ses.userVars[strings.ToLower(v.Name)]
+		if !ok {
+			return NewMysqlError(ER_PARSE_ERROR, "the prepared sql is NULL", "")
+		}
+		if nv, ok := val.(*tree.NumVal); ok && nv.Value.Kind() == constant.String {
+			sql = constant.StringVal(nv.Value)
+		} else {
+			sql = tree.String(val, dialect.MYSQL)
+		}
+	}
+
+	if _, err := mce.prepareStmt(strings.ToLower(string(st.Name)), sql); err != nil {
+		return err
+	}
+	return proto.sendOKPacket(0, 0, 0, 0, "Statement prepared")
+}
+
+/*
+handle EXECUTE name USING @var, ...
+The variables which are not defined are NULL.
+*/
+func (mce *MysqlCmdExecutor) handleExecute(st *tree.Execute) error {
+	ses := mce.routine.GetSession()
+	name := strings.ToLower(string(st.Name))
+	ps, ok := ses.prepareStmts[name]
+	if !ok {
+		return NewMysqlError(ER_UNKNOWN_STMT_HANDLER, len(name), name, "EXECUTE")
+	}
+	if len(st.Variables) != ps.ParamCount {
+		return NewMysqlError(ER_WRONG_ARGUMENTS, "EXECUTE")
+	}
+	params := make([]tree.Expr, len(st.Variables))
+	for i, v := range st.Variables {
+		if val, ok := ses.userVars[strings.ToLower(v.Name)]; ok {
+			params[i] = val
+		} else {
+			params[i] = nullParam()
+		}
+	}
+	return mce.doQuery(ps.Sql, params)
+}
+
+/*
+handle DEALLOCATE PREPARE name or DROP PREPARE name
+*/
+func (mce *MysqlCmdExecutor) handleDeallocate(st *tree.Deallocate) error {
+	ses := mce.routine.GetSession()
+	proto := mce.routine.GetClientProtocol().(MysqlProtocol)
+	name := strings.ToLower(string(st.Name))
+	if _, ok := ses.prepareStmts[name]; !ok {
+		return NewMysqlError(ER_UNKNOWN_STMT_HANDLER, len(name), name, "DEALLOCATE PREPARE")
+	}
+	delete(ses.prepareStmts, name)
+	return proto.sendOKPacket(0, 0, 0, 0, "")
+}
+
+//getStmtById returns the prepared statement of the binary protocol
+func (mce *MysqlCmdExecutor) getStmtById(data []byte, cmd string) (*PrepareStmt, error) {
+	proto := mce.routine.GetClientProtocol().(MysqlProtocol)
+	pro, ok := proto.(*MysqlProtocolImpl)
+	if !ok {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	id, _, ok := pro.io.ReadUint32(data, 0)
+	if !ok {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	name := stmtName(id)
+	ps, ok := mce.routine.GetSession().prepareStmts[name]
+	if !ok {
+		return nil, NewMysqlError(ER_UNKNOWN_STMT_HANDLER, len(name), name, cmd)
+	}
+	return ps, nil
+}
+
+//the statements of the binary protocol are keyed by the id, it can not conflict with the identifiers
+func stmtName(id uint32) string {
+	return fmt.Sprintf("#%d", id)
+}
+
+/*
+handle COM_STMT_PREPARE
+the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-prepare.html
+*/
+func (mce *MysqlCmdExecutor) handleStmtPrepare(sql string) error {
+	ses := mce.routine.GetSession()
+	proto := mce.routine.GetClientProtocol().(MysqlProtocol)
+
+	ses.lastStmtId++
+	ps, err := mce.prepareStmt(stmtName(ses.lastStmtId), sql)
+	if err != nil {
+		return err
+	}
+	ps.Id = ses.lastStmtId
+	return proto.SendPrepareResponse(ps)
+}
+
+/*
+handle COM_STMT_EXECUTE
+the long data of the parameters is cleared after the execution.
+the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
+*/
+func (mce *MysqlCmdExecutor) handleStmtExecute(data []byte) error {
+	proto := mce.routine.GetClientProtocol().(MysqlProtocol)
+	ps, err := mce.getStmtById(data, "mysqld_stmt_execute")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		ps.longData = nil
+	}()
+	params, err := proto.ParseExecuteData(ps, data[4:])
+	if err != nil {
+		return err
+	}
+	return mce.doQuery(ps.Sql, params)
+}
+
+/*
+handle COM_STMT_SEND_LONG_DATA
+the data is appended to the parameter. there is no response.
+the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-send-long-data.html
+*/
+func (mce *MysqlCmdExecutor) handleStmtSendLongData(data []byte) error {
+	ps, err := mce.getStmtById(data, "mysqld_stmt_send_long_data")
+	if err != nil {
+		return err
+	}
+	if len(data) < 6 {
+		return NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	idx := int(data[4]) | int(data[5])<<8
+	if idx >= ps.ParamCount {
+		return NewMysqlError(ER_WRONG_ARGUMENTS, "mysqld_stmt_send_long_data")
+	}
+	if ps.longData == nil {
+		ps.longData = make(map[int][]byte)
+	}
+	ps.longData[idx] = append(ps.longData[idx], data[6:]...)
+	return nil
+}
+
+/*
+handle COM_STMT_RESET
+the long data of the statement is cleared.
+*/
+func (mce *MysqlCmdExecutor) handleStmtReset(data []byte) error {
+	proto := mce.routine.GetClientProtocol().(MysqlProtocol)
+	ps, err := mce.getStmtById(data, "mysqld_stmt_reset")
+	if err != nil {
+		return err
+	}
+	ps.longData = nil
+	return proto.sendOKPacket(0, 0, 0, 0, "")
+}
+
+/*
+handle COM_STMT_CLOSE
+there is no response.
+*/
+func (mce *MysqlCmdExecutor) handleStmtClose(data []byte) {
+	if ps, err := mce.getStmtById(data, "mysqld_stmt_close"); err == nil {
+		delete(mce.routine.GetSession().prepareStmts, stmtName(ps.Id))
+	}
+}
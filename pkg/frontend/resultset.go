@@ -18,6 +18,8 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 )
 
@@ -480,6 +482,12 @@ func (mrs *MysqlResultSet) GetString(rindex, cindex uint64) (string, error) {
 		return strconv.FormatInt(int64(v), 10), nil
 	case uint:
 		return strconv.FormatUint(uint64(v), 10), nil
+	case types.Date:
+		return v.String(), nil
+	case types.Datetime:
+		return v.String(), nil
+	case bytejson.ByteJson:
+		return v.String(), nil
 	default:
 		return "", fmt.Errorf("unsupported type %d ", v)
 	}
@@ -15,10 +15,14 @@
 package frontend
 
 import (
+	"context"
 	"github.com/fagongzi/goetty"
 	pConfig "github.com/matrixorigin/matrixcube/components/prophet/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"io"
 	"net"
+	"sync"
 	"time"
 )
 
@@ -44,6 +48,12 @@ type Routine struct {
 	// current username
 	user string
 
+	// host of the account the user logged in as
+	host string
+
+	// roles activated for the current user
+	roles []string
+
 	// current db name
 	db string
 
@@ -55,6 +65,19 @@ type Routine struct {
 
 	//channel of notify
 	notifyChan chan interface{}
+
+	//the manager that the routine belongs to
+	routineMgr *RoutineManager
+
+	//cancels the running statement, it may be called by other routines
+	cancelLock sync.Mutex
+	cancel     context.CancelFunc
+
+	//the file of LOAD DATA LOCAL INFILE sent by the client.
+	//the packets from the client are written into the pipe, instead of being the requests.
+	loadLocalLock   sync.Mutex
+	loadLocalWriter *io.PipeWriter
+	loadLocalDone   chan struct{}
 }
 
 func (routine *Routine) GetClientProtocol() Protocol {
@@ -113,10 +136,97 @@ func (routine *Routine) Loop() {
 	}
 }
 
+/*
+startQuery binds a new cancellation signal to the process of the statement.
+The statement will be interrupted after the timeout, if the timeout is not zero.
+ */
+func (routine *Routine) startQuery(proc *process.Process, timeout time.Duration) {
+	routine.cancelLock.Lock()
+	defer routine.cancelLock.Unlock()
+	if routine.cancel != nil {
+		routine.cancel()
+	}
+	if timeout > 0 {
+		proc.Ctx, proc.Cancel = context.WithTimeout(context.Background(), timeout)
+	} else {
+		proc.Ctx, proc.Cancel = context.WithCancel(context.Background())
+	}
+	routine.cancel = proc.Cancel
+}
+
+/*
+cancelQuery interrupts the running statement of the routine.
+It is called by the KILL statement and when the client is gone.
+ */
+func (routine *Routine) cancelQuery() {
+	routine.cancelLock.Lock()
+	defer routine.cancelLock.Unlock()
+	if routine.cancel != nil {
+		routine.cancel()
+		routine.cancel = nil
+	}
+}
+
+/*
+startLoadLocal makes the routine receive the file of LOAD DATA LOCAL INFILE.
+The content of the file can be read from the reader, and the channel is closed
+when the client finishes sending the file.
+ */
+func (routine *Routine) startLoadLocal() (*io.PipeReader, chan struct{}) {
+	routine.loadLocalLock.Lock()
+	defer routine.loadLocalLock.Unlock()
+	reader, writer := io.Pipe()
+	routine.loadLocalWriter = writer
+	routine.loadLocalDone = make(chan struct{})
+	return reader, routine.loadLocalDone
+}
+
+/*
+writeLoadLocal writes the payload from the client into the file of LOAD DATA LOCAL INFILE.
+The empty payload means the end of the file.
+It returns false if the routine does not wait for the file.
+ */
+func (routine *Routine) writeLoadLocal(payload []byte) bool {
+	routine.loadLocalLock.Lock()
+	writer, done := routine.loadLocalWriter, routine.loadLocalDone
+	if writer != nil && len(payload) == 0 {
+		routine.loadLocalWriter, routine.loadLocalDone = nil, nil
+	}
+	routine.loadLocalLock.Unlock()
+
+	if writer == nil {
+		return false
+	}
+	if len(payload) == 0 {
+		_ = writer.Close()
+		close(done)
+		return true
+	}
+	//the reader quits on errors, then the rest of the file is discarded
+	_, _ = writer.Write(payload)
+	return true
+}
+
+/*
+stopLoadLocal aborts the file of LOAD DATA LOCAL INFILE.
+It is called when the file is never requested or the client is gone.
+ */
+func (routine *Routine) stopLoadLocal() {
+	routine.loadLocalLock.Lock()
+	defer routine.loadLocalLock.Unlock()
+	if routine.loadLocalWriter != nil {
+		_ = routine.loadLocalWriter.CloseWithError(io.ErrUnexpectedEOF)
+		close(routine.loadLocalDone)
+		routine.loadLocalWriter, routine.loadLocalDone = nil, nil
+	}
+}
+
 /*
 When the io is closed, the Quit will be called.
  */
 func (routine *Routine) Quit() {
+	routine.cancelQuery()
+	routine.stopLoadLocal()
 	if routine.io != nil {
 		_ = routine.io.Close()
 	}
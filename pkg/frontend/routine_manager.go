@@ -40,6 +40,7 @@ func (rm *RoutineManager) Created(rs goetty.IOSession) {
 	ses := NewSessionWithParameterUnit(rm.pu)
 	routine := NewRoutine(rs, pro, exe, ses)
 	routine.pdHook = rm.pdHook
+	routine.routineMgr = rm
 
 	hsV10pkt := pro.makeHandshakeV10Payload()
 	err := pro.writePackets(hsV10pkt)
@@ -69,6 +70,19 @@ func (rm *RoutineManager) Closed(rs goetty.IOSession) {
 	rt.Quit()
 }
 
+//getRoutineByConnID returns the routine of the connection id
+func (rm *RoutineManager) getRoutineByConnID(id uint32) (*Routine, bool) {
+	rm.rwlock.RLock()
+	defer rm.rwlock.RUnlock()
+
+	for _, routine := range rm.clients {
+		if routine.getConnID() == id {
+			return routine, true
+		}
+	}
+	return nil, false
+}
+
 func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received uint64) error {
 	if rm.pu.SV.GetRejectWhenHeartbeatFromPDLeaderIsTimeout() {
 		if !rm.pdHook.CanAcceptSomething() {
@@ -122,6 +136,11 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 		return nil
 	}
 
+	//the content of the file of LOAD DATA LOCAL INFILE
+	if routine.writeLoadLocal(payload) {
+		return nil
+	}
+
 	req := routine.protocol.GetRequest(payload)
 	routine.requestChan <- req
 
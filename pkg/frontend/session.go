@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 )
@@ -32,6 +33,20 @@ type Session struct {
 
 	sessionVars config.SystemVariables
 
+	//max_execution_time, the timeout of the SELECT statement in milliseconds.
+	//zero means no timeout.
+	maxExecutionTime uint64
+
+	//user variables defined by SET @var = value, the names are in lower case
+	userVars map[string]tree.Expr
+
+	//prepared statements of the session, the statements of the binary protocol
+	//are keyed by their id
+	prepareStmts map[string]*PrepareStmt
+
+	//the id of the last prepared statement of the binary protocol
+	lastStmtId uint32
+
 	Pu *config.ParameterUnit
 }
 
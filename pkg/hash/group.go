@@ -357,9 +357,134 @@ func (g *Group) Fill(sels, matched []int64, vecs, gvecs []*vector.Vector, diffs
 					diffs[i] = diffs[i] || (gv != vs[sel])
 				}
 			}
-		case types.T_decimal:
+		case types.T_decimal64:
+			vec := vecs[i]
+			lnull := vec.Nsp.Any()
+			rnull := gvec.Nsp.Contains(uint64(g.Sel))
+			switch {
+			case lnull && rnull:
+				for i, sel := range sels {
+					if !vec.Nsp.Contains(uint64(sel)) { // only null eq null
+						diffs[i] = true
+					}
+				}
+			case lnull && !rnull: // null is not value
+				vs := vec.Col.([]types.Decimal64)
+				gv := gvec.Col.([]types.Decimal64)[g.Sel]
+				for i, sel := range sels {
+					if vec.Nsp.Contains(uint64(sel)) {
+						diffs[i] = true
+					} else {
+						diffs[i] = diffs[i] || (gv != vs[sel])
+					}
+				}
+			case !lnull && rnull: // null is not value
+				for i := range sels {
+					diffs[i] = true
+				}
+			default:
+				vs := vec.Col.([]types.Decimal64)
+				gv := gvec.Col.([]types.Decimal64)[g.Sel]
+				for i, sel := range sels {
+					diffs[i] = diffs[i] || (gv != vs[sel])
+				}
+			}
+		case types.T_decimal128:
+			vec := vecs[i]
+			lnull := vec.Nsp.Any()
+			rnull := gvec.Nsp.Contains(uint64(g.Sel))
+			switch {
+			case lnull && rnull:
+				for i, sel := range sels {
+					if !vec.Nsp.Contains(uint64(sel)) { // only null eq null
+						diffs[i] = true
+					}
+				}
+			case lnull && !rnull: // null is not value
+				vs := vec.Col.([]types.Decimal128)
+				gv := gvec.Col.([]types.Decimal128)[g.Sel]
+				for i, sel := range sels {
+					if vec.Nsp.Contains(uint64(sel)) {
+						diffs[i] = true
+					} else {
+						diffs[i] = diffs[i] || (gv != vs[sel])
+					}
+				}
+			case !lnull && rnull: // null is not value
+				for i := range sels {
+					diffs[i] = true
+				}
+			default:
+				vs := vec.Col.([]types.Decimal128)
+				gv := gvec.Col.([]types.Decimal128)[g.Sel]
+				for i, sel := range sels {
+					diffs[i] = diffs[i] || (gv != vs[sel])
+				}
+			}
 		case types.T_date:
+			vec := vecs[i]
+			lnull := vec.Nsp.Any()
+			rnull := gvec.Nsp.Contains(uint64(g.Sel))
+			switch {
+			case lnull && rnull:
+				for i, sel := range sels {
+					if !vec.Nsp.Contains(uint64(sel)) { // only null eq null
+						diffs[i] = true
+					}
+				}
+			case lnull && !rnull: // null is not value
+				vs := vec.Col.([]types.Date)
+				gv := gvec.Col.([]types.Date)[g.Sel]
+				for i, sel := range sels {
+					if vec.Nsp.Contains(uint64(sel)) {
+						diffs[i] = true
+					} else {
+						diffs[i] = diffs[i] || (gv != vs[sel])
+					}
+				}
+			case !lnull && rnull: // null is not value
+				for i := range sels {
+					diffs[i] = true
+				}
+			default:
+				vs := vec.Col.([]types.Date)
+				gv := gvec.Col.([]types.Date)[g.Sel]
+				for i, sel := range sels {
+					diffs[i] = diffs[i] || (gv != vs[sel])
+				}
+			}
 		case types.T_datetime:
+			vec := vecs[i]
+			lnull := vec.Nsp.Any()
+			rnull := gvec.Nsp.Contains(uint64(g.Sel))
+			switch {
+			case lnull && rnull:
+				for i, sel := range sels {
+					if !vec.Nsp.Contains(uint64(sel)) { // only null eq null
+						diffs[i] = true
+					}
+				}
+			case lnull && !rnull: // null is not value
+				vs := vec.Col.([]types.Datetime)
+				gv := gvec.Col.([]types.Datetime)[g.Sel]
+				for i, sel := range sels {
+					if vec.Nsp.Contains(uint64(sel)) {
+						diffs[i] = true
+					} else {
+						diffs[i] = diffs[i] || (gv != vs[sel])
+					}
+				}
+			case !lnull && rnull: // null is not value
+				for i := range sels {
+					diffs[i] = true
+				}
+			default:
+				vs := vec.Col.([]types.Datetime)
+				gv := gvec.Col.([]types.Datetime)[g.Sel]
+				for i, sel := range sels {
+					diffs[i] = diffs[i] || (gv != vs[sel])
+				}
+			}
 		case types.T_char, types.T_json, types.T_varchar:
 			vec := vecs[i]
 			lnull := vec.Nsp.Any()
@@ -64,10 +64,15 @@ func Rehash(count int, hs []uint64, vec *vector.Vector) {
 		for i := 0; i < count; i++ {
 			hs[i] = uint64(Memhash64(noescape(unsafe.Pointer(&vs[i])), uintptr(hs[i])))
 		}
-	case types.T_decimal:
-		vs := vec.Col.([]types.Decimal)
+	case types.T_decimal64:
+		vs := vec.Col.([]types.Decimal64)
 		for i := 0; i < count; i++ {
-			hs[i] = uint64(Memhash(unsafe.Pointer(&vs[i]), uintptr(hs[i]), uintptr(encoding.DecimalSize)))
+			hs[i] = uint64(Memhash64(noescape(unsafe.Pointer(&vs[i])), uintptr(hs[i])))
+		}
+	case types.T_decimal128:
+		vs := vec.Col.([]types.Decimal128)
+		for i := 0; i < count; i++ {
+			hs[i] = uint64(Memhash(unsafe.Pointer(&vs[i]), uintptr(hs[i]), uintptr(encoding.Decimal128Size)))
 		}
 	case types.T_float32:
 		vs := vec.Col.([]float32)
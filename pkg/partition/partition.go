@@ -264,9 +264,102 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 			diffs[i] = diffs[i] || (v != w)
 			v = w
 		}
-	case types.T_decimal:
+	case types.T_decimal64:
+		var n bool
+		var v types.Decimal64
+
+		vs := vec.Col.([]types.Decimal64)
+		if vec.Nsp.Any() {
+			for i, sel := range sels {
+				w := vs[sel]
+				isNull := vec.Nsp.Contains(uint64(sel))
+				if n != isNull {
+					diffs[i] = true
+				} else {
+					diffs[i] = diffs[i] || (v != vs[sel])
+				}
+				v = w
+				n = isNull
+			}
+			break
+		}
+		for i, sel := range sels {
+			w := vs[sel]
+			diffs[i] = diffs[i] || (v != w)
+			v = w
+		}
+	case types.T_decimal128:
+		var n bool
+		var v types.Decimal128
+
+		vs := vec.Col.([]types.Decimal128)
+		if vec.Nsp.Any() {
+			for i, sel := range sels {
+				w := vs[sel]
+				isNull := vec.Nsp.Contains(uint64(sel))
+				if n != isNull {
+					diffs[i] = true
+				} else {
+					diffs[i] = diffs[i] || (v != vs[sel])
+				}
+				v = w
+				n = isNull
+			}
+			break
+		}
+		for i, sel := range sels {
+			w := vs[sel]
+			diffs[i] = diffs[i] || (v != w)
+			v = w
+		}
 	case types.T_date:
+		var n bool
+		var v types.Date
+
+		vs := vec.Col.([]types.Date)
+		if vec.Nsp.Any() {
+			for i, sel := range sels {
+				w := vs[sel]
+				isNull := vec.Nsp.Contains(uint64(sel))
+				if n != isNull {
+					diffs[i] = true
+				} else {
+					diffs[i] = diffs[i] || (v != vs[sel])
+				}
+				v = w
+				n = isNull
+			}
+			break
+		}
+		for i, sel := range sels {
+			w := vs[sel]
+			diffs[i] = diffs[i] || (v != w)
+			v = w
+		}
 	case types.T_datetime:
+		var n bool
+		var v types.Datetime
+
+		vs := vec.Col.([]types.Datetime)
+		if vec.Nsp.Any() {
+			for i, sel := range sels {
+				w := vs[sel]
+				isNull := vec.Nsp.Contains(uint64(sel))
+				if n != isNull {
+					diffs[i] = true
+				} else {
+					diffs[i] = diffs[i] || (v != vs[sel])
+				}
+				v = w
+				n = isNull
+			}
+			break
+		}
+		for i, sel := range sels {
+			w := vs[sel]
+			diffs[i] = diffs[i] || (v != w)
+			v = w
+		}
 	case types.T_char, types.T_json, types.T_varchar:
 		var n bool
 		var v []byte
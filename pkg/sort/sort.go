@@ -17,6 +17,10 @@ package sort
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sort/asc/dates"
+	"github.com/matrixorigin/matrixone/pkg/sort/asc/datetimes"
+	"github.com/matrixorigin/matrixone/pkg/sort/asc/decimal128s"
+	"github.com/matrixorigin/matrixone/pkg/sort/asc/decimal64s"
 	"github.com/matrixorigin/matrixone/pkg/sort/asc/float32s"
 	"github.com/matrixorigin/matrixone/pkg/sort/asc/float64s"
 	"github.com/matrixorigin/matrixone/pkg/sort/asc/int16s"
@@ -28,6 +32,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sort/asc/uint64s"
 	"github.com/matrixorigin/matrixone/pkg/sort/asc/uint8s"
 	"github.com/matrixorigin/matrixone/pkg/sort/asc/varchar"
+	ddates "github.com/matrixorigin/matrixone/pkg/sort/desc/dates"
+	ddatetimes "github.com/matrixorigin/matrixone/pkg/sort/desc/datetimes"
+	ddecimal128s "github.com/matrixorigin/matrixone/pkg/sort/desc/decimal128s"
+	ddecimal64s "github.com/matrixorigin/matrixone/pkg/sort/desc/decimal64s"
 	dfloat32s "github.com/matrixorigin/matrixone/pkg/sort/desc/float32s"
 	dfloat64s "github.com/matrixorigin/matrixone/pkg/sort/desc/float64s"
 	dint16s "github.com/matrixorigin/matrixone/pkg/sort/desc/int16s"
@@ -103,6 +111,30 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			float64s.Sort(vec.Col.([]float64), os)
 		}
+	case types.T_decimal64:
+		if desc {
+			ddecimal64s.Sort(vec.Col.([]types.Decimal64), os)
+		} else {
+			decimal64s.Sort(vec.Col.([]types.Decimal64), os)
+		}
+	case types.T_decimal128:
+		if desc {
+			ddecimal128s.Sort(vec.Col.([]types.Decimal128), os)
+		} else {
+			decimal128s.Sort(vec.Col.([]types.Decimal128), os)
+		}
+	case types.T_date:
+		if desc {
+			ddates.Sort(vec.Col.([]types.Date), os)
+		} else {
+			dates.Sort(vec.Col.([]types.Date), os)
+		}
+	case types.T_datetime:
+		if desc {
+			ddatetimes.Sort(vec.Col.([]types.Datetime), os)
+		} else {
+			datetimes.Sort(vec.Col.([]types.Datetime), os)
+		}
 	case types.T_char, types.T_json, types.T_varchar:
 		if desc {
 			dvarchar.Sort(vec.Col.(*types.Bytes), os)
@@ -0,0 +1,137 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package build
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/alterTable"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/partition"
+	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
+)
+
+func (b *build) buildAlterTable(stmt *tree.AlterTable) (op.OP, error) {
+	_, _, r, err := b.tableName(&stmt.Table)
+	if err != nil {
+		return nil, err
+	}
+	// the attributes of the relation after each option is applied
+	attrs := make(map[string]metadata.Attribute)
+	for _, attr := range r.Attribute() {
+		attrs[attr.Name] = attr
+	}
+	// the partitions of the relation after each option is applied
+	pdef := r.Partition()
+	opts := make([]alterTable.Option, 0, len(stmt.Options))
+	for _, opt := range stmt.Options {
+		switch n := opt.(type) {
+		case *tree.AlterOptionAddColumn:
+			def, err := b.getTableDef(n.Column)
+			if err != nil {
+				return nil, err
+			}
+			attr := def.(*engine.AttributeDef).Attr
+			if _, ok := attrs[attr.Name]; ok {
+				return nil, sqlerror.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", attr.Name))
+			}
+			if attr.PrimaryKey {
+				return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("can't add '%s' as the primary key", attr.Name))
+			}
+			// the rows written before take the default value of the column
+			if !attr.HasDefaultExpr() {
+				return nil, sqlerror.New(errno.InvalidColumnDefinition, fmt.Sprintf("column '%s' added must have a default value", attr.Name))
+			}
+			attrs[attr.Name] = attr
+			opts = append(opts, alterTable.Option{Typ: alterTable.AddColumn, Def: def})
+		case *tree.AlterOptionDropColumn:
+			attr, ok := attrs[string(n.Name)]
+			if !ok {
+				return nil, sqlerror.New(errno.UndefinedColumn, fmt.Sprintf("Can't DROP '%s'; check that column exists", n.Name))
+			}
+			if attr.PrimaryKey {
+				return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("can't drop the primary key '%s'", n.Name))
+			}
+			if len(attrs) == 1 {
+				return nil, sqlerror.New(errno.InvalidTableDefinition, "You can't delete all columns with ALTER TABLE; use DROP TABLE instead")
+			}
+			if isPartitionField(pdef, attr.Name) {
+				return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("can't drop the partition field '%s'", n.Name))
+			}
+			delete(attrs, attr.Name)
+			opts = append(opts, alterTable.Option{Typ: alterTable.DropColumn, Def: &engine.AttributeDef{Attr: attr}})
+		case *tree.AlterOptionRenameColumn:
+			oldName, newName := string(n.OldName), string(n.NewName)
+			attr, ok := attrs[oldName]
+			if !ok {
+				return nil, sqlerror.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in '%s'", oldName, stmt.Table.ObjectName))
+			}
+			if _, ok := attrs[newName]; ok {
+				return nil, sqlerror.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", newName))
+			}
+			if isPartitionField(pdef, oldName) {
+				return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("can't rename the partition field '%s'", oldName))
+			}
+			delete(attrs, oldName)
+			attr.Name = newName
+			attrs[newName] = attr
+			opts = append(opts, alterTable.Option{Typ: alterTable.RenameColumn, OldName: oldName, NewName: newName})
+		case *tree.AlterOptionAddPartition:
+			if pdef == nil {
+				return nil, sqlerror.New(errno.InvalidTableDefinition, "Partition management on a not partitioned table is not possible")
+			}
+			var typ tree.PartitionType = &tree.ListType{}
+			if len(pdef.Range) > 0 {
+				typ = &tree.RangeType{}
+			}
+			def, err := buildPartitions(&engine.PartitionBy{Fields: pdef.Fields}, typ, n.Partitions, attrs)
+			if err != nil {
+				return nil, err
+			}
+			if pdef, err = partition.Add(pdef, def); err != nil {
+				return nil, sqlerror.New(errno.InvalidTableDefinition, err.Error())
+			}
+			opts = append(opts, alterTable.Option{Typ: alterTable.AddPartition, Partition: def})
+		case *tree.AlterOptionDropPartition:
+			if pdef == nil {
+				return nil, sqlerror.New(errno.InvalidTableDefinition, "Partition management on a not partitioned table is not possible")
+			}
+			for _, name := range n.Names {
+				if pdef, err = partition.Drop(pdef, string(name)); err != nil {
+					return nil, sqlerror.New(errno.InvalidTableDefinition, err.Error())
+				}
+				opts = append(opts, alterTable.Option{Typ: alterTable.DropPartition, PartitionName: string(name)})
+			}
+		default:
+			return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport alter table option: '%v'", opt))
+		}
+	}
+	return alterTable.New(r, opts), nil
+}
+
+func isPartitionField(pdef *engine.PartitionBy, name string) bool {
+	if pdef == nil {
+		return false
+	}
+	for _, field := range pdef.Fields {
+		if field == name {
+			return true
+		}
+	}
+	return false
+}
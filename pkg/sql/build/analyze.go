@@ -0,0 +1,39 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/analyzeTable"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (b *build) buildAnalyzeTable(stmt *tree.AnalyzeTable) (op.OP, error) {
+	names := make([]string, 0, len(stmt.Tables))
+	rs := make([]engine.Relation, 0, len(stmt.Tables))
+	for _, tbl := range stmt.Tables {
+		db, name, r, err := b.tableName(tbl)
+		if err != nil {
+			for _, r := range rs {
+				r.Close()
+			}
+			return nil, err
+		}
+		names = append(names, db+"."+name)
+		rs = append(rs, r)
+	}
+	return analyzeTable.New(names, rs), nil
+}
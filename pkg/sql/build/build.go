@@ -28,12 +28,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
-func New(db string, sql string, e engine.Engine, proc *process.Process) *build {
+func New(db string, sql string, e engine.Engine, proc *process.Process, exec Executor) *build {
 	return &build{
-		e:    e,
-		db:   db,
-		sql:  sql,
-		proc: proc,
+		e:          e,
+		db:         db,
+		sql:        sql,
+		exec:       exec,
+		proc:       proc,
+		subqueries: make(map[*tree.Subquery]*subquery),
 	}
 }
 
@@ -63,6 +65,10 @@ func (b *build) BuildStatement(stmt tree.Statement) (op.OP, error) {
 		return b.buildSelect(stmt.Select)
 	case *tree.Insert:
 		return b.buildInsert(stmt)
+	case *tree.Delete:
+		return b.buildDelete(stmt)
+	case *tree.Update:
+		return b.buildUpdate(stmt)
 	case *tree.DropTable:
 		return b.buildDropTable(stmt)
 	case *tree.DropDatabase:
@@ -79,10 +85,16 @@ func (b *build) BuildStatement(stmt tree.Statement) (op.OP, error) {
 		return b.buildShowDatabases(stmt)
 	case *tree.ShowColumns:
 		return b.buildShowColumns(stmt)
+	case *tree.ShowStats:
+		return b.buildShowStats(stmt)
+	case *tree.AnalyzeTable:
+		return b.buildAnalyzeTable(stmt)
 	case *tree.CreateIndex:
 		return b.buildCreateIndex(stmt)
 	case *tree.DropIndex:
 		return b.buildDropIndex(stmt)
+	case *tree.AlterTable:
+		return b.buildAlterTable(stmt)
 	}
 	return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unexpected statement: '%v'", stmt))
 }
@@ -0,0 +1,259 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+)
+
+// buildCase builds case into a multi extend whose arguments are the pairs of
+// a condition and its value followed by the else value if any, the simple
+// case `case x when y then ...` compares x with every y.
+func (b *build) buildCase(o op.OP, e *tree.CaseExpr, fn func(op.OP, tree.Expr) (extend.Extend, error)) (extend.Extend, error) {
+	var args []extend.Extend
+
+	for _, w := range e.Whens {
+		cond := w.Cond
+		if e.Expr != nil {
+			cond = tree.NewComparisonExpr(tree.EQUAL, e.Expr, w.Cond)
+		}
+		c, err := b.buildCondition(o, cond, fn)
+		if err != nil {
+			return nil, err
+		}
+		v, err := fn(o, w.Val)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, c, v)
+	}
+	if e.Else != nil {
+		v, err := fn(o, e.Else)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+	}
+	return &extend.MultiExtend{Op: overload.Case, Args: args}, nil
+}
+
+// caseExprs returns the subexpressions of case.
+func caseExprs(e *tree.CaseExpr) []tree.Expr {
+	var es []tree.Expr
+
+	if e.Expr != nil {
+		es = append(es, e.Expr)
+	}
+	for _, w := range e.Whens {
+		es = append(es, w.Cond, w.Val)
+	}
+	if e.Else != nil {
+		es = append(es, e.Else)
+	}
+	return es
+}
+
+// buildIf builds if(cond, x, y) into the case `case when cond then x else y end`.
+func (b *build) buildIf(o op.OP, e *tree.FuncExpr, fn func(op.OP, tree.Expr) (extend.Extend, error)) (extend.Extend, error) {
+	if len(e.Exprs) != 3 {
+		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, "incorrect parameter count in the call to function 'if'")
+	}
+	c, err := b.buildCondition(o, e.Exprs[0], fn)
+	if err != nil {
+		return nil, err
+	}
+	x, err := fn(o, e.Exprs[1])
+	if err != nil {
+		return nil, err
+	}
+	y, err := fn(o, e.Exprs[2])
+	if err != nil {
+		return nil, err
+	}
+	return &extend.MultiExtend{Op: overload.Case, Args: []extend.Extend{c, x, y}}, nil
+}
+
+// buildCondition builds a condition of case, which is evaluated into the rows
+// it selects. A condition that is not a predicate is true if it is not zero.
+func (b *build) buildCondition(o op.OP, n tree.Expr, fn func(op.OP, tree.Expr) (extend.Extend, error)) (extend.Extend, error) {
+	e, err := fn(o, n)
+	if err != nil {
+		return nil, err
+	}
+	if e = RewriteExtend(e); e.IsLogical() {
+		return e, nil
+	}
+	return &extend.BinaryExtend{Op: overload.NE, Left: e, Right: booleanValue(false)}, nil
+}
+
+// pruneCase removes the conditions which are constants, a true constant
+// makes its value the else value. The values are cast to their common type.
+func (b *build) pruneCase(e *extend.MultiExtend) (extend.Extend, error) {
+	var args []extend.Extend
+
+	els := len(e.Args)%2 == 1
+	for i := 0; i+1 < len(e.Args); i += 2 {
+		c, ok := e.Args[i].(*extend.ValueExtend)
+		if !ok {
+			args = append(args, e.Args[i], e.Args[i+1])
+			continue
+		}
+		if isTrueValue(c) {
+			args, els = append(args, e.Args[i+1]), true
+			break
+		}
+	}
+	if els && len(args)%2 == 0 {
+		args = append(args, e.Args[len(e.Args)-1])
+	}
+	switch {
+	case len(args) == 0:
+		return nullValue(), nil
+	case len(args) == 1:
+		return args[0], nil
+	}
+	var vs []extend.Extend
+	for i := 1; i < len(args); i += 2 {
+		vs = append(vs, args[i])
+	}
+	if len(args)%2 == 1 {
+		vs = append(vs, args[len(args)-1])
+	}
+	if err := unifyValues(vs); err != nil {
+		return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("%s in '%s'", err.Error(), e))
+	}
+	for i := 1; i < len(args); i += 2 {
+		args[i], vs = vs[0], vs[1:]
+	}
+	if len(args)%2 == 1 {
+		args[len(args)-1] = vs[0]
+	}
+	e.Args = args
+	return e, nil
+}
+
+// unifyValues casts the values of a conditional expression to their common
+// type. The type is decided by the values which are not constants, and a
+// constant is converted to it if possible, so that `case ... then price else 0
+// end` is still a decimal. The constant nulls are kept since a null has any type.
+func unifyValues(es []extend.Extend) error {
+	var typ types.Type
+
+	typ.Oid = types.T_any
+	for _, constant := range []bool{false, true} {
+		for _, e := range es {
+			if _, ok := e.(*extend.ValueExtend); ok != constant || isNullValue(e) {
+				continue
+			}
+			if constant && typ.Oid != types.T_any && convertConstant(e.(*extend.ValueExtend), typ.Oid) == nil {
+				continue
+			}
+			if typ.Oid == types.T_any {
+				typ = e.ReturnType().ToType()
+				continue
+			}
+			t, ok := valueType(typ.Oid, e.ReturnType())
+			if !ok {
+				return fmt.Errorf("cannot unify the types %s and %s", typ.Oid, e.ReturnType())
+			}
+			typ = t
+		}
+	}
+	for i, e := range es {
+		if isNullValue(e) || e.ReturnType() == typ.Oid {
+			continue
+		}
+		es[i] = &extend.BinaryExtend{
+			Op:    overload.Typecast,
+			Left:  e,
+			Right: &extend.ValueExtend{V: vector.New(typ)},
+		}
+	}
+	return nil
+}
+
+// convertConstant converts a constant to the type oid, it only converts the
+// constants whose value is kept by the conversion.
+func convertConstant(e *extend.ValueExtend, oid types.T) error {
+	src := e.V.Typ.Oid
+	switch {
+	case src == oid:
+		return nil
+	case oid == types.T_float64:
+		if src == types.T_int64 {
+			return toFloat64(e)
+		}
+	case types.IsDecimal(oid):
+		if src == types.T_int64 || src == types.T_float64 {
+			return toDecimal(e, oid)
+		}
+	case oid == types.T_char || oid == types.T_varchar:
+		return toString(e, oid)
+	case oid == types.T_date || oid == types.T_datetime:
+		if src == types.T_char || src == types.T_varchar {
+			return toDate(e, oid)
+		}
+	}
+	return fmt.Errorf("cannot convert %s to %s", src, oid)
+}
+
+// valueType returns the common type of two values of a conditional
+// expression. A decimal and an integer are unified into the decimal, and the
+// scale of the decimals is unified when evaluated.
+func valueType(lt, rt types.T) (types.Type, bool) {
+	switch {
+	case types.IsDecimal(lt) && types.IsDecimal(rt):
+		return types.T(types.T_decimal128).ToType(), true
+	case types.IsDecimal(lt) && isInteger(rt):
+		return lt.ToType(), true
+	case isInteger(lt) && types.IsDecimal(rt):
+		return rt.ToType(), true
+	case types.IsDecimal(lt) && isNumeric(rt), isNumeric(lt) && types.IsDecimal(rt):
+		return types.T(types.T_float64).ToType(), true
+	}
+	typ, err := unionType(lt, rt)
+	return typ, err == nil
+}
+
+func isInteger(t types.T) bool {
+	switch t {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	}
+	return false
+}
+
+// isTrueValue returns true if a constant condition is neither null nor zero.
+func isTrueValue(e *extend.ValueExtend) bool {
+	if e.V.Nsp.Contains(0) {
+		return false
+	}
+	switch vs := e.V.Col.(type) {
+	case []int64:
+		return vs[0] != 0
+	case []float64:
+		return vs[0] != 0
+	}
+	return false
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sqlerror"
 )
@@ -76,11 +77,11 @@ func (b *build) checkInnerJoin(r, s op.OP, rattrs, sattrs []string, expr tree.Ex
 
 		left, ok := e.Left.(*tree.UnresolvedName)
 		if !ok {
-			return nil, nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition %#v", expr))
+			return nil, nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition '%s'", tree.String(expr, dialect.MYSQL)))
 		}
 		right, ok := e.Right.(*tree.UnresolvedName)
 		if !ok {
-			return nil, nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition %#v", expr))
+			return nil, nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition '%s'", tree.String(expr, dialect.MYSQL)))
 		}
 		{
 			var err error
@@ -115,7 +116,7 @@ func (b *build) checkInnerJoin(r, s op.OP, rattrs, sattrs []string, expr tree.Ex
 		}
 		return rattrs, sattrs, nil
 	}
-	return nil, nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition %#v", expr))
+	return nil, nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition '%s'", tree.String(expr, dialect.MYSQL)))
 
 }
 
@@ -23,6 +23,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sqlerror"
 	"math"
+	"strconv"
+	"strings"
 )
 
 func Neg(x *extend.ValueExtend) (extend.Extend, error) {
@@ -582,3 +584,101 @@ func toChar(e *extend.ValueExtend) error {
 	}
 	return nil
 }
+
+// toString converts a numeric constant to a string of type oid, a null is
+// kept null.
+func toString(e *extend.ValueExtend, oid types.T) error {
+	var s string
+
+	switch e.V.Typ.Oid {
+	case types.T_char, types.T_varchar:
+		e.V.Typ.Oid = oid
+		return nil
+	case types.T_int64:
+		s = strconv.FormatInt(e.V.Col.([]int64)[0], 10)
+	case types.T_float64:
+		s = strconv.FormatFloat(e.V.Col.([]float64)[0], 'f', -1, 64)
+	default:
+		return sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("cannot convert %s to %s", e.V.Typ, oid))
+	}
+	vec := vector.New(types.Type{Oid: oid, Size: 24})
+	vec.Ref = 1
+	if e.V.Nsp.Contains(0) {
+		s = ""
+		vec.Nsp.Add(0)
+	}
+	vec.Col = &types.Bytes{
+		Data:    []byte(s),
+		Offsets: []uint32{0},
+		Lengths: []uint32{uint32(len(s))},
+	}
+	e.V = vec
+	return nil
+}
+
+// toDecimal converts a numeric constant to a decimal of type oid, whose scale
+// is the number of the fractional digits of the constant.
+func toDecimal(e *extend.ValueExtend, oid types.T) error {
+	var s string
+
+	switch e.V.Typ.Oid {
+	case types.T_int64:
+		s = strconv.FormatInt(e.V.Col.([]int64)[0], 10)
+	case types.T_float64:
+		s = strconv.FormatFloat(e.V.Col.([]float64)[0], 'f', -1, 64)
+	default:
+		return sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("cannot convert %s to %s", e.V.Typ, oid))
+	}
+	typ := oid.ToType()
+	if typ.Width = 18; oid == types.T_decimal128 {
+		typ.Width = 38
+	}
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		typ.Precision = int32(len(s) - i - 1)
+	}
+	v, err := buildConstantDecimal(typ, s)
+	if err != nil {
+		return err
+	}
+	vec := vector.New(typ)
+	vec.Ref = 1
+	switch d := v.(type) {
+	case types.Decimal64:
+		vec.Col = []types.Decimal64{d}
+	case types.Decimal128:
+		vec.Col = []types.Decimal128{d}
+	}
+	e.V = vec
+	return nil
+}
+
+// floatToDecimal converts a float constant mixed with a decimal operand into a
+// decimal of type oid, since a literal such as 1.5 is a decimal in mysql. The
+// constant is kept as it is if it doesn't fit in a decimal.
+func floatToDecimal(e *extend.ValueExtend, oid types.T) {
+	if e.V.Typ.Oid != types.T_float64 || e.V.Nsp.Contains(0) {
+		return
+	}
+	toDecimal(e, oid)
+}
+
+// toDate converts a string constant to a date or a datetime.
+func toDate(e *extend.ValueExtend, oid types.T) error {
+	if e.V.Typ.Oid != types.T_char && e.V.Typ.Oid != types.T_varchar {
+		return sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("cannot convert %s to %s", e.V.Typ, oid))
+	}
+	v, err := buildConstantDate(oid.ToType(), string(e.V.Col.(*types.Bytes).Get(0)))
+	if err != nil {
+		return err
+	}
+	vec := vector.New(oid.ToType())
+	vec.Ref = 1
+	switch d := v.(type) {
+	case types.Date:
+		vec.Col = []types.Date{d}
+	case types.Datetime:
+		vec.Col = []types.Datetime{d}
+	}
+	e.V = vec
+	return nil
+}
@@ -51,10 +51,18 @@ func (b *build) buildCreateTable(stmt *tree.CreateTable) (op.OP, error) {
 			defs = append(defs, def)
 		}
 	}
+	var pdef *engine.PartitionBy
 	if stmt.PartitionOption != nil {
-		return nil, sqlerror.New(errno.SQLStatementNotYetComplete, "partitionBy not yet complete")
+		attrs := make(map[string]metadata.Attribute)
+		for _, def := range defs {
+			attr := def.(*engine.AttributeDef).Attr
+			attrs[attr.Name] = attr
+		}
+		if pdef, err = buildPartitionBy(stmt.PartitionOption, attrs); err != nil {
+			return nil, err
+		}
 	}
-	return createTable.New(stmt.IfNotExists, tblName, defs, nil, db), nil
+	return createTable.New(stmt.IfNotExists, tblName, defs, pdef, db), nil
 }
 
 func (b *build) buildCreateDatabase(stmt *tree.CreateDatabase) (op.OP, error) {
@@ -127,6 +135,12 @@ func (b *build) getTableDefType(typ tree.ResolvableTypeReference) (*types.Type,
 			return &types.Type{Oid: types.T_float32, Size: 4, Width: n.InternalType.Width}, nil
 		case defines.MYSQL_TYPE_DOUBLE:
 			return &types.Type{Oid: types.T_float64, Size: 8, Width: n.InternalType.Width}, nil
+		case defines.MYSQL_TYPE_DECIMAL:
+			return getDecimalType(n)
+		case defines.MYSQL_TYPE_DATE:
+			return &types.Type{Oid: types.T_date, Size: 4}, nil
+		case defines.MYSQL_TYPE_DATETIME:
+			return &types.Type{Oid: types.T_datetime, Size: 8}, nil
 		case defines.MYSQL_TYPE_STRING:
 			if n.InternalType.DisplayWith == -1 { // type char
 				return &types.Type{Oid: types.T_char, Size: 24, Width: 1}, nil
@@ -134,11 +148,25 @@ func (b *build) getTableDefType(typ tree.ResolvableTypeReference) (*types.Type,
 			return &types.Type{Oid: types.T_char, Size: 24, Width: n.InternalType.DisplayWith}, nil
 		case defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_VARCHAR:
 			return &types.Type{Oid: types.T_varchar, Size: 24, Width: n.InternalType.DisplayWith}, nil
+		case defines.MYSQL_TYPE_JSON:
+			return &types.Type{Oid: types.T_json, Size: 24}, nil
 		}
 	}
 	return nil, sqlerror.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport type: '%v'", typ))
 }
 
+func getDecimalType(n *tree.T) (*types.Type, error) {
+	precision, scale := n.InternalType.DisplayWith, n.InternalType.Precision
+	if precision > types.MaxDecimal128Precision {
+		return nil, sqlerror.New(errno.InvalidColumnDefinition, fmt.Sprintf("too big precision %v specified, maximum is %v", precision, types.MaxDecimal128Precision))
+	}
+	if precision > 0 && scale > precision {
+		return nil, sqlerror.New(errno.InvalidColumnDefinition, fmt.Sprintf("scale %v must not be greater than precision %v", scale, precision))
+	}
+	typ := types.DecimalType(precision, scale)
+	return &typ, nil
+}
+
 func (b *build) tableInfo(stmt tree.TableExpr) (string, string, error) {
 	tbl, ok := stmt.(tree.TableName)
 	if !ok {
@@ -189,6 +217,18 @@ func getDefaultExprFromColumnDef(column *tree.ColumnTableDef, typ *types.Type) (
 			if _ ,err = rangeCheck(value, *typ, "", 0); err != nil { // value out of range
 				return metadata.EmptyDefaultExpr, sqlerror.New(errno.InvalidColumnDefinition, fmt.Sprintf("Invalid default value for '%s'", column.Name.Parts[0]))
 			}
+			// decimal and date default values are kept in their text form, so that they
+			// survive the serialization of the table definition.
+			switch v := value.(type) {
+			case types.Decimal64:
+				value = v.Format(typ.Precision)
+			case types.Decimal128:
+				value = v.Format(typ.Precision)
+			case types.Date:
+				value = v.String()
+			case types.Datetime:
+				value = v.String()
+			}
 			return metadata.MakeDefaultExpr(true, value, false), nil
 		}
 	}
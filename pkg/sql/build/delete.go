@@ -0,0 +1,88 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package build
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/delete"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+)
+
+func (b *build) buildDelete(stmt *tree.Delete) (op.OP, error) {
+	if stmt.OrderBy != nil || stmt.Limit != nil {
+		return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport clause: '%v'", stmt))
+	}
+	tbl, ok := mutationTable(stmt.Table)
+	if !ok {
+		return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table: '%v'", stmt.Table))
+	}
+	s := len(tbl.SchemaName) == 0
+	db, id, r, err := b.tableName(tbl)
+	if err != nil {
+		return nil, err
+	}
+	if stmt.Where == nil {
+		return delete.New(id, db, nil, r), nil
+	}
+	o, err := b.getTable(s, db, id)
+	if err != nil {
+		return nil, err
+	}
+	e, ok, err := b.buildMutationCondition(o, stmt.Where)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return delete.New(id, db, e, r), nil
+}
+
+// mutationTable returns the name of the table modified by a DELETE or
+// UPDATE statement, only a single table without alias is supported.
+func mutationTable(stmt tree.TableExpr) (*tree.TableName, bool) {
+	switch stmt := stmt.(type) {
+	case *tree.TableName:
+		return stmt, true
+	case *tree.AliasedTableExpr:
+		if len(stmt.As.Alias) > 0 {
+			return nil, false
+		}
+		return mutationTable(stmt.Expr)
+	}
+	return nil, false
+}
+
+// buildMutationCondition returns the extend selecting the rows modified by a
+// DELETE or UPDATE statement, the extend is nil if all the rows are selected
+// and ok is false if no row is selected.
+func (b *build) buildMutationCondition(o op.OP, stmt *tree.Where) (extend.Extend, bool, error) {
+	e, err := b.buildExtend(o, stmt.Expr)
+	if err != nil {
+		return nil, false, err
+	}
+	if v, ok := e.(*extend.ValueExtend); ok {
+		switch v.V.Typ.Oid {
+		case types.T_int64:
+			return nil, v.V.Col.([]int64)[0] != 0, nil
+		case types.T_float64:
+			return nil, v.V.Col.([]float64)[0] != 0, nil
+		default:
+			return nil, false, nil
+		}
+	}
+	return e, true, nil
+}
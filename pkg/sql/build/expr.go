@@ -17,6 +17,7 @@ package build
 import (
 	"errors"
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -24,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sqlerror"
 	"go/constant"
@@ -75,21 +77,37 @@ func (b *build) hasAggregate(n tree.Expr) bool {
 	case *tree.ComparisonExpr:
 		return b.hasAggregate(e.Left) || b.hasAggregate(e.Right)
 	case *tree.FuncExpr:
+		if e.WindowSpec != nil {
+			return false
+		}
 		if name, ok := e.Func.FunctionReference.(*tree.UnresolvedName); ok {
 			if _, ok = AggFuncs[name.Parts[0]]; ok {
 				return true
 			}
 		}
+		for _, expr := range e.Exprs {
+			if b.hasAggregate(expr) {
+				return true
+			}
+		}
 	case *tree.CastExpr:
 		return b.hasAggregate(e.Expr)
 	case *tree.RangeCond:
 		return b.hasAggregate(e.Left) || b.hasAggregate(e.From) || b.hasAggregate(e.To)
+	case *tree.CaseExpr:
+		for _, expr := range caseExprs(e) {
+			if b.hasAggregate(expr) {
+				return true
+			}
+		}
 	}
 	return false
 }
 
 func (b *build) buildExpr(o op.OP, n tree.Expr) (extend.Extend, error) {
 	switch e := n.(type) {
+	case *tree.ParamExpr:
+		return nil, sqlerror.New(errno.UndefinedParameter, fmt.Sprintf("no value bound to the parameter %v", e.Offset))
 	case *tree.NumVal:
 		return buildValue(e.Value)
 	case *tree.ParenExpr:
@@ -131,13 +149,23 @@ func (b *build) buildExpr(o op.OP, n tree.Expr) (extend.Extend, error) {
 	case *tree.Tuple:
 		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
 	case *tree.FuncExpr:
-		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
+		return b.buildFunc(o, e, b.buildExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(o, e, b.buildExpr)
 	case *tree.IsNullExpr:
-		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
+		ext, err := b.buildExpr(o, e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return buildIsNull(overload.IsNull, ext, n)
 	case *tree.IsNotNullExpr:
-		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
+		ext, err := b.buildExpr(o, e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return buildIsNull(overload.IsNotNull, ext, n)
 	case *tree.Subquery:
-		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
+		return b.buildSubquery(o, e)
 	case *tree.CastExpr:
 		left, err := b.buildExpr(o, e.Expr)
 		if err != nil {
@@ -163,9 +191,24 @@ func (b *build) buildExpr(o op.OP, n tree.Expr) (extend.Extend, error) {
 		case defines.MYSQL_TYPE_DOUBLE:
 			typ.Size = 8
 			typ.Oid = types.T_float64
+		case defines.MYSQL_TYPE_DECIMAL:
+			dt, err := getDecimalType(e.Type.(*tree.T))
+			if err != nil {
+				return nil, err
+			}
+			typ = *dt
+		case defines.MYSQL_TYPE_DATE:
+			typ.Size = 4
+			typ.Oid = types.T_date
+		case defines.MYSQL_TYPE_DATETIME:
+			typ.Size = 8
+			typ.Oid = types.T_datetime
 		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
 			typ.Size = 24
 			typ.Oid = types.T_varchar
+		case defines.MYSQL_TYPE_JSON:
+			typ.Size = 24
+			typ.Oid = types.T_json
 		default:
 			return nil, sqlerror.New(errno.IndeterminateDatatype, fmt.Sprintf("'%v' is not support now", n))
 		}
@@ -223,6 +266,8 @@ func (b *build) buildExpr(o op.OP, n tree.Expr) (extend.Extend, error) {
 
 func (b *build) buildExprWithoutCheck(o op.OP, n tree.Expr) (extend.Extend, error) {
 	switch e := n.(type) {
+	case *tree.ParamExpr:
+		return nil, sqlerror.New(errno.UndefinedParameter, fmt.Sprintf("no value bound to the parameter %v", e.Offset))
 	case *tree.NumVal:
 		return buildValue(e.Value)
 	case *tree.ParenExpr:
@@ -272,20 +317,33 @@ func (b *build) buildExprWithoutCheck(o op.OP, n tree.Expr) (extend.Extend, erro
 		if !ok {
 			return nil, sqlerror.New(errno.SyntaxError, fmt.Sprintf("illegal expression '%s'", e))
 		}
-		if _, ok := e.Exprs[0].(*tree.NumVal); ok {
+		if _, ok := AggFuncs[name.Parts[0]]; !ok {
+			return b.buildFunc(o, e, b.buildExprWithoutCheck)
+		}
+		if _, ok := e.Exprs[0].(*tree.NumVal); ok && name.Parts[0] != "group_concat" {
 			return &extend.Attribute{Name: "count(*)"}, nil
 		}
-		ext, err := b.buildExprWithoutCheck(o, e.Exprs[0])
+		ext, err := b.buildAggregateArg(o, name.Parts[0], e, b.buildExprWithoutCheck)
 		if err != nil {
 			return nil, err
 		}
-		return &extend.Attribute{Name: fmt.Sprintf("%s(%s)", name.Parts[0], ext)}, nil
+		return &extend.Attribute{Name: aggregateName(name.Parts[0], e, ext.String())}, nil
+	case *tree.CaseExpr:
+		return b.buildCase(o, e, b.buildExprWithoutCheck)
 	case *tree.IsNullExpr:
-		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
+		ext, err := b.buildExprWithoutCheck(o, e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return buildIsNull(overload.IsNull, ext, n)
 	case *tree.IsNotNullExpr:
-		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
+		ext, err := b.buildExprWithoutCheck(o, e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return buildIsNull(overload.IsNotNull, ext, n)
 	case *tree.Subquery:
-		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
+		return b.buildSubquery(o, e)
 	case *tree.CastExpr:
 		left, err := b.buildExprWithoutCheck(o, e.Expr)
 		if err != nil {
@@ -311,9 +369,24 @@ func (b *build) buildExprWithoutCheck(o op.OP, n tree.Expr) (extend.Extend, erro
 		case defines.MYSQL_TYPE_DOUBLE:
 			typ.Size = 8
 			typ.Oid = types.T_float64
+		case defines.MYSQL_TYPE_DECIMAL:
+			dt, err := getDecimalType(e.Type.(*tree.T))
+			if err != nil {
+				return nil, err
+			}
+			typ = *dt
+		case defines.MYSQL_TYPE_DATE:
+			typ.Size = 4
+			typ.Oid = types.T_date
+		case defines.MYSQL_TYPE_DATETIME:
+			typ.Size = 8
+			typ.Oid = types.T_datetime
 		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
 			typ.Size = 24
 			typ.Oid = types.T_varchar
+		case defines.MYSQL_TYPE_JSON:
+			typ.Size = 24
+			typ.Oid = types.T_json
 		default:
 			return nil, sqlerror.New(errno.IndeterminateDatatype, fmt.Sprintf("'%v' is not support now", n))
 		}
@@ -514,6 +587,8 @@ func (b *build) buildComparisonWithoutCheck(o op.OP, e *tree.ComparisonExpr) (ex
 			return nil, err
 		}
 		return &extend.BinaryExtend{Op: overload.NE, Left: left, Right: right}, nil
+	case tree.IN, tree.NOT_IN:
+		return b.buildIn(o, e)
 	}
 	return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e.Op))
 }
@@ -670,6 +745,8 @@ func (b *build) buildComparison(o op.OP, e *tree.ComparisonExpr) (extend.Extend,
 			return nil, err
 		}
 		return &extend.BinaryExtend{Op: overload.Like, Left: left, Right: right}, nil
+	case tree.IN, tree.NOT_IN:
+		return b.buildIn(o, e)
 	}
 	return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e.Op.ToString()))
 }
@@ -741,6 +818,10 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 				return val * -1, nil
 			case float64:
 				return val * -1, nil
+			case types.Decimal64:
+				return val * -1, nil
+			case types.Decimal128:
+				return val.Neg(), nil
 			}
 			return v, nil
 		}
@@ -851,6 +932,8 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 			return float32(floatResult), nil
 		case types.T_float64:
 			return floatResult, nil
+		case types.T_decimal64, types.T_decimal128:
+			return buildConstantDecimal(typ, strconv.FormatFloat(floatResult, 'f', -1, 64))
 		default:
 			return nil, errors.New(fmt.Sprintf("unexpected return type '%v' for binary expression '%v'", typ, e.Op))
 		}
@@ -901,6 +984,8 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 				return float64(-v), nil
 			}
 			return float64(v), nil
+		case types.T_decimal64, types.T_decimal128:
+			return buildConstantDecimal(typ, str)
 		}
 	case constant.Float:
 		switch typ.Oid {
@@ -955,18 +1040,95 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 				return float64(-v), nil
 			}
 			return float64(v), nil
+		case types.T_decimal64, types.T_decimal128:
+			return buildConstantDecimal(typ, str)
 		}
 	case constant.String:
 		if !num.Negative() {
 			switch typ.Oid {
 			case types.T_char, types.T_varchar:
 				return constant.StringVal(val), nil
+			case types.T_decimal64, types.T_decimal128:
+				return buildConstantDecimal(typ, constant.StringVal(val))
+			case types.T_date, types.T_datetime:
+				return buildConstantDate(typ, constant.StringVal(val))
+			case types.T_json:
+				return buildConstantJson(constant.StringVal(val))
 			}
 		}
 	}
 	return nil, sqlerror.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport value: %v", val))
 }
 
+// buildConstantDecimal parses s as a decimal of type typ, the digits beyond
+// the scale of typ are rounded.
+func buildConstantDecimal(typ types.Type, s string) (interface{}, error) {
+	switch typ.Oid {
+	case types.T_decimal64:
+		v, err := types.ParseStringToDecimal64(s, typ.Width, typ.Precision)
+		if err != nil {
+			if err == types.ErrDecimalOverflow {
+				return nil, errConstantOutRange
+			}
+			return nil, sqlerror.New(errno.DataException, fmt.Sprintf("incorrect decimal value: '%s'", s))
+		}
+		return v, nil
+	case types.T_decimal128:
+		v, err := types.ParseStringToDecimal128(s, typ.Width, typ.Precision)
+		if err != nil {
+			if err == types.ErrDecimalOverflow {
+				return nil, errConstantOutRange
+			}
+			return nil, sqlerror.New(errno.DataException, fmt.Sprintf("incorrect decimal value: '%s'", s))
+		}
+		return v, nil
+	}
+	return nil, sqlerror.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport decimal type: %v", typ))
+}
+
+// buildConstantDate parses s as a date or a datetime.
+func buildConstantDate(typ types.Type, s string) (interface{}, error) {
+	if typ.Oid == types.T_date {
+		v, err := types.ParseDate(s)
+		if err != nil {
+			return nil, sqlerror.New(errno.DataException, fmt.Sprintf("incorrect date value: '%s'", s))
+		}
+		return v, nil
+	}
+	v, err := types.ParseDatetime(s)
+	if err != nil {
+		return nil, sqlerror.New(errno.DataException, fmt.Sprintf("incorrect datetime value: '%s'", s))
+	}
+	return v, nil
+}
+
+// buildConstantJson parses s as a json document and returns its binary encoding.
+func buildConstantJson(s string) (interface{}, error) {
+	bj, err := bytejson.ParseFromString(s)
+	if err != nil {
+		return nil, sqlerror.New(errno.DataException, fmt.Sprintf("invalid JSON text: '%s'", s))
+	}
+	return string(bj), nil
+}
+
+// buildIsNull builds is null and is not null of ext. A predicate can't be the
+// operand as its result only keeps the rows where it is true.
+func buildIsNull(op int, ext extend.Extend, n tree.Expr) (extend.Extend, error) {
+	if ext.ReturnType() == types.T_sel {
+		return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("'%s' is not support now, the operand of is [not] null can't be a predicate", tree.String(n, dialect.MYSQL)))
+	}
+	return &extend.MultiExtend{Op: op, Args: []extend.Extend{ext}}, nil
+}
+
+// nullValue returns the constant null, which is typed as a bigint.
+func nullValue() extend.Extend {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Ref = 1
+	vec.Col = []int64{0}
+	vec.Nsp.Add(0)
+	return &extend.ValueExtend{V: vec}
+}
+
 func buildValue(val constant.Value) (extend.Extend, error) {
 	switch val.Kind() {
 	case constant.Int:
@@ -991,6 +1153,8 @@ func buildValue(val constant.Value) (extend.Extend, error) {
 			Lengths: []uint32{uint32(len(v))},
 		}
 		return &extend.ValueExtend{V: vec}, nil
+	case constant.Unknown:
+		return nullValue(), nil
 	default:
 		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport value: %v", val))
 	}
@@ -0,0 +1,212 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/dateadd"
+	"strings"
+)
+
+var ScalarFuncs map[string]int = map[string]int{
+	"substring":         overload.Substring,
+	"concat":            overload.Concat,
+	"upper":             overload.Upper,
+	"ucase":             overload.Upper,
+	"lower":             overload.Lower,
+	"lcase":             overload.Lower,
+	"trim":              overload.Trim,
+	"ltrim":             overload.LTrim,
+	"rtrim":             overload.RTrim,
+	"length":            overload.Length,
+	"abs":               overload.Abs,
+	"round":             overload.Round,
+	"floor":             overload.Floor,
+	"ceil":              overload.Ceil,
+	"ceiling":           overload.Ceil,
+	"coalesce":          overload.Coalesce,
+	"ifnull":            overload.IfNull,
+	"date_add":          overload.DateAdd,
+	"date_sub":          overload.DateSub,
+	"year":              overload.Year,
+	"month":             overload.Month,
+	"day":               overload.Day,
+	"dayofmonth":        overload.Day,
+	"now":               overload.Now,
+	"current_timestamp": overload.Now,
+	"localtime":         overload.Now,
+	"localtimestamp":    overload.Now,
+	"json_extract":      overload.JsonExtract,
+	"json_unquote":      overload.JsonUnquote,
+	"json_contains":     overload.JsonContains,
+	"json_length":       overload.JsonLength,
+	"json_keys":         overload.JsonKeys,
+	"json_valid":        overload.JsonValid,
+}
+
+// castFuncs are the functions which cast their argument, they also build the
+// literals DATE 'str' and TIMESTAMP 'str'.
+var castFuncs = map[string]types.Type{
+	"date":      {Oid: types.T_date, Size: 4},
+	"timestamp": {Oid: types.T_datetime, Size: 8},
+}
+
+// stringArgs is the number of the leading arguments of the string functions
+// which are strings, -1 for all the arguments. The arguments of other types
+// are cast to varchar, as concat(1, 'a') is '1a' in mysql.
+var stringArgs = map[int]int{
+	overload.Substring: 1,
+	overload.Concat:    -1,
+	overload.Upper:     1,
+	overload.Lower:     1,
+	overload.Trim:      1,
+	overload.LTrim:     1,
+	overload.RTrim:     1,
+	overload.Length:    1,
+}
+
+var intervalUnits = map[tree.IntervalType]int{
+	tree.INTERVAL_TYPE_MICROSECOND: dateadd.MicroSecond,
+	tree.INTERVAL_TYPE_SECOND:      dateadd.Second,
+	tree.INTERVAL_TYPE_MINUTE:      dateadd.Minute,
+	tree.INTERVAL_TYPE_HOUR:        dateadd.Hour,
+	tree.INTERVAL_TYPE_DAY:         dateadd.Day,
+	tree.INTERVAL_TYPE_WEEK:        dateadd.Week,
+	tree.INTERVAL_TYPE_MONTH:       dateadd.Month,
+	tree.INTERVAL_TYPE_QUARTER:     dateadd.Quarter,
+	tree.INTERVAL_TYPE_YEAR:        dateadd.Year,
+}
+
+// buildFunc builds a scalar function into a multi extend, fn is used to build its arguments.
+func (b *build) buildFunc(o op.OP, e *tree.FuncExpr, fn func(op.OP, tree.Expr) (extend.Extend, error)) (extend.Extend, error) {
+	name, ok := e.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return nil, sqlerror.New(errno.SyntaxError, fmt.Sprintf("illegal expression '%s'", e))
+	}
+	name.Parts[0] = strings.ToLower(name.Parts[0])
+	if _, ok := AggFuncs[name.Parts[0]]; ok {
+		return nil, sqlerror.New(errno.GroupingError, fmt.Sprintf("invalid use of aggregate function '%s'", e))
+	}
+	if typ, ok := castFuncs[name.Parts[0]]; ok {
+		if len(e.Exprs) != 1 {
+			return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("incorrect parameter count in the call to function '%s'", name.Parts[0]))
+		}
+		arg, err := fn(o, e.Exprs[0])
+		if err != nil {
+			return nil, err
+		}
+		return &extend.BinaryExtend{
+			Op:    overload.Typecast,
+			Left:  arg,
+			Right: &extend.ValueExtend{V: vector.New(typ)},
+		}, nil
+	}
+	if name.Parts[0] == "if" {
+		return b.buildIf(o, e, fn)
+	}
+	fop, ok := ScalarFuncs[name.Parts[0]]
+	if !ok {
+		return nil, sqlerror.New(errno.UndefinedFunction, fmt.Sprintf("unimplemented function '%s'", name.Parts[0]))
+	}
+	// the interval of date_add is built into two arguments
+	if fop == overload.DateAdd || fop == overload.DateSub {
+		return b.buildDateAdd(o, fop, e, fn)
+	}
+	if min, max, ok := overload.MultiArgs(fop); ok {
+		if len(e.Exprs) < min || (max >= 0 && len(e.Exprs) > max) {
+			return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("incorrect parameter count in the call to function '%s'", name.Parts[0]))
+		}
+	}
+	args := make([]extend.Extend, len(e.Exprs))
+	for i, expr := range e.Exprs {
+		arg, err := fn(o, expr)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = arg
+	}
+	if n, ok := stringArgs[fop]; ok {
+		for i, arg := range args {
+			if n >= 0 && i >= n {
+				break
+			}
+			arg, err := castToString(arg)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = arg
+		}
+	}
+	return &extend.MultiExtend{Op: fop, Args: args}, nil
+}
+
+// castToString casts an argument of a string function to varchar, nulls
+// and strings are kept as they are and the constants are converted here.
+func castToString(e extend.Extend) (extend.Extend, error) {
+	switch e.ReturnType() {
+	case types.T_char, types.T_varchar, types.T_any:
+		return e, nil
+	}
+	if v, ok := e.(*extend.ValueExtend); ok {
+		if err := convertConstant(v, types.T_varchar); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+	return &extend.BinaryExtend{
+		Op:    overload.Typecast,
+		Left:  e,
+		Right: &extend.ValueExtend{V: vector.New(types.Type{Oid: types.T_varchar, Size: 24})},
+	}, nil
+}
+
+// buildDateAdd builds date_add(expr, interval n unit) into a multi extend with
+// the arguments expr, n and the unit of the interval.
+func (b *build) buildDateAdd(o op.OP, fop int, e *tree.FuncExpr, fn func(op.OP, tree.Expr) (extend.Extend, error)) (extend.Extend, error) {
+	if len(e.Exprs) != 2 {
+		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("incorrect parameter count in the call to function '%s'", overload.OpName[fop]))
+	}
+	interval, ok := e.Exprs[1].(*tree.IntervalExpr)
+	if !ok {
+		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%s' expects an interval but got '%s'", overload.OpName[fop], e.Exprs[1]))
+	}
+	unit, ok := intervalUnits[interval.Type]
+	if !ok {
+		return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("interval unit '%s' is not support now", interval.Type.ToString()))
+	}
+	x, err := fn(o, e.Exprs[0])
+	if err != nil {
+		return nil, err
+	}
+	n, err := fn(o, interval.Expr)
+	if err != nil {
+		return nil, err
+	}
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Ref = 1
+	vec.Col = []int64{int64(unit)}
+	return &extend.MultiExtend{
+		Op:   fop,
+		Args: []extend.Extend{x, n, &extend.ValueExtend{V: vec}},
+	}, nil
+}
@@ -15,19 +15,17 @@
 package build
 
 import (
-	"fmt"
-	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/op"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/group"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
-	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+	"strings"
 )
 
-func (b *build) buildGroupBy(o op.OP, ns tree.SelectExprs, grs tree.GroupBy, where *tree.Where) (op.OP, error) {
+func (b *build) buildGroupBy(o op.OP, ns tree.SelectExprs, grs tree.GroupBy, where, having *tree.Where) (op.OP, error) {
 	var err error
 	var fs []*tree.FuncExpr
 	var gs []*extend.Attribute
@@ -43,8 +41,9 @@ func (b *build) buildGroupBy(o op.OP, ns tree.SelectExprs, grs tree.GroupBy, whe
 				return nil, err
 			}
 		}
+		keys := make(map[string]string)
 		{
-			for _, g := range grs {
+			for _, g := range groupByAlias(o, ns, grs) {
 				e, err := b.buildExtend(o, g)
 				if err != nil {
 					return nil, err
@@ -57,10 +56,18 @@ func (b *build) buildGroupBy(o op.OP, ns tree.SelectExprs, grs tree.GroupBy, whe
 					Name: e.String(),
 					Type: e.ReturnType(),
 				})
+				if _, ok := g.(*tree.UnresolvedName); !ok {
+					keys[tree.String(g, dialect.MYSQL)] = e.String()
+				}
 			}
 		}
 		for i, n := range ns {
-			if ns[i].Expr, err = b.stripAggregate(o, n.Expr, &fs, &pes, mp, mq); err != nil {
+			if ns[i].Expr, err = b.stripAggregate(o, substituteGroupKeys(n.Expr, keys), &fs, &pes, mp, mq); err != nil {
+				return nil, err
+			}
+		}
+		if having != nil {
+			if having.Expr, err = b.stripAggregate(o, substituteGroupKeys(having.Expr, keys), &fs, &pes, mp, mq); err != nil {
 				return nil, err
 			}
 		}
@@ -75,48 +82,62 @@ func (b *build) buildGroupBy(o op.OP, ns tree.SelectExprs, grs tree.GroupBy, whe
 			}
 		}
 	}
-	for _, f := range fs {
-		name, ok := f.Func.FunctionReference.(*tree.UnresolvedName)
-		if !ok {
-			return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("illegal expression '%s'", f))
+	if es, err = b.buildAggregates(o, prev, fs); err != nil {
+		return nil, err
+	}
+	if o, err = group.New(o, gs, es); err != nil {
+		return nil, err
+	}
+	if having != nil {
+		if o, err = b.buildHaving(o, ns, having); err != nil || o == nil {
+			return nil, err
 		}
-		op, ok := AggFuncs[name.Parts[0]]
-		if !ok {
-			return nil, sqlerror.New(errno.UndefinedFunction, fmt.Sprintf("unimplemented aggregated functions '%s'", name.Parts[0]))
+	}
+	return b.buildProjection(o, ns)
+}
+
+// groupByAlias replaces the names of group by which are aliases of the
+// projection ns rather than columns of o by the expressions of the aliases.
+func groupByAlias(o op.OP, ns tree.SelectExprs, grs tree.GroupBy) tree.GroupBy {
+	attrs := o.Attribute()
+	for i, g := range grs {
+		e, ok := g.(*tree.UnresolvedName)
+		if !ok || e.NumParts != 1 {
+			continue
 		}
-		switch e := f.Exprs[0].(type) {
-		case *tree.NumVal:
-			alias := "count(*)"
-			agg, err := newAggregate(op, types.Type{Oid: types.T_int64, Size: 8})
-			if err != nil {
-				return nil, err
-			}
-			es = append(es, aggregation.Extend{
-				Agg:   agg,
-				Alias: alias,
-				Name:  prev.ResultColumns()[0],
-				Op:    aggregation.StarCount,
-			})
-		case *tree.UnresolvedName:
-			alias := fmt.Sprintf("%s(%s)", name.Parts[0], e.Parts[0])
-			typ, ok := o.Attribute()[e.Parts[0]]
-			if !ok {
-				return nil, sqlerror.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s' in aggregation", e.Parts[0]))
-			}
-			agg, err := newAggregate(op, typ)
-			if err != nil {
-				return nil, err
+		if _, ok := attrs[e.Parts[0]]; ok {
+			continue
+		}
+		for _, n := range ns {
+			if string(n.As) == e.Parts[0] {
+				grs[i] = n.Expr
+				break
 			}
-			es = append(es, aggregation.Extend{
-				Op:    op,
-				Agg:   agg,
-				Alias: alias,
-				Name:  e.Parts[0],
-			})
 		}
 	}
-	if o, err = group.New(o, gs, es); err != nil {
-		return nil, err
+	return grs
+}
+
+// substituteGroupKeys replaces the expressions of n which are grouping keys
+// by the names of the keys, the arguments of the aggregates are left as they are.
+func substituteGroupKeys(n tree.Expr, keys map[string]string) tree.Expr {
+	if len(keys) == 0 {
+		return n
 	}
-	return b.buildProjection(o, ns)
+	return substitute(n, func(n tree.Expr) (tree.Expr, bool) {
+		switch e := n.(type) {
+		case *tree.UnresolvedName:
+			return nil, false
+		case *tree.FuncExpr:
+			if name, ok := e.Func.FunctionReference.(*tree.UnresolvedName); ok {
+				if _, ok := AggFuncs[strings.ToLower(name.Parts[0])]; ok {
+					return n, true
+				}
+			}
+		}
+		if name, ok := keys[tree.String(n, dialect.MYSQL)]; ok {
+			return &tree.UnresolvedName{NumParts: 1, Parts: tree.NameParts{name}}, true
+		}
+		return nil, false
+	})
 }
@@ -0,0 +1,98 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildHaving builds the restrict of having over o, the output of a group or a summarize.
+// The aggregates of having have been stripped together with the projection ns, so that
+// having only refers to the grouping attributes, the aggregates and the aliases of ns.
+func (b *build) buildHaving(o op.OP, ns tree.SelectExprs, having *tree.Where) (op.OP, error) {
+	mp := make(map[string]tree.Expr)
+	{
+		attrs := o.Attribute()
+		for _, n := range ns {
+			if _, ok := attrs[string(n.As)]; !ok && len(n.As) > 0 {
+				mp[string(n.As)] = n.Expr
+			}
+		}
+	}
+	return b.buildWhere(o, &tree.Where{Type: having.Type, Expr: substituteAlias(having.Expr, mp)})
+}
+
+// substituteAlias replaces the names of n which are aliases of the projection by their expressions.
+func substituteAlias(n tree.Expr, mp map[string]tree.Expr) tree.Expr {
+	return substitute(n, func(n tree.Expr) (tree.Expr, bool) {
+		if e, ok := n.(*tree.UnresolvedName); ok && e.NumParts == 1 {
+			expr, ok := mp[e.Parts[0]]
+			return expr, ok
+		}
+		return nil, false
+	})
+}
+
+// substitute replaces the subexpressions of n for which fn reports true by
+// the expressions returned by fn.
+func substitute(n tree.Expr, fn func(tree.Expr) (tree.Expr, bool)) tree.Expr {
+	if expr, ok := fn(n); ok {
+		return expr
+	}
+	switch e := n.(type) {
+	case *tree.ParenExpr:
+		e.Expr = substitute(e.Expr, fn)
+	case *tree.OrExpr:
+		e.Left, e.Right = substitute(e.Left, fn), substitute(e.Right, fn)
+	case *tree.NotExpr:
+		e.Expr = substitute(e.Expr, fn)
+	case *tree.AndExpr:
+		e.Left, e.Right = substitute(e.Left, fn), substitute(e.Right, fn)
+	case *tree.UnaryExpr:
+		e.Expr = substitute(e.Expr, fn)
+	case *tree.BinaryExpr:
+		e.Left, e.Right = substitute(e.Left, fn), substitute(e.Right, fn)
+	case *tree.ComparisonExpr:
+		e.Left, e.Right = substitute(e.Left, fn), substitute(e.Right, fn)
+	case *tree.IsNullExpr:
+		e.Expr = substitute(e.Expr, fn)
+	case *tree.IsNotNullExpr:
+		e.Expr = substitute(e.Expr, fn)
+	case *tree.FuncExpr:
+		for i := range e.Exprs {
+			e.Exprs[i] = substitute(e.Exprs[i], fn)
+		}
+		for _, ord := range e.OrderBy {
+			ord.Expr = substitute(ord.Expr, fn)
+		}
+	case *tree.CastExpr:
+		e.Expr = substitute(e.Expr, fn)
+	case *tree.RangeCond:
+		e.Left = substitute(e.Left, fn)
+		e.From, e.To = substitute(e.From, fn), substitute(e.To, fn)
+	case *tree.CaseExpr:
+		if e.Expr != nil {
+			e.Expr = substitute(e.Expr, fn)
+		}
+		for _, w := range e.Whens {
+			w.Cond, w.Val = substitute(w.Cond, fn), substitute(w.Val, fn)
+		}
+		if e.Else != nil {
+			e.Else = substitute(e.Else, fn)
+		}
+	}
+	return n
+}
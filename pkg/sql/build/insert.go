@@ -308,7 +308,95 @@ func (b *build) buildInsert(stmt *tree.Insert) (op.OP, error) {
 			if err := vec.Append(vs); err != nil {
 				return nil, err
 			}
-		case types.T_char, types.T_varchar:
+		case types.T_decimal64:
+			vs := make([]types.Decimal64, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return nil, err
+					}
+					if v == nil {
+						vec.Nsp.Add(uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.Decimal64), vec.Typ, bat.Attrs[i], j + 1); err != nil {
+							return nil, err
+						} else {
+							vs[j] = vv.(types.Decimal64)
+						}
+					}
+				}
+			}
+			if err := vec.Append(vs); err != nil {
+				return nil, err
+			}
+		case types.T_decimal128:
+			vs := make([]types.Decimal128, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return nil, err
+					}
+					if v == nil {
+						vec.Nsp.Add(uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.Decimal128), vec.Typ, bat.Attrs[i], j + 1); err != nil {
+							return nil, err
+						} else {
+							vs[j] = vv.(types.Decimal128)
+						}
+					}
+				}
+			}
+			if err := vec.Append(vs); err != nil {
+				return nil, err
+			}
+		case types.T_date:
+			vs := make([]types.Date, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return nil, err
+					}
+					if v == nil {
+						vec.Nsp.Add(uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.Date), vec.Typ, bat.Attrs[i], j + 1); err != nil {
+							return nil, err
+						} else {
+							vs[j] = vv.(types.Date)
+						}
+					}
+				}
+			}
+			if err := vec.Append(vs); err != nil {
+				return nil, err
+			}
+		case types.T_datetime:
+			vs := make([]types.Datetime, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return nil, err
+					}
+					if v == nil {
+						vec.Nsp.Add(uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.Datetime), vec.Typ, bat.Attrs[i], j + 1); err != nil {
+							return nil, err
+						} else {
+							vs[j] = vv.(types.Datetime)
+						}
+					}
+				}
+			}
+			if err := vec.Append(vs); err != nil {
+				return nil, err
+			}
+		case types.T_char, types.T_varchar, types.T_json:
 			vs := make([][]byte, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
@@ -359,7 +447,15 @@ func (b *build) buildInsert(stmt *tree.Insert) (op.OP, error) {
 			vec.Col = make([]float32, len(rows.Rows))
 		case types.T_float64:
 			vec.Col = make([]float64, len(rows.Rows))
-		case types.T_char, types.T_varchar:
+		case types.T_decimal64:
+			vec.Col = make([]types.Decimal64, len(rows.Rows))
+		case types.T_decimal128:
+			vec.Col = make([]types.Decimal128, len(rows.Rows))
+		case types.T_date:
+			vec.Col = make([]types.Date, len(rows.Rows))
+		case types.T_datetime:
+			vec.Col = make([]types.Datetime, len(rows.Rows))
+		case types.T_char, types.T_varchar, types.T_json:
 			col := &types.Bytes{}
 			if err = col.Append(make([][]byte, len(rows.Rows))); err != nil {
 				return nil, err
@@ -448,12 +544,34 @@ func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber
 			return nil, errors.New("unexpected type and value")
 		}
 		return nil, sqlerror.New(errno.DataException, fmt.Sprintf(errString, columnName, rowNumber))
+	case types.Decimal64:
+		if typ.Oid == types.T_decimal64 {
+			return v, nil
+		}
+		return nil, errors.New("unexpected type and value")
+	case types.Decimal128:
+		if typ.Oid == types.T_decimal128 {
+			return v, nil
+		}
+		return nil, errors.New("unexpected type and value")
+	case types.Date:
+		if typ.Oid == types.T_date {
+			return v, nil
+		}
+		return nil, errors.New("unexpected type and value")
+	case types.Datetime:
+		if typ.Oid == types.T_datetime {
+			return v, nil
+		}
+		return nil, errors.New("unexpected type and value")
 	case string:
 		switch typ.Oid {
 		case types.T_char, types.T_varchar: // string family should compare the length but not value
 			if len(v) <= int(typ.Width) {
 				return v, nil
 			}
+		case types.T_json: // json values are validated and encoded by buildConstant
+			return v, nil
 		default:
 			return nil, errors.New("unexpected type and value")
 		}
@@ -553,6 +671,9 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 			res := value.(float64)
 			str := strconv.FormatFloat(res, 'f', 10, 64)
 			return tree.NewNumVal(constant.MakeFloat64(res), str, res < 0)
+		case types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
+			res := value.(string)
+			return tree.NewNumVal(constant.MakeString(res), res, false)
 		case types.T_char, types.T_varchar:
 			res := value.(string)
 			return tree.NewNumVal(constant.MakeString(res), res, false)
@@ -16,11 +16,15 @@ package build
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/op"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/innerJoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/naturalJoin"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/outerJoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/product"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sqlerror"
 )
@@ -34,15 +38,15 @@ func (b *build) buildJoin(stmt *tree.JoinTableExpr) (op.OP, error) {
 	if err != nil {
 		return nil, err
 	}
-	{
-		switch stmt.JoinType {
-		case tree.JOIN_TYPE_FULL:
-			return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join type '%v'", stmt.JoinType))
-		case tree.JOIN_TYPE_LEFT:
-			return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join type '%v'", stmt.JoinType))
-		case tree.JOIN_TYPE_RIGHT:
-			return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join type '%v'", stmt.JoinType))
-		}
+	switch stmt.JoinType {
+	case tree.JOIN_TYPE_FULL:
+		return b.buildOuterJoin(outerJoin.Full, r, s, stmt.Cond)
+	case tree.JOIN_TYPE_LEFT:
+		return b.buildOuterJoin(outerJoin.Left, r, s, stmt.Cond)
+	case tree.JOIN_TYPE_RIGHT:
+		return b.buildOuterJoin(outerJoin.Right, r, s, stmt.Cond)
+	case tree.JOIN_TYPE_NATURAL_LEFT, tree.JOIN_TYPE_NATURAL_RIGHT, tree.JOIN_TYPE_NATURAL_FULL:
+		return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join type '%v'", stmt.JoinType))
 	}
 	if stmt.Cond == nil {
 		if err := b.checkProduct(r, s); err != nil {
@@ -66,3 +70,86 @@ func (b *build) buildJoin(stmt *tree.JoinTableExpr) (op.OP, error) {
 		return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join condition '%v'", cond))
 	}
 }
+
+func (b *build) buildOuterJoin(typ int, r, s op.OP, cond tree.JoinCond) (op.OP, error) {
+	var err error
+	var rattrs, sattrs []string
+
+	on, ok := cond.(*tree.OnJoinCond)
+	if !ok {
+		return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join condition '%v'", cond))
+	}
+	eqs, es := splitJoinCond(r, s, on.Expr, nil, nil)
+	if len(eqs) == 0 {
+		return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join condition '%s' without an equality between the two relations", tree.String(on.Expr, dialect.MYSQL)))
+	}
+	for _, eq := range eqs {
+		if rattrs, sattrs, err = b.checkInnerJoin(r, s, rattrs, sattrs, eq); err != nil {
+			return nil, err
+		}
+	}
+	o := outerJoin.New(typ, r, s, rattrs, sattrs)
+	if len(es) == 0 {
+		return o, nil
+	}
+	// the other conditions are evaluated by the join before the rows without a match are padded
+	expr := es[0]
+	for _, e := range es[1:] {
+		expr = tree.NewAndExpr(expr, e)
+	}
+	e, err := b.buildExtend(o, expr)
+	if err != nil {
+		return nil, err
+	}
+	switch v := e.(type) {
+	case *extend.ValueExtend:
+		if !isTrueValue(v) {
+			o.Cond = v
+		}
+	case *extend.Attribute:
+		o.Cond = v
+	default:
+		if e.ReturnType() != types.T_sel {
+			return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join condition '%s'", tree.String(expr, dialect.MYSQL)))
+		}
+		o.Cond = e
+	}
+	return o, nil
+}
+
+// splitJoinCond splits the conjunctions of a join condition into the equalities
+// between an attribute of r and an attribute of s, and the other conditions.
+func splitJoinCond(r, s op.OP, expr tree.Expr, eqs, es []tree.Expr) ([]tree.Expr, []tree.Expr) {
+	switch e := expr.(type) {
+	case *tree.ParenExpr:
+		return splitJoinCond(r, s, e.Expr, eqs, es)
+	case *tree.AndExpr:
+		eqs, es = splitJoinCond(r, s, e.Left, eqs, es)
+		return splitJoinCond(r, s, e.Right, eqs, es)
+	case *tree.ComparisonExpr:
+		if e.Op == tree.EQUAL && isJoinEquality(r, s, e) {
+			return append(eqs, e), es
+		}
+	}
+	return eqs, append(es, expr)
+}
+
+func isJoinEquality(r, s op.OP, e *tree.ComparisonExpr) bool {
+	left, ok := e.Left.(*tree.UnresolvedName)
+	if !ok {
+		return false
+	}
+	right, ok := e.Right.(*tree.UnresolvedName)
+	if !ok {
+		return false
+	}
+	lr, ls, _, err := getJoinAttribute(r, s, left)
+	if err != nil {
+		return false
+	}
+	rr, rs, _, err := getJoinAttribute(r, s, right)
+	if err != nil {
+		return false
+	}
+	return (len(lr) > 0 && len(rs) > 0) || (len(ls) > 0 && len(rr) > 0)
+}
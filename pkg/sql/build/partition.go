@@ -0,0 +1,274 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/partition"
+	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
+)
+
+// buildPartitionBy converts the partition option of CREATE TABLE into the
+// partition definition of the table, only columns can be used as the
+// partition fields.
+func buildPartitionBy(opt *tree.PartitionOption, attrs map[string]metadata.Attribute) (*engine.PartitionBy, error) {
+	var err error
+	var fields []string
+
+	if opt.SubPartBy != nil {
+		return nil, sqlerror.New(errno.FeatureNotSupported, "subpartition is not supported")
+	}
+	isHash := false
+	switch n := opt.PartBy.PType.(type) {
+	case *tree.RangeType:
+		fields, err = partitionFields(n.Expr, n.ColumnList)
+	case *tree.ListType:
+		fields, err = partitionFields(n.Expr, n.ColumnList)
+	case *tree.HashType:
+		isHash = true
+		fields, err = partitionFields(n.Expr, nil)
+	case *tree.KeyType:
+		isHash = true
+		fields, err = partitionFields(nil, n.ColumnList)
+	default:
+		return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("unsupport partition type: '%v'", opt.PartBy.PType))
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range fields {
+		attr, ok := attrs[field]
+		if !ok {
+			return nil, sqlerror.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in 'partition function'", field))
+		}
+		if attr.Type.Oid == types.T_json {
+			return nil, sqlerror.New(errno.InvalidTableDefinition, fmt.Sprintf("Field '%s' is of a not allowed type for this type of partitioning", field))
+		}
+	}
+	def := &engine.PartitionBy{Fields: fields}
+	if isHash {
+		num := int(opt.PartBy.Num)
+		if len(opt.Partitions) > 0 {
+			if num > 0 && num != len(opt.Partitions) {
+				return nil, sqlerror.New(errno.InvalidTableDefinition, "Wrong number of partitions defined, mismatch with previous setting")
+			}
+			for _, p := range opt.Partitions {
+				if p.Values != nil {
+					return nil, sqlerror.New(errno.InvalidTableDefinition, "Only RANGE/LIST PARTITIONING can use VALUES in partition definition")
+				}
+				if len(p.Subs) > 0 {
+					return nil, sqlerror.New(errno.FeatureNotSupported, "subpartition is not supported")
+				}
+				def.Hash = append(def.Hash, string(p.Name))
+			}
+		} else {
+			if num == 0 {
+				num = 1
+			}
+			for i := 0; i < num; i++ {
+				def.Hash = append(def.Hash, fmt.Sprintf("p%v", i))
+			}
+		}
+	} else {
+		if opt.PartBy.Num > 0 && int(opt.PartBy.Num) != len(opt.Partitions) {
+			return nil, sqlerror.New(errno.InvalidTableDefinition, "Wrong number of partitions defined, mismatch with previous setting")
+		}
+		if def, err = buildPartitions(def, opt.PartBy.PType, opt.Partitions, attrs); err != nil {
+			return nil, err
+		}
+	}
+	if err := partition.Validate(def); err != nil {
+		return nil, sqlerror.New(errno.InvalidTableDefinition, err.Error())
+	}
+	return def, nil
+}
+
+// buildPartitions converts the range or list partitions, of the type of
+// partitions of typ, into the partitions of def.
+func buildPartitions(def *engine.PartitionBy, typ tree.PartitionType, ps []*tree.Partition, attrs map[string]metadata.Attribute) (*engine.PartitionBy, error) {
+	_, isRange := typ.(*tree.RangeType)
+	if len(ps) == 0 {
+		if isRange {
+			return nil, sqlerror.New(errno.InvalidTableDefinition, "For RANGE partitions each partition must be defined")
+		}
+		return nil, sqlerror.New(errno.InvalidTableDefinition, "For LIST partitions each partition must be defined")
+	}
+	for _, p := range ps {
+		if len(p.Subs) > 0 {
+			return nil, sqlerror.New(errno.FeatureNotSupported, "subpartition is not supported")
+		}
+		switch v := p.Values.(type) {
+		case *tree.ValuesLessThan:
+			if !isRange {
+				return nil, sqlerror.New(errno.InvalidTableDefinition, "Only RANGE PARTITIONING can use VALUES LESS THAN in partition definition")
+			}
+			es, err := partitionBound(def.Fields, v.ValueList, attrs)
+			if err != nil {
+				return nil, err
+			}
+			def.Range = append(def.Range, engine.RangePartition{Name: string(p.Name), To: es})
+		case *tree.ValuesIn:
+			if isRange {
+				return nil, sqlerror.New(errno.InvalidTableDefinition, "Only LIST PARTITIONING can use VALUES IN in partition definition")
+			}
+			var es []extend.Extend
+			for _, vl := range v.ValueList {
+				if len(vl) != len(def.Fields) {
+					return nil, sqlerror.New(errno.InvalidTableDefinition, "Inconsistency in usage of column lists for partitioning")
+				}
+				for i, e := range vl {
+					ve, err := partitionValue(attrs[def.Fields[i]], e)
+					if err != nil {
+						return nil, err
+					}
+					es = append(es, ve)
+				}
+			}
+			def.List = append(def.List, engine.ListPartition{Name: string(p.Name), Extends: es})
+		default:
+			if isRange {
+				return nil, sqlerror.New(errno.InvalidTableDefinition, "RANGE PARTITIONING requires definition of VALUES LESS THAN for each partition")
+			}
+			return nil, sqlerror.New(errno.InvalidTableDefinition, "LIST PARTITIONING requires definition of VALUES IN for each partition")
+		}
+	}
+	return def, nil
+}
+
+// partitionBound returns the bound of a range partition, which is cut off
+// at the first MAXVALUE.
+func partitionBound(fields []string, vl tree.Exprs, attrs map[string]metadata.Attribute) ([]extend.Extend, error) {
+	if len(vl) == 1 {
+		if _, ok := vl[0].(*tree.MaxValue); ok {
+			return nil, nil
+		}
+	}
+	if len(vl) != len(fields) {
+		return nil, sqlerror.New(errno.InvalidTableDefinition, "Inconsistency in usage of column lists for partitioning")
+	}
+	var es []extend.Extend
+	for i, e := range vl {
+		if _, ok := e.(*tree.MaxValue); ok {
+			break
+		}
+		ve, err := partitionValue(attrs[fields[i]], e)
+		if err != nil {
+			return nil, err
+		}
+		es = append(es, ve)
+	}
+	return es, nil
+}
+
+func partitionFields(e tree.Expr, cols []*tree.UnresolvedName) ([]string, error) {
+	if e != nil {
+		if p, ok := e.(*tree.ParenExpr); ok {
+			return partitionFields(p.Expr, nil)
+		}
+		col, ok := e.(*tree.UnresolvedName)
+		if !ok {
+			return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("partition function '%s' is not supported, only columns can be used", tree.String(e, dialect.MYSQL)))
+		}
+		cols = []*tree.UnresolvedName{col}
+	}
+	fields := make([]string, len(cols))
+	for i, col := range cols {
+		fields[i] = col.Parts[0]
+	}
+	return fields, nil
+}
+
+// partitionValue returns a value extend of one row holding the constant
+// converted into the type of the attribute.
+func partitionValue(attr metadata.Attribute, e tree.Expr) (*extend.ValueExtend, error) {
+	var one interface{}
+
+	typ := attr.Type
+	v, err := buildConstant(typ, e)
+	if err != nil {
+		return nil, err
+	}
+	if v != nil {
+		if v, err = rangeCheck(v, typ, attr.Name, 1); err != nil {
+			return nil, err
+		}
+	}
+	switch typ.Oid {
+	case types.T_int8:
+		x, _ := v.(int8)
+		one = []int8{x}
+	case types.T_int16:
+		x, _ := v.(int16)
+		one = []int16{x}
+	case types.T_int32:
+		x, _ := v.(int32)
+		one = []int32{x}
+	case types.T_int64:
+		x, _ := v.(int64)
+		one = []int64{x}
+	case types.T_uint8:
+		x, _ := v.(uint8)
+		one = []uint8{x}
+	case types.T_uint16:
+		x, _ := v.(uint16)
+		one = []uint16{x}
+	case types.T_uint32:
+		x, _ := v.(uint32)
+		one = []uint32{x}
+	case types.T_uint64:
+		x, _ := v.(uint64)
+		one = []uint64{x}
+	case types.T_float32:
+		x, _ := v.(float32)
+		one = []float32{x}
+	case types.T_float64:
+		x, _ := v.(float64)
+		one = []float64{x}
+	case types.T_decimal64:
+		x, _ := v.(types.Decimal64)
+		one = []types.Decimal64{x}
+	case types.T_decimal128:
+		x, _ := v.(types.Decimal128)
+		one = []types.Decimal128{x}
+	case types.T_date:
+		x, _ := v.(types.Date)
+		one = []types.Date{x}
+	case types.T_datetime:
+		x, _ := v.(types.Datetime)
+		one = []types.Datetime{x}
+	case types.T_char, types.T_varchar:
+		x, _ := v.(string)
+		one = [][]byte{[]byte(x)}
+	default:
+		return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("unsupport partition field type: '%s'", typ))
+	}
+	vec := vector.New(typ)
+	if err := vec.Append(one); err != nil {
+		return nil, err
+	}
+	if v == nil {
+		vec.Nsp.Add(0)
+	}
+	return &extend.ValueExtend{V: vec}, nil
+}
@@ -70,21 +70,54 @@ func (b *build) checkProjectionExpr(n tree.Expr, attrs []string) []string {
 		attrs = b.checkProjectionExpr(e.Left, attrs)
 		return b.checkProjectionExpr(e.Right, attrs)
 	case *tree.Tuple:
+		for _, expr := range e.Exprs {
+			attrs = b.checkProjectionExpr(expr, attrs)
+		}
+		return attrs
+	case *tree.Subquery:
+		ns, err := b.outerColumns(e)
+		if err != nil {
+			return attrs
+		}
+		for _, name := range ns {
+			attrs = b.checkProjectionExpr(name, attrs)
+		}
 		return attrs
 	case *tree.FuncExpr:
+		// a function without arguments such as now() has a value for each row
+		if e.WindowSpec != nil || len(e.Exprs) == 0 {
+			return append(attrs, "*")
+		}
 		if name, ok := e.Func.FunctionReference.(*tree.UnresolvedName); ok {
 			name.Parts[0] = strings.ToLower(name.Parts[0])
 			if op, ok := AggFuncs[name.Parts[0]]; ok {
 				if op == aggregation.StarCount {
 					attrs = append(attrs, "*")
 				} else {
-					attrs = b.checkProjectionExpr(e.Exprs[0], attrs)
+					for _, expr := range aggregateExprs(name.Parts[0], e) {
+						attrs = b.checkProjectionExpr(expr, attrs)
+					}
 				}
+				return attrs
 			}
 		}
+		for _, expr := range e.Exprs {
+			attrs = b.checkProjectionExpr(expr, attrs)
+		}
+		return attrs
+	case *tree.IntervalExpr:
+		return b.checkProjectionExpr(e.Expr, attrs)
+	case *tree.CaseExpr:
+		for _, expr := range caseExprs(e) {
+			attrs = b.checkProjectionExpr(expr, attrs)
+		}
 		return attrs
 	case *tree.CastExpr:
 		return b.checkProjectionExpr(e.Expr, attrs)
+	case *tree.IsNullExpr:
+		return b.checkProjectionExpr(e.Expr, attrs)
+	case *tree.IsNotNullExpr:
+		return b.checkProjectionExpr(e.Expr, attrs)
 	case *tree.UnresolvedName:
 		if e.NumParts == 1 {
 			attrs = append(attrs, e.Parts[0])
@@ -222,6 +255,56 @@ func (b *build) extractExtend(o op.OP, n tree.Expr, es *[]*projection.Extend, mp
 		return nil
 	case *tree.CastExpr:
 		return b.extractExtend(o, e.Expr, es, mp)
+	case *tree.IsNullExpr:
+		return b.extractExtend(o, e.Expr, es, mp)
+	case *tree.IsNotNullExpr:
+		return b.extractExtend(o, e.Expr, es, mp)
+	case *tree.FuncExpr:
+		for _, expr := range e.Exprs {
+			if err := b.extractExtend(o, expr, es, mp); err != nil {
+				return err
+			}
+		}
+		if e.WindowSpec != nil {
+			for _, expr := range e.WindowSpec.PartitionBy {
+				if err := b.extractExtend(o, expr, es, mp); err != nil {
+					return err
+				}
+			}
+			for _, ord := range e.WindowSpec.OrderBy {
+				if err := b.extractExtend(o, ord.Expr, es, mp); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	case *tree.IntervalExpr:
+		return b.extractExtend(o, e.Expr, es, mp)
+	case *tree.CaseExpr:
+		for _, expr := range caseExprs(e) {
+			if err := b.extractExtend(o, expr, es, mp); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *tree.Tuple:
+		for _, expr := range e.Exprs {
+			if err := b.extractExtend(o, expr, es, mp); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *tree.Subquery:
+		ns, err := b.outerColumns(e)
+		if err != nil {
+			return err
+		}
+		for _, name := range ns {
+			if err := b.extractExtend(o, name, es, mp); err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 	return nil
 }
@@ -56,6 +56,14 @@ func (b *build) pruneExtend(e extend.Extend, isProjection bool) (extend.Extend,
 		if n.Right, err = b.pruneExtend(n.Right, isProjection); err != nil {
 			return nil, err
 		}
+		if isNullValue(n.Left) || isNullValue(n.Right) {
+			switch {
+			case isComparison(n.Op):
+				return booleanValue(false), nil // a comparison with null is never true
+			case isArithmetic(n.Op):
+				return nullValue(), nil
+			}
+		}
 		switch n.Op {
 		case overload.Or:
 			return b.pruneOr(n)
@@ -86,10 +94,45 @@ func (b *build) pruneExtend(e extend.Extend, isProjection bool) (extend.Extend,
 		case overload.Like:
 			return b.pruneLike(n)
 		}
+	case *extend.MultiExtend:
+		for i := range n.Args {
+			if n.Args[i], err = b.pruneExtend(n.Args[i], isProjection); err != nil {
+				return nil, err
+			}
+		}
+		switch n.Op {
+		case overload.Coalesce, overload.IfNull:
+			return b.pruneCoalesce(n)
+		case overload.IsNull, overload.IsNotNull:
+			return b.pruneIsNull(n)
+		case overload.Case:
+			return b.pruneCase(n)
+		}
 	}
 	return e, nil
 }
 
+func isComparison(op int) bool {
+	switch op {
+	case overload.EQ, overload.NE, overload.LT, overload.LE, overload.GT, overload.GE, overload.Like:
+		return true
+	}
+	return false
+}
+
+func isArithmetic(op int) bool {
+	switch op {
+	case overload.Plus, overload.Minus, overload.Mult, overload.Div, overload.IntegerDiv, overload.Mod:
+		return true
+	}
+	return false
+}
+
+func isNullValue(e extend.Extend) bool {
+	v, ok := e.(*extend.ValueExtend)
+	return ok && v.V.Nsp.Contains(0)
+}
+
 func (b *build) pruneNot(e *extend.UnaryExtend) (extend.Extend, error) {
 	cnt := 1
 	ext := e.E
@@ -108,6 +151,9 @@ func (b *build) pruneNot(e *extend.UnaryExtend) (extend.Extend, error) {
 	if !ok {
 		return e, nil
 	}
+	if isNullValue(v) { // not null is null
+		return v, nil
+	}
 	ok = false // set Zero
 	switch v.V.Typ.Oid {
 	case types.T_int64:
@@ -132,6 +178,9 @@ func (b *build) pruneUnaryMinus(e *extend.UnaryExtend) (extend.Extend, error) {
 	if !ok {
 		return e, nil
 	}
+	if isNullValue(v) {
+		return v, nil
+	}
 	return Neg(v)
 }
 
@@ -322,6 +371,11 @@ func (b *build) pruneDiv(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(re); err != nil {
 				return nil, err
 			}
+		case types.T_decimal64, types.T_decimal128:
+			// the scale of a decimal is only known at runtime, so the
+			// constant is left to the typecast rules of the operator
+			// except that a float is taken as a decimal literal.
+			floatToDecimal(re, e.Left.ReturnType())
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -373,6 +427,8 @@ func (b *build) pruneDiv(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(le); err != nil {
 				return nil, err
 			}
+		case types.T_decimal64, types.T_decimal128:
+			floatToDecimal(le, e.Right.ReturnType())
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -430,6 +486,7 @@ func (b *build) pruneMod(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(re); err != nil {
 				return nil, err
 			}
+		case types.T_decimal64, types.T_decimal128:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -481,6 +538,7 @@ func (b *build) pruneMod(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(le); err != nil {
 				return nil, err
 			}
+		case types.T_decimal64, types.T_decimal128:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -535,6 +593,8 @@ func (b *build) pruneMul(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(re); err != nil {
 				return nil, err
 			}
+		case types.T_decimal64, types.T_decimal128:
+			floatToDecimal(re, e.Left.ReturnType())
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -583,6 +643,8 @@ func (b *build) pruneMul(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(le); err != nil {
 				return nil, err
 			}
+		case types.T_decimal64, types.T_decimal128:
+			floatToDecimal(le, e.Right.ReturnType())
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -637,6 +699,8 @@ func (b *build) prunePlus(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(re); err != nil {
 				return nil, err
 			}
+		case types.T_decimal64, types.T_decimal128:
+			floatToDecimal(re, e.Left.ReturnType())
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -685,6 +749,8 @@ func (b *build) prunePlus(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(le); err != nil {
 				return nil, err
 			}
+		case types.T_decimal64, types.T_decimal128:
+			floatToDecimal(le, e.Right.ReturnType())
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -739,6 +805,8 @@ func (b *build) pruneMinus(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(re); err != nil {
 				return nil, err
 			}
+		case types.T_decimal64, types.T_decimal128:
+			floatToDecimal(re, e.Left.ReturnType())
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -787,6 +855,8 @@ func (b *build) pruneMinus(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toFloat64(le); err != nil {
 				return nil, err
 			}
+		case types.T_decimal64, types.T_decimal128:
+			floatToDecimal(le, e.Right.ReturnType())
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -845,7 +915,7 @@ func (b *build) pruneEq(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(re); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -898,7 +968,7 @@ func (b *build) pruneEq(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(le); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -957,7 +1027,7 @@ func (b *build) pruneNe(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(re); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1010,7 +1080,7 @@ func (b *build) pruneNe(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(le); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1069,7 +1139,7 @@ func (b *build) pruneLt(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(re); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1122,7 +1192,7 @@ func (b *build) pruneLt(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(le); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1181,7 +1251,7 @@ func (b *build) pruneLe(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(re); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1234,7 +1304,7 @@ func (b *build) pruneLe(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(le); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1293,7 +1363,7 @@ func (b *build) pruneGt(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(re); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1346,7 +1416,7 @@ func (b *build) pruneGt(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(le); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1405,7 +1475,7 @@ func (b *build) pruneGe(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(re); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1458,7 +1528,7 @@ func (b *build) pruneGe(e *extend.BinaryExtend) (extend.Extend, error) {
 			if err := toChar(le); err != nil {
 				return nil, err
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime:
 		default:
 			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1494,4 +1564,111 @@ func (b *build) pruneLike(e *extend.BinaryExtend) (extend.Extend, error) {
 		return &extend.ValueExtend{V: vec}, nil
 	}
 	return e, nil
-}
\ No newline at end of file
+}
+
+// pruneCoalesce removes the null arguments of coalesce and ifnull, and
+// converts their constant arguments to the type of the first non-constant argument.
+func (b *build) pruneCoalesce(e *extend.MultiExtend) (extend.Extend, error) {
+	args := e.Args[:0]
+	for _, arg := range e.Args {
+		if !isNullValue(arg) {
+			args = append(args, arg)
+		}
+	}
+	switch {
+	case len(args) == 0:
+		return nullValue(), nil
+	case len(args) == 1:
+		return args[0], nil
+	}
+	if _, ok := args[0].(*extend.ValueExtend); ok {
+		return args[0], nil
+	}
+	e.Args = args
+	var es []extend.Extend
+	for _, arg := range e.Args {
+		if _, ok := arg.(*extend.ValueExtend); !ok {
+			es = append(es, arg)
+		}
+	}
+	if err := unifyValues(es); err != nil {
+		return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("%s in '%s'", err.Error(), e))
+	}
+	for i, arg := range e.Args {
+		if _, ok := arg.(*extend.ValueExtend); !ok {
+			e.Args[i], es = es[0], es[1:]
+		}
+	}
+	typ := e.Args[0].ReturnType()
+	for _, arg := range e.Args {
+		v, ok := arg.(*extend.ValueExtend)
+		if !ok || v.V.Typ.Oid == typ {
+			continue
+		}
+		switch typ {
+		case types.T_int8:
+			if err := toInt8(v); err != nil {
+				return nil, err
+			}
+		case types.T_int16:
+			if err := toInt16(v); err != nil {
+				return nil, err
+			}
+		case types.T_int32:
+			if err := toInt32(v); err != nil {
+				return nil, err
+			}
+		case types.T_int64:
+			if err := toInt64(v); err != nil {
+				return nil, err
+			}
+		case types.T_uint8:
+			if err := toUint8(v); err != nil {
+				return nil, err
+			}
+		case types.T_uint16:
+			if err := toUint16(v); err != nil {
+				return nil, err
+			}
+		case types.T_uint32:
+			if err := toUint32(v); err != nil {
+				return nil, err
+			}
+		case types.T_uint64:
+			if err := toUint64(v); err != nil {
+				return nil, err
+			}
+		case types.T_float32:
+			if err := toFloat32(v); err != nil {
+				return nil, err
+			}
+		case types.T_float64:
+			if err := toFloat64(v); err != nil {
+				return nil, err
+			}
+		case types.T_char, types.T_varchar:
+			if err := toString(v, typ); err != nil {
+				return nil, err
+			}
+		case types.T_decimal64, types.T_decimal128:
+			if err := toDecimal(v, typ); err != nil {
+				return nil, err
+			}
+		case types.T_date, types.T_datetime:
+			if err := toDate(v, typ); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
+		}
+	}
+	return e, nil
+}
+
+// pruneIsNull evaluates is null and is not null of a constant.
+func (b *build) pruneIsNull(e *extend.MultiExtend) (extend.Extend, error) {
+	if _, ok := e.Args[0].(*extend.ValueExtend); !ok {
+		return e, nil
+	}
+	return booleanValue(isNullValue(e.Args[0]) == (e.Op == overload.IsNull)), nil
+}
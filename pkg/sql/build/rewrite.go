@@ -35,6 +35,11 @@ func RewriteExtend(e extend.Extend) extend.Extend {
 		v.Left = RewriteExtend(v.Left)
 		v.Right = RewriteExtend(v.Right)
 		return v
+	case *extend.MultiExtend:
+		for i := range v.Args {
+			v.Args[i] = RewriteExtend(v.Args[i])
+		}
+		return v
 	}
 	return e
 }
@@ -71,18 +76,23 @@ func rewriteNotBinary(e *extend.BinaryExtend) extend.Extend {
 func negation(e extend.Extend, isParen bool) extend.Extend {
 	switch v := e.(type) {
 	case *extend.UnaryExtend:
-		return rewriteNotUnary(v)
+		if v.Op == overload.Not { // not not e is e
+			return RewriteExtend(v.E)
+		}
 	case *extend.ParenExtend:
 		return &extend.ParenExtend{E: negation(v.E, true)}
 	case *extend.BinaryExtend:
-		if !isParen && (v.Op == overload.And || v.Op == overload.Or) {
-			v.Left = negation(v.Left, isParen)
-			return v
-		}
 		return negationBinary(v, isParen)
+	case *extend.MultiExtend:
+		if op, ok := overload.NegOps[v.Op]; ok {
+			return &extend.MultiExtend{Op: op, Args: v.Args}
+		}
 	case *extend.ValueExtend:
 		var ok bool
 
+		if v.V.Nsp.Contains(0) { // not null is null
+			return v
+		}
 		switch v.V.Typ.Oid {
 		case types.T_int64:
 			if v.V.Col.([]int64)[0] != 0 {
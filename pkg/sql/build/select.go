@@ -77,6 +77,10 @@ func (b *build) buildSelectWithoutParens(stmt tree.SelectStatement, orderBy tree
 			return nil, err
 		}
 		ns = stmt.Exprs
+	case *tree.UnionClause:
+		if o, es, err = b.buildUnion(stmt); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown select statement: %T", stmt))
 	}
@@ -144,7 +148,9 @@ func (b *build) buildSelectWithoutParens(stmt tree.SelectStatement, orderBy tree
 			return nil, err
 		}
 	}
-	o.SetColumns(b.resultColumns(ns))
+	if ns != nil {
+		o.SetColumns(b.resultColumns(ns))
+	}
 	return o, nil
 }
 
@@ -155,7 +161,10 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy)
 	if stmt.From == nil {
 		return nil, nil, sqlerror.New(errno.SQLStatementNotYetComplete, "need from clause")
 	}
-	if b.hasSummarize(stmt.Exprs) {
+	if b.hasWindows(stmt.Exprs) {
+		return b.buildSelectClauseWithWindow(stmt, orderBy)
+	}
+	if b.hasSummarize(stmt.Exprs) || (stmt.Having != nil && b.hasAggregate(stmt.Having.Expr)) {
 		return b.buildSelectClauseWithSummarize(stmt)
 	}
 	return b.buildSelectClauseWithoutSummarize(stmt, orderBy)
@@ -173,12 +182,12 @@ func (b *build) buildSelectClauseWithSummarize(stmt *tree.SelectClause) (op.OP,
 	}
 	if len(stmt.GroupBy) != 0 {
 		if len(stmt.Exprs) != 0 {
-			if o, err = b.buildGroupBy(o, stmt.Exprs, stmt.GroupBy, stmt.Where); err != nil {
+			if o, err = b.buildGroupBy(o, stmt.Exprs, stmt.GroupBy, stmt.Where, stmt.Having); err != nil {
 				return nil, nil, err
 			}
 		}
 	} else {
-		if o, err = b.buildSummarize(o, stmt.Exprs, stmt.Where); err != nil {
+		if o, err = b.buildSummarize(o, stmt.Exprs, stmt.Where, stmt.Having); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -261,6 +270,11 @@ func (b *build) buildSelectClauseWithoutSummarize(stmt *tree.SelectClause, order
 			}
 		}
 	}
+	if stmt.Having != nil {
+		if o, err = b.buildWhere(o, stmt.Having); err != nil || o == nil {
+			return nil, nil, err
+		}
+	}
 	if stmt.Distinct {
 		if o, err = b.buildDedup(o); err != nil {
 			return nil, nil, err
@@ -19,6 +19,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/op"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/showColumns"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/showDatabases"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/showStats"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/showTables"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sqlerror"
@@ -70,3 +71,11 @@ func (b *build) buildShowColumns(stmt *tree.ShowColumns) (op.OP, error) {
 	}
 	return showColumns.New(r, likeStr), nil
 }
+
+func (b *build) buildShowStats(stmt *tree.ShowStats) (op.OP, error) {
+	db, name, r, err := b.tableName(&stmt.Table)
+	if err != nil {
+		return nil, err
+	}
+	return showStats.New(db+"."+name, r), nil
+}
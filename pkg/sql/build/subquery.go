@@ -0,0 +1,882 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/rewrite"
+	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"go/constant"
+	"math"
+)
+
+const (
+	scalarSubquery = iota
+	existsSubquery
+	inSubquery
+)
+
+var errMoreThanOneRow = sqlerror.New(errno.CardinalityViolation, "subquery returns more than 1 row")
+
+// buildSubquery builds a scalar subquery or an exists predicate.
+func (b *build) buildSubquery(o op.OP, n *tree.Subquery) (extend.Extend, error) {
+	if n.Exists {
+		return b.buildExists(o, n)
+	}
+	s, err := b.materialise(n, scalarSubquery)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.vecs) != 1 {
+		return nil, sqlerror.New(errno.CardinalityViolation, "operand should contain 1 column(s)")
+	}
+	if len(s.outer) == 0 {
+		switch s.vecs[0].Length() {
+		case 0:
+			return scalarValue(s.vecs[0], -1), nil
+		case 1:
+			return scalarValue(s.vecs[0], 0), nil
+		default:
+			return nil, errMoreThanOneRow
+		}
+	}
+	ps, err := b.buildOuter(o, s.outer)
+	if err != nil {
+		return nil, err
+	}
+	for i := range ps {
+		ps[i] = castProbe(ps[i], s.keys[i].Typ)
+	}
+	args := ps
+	for _, key := range s.keys {
+		args = append(args, constantSet(key))
+	}
+	return &extend.MultiExtend{
+		Op:   overload.Lookup,
+		Args: append(args, constantSet(appendDefault(s.vecs[0], s.count))),
+	}, nil
+}
+
+// buildExists builds an exists predicate, a correlated one is the semi join
+// of the outer sides of the correlation equalities with the inner sides.
+func (b *build) buildExists(o op.OP, n *tree.Subquery) (extend.Extend, error) {
+	s, err := b.materialise(n, existsSubquery)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.outer) == 0 {
+		return booleanValue(len(s.vecs) > 0 && s.vecs[0].Length() > 0), nil
+	}
+	ps, err := b.buildOuter(o, s.outer)
+	if err != nil {
+		return nil, err
+	}
+	return b.buildSet(overload.Exists, ps, s.keys)
+}
+
+// buildIn builds the membership of the left side in the list or the
+// subquery of the right side.
+func (b *build) buildIn(o op.OP, e *tree.ComparisonExpr) (extend.Extend, error) {
+	var ns tree.Exprs
+
+	op := overload.In
+	if e.Op == tree.NOT_IN {
+		op = overload.NotIn
+	}
+	if t, ok := e.Left.(*tree.Tuple); ok {
+		ns = t.Exprs
+	} else {
+		ns = tree.Exprs{e.Left}
+	}
+	ps := make([]extend.Extend, len(ns))
+	for i, n := range ns {
+		p, err := b.buildExpr(o, n)
+		if err != nil {
+			return nil, err
+		}
+		if ps[i], err = b.pruneExtend(p, false); err != nil {
+			return nil, err
+		}
+	}
+	switch r := e.Right.(type) {
+	case *tree.Tuple:
+		return b.buildInList(o, op, ps, r.Exprs)
+	case *tree.Subquery:
+		s, err := b.materialise(r, inSubquery)
+		if err != nil {
+			return nil, err
+		}
+		if len(s.vecs) != len(ps) {
+			return nil, sqlerror.New(errno.CardinalityViolation, fmt.Sprintf("operand should contain %v column(s)", len(ps)))
+		}
+		if len(s.outer) == 0 {
+			return b.buildSet(op, ps, s.vecs)
+		}
+		qs, err := b.buildOuter(o, s.outer)
+		if err != nil {
+			return nil, err
+		}
+		return b.buildSet(op, append(ps, qs...), append(append([]*vector.Vector{}, s.vecs...), s.keys...))
+	}
+	return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(e, dialect.MYSQL)))
+}
+
+// buildInList builds the membership in a list, a list of constants is a set,
+// otherwise it is expanded to the disjunction of the equalities.
+func (b *build) buildInList(o op.OP, op int, ps []extend.Extend, ns tree.Exprs) (extend.Extend, error) {
+	rows := make([][]extend.Extend, len(ns))
+	for i, n := range ns {
+		var es tree.Exprs
+
+		if t, ok := n.(*tree.Tuple); ok {
+			es = t.Exprs
+		} else {
+			es = tree.Exprs{n}
+		}
+		if len(es) != len(ps) {
+			return nil, sqlerror.New(errno.CardinalityViolation, fmt.Sprintf("operand should contain %v column(s)", len(ps)))
+		}
+		rows[i] = make([]extend.Extend, len(es))
+		for j, e := range es {
+			ext, err := b.buildExpr(o, e)
+			if err != nil {
+				return nil, err
+			}
+			if rows[i][j], err = b.pruneExtend(ext, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if vs, ok := listSet(ps, rows); ok {
+		return b.buildSet(op, ps, vs)
+	}
+	var e extend.Extend
+	for _, row := range rows {
+		var r extend.Extend
+		for j, v := range row {
+			var c extend.Extend
+			if op == overload.In {
+				c = &extend.BinaryExtend{Op: overload.EQ, Left: ps[j], Right: v}
+			} else {
+				c = &extend.BinaryExtend{Op: overload.NE, Left: ps[j], Right: v}
+			}
+			r = connect(op, r, c, true)
+		}
+		e = connect(op, e, &extend.ParenExtend{E: r}, false)
+	}
+	return e, nil
+}
+
+// listSet makes the columns of the set of a list of constants, the numbers
+// are converted to the types of numeric probes and the rows which cannot be
+// equal to the probes are removed.
+func listSet(ps []extend.Extend, rows [][]extend.Extend) ([]*vector.Vector, bool) {
+	vs := make([]*vector.Vector, len(ps))
+	typs := make([]types.T, len(ps))
+	keep := make([]bool, len(rows))
+	for i := range keep {
+		keep[i] = true
+	}
+	for j, p := range ps {
+		var num, str, flt bool
+
+		for _, row := range rows {
+			v, ok := row[j].(*extend.ValueExtend)
+			if !ok || v.V.Nsp.Any() {
+				return nil, false
+			}
+			switch v.V.Typ.Oid {
+			case types.T_int64:
+				num = true
+			case types.T_float64:
+				num, flt = true, true
+			case types.T_char, types.T_varchar:
+				str = true
+			default:
+				return nil, false
+			}
+		}
+		switch {
+		case num && str:
+			return nil, false
+		case str:
+			typs[j] = types.T_varchar
+		case isNumeric(p.ReturnType()):
+			typs[j] = p.ReturnType()
+			for i, row := range rows {
+				if _, ok := convertValue(row[j].(*extend.ValueExtend).V, typs[j]); !ok {
+					keep[i] = false
+				}
+			}
+		case flt:
+			typs[j] = types.T_float64
+		default:
+			typs[j] = types.T_int64
+		}
+	}
+	for j, typ := range typs {
+		vs[j] = vector.New(typ.ToType())
+		for i, row := range rows {
+			if keep[i] {
+				v, _ := convertValue(row[j].(*extend.ValueExtend).V, typ)
+				appendConstant(vs[j], v)
+			}
+		}
+	}
+	return vs, true
+}
+
+// convertValue converts a constant to the value of a type, it returns false
+// if the constant is not a value of the type.
+func convertValue(vec *vector.Vector, typ types.T) (interface{}, bool) {
+	var i int64
+	var f float64
+
+	switch vs := vec.Col.(type) {
+	case []int64:
+		i, f = vs[0], float64(vs[0])
+	case []float64:
+		f = vs[0]
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+			return convertFloat(f, typ)
+		}
+		i = int64(f)
+	case *types.Bytes:
+		return string(vs.Get(0)), true
+	}
+	switch typ {
+	case types.T_int8:
+		return int8(i), i >= math.MinInt8 && i <= math.MaxInt8
+	case types.T_int16:
+		return int16(i), i >= math.MinInt16 && i <= math.MaxInt16
+	case types.T_int32:
+		return int32(i), i >= math.MinInt32 && i <= math.MaxInt32
+	case types.T_int64:
+		return i, true
+	case types.T_uint8:
+		return uint8(i), i >= 0 && i <= math.MaxUint8
+	case types.T_uint16:
+		return uint16(i), i >= 0 && i <= math.MaxUint16
+	case types.T_uint32:
+		return uint32(i), i >= 0 && i <= math.MaxUint32
+	case types.T_uint64:
+		return uint64(i), i >= 0
+	}
+	return convertFloat(f, typ)
+}
+
+func convertFloat(f float64, typ types.T) (interface{}, bool) {
+	switch typ {
+	case types.T_float32:
+		return float32(f), float64(float32(f)) == f
+	case types.T_float64:
+		return f, true
+	case types.T_uint64:
+		return uint64(f), f == math.Trunc(f) && f >= 0 && f < math.MaxUint64
+	}
+	return nil, false
+}
+
+// appendConstant appends a value converted by convertValue to the vector.
+func appendConstant(vec *vector.Vector, v interface{}) {
+	switch x := v.(type) {
+	case int8:
+		vec.Col = append(vec.Col.([]int8), x)
+	case int16:
+		vec.Col = append(vec.Col.([]int16), x)
+	case int32:
+		vec.Col = append(vec.Col.([]int32), x)
+	case int64:
+		vec.Col = append(vec.Col.([]int64), x)
+	case uint8:
+		vec.Col = append(vec.Col.([]uint8), x)
+	case uint16:
+		vec.Col = append(vec.Col.([]uint16), x)
+	case uint32:
+		vec.Col = append(vec.Col.([]uint32), x)
+	case uint64:
+		vec.Col = append(vec.Col.([]uint64), x)
+	case float32:
+		vec.Col = append(vec.Col.([]float32), x)
+	case float64:
+		vec.Col = append(vec.Col.([]float64), x)
+	case string:
+		vs := vec.Col.(*types.Bytes)
+		vs.Offsets = append(vs.Offsets, uint32(len(vs.Data)))
+		vs.Lengths = append(vs.Lengths, uint32(len(x)))
+		vs.Data = append(vs.Data, x...)
+	}
+}
+
+// connect joins the conditions of an expanded list by and (or) for in, and
+// by or (and) for not in.
+func connect(op int, e, c extend.Extend, inner bool) extend.Extend {
+	if e == nil {
+		return c
+	}
+	if (op == overload.In) == inner {
+		return &extend.BinaryExtend{Op: overload.And, Left: e, Right: c}
+	}
+	return &extend.BinaryExtend{Op: overload.Or, Left: e, Right: c}
+}
+
+// buildSet builds the operator of a set, the set is folded if the probes
+// are constants.
+func (b *build) buildSet(op int, ps []extend.Extend, vs []*vector.Vector) (extend.Extend, error) {
+	if len(vs) == 0 || vs[0].Length() == 0 {
+		return booleanValue(op == overload.NotIn || op == overload.NotExists), nil
+	}
+	args := make([]extend.Extend, 0, len(ps)*2)
+	for i, p := range ps {
+		args = append(args, castProbe(p, vs[i].Typ))
+	}
+	for _, v := range vs {
+		args = append(args, constantSet(v))
+	}
+	e := &extend.MultiExtend{Op: op, Args: args}
+	for _, p := range ps {
+		if len(p.Attributes()) > 0 {
+			return e, nil
+		}
+	}
+	proc := process.NewFromProc(b.proc)
+	proc.Mp = mempool.New()
+	vec, _, err := e.Eval(batch.New(true, nil), proc)
+	if err != nil {
+		return nil, err
+	}
+	defer vec.Clean(proc)
+	return booleanValue(len(vec.Col.([]int64)) > 0), nil
+}
+
+// buildOuter builds the outer sides of the correlation equalities.
+func (b *build) buildOuter(o op.OP, ns []tree.Expr) ([]extend.Extend, error) {
+	es := make([]extend.Extend, len(ns))
+	for i, n := range ns {
+		e, err := b.buildExpr(o, n)
+		if err != nil {
+			return nil, err
+		}
+		if es[i], err = b.pruneExtend(e, false); err != nil {
+			return nil, err
+		}
+	}
+	return es, nil
+}
+
+// materialise runs the query of a subquery, a correlated subquery is
+// decorrelated into the query of its correlation keys.
+func (b *build) materialise(n *tree.Subquery, mode int) (*subquery, error) {
+	if s, ok := b.subqueries[n]; ok {
+		return s, nil
+	}
+	if b.exec == nil {
+		return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("'%v' is not support now", tree.String(n, dialect.MYSQL)))
+	}
+	stmt := subquerySelect(n)
+	clause, ok := stmt.Select.(*tree.SelectClause)
+	if ok && clause.From != nil {
+		inner, err := b.buildFrom(clause.From.Tables)
+		if err != nil {
+			return nil, err
+		}
+		if b.isCorrelated(inner, clause) {
+			s, err := b.decorrelate(inner, stmt, clause, mode)
+			if err != nil {
+				return nil, err
+			}
+			b.subqueries[n] = s
+			return s, nil
+		}
+	}
+	if stmt.Limit == nil && ok && !b.isAggregated(clause) { // only the first rows are needed to check the result
+		switch mode {
+		case existsSubquery:
+			stmt.Limit = &tree.Limit{Count: tree.NewNumVal(constant.MakeInt64(1), "1", false)}
+		case scalarSubquery:
+			stmt.Limit = &tree.Limit{Count: tree.NewNumVal(constant.MakeInt64(2), "2", false)}
+		}
+	}
+	vs, err := b.execute(stmt)
+	if err != nil {
+		return nil, err
+	}
+	s := &subquery{vecs: vs}
+	b.subqueries[n] = s
+	return s, nil
+}
+
+// decorrelate splits the where clause of a correlated subquery into the
+// equalities correlating it with the outer query and the local conditions,
+// and runs the subquery for all the values of the inner sides of the
+// equalities. The rows of a scalar aggregate are grouped by the inner sides.
+func (b *build) decorrelate(inner op.OP, stmt *tree.Select, clause *tree.SelectClause, mode int) (*subquery, error) {
+	var ks, ls []tree.Expr
+
+	s := new(subquery)
+	if stmt.Limit != nil || len(clause.GroupBy) > 0 || clause.Having != nil {
+		return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("correlated subquery '%v' is not support now", tree.String(stmt, dialect.MYSQL)))
+	}
+	for _, n := range clause.Exprs {
+		if b.hasOuter(inner, n.Expr) {
+			return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("correlated column in '%v' is not support now", tree.String(n.Expr, dialect.MYSQL)))
+		}
+	}
+	if clause.Where != nil {
+		for _, c := range conjuncts(clause.Where.Expr, nil) {
+			if !b.hasOuter(inner, c) {
+				ls = append(ls, c)
+				continue
+			}
+			e, ok := stripParens(c).(*tree.ComparisonExpr)
+			if !ok || e.Op != tree.EQUAL {
+				return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("correlated condition '%v' is not support now", tree.String(c, dialect.MYSQL)))
+			}
+			switch {
+			case b.isInner(inner, e.Left) && b.isOuter(inner, e.Right):
+				ks, s.outer = append(ks, e.Left), append(s.outer, e.Right)
+			case b.isOuter(inner, e.Left) && b.isInner(inner, e.Right):
+				ks, s.outer = append(ks, e.Right), append(s.outer, e.Left)
+			default:
+				return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("correlated condition '%v' is not support now", tree.String(c, dialect.MYSQL)))
+			}
+		}
+	}
+	ns := make(tree.SelectExprs, 0, len(ks)+len(clause.Exprs))
+	for i, k := range ks {
+		ns = append(ns, tree.SelectExpr{Expr: k, As: tree.UnrestrictedIdentifier(fmt.Sprintf("key%v", i))})
+	}
+	sc := &tree.SelectClause{From: clause.From}
+	switch mode {
+	case existsSubquery:
+		sc.Distinct = true
+	case inSubquery:
+		ns = append(ns, clause.Exprs...)
+	case scalarSubquery:
+		if len(clause.Exprs) != 1 {
+			return nil, sqlerror.New(errno.CardinalityViolation, "operand should contain 1 column(s)")
+		}
+		ns = append(ns, clause.Exprs...)
+		if b.hasAggregate(clause.Exprs[0].Expr) {
+			sc.GroupBy = ks
+			s.count = isCount(clause.Exprs[0].Expr)
+		}
+	}
+	sc.Exprs = ns
+	for _, l := range ls {
+		if sc.Where == nil {
+			sc.Where = tree.NewWhere(l)
+		} else {
+			sc.Where = tree.NewWhere(tree.NewAndExpr(sc.Where.Expr, l))
+		}
+	}
+	vs, err := b.execute(&tree.Select{Select: sc})
+	if err != nil {
+		return nil, err
+	}
+	if len(vs) == 0 { // no rows
+		s.keys = make([]*vector.Vector, len(ks))
+		return s, nil
+	}
+	s.keys, s.vecs = vs[:len(ks)], vs[len(ks):]
+	return s, nil
+}
+
+// execute builds and runs the query of a subquery.
+func (b *build) execute(stmt *tree.Select) ([]*vector.Vector, error) {
+	o, err := b.buildSelect(stmt)
+	if err != nil {
+		return nil, err
+	}
+	if o == nil {
+		return nil, nil
+	}
+	bat, err := b.exec(o)
+	if err != nil {
+		return nil, err
+	}
+	return bat.Vecs, nil
+}
+
+// isAggregated returns true if the rows of the select clause are grouped.
+func (b *build) isAggregated(clause *tree.SelectClause) bool {
+	if len(clause.GroupBy) > 0 || clause.Distinct {
+		return true
+	}
+	for _, n := range clause.Exprs {
+		if b.hasAggregate(n.Expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCorrelated returns true if the subquery refers to the columns of the
+// outer query.
+func (b *build) isCorrelated(inner op.OP, clause *tree.SelectClause) bool {
+	for _, n := range clause.Exprs {
+		if b.hasOuter(inner, n.Expr) {
+			return true
+		}
+	}
+	if clause.Where != nil && b.hasOuter(inner, clause.Where.Expr) {
+		return true
+	}
+	for _, g := range clause.GroupBy {
+		if b.hasOuter(inner, g) {
+			return true
+		}
+	}
+	return clause.Having != nil && b.hasOuter(inner, clause.Having.Expr)
+}
+
+// outerColumns returns the columns of the outer query referred by a
+// correlated subquery.
+func (b *build) outerColumns(n *tree.Subquery) ([]*tree.UnresolvedName, error) {
+	var rs []*tree.UnresolvedName
+
+	stmt := subquerySelect(n)
+	clause, ok := stmt.Select.(*tree.SelectClause)
+	if !ok || clause.From == nil {
+		return nil, nil
+	}
+	inner, err := b.buildFrom(clause.From.Tables)
+	if err != nil {
+		return nil, err
+	}
+	var ns []*tree.UnresolvedName
+	for _, e := range clause.Exprs {
+		ns = columnNames(e.Expr, ns)
+	}
+	if clause.Where != nil {
+		ns = columnNames(clause.Where.Expr, ns)
+	}
+	for _, name := range ns {
+		if !isColumn(inner, name) {
+			rs = append(rs, name)
+		}
+	}
+	return rs, nil
+}
+
+// hasOuter returns true if the expression refers to a column which is not
+// a column of the inner query.
+func (b *build) hasOuter(inner op.OP, n tree.Expr) bool {
+	for _, name := range columnNames(n, nil) {
+		if !isColumn(inner, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isInner returns true if the expression only refers to the columns of
+// the inner query.
+func (b *build) isInner(inner op.OP, n tree.Expr) bool {
+	ns := columnNames(n, nil)
+	for _, name := range ns {
+		if !isColumn(inner, name) {
+			return false
+		}
+	}
+	return len(ns) > 0
+}
+
+// isOuter returns true if the expression only refers to the columns of
+// the outer query.
+func (b *build) isOuter(inner op.OP, n tree.Expr) bool {
+	ns := columnNames(n, nil)
+	for _, name := range ns {
+		if isColumn(inner, name) {
+			return false
+		}
+	}
+	return len(ns) > 0
+}
+
+// isColumn returns true if the name is a column of the op, a qualified
+// name must match the name of the op.
+func isColumn(o op.OP, e *tree.UnresolvedName) bool {
+	attrs := o.Attribute()
+	if e.NumParts == 1 || len(e.Parts[1]) == 0 {
+		_, ok := attrs[e.Parts[0]]
+		return ok
+	}
+	if _, ok := attrs[e.Parts[1]+"."+e.Parts[0]]; ok {
+		return true
+	}
+	if o.Name() == e.Parts[1] {
+		_, ok := attrs[e.Parts[0]]
+		return ok
+	}
+	return false
+}
+
+// columnNames returns the names of the columns referred by an expression,
+// the columns of the subqueries within the expression are excluded.
+func columnNames(n tree.Expr, ns []*tree.UnresolvedName) []*tree.UnresolvedName {
+	switch e := n.(type) {
+	case *tree.ParenExpr:
+		return columnNames(e.Expr, ns)
+	case *tree.OrExpr:
+		return columnNames(e.Right, columnNames(e.Left, ns))
+	case *tree.NotExpr:
+		return columnNames(e.Expr, ns)
+	case *tree.AndExpr:
+		return columnNames(e.Right, columnNames(e.Left, ns))
+	case *tree.XorExpr:
+		return columnNames(e.Right, columnNames(e.Left, ns))
+	case *tree.UnaryExpr:
+		return columnNames(e.Expr, ns)
+	case *tree.BinaryExpr:
+		return columnNames(e.Right, columnNames(e.Left, ns))
+	case *tree.ComparisonExpr:
+		return columnNames(e.Right, columnNames(e.Left, ns))
+	case *tree.RangeCond:
+		return columnNames(e.To, columnNames(e.From, columnNames(e.Left, ns)))
+	case *tree.IsNullExpr:
+		return columnNames(e.Expr, ns)
+	case *tree.IsNotNullExpr:
+		return columnNames(e.Expr, ns)
+	case *tree.Tuple:
+		for _, expr := range e.Exprs {
+			ns = columnNames(expr, ns)
+		}
+		return ns
+	case *tree.FuncExpr:
+		for _, expr := range e.Exprs {
+			ns = columnNames(expr, ns)
+		}
+		for _, ord := range e.OrderBy {
+			ns = columnNames(ord.Expr, ns)
+		}
+		return ns
+	case *tree.CastExpr:
+		return columnNames(e.Expr, ns)
+	case *tree.IntervalExpr:
+		return columnNames(e.Expr, ns)
+	case *tree.CaseExpr:
+		for _, expr := range caseExprs(e) {
+			ns = columnNames(expr, ns)
+		}
+		return ns
+	case *tree.UnresolvedName:
+		if e.Star {
+			return ns
+		}
+		return append(ns, e)
+	}
+	return ns
+}
+
+// conjuncts splits an expression into the conditions joined by and.
+func conjuncts(n tree.Expr, es []tree.Expr) []tree.Expr {
+	switch e := n.(type) {
+	case *tree.ParenExpr:
+		if _, ok := stripParens(e).(*tree.AndExpr); ok {
+			return conjuncts(stripParens(e), es)
+		}
+	case *tree.AndExpr:
+		return conjuncts(e.Right, conjuncts(e.Left, es))
+	}
+	return append(es, n)
+}
+
+// subquerySelect returns the rewritten statement of a subquery.
+func subquerySelect(n *tree.Subquery) *tree.Select {
+	stmt, ok := n.Select.(*tree.ParenSelect)
+	if !ok {
+		return rewrite.AstRewrite(rewrite.Rewrite(&tree.Select{Select: n.Select})).(*tree.Select)
+	}
+	return rewrite.AstRewrite(rewrite.Rewrite(stmt.Select)).(*tree.Select)
+}
+
+func isCount(n tree.Expr) bool {
+	if e, ok := stripParens(n).(*tree.FuncExpr); ok {
+		if name, ok := e.Func.FunctionReference.(*tree.UnresolvedName); ok {
+			return name.Parts[0] == "count" || name.Parts[0] == "starcount"
+		}
+	}
+	return false
+}
+
+// castProbe casts a probe to the type which it is compared with a column
+// of the set, the column of the set is cast to the type of the probe when
+// the set is evaluated.
+func castProbe(e extend.Extend, typ types.Type) extend.Extend {
+	t := e.ReturnType()
+	if t == typ.Oid || (isChar(t) && isChar(typ.Oid)) {
+		return e
+	}
+	if lt, _, ok := overload.BinaryCastRule(overload.EQ, t, typ.Oid); ok {
+		if lt.Oid == t {
+			return e
+		}
+		typ = lt
+	} else if !isDecimal(typ.Oid) || isDecimal(t) {
+		return e
+	}
+	return &extend.BinaryExtend{
+		Op:    overload.Typecast,
+		Left:  e,
+		Right: &extend.ValueExtend{V: vector.New(typ)},
+	}
+}
+
+// constantSet makes the constant of a column of a set.
+func constantSet(vec *vector.Vector) extend.Extend {
+	vec.Ref = 1
+	return &extend.ValueExtend{V: vec}
+}
+
+// appendDefault appends the value of a scalar subquery for the outer rows
+// without rows, which is zero for a count and null otherwise.
+func appendDefault(vec *vector.Vector, count bool) *vector.Vector {
+	n := vec.Length()
+	switch vs := vec.Col.(type) {
+	case []int8:
+		vec.Col = append(vs, 0)
+	case []int16:
+		vec.Col = append(vs, 0)
+	case []int32:
+		vec.Col = append(vs, 0)
+	case []int64:
+		vec.Col = append(vs, 0)
+	case []uint8:
+		vec.Col = append(vs, 0)
+	case []uint16:
+		vec.Col = append(vs, 0)
+	case []uint32:
+		vec.Col = append(vs, 0)
+	case []uint64:
+		vec.Col = append(vs, 0)
+	case []float32:
+		vec.Col = append(vs, 0)
+	case []float64:
+		vec.Col = append(vs, 0)
+	case []types.Decimal64:
+		vec.Col = append(vs, 0)
+	case []types.Decimal128:
+		vec.Col = append(vs, types.Decimal128{})
+	case []types.Date:
+		vec.Col = append(vs, 0)
+	case []types.Datetime:
+		vec.Col = append(vs, 0)
+	case *types.Bytes:
+		vs.Offsets = append(vs.Offsets, uint32(len(vs.Data)))
+		vs.Lengths = append(vs.Lengths, 0)
+	}
+	if !count {
+		vec.Nsp.Add(uint64(n))
+	}
+	return vec
+}
+
+// scalarValue returns the value of a row of a scalar subquery as a constant,
+// the value is null if the row is negative.
+func scalarValue(vec *vector.Vector, row int64) extend.Extend {
+	if row < 0 || vec.Nsp.Contains(uint64(row)) {
+		return nullValue()
+	}
+	var rvec *vector.Vector
+	switch vs := vec.Col.(type) {
+	case []int8:
+		rvec = int64Value(int64(vs[row]))
+	case []int16:
+		rvec = int64Value(int64(vs[row]))
+	case []int32:
+		rvec = int64Value(int64(vs[row]))
+	case []int64:
+		rvec = int64Value(vs[row])
+	case []uint8:
+		rvec = int64Value(int64(vs[row]))
+	case []uint16:
+		rvec = int64Value(int64(vs[row]))
+	case []uint32:
+		rvec = int64Value(int64(vs[row]))
+	case []uint64:
+		if int64(vs[row]) >= 0 {
+			rvec = int64Value(int64(vs[row]))
+		} else {
+			rvec = vector.New(types.Type{Oid: types.T_float64, Size: 8})
+			rvec.Col = []float64{float64(vs[row])}
+		}
+	case []float32:
+		rvec = vector.New(types.Type{Oid: types.T_float64, Size: 8})
+		rvec.Col = []float64{float64(vs[row])}
+	case []float64:
+		rvec = vector.New(types.Type{Oid: types.T_float64, Size: 8})
+		rvec.Col = []float64{vs[row]}
+	case *types.Bytes:
+		v := vs.Get(row)
+		rvec = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+		rvec.Col = &types.Bytes{
+			Data:    append([]byte{}, v...),
+			Offsets: []uint32{0},
+			Lengths: []uint32{uint32(len(v))},
+		}
+	default:
+		rvec = vector.New(vec.Typ)
+		rvec.Col = vec.Window(int(row), int(row)+1, rvec).Col
+	}
+	rvec.Ref = 1
+	return &extend.ValueExtend{V: rvec}
+}
+
+func int64Value(v int64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Col = []int64{v}
+	return vec
+}
+
+func booleanValue(ok bool) extend.Extend {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Ref = 1
+	if ok {
+		vec.Col = []int64{1}
+	} else {
+		vec.Col = []int64{0}
+	}
+	return &extend.ValueExtend{V: vec}
+}
+
+func isChar(t types.T) bool {
+	return t == types.T_char || t == types.T_varchar
+}
+
+func isNumeric(t types.T) bool {
+	switch t {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64:
+		return true
+	}
+	return false
+}
+
+func isDecimal(t types.T) bool {
+	return t == types.T_decimal64 || t == types.T_decimal128
+}
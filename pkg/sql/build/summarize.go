@@ -17,28 +17,47 @@ package build
 import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/aggfunc"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/avg"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/count"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/max"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/min"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/starcount"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/sum"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/sql/op"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/summarize"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+	"go/constant"
+	"strings"
 )
 
 var AggFuncs map[string]int = map[string]int{
-	"avg":       aggregation.Avg,
-	"max":       aggregation.Max,
-	"min":       aggregation.Min,
-	"sum":       aggregation.Sum,
-	"count":     aggregation.Count,
-	"starcount": aggregation.StarCount,
+	"avg":                   aggregation.Avg,
+	"max":                   aggregation.Max,
+	"min":                   aggregation.Min,
+	"sum":                   aggregation.Sum,
+	"count":                 aggregation.Count,
+	"starcount":             aggregation.StarCount,
+	"std":                   aggregation.StdDevPop,
+	"stddev":                aggregation.StdDevPop,
+	"stddev_pop":            aggregation.StdDevPop,
+	"stddev_samp":           aggregation.StdDevSamp,
+	"variance":              aggregation.VarPop,
+	"var_pop":               aggregation.VarPop,
+	"var_samp":              aggregation.VarSamp,
+	"group_concat":          aggregation.GroupConcat,
+	"bit_and":               aggregation.BitAnd,
+	"bit_or":                aggregation.BitOr,
+	"bit_xor":               aggregation.BitXor,
+	"any_value":             aggregation.AnyValue,
+	"approx_count_distinct": aggregation.ApproxCountDistinct,
 }
 
 func (b *build) hasSummarize(ns tree.SelectExprs) bool {
@@ -50,7 +69,7 @@ func (b *build) hasSummarize(ns tree.SelectExprs) bool {
 	return false
 }
 
-func (b *build) buildSummarize(o op.OP, ns tree.SelectExprs, where *tree.Where) (op.OP, error) {
+func (b *build) buildSummarize(o op.OP, ns tree.SelectExprs, where, having *tree.Where) (op.OP, error) {
 	var err error
 	var fs []*tree.FuncExpr
 	var es []aggregation.Extend
@@ -77,6 +96,11 @@ func (b *build) buildSummarize(o op.OP, ns tree.SelectExprs, where *tree.Where)
 				return nil, err
 			}
 		}
+		if having != nil {
+			if having.Expr, err = b.stripAggregate(o, having.Expr, &fs, &pes, mp, mq); err != nil {
+				return nil, err
+			}
+		}
 		if len(pes) > 0 {
 			if o, err = projection.New(o, pes); err != nil {
 				return nil, err
@@ -88,49 +112,17 @@ func (b *build) buildSummarize(o op.OP, ns tree.SelectExprs, where *tree.Where)
 			}
 		}
 	}
-	for _, f := range fs {
-		name, ok := f.Func.FunctionReference.(*tree.UnresolvedName)
-		if !ok {
-			return nil, sqlerror.New(errno.SyntaxError, fmt.Sprintf("illegal expression '%s'", f))
-		}
-		op, ok := AggFuncs[name.Parts[0]]
-		if !ok {
-			return nil, sqlerror.New(errno.UndefinedFunction, fmt.Sprintf("unimplemented aggregated functions '%s'", name.Parts[0]))
-		}
-		switch e := f.Exprs[0].(type) {
-		case *tree.NumVal:
-			alias := "count(*)"
-			agg, err := newAggregate(op, types.Type{Oid: types.T_int64, Size: 8})
-			if err != nil {
-				return nil, err
-			}
-			es = append(es, aggregation.Extend{
-				Agg:   agg,
-				Alias: alias,
-				Name:  prev.ResultColumns()[0],
-				Op:    aggregation.StarCount,
-			})
-		case *tree.UnresolvedName:
-			alias := fmt.Sprintf("%s(%s)", name.Parts[0], e.Parts[0])
-			typ, ok := o.Attribute()[e.Parts[0]]
-			if !ok {
-				return nil, sqlerror.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s' in aggregation", e.Parts[0]))
-			}
-			agg, err := newAggregate(op, typ)
-			if err != nil {
-				return nil, err
-			}
-			es = append(es, aggregation.Extend{
-				Op:    op,
-				Agg:   agg,
-				Alias: alias,
-				Name:  e.Parts[0],
-			})
-		}
+	if es, err = b.buildAggregates(o, prev, fs); err != nil {
+		return nil, err
 	}
 	if o, err = summarize.New(o, es); err != nil {
 		return nil, err
 	}
+	if having != nil {
+		if o, err = b.buildHaving(o, ns, having); err != nil || o == nil {
+			return nil, err
+		}
+	}
 	return b.buildProjection(o, ns)
 }
 
@@ -188,8 +180,7 @@ func (b *build) stripAggregate(o op.OP, n tree.Expr, fs *[]*tree.FuncExpr, es *[
 	case *tree.FuncExpr:
 		if name, ok := e.Func.FunctionReference.(*tree.UnresolvedName); ok {
 			if _, ok = AggFuncs[name.Parts[0]]; ok {
-				switch e.Exprs[0].(type) {
-				case *tree.NumVal:
+				if _, ok := e.Exprs[0].(*tree.NumVal); ok && name.Parts[0] != "group_concat" {
 					ext, err := b.buildExtend(o, &tree.UnresolvedName{
 						Parts: [4]string{o.ResultColumns()[0]},
 					})
@@ -207,27 +198,60 @@ func (b *build) stripAggregate(o op.OP, n tree.Expr, fs *[]*tree.FuncExpr, es *[
 					return &tree.UnresolvedName{
 						Parts: [4]string{"count(*)"},
 					}, nil
-				default:
-					ext, err := b.buildExtend(o, e.Exprs[0])
-					if err != nil {
-						return nil, err
-					}
-					if _, ok := mp[ext.String()]; !ok {
-						mp[ext.String()] = 0
-						*(es) = append((*es), &projection.Extend{E: ext})
-					}
-					e.Exprs[0] = &tree.UnresolvedName{
-						Parts: [4]string{ext.String()},
-					}
-					fn := fmt.Sprintf("%s(%s)", name.Parts[0], ext)
-					if _, ok := mq[fn]; !ok {
-						*(fs) = append(*(fs), e)
-						mq[fn] = 0
-					}
-					return &tree.UnresolvedName{
-						Parts: [4]string{fn},
-					}, nil
 				}
+				ext, err := b.buildAggregateArg(o, name.Parts[0], e, b.buildExtend)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := mp[ext.String()]; !ok {
+					mp[ext.String()] = 0
+					*(es) = append((*es), &projection.Extend{E: ext})
+				}
+				fn := aggregateName(name.Parts[0], e, ext.String())
+				e.Exprs[0] = &tree.UnresolvedName{
+					Parts: [4]string{ext.String()},
+				}
+				if name.Parts[0] == "group_concat" {
+					// only the separator is kept, the values and the keys are in the item
+					e.Exprs, e.OrderBy = append(e.Exprs[:1], e.Exprs[len(e.Exprs)-1]), nil
+				}
+				if _, ok := mq[fn]; !ok {
+					*(fs) = append(*(fs), e)
+					mq[fn] = 0
+				}
+				return &tree.UnresolvedName{
+					Parts: [4]string{fn},
+				}, nil
+			}
+		}
+		for i := range e.Exprs {
+			if e.Exprs[i], err = b.stripAggregate(o, e.Exprs[i], fs, es, mp, mq); err != nil {
+				return nil, err
+			}
+		}
+		return e, nil
+	case *tree.IntervalExpr:
+		if e.Expr, err = b.stripAggregate(o, e.Expr, fs, es, mp, mq); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *tree.CaseExpr:
+		if e.Expr != nil {
+			if e.Expr, err = b.stripAggregate(o, e.Expr, fs, es, mp, mq); err != nil {
+				return nil, err
+			}
+		}
+		for _, w := range e.Whens {
+			if w.Cond, err = b.stripAggregate(o, w.Cond, fs, es, mp, mq); err != nil {
+				return nil, err
+			}
+			if w.Val, err = b.stripAggregate(o, w.Val, fs, es, mp, mq); err != nil {
+				return nil, err
+			}
+		}
+		if e.Else != nil {
+			if e.Else, err = b.stripAggregate(o, e.Else, fs, es, mp, mq); err != nil {
+				return nil, err
 			}
 		}
 		return e, nil
@@ -246,6 +270,151 @@ func (b *build) stripAggregate(o op.OP, n tree.Expr, fs *[]*tree.FuncExpr, es *[
 	return n, nil
 }
 
+// buildAggregates builds the aggregations of fs, whose arguments are the
+// columns of o produced by stripAggregate.
+func (b *build) buildAggregates(o, prev op.OP, fs []*tree.FuncExpr) ([]aggregation.Extend, error) {
+	var es []aggregation.Extend
+
+	for _, f := range fs {
+		name, ok := f.Func.FunctionReference.(*tree.UnresolvedName)
+		if !ok {
+			return nil, sqlerror.New(errno.SyntaxError, fmt.Sprintf("illegal expression '%s'", f))
+		}
+		op, err := aggregateOp(name.Parts[0], f)
+		if err != nil {
+			return nil, err
+		}
+		switch e := f.Exprs[0].(type) {
+		case *tree.NumVal:
+			alias := "count(*)"
+			agg, err := newAggregate(op, types.Type{Oid: types.T_int64, Size: 8})
+			if err != nil {
+				return nil, err
+			}
+			es = append(es, aggregation.Extend{
+				Agg:   agg,
+				Alias: alias,
+				Name:  prev.ResultColumns()[0],
+				Op:    aggregation.StarCount,
+			})
+		case *tree.UnresolvedName:
+			alias := aggregateName(name.Parts[0], f, e.Parts[0])
+			typ, ok := o.Attribute()[e.Parts[0]]
+			if !ok {
+				return nil, sqlerror.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s' in aggregation", e.Parts[0]))
+			}
+			agg, err := newAggregate(op, typ)
+			if err != nil {
+				return nil, err
+			}
+			es = append(es, aggregation.Extend{
+				Op:    op,
+				Agg:   agg,
+				Alias: alias,
+				Name:  e.Parts[0],
+
+				Distinct:  f.Type == tree.FUNC_TYPE_DISTINCT,
+				Separator: separator(name.Parts[0], f),
+			})
+		}
+	}
+	return es, nil
+}
+
+// aggregateOp returns the operator of the aggregate function named name,
+// count(distinct x) is a different aggregation from count(x).
+func aggregateOp(name string, f *tree.FuncExpr) (int, error) {
+	op, ok := AggFuncs[name]
+	if !ok {
+		return 0, sqlerror.New(errno.UndefinedFunction, fmt.Sprintf("unimplemented aggregated functions '%s'", name))
+	}
+	if f.Type == tree.FUNC_TYPE_DISTINCT {
+		switch op {
+		case aggregation.Count:
+			return aggregation.CountDistinct, nil
+		case aggregation.Max, aggregation.Min, aggregation.BitAnd, aggregation.BitOr,
+			aggregation.AnyValue, aggregation.GroupConcat, aggregation.ApproxCountDistinct:
+		default:
+			return 0, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("unimplemented aggregated functions '%s(distinct)'", name))
+		}
+	}
+	return op, nil
+}
+
+// buildAggregateArg builds the argument of the aggregate function e, the
+// argument of group_concat is the item made of its values and order by keys.
+func (b *build) buildAggregateArg(o op.OP, name string, e *tree.FuncExpr, fn func(op.OP, tree.Expr) (extend.Extend, error)) (extend.Extend, error) {
+	if name != "group_concat" {
+		if len(e.Exprs) != 1 {
+			return nil, sqlerror.New(errno.SyntaxError, fmt.Sprintf("incorrect parameter count in the call to '%s'", name))
+		}
+		return fn(o, e.Exprs[0])
+	}
+	vs := e.Exprs[:len(e.Exprs)-1]
+	args := []extend.Extend{&extend.ValueExtend{V: constInt64(int64(len(vs)))}}
+	for _, v := range vs {
+		ext, err := fn(o, v)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, ext)
+	}
+	for _, ord := range e.OrderBy {
+		ext, err := fn(o, ord.Expr)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, ext, booleanValue(ord.Direction == tree.Descending))
+	}
+	return &extend.MultiExtend{Op: overload.ConcatItem, Args: args}, nil
+}
+
+// aggregateName returns the name of the result of the aggregate function e
+// whose argument is arg.
+func aggregateName(name string, e *tree.FuncExpr, arg string) string {
+	var distinct string
+
+	if e.Type == tree.FUNC_TYPE_DISTINCT {
+		distinct = "distinct "
+	}
+	if name == "group_concat" {
+		arg = strings.TrimSuffix(strings.TrimPrefix(arg, overload.OpName[overload.ConcatItem]+"("), ")")
+		if sep := separator(name, e); sep != "," {
+			return fmt.Sprintf("%s(%s%s separator '%s')", name, distinct, arg, sep)
+		}
+	}
+	return fmt.Sprintf("%s(%s%s)", name, distinct, arg)
+}
+
+// aggregateExprs returns the expressions used by the aggregate function e.
+func aggregateExprs(name string, e *tree.FuncExpr) tree.Exprs {
+	if name != "group_concat" {
+		return e.Exprs[:1]
+	}
+	es := append(tree.Exprs{}, e.Exprs[:len(e.Exprs)-1]...)
+	for _, ord := range e.OrderBy {
+		es = append(es, ord.Expr)
+	}
+	return es
+}
+
+// separator returns the separator of group_concat, which is its last argument.
+func separator(name string, e *tree.FuncExpr) string {
+	if name != "group_concat" {
+		return ""
+	}
+	if v, ok := e.Exprs[len(e.Exprs)-1].(*tree.NumVal); ok && v.Value.Kind() == constant.String {
+		return constant.StringVal(v.Value)
+	}
+	return ","
+}
+
+func constInt64(v int64) *vector.Vector {
+	vec := int64Value(v)
+	vec.Ref = 1
+	return vec
+}
+
 func newAggregate(op int, typ types.Type) (aggregation.Aggregation, error) {
 	switch op {
 	case aggregation.Avg:
@@ -256,6 +425,8 @@ func newAggregate(op int, typ types.Type) (aggregation.Aggregation, error) {
 			return avg.NewInt(typ), nil
 		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 			return avg.NewUint(typ), nil
+		case types.T_decimal64, types.T_decimal128:
+			return avg.NewDecimal(typ), nil
 		}
 	case aggregation.Max:
 		switch typ.Oid {
@@ -279,6 +450,10 @@ func newAggregate(op int, typ types.Type) (aggregation.Aggregation, error) {
 			return max.NewFloat32(typ), nil
 		case types.T_float64:
 			return max.NewFloat64(typ), nil
+		case types.T_decimal64:
+			return max.NewDecimal64(typ), nil
+		case types.T_decimal128:
+			return max.NewDecimal128(typ), nil
 		case types.T_char, types.T_varchar:
 			return max.NewStr(typ), nil
 		}
@@ -304,6 +479,10 @@ func newAggregate(op int, typ types.Type) (aggregation.Aggregation, error) {
 			return min.NewFloat32(typ), nil
 		case types.T_float64:
 			return min.NewFloat64(typ), nil
+		case types.T_decimal64:
+			return min.NewDecimal64(typ), nil
+		case types.T_decimal128:
+			return min.NewDecimal128(typ), nil
 		case types.T_char, types.T_varchar:
 			return min.NewStr(typ), nil
 		}
@@ -315,11 +494,32 @@ func newAggregate(op int, typ types.Type) (aggregation.Aggregation, error) {
 			return sum.NewInt(typ), nil
 		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 			return sum.NewUint(typ), nil
+		case types.T_decimal64, types.T_decimal128:
+			return sum.NewDecimal(typ), nil
 		}
 	case aggregation.Count:
 		return count.New(typ), nil
 	case aggregation.StarCount:
 		return starcount.New(typ), nil
+	case aggregation.CountDistinct, aggregation.GroupConcat, aggregation.ApproxCountDistinct:
+		return aggfunc.New(aggregation.Extend{Op: op}, typ), nil
+	case aggregation.StdDevPop, aggregation.StdDevSamp, aggregation.VarPop, aggregation.VarSamp:
+		switch typ.Oid {
+		case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+			types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+			types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128:
+			return aggfunc.New(aggregation.Extend{Op: op}, typ), nil
+		}
+	case aggregation.BitAnd, aggregation.BitOr, aggregation.BitXor:
+		switch typ.Oid {
+		case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+			types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+			return aggfunc.New(aggregation.Extend{Op: op}, typ), nil
+		}
+	case aggregation.AnyValue:
+		if agg := aggfunc.New(aggregation.Extend{Op: op}, typ); agg != nil {
+			return agg, nil
+		}
 	}
-	return nil, sqlerror.New(errno.UndefinedFunction, fmt.Sprintf("unimplemented aggregation '%v' for '%s'", op, typ))
+	return nil, sqlerror.New(errno.UndefinedFunction, fmt.Sprintf("unimplemented aggregation '%s' for '%s'", aggregation.AggName[op], typ))
 }
@@ -15,13 +15,39 @@
 package build
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
+// Executor runs a query and returns all the rows of its result columns,
+// it is used to materialise the subqueries of the statement being built.
+type Executor func(op.OP) (*batch.Batch, error)
+
 type build struct {
 	db   string
 	sql  string
 	e    engine.Engine
+	exec Executor
 	proc *process.Process
+	// subqueries caches the materialised subqueries of the statement
+	subqueries map[*tree.Subquery]*subquery
+}
+
+// subquery is a materialised subquery. A correlated subquery is decorrelated
+// into the query of the inner sides of the equalities correlating it with
+// the outer query, the rows of the query are matched against the outer sides.
+type subquery struct {
+	// outer are the outer sides of the correlation equalities
+	outer []tree.Expr
+	// keys are the inner sides of the correlation equalities
+	keys []*vector.Vector
+	// vecs are the columns of the subquery
+	vecs []*vector.Vector
+	// count is true if the value of a scalar subquery is a count,
+	// which is zero rather than null for the outer rows without rows.
+	count bool
 }
@@ -0,0 +1,127 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/union"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+)
+
+var unionTypes = map[tree.UnionType]int{
+	tree.UNION:     union.UnionOp,
+	tree.INTERSECT: union.IntersectOp,
+	tree.EXCEPT:    union.ExceptOp,
+}
+
+// buildUnion builds a set operation, the columns of both sides are matched by
+// position and cast to a common type, they take the names of the left side.
+func (b *build) buildUnion(stmt *tree.UnionClause) (op.OP, []*projection.Extend, error) {
+	r, err := b.buildUnionSelect(stmt.Left)
+	if err != nil {
+		return nil, nil, err
+	}
+	s, err := b.buildUnionSelect(stmt.Right)
+	if err != nil {
+		return nil, nil, err
+	}
+	rattrs, sattrs := r.ResultColumns(), s.ResultColumns()
+	if len(rattrs) != len(sattrs) {
+		return nil, nil, sqlerror.New(errno.CardinalityViolation, "The used SELECT statements have a different number of columns")
+	}
+	rmp, smp := r.Attribute(), s.Attribute()
+	res := make([]*projection.Extend, len(rattrs))
+	ses := make([]*projection.Extend, len(sattrs))
+	for i := range rattrs {
+		typ, err := unionType(rmp[rattrs[i]].Oid, smp[sattrs[i]].Oid)
+		if err != nil {
+			return nil, nil, err
+		}
+		res[i] = &projection.Extend{
+			Alias: rattrs[i],
+			E:     castAttribute(rattrs[i], rmp[rattrs[i]].Oid, typ),
+		}
+		ses[i] = &projection.Extend{
+			Alias: rattrs[i],
+			E:     castAttribute(sattrs[i], smp[sattrs[i]].Oid, typ),
+		}
+	}
+	if r, err = projection.New(r, res); err != nil {
+		return nil, nil, err
+	}
+	if s, err = projection.New(s, ses); err != nil {
+		return nil, nil, err
+	}
+	o := union.New(r, s, unionTypes[stmt.Type], stmt.All)
+	es := make([]*projection.Extend, len(rattrs))
+	for i, attr := range rattrs {
+		es[i] = &projection.Extend{
+			Alias: attr,
+			E: &extend.Attribute{
+				Name: attr,
+				Type: o.Attrs[attr].Oid,
+			},
+		}
+	}
+	return o, es, nil
+}
+
+func (b *build) buildUnionSelect(stmt tree.SelectStatement) (op.OP, error) {
+	switch stmt := stmt.(type) {
+	case *tree.ParenSelect:
+		return b.buildSelect(stmt.Select)
+	case *tree.SelectClause, *tree.UnionClause:
+		return b.buildSelectWithoutParens(stmt, nil, nil)
+	}
+	return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown select statement: %T", stmt))
+}
+
+// unionType returns the type of a column whose values are of type lt and rt,
+// a string mixed with any other type gives a string.
+func unionType(lt, rt types.T) (types.Type, error) {
+	switch {
+	case lt == rt:
+		return lt.ToType(), nil
+	case lt == types.T_char || lt == types.T_varchar || rt == types.T_char || rt == types.T_varchar:
+		return types.T(types.T_varchar).ToType(), nil
+	}
+	if typ, ok := overload.CommonType(lt, rt); ok {
+		return typ, nil
+	}
+	return types.Type{}, sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("cannot union the types %s and %s", lt, rt))
+}
+
+func castAttribute(name string, oid types.T, typ types.Type) extend.Extend {
+	attr := &extend.Attribute{
+		Name: name,
+		Type: oid,
+	}
+	if oid == typ.Oid {
+		return attr
+	}
+	return &extend.BinaryExtend{
+		Op:    overload.Typecast,
+		Left:  attr,
+		Right: &extend.ValueExtend{V: vector.New(typ)},
+	}
+}
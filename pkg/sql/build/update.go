@@ -0,0 +1,100 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package build
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/update"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+)
+
+// buildUpdate builds the update replacing the rows selected by the WHERE
+// clause, no update is built if no row is selected.
+func (b *build) buildUpdate(stmt *tree.Update) (op.OP, error) {
+	if stmt.From != nil || stmt.OrderBy != nil || stmt.Limit != nil {
+		return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport clause: '%v'", stmt))
+	}
+	tbl, ok := mutationTable(stmt.Table)
+	if !ok {
+		return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table: '%v'", stmt.Table))
+	}
+	s := len(tbl.SchemaName) == 0
+	db, id, r, err := b.tableName(tbl)
+	if err != nil {
+		return nil, err
+	}
+	o, err := b.getTable(s, db, id)
+	if err != nil {
+		return nil, err
+	}
+	var e extend.Extend
+	if stmt.Where != nil {
+		if e, ok, err = b.buildMutationCondition(o, stmt.Where); err != nil || !ok {
+			return nil, err
+		}
+	}
+	mp := make(map[string]tree.Expr)
+	for _, expr := range stmt.Exprs {
+		if expr.Tuple || len(expr.Names) != 1 {
+			return nil, sqlerror.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport expression: '%v'", expr))
+		}
+		name := expr.Names[0].Parts[0]
+		if _, ok := mp[name]; ok {
+			return nil, sqlerror.New(errno.DuplicateColumn, fmt.Sprintf("column '%s' specified twice", name))
+		}
+		mp[name] = expr.Expr
+	}
+	// the rows updated stay in their partition, so the fields of the
+	// partition can't be updated
+	if pb := r.Partition(); pb != nil {
+		for _, field := range pb.Fields {
+			if _, ok := mp[field]; ok {
+				return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("can't update the partition column '%s'", field))
+			}
+		}
+	}
+	attrs := r.Attribute()
+	names := make([]string, len(attrs))
+	sets := make(map[string]extend.Extend)
+	for i, attr := range attrs {
+		names[i] = attr.Name
+		expr, ok := mp[attr.Name]
+		if !ok {
+			continue
+		}
+		delete(mp, attr.Name)
+		pe, err := b.buildProjectionExtend(o, expr)
+		if err != nil {
+			return nil, err
+		}
+		if pe.ReturnType() != attr.Type.Oid {
+			pe = &extend.BinaryExtend{
+				Op:    overload.Typecast,
+				Left:  pe,
+				Right: &extend.ValueExtend{V: vector.New(attr.Type)},
+			}
+		}
+		sets[attr.Name] = pe
+	}
+	for name := range mp {
+		return nil, sqlerror.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s' in 'field list'", name))
+	}
+	return update.New(id, db, e, names, sets, r), nil
+}
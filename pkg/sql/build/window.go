@@ -0,0 +1,624 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	vorder "github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	vwindow "github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+	"strconv"
+	"strings"
+)
+
+var WindowFuncs map[string]int = map[string]int{
+	"row_number": vwindow.RowNumber,
+	"rank":       vwindow.Rank,
+	"dense_rank": vwindow.DenseRank,
+	"lag":        vwindow.Lag,
+	"lead":       vwindow.Lead,
+}
+
+func (b *build) hasWindow(n tree.Expr) bool {
+	switch e := n.(type) {
+	case *tree.ParenExpr:
+		return b.hasWindow(e.Expr)
+	case *tree.OrExpr:
+		return b.hasWindow(e.Left) || b.hasWindow(e.Right)
+	case *tree.NotExpr:
+		return b.hasWindow(e.Expr)
+	case *tree.AndExpr:
+		return b.hasWindow(e.Left) || b.hasWindow(e.Right)
+	case *tree.UnaryExpr:
+		return b.hasWindow(e.Expr)
+	case *tree.BinaryExpr:
+		return b.hasWindow(e.Left) || b.hasWindow(e.Right)
+	case *tree.ComparisonExpr:
+		return b.hasWindow(e.Left) || b.hasWindow(e.Right)
+	case *tree.FuncExpr:
+		if e.WindowSpec != nil {
+			return true
+		}
+		for _, expr := range e.Exprs {
+			if b.hasWindow(expr) {
+				return true
+			}
+		}
+	case *tree.CastExpr:
+		return b.hasWindow(e.Expr)
+	case *tree.RangeCond:
+		return b.hasWindow(e.Left) || b.hasWindow(e.From) || b.hasWindow(e.To)
+	case *tree.CaseExpr:
+		for _, expr := range caseExprs(e) {
+			if b.hasWindow(expr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b *build) hasWindows(ns tree.SelectExprs) bool {
+	for _, n := range ns {
+		if b.hasWindow(n.Expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildSelectClauseWithWindow builds a select whose projection has window
+// functions, the window functions are evaluated after where and before the
+// projection, and they are replaced by their results in the projection.
+func (b *build) buildSelectClauseWithWindow(stmt *tree.SelectClause, orderBy tree.OrderBy) (op.OP, []*projection.Extend, error) {
+	var o op.OP
+	var err error
+	var es, pes []*projection.Extend
+	var fs []*tree.FuncExpr
+
+	if len(stmt.GroupBy) > 0 || stmt.Having != nil || b.hasSummarize(stmt.Exprs) {
+		return nil, nil, sqlerror.New(errno.FeatureNotSupported, "window functions with aggregation are not support now")
+	}
+	if o, err = b.buildFrom(stmt.From.Tables); err != nil {
+		return nil, nil, err
+	}
+	for i, n := range stmt.Exprs {
+		if len(n.As) == 0 && b.hasWindow(n.Expr) {
+			stmt.Exprs[i].As = tree.UnrestrictedIdentifier(tree.String(n.Expr, dialect.MYSQL))
+		}
+	}
+	if stmt.Exprs, err = b.rewriteProjection(o, stmt.Exprs); err != nil {
+		return nil, nil, err
+	}
+	for _, ord := range orderBy {
+		if name, ok := ord.Expr.(*tree.UnresolvedName); ok && name.NumParts == 1 {
+			for _, n := range stmt.Exprs {
+				if string(n.As) == name.Parts[0] && b.hasWindow(n.Expr) {
+					ord.Expr = n.Expr
+					break
+				}
+			}
+		}
+	}
+	mp := make(map[string]uint8)
+	{
+		if stmt.Where != nil {
+			if err := b.extractExtend(o, stmt.Where.Expr, &es, mp); err != nil {
+				return nil, nil, err
+			}
+		}
+		for _, n := range stmt.Exprs {
+			if err := b.extractExtend(o, n.Expr, &es, mp); err != nil {
+				return nil, nil, err
+			}
+		}
+		for _, ord := range orderBy {
+			if err := b.extractExtend(o, ord.Expr, &es, mp); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	mq := make(map[string]uint8)
+	for i, n := range stmt.Exprs {
+		if stmt.Exprs[i].Expr, err = b.stripWindow(n.Expr, &fs, mq); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, ord := range orderBy {
+		if ord.Expr, err = b.stripWindow(ord.Expr, &fs, mq); err != nil {
+			return nil, nil, err
+		}
+	}
+	if star := o.ResultColumns()[0]; len(es) == 0 || hasStarCount(fs) {
+		if _, ok := mp[star]; !ok {
+			mp[star] = 0
+			es = append(es, &projection.Extend{
+				E: &extend.Attribute{Name: star, Type: o.Attribute()[star].Oid},
+			})
+		}
+	}
+	if o, err = projection.New(o, es); err != nil {
+		return nil, nil, err
+	}
+	if stmt.Where != nil {
+		if o, err = b.buildWhere(o, stmt.Where); err != nil {
+			return nil, nil, err
+		}
+	}
+	if o, err = b.buildWindow(o, fs); err != nil {
+		return nil, nil, err
+	}
+	{
+		es = nil
+		mp = make(map[string]uint8)
+		for _, ord := range orderBy {
+			if err := b.extractExtend(o, ord.Expr, &es, mp); err != nil {
+				return nil, nil, err
+			}
+		}
+		if o, pes, err = b.buildProjectionWithOrder(o, stmt.Exprs, es, mp); err != nil {
+			return nil, nil, err
+		}
+	}
+	if stmt.Distinct {
+		if o, err = b.buildDedup(o); err != nil {
+			return nil, nil, err
+		}
+	}
+	return o, pes, nil
+}
+
+// stripWindow replaces the window functions of n by the attributes of their
+// results, and appends the window functions to fs.
+func (b *build) stripWindow(n tree.Expr, fs *[]*tree.FuncExpr, mq map[string]uint8) (tree.Expr, error) {
+	var err error
+
+	switch e := n.(type) {
+	case *tree.ParenExpr:
+		if e.Expr, err = b.stripWindow(e.Expr, fs, mq); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *tree.OrExpr:
+		if e.Left, err = b.stripWindow(e.Left, fs, mq); err != nil {
+			return nil, err
+		}
+		if e.Right, err = b.stripWindow(e.Right, fs, mq); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *tree.NotExpr:
+		if e.Expr, err = b.stripWindow(e.Expr, fs, mq); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *tree.AndExpr:
+		if e.Left, err = b.stripWindow(e.Left, fs, mq); err != nil {
+			return nil, err
+		}
+		if e.Right, err = b.stripWindow(e.Right, fs, mq); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *tree.UnaryExpr:
+		if e.Expr, err = b.stripWindow(e.Expr, fs, mq); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *tree.BinaryExpr:
+		if e.Left, err = b.stripWindow(e.Left, fs, mq); err != nil {
+			return nil, err
+		}
+		if e.Right, err = b.stripWindow(e.Right, fs, mq); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *tree.ComparisonExpr:
+		if e.Left, err = b.stripWindow(e.Left, fs, mq); err != nil {
+			return nil, err
+		}
+		if e.Right, err = b.stripWindow(e.Right, fs, mq); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *tree.FuncExpr:
+		if e.WindowSpec != nil {
+			for _, expr := range e.Exprs {
+				if b.hasWindow(expr) {
+					return nil, sqlerror.New(errno.WindowingError, fmt.Sprintf("nested window function '%s'", tree.String(e, dialect.MYSQL)))
+				}
+			}
+			alias := tree.String(e, dialect.MYSQL)
+			if _, ok := mq[alias]; !ok {
+				mq[alias] = 0
+				*fs = append(*fs, e)
+			}
+			return &tree.UnresolvedName{
+				NumParts: 1,
+				Parts:    [4]string{alias},
+			}, nil
+		}
+		for i := range e.Exprs {
+			if e.Exprs[i], err = b.stripWindow(e.Exprs[i], fs, mq); err != nil {
+				return nil, err
+			}
+		}
+		return e, nil
+	case *tree.CastExpr:
+		if e.Expr, err = b.stripWindow(e.Expr, fs, mq); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *tree.CaseExpr:
+		if e.Expr != nil {
+			if e.Expr, err = b.stripWindow(e.Expr, fs, mq); err != nil {
+				return nil, err
+			}
+		}
+		for _, w := range e.Whens {
+			if w.Cond, err = b.stripWindow(w.Cond, fs, mq); err != nil {
+				return nil, err
+			}
+			if w.Val, err = b.stripWindow(w.Val, fs, mq); err != nil {
+				return nil, err
+			}
+		}
+		if e.Else != nil {
+			if e.Else, err = b.stripWindow(e.Else, fs, mq); err != nil {
+				return nil, err
+			}
+		}
+		return e, nil
+	}
+	return n, nil
+}
+
+func hasStarCount(fs []*tree.FuncExpr) bool {
+	for _, f := range fs {
+		if isStarCount(f) {
+			return true
+		}
+	}
+	return false
+}
+
+func isStarCount(f *tree.FuncExpr) bool {
+	if isCount(f) && len(f.Exprs) == 1 {
+		_, ok := f.Exprs[0].(*tree.NumVal)
+		return ok
+	}
+	return false
+}
+
+// buildWindow builds the window functions fs over o, the arguments, the
+// partition keys and the order keys of fs are projected before.
+func (b *build) buildWindow(o op.OP, fs []*tree.FuncExpr) (op.OP, error) {
+	var err error
+	var es []*projection.Extend
+
+	mp := make(map[string]uint8)
+	for _, attr := range o.ResultColumns() {
+		mp[attr] = 0
+		es = append(es, &projection.Extend{
+			E: &extend.Attribute{Name: attr, Type: o.Attribute()[attr].Oid},
+		})
+	}
+	add := func(n tree.Expr) (extend.Extend, error) {
+		e, err := b.buildProjectionExtend(o, n)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := e.(*extend.ValueExtend); ok {
+			return e, nil
+		}
+		if _, ok := mp[e.String()]; !ok {
+			mp[e.String()] = 0
+			es = append(es, &projection.Extend{E: e})
+		}
+		return e, nil
+	}
+	wfs := make([]vwindow.Function, len(fs))
+	args := make([][]extend.Extend, len(fs))
+	for i, f := range fs {
+		name, ok := f.Func.FunctionReference.(*tree.UnresolvedName)
+		if !ok {
+			return nil, sqlerror.New(errno.SyntaxError, fmt.Sprintf("illegal expression '%s'", f))
+		}
+		wfs[i].Alias = tree.String(f, dialect.MYSQL)
+		fn := strings.ToLower(name.Parts[0])
+		switch op, ok := WindowFuncs[fn]; {
+		case ok:
+			wfs[i].Op = op
+		case isStarCount(f):
+			wfs[i].Op = vwindow.Aggregate
+			wfs[i].Agg.Op = aggregation.StarCount
+			wfs[i].Name = o.ResultColumns()[0]
+		default:
+			if wfs[i].Agg.Op, err = aggregateOp(fn, f); err != nil {
+				return nil, err
+			}
+			if f.Type == tree.FUNC_TYPE_DISTINCT || wfs[i].Agg.Op == aggregation.GroupConcat {
+				return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("'%s' as window function is not support now", fn))
+			}
+			wfs[i].Op = vwindow.Aggregate
+		}
+		switch wfs[i].Op {
+		case vwindow.RowNumber, vwindow.Rank, vwindow.DenseRank:
+			if len(f.Exprs) != 0 {
+				return nil, sqlerror.New(errno.SyntaxError, fmt.Sprintf("incorrect parameter count in the call to '%s'", fn))
+			}
+		case vwindow.Lag, vwindow.Lead:
+			if len(f.Exprs) == 0 || len(f.Exprs) > 3 {
+				return nil, sqlerror.New(errno.SyntaxError, fmt.Sprintf("incorrect parameter count in the call to '%s'", fn))
+			}
+		default:
+			if len(f.Exprs) != 1 {
+				return nil, sqlerror.New(errno.SyntaxError, fmt.Sprintf("incorrect parameter count in the call to '%s'", fn))
+			}
+		}
+		if len(wfs[i].Name) == 0 && len(f.Exprs) > 0 {
+			e, err := add(f.Exprs[0])
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := e.(*extend.ValueExtend); ok {
+				return nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("constant argument of window function '%s' is not support now", fn))
+			}
+			wfs[i].Name = e.String()
+			for _, n := range f.Exprs[1:] {
+				e, err := b.buildProjectionExtend(o, n)
+				if err != nil {
+					return nil, err
+				}
+				args[i] = append(args[i], e)
+			}
+		}
+		for _, n := range f.WindowSpec.PartitionBy {
+			e, err := add(n)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := e.(*extend.ValueExtend); !ok {
+				wfs[i].Ps = append(wfs[i].Ps, e.String())
+			}
+		}
+		for _, ord := range f.WindowSpec.OrderBy {
+			e, err := add(ord.Expr)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := e.(*extend.ValueExtend); !ok {
+				wfs[i].Os = append(wfs[i].Os, vorder.Field{
+					Attr: e.String(),
+					Type: vorder.Direction(getDirection(ord.Direction)),
+				})
+			}
+		}
+		if wfs[i].Frame, err = b.buildFrame(o, f.WindowSpec); err != nil {
+			return nil, err
+		}
+	}
+	if o, err = projection.New(o, es); err != nil {
+		return nil, err
+	}
+	attrs := o.Attribute()
+	for i := range wfs {
+		f := &wfs[i]
+		switch f.Op {
+		case vwindow.Lag, vwindow.Lead:
+			if f.Offset, f.Default, err = buildOffsetArgs(args[i], attrs[f.Name]); err != nil {
+				return nil, err
+			}
+		case vwindow.Aggregate:
+			if f.Agg.Agg, err = newAggregate(f.Agg.Op, attrs[f.Name]); err != nil {
+				return nil, err
+			}
+			f.Agg.Name, f.Agg.Alias = f.Name, f.Alias
+			if err := checkFrame(f, attrs); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return window.New(o, wfs)
+}
+
+// buildFrame returns the frame of the window spec, the frame is from the
+// start of the partition to the last peer of the current row if the rows
+// are ordered, or else the whole partition.
+func (b *build) buildFrame(o op.OP, spec *tree.WindowSpec) (vwindow.Frame, error) {
+	var err error
+	var f vwindow.Frame
+
+	if spec.Frame == nil {
+		if len(spec.OrderBy) > 0 {
+			f.Type = vwindow.Range
+			f.End.Type = vwindow.CurrentRow
+		} else {
+			f.Type = vwindow.Rows
+			f.End.Type = vwindow.UnboundedFollowing
+		}
+		f.Start.Type = vwindow.UnboundedPreceding
+		return f, nil
+	}
+	if spec.Frame.Type == tree.FRAME_RANGE {
+		f.Type = vwindow.Range
+	}
+	if f.Start, err = b.buildFrameBound(o, f.Type, spec.Frame.Start); err != nil {
+		return f, err
+	}
+	f.End.Type = vwindow.CurrentRow
+	if spec.Frame.End != nil {
+		if f.End, err = b.buildFrameBound(o, f.Type, spec.Frame.End); err != nil {
+			return f, err
+		}
+	}
+	if f.Start.Type == vwindow.UnboundedFollowing || f.End.Type == vwindow.UnboundedPreceding || f.Start.Type > f.End.Type {
+		return f, sqlerror.New(errno.WindowingError, fmt.Sprintf("invalid window frame '%s'", tree.String(spec.Frame, dialect.MYSQL)))
+	}
+	return f, nil
+}
+
+func (b *build) buildFrameBound(o op.OP, typ int, n *tree.FrameBound) (vwindow.Bound, error) {
+	bd := vwindow.Bound{Type: int(n.Type)}
+	if n.Type != tree.PRECEDING && n.Type != tree.FOLLOWING {
+		return bd, nil
+	}
+	days, expr := int64(1), n.Expr
+	if interval, ok := expr.(*tree.IntervalExpr); ok {
+		switch {
+		case typ != vwindow.Range:
+			return bd, sqlerror.New(errno.WindowingError, fmt.Sprintf("invalid offset '%s' of window frame", tree.String(n.Expr, dialect.MYSQL)))
+		case interval.Type == tree.INTERVAL_TYPE_DAY:
+		case interval.Type == tree.INTERVAL_TYPE_WEEK:
+			days = 7
+		default:
+			return bd, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("interval unit '%s' of window frame is not support now", interval.Type.ToString()))
+		}
+		expr = interval.Expr
+	}
+	e, err := b.buildProjectionExtend(o, expr)
+	if err != nil {
+		return bd, err
+	}
+	if v, ok := e.(*extend.ValueExtend); ok && !isNullValue(e) {
+		switch vs := v.V.Col.(type) {
+		case []int64:
+			bd.Offset = float64(vs[0] * days)
+		case []float64:
+			if typ == vwindow.Range {
+				bd.Offset = vs[0]
+			} else {
+				bd.Offset = -1
+			}
+		default:
+			bd.Offset = -1
+		}
+		if bd.Offset >= 0 {
+			return bd, nil
+		}
+	}
+	return bd, sqlerror.New(errno.WindowingError, fmt.Sprintf("invalid offset '%s' of window frame", tree.String(n.Expr, dialect.MYSQL)))
+}
+
+// checkFrame checks the order keys of the range frame with offsets, which
+// needs exactly one numeric or date order key.
+func checkFrame(f *vwindow.Function, attrs map[string]types.Type) error {
+	if f.Frame.Type != vwindow.Range {
+		return nil
+	}
+	if f.Frame.Start.Type != vwindow.Preceding && f.Frame.Start.Type != vwindow.Following &&
+		f.Frame.End.Type != vwindow.Preceding && f.Frame.End.Type != vwindow.Following {
+		return nil
+	}
+	if len(f.Os) == 1 {
+		switch typ := attrs[f.Os[0].Attr].Oid; {
+		case isInteger(typ), typ == types.T_float32, typ == types.T_float64, typ == types.T_date:
+			return nil
+		}
+	}
+	return sqlerror.New(errno.WindowingError, fmt.Sprintf("window '%s' with range frame needs exactly one numeric or date order key", f.Alias))
+}
+
+// buildOffsetArgs returns the offset and the default value of lag and lead.
+func buildOffsetArgs(args []extend.Extend, typ types.Type) (int64, *vector.Vector, error) {
+	var off int64 = 1
+
+	if len(args) > 0 {
+		v, ok := args[0].(*extend.ValueExtend)
+		if !ok || v.V.Typ.Oid != types.T_int64 || isNullValue(v) || v.V.Col.([]int64)[0] < 0 {
+			return 0, nil, sqlerror.New(errno.WindowingError, fmt.Sprintf("invalid offset '%s'", args[0]))
+		}
+		off = v.V.Col.([]int64)[0]
+	}
+	if len(args) < 2 || isNullValue(args[1]) {
+		return off, nil, nil
+	}
+	v, ok := args[1].(*extend.ValueExtend)
+	if !ok {
+		return 0, nil, sqlerror.New(errno.FeatureNotSupported, fmt.Sprintf("non-constant default value '%s' is not support now", args[1]))
+	}
+	if err := convertDefault(v, typ); err != nil {
+		return 0, nil, err
+	}
+	return off, v.V, nil
+}
+
+// convertDefault converts the default value of lag and lead to typ.
+func convertDefault(e *extend.ValueExtend, typ types.Type) error {
+	if e.V.Typ.Oid == typ.Oid && !types.IsDecimal(typ.Oid) {
+		return nil
+	}
+	switch typ.Oid {
+	case types.T_int8:
+		return toInt8(e)
+	case types.T_int16:
+		return toInt16(e)
+	case types.T_int32:
+		return toInt32(e)
+	case types.T_int64:
+		return toInt64(e)
+	case types.T_uint8:
+		return toUint8(e)
+	case types.T_uint16:
+		return toUint16(e)
+	case types.T_uint32:
+		return toUint32(e)
+	case types.T_uint64:
+		return toUint64(e)
+	case types.T_float32:
+		return toFloat32(e)
+	case types.T_float64:
+		return toFloat64(e)
+	case types.T_char, types.T_varchar:
+		return toString(e, typ.Oid)
+	case types.T_date, types.T_datetime:
+		return toDate(e, typ.Oid)
+	case types.T_decimal64, types.T_decimal128:
+		var s string
+
+		switch e.V.Typ.Oid {
+		case types.T_int64:
+			s = strconv.FormatInt(e.V.Col.([]int64)[0], 10)
+		case types.T_float64:
+			s = strconv.FormatFloat(e.V.Col.([]float64)[0], 'f', -1, 64)
+		default:
+			return sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("cannot convert %s to %s", e.V.Typ, typ))
+		}
+		v, err := buildConstantDecimal(typ, s)
+		if err != nil {
+			return err
+		}
+		vec := vector.New(typ)
+		switch d := v.(type) {
+		case types.Decimal64:
+			vec.Col = []types.Decimal64{d}
+		case types.Decimal128:
+			vec.Col = []types.Decimal128{d}
+		}
+		e.V = vec
+		return nil
+	}
+	return sqlerror.New(errno.DatatypeMismatch, fmt.Sprintf("cannot convert %s to %s", e.V.Typ, typ))
+}
@@ -17,12 +17,18 @@ package aggfunc
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/anyvalue"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/approxcount"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/avg"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/bit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/count"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/distinct"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/groupconcat"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/max"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/min"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/starcount"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/sum"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/variance"
 )
 
 func NewCount(typ types.Type) aggregation.Aggregation {
@@ -55,6 +61,10 @@ func NewMax(typ types.Type) aggregation.Aggregation {
 		return max.NewFloat32(aggregation.ReturnType(aggregation.Max, typ))
 	case types.T_float64:
 		return max.NewFloat64(aggregation.ReturnType(aggregation.Max, typ))
+	case types.T_decimal64:
+		return max.NewDecimal64(aggregation.ReturnType(aggregation.Max, typ))
+	case types.T_decimal128:
+		return max.NewDecimal128(aggregation.ReturnType(aggregation.Max, typ))
 	case types.T_char, types.T_varchar:
 		return max.NewStr(aggregation.ReturnType(aggregation.Max, typ))
 	}
@@ -83,6 +93,10 @@ func NewMin(typ types.Type) aggregation.Aggregation {
 		return min.NewFloat32(aggregation.ReturnType(aggregation.Max, typ))
 	case types.T_float64:
 		return min.NewFloat64(aggregation.ReturnType(aggregation.Max, typ))
+	case types.T_decimal64:
+		return min.NewDecimal64(aggregation.ReturnType(aggregation.Max, typ))
+	case types.T_decimal128:
+		return min.NewDecimal128(aggregation.ReturnType(aggregation.Max, typ))
 	case types.T_char, types.T_varchar:
 		return min.NewStr(aggregation.ReturnType(aggregation.Max, typ))
 	}
@@ -97,6 +111,8 @@ func NewSum(typ types.Type) aggregation.Aggregation {
 		return sum.NewInt(aggregation.ReturnType(aggregation.Sum, typ))
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 		return sum.NewUint(aggregation.ReturnType(aggregation.Sum, typ))
+	case types.T_decimal64, types.T_decimal128:
+		return sum.NewDecimal(aggregation.ReturnType(aggregation.Sum, typ))
 	}
 	return nil
 }
@@ -104,6 +120,9 @@ func NewSum(typ types.Type) aggregation.Aggregation {
 func NewAvg(typ types.Type) aggregation.Aggregation {
 	switch typ.Oid {
 	case types.T_tuple:
+		if typ.Width > 0 {
+			return avg.NewDecimal(aggregation.ReturnType(aggregation.Avg, typ))
+		}
 		return avg.NewSumCount(aggregation.ReturnType(aggregation.Avg, typ))
 	case types.T_float32, types.T_float64:
 		return avg.NewFloat(aggregation.ReturnType(aggregation.Avg, typ))
@@ -111,6 +130,8 @@ func NewAvg(typ types.Type) aggregation.Aggregation {
 		return avg.NewInt(aggregation.ReturnType(aggregation.Avg, typ))
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 		return avg.NewUint(aggregation.ReturnType(aggregation.Avg, typ))
+	case types.T_decimal64, types.T_decimal128:
+		return avg.NewDecimal(aggregation.ReturnType(aggregation.Avg, typ))
 	}
 	return nil
 }
@@ -118,6 +139,9 @@ func NewAvg(typ types.Type) aggregation.Aggregation {
 func NewSumCount(typ types.Type) aggregation.Aggregation {
 	switch typ.Oid {
 	case types.T_tuple:
+		if typ.Width > 0 {
+			return sum.NewDecimalSumCount(aggregation.ReturnType(aggregation.SumCount, typ))
+		}
 		return sum.NewSumCount(aggregation.ReturnType(aggregation.SumCount, typ))
 	case types.T_float32, types.T_float64:
 		return sum.NewFloatSumCount(aggregation.ReturnType(aggregation.SumCount, typ))
@@ -125,6 +149,60 @@ func NewSumCount(typ types.Type) aggregation.Aggregation {
 		return sum.NewIntSumCount(aggregation.ReturnType(aggregation.SumCount, typ))
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 		return sum.NewUintSumCount(aggregation.ReturnType(aggregation.SumCount, typ))
+	case types.T_decimal64, types.T_decimal128:
+		return sum.NewDecimalSumCount(aggregation.ReturnType(aggregation.SumCount, typ))
+	}
+	return nil
+}
+
+// New returns the aggregation of e for the argument of type typ, it returns
+// nil if the aggregation doesn't support typ.
+func New(e aggregation.Extend, typ types.Type) aggregation.Aggregation {
+	switch e.Op {
+	case aggregation.Avg:
+		return NewAvg(typ)
+	case aggregation.Max:
+		return NewMax(typ)
+	case aggregation.Min:
+		return NewMin(typ)
+	case aggregation.Sum:
+		return NewSum(typ)
+	case aggregation.Count:
+		return NewCount(typ)
+	case aggregation.StarCount:
+		return NewStarCount(typ)
+	case aggregation.SumCount:
+		return NewSumCount(typ)
+	case aggregation.CountDistinct:
+		return distinct.NewCount(aggregation.ReturnType(e.Op, typ))
+	case aggregation.DistinctSet:
+		return distinct.NewSet(aggregation.ReturnType(e.Op, typ))
+	case aggregation.StdDevPop:
+		return variance.NewStdDevPop(aggregation.ReturnType(e.Op, typ))
+	case aggregation.StdDevSamp:
+		return variance.NewStdDevSamp(aggregation.ReturnType(e.Op, typ))
+	case aggregation.VarPop:
+		return variance.NewVarPop(aggregation.ReturnType(e.Op, typ))
+	case aggregation.VarSamp:
+		return variance.NewVarSamp(aggregation.ReturnType(e.Op, typ))
+	case aggregation.Moment:
+		return variance.NewMoment(aggregation.ReturnType(e.Op, typ))
+	case aggregation.GroupConcat:
+		return groupconcat.New(aggregation.ReturnType(e.Op, typ), e.Distinct, e.Separator)
+	case aggregation.ConcatList:
+		return groupconcat.NewList(aggregation.ReturnType(e.Op, typ))
+	case aggregation.BitAnd:
+		return bit.NewAnd(aggregation.ReturnType(e.Op, typ))
+	case aggregation.BitOr:
+		return bit.NewOr(aggregation.ReturnType(e.Op, typ))
+	case aggregation.BitXor:
+		return bit.NewXor(aggregation.ReturnType(e.Op, typ))
+	case aggregation.AnyValue:
+		return anyvalue.New(aggregation.ReturnType(e.Op, typ))
+	case aggregation.ApproxCountDistinct:
+		return approxcount.New(aggregation.ReturnType(e.Op, typ))
+	case aggregation.HyperLogLog:
+		return approxcount.NewHyperLogLog(aggregation.ReturnType(e.Op, typ))
 	}
 	return nil
 }
@@ -0,0 +1,279 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggfunc
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/groupconcat"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	int32Type   = types.Type{Oid: types.T_int32, Size: 4, Width: 32}
+	uint8Type   = types.Type{Oid: types.T_uint8, Size: 1, Width: 8}
+	varcharType = types.Type{Oid: types.T_varchar, Size: 24}
+	decimalType = types.DecimalType(10, 2)
+)
+
+// partialOps are the partial aggregations whose results are merged by the
+// aggregations.
+var partialOps = map[int]int{
+	aggregation.Min:                 aggregation.Min,
+	aggregation.Max:                 aggregation.Max,
+	aggregation.VarPop:              aggregation.Moment,
+	aggregation.VarSamp:             aggregation.Moment,
+	aggregation.StdDevPop:           aggregation.Moment,
+	aggregation.StdDevSamp:          aggregation.Moment,
+	aggregation.CountDistinct:       aggregation.DistinctSet,
+	aggregation.GroupConcat:         aggregation.ConcatList,
+	aggregation.ApproxCountDistinct: aggregation.HyperLogLog,
+	aggregation.BitAnd:              aggregation.BitAnd,
+	aggregation.BitOr:               aggregation.BitOr,
+	aggregation.BitXor:              aggregation.BitXor,
+}
+
+type aggTestCase struct {
+	name string
+	e    aggregation.Extend
+	typ  types.Type
+	vs   []interface{} // nil is null
+	want interface{}   // nil is null
+	// delta is the allowed error of the estimated results
+	delta float64
+}
+
+func TestAggregation(t *testing.T) {
+	var vs []interface{}
+	for i := 0; i < 1000; i++ {
+		if i%10 == 0 {
+			vs = append(vs, nil)
+		} else {
+			vs = append(vs, int32(i%100))
+		}
+	}
+	tcs := []aggTestCase{
+		{name: "min", e: aggregation.Extend{Op: aggregation.Min}, typ: int32Type,
+			vs: []interface{}{int32(3), nil, int32(5), int32(4)}, want: int32(3)},
+		{name: "max of negatives", e: aggregation.Extend{Op: aggregation.Max}, typ: int32Type,
+			vs: []interface{}{int32(-3), nil, int32(-5), nil}, want: int32(-3)},
+		{name: "min of decimals", e: aggregation.Extend{Op: aggregation.Min}, typ: decimalType,
+			vs: []interface{}{types.Decimal64(250), nil, types.Decimal64(150)}, want: types.Decimal64(150)},
+		{name: "max of strings", e: aggregation.Extend{Op: aggregation.Max}, typ: varcharType,
+			vs: []interface{}{nil, "a", "c", "b"}, want: []byte("c")},
+		{name: "min of nulls", e: aggregation.Extend{Op: aggregation.Min}, typ: int32Type,
+			vs: []interface{}{nil, nil}, want: nil},
+		{name: "max of empty group", e: aggregation.Extend{Op: aggregation.Max}, typ: decimalType,
+			want: nil},
+
+		{name: "var_pop", e: aggregation.Extend{Op: aggregation.VarPop}, typ: int32Type,
+			vs: []interface{}{int32(2), nil, int32(4), int32(4), int32(4), int32(5), nil, int32(5), int32(7), int32(9)}, want: 4.0},
+		{name: "var_samp", e: aggregation.Extend{Op: aggregation.VarSamp}, typ: int32Type,
+			vs: []interface{}{int32(2), nil, int32(4), int32(4), int32(4), int32(5), nil, int32(5), int32(7), int32(9)}, want: 32.0 / 7},
+		{name: "stddev_pop", e: aggregation.Extend{Op: aggregation.StdDevPop}, typ: int32Type,
+			vs: []interface{}{int32(2), nil, int32(4), int32(4), int32(4), int32(5), nil, int32(5), int32(7), int32(9)}, want: 2.0},
+		{name: "stddev_samp", e: aggregation.Extend{Op: aggregation.StdDevSamp}, typ: int32Type,
+			vs: []interface{}{int32(2), nil, int32(4), int32(4), int32(4), int32(5), nil, int32(5), int32(7), int32(9)}, want: math.Sqrt(32.0 / 7)},
+		{name: "var_pop of decimals", e: aggregation.Extend{Op: aggregation.VarPop}, typ: decimalType,
+			vs: []interface{}{types.Decimal64(150), nil, types.Decimal64(250)}, want: 0.25},
+		{name: "var_pop of one value", e: aggregation.Extend{Op: aggregation.VarPop}, typ: int32Type,
+			vs: []interface{}{nil, int32(3)}, want: 0.0},
+		{name: "var_samp of one value", e: aggregation.Extend{Op: aggregation.VarSamp}, typ: int32Type,
+			vs: []interface{}{nil, int32(3)}, want: nil},
+		{name: "var_pop of nulls", e: aggregation.Extend{Op: aggregation.VarPop}, typ: int32Type,
+			vs: []interface{}{nil, nil}, want: nil},
+		{name: "stddev_samp of empty group", e: aggregation.Extend{Op: aggregation.StdDevSamp}, typ: int32Type,
+			want: nil},
+
+		{name: "bit_and", e: aggregation.Extend{Op: aggregation.BitAnd}, typ: int32Type,
+			vs: []interface{}{int32(12), nil, int32(10), int32(14)}, want: uint64(8)},
+		{name: "bit_or", e: aggregation.Extend{Op: aggregation.BitOr}, typ: int32Type,
+			vs: []interface{}{int32(12), nil, int32(10), int32(14)}, want: uint64(14)},
+		{name: "bit_xor", e: aggregation.Extend{Op: aggregation.BitXor}, typ: int32Type,
+			vs: []interface{}{int32(12), nil, int32(10), int32(14)}, want: uint64(8)},
+		{name: "bit_and of negatives", e: aggregation.Extend{Op: aggregation.BitAnd}, typ: int32Type,
+			vs: []interface{}{int32(-1), int32(-2)}, want: math.MaxUint64 - uint64(1)},
+		{name: "bit_or of uint8", e: aggregation.Extend{Op: aggregation.BitOr}, typ: uint8Type,
+			vs: []interface{}{uint8(1), uint8(128), nil}, want: uint64(129)},
+		{name: "bit_and of nulls", e: aggregation.Extend{Op: aggregation.BitAnd}, typ: int32Type,
+			vs: []interface{}{nil}, want: uint64(math.MaxUint64)},
+		{name: "bit_and of empty group", e: aggregation.Extend{Op: aggregation.BitAnd}, typ: int32Type,
+			want: uint64(math.MaxUint64)},
+		{name: "bit_or of empty group", e: aggregation.Extend{Op: aggregation.BitOr}, typ: int32Type,
+			want: uint64(0)},
+		{name: "bit_xor of nulls", e: aggregation.Extend{Op: aggregation.BitXor}, typ: int32Type,
+			vs: []interface{}{nil, nil}, want: uint64(0)},
+
+		{name: "approx_count_distinct", e: aggregation.Extend{Op: aggregation.ApproxCountDistinct}, typ: int32Type,
+			vs: vs, want: int64(90), delta: 5},
+		{name: "approx_count_distinct of strings", e: aggregation.Extend{Op: aggregation.ApproxCountDistinct}, typ: varcharType,
+			vs: []interface{}{"a", "b", nil, "a", "c"}, want: int64(3), delta: 0.5},
+		{name: "approx_count_distinct of nulls", e: aggregation.Extend{Op: aggregation.ApproxCountDistinct}, typ: int32Type,
+			vs: []interface{}{nil, nil}, want: int64(0)},
+		{name: "approx_count_distinct of empty group", e: aggregation.Extend{Op: aggregation.ApproxCountDistinct}, typ: int32Type,
+			want: int64(0)},
+
+		{name: "count distinct", e: aggregation.Extend{Op: aggregation.CountDistinct}, typ: int32Type,
+			vs: vs, want: int64(90)},
+		{name: "count distinct of strings", e: aggregation.Extend{Op: aggregation.CountDistinct}, typ: varcharType,
+			vs: []interface{}{"a", "b", nil, "a", ""}, want: int64(3)},
+		{name: "count distinct of decimals", e: aggregation.Extend{Op: aggregation.CountDistinct}, typ: decimalType,
+			vs: []interface{}{types.Decimal64(150), types.Decimal64(150), nil, types.Decimal64(-150)}, want: int64(2)},
+		{name: "count distinct of nulls", e: aggregation.Extend{Op: aggregation.CountDistinct}, typ: int32Type,
+			vs: []interface{}{nil}, want: int64(0)},
+		{name: "count distinct of empty group", e: aggregation.Extend{Op: aggregation.CountDistinct}, typ: int32Type,
+			want: int64(0)},
+
+		{name: "group_concat", e: aggregation.Extend{Op: aggregation.GroupConcat, Separator: ","}, typ: varcharType,
+			vs: []interface{}{item("2", "b"), nil, item("1", "a"), item("3", "a")}, want: []byte("a,b,a")},
+		{name: "group_concat distinct", e: aggregation.Extend{Op: aggregation.GroupConcat, Distinct: true, Separator: ","}, typ: varcharType,
+			vs: []interface{}{item("2", "b"), nil, item("1", "a"), item("3", "a")}, want: []byte("a,b")},
+		{name: "group_concat with separator", e: aggregation.Extend{Op: aggregation.GroupConcat, Separator: " - "}, typ: varcharType,
+			vs: []interface{}{item("", "x"), item("", "y")}, want: []byte("x - y")},
+		{name: "group_concat of empty strings", e: aggregation.Extend{Op: aggregation.GroupConcat, Separator: ","}, typ: varcharType,
+			vs: []interface{}{item("", ""), item("", "")}, want: []byte(",")},
+		{name: "group_concat of nulls", e: aggregation.Extend{Op: aggregation.GroupConcat, Separator: ","}, typ: varcharType,
+			vs: []interface{}{nil, nil}, want: nil},
+		{name: "group_concat of empty group", e: aggregation.Extend{Op: aggregation.GroupConcat, Separator: ","}, typ: varcharType,
+			want: nil},
+	}
+	proc := process.New(guest.New(1<<20, host.New(1<<20)))
+	proc.Mp = mempool.New()
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			agg := New(tc.e, tc.typ)
+			require.NotNil(t, agg)
+			require.NoError(t, agg.Fill(nil, newVector(t, tc.typ, tc.vs)))
+			checkResult(t, tc, agg.Eval())
+			vec, err := agg.EvalCopy(proc)
+			require.NoError(t, err)
+			checkVector(t, tc, vec)
+
+			// the data is split in two parts whose partial results are merged
+			n := len(tc.vs) / 2
+			final := New(tc.e, aggregation.ReturnType(partialOps[tc.e.Op], tc.typ))
+			require.NotNil(t, final)
+			for _, vs := range [][]interface{}{tc.vs[:n], tc.vs[n:]} {
+				partial := New(aggregation.Extend{Op: partialOps[tc.e.Op]}, tc.typ)
+				require.NotNil(t, partial)
+				require.NoError(t, partial.Fill(nil, newVector(t, tc.typ, vs)))
+				vec, err := partial.EvalCopy(proc)
+				require.NoError(t, err)
+				require.NoError(t, final.Fill(nil, vec))
+			}
+			checkResult(t, tc, final.Eval())
+
+			agg.Reset()
+			require.NoError(t, agg.Fill(nil, newVector(t, tc.typ, nil)))
+			require.Equal(t, agg.Dup().Eval(), agg.Eval())
+		})
+	}
+}
+
+func item(key, v string) string {
+	return string(groupconcat.AppendItem(nil, []byte(key), []byte(v)))
+}
+
+func checkResult(t *testing.T, tc aggTestCase, r interface{}) {
+	switch want := tc.want.(type) {
+	case nil:
+		require.Nil(t, r)
+	case float64:
+		require.InDelta(t, want, r, 1e-9)
+	case int64:
+		require.InDelta(t, want, r, tc.delta)
+	default:
+		require.Equal(t, want, r)
+	}
+}
+
+func checkVector(t *testing.T, tc aggTestCase, vec *vector.Vector) {
+	require.Equal(t, 1, vec.Length())
+	if tc.want == nil {
+		require.True(t, vec.Nsp.Contains(0))
+		return
+	}
+	require.False(t, vec.Nsp.Contains(0))
+	switch vs := vec.Col.(type) {
+	case []float64:
+		checkResult(t, tc, vs[0])
+	case []int64:
+		checkResult(t, tc, vs[0])
+	case []uint64:
+		checkResult(t, tc, vs[0])
+	case *types.Bytes:
+		checkResult(t, tc, vs.Get(0))
+	default:
+		checkResult(t, tc, reflect.ValueOf(vec.Col).Index(0).Interface())
+	}
+}
+
+func newVector(t *testing.T, typ types.Type, vs []interface{}) *vector.Vector {
+	var col interface{}
+
+	switch typ.Oid {
+	case types.T_int32:
+		xs := make([]int32, len(vs))
+		for i, v := range vs {
+			if v != nil {
+				xs[i] = v.(int32)
+			}
+		}
+		col = xs
+	case types.T_uint8:
+		xs := make([]uint8, len(vs))
+		for i, v := range vs {
+			if v != nil {
+				xs[i] = v.(uint8)
+			}
+		}
+		col = xs
+	case types.T_decimal64:
+		xs := make([]types.Decimal64, len(vs))
+		for i, v := range vs {
+			if v != nil {
+				xs[i] = v.(types.Decimal64)
+			}
+		}
+		col = xs
+	case types.T_varchar:
+		xs := make([][]byte, len(vs))
+		for i, v := range vs {
+			if v != nil {
+				xs[i] = []byte(v.(string))
+			}
+		}
+		col = xs
+	default:
+		t.Fatalf("unexpected type %s", typ)
+	}
+	vec := vector.New(typ)
+	require.NoError(t, vec.Append(col))
+	for i, v := range vs {
+		if v == nil {
+			vec.Nsp.Add(uint64(i))
+		}
+	}
+	return vec
+}
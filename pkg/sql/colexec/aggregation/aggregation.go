@@ -31,7 +31,36 @@ var sumReturnTypes = map[types.T]types.Type{
 	types.T_float64: types.Type{Oid: types.T_float64, Size: 8, Width: 8, Precision: 0},
 }
 
+// DecimalAvgScale is the number of fractional digits avg adds to the scale of
+// its decimal argument.
+// fixedReturnTypes are the return types of the aggregations which
+// don't depend on the type of the argument.
+var fixedReturnTypes = map[int]types.Type{
+	CountDistinct:       types.Type{Oid: types.T_int64, Size: 8, Width: 8, Precision: 0},
+	StdDevPop:           types.Type{Oid: types.T_float64, Size: 8, Width: 8, Precision: 0},
+	StdDevSamp:          types.Type{Oid: types.T_float64, Size: 8, Width: 8, Precision: 0},
+	VarPop:              types.Type{Oid: types.T_float64, Size: 8, Width: 8, Precision: 0},
+	VarSamp:             types.Type{Oid: types.T_float64, Size: 8, Width: 8, Precision: 0},
+	GroupConcat:         types.Type{Oid: types.T_varchar, Size: 24, Width: 0, Precision: 0},
+	BitAnd:              types.Type{Oid: types.T_uint64, Size: 8, Width: 8, Precision: 0},
+	BitOr:               types.Type{Oid: types.T_uint64, Size: 8, Width: 8, Precision: 0},
+	BitXor:              types.Type{Oid: types.T_uint64, Size: 8, Width: 8, Precision: 0},
+	ApproxCountDistinct: types.Type{Oid: types.T_int64, Size: 8, Width: 8, Precision: 0},
+	DistinctSet:         types.Type{Oid: types.T_tuple, Size: 24, Width: 0, Precision: 0},
+	Moment:              types.Type{Oid: types.T_tuple, Size: 24, Width: 0, Precision: 0},
+	ConcatList:          types.Type{Oid: types.T_tuple, Size: 24, Width: 0, Precision: 0},
+	HyperLogLog:         types.Type{Oid: types.T_tuple, Size: 24, Width: 0, Precision: 0},
+}
+
+const DecimalAvgScale = 4
+
 func ReturnType(op int, typ types.Type) types.Type {
+	if rtyp, ok := fixedReturnTypes[op]; ok {
+		return rtyp
+	}
+	if types.IsDecimal(typ.Oid) || isDecimalTuple(typ) {
+		return decimalReturnType(op, typ)
+	}
 	switch op {
 	case Avg:
 		return types.Type{Oid: types.T_float64, Size: 8, Width: 8, Precision: 0}
@@ -39,6 +68,8 @@ func ReturnType(op int, typ types.Type) types.Type {
 		return typ
 	case Min:
 		return typ
+	case AnyValue:
+		return typ
 	case Sum:
 		return sumReturnTypes[typ.Oid]
 	case Count:
@@ -50,3 +81,25 @@ func ReturnType(op int, typ types.Type) types.Type {
 	}
 	return types.Type{}
 }
+
+// isDecimalTuple reports whether typ is the sumCount tuple of a decimal,
+// which keeps the precision and scale of the decimal being summed.
+func isDecimalTuple(typ types.Type) bool {
+	return typ.Oid == types.T_tuple && typ.Width > 0
+}
+
+func decimalReturnType(op int, typ types.Type) types.Type {
+	switch op {
+	case Avg:
+		return types.DecimalType(types.MaxDecimal128Precision, typ.Precision+DecimalAvgScale)
+	case Max, Min, AnyValue:
+		return typ
+	case Sum:
+		return types.DecimalType(types.MaxDecimal128Precision, typ.Precision)
+	case Count, StarCount:
+		return types.Type{Oid: types.T_int64, Size: 8, Width: 8, Precision: 0}
+	case SumCount:
+		return types.Type{Oid: types.T_tuple, Size: 24, Width: typ.Width, Precision: typ.Precision}
+	}
+	return types.Type{}
+}
@@ -0,0 +1,160 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anyvalue
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func New(typ types.Type) *anyValue {
+	return &anyValue{typ: typ}
+}
+
+func (a *anyValue) Reset() {
+	a.v = nil
+}
+
+func (a *anyValue) Type() types.Type {
+	return a.typ
+}
+
+func (a *anyValue) Dup() aggregation.Aggregation {
+	return &anyValue{typ: a.typ}
+}
+
+func (a *anyValue) Fill(sels []int64, vec *vector.Vector) error {
+	if a.v != nil {
+		return nil
+	}
+	if len(sels) > 0 {
+		for _, sel := range sels {
+			if !vec.Nsp.Contains(uint64(sel)) {
+				return a.fill(vec, sel)
+			}
+		}
+		return nil
+	}
+	for i, n := int64(0), int64(vec.Length()); i < n; i++ {
+		if !vec.Nsp.Contains(uint64(i)) {
+			return a.fill(vec, i)
+		}
+	}
+	return nil
+}
+
+func (a *anyValue) Eval() interface{} {
+	return a.v
+}
+
+func (a *anyValue) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	vec := vector.New(a.typ)
+	if a.v == nil {
+		if err := vec.UnionNull(proc); err != nil {
+			return nil, err
+		}
+		return vec, nil
+	}
+	w := vector.New(a.typ)
+	switch v := a.v.(type) {
+	case []byte:
+		if err := w.Append([][]byte{v}); err != nil {
+			return nil, err
+		}
+	default:
+		w.SetCol(column(v))
+	}
+	if err := vec.UnionOne(w, 0, proc); err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
+// fill keeps the i-th value of vec, the bytes are copied since the vector
+// is freed after the aggregation is filled.
+func (a *anyValue) fill(vec *vector.Vector, i int64) error {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		a.v = vs[i]
+	case []int16:
+		a.v = vs[i]
+	case []int32:
+		a.v = vs[i]
+	case []int64:
+		a.v = vs[i]
+	case []uint8:
+		a.v = vs[i]
+	case []uint16:
+		a.v = vs[i]
+	case []uint32:
+		a.v = vs[i]
+	case []uint64:
+		a.v = vs[i]
+	case []float32:
+		a.v = vs[i]
+	case []float64:
+		a.v = vs[i]
+	case []types.Decimal64:
+		a.v = vs[i]
+	case []types.Decimal128:
+		a.v = vs[i]
+	case []types.Date:
+		a.v = vs[i]
+	case []types.Datetime:
+		a.v = vs[i]
+	case *types.Bytes:
+		a.v = append([]byte{}, vs.Get(i)...)
+	default:
+		return fmt.Errorf("unsupport type %s for any_value", vec.Typ)
+	}
+	return nil
+}
+
+func column(v interface{}) interface{} {
+	switch v := v.(type) {
+	case int8:
+		return []int8{v}
+	case int16:
+		return []int16{v}
+	case int32:
+		return []int32{v}
+	case int64:
+		return []int64{v}
+	case uint8:
+		return []uint8{v}
+	case uint16:
+		return []uint16{v}
+	case uint32:
+		return []uint32{v}
+	case uint64:
+		return []uint64{v}
+	case float32:
+		return []float32{v}
+	case float64:
+		return []float64{v}
+	case types.Decimal64:
+		return []types.Decimal64{v}
+	case types.Decimal128:
+		return []types.Decimal128{v}
+	case types.Date:
+		return []types.Date{v}
+	case types.Datetime:
+		return []types.Datetime{v}
+	}
+	return nil
+}
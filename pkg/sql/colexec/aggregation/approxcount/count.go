@@ -0,0 +1,60 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approxcount
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func New(typ types.Type) *approxCount {
+	return &approxCount{typ: typ}
+}
+
+func (a *approxCount) Reset() {
+	a.s = sketch{}
+}
+
+func (a *approxCount) Type() types.Type {
+	return a.typ
+}
+
+func (a *approxCount) Dup() aggregation.Aggregation {
+	return &approxCount{typ: a.typ}
+}
+
+func (a *approxCount) Fill(sels []int64, vec *vector.Vector) error {
+	return a.s.fill(sels, vec)
+}
+
+func (a *approxCount) Eval() interface{} {
+	return a.s.estimate()
+}
+
+func (a *approxCount) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	data, err := proc.Alloc(8)
+	if err != nil {
+		return nil, err
+	}
+	vec := vector.New(a.typ)
+	vs := encoding.DecodeInt64Slice(data[:8])
+	vs[0] = a.s.estimate()
+	vec.Col = vs
+	vec.Data = data
+	return vec, nil
+}
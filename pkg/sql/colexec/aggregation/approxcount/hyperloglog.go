@@ -0,0 +1,54 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approxcount
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// NewHyperLogLog returns the partial aggregation of approx_count_distinct,
+// whose result is the registers of the sketch.
+func NewHyperLogLog(typ types.Type) *hyperLogLog {
+	return &hyperLogLog{typ: typ}
+}
+
+func (a *hyperLogLog) Reset() {
+	a.s = sketch{}
+}
+
+func (a *hyperLogLog) Type() types.Type {
+	return a.typ
+}
+
+func (a *hyperLogLog) Dup() aggregation.Aggregation {
+	return &hyperLogLog{typ: a.typ}
+}
+
+func (a *hyperLogLog) Fill(sels []int64, vec *vector.Vector) error {
+	return a.s.fill(sels, vec)
+}
+
+func (a *hyperLogLog) Eval() interface{} {
+	return []interface{}{a.s.rs}
+}
+
+func (a *hyperLogLog) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	vec := vector.New(a.typ)
+	vec.SetCol([][]interface{}{[]interface{}{a.s.rs}})
+	return vec, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approxcount
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+func (s *sketch) add(key string) {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	x := mix(h.Sum64())
+	if s.rs == nil {
+		s.rs = make([]byte, registers)
+	}
+	i := x >> (64 - precision)
+	rank := byte(bits.LeadingZeros64(x<<precision|1<<(precision-1)) + 1)
+	if rank > s.rs[i] {
+		s.rs[i] = rank
+	}
+}
+
+func (s *sketch) merge(rs []byte) error {
+	if len(rs) == 0 {
+		return nil
+	}
+	if len(rs) != registers {
+		return fmt.Errorf("hyperloglog sketch of %v registers", len(rs))
+	}
+	if s.rs == nil {
+		s.rs = make([]byte, registers)
+	}
+	for i, r := range rs {
+		if r > s.rs[i] {
+			s.rs[i] = r
+		}
+	}
+	return nil
+}
+
+// estimate returns the estimated cardinality, linear counting is used
+// for the small cardinalities.
+func (s *sketch) estimate() int64 {
+	if s.rs == nil {
+		return 0
+	}
+	var zeros int
+	var sum float64
+
+	m := float64(registers)
+	for _, r := range s.rs {
+		if r == 0 {
+			zeros++
+		}
+		sum += math.Ldexp(1, -int(r))
+	}
+	e := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if e <= 2.5*m && zeros > 0 {
+		e = m * math.Log(m/float64(zeros))
+	}
+	return int64(math.Round(e))
+}
+
+// fill adds the values of vec to s, vec is either a column or
+// the sketches of some partial results.
+func (s *sketch) fill(sels []int64, vec *vector.Vector) error {
+	var err error
+
+	if vec.Typ.Oid == types.T_tuple {
+		vs := vec.Col.([][]interface{})
+		aggregation.Rows(sels, vec, func(i int64) {
+			if len(vs[i]) > 0 && err == nil {
+				err = s.merge(vs[i][0].([]byte))
+			}
+		})
+		return err
+	}
+	aggregation.Rows(sels, vec, func(i int64) {
+		s.add(aggregation.Key(vec, i))
+	})
+	return nil
+}
+
+// mix is the finalizer of murmur3 which spreads the bits of the fnv hash.
+func mix(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approxcount
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+const (
+	// precision is the number of bits of the hash which index the registers,
+	// the standard error of the estimate is 1.04 / sqrt(2^precision).
+	precision = 12
+	registers = 1 << precision
+)
+
+// sketch is a HyperLogLog sketch, the registers are allocated when
+// the first value is added.
+type sketch struct {
+	rs []byte
+}
+
+type approxCount struct {
+	s   sketch
+	typ types.Type
+}
+
+type hyperLogLog struct {
+	s   sketch
+	typ types.Type
+}
@@ -39,3 +39,9 @@ type sumCountAvg struct {
 	sum float64
 	typ types.Type
 }
+
+type decimalAvg struct {
+	cnt int64
+	sum types.Decimal128
+	typ types.Type
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bit
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func NewAnd(typ types.Type) *bitAnd {
+	return &bitAnd{typ: typ, v: ^uint64(0)}
+}
+
+func (a *bitAnd) Reset() {
+	a.v = ^uint64(0)
+}
+
+func (a *bitAnd) Type() types.Type {
+	return a.typ
+}
+
+func (a *bitAnd) Dup() aggregation.Aggregation {
+	return &bitAnd{typ: a.typ, v: ^uint64(0)}
+}
+
+func (a *bitAnd) Fill(sels []int64, vec *vector.Vector) error {
+	return fill(sels, vec, func(v uint64) { a.v &= v })
+}
+
+func (a *bitAnd) Eval() interface{} {
+	return a.v
+}
+
+func (a *bitAnd) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	data, err := proc.Alloc(8)
+	if err != nil {
+		return nil, err
+	}
+	vec := vector.New(a.typ)
+	vs := encoding.DecodeUint64Slice(data[:8])
+	vs[0] = a.v
+	vec.Col = vs
+	vec.Data = data
+	return vec, nil
+}
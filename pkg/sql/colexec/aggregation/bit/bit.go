@@ -0,0 +1,47 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bit
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+)
+
+// fill calls fn on the values of vec as unsigned 64-bit integers,
+// the signed integers are converted in two's complement.
+func fill(sels []int64, vec *vector.Vector, fn func(uint64)) error {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		aggregation.Rows(sels, vec, func(i int64) { fn(uint64(vs[i])) })
+	case []int16:
+		aggregation.Rows(sels, vec, func(i int64) { fn(uint64(vs[i])) })
+	case []int32:
+		aggregation.Rows(sels, vec, func(i int64) { fn(uint64(vs[i])) })
+	case []int64:
+		aggregation.Rows(sels, vec, func(i int64) { fn(uint64(vs[i])) })
+	case []uint8:
+		aggregation.Rows(sels, vec, func(i int64) { fn(uint64(vs[i])) })
+	case []uint16:
+		aggregation.Rows(sels, vec, func(i int64) { fn(uint64(vs[i])) })
+	case []uint32:
+		aggregation.Rows(sels, vec, func(i int64) { fn(uint64(vs[i])) })
+	case []uint64:
+		aggregation.Rows(sels, vec, func(i int64) { fn(vs[i]) })
+	default:
+		return fmt.Errorf("unsupport type %s for bit aggregation", vec.Typ)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distinct
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func NewCount(typ types.Type) *distinctCount {
+	return &distinctCount{typ: typ, keys: make(map[string]struct{})}
+}
+
+func (a *distinctCount) Reset() {
+	a.keys = make(map[string]struct{})
+}
+
+func (a *distinctCount) Type() types.Type {
+	return a.typ
+}
+
+func (a *distinctCount) Dup() aggregation.Aggregation {
+	return &distinctCount{typ: a.typ, keys: make(map[string]struct{})}
+}
+
+func (a *distinctCount) Fill(sels []int64, vec *vector.Vector) error {
+	fill(a.keys, sels, vec)
+	return nil
+}
+
+func (a *distinctCount) Eval() interface{} {
+	return int64(len(a.keys))
+}
+
+func (a *distinctCount) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	data, err := proc.Alloc(8)
+	if err != nil {
+		return nil, err
+	}
+	vec := vector.New(a.typ)
+	vs := encoding.DecodeInt64Slice(data[:8])
+	vs[0] = int64(len(a.keys))
+	vec.Col = vs
+	vec.Data = data
+	return vec, nil
+}
+
+// fill adds the keys of the values of vec to keys, vec is either a column
+// or the distinct sets of some partial results.
+func fill(keys map[string]struct{}, sels []int64, vec *vector.Vector) {
+	if vec.Typ.Oid == types.T_tuple {
+		vs := vec.Col.([][]interface{})
+		aggregation.Rows(sels, vec, func(i int64) {
+			if len(vs[i]) > 0 {
+				for _, k := range vs[i][0].([]string) {
+					keys[k] = struct{}{}
+				}
+			}
+		})
+		return
+	}
+	aggregation.Rows(sels, vec, func(i int64) {
+		keys[aggregation.Key(vec, i)] = struct{}{}
+	})
+}
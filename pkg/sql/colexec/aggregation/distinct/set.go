@@ -0,0 +1,59 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distinct
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// NewSet returns the partial aggregation of count(distinct), whose result
+// is the set of the distinct keys.
+func NewSet(typ types.Type) *distinctSet {
+	return &distinctSet{typ: typ, keys: make(map[string]struct{})}
+}
+
+func (a *distinctSet) Reset() {
+	a.keys = make(map[string]struct{})
+}
+
+func (a *distinctSet) Type() types.Type {
+	return a.typ
+}
+
+func (a *distinctSet) Dup() aggregation.Aggregation {
+	return &distinctSet{typ: a.typ, keys: make(map[string]struct{})}
+}
+
+func (a *distinctSet) Fill(sels []int64, vec *vector.Vector) error {
+	fill(a.keys, sels, vec)
+	return nil
+}
+
+func (a *distinctSet) Eval() interface{} {
+	ks := make([]string, 0, len(a.keys))
+	for k := range a.keys {
+		ks = append(ks, k)
+	}
+	return []interface{}{ks}
+}
+
+func (a *distinctSet) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	vec := vector.New(a.typ)
+	vec.SetCol([][]interface{}{a.Eval().([]interface{})})
+	return vec, nil
+}
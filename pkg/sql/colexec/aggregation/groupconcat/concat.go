@@ -0,0 +1,70 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func New(typ types.Type, distinct bool, sep string) *groupConcat {
+	return &groupConcat{typ: typ, distinct: distinct, sep: sep}
+}
+
+func (a *groupConcat) Reset() {
+	a.is = nil
+}
+
+func (a *groupConcat) Type() types.Type {
+	return a.typ
+}
+
+func (a *groupConcat) Dup() aggregation.Aggregation {
+	return &groupConcat{typ: a.typ, distinct: a.distinct, sep: a.sep}
+}
+
+func (a *groupConcat) Fill(sels []int64, vec *vector.Vector) error {
+	var err error
+
+	a.is, err = fill(a.is, sels, vec)
+	return err
+}
+
+func (a *groupConcat) Eval() interface{} {
+	if len(a.is) == 0 {
+		return nil
+	}
+	return concat(a.is, a.sep, a.distinct)
+}
+
+func (a *groupConcat) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	vec := vector.New(a.typ)
+	if len(a.is) == 0 {
+		if err := vec.UnionNull(proc); err != nil {
+			return nil, err
+		}
+		return vec, nil
+	}
+	w := vector.New(a.typ)
+	if err := w.Append([][]byte{concat(a.is, a.sep, a.distinct)}); err != nil {
+		return nil, err
+	}
+	if err := vec.UnionOne(w, 0, proc); err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
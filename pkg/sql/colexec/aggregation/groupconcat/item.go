@@ -0,0 +1,100 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"sort"
+)
+
+// AppendItem appends the item of the value v sorted by key to data.
+func AppendItem(data, key, v []byte) []byte {
+	var buf [binary.MaxVarintLen64]byte
+
+	n := binary.PutUvarint(buf[:], uint64(len(key)))
+	data = append(data, buf[:n]...)
+	data = append(data, key...)
+	return append(data, v...)
+}
+
+// splitItem returns the sort key and the value of an item.
+func splitItem(item string) (string, string) {
+	size, n := binary.Uvarint([]byte(item[:min(len(item), binary.MaxVarintLen64)]))
+	if n <= 0 || n+int(size) > len(item) {
+		return "", item
+	}
+	return item[n : n+int(size)], item[n+int(size):]
+}
+
+// fill appends the items of vec to is, vec is either a column of items or
+// the item lists of some partial results.
+func fill(is []string, sels []int64, vec *vector.Vector) ([]string, error) {
+	switch vs := vec.Col.(type) {
+	case [][]interface{}:
+		aggregation.Rows(sels, vec, func(i int64) {
+			if len(vs[i]) > 0 {
+				is = append(is, vs[i][0].([]string)...)
+			}
+		})
+	case *types.Bytes:
+		aggregation.Rows(sels, vec, func(i int64) {
+			is = append(is, string(vs.Get(i)))
+		})
+	default:
+		return is, fmt.Errorf("unsupport type %s for group_concat", vec.Typ)
+	}
+	return is, nil
+}
+
+// concat sorts the items by their keys and joins their values by sep,
+// the duplicate values are removed if distinct is true.
+func concat(is []string, sep string, distinct bool) []byte {
+	keys, vs := make([]string, len(is)), make([]string, len(is))
+	for i, item := range is {
+		keys[i], vs[i] = splitItem(item)
+	}
+	os := make([]int, len(is))
+	for i := range os {
+		os[i] = i
+	}
+	sort.SliceStable(os, func(i, j int) bool { return keys[os[i]] < keys[os[j]] })
+	var b bytes.Buffer
+	mp := make(map[string]struct{})
+	for i, o := range os {
+		if distinct {
+			if _, ok := mp[vs[o]]; ok {
+				continue
+			}
+			mp[vs[o]] = struct{}{}
+		}
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(vs[o])
+	}
+	return b.Bytes()
+}
+
+func min(x, y int) int {
+	if x < y {
+		return x
+	}
+	return y
+}
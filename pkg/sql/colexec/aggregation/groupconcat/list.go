@@ -0,0 +1,57 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// NewList returns the partial aggregation of group_concat, whose result
+// is the list of the items.
+func NewList(typ types.Type) *concatList {
+	return &concatList{typ: typ}
+}
+
+func (a *concatList) Reset() {
+	a.is = nil
+}
+
+func (a *concatList) Type() types.Type {
+	return a.typ
+}
+
+func (a *concatList) Dup() aggregation.Aggregation {
+	return &concatList{typ: a.typ}
+}
+
+func (a *concatList) Fill(sels []int64, vec *vector.Vector) error {
+	var err error
+
+	a.is, err = fill(a.is, sels, vec)
+	return err
+}
+
+func (a *concatList) Eval() interface{} {
+	return []interface{}{a.is}
+}
+
+func (a *concatList) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	vec := vector.New(a.typ)
+	vec.SetCol([][]interface{}{[]interface{}{a.is}})
+	return vec, nil
+}
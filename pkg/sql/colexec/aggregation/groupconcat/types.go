@@ -0,0 +1,33 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+// The argument of group_concat is a column of items, an item is the
+// concatenation of the length of its sort key, the sort key and the value,
+// the values are ordered by the sort keys which are compared as bytes.
+
+type groupConcat struct {
+	is       []string
+	sep      string
+	distinct bool
+	typ      types.Type
+}
+
+type concatList struct {
+	is  []string
+	typ types.Type
+}
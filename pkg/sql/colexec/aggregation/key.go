@@ -0,0 +1,93 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+// Key returns the key of the i-th value of vec, the values of a column are
+// equal if and only if their keys are equal.
+func Key(vec *vector.Vector, i int64) string {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return string(encoding.EncodeInt8(vs[i]))
+	case []int16:
+		return string(encoding.EncodeInt16(vs[i]))
+	case []int32:
+		return string(encoding.EncodeInt32(vs[i]))
+	case []int64:
+		return string(encoding.EncodeInt64(vs[i]))
+	case []uint8:
+		return string(encoding.EncodeUint8(vs[i]))
+	case []uint16:
+		return string(encoding.EncodeUint16(vs[i]))
+	case []uint32:
+		return string(encoding.EncodeUint32(vs[i]))
+	case []uint64:
+		return string(encoding.EncodeUint64(vs[i]))
+	case []float32:
+		if vs[i] == 0 { // -0 equals 0
+			return string(encoding.EncodeFloat32(0))
+		}
+		return string(encoding.EncodeFloat32(vs[i]))
+	case []float64:
+		if vs[i] == 0 {
+			return string(encoding.EncodeFloat64(0))
+		}
+		return string(encoding.EncodeFloat64(vs[i]))
+	case []types.Decimal64:
+		return string(encoding.EncodeDecimal64(vs[i]))
+	case []types.Decimal128:
+		return string(encoding.EncodeDecimal128(vs[i]))
+	case []types.Date:
+		return string(encoding.EncodeDate(vs[i]))
+	case []types.Datetime:
+		return string(encoding.EncodeDatetime(vs[i]))
+	case *types.Bytes:
+		return string(vs.Get(i))
+	}
+	return ""
+}
+
+// Rows calls fn on the rows of vec selected by sels which are not null,
+// all the rows of vec are selected if sels is empty.
+func Rows(sels []int64, vec *vector.Vector, fn func(int64)) {
+	if len(sels) > 0 {
+		for _, sel := range sels {
+			if !vec.Nsp.Contains(uint64(sel)) {
+				fn(sel)
+			}
+		}
+		return
+	}
+	for i, n := int64(0), int64(vec.Length()); i < n; i++ {
+		if !vec.Nsp.Contains(uint64(i)) {
+			fn(i)
+		}
+	}
+}
+
+// NotNullRows returns the rows of vec selected by sels which are not null,
+// all the rows of vec are selected if sels is empty.
+func NotNullRows(sels []int64, vec *vector.Vector) []int64 {
+	rows := make([]int64, 0, len(sels))
+	Rows(sels, vec, func(i int64) {
+		rows = append(rows, i)
+	})
+	return rows
+}
@@ -41,6 +41,11 @@ func (a *int32Max) Dup() aggregation.Aggregation {
 }
 
 func (a *int32Max) Fill(sels []int64, vec *vector.Vector) error {
+	if vec.Nsp.Any() || vec.Length() == 0 {
+		if sels = aggregation.NotNullRows(sels, vec); len(sels) == 0 {
+			return nil
+		}
+	}
 	if n := len(sels); n > 0 {
 		v := max.Int32MaxSels(vec.Col.([]int32), sels)
 		if a.cnt == 0 || v > a.v {
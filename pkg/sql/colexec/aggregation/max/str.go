@@ -41,6 +41,11 @@ func (a *strMax) Dup() aggregation.Aggregation {
 }
 
 func (a *strMax) Fill(sels []int64, vec *vector.Vector) error {
+	if vec.Nsp.Any() || vec.Length() == 0 {
+		if sels = aggregation.NotNullRows(sels, vec); len(sels) == 0 {
+			return nil
+		}
+	}
 	if n := len(sels); n > 0 {
 		v := max.StrMaxSels(vec.Col.(*types.Bytes), sels)
 		if a.cnt == 0 || bytes.Compare(v, a.v) > 0 {
@@ -75,7 +80,7 @@ func (a *strMax) EvalCopy(proc *process.Process) (*vector.Vector, error) {
 	}
 	vec := vector.New(a.typ)
 	col := vec.Col.(*types.Bytes)
-	col.Data = data
+	col.Data = data[:0]
 	col.Offsets = append(col.Offsets, 0)
 	if a.cnt == 0 {
 		vec.Nsp.Add(0)
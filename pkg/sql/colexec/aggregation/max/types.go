@@ -81,3 +81,15 @@ type strMax struct {
 	v   []byte
 	typ types.Type
 }
+
+type decimal64Max struct {
+	cnt int64
+	v   types.Decimal64
+	typ types.Type
+}
+
+type decimal128Max struct {
+	cnt int64
+	v   types.Decimal128
+	typ types.Type
+}
@@ -41,6 +41,11 @@ func (a *uint16Max) Dup() aggregation.Aggregation {
 }
 
 func (a *uint16Max) Fill(sels []int64, vec *vector.Vector) error {
+	if vec.Nsp.Any() || vec.Length() == 0 {
+		if sels = aggregation.NotNullRows(sels, vec); len(sels) == 0 {
+			return nil
+		}
+	}
 	if n := len(sels); n > 0 {
 		v := max.Uint16MaxSels(vec.Col.([]uint16), sels)
 		if a.cnt == 0 || v > a.v {
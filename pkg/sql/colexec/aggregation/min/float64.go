@@ -41,6 +41,11 @@ func (a *float64Min) Dup() aggregation.Aggregation {
 }
 
 func (a *float64Min) Fill(sels []int64, vec *vector.Vector) error {
+	if vec.Nsp.Any() || vec.Length() == 0 {
+		if sels = aggregation.NotNullRows(sels, vec); len(sels) == 0 {
+			return nil
+		}
+	}
 	if n := len(sels); n > 0 {
 		v := min.Float64MinSels(vec.Col.([]float64), sels)
 		if a.cnt == 0 || v < a.v {
@@ -41,6 +41,11 @@ func (a *int8Min) Dup() aggregation.Aggregation {
 }
 
 func (a *int8Min) Fill(sels []int64, vec *vector.Vector) error {
+	if vec.Nsp.Any() || vec.Length() == 0 {
+		if sels = aggregation.NotNullRows(sels, vec); len(sels) == 0 {
+			return nil
+		}
+	}
 	if n := len(sels); n > 0 {
 		v := min.Int8MinSels(vec.Col.([]int8), sels)
 		if a.cnt == 0 || v < a.v {
@@ -81,3 +81,15 @@ type strMin struct {
 	v   []byte
 	typ types.Type
 }
+
+type decimal64Min struct {
+	cnt int64
+	v   types.Decimal64
+	typ types.Type
+}
+
+type decimal128Min struct {
+	cnt int64
+	v   types.Decimal128
+	typ types.Type
+}
@@ -41,6 +41,11 @@ func (a *uint64Min) Dup() aggregation.Aggregation {
 }
 
 func (a *uint64Min) Fill(sels []int64, vec *vector.Vector) error {
+	if vec.Nsp.Any() || vec.Length() == 0 {
+		if sels = aggregation.NotNullRows(sels, vec); len(sels) == 0 {
+			return nil
+		}
+	}
 	if n := len(sels); n > 0 {
 		v := min.Uint64MinSels(vec.Col.([]uint64), sels)
 		if a.cnt == 0 || v < a.v {
@@ -0,0 +1,85 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sum
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/sum"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func NewDecimal(typ types.Type) *decimalSum {
+	return &decimalSum{typ: typ}
+}
+
+func (a *decimalSum) Reset() {
+	a.cnt = 0
+	a.sum = types.Decimal128{}
+}
+
+func (a *decimalSum) Type() types.Type {
+	return a.typ
+}
+
+func (a *decimalSum) Dup() aggregation.Aggregation {
+	return &decimalSum{typ: a.typ}
+}
+
+func (a *decimalSum) Fill(sels []int64, vec *vector.Vector) error {
+	if n := len(sels); n > 0 {
+		switch vec.Typ.Oid {
+		case types.T_decimal64:
+			a.sum = types.Decimal128Add(a.sum, sum.Decimal64SumSels(vec.Col.([]types.Decimal64), sels))
+		case types.T_decimal128:
+			a.sum = types.Decimal128Add(a.sum, sum.Decimal128SumSels(vec.Col.([]types.Decimal128), sels))
+		}
+		a.cnt += int64(n - vec.Nsp.FilterCount(sels))
+	} else {
+		switch vec.Typ.Oid {
+		case types.T_decimal64:
+			a.sum = types.Decimal128Add(a.sum, sum.Decimal64Sum(vec.Col.([]types.Decimal64)))
+		case types.T_decimal128:
+			a.sum = types.Decimal128Add(a.sum, sum.Decimal128Sum(vec.Col.([]types.Decimal128)))
+		}
+		a.cnt += int64(vec.Length() - vec.Nsp.Length())
+	}
+	return nil
+}
+
+func (a *decimalSum) Eval() interface{} {
+	if a.cnt == 0 {
+		return nil
+	}
+	return a.sum
+}
+
+func (a *decimalSum) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	data, err := proc.Alloc(16)
+	if err != nil {
+		return nil, err
+	}
+	vec := vector.New(a.typ)
+	vs := encoding.DecodeDecimal128Slice(data[:16])
+	vs[0] = a.sum
+	if a.cnt == 0 {
+		vec.Nsp.Add(0)
+	}
+	vec.Col = vs
+	vec.Data = data
+	return vec, nil
+}
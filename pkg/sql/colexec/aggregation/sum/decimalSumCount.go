@@ -0,0 +1,90 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sum
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/sum"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func NewDecimalSumCount(typ types.Type) *decimalSumCount {
+	return &decimalSumCount{typ: typ}
+}
+
+func (a *decimalSumCount) Reset() {
+	a.cnt = 0
+	a.sum = types.Decimal128{}
+}
+
+func (a *decimalSumCount) Type() types.Type {
+	return a.typ
+}
+
+func (a *decimalSumCount) Dup() aggregation.Aggregation {
+	return &decimalSumCount{typ: a.typ}
+}
+
+func (a *decimalSumCount) Fill(sels []int64, vec *vector.Vector) error {
+	if vec.Typ.Oid == types.T_tuple {
+		vs := vec.Col.([][]interface{})
+		if len(sels) > 0 {
+			for _, sel := range sels {
+				if len(vs[sel]) > 0 {
+					a.cnt += vs[sel][0].(int64)
+					a.sum = types.Decimal128Add(a.sum, vs[sel][1].(types.Decimal128))
+				}
+			}
+		} else {
+			for _, v := range vs {
+				if len(v) > 0 {
+					a.cnt += v[0].(int64)
+					a.sum = types.Decimal128Add(a.sum, v[1].(types.Decimal128))
+				}
+			}
+		}
+		return nil
+	}
+	if n := len(sels); n > 0 {
+		switch vec.Typ.Oid {
+		case types.T_decimal64:
+			a.sum = types.Decimal128Add(a.sum, sum.Decimal64SumSels(vec.Col.([]types.Decimal64), sels))
+		case types.T_decimal128:
+			a.sum = types.Decimal128Add(a.sum, sum.Decimal128SumSels(vec.Col.([]types.Decimal128), sels))
+		}
+		a.cnt += int64(n - vec.Nsp.FilterCount(sels))
+	} else {
+		switch vec.Typ.Oid {
+		case types.T_decimal64:
+			a.sum = types.Decimal128Add(a.sum, sum.Decimal64Sum(vec.Col.([]types.Decimal64)))
+		case types.T_decimal128:
+			a.sum = types.Decimal128Add(a.sum, sum.Decimal128Sum(vec.Col.([]types.Decimal128)))
+		}
+		a.cnt += int64(vec.Length() - vec.Nsp.Length())
+	}
+	return nil
+}
+
+func (a *decimalSumCount) Eval() interface{} {
+	return []interface{}{a.cnt, a.sum}
+}
+
+func (a *decimalSumCount) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	vec := vector.New(a.typ)
+	vec.SetCol([][]interface{}{[]interface{}{a.cnt, a.sum}})
+	return vec, nil
+}
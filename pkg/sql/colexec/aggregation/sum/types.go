@@ -57,3 +57,15 @@ type floatSumCount struct {
 	sum float64
 	typ types.Type
 }
+
+type decimalSum struct {
+	cnt int64
+	sum types.Decimal128
+	typ types.Type
+}
+
+type decimalSumCount struct {
+	cnt int64
+	sum types.Decimal128
+	typ types.Type
+}
@@ -28,8 +28,23 @@ const (
 	Sum
 	Count
 	StarCount
+	CountDistinct
+	StdDevPop
+	StdDevSamp
+	VarPop
+	VarSamp
+	GroupConcat
+	BitAnd
+	BitOr
+	BitXor
+	AnyValue
+	ApproxCountDistinct
 	// system function
 	SumCount
+	DistinctSet
+	Moment
+	ConcatList
+	HyperLogLog
 )
 
 var AggName = [...]string{
@@ -39,7 +54,24 @@ var AggName = [...]string{
 	Sum:       "sum",
 	Count:     "count",
 	StarCount: "starCount",
-	SumCount:  "sumCount",
+
+	CountDistinct:       "countDistinct",
+	StdDevPop:           "stddev_pop",
+	StdDevSamp:          "stddev_samp",
+	VarPop:              "var_pop",
+	VarSamp:             "var_samp",
+	GroupConcat:         "group_concat",
+	BitAnd:              "bit_and",
+	BitOr:               "bit_or",
+	BitXor:              "bit_xor",
+	AnyValue:            "any_value",
+	ApproxCountDistinct: "approx_count_distinct",
+
+	SumCount:    "sumCount",
+	DistinctSet: "distinctSet",
+	Moment:      "moment",
+	ConcatList:  "concatList",
+	HyperLogLog: "hyperLogLog",
 }
 
 type Extend struct {
@@ -47,6 +79,9 @@ type Extend struct {
 	Name  string
 	Alias string
 	Agg   Aggregation
+	// Distinct and Separator are the parameters of group_concat.
+	Distinct  bool
+	Separator string
 }
 
 type Aggregation interface {
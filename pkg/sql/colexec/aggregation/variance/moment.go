@@ -0,0 +1,118 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package variance
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// NewMoment returns the partial aggregation of the variances and the standard deviations.
+func NewMoment(typ types.Type) *momentState {
+	return &momentState{typ: typ}
+}
+
+func (a *momentState) Reset() {
+	a.m = moment{}
+}
+
+func (a *momentState) Type() types.Type {
+	return a.typ
+}
+
+func (a *momentState) Dup() aggregation.Aggregation {
+	return &momentState{typ: a.typ}
+}
+
+func (a *momentState) Fill(sels []int64, vec *vector.Vector) error {
+	return a.m.fill(sels, vec)
+}
+
+func (a *momentState) Eval() interface{} {
+	return []interface{}{a.m.cnt, a.m.mean, a.m.m2}
+}
+
+func (a *momentState) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	vec := vector.New(a.typ)
+	vec.SetCol([][]interface{}{[]interface{}{a.m.cnt, a.m.mean, a.m.m2}})
+	return vec, nil
+}
+
+func (m *moment) add(v float64) {
+	m.cnt++
+	delta := v - m.mean
+	m.mean += delta / float64(m.cnt)
+	m.m2 += delta * (v - m.mean)
+}
+
+func (m *moment) merge(cnt int64, mean, m2 float64) {
+	if cnt == 0 {
+		return
+	}
+	if m.cnt == 0 {
+		m.cnt, m.mean, m.m2 = cnt, mean, m2
+		return
+	}
+	n := m.cnt + cnt
+	delta := mean - m.mean
+	m.m2 += m2 + delta*delta*float64(m.cnt)*float64(cnt)/float64(n)
+	m.mean += delta * float64(cnt) / float64(n)
+	m.cnt = n
+}
+
+// fill adds the values of vec to m, vec is either a numeric column or
+// the moments of some partial results.
+func (m *moment) fill(sels []int64, vec *vector.Vector) error {
+	switch vs := vec.Col.(type) {
+	case [][]interface{}:
+		aggregation.Rows(sels, vec, func(i int64) {
+			if len(vs[i]) > 0 {
+				m.merge(vs[i][0].(int64), vs[i][1].(float64), vs[i][2].(float64))
+			}
+		})
+	case []int8:
+		aggregation.Rows(sels, vec, func(i int64) { m.add(float64(vs[i])) })
+	case []int16:
+		aggregation.Rows(sels, vec, func(i int64) { m.add(float64(vs[i])) })
+	case []int32:
+		aggregation.Rows(sels, vec, func(i int64) { m.add(float64(vs[i])) })
+	case []int64:
+		aggregation.Rows(sels, vec, func(i int64) { m.add(float64(vs[i])) })
+	case []uint8:
+		aggregation.Rows(sels, vec, func(i int64) { m.add(float64(vs[i])) })
+	case []uint16:
+		aggregation.Rows(sels, vec, func(i int64) { m.add(float64(vs[i])) })
+	case []uint32:
+		aggregation.Rows(sels, vec, func(i int64) { m.add(float64(vs[i])) })
+	case []uint64:
+		aggregation.Rows(sels, vec, func(i int64) { m.add(float64(vs[i])) })
+	case []float32:
+		aggregation.Rows(sels, vec, func(i int64) { m.add(float64(vs[i])) })
+	case []float64:
+		aggregation.Rows(sels, vec, func(i int64) { m.add(vs[i]) })
+	case []types.Decimal64:
+		scale := vec.Typ.Precision
+		aggregation.Rows(sels, vec, func(i int64) { m.add(vs[i].ToFloat64(scale)) })
+	case []types.Decimal128:
+		scale := vec.Typ.Precision
+		aggregation.Rows(sels, vec, func(i int64) { m.add(vs[i].ToFloat64(scale)) })
+	default:
+		return fmt.Errorf("unsupport type %s for variance", vec.Typ)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package variance
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+// moment is the count, the mean and the sum of squared differences from
+// the mean of some values, two moments are merged by Chan's method.
+type moment struct {
+	cnt  int64
+	mean float64
+	m2   float64
+}
+
+type variance struct {
+	m    moment
+	typ  types.Type
+	samp bool // sample or population
+	sqrt bool // standard deviation or variance
+}
+
+type momentState struct {
+	m   moment
+	typ types.Type
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package variance
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"math"
+)
+
+func NewVarPop(typ types.Type) *variance {
+	return &variance{typ: typ}
+}
+
+func NewVarSamp(typ types.Type) *variance {
+	return &variance{typ: typ, samp: true}
+}
+
+func NewStdDevPop(typ types.Type) *variance {
+	return &variance{typ: typ, sqrt: true}
+}
+
+func NewStdDevSamp(typ types.Type) *variance {
+	return &variance{typ: typ, samp: true, sqrt: true}
+}
+
+func (a *variance) Reset() {
+	a.m = moment{}
+}
+
+func (a *variance) Type() types.Type {
+	return a.typ
+}
+
+func (a *variance) Dup() aggregation.Aggregation {
+	return &variance{typ: a.typ, samp: a.samp, sqrt: a.sqrt}
+}
+
+func (a *variance) Fill(sels []int64, vec *vector.Vector) error {
+	return a.m.fill(sels, vec)
+}
+
+func (a *variance) Eval() interface{} {
+	if v, ok := a.eval(); ok {
+		return v
+	}
+	return nil
+}
+
+func (a *variance) EvalCopy(proc *process.Process) (*vector.Vector, error) {
+	data, err := proc.Alloc(8)
+	if err != nil {
+		return nil, err
+	}
+	vec := vector.New(a.typ)
+	vs := encoding.DecodeFloat64Slice(data[:8])
+	if v, ok := a.eval(); ok {
+		vs[0] = v
+	} else {
+		vs[0] = 0
+		vec.Nsp.Add(0)
+	}
+	vec.Col = vs
+	vec.Data = data
+	return vec, nil
+}
+
+func (a *variance) eval() (float64, bool) {
+	n := a.m.cnt
+	if a.samp {
+		n--
+	}
+	if n <= 0 {
+		return 0, false
+	}
+	v := a.m.m2 / float64(n)
+	if a.sqrt {
+		v = math.Sqrt(v)
+	}
+	return v, true
+}
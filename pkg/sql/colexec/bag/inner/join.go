@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/hash"
 	"github.com/matrixorigin/matrixone/pkg/intmap/fastmap"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -53,10 +54,10 @@ func Prepare(proc *process.Process, arg interface{}) error {
 	}
 	{
 		for _, attr := range n.Rattrs {
-			n.Ctr.rattrs = append(n.Ctr.rattrs, n.R+"."+attr)
+			n.Ctr.rattrs = append(n.Ctr.rattrs, qualify(n.R, attr))
 		}
 		for _, attr := range n.Sattrs {
-			n.Ctr.rattrs = append(n.Ctr.rattrs, n.S+"."+attr)
+			n.Ctr.rattrs = append(n.Ctr.rattrs, qualify(n.S, attr))
 		}
 	}
 	return nil
@@ -74,6 +75,14 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				return true, err
 			}
 			ctr.state = Eval
+			if ctr.sparts != nil {
+				if err := ctr.partitionProbe(n.Rattrs, proc); err != nil {
+					ctr.clean(proc)
+					ctr.state = End
+					return true, err
+				}
+				ctr.state = Spill
+			}
 		case Eval:
 			ok, err := ctr.probe(n.R, n.S, n.Rattrs, proc)
 			if err != nil || ok {
@@ -82,6 +91,14 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				return ok, err
 			}
 			return ok, err
+		case Spill:
+			ok, err := ctr.probePartition(n.R, n.S, n.Rattrs, proc)
+			if err != nil || ok {
+				ctr.state = End
+				ctr.clean(proc)
+				return ok, err
+			}
+			return ok, err
 		case End:
 			proc.Reg.InputBatch = nil
 			return true, nil
@@ -106,6 +123,9 @@ func (ctr *Container) build(attrs []string, proc *process.Process) error {
 			reg.Wg.Done()
 			continue
 		}
+		if len(bat.Sels) > 0 {
+			bat.Shuffle(proc)
+		}
 		if ctr.bat == nil {
 			bat.Reorder(attrs)
 			ctr.bat = batch.New(true, bat.Attrs)
@@ -116,7 +136,12 @@ func (ctr *Container) build(attrs []string, proc *process.Process) error {
 		} else {
 			bat.Reorder(ctr.bat.Attrs)
 		}
-		if err = ctr.buildBatch(bat.Vecs, proc); err != nil {
+		if ctr.sparts != nil {
+			err = ctr.partition(ctr.sparts, ctr.bat.Attrs, bat.Vecs, proc)
+		} else if err = ctr.buildBatch(bat.Vecs, proc); err == nil && spill.Overflow(proc) {
+			err = ctr.spillBuild(proc)
+		}
+		if err != nil {
 			reg.Ch = nil
 			reg.Wg.Done()
 			bat.Clean(proc)
@@ -151,46 +176,144 @@ func (ctr *Container) probe(rName, sName string, attrs []string, proc *process.P
 			bat.Clean(proc)
 			return true, nil
 		}
+		if err := ctr.join(rName, sName, attrs, bat, proc); err != nil {
+			reg.Ch = nil
+			reg.Wg.Done()
+			bat.Clean(proc)
+			return true, err
+		}
+		if ctr.Probe.bat.Vecs[0].Length() == 0 {
+			reg.Wg.Done()
+			bat.Clean(proc)
+			continue
+		}
+		reg.Wg.Done()
+		bat.Clean(proc)
+		ctr.Probe.bat.Reduce(ctr.rattrs, proc)
+		proc.Reg.InputBatch = ctr.Probe.bat
+		ctr.Probe.bat = nil
+		return false, nil
+	}
+}
+
+// join probes the hash table with bat, the result is stored in ctr.Probe.bat.
+func (ctr *Container) join(rName, sName string, attrs []string, bat *batch.Batch, proc *process.Process) error {
+	if len(ctr.Probe.attrs) == 0 {
+		bat.Reorder(attrs)
+		ctr.Probe.attrs = append(ctr.Probe.attrs, bat.Attrs...)
+	} else {
+		bat.Reorder(ctr.Probe.attrs)
+	}
+	if len(ctr.attrs) == 0 {
+		ctr.attrs = make([]string, 0, len(bat.Attrs)+len(ctr.bat.Attrs))
+		for _, attr := range bat.Attrs {
+			ctr.attrs = append(ctr.attrs, qualify(rName, attr))
+		}
+		for _, attr := range ctr.bat.Attrs {
+			ctr.attrs = append(ctr.attrs, qualify(sName, attr))
+		}
+	}
+	{
+		ctr.Probe.bat = batch.New(true, ctr.attrs)
+		for i, attr := range bat.Attrs {
+			vec := bat.GetVector(attr)
+			ctr.Probe.bat.Vecs[i] = vector.New(vec.Typ)
+		}
+		j := len(bat.Attrs)
+		for i, vec := range ctr.bat.Vecs {
+			ctr.Probe.bat.Vecs[i+j] = vector.New(vec.Typ)
+		}
+	}
+	if len(bat.Sels) > 0 {
+		bat.Shuffle(proc)
+	}
+	return ctr.probeBatch(bat.Vecs, proc)
+}
+
+// spillBuild moves the build relation in memory to the partitions on disk,
+// the rest of the build relation is partitioned without being built.
+func (ctr *Container) spillBuild(proc *process.Process) error {
+	ctr.sparts = make([]*spill.File, spill.Partitions)
+	ctr.rparts = make([]*spill.File, spill.Partitions)
+	if err := ctr.partition(ctr.sparts, ctr.bat.Attrs, ctr.bat.Vecs, proc); err != nil {
+		return err
+	}
+	ctr.reset(proc)
+	return nil
+}
+
+// partitionProbe moves the probe relation to the partitions on disk.
+func (ctr *Container) partitionProbe(attrs []string, proc *process.Process) error {
+	reg := proc.Reg.MergeReceivers[0]
+	for {
+		v := <-reg.Ch
+		if v == nil {
+			reg.Ch = nil
+			reg.Wg.Done()
+			return ctr.flush(proc)
+		}
+		bat := v.(*batch.Batch)
+		if bat == nil || bat.Attrs == nil {
+			reg.Wg.Done()
+			continue
+		}
+		if len(bat.Sels) > 0 {
+			bat.Shuffle(proc)
+		}
 		if len(ctr.Probe.attrs) == 0 {
 			bat.Reorder(attrs)
 			ctr.Probe.attrs = append(ctr.Probe.attrs, bat.Attrs...)
-			ctr.attrs = make([]string, 0, len(bat.Attrs)+len(ctr.attrs))
-			for _, attr := range bat.Attrs {
-				ctr.attrs = append(ctr.attrs, rName+"."+attr)
-			}
-			for _, attr := range ctr.bat.Attrs {
-				ctr.attrs = append(ctr.attrs, sName+"."+attr)
-			}
 		} else {
 			bat.Reorder(ctr.Probe.attrs)
 		}
-		{
-			ctr.Probe.bat = batch.New(true, ctr.attrs)
-			for i, attr := range bat.Attrs {
-				vec := bat.GetVector(attr)
-				ctr.Probe.bat.Vecs[i] = vector.New(vec.Typ)
+		if err := ctr.partition(ctr.rparts, ctr.Probe.attrs, bat.Vecs, proc); err != nil {
+			reg.Ch = nil
+			reg.Wg.Done()
+			bat.Clean(proc)
+			return err
+		}
+		reg.Wg.Done()
+		bat.Clean(proc)
+	}
+}
+
+// probePartition joins the partitions of both relations in turn, it returns
+// true if all the partitions have been joined.
+func (ctr *Container) probePartition(rName, sName string, attrs []string, proc *process.Process) (bool, error) {
+	for {
+		if ctr.rf == nil {
+			if ctr.part == len(ctr.sparts) {
+				proc.Reg.InputBatch = nil
+				return true, nil
 			}
-			j := len(bat.Attrs)
-			for i, vec := range ctr.bat.Vecs {
-				ctr.Probe.bat.Vecs[i+j] = vector.New(vec.Typ)
+			i := ctr.part
+			ctr.part++
+			if ctr.sparts[i] == nil || ctr.rparts[i] == nil {
+				continue
+			}
+			if err := ctr.buildPartition(i, proc); err != nil {
+				return true, err
 			}
+			ctr.rf = ctr.rparts[i]
 		}
-		if len(bat.Sels) > 0 {
-			bat.Shuffle(proc)
+		bat, err := ctr.rf.Read(proc)
+		if err != nil {
+			return true, err
 		}
-		if err := ctr.probeBatch(bat.Vecs, proc); err != nil {
-			reg.Ch = nil
-			reg.Wg.Done()
-			bat.Clean(proc)
+		if bat == nil {
+			ctr.rf.Clean(proc)
+			ctr.rf = nil
+			ctr.rparts[ctr.part-1] = nil
+			continue
+		}
+		err = ctr.join(rName, sName, attrs, bat, proc)
+		bat.Clean(proc)
+		if err != nil {
 			return true, err
 		}
 		if ctr.Probe.bat.Vecs[0].Length() == 0 {
-			reg.Wg.Done()
-			bat.Clean(proc)
 			continue
 		}
-		reg.Wg.Done()
-		bat.Clean(proc)
 		ctr.Probe.bat.Reduce(ctr.rattrs, proc)
 		proc.Reg.InputBatch = ctr.Probe.bat
 		ctr.Probe.bat = nil
@@ -198,6 +321,91 @@ func (ctr *Container) probe(rName, sName string, attrs []string, proc *process.P
 	}
 }
 
+// buildPartition builds the hash table of the i-th partition of the build relation.
+func (ctr *Container) buildPartition(i int, proc *process.Process) error {
+	f := ctr.sparts[i]
+	ctr.reset(proc)
+	for {
+		bat, err := f.Read(proc)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			break
+		}
+		err = ctr.buildBatch(bat.Vecs, proc)
+		bat.Clean(proc)
+		if err != nil {
+			return err
+		}
+	}
+	f.Clean(proc)
+	ctr.sparts[i] = nil
+	return nil
+}
+
+// flush writes the rows buffered by the partitions of both relations.
+func (ctr *Container) flush(proc *process.Process) error {
+	for i := range ctr.sparts {
+		if f := ctr.sparts[i]; f != nil {
+			if err := f.Flush(proc); err != nil {
+				return err
+			}
+		}
+		if f := ctr.rparts[i]; f != nil {
+			if err := f.Flush(proc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// partition appends the rows of vecs to the partitions of their hash codes.
+func (ctr *Container) partition(parts []*spill.File, attrs []string, vecs []*vector.Vector, proc *process.Process) error {
+	for i, j := 0, vecs[0].Length(); i < j; i += UnitLimit {
+		count := j - i
+		if count > UnitLimit {
+			count = UnitLimit
+		}
+		copy(ctr.hashs[:count], OneUint64s[:count])
+		ctr.hashs = ctr.hashs[:count]
+		for _, vec := range vecs[:ctr.n] {
+			hash.Rehash(count, ctr.hashs, vec.Window(i, i+count, ctr.vec))
+		}
+		for k, h := range ctr.hashs {
+			p := spill.Index(h)
+			if parts[p] == nil {
+				typs := make([]types.Type, len(vecs))
+				for x, vec := range vecs {
+					typs[x] = vec.Typ
+				}
+				f, err := spill.New(attrs, typs)
+				if err != nil {
+					return err
+				}
+				parts[p] = f
+			}
+			if err := parts[p].Append(vecs, int64(i+k), proc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// reset empties the hash table of the build relation.
+func (ctr *Container) reset(proc *process.Process) {
+	bat := batch.New(true, ctr.bat.Attrs)
+	for i, vec := range ctr.bat.Vecs {
+		bat.Vecs[i] = vector.New(vec.Typ)
+	}
+	ctr.bat.Clean(proc)
+	ctr.bat = bat
+	ctr.rows = 0
+	ctr.groups = make(map[uint64][]*hash.BagGroup)
+}
+
 func (ctr *Container) buildBatch(vecs []*vector.Vector, proc *process.Process) error {
 	for i, j := 0, vecs[0].Length(); i < j; i += UnitLimit {
 		length := j - i
@@ -230,6 +438,7 @@ func (ctr *Container) buildUnit(start, count int, vecs []*vector.Vector, proc *p
 								return err
 							}
 						}
+						g.Sels[len(g.Sels)-len(matchs)] = ctr.rows
 						ctr.rows++
 						matchs = matchs[1:]
 						if proc.Size() > proc.Lim.Size {
@@ -262,6 +471,7 @@ func (ctr *Container) buildUnit(start, count int, vecs []*vector.Vector, proc *p
 							return err
 						}
 					}
+					g.Sels[len(g.Sels)-len(matchs)] = ctr.rows
 					ctr.rows++
 					matchs = matchs[1:]
 					if proc.Size() > proc.Lim.Size {
@@ -366,6 +576,16 @@ func (ctr *Container) clean(proc *process.Process) {
 		ctr.Probe.bat.Clean(proc)
 		ctr.Probe.bat = nil
 	}
+	for i := range ctr.sparts {
+		if ctr.sparts[i] != nil {
+			ctr.sparts[i].Clean(proc)
+		}
+		if ctr.rparts[i] != nil {
+			ctr.rparts[i].Clean(proc)
+		}
+	}
+	ctr.rf = nil
+	ctr.sparts, ctr.rparts = nil, nil
 	{
 		for _, reg := range proc.Reg.MergeReceivers {
 			if reg.Ch != nil {
@@ -389,3 +609,12 @@ func (ctr *Container) clean(proc *process.Process) {
 		}
 	}
 }
+
+// qualify returns the name of an attribute of a join input as seen by the
+// parent of the join, the attributes of an unnamed input are qualified already.
+func qualify(name, attr string) string {
+	if len(name) == 0 {
+		return attr
+	}
+	return name + "." + attr
+}
@@ -19,11 +19,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/hash"
 	"github.com/matrixorigin/matrixone/pkg/intmap/fastmap"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 )
 
 const (
 	Build = iota
 	Eval
+	Spill
 	End
 )
 
@@ -54,6 +56,12 @@ type Container struct {
 		bat   *batch.Batch // output relation
 	}
 	groups map[uint64][]*hash.BagGroup // hash code -> group list
+	// rparts and sparts are the partitions of both relations when the build
+	// relation does not fit in memory, the i-th partitions are joined in turn.
+	part   int         // the next partition to be joined
+	rf     *spill.File // the probe partition being joined
+	rparts []*spill.File
+	sparts []*spill.File
 }
 
 type Argument struct {
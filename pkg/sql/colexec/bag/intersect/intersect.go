@@ -0,0 +1,340 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intersect
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/hash"
+	"github.com/matrixorigin/matrixone/pkg/intmap/fastmap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	ZeroBools = make([]bool, UnitLimit)
+	OneUint64s = make([]uint64, UnitLimit)
+	for i := range OneUint64s {
+		OneUint64s[i] = 1
+	}
+}
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	if n.All {
+		buf.WriteString(fmt.Sprintf("%s ∩ ALL %s", n.R, n.S))
+	} else {
+		buf.WriteString(fmt.Sprintf("%s ∩ %s", n.R, n.S))
+	}
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.Ctr = Container{
+		slots:  fastmap.New(),
+		diffs:  make([]bool, UnitLimit),
+		matchs: make([]int64, UnitLimit),
+		hashs:  make([]uint64, UnitLimit),
+		sels:   make([][]int64, UnitLimit),
+		groups: make(map[uint64][]*hash.SetGroup),
+		vec:    vector.New(types.Type{Oid: types.T_int8}),
+	}
+	return nil
+}
+
+// Call returns the rows of R which are also in S, the rows of S come from the
+// second receiver and are read first. A row of S matches at most one row of R
+// if n.All is set, otherwise each distinct row is returned once.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := &n.Ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(n.Attrs, proc); err != nil {
+				ctr.clean(proc)
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Eval
+		case Eval:
+			ok, err := ctr.probe(n.Attrs, n.All, proc)
+			if err != nil || ok {
+				ctr.state = End
+				ctr.clean(proc)
+				return ok, err
+			}
+			return ok, err
+		case End:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) build(attrs []string, proc *process.Process) error {
+	reg := proc.Reg.MergeReceivers[1]
+	for {
+		v := <-reg.Ch
+		if v == nil {
+			reg.Ch = nil
+			reg.Wg.Done()
+			break
+		}
+		bat := v.(*batch.Batch)
+		if bat == nil || bat.Attrs == nil {
+			reg.Wg.Done()
+			continue
+		}
+		if len(bat.Sels) > 0 {
+			bat.Shuffle(proc)
+		}
+		bat.Reorder(attrs)
+		if ctr.bat == nil {
+			ctr.bat = batch.New(true, attrs)
+			for i, vec := range bat.Vecs {
+				ctr.bat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		if err := ctr.buildBatch(bat.Vecs, proc); err != nil {
+			reg.Ch = nil
+			reg.Wg.Done()
+			bat.Clean(proc)
+			return err
+		}
+		reg.Wg.Done()
+		bat.Clean(proc)
+	}
+	return nil
+}
+
+func (ctr *Container) probe(attrs []string, all bool, proc *process.Process) (bool, error) {
+	reg := proc.Reg.MergeReceivers[0]
+	for {
+		v := <-reg.Ch
+		if v == nil {
+			reg.Ch = nil
+			reg.Wg.Done()
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+		bat := v.(*batch.Batch)
+		if bat == nil || bat.Attrs == nil {
+			reg.Wg.Done()
+			continue
+		}
+		if len(ctr.groups) == 0 {
+			reg.Ch = nil
+			reg.Wg.Done()
+			proc.Reg.InputBatch = nil
+			bat.Clean(proc)
+			return true, nil
+		}
+		if len(bat.Sels) > 0 {
+			bat.Shuffle(proc)
+		}
+		bat.Reorder(attrs)
+		{
+			ctr.Probe.bat = batch.New(true, attrs)
+			for i, vec := range bat.Vecs {
+				ctr.Probe.bat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		if err := ctr.probeBatch(bat.Vecs, all, proc); err != nil {
+			reg.Ch = nil
+			reg.Wg.Done()
+			bat.Clean(proc)
+			return true, err
+		}
+		reg.Wg.Done()
+		bat.Clean(proc)
+		if ctr.Probe.bat.Vecs[0].Length() == 0 {
+			ctr.Probe.bat.Clean(proc)
+			ctr.Probe.bat = nil
+			continue
+		}
+		ctr.Probe.bat.Reduce(attrs, proc)
+		proc.Reg.InputBatch = ctr.Probe.bat
+		ctr.Probe.bat = nil
+		return false, nil
+	}
+}
+
+func (ctr *Container) buildBatch(vecs []*vector.Vector, proc *process.Process) error {
+	for i, j := 0, vecs[0].Length(); i < j; i += UnitLimit {
+		length := j - i
+		if length > UnitLimit {
+			length = UnitLimit
+		}
+		if err := ctr.buildUnit(i, length, vecs, proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildUnit puts the rows of S into groups of equal rows and counts the rows of each group.
+func (ctr *Container) buildUnit(start, count int, vecs []*vector.Vector, proc *process.Process) error {
+	copy(ctr.hashs[:count], OneUint64s[:count])
+	ctr.fillHash(start, count, vecs)
+	for i, hs := range ctr.slots.Ks {
+		for j, h := range hs {
+			remaining := ctr.sels[ctr.slots.Vs[i][j]]
+			if gs, ok := ctr.groups[h]; ok {
+				for _, g := range gs {
+					remaining = ctr.fill(g, remaining, vecs, proc)
+				}
+			} else {
+				ctr.groups[h] = make([]*hash.SetGroup, 0, 8)
+			}
+			for len(remaining) > 0 {
+				g := hash.NewSetGroup(ctr.rows)
+				{
+					for i, vec := range ctr.bat.Vecs {
+						if err := vec.UnionOne(vecs[i], remaining[0], proc); err != nil {
+							return err
+						}
+					}
+					if proc.Size() > proc.Lim.Size {
+						return errors.New("out of memory")
+					}
+				}
+				ctr.rows++
+				ctr.cnts = append(ctr.cnts, 0)
+				ctr.groups[h] = append(ctr.groups[h], g)
+				remaining = ctr.fill(g, remaining, vecs, proc)
+			}
+			ctr.sels[ctr.slots.Vs[i][j]] = ctr.sels[ctr.slots.Vs[i][j]][:0]
+		}
+	}
+	ctr.slots.Reset()
+	return nil
+}
+
+// fill adds the rows of sels which are equal to g into g, and returns the others.
+func (ctr *Container) fill(g *hash.SetGroup, sels []int64, vecs []*vector.Vector, proc *process.Process) []int64 {
+	n := len(sels)
+	copy(ctr.diffs[:n], ZeroBools[:n])
+	remaining := g.Fill(sels, ctr.matchs, vecs, ctr.bat.Vecs, ctr.diffs, proc)
+	ctr.cnts[g.Sel] += int64(n - len(remaining))
+	return remaining
+}
+
+func (ctr *Container) probeBatch(vecs []*vector.Vector, all bool, proc *process.Process) error {
+	for i, j := 0, vecs[0].Length(); i < j; i += UnitLimit {
+		length := j - i
+		if length > UnitLimit {
+			length = UnitLimit
+		}
+		if err := ctr.probeUnit(i, length, vecs, all, proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctr *Container) probeUnit(start, count int, vecs []*vector.Vector, all bool, proc *process.Process) error {
+	copy(ctr.hashs[:count], OneUint64s[:count])
+	ctr.fillHash(start, count, vecs)
+	for i, hs := range ctr.slots.Ks {
+		for j, h := range hs {
+			remaining := ctr.sels[ctr.slots.Vs[i][j]]
+			for _, g := range ctr.groups[h] {
+				if len(remaining) == 0 {
+					break
+				}
+				// Fill overwrites remaining, the rows which are equal to g are
+				// those of gsels whose diffs are false.
+				ctr.gsels = append(ctr.gsels[:0], remaining...)
+				copy(ctr.diffs[:len(remaining)], ZeroBools[:len(remaining)])
+				remaining = g.Fill(remaining, ctr.matchs, vecs, ctr.bat.Vecs, ctr.diffs, proc)
+				for k, sel := range ctr.gsels {
+					if ctr.diffs[k] || ctr.cnts[g.Sel] == 0 {
+						continue
+					}
+					if all {
+						ctr.cnts[g.Sel]--
+					} else {
+						ctr.cnts[g.Sel] = 0
+					}
+					for i, vec := range ctr.Probe.bat.Vecs {
+						if err := vec.UnionOne(vecs[i], sel, proc); err != nil {
+							return err
+						}
+					}
+					if proc.Size() > proc.Lim.Size {
+						return errors.New("out of memory")
+					}
+				}
+			}
+			ctr.sels[ctr.slots.Vs[i][j]] = ctr.sels[ctr.slots.Vs[i][j]][:0]
+		}
+	}
+	ctr.slots.Reset()
+	return nil
+}
+
+func (ctr *Container) fillHash(start, count int, vecs []*vector.Vector) {
+	ctr.hashs = ctr.hashs[:count]
+	for _, vec := range vecs {
+		hash.Rehash(count, ctr.hashs, vec.Window(start, start+count, ctr.vec))
+	}
+	nextslot := 0
+	for i, h := range ctr.hashs {
+		slot, ok := ctr.slots.Get(h)
+		if !ok {
+			slot = nextslot
+			ctr.slots.Set(h, slot)
+			nextslot++
+		}
+		ctr.sels[slot] = append(ctr.sels[slot], int64(i+start))
+	}
+}
+
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(proc)
+		ctr.bat = nil
+	}
+	if ctr.Probe.bat != nil {
+		ctr.Probe.bat.Clean(proc)
+		ctr.Probe.bat = nil
+	}
+	{
+		for _, reg := range proc.Reg.MergeReceivers {
+			if reg.Ch != nil {
+				v := <-reg.Ch
+				switch {
+				case v == nil:
+					reg.Ch = nil
+					reg.Wg.Done()
+				default:
+					bat := v.(*batch.Batch)
+					if bat == nil || bat.Attrs == nil {
+						reg.Ch = nil
+						reg.Wg.Done()
+					} else {
+						bat.Clean(proc)
+						reg.Ch = nil
+						reg.Wg.Done()
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,663 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/hash"
+	"github.com/matrixorigin/matrixone/pkg/intmap/fastmap"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
+	"sort"
+)
+
+func init() {
+	ZeroBools = make([]bool, UnitLimit)
+	OneUint64s = make([]uint64, UnitLimit)
+	for i := range OneUint64s {
+		OneUint64s[i] = 1
+	}
+}
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	if n.Full {
+		buf.WriteString(fmt.Sprintf("%s ⟗ %s", n.R, n.S))
+	} else {
+		buf.WriteString(fmt.Sprintf("%s ⟕ %s", n.R, n.S))
+	}
+}
+
+func Prepare(proc *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.Ctr = Container{
+		n:      len(n.Rattrs),
+		slots:  fastmap.New(),
+		diffs:  make([]bool, UnitLimit),
+		found:  make([]bool, UnitLimit),
+		matchs: make([]int64, UnitLimit),
+		hashs:  make([]uint64, UnitLimit),
+		sels:   make([][]int64, UnitLimit),
+		groups: make(map[uint64][]*hash.BagGroup),
+		vec:    vector.New(types.Type{Oid: types.T_int8}),
+	}
+	{
+		for _, attr := range n.Rattrs {
+			n.Ctr.rattrs = append(n.Ctr.rattrs, qualify(n.R, attr))
+		}
+		for _, attr := range n.Sattrs {
+			n.Ctr.rattrs = append(n.Ctr.rattrs, qualify(n.S, attr))
+		}
+	}
+	if n.Cond != nil {
+		n.Ctr.cond = n.Cond
+		n.Ctr.rattrs = append(n.Ctr.rattrs, n.Cond.Attributes()...)
+	}
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := &n.Ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(n, proc); err != nil {
+				ctr.clean(proc)
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Eval
+		case Eval:
+			ok, err := ctr.probe(n.R, n.S, n.Rattrs, n.Full, proc)
+			if err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				return true, err
+			}
+			if ok && n.Full {
+				ctr.state = Fill
+				continue
+			}
+			if ok {
+				ctr.state = End
+				ctr.clean(proc)
+			}
+			return ok, err
+		case Fill:
+			err := ctr.fill(n, proc)
+			ctr.state = End
+			ctr.clean(proc)
+			if err != nil || proc.Reg.InputBatch == nil {
+				return true, err
+			}
+			return false, nil
+		case End:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// R ⟕ S - S is the build relation
+func (ctr *Container) build(n *Argument, proc *process.Process) error {
+	var err error
+
+	reg := proc.Reg.MergeReceivers[1]
+	for {
+		v := <-reg.Ch
+		if v == nil {
+			reg.Ch = nil
+			reg.Wg.Done()
+			break
+		}
+		bat := v.(*batch.Batch)
+		if bat == nil || bat.Attrs == nil {
+			reg.Wg.Done()
+			continue
+		}
+		if len(bat.Sels) > 0 {
+			bat.Shuffle(proc)
+		}
+		if ctr.bat == nil {
+			bat.Reorder(n.Sattrs)
+			ctr.bat = batch.New(true, bat.Attrs)
+			for i, attr := range bat.Attrs {
+				vec := bat.GetVector(attr)
+				ctr.bat.Vecs[i] = vector.New(vec.Typ)
+				ctr.bat.Vecs[i].Ref = vec.Ref
+			}
+		} else {
+			bat.Reorder(ctr.bat.Attrs)
+		}
+		if err = ctr.buildBatch(bat.Vecs, proc); err != nil {
+			reg.Ch = nil
+			reg.Wg.Done()
+			bat.Clean(proc)
+			return err
+		}
+		reg.Wg.Done()
+		bat.Clean(proc)
+	}
+	if ctr.bat == nil { // S is empty
+		attrs := relationAttributes(n.Sattrs, n.Styps)
+		ctr.bat = batch.New(true, attrs)
+		for i, attr := range attrs {
+			ctr.bat.Vecs[i] = vector.New(n.Styps[attr])
+			ctr.bat.Vecs[i].Ref = n.Srefer[attr]
+		}
+	}
+	if n.Full {
+		ctr.matched = make([]bool, ctr.rows)
+	}
+	return nil
+}
+
+func (ctr *Container) probe(rName, sName string, attrs []string, full bool, proc *process.Process) (bool, error) {
+	reg := proc.Reg.MergeReceivers[0]
+	for {
+		v := <-reg.Ch
+		if v == nil {
+			reg.Ch = nil
+			reg.Wg.Done()
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+		bat := v.(*batch.Batch)
+		if bat == nil || bat.Attrs == nil {
+			reg.Wg.Done()
+			continue
+		}
+		if len(ctr.Probe.attrs) == 0 {
+			bat.Reorder(attrs)
+			ctr.Probe.attrs = append(ctr.Probe.attrs, bat.Attrs...)
+			ctr.attrs = make([]string, 0, len(bat.Attrs)+len(ctr.bat.Attrs))
+			for _, attr := range bat.Attrs {
+				ctr.attrs = append(ctr.attrs, qualify(rName, attr))
+			}
+			for _, attr := range ctr.bat.Attrs {
+				ctr.attrs = append(ctr.attrs, qualify(sName, attr))
+			}
+		} else {
+			bat.Reorder(ctr.Probe.attrs)
+		}
+		{
+			ctr.Probe.bat = batch.New(true, ctr.attrs)
+			for i, attr := range bat.Attrs {
+				vec := bat.GetVector(attr)
+				ctr.Probe.bat.Vecs[i] = vector.New(vec.Typ)
+				ctr.Probe.bat.Vecs[i].Ref = vec.Ref
+			}
+			j := len(bat.Attrs)
+			for i, vec := range ctr.bat.Vecs {
+				ctr.Probe.bat.Vecs[i+j] = vector.New(vec.Typ)
+				ctr.Probe.bat.Vecs[i+j].Ref = vec.Ref
+			}
+		}
+		if len(bat.Sels) > 0 {
+			bat.Shuffle(proc)
+		}
+		if err := ctr.probeBatch(bat.Vecs, full, proc); err != nil {
+			reg.Ch = nil
+			reg.Wg.Done()
+			bat.Clean(proc)
+			return true, err
+		}
+		if ctr.Probe.bat.Vecs[0].Length() == 0 {
+			reg.Wg.Done()
+			bat.Clean(proc)
+			continue
+		}
+		reg.Wg.Done()
+		bat.Clean(proc)
+		ctr.Probe.bat.Reduce(ctr.rattrs, proc)
+		proc.Reg.InputBatch = ctr.Probe.bat
+		ctr.Probe.bat = nil
+		return false, nil
+	}
+}
+
+// fill generates the rows of S which have no match in R
+func (ctr *Container) fill(n *Argument, proc *process.Process) error {
+	var rvecs []*vector.Vector
+
+	proc.Reg.InputBatch = nil
+	if len(ctr.Probe.attrs) == 0 { // R is empty
+		ctr.Probe.attrs = relationAttributes(n.Rattrs, n.Rtyps)
+		ctr.attrs = make([]string, 0, len(ctr.Probe.attrs)+len(ctr.bat.Attrs))
+		for _, attr := range ctr.Probe.attrs {
+			ctr.attrs = append(ctr.attrs, qualify(n.R, attr))
+		}
+		for _, attr := range ctr.bat.Attrs {
+			ctr.attrs = append(ctr.attrs, qualify(n.S, attr))
+		}
+	}
+	bat := batch.New(true, ctr.attrs)
+	{
+		for i, attr := range ctr.Probe.attrs {
+			bat.Vecs[i] = vector.New(n.Rtyps[attr])
+			bat.Vecs[i].Ref = n.Rrefer[attr]
+		}
+		rvecs = bat.Vecs[:len(ctr.Probe.attrs)]
+		j := len(ctr.Probe.attrs)
+		for i, vec := range ctr.bat.Vecs {
+			bat.Vecs[i+j] = vector.New(vec.Typ)
+			bat.Vecs[i+j].Ref = vec.Ref
+		}
+	}
+	j := len(rvecs)
+	for sel, ok := range ctr.matched {
+		if ok {
+			continue
+		}
+		for _, vec := range rvecs {
+			if err := vec.UnionNull(proc); err != nil {
+				bat.Clean(proc)
+				return err
+			}
+		}
+		for i, vec := range ctr.bat.Vecs {
+			if err := bat.Vecs[i+j].UnionOne(vec, int64(sel), proc); err != nil {
+				bat.Clean(proc)
+				return err
+			}
+		}
+		if proc.Size() > proc.Lim.Size {
+			bat.Clean(proc)
+			return errors.New("out of memory")
+		}
+	}
+	if bat.Vecs[0].Length() == 0 {
+		return nil
+	}
+	bat.Reduce(ctr.rattrs, proc)
+	proc.Reg.InputBatch = bat
+	return nil
+}
+
+func (ctr *Container) buildBatch(vecs []*vector.Vector, proc *process.Process) error {
+	for i, j := 0, vecs[0].Length(); i < j; i += UnitLimit {
+		length := j - i
+		if length > UnitLimit {
+			length = UnitLimit
+		}
+		if err := ctr.buildUnit(i, length, vecs, proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctr *Container) buildUnit(start, count int, vecs []*vector.Vector, proc *process.Process) error {
+	var err error
+	var matchs []int64
+
+	copy(ctr.hashs[:count], OneUint64s[:count])
+	ctr.fillHash(start, count, vecs[:ctr.n], false)
+	copy(ctr.diffs[:count], ZeroBools[:count])
+	for i, hs := range ctr.slots.Ks {
+		for j, h := range hs {
+			remaining := ctr.sels[ctr.slots.Vs[i][j]]
+			if gs, ok := ctr.groups[h]; ok {
+				for _, g := range gs {
+					matchs, remaining = g.Fill(remaining, ctr.matchs, vecs, ctr.bat.Vecs[:ctr.n], ctr.diffs)
+					for len(matchs) > 0 {
+						for i, vec := range ctr.bat.Vecs {
+							if err = vec.UnionOne(vecs[i], matchs[0], proc); err != nil {
+								return err
+							}
+						}
+						g.Sels[len(g.Sels)-len(matchs)] = ctr.rows
+						ctr.rows++
+						matchs = matchs[1:]
+						if proc.Size() > proc.Lim.Size {
+							return errors.New("out of memory")
+						}
+					}
+					copy(ctr.diffs[:len(remaining)], ZeroBools[:len(remaining)])
+				}
+			} else {
+				ctr.groups[h] = make([]*hash.BagGroup, 0, 8)
+			}
+			for len(remaining) > 0 {
+				g := hash.NewBagGroup(ctr.rows)
+				{
+					for i, vec := range ctr.bat.Vecs {
+						if err = vec.UnionOne(vecs[i], remaining[0], proc); err != nil {
+							return err
+						}
+					}
+					if proc.Size() > proc.Lim.Size {
+						return errors.New("out of memory")
+					}
+				}
+				ctr.rows++
+				ctr.groups[h] = append(ctr.groups[h], g)
+				matchs, remaining = g.Fill(remaining[1:], ctr.matchs, vecs, ctr.bat.Vecs[:ctr.n], ctr.diffs)
+				for len(matchs) > 0 {
+					for i, vec := range ctr.bat.Vecs {
+						if err = vec.UnionOne(vecs[i], matchs[0], proc); err != nil {
+							return err
+						}
+					}
+					g.Sels[len(g.Sels)-len(matchs)] = ctr.rows
+					ctr.rows++
+					matchs = matchs[1:]
+					if proc.Size() > proc.Lim.Size {
+						return errors.New("out of memory")
+					}
+				}
+				copy(ctr.diffs[:len(remaining)], ZeroBools[:len(remaining)])
+			}
+			ctr.sels[ctr.slots.Vs[i][j]] = ctr.sels[ctr.slots.Vs[i][j]][:0]
+		}
+	}
+	ctr.slots.Reset()
+	return nil
+}
+
+func (ctr *Container) probeBatch(vecs []*vector.Vector, full bool, proc *process.Process) error {
+	for i, j := 0, vecs[0].Length(); i < j; i += UnitLimit {
+		length := j - i
+		if length > UnitLimit {
+			length = UnitLimit
+		}
+		if err := ctr.probeUnit(i, length, vecs, full, proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctr *Container) probeUnit(start, count int, vecs []*vector.Vector, full bool, proc *process.Process) error {
+	var matchs []int64
+
+	copy(ctr.hashs[:count], OneUint64s[:count])
+	ctr.fillHash(start, count, vecs[:ctr.n], true)
+	copy(ctr.diffs[:count], ZeroBools[:count])
+	copy(ctr.found[:count], ZeroBools[:count])
+	for i, hs := range ctr.slots.Ks {
+		for j, h := range hs {
+			remaining := ctr.sels[ctr.slots.Vs[i][j]]
+			if gs, ok := ctr.groups[h]; ok {
+				for k := 0; k < len(gs); k++ {
+					g := gs[k]
+					matchs, remaining = g.Probe(remaining, ctr.matchs, vecs, ctr.bat.Vecs[:ctr.n], ctr.diffs)
+					if len(matchs) > 0 && ctr.cond != nil {
+						for _, sel := range matchs {
+							for _, gsel := range g.Sels {
+								ctr.rsels = append(ctr.rsels, sel)
+								ctr.ssels = append(ctr.ssels, gsel)
+							}
+						}
+					}
+					if len(matchs) > 0 && ctr.cond == nil {
+						if err := ctr.product(matchs, g, vecs, full, proc); err != nil {
+							return err
+						}
+						for _, sel := range matchs {
+							ctr.found[sel-int64(start)] = true
+						}
+						if proc.Size() > proc.Lim.Size {
+							return errors.New("out of memory")
+						}
+					}
+					copy(ctr.diffs[:len(remaining)], ZeroBools[:len(remaining)])
+				}
+			}
+			ctr.sels[ctr.slots.Vs[i][j]] = ctr.sels[ctr.slots.Vs[i][j]][:0]
+		}
+	}
+	ctr.slots.Reset()
+	if ctr.cond != nil {
+		if err := ctr.restrict(start, vecs, full, proc); err != nil {
+			return err
+		}
+	}
+	for i, ok := range ctr.found[:count] {
+		if !ok {
+			if err := ctr.pad(int64(i+start), vecs, proc); err != nil {
+				return err
+			}
+		}
+	}
+	if proc.Size() > proc.Lim.Size {
+		return errors.New("out of memory")
+	}
+	return nil
+}
+
+func (ctr *Container) product(sels []int64, g *hash.BagGroup, vecs []*vector.Vector, full bool, proc *process.Process) error {
+	for _, sel := range sels {
+		for _, gsel := range g.Sels {
+			for i, vec := range vecs {
+				if err := ctr.Probe.bat.Vecs[i].UnionOne(vec, sel, proc); err != nil {
+					return err
+				}
+			}
+			j := len(vecs)
+			for i, vec := range ctr.bat.Vecs {
+				if err := ctr.Probe.bat.Vecs[i+j].UnionOne(vec, gsel, proc); err != nil {
+					return err
+				}
+			}
+			if full {
+				ctr.matched[gsel] = true
+			}
+		}
+	}
+	return nil
+}
+
+// restrict evaluates the condition of the join on the pairs of rows whose join
+// attributes are equal, the pairs satisfying it are appended to the result.
+func (ctr *Container) restrict(start int, vecs []*vector.Vector, full bool, proc *process.Process) error {
+	defer func() {
+		ctr.rsels, ctr.ssels = ctr.rsels[:0], ctr.ssels[:0]
+	}()
+	if len(ctr.rsels) == 0 {
+		return nil
+	}
+	bat := batch.New(true, ctr.attrs)
+	{
+		for i, vec := range vecs {
+			bat.Vecs[i] = vector.New(vec.Typ)
+			bat.Vecs[i].Ref = 2 // the vectors are released by the clean of bat
+		}
+		j := len(vecs)
+		for i, vec := range ctr.bat.Vecs {
+			bat.Vecs[i+j] = vector.New(vec.Typ)
+			bat.Vecs[i+j].Ref = 2
+		}
+	}
+	defer bat.Clean(proc)
+	for k, sel := range ctr.rsels {
+		for i, vec := range vecs {
+			if err := bat.Vecs[i].UnionOne(vec, sel, proc); err != nil {
+				return err
+			}
+		}
+		j := len(vecs)
+		for i, vec := range ctr.bat.Vecs {
+			if err := bat.Vecs[i+j].UnionOne(vec, ctr.ssels[k], proc); err != nil {
+				return err
+			}
+		}
+		if proc.Size() > proc.Lim.Size {
+			return errors.New("out of memory")
+		}
+	}
+	sels, err := ctr.eval(bat, proc)
+	if err != nil {
+		return err
+	}
+	for _, k := range sels {
+		for i, vec := range vecs {
+			if err := ctr.Probe.bat.Vecs[i].UnionOne(vec, ctr.rsels[k], proc); err != nil {
+				return err
+			}
+		}
+		j := len(vecs)
+		for i, vec := range ctr.bat.Vecs {
+			if err := ctr.Probe.bat.Vecs[i+j].UnionOne(vec, ctr.ssels[k], proc); err != nil {
+				return err
+			}
+		}
+		ctr.found[ctr.rsels[k]-int64(start)] = true
+		if full {
+			ctr.matched[ctr.ssels[k]] = true
+		}
+	}
+	if proc.Size() > proc.Lim.Size {
+		return errors.New("out of memory")
+	}
+	return nil
+}
+
+// eval returns the rows of bat satisfying the condition of the join.
+func (ctr *Container) eval(bat *batch.Batch, proc *process.Process) ([]int64, error) {
+	switch ctr.cond.(type) {
+	case *extend.Attribute: // mysql treats any attribute as true
+		sels := make([]int64, bat.Vecs[0].Length())
+		for i := range sels {
+			sels[i] = int64(i)
+		}
+		return sels, nil
+	case *extend.ValueExtend: // the condition is false or null
+		return nil, nil
+	}
+	vec, _, err := ctr.cond.Eval(bat, proc)
+	if err != nil {
+		return nil, err
+	}
+	sels := append([]int64{}, vec.Col.([]int64)...)
+	register.Put(proc, vec)
+	return sels, nil
+}
+
+// pad appends the sel-th row of R and a null row of S to the result
+func (ctr *Container) pad(sel int64, vecs []*vector.Vector, proc *process.Process) error {
+	for i, vec := range vecs {
+		if err := ctr.Probe.bat.Vecs[i].UnionOne(vec, sel, proc); err != nil {
+			return err
+		}
+	}
+	j := len(vecs)
+	for i := range ctr.bat.Vecs {
+		if err := ctr.Probe.bat.Vecs[i+j].UnionNull(proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillHash computes the hash code of rows, rows whose join attributes
+// contain null are skipped if skipNull is set since null matches nothing.
+func (ctr *Container) fillHash(start, count int, vecs []*vector.Vector, skipNull bool) {
+	ctr.hashs = ctr.hashs[:count]
+	for _, vec := range vecs {
+		hash.Rehash(count, ctr.hashs, vec.Window(start, start+count, ctr.vec))
+	}
+	nextslot := 0
+	for i, h := range ctr.hashs {
+		if skipNull && isNull(int64(i+start), vecs) {
+			continue
+		}
+		slot, ok := ctr.slots.Get(h)
+		if !ok {
+			slot = nextslot
+			ctr.slots.Set(h, slot)
+			nextslot++
+		}
+		ctr.sels[slot] = append(ctr.sels[slot], int64(i+start))
+	}
+}
+
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(proc)
+		ctr.bat = nil
+	}
+	if ctr.Probe.bat != nil {
+		ctr.Probe.bat.Clean(proc)
+		ctr.Probe.bat = nil
+	}
+	{
+		for _, reg := range proc.Reg.MergeReceivers {
+			if reg.Ch != nil {
+				v := <-reg.Ch
+				switch {
+				case v == nil:
+					reg.Ch = nil
+					reg.Wg.Done()
+				default:
+					bat := v.(*batch.Batch)
+					if bat == nil || bat.Attrs == nil {
+						reg.Ch = nil
+						reg.Wg.Done()
+					} else {
+						bat.Clean(proc)
+						reg.Ch = nil
+						reg.Wg.Done()
+					}
+				}
+			}
+		}
+	}
+}
+
+func isNull(sel int64, vecs []*vector.Vector) bool {
+	for _, vec := range vecs {
+		if vec.Nsp.Contains(uint64(sel)) {
+			return true
+		}
+	}
+	return false
+}
+
+// relationAttributes returns the attributes of a relation, the join attributes come first.
+func relationAttributes(attrs []string, typs map[string]types.Type) []string {
+	rs := make([]string, 0, len(typs))
+	mp := make(map[string]struct{})
+	for _, attr := range attrs {
+		if _, ok := mp[attr]; !ok {
+			mp[attr] = struct{}{}
+			rs = append(rs, attr)
+		}
+	}
+	n := len(rs)
+	for attr := range typs {
+		if _, ok := mp[attr]; !ok {
+			rs = append(rs, attr)
+		}
+	}
+	sort.Strings(rs[n:])
+	return rs
+}
+
+// qualify returns the name of an attribute of a join input as seen by the
+// parent of the join, the attributes of an unnamed input are qualified already.
+func qualify(name, attr string) string {
+	if len(name) == 0 {
+		return attr
+	}
+	return name + "." + attr
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outer
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/hash"
+	"github.com/matrixorigin/matrixone/pkg/intmap/fastmap"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+)
+
+const (
+	Build = iota
+	Eval
+	Fill
+	End
+)
+
+const (
+	UnitLimit = 1024
+)
+
+var (
+	ZeroBools  []bool
+	OneUint64s []uint64
+)
+
+type Container struct {
+	n       int
+	state   int
+	rows    int64
+	diffs   []bool
+	found   []bool // found[i] is true if the i-th row of the current unit has a match
+	matchs  []int64
+	hashs   []uint64
+	rsels   []int64 // rsels and ssels are the pairs of rows of R and S to be checked by cond
+	ssels   []int64
+	cond    extend.Extend
+	attrs   []string
+	rattrs  []string
+	sels    [][]int64    // sels
+	slots   *fastmap.Map // hash code -> sels index
+	bat     *batch.Batch
+	vec     *vector.Vector
+	matched []bool // matched[i] is true if the i-th row of the build relation has a match
+	Probe   struct {
+		attrs []string
+		bat   *batch.Batch // output relation
+	}
+	groups map[uint64][]*hash.BagGroup // hash code -> group list
+}
+
+// Argument of the outer join, the rows of R without a match are padded with nulls,
+// and so are the rows of S if Full is set.
+type Argument struct {
+	Full   bool
+	R      string
+	S      string
+	Rattrs []string
+	Sattrs []string
+	// Cond is the condition of the join besides the equalities, a pair of rows
+	// whose join attributes are equal has a match only if it is satisfied.
+	Cond extend.Extend
+	// Rrefer, Srefer, Rtyps and Styps describe the attributes of both relations,
+	// they are used to build the null padded columns when a relation is empty.
+	Rrefer map[string]uint64
+	Srefer map[string]uint64
+	Rtyps  map[string]types.Type
+	Styps  map[string]types.Type
+	Ctr    Container
+}
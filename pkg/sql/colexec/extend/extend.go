@@ -18,6 +18,8 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/dateadd"
+	"strings"
 )
 
 var UnaryReturnTypes = map[int]func(Extend) types.T{
@@ -83,7 +85,113 @@ var BinaryReturnTypes = map[int]func(Extend, Extend) types.T{
 	},
 }
 
-var MultiReturnTypes = map[int]func([]Extend) types.T{}
+var MultiReturnTypes = map[int]func([]Extend) types.T{
+	overload.Substring: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Substring, es[0].ReturnType())
+	},
+	overload.Concat: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Concat, es[0].ReturnType())
+	},
+	overload.Upper: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Upper, es[0].ReturnType())
+	},
+	overload.Lower: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Lower, es[0].ReturnType())
+	},
+	overload.Trim: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Trim, es[0].ReturnType())
+	},
+	overload.LTrim: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.LTrim, es[0].ReturnType())
+	},
+	overload.RTrim: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.RTrim, es[0].ReturnType())
+	},
+	overload.Length: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Length, es[0].ReturnType())
+	},
+	overload.Abs: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Abs, es[0].ReturnType())
+	},
+	overload.Round: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Round, es[0].ReturnType())
+	},
+	overload.Floor: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Floor, es[0].ReturnType())
+	},
+	overload.Ceil: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Ceil, es[0].ReturnType())
+	},
+	overload.Coalesce: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Coalesce, es[0].ReturnType())
+	},
+	overload.IfNull: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.IfNull, es[0].ReturnType())
+	},
+	overload.DateAdd: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.DateAdd, es[0].ReturnType())
+	},
+	overload.DateSub: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.DateSub, es[0].ReturnType())
+	},
+	overload.Year: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Year, es[0].ReturnType())
+	},
+	overload.Month: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Month, es[0].ReturnType())
+	},
+	overload.Day: func(es []Extend) types.T {
+		return overload.GetMultiOpReturnType(overload.Day, es[0].ReturnType())
+	},
+	overload.Now: func(_ []Extend) types.T {
+		return types.T_datetime
+	},
+	overload.JsonExtract: func(_ []Extend) types.T {
+		return types.T_json
+	},
+	overload.JsonUnquote: func(_ []Extend) types.T {
+		return types.T_varchar
+	},
+	overload.JsonContains: func(_ []Extend) types.T {
+		return types.T_int64
+	},
+	overload.JsonLength: func(_ []Extend) types.T {
+		return types.T_int64
+	},
+	overload.JsonKeys: func(_ []Extend) types.T {
+		return types.T_json
+	},
+	overload.JsonValid: func(_ []Extend) types.T {
+		return types.T_int64
+	},
+	overload.IsNull: func(_ []Extend) types.T {
+		return types.T_sel
+	},
+	overload.IsNotNull: func(_ []Extend) types.T {
+		return types.T_sel
+	},
+	overload.Case: func(es []Extend) types.T {
+		return caseReturnType(es)
+	},
+	overload.ConcatItem: func(_ []Extend) types.T {
+		return types.T_varchar
+	},
+	overload.In: func(_ []Extend) types.T {
+		return types.T_sel
+	},
+	overload.NotIn: func(_ []Extend) types.T {
+		return types.T_sel
+	},
+	overload.Exists: func(_ []Extend) types.T {
+		return types.T_sel
+	},
+	overload.NotExists: func(_ []Extend) types.T {
+		return types.T_sel
+	},
+	overload.Lookup: func(es []Extend) types.T {
+		return es[len(es)-1].ReturnType()
+	},
+}
 
 var UnaryStrings = map[int]func(Extend) string{
 	overload.UnaryMinus: func(e Extend) string {
@@ -148,7 +256,121 @@ var BinaryStrings = map[int]func(Extend, Extend) string{
 	},
 }
 
-var MultiStrings = map[int]func([]Extend) string{}
+var MultiStrings = map[int]func([]Extend) string{
+	overload.DateAdd: func(es []Extend) string {
+		return fmt.Sprintf("date_add(%s, interval %s %s)", es[0], es[1], intervalUnit(es[2]))
+	},
+	overload.DateSub: func(es []Extend) string {
+		return fmt.Sprintf("date_sub(%s, interval %s %s)", es[0], es[1], intervalUnit(es[2]))
+	},
+	overload.IsNull: func(es []Extend) string {
+		return fmt.Sprintf("%s is null", es[0])
+	},
+	overload.IsNotNull: func(es []Extend) string {
+		return fmt.Sprintf("%s is not null", es[0])
+	},
+	overload.Case: func(es []Extend) string {
+		ss := []string{"case"}
+		for i := 0; i+1 < len(es); i += 2 {
+			ss = append(ss, fmt.Sprintf("when %s then %s", es[i], valueString(es[i+1])))
+		}
+		if len(es)%2 == 1 {
+			ss = append(ss, fmt.Sprintf("else %s", valueString(es[len(es)-1])))
+		}
+		return strings.Join(append(ss, "end"), " ")
+	},
+	overload.ConcatItem: func(es []Extend) string {
+		return concatItemString(es)
+	},
+	overload.In: func(es []Extend) string {
+		return setString("in", es[:len(es)/2], es[len(es)/2:])
+	},
+	overload.NotIn: func(es []Extend) string {
+		return setString("not in", es[:len(es)/2], es[len(es)/2:])
+	},
+	overload.Exists: func(es []Extend) string {
+		return setString("exists", es[:len(es)/2], es[len(es)/2:])
+	},
+	overload.NotExists: func(es []Extend) string {
+		return setString("not exists", es[:len(es)/2], es[len(es)/2:])
+	},
+	overload.Lookup: func(es []Extend) string {
+		return setString("lookup", es[:len(es)/2], es[len(es)/2:])
+	},
+}
+
+// setString formats the probes and the set of the operators of a set.
+func setString(name string, ps, ss []Extend) string {
+	args := make([]string, len(ps))
+	for i, p := range ps {
+		args[i] = p.String()
+	}
+	sets := make([]string, len(ss))
+	for i, s := range ss {
+		sets[i] = s.String()
+	}
+	return fmt.Sprintf("(%s) %s (%s)", strings.Join(args, ", "), name, strings.Join(sets, ", "))
+}
+
+// caseReturnType returns the type of the first value of case which is not
+// the constant null, the values are cast to the same type when built.
+func caseReturnType(es []Extend) types.T {
+	for i := 1; i < len(es); i += 2 {
+		if !isNull(es[i]) {
+			return es[i].ReturnType()
+		}
+	}
+	if len(es)%2 == 1 && !isNull(es[len(es)-1]) {
+		return es[len(es)-1].ReturnType()
+	}
+	return es[1].ReturnType()
+}
+
+func isNull(e Extend) bool {
+	v, ok := e.(*ValueExtend)
+	return ok && v.V.Nsp.Contains(0)
+}
+
+func valueString(e Extend) string {
+	if isNull(e) {
+		return "null"
+	}
+	return e.String()
+}
+
+// concatItemString formats the values and the order by keys of group_concat.
+func concatItemString(es []Extend) string {
+	k := 0
+	if v, ok := es[0].(*ValueExtend); ok {
+		if vs, ok := v.V.Col.([]int64); ok {
+			k = int(vs[0])
+		}
+	}
+	var args, keys []string
+	for i := 1; i <= k && i < len(es); i++ {
+		args = append(args, es[i].String())
+	}
+	for i := k + 1; i+1 < len(es); i += 2 {
+		if desc, ok := es[i+1].(*ValueExtend); ok && desc.V.Col.([]int64)[0] != 0 {
+			keys = append(keys, es[i].String()+" desc")
+		} else {
+			keys = append(keys, es[i].String())
+		}
+	}
+	if len(keys) == 0 {
+		return fmt.Sprintf("%s(%s)", overload.OpName[overload.ConcatItem], strings.Join(args, ", "))
+	}
+	return fmt.Sprintf("%s(%s order by %s)", overload.OpName[overload.ConcatItem], strings.Join(args, ", "), strings.Join(keys, ", "))
+}
+
+func intervalUnit(e Extend) string {
+	if v, ok := e.(*ValueExtend); ok {
+		if vs, ok := v.V.Col.([]int64); ok && vs[0] >= 0 && int(vs[0]) < len(dateadd.Units) {
+			return dateadd.Units[vs[0]]
+		}
+	}
+	return e.String()
+}
 
 func AndExtends(e Extend, es []Extend) []Extend {
 	switch v := e.(type) {
@@ -160,6 +382,8 @@ func AndExtends(e Extend, es []Extend) []Extend {
 		return es
 	case *ValueExtend:
 		return es
+	case *MultiExtend:
+		return append(es, v)
 	case *BinaryExtend:
 		if v.Op == overload.And {
 			return append(AndExtends(v.Left, es), AndExtends(v.Right, es)...)
@@ -15,37 +15,132 @@
 package extend
 
 import (
+	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
+	"strings"
 )
 
 func (e *MultiExtend) IsLogical() bool {
 	return overload.IsLogical(e.Op)
 }
 
-func (_ *MultiExtend) IsConstant() bool {
-	return false
+// IsConstant returns true if all the arguments are constants, a function
+// without arguments such as now() has a value for each row.
+func (e *MultiExtend) IsConstant() bool {
+	if len(e.Args) == 0 {
+		return false
+	}
+	for _, arg := range e.Args {
+		if !arg.IsConstant() {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *MultiExtend) Attributes() []string {
+	var attrs []string
+
+	for _, arg := range e.Args {
+		attrs = append(attrs, arg.Attributes()...)
+	}
+	return attrs
 }
 
-func (_ *MultiExtend) Attributes() []string {
-	return nil
+func (e *MultiExtend) ReturnType() types.T {
+	if fn, ok := MultiReturnTypes[e.Op]; ok {
+		return fn(e.Args)
+	}
+	return types.T_any
+}
+
+func (e *MultiExtend) Eval(bat *batch.Batch, proc *process.Process) (*vector.Vector, types.T, error) {
+	var typ types.T
+
+	cs := make([]bool, len(e.Args))
+	vs := make([]*vector.Vector, len(e.Args))
+	for i, arg := range e.Args {
+		v, t, err := arg.Eval(bat, proc)
+		if err != nil {
+			return nil, 0, err
+		}
+		if i == 0 {
+			typ = t
+		}
+		vs[i], cs[i] = v, arg.IsConstant()
+	}
+	var vec *vector.Vector
+	var err error
+	if e.Op == overload.Case {
+		vec, err = overload.CaseEval(e.length(bat), cs, vs, proc)
+	} else {
+		vec, err = overload.MultiEval(e.Op, typ, cs, vs, proc)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(e.Args) == 0 {
+		if vec, err = repeat(vec, e.length(bat), proc); err != nil {
+			return nil, 0, err
+		}
+	}
+	return vec, e.ReturnType(), nil
 }
 
-func (_ *MultiExtend) ReturnType() types.T {
-	return 0
+// repeat returns the value of a function without arguments for n rows, the
+// function is evaluated once for the whole batch.
+func repeat(vec *vector.Vector, n int, proc *process.Process) (*vector.Vector, error) {
+	if n == 1 {
+		return vec, nil
+	}
+	defer register.Put(proc, vec)
+	rs := vector.New(vec.Typ)
+	for i := 0; i < n; i++ {
+		if err := rs.UnionOne(vec, 0, proc); err != nil {
+			rs.Free(proc)
+			return nil, err
+		}
+	}
+	rs.Ref = 0
+	return rs, nil
 }
 
-func (_ *MultiExtend) Eval(_ *batch.Batch, _ *process.Process) (*vector.Vector, types.T, error) {
-	return nil, 0, nil
+// length returns the number of rows of the batch, it is one if the
+// extend is a constant.
+func (e *MultiExtend) length(bat *batch.Batch) int {
+	if e.IsConstant() || len(bat.Vecs) == 0 {
+		return 1
+	}
+	return bat.Length()
 }
 
-func (_ *MultiExtend) Eq(_ Extend) bool {
+func (a *MultiExtend) Eq(e Extend) bool {
+	if b, ok := e.(*MultiExtend); ok {
+		if a.Op != b.Op || len(a.Args) != len(b.Args) {
+			return false
+		}
+		for i := range a.Args {
+			if !a.Args[i].Eq(b.Args[i]) {
+				return false
+			}
+		}
+		return true
+	}
 	return false
 }
 
-func (_ *MultiExtend) String() string {
-	return ""
+func (e *MultiExtend) String() string {
+	if fn, ok := MultiStrings[e.Op]; ok {
+		return fn(e.Args)
+	}
+	args := make([]string, len(e.Args))
+	for i, arg := range e.Args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", overload.OpName[e.Op], strings.Join(args, ", "))
 }
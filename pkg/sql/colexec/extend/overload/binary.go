@@ -113,6 +113,18 @@ func GetBinOpReturnType(op int, lt, rt types.T) types.T {
 	return t
 }
 
+// CommonType returns the type that both lt and rt are cast to before they are compared,
+// it reports false if the two types are not compared by a common type.
+func CommonType(lt, rt types.T) (types.Type, bool) {
+	if lt == rt {
+		return lt.ToType(), true
+	}
+	if c := binOpsTypeCastRules[EQ-firstBinaryOp][lt][rt]; c.has && c.leftCast.Oid == c.rightCast.Oid {
+		return c.leftCast, true
+	}
+	return types.Type{}, false
+}
+
 func initSliceForBinaryOps() {
 	binOpsReturnType = make([][][]types.T, len(binOperators))
 	for i := range binOperators {
@@ -146,6 +158,7 @@ func initCastRulesForBinaryOps() {
 	uints := []uint8{types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64}
 	floats := []uint8{types.T_float32, types.T_float64}
 	chars := []uint8{types.T_char, types.T_varchar}
+	dates := []uint8{types.T_date, types.T_datetime}
 
 	// PLUS cast rule / Minus cast rule / Mult cast rule
 	{
@@ -669,6 +682,45 @@ func initCastRulesForBinaryOps() {
 				newReturnType: types.T(nret),
 			}
 			binOpsReturnType[index][types.T_float64][types.T_float32] = types.T(nret)
+			// date op char, the string is cast to the date type
+			for _, l := range dates {
+				nd := types.Type{Oid: types.T(l), Size: 4}
+				if l == types.T_datetime {
+					nd.Size = 8
+				}
+				for _, r := range chars {
+					binOpsTypeCastRules[index][l][r] = castResult{
+						has:           true,
+						leftCast:      nd,
+						rightCast:     nd,
+						newReturnType: types.T(nret),
+					}
+					binOpsReturnType[index][l][r] = types.T(nret)
+					binOpsTypeCastRules[index][r][l] = castResult{
+						has:           true,
+						leftCast:      nd,
+						rightCast:     nd,
+						newReturnType: types.T(nret),
+					}
+					binOpsReturnType[index][r][l] = types.T(nret)
+				}
+			}
+			// date op datetime, the date is cast to the midnight of its day
+			nd := types.Type{Oid: types.T_datetime, Size: 8}
+			binOpsTypeCastRules[index][types.T_date][types.T_datetime] = castResult{
+				has:           true,
+				leftCast:      nd,
+				rightCast:     nd,
+				newReturnType: types.T(nret),
+			}
+			binOpsReturnType[index][types.T_date][types.T_datetime] = types.T(nret)
+			binOpsTypeCastRules[index][types.T_datetime][types.T_date] = castResult{
+				has:           true,
+				leftCast:      nd,
+				rightCast:     nd,
+				newReturnType: types.T(nret),
+			}
+			binOpsReturnType[index][types.T_datetime][types.T_date] = types.T(nret)
 		}
 	}
 }
@@ -677,3 +729,10 @@ func initCastRulesForBinaryOps() {
 func binaryOpsNeedCast(op int, ltyp types.T, rtyp types.T) (castResult, bool) {
 	return binOpsTypeCastRules[op-firstBinaryOp][ltyp][rtyp], binOpsTypeCastRules[op-firstBinaryOp][ltyp][rtyp].has
 }
+
+// BinaryCastRule returns the types which the arguments of a binary operator
+// are cast to, it returns false if the arguments are not cast.
+func BinaryCastRule(op int, ltyp types.T, rtyp types.T) (types.Type, types.Type, bool) {
+	rule, ok := binaryOpsNeedCast(op, ltyp, rtyp)
+	return rule.leftCast, rule.rightCast, ok
+}
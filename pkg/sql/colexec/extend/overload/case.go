@@ -0,0 +1,165 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
+)
+
+// CaseEval evaluates case, whose arguments are pairs of a condition and its
+// value followed by the optional else value. n is the number of rows of the
+// batch, since the conditions are selection vectors which only hold the rows
+// they select. Each row takes the value of the first condition selecting it,
+// a row selected by no condition takes the else value or null. A condition
+// which is null is not true, so that the row falls through to the next one.
+func CaseEval(n int, cs []bool, vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	// from is the index of the value taken by each row, -1 means null
+	from := make([]int, n)
+	for i := range from {
+		from[i] = -1
+		if len(vs)%2 == 1 {
+			from[i] = len(vs) - 1
+		}
+	}
+	// the conditions are visited backwards, so an earlier one overwrites a later one
+	for i := len(vs)/2*2 - 2; i >= 0; i -= 2 {
+		if vs[i].Typ.Oid != types.T_sel {
+			if !isTrue(vs[i]) {
+				continue
+			}
+			for j := range from {
+				from[j] = i + 1
+			}
+			continue
+		}
+		for _, sel := range vs[i].Col.([]int64) {
+			if sel < int64(n) {
+				from[sel] = i + 1
+			}
+		}
+	}
+	typ := caseType(cs, vs)
+	if types.IsDecimal(typ.Oid) {
+		isValue := func(i int) bool {
+			return (i%2 == 1 || i == len(vs)-1) && !isNullConstant(cs[i], vs[i])
+		}
+		tmps, err := alignScale(typ, cs, vs, isValue, proc)
+		if err != nil {
+			multiFree(vs, cs, proc)
+			return nil, err
+		}
+		defer putVectors(tmps, proc)
+		typ.Precision = decimalScale(vs[caseValue(cs, vs)])
+	}
+	vec := vector.New(typ)
+	for i, j := range from {
+		if j < 0 || vs[j].Nsp.Contains(uint64(caseRow(i, cs[j], vs[j]))) {
+			if err := vec.UnionNull(proc); err != nil {
+				vec.Clean(proc)
+				return nil, err
+			}
+			continue
+		}
+		if err := vec.UnionOne(vs[j], caseRow(i, cs[j], vs[j]), proc); err != nil {
+			vec.Clean(proc)
+			return nil, err
+		}
+	}
+	vec.Ref = 0
+	multiFree(vs, cs, proc)
+	return vec, nil
+}
+
+// caseType returns the type of the result, which is the type of the first
+// value that is not the constant null.
+func caseType(cs []bool, vs []*vector.Vector) types.Type {
+	return vs[caseValue(cs, vs)].Typ
+}
+
+// caseValue returns the index of the first value that is not the constant null.
+func caseValue(cs []bool, vs []*vector.Vector) int {
+	for i := 1; i < len(vs); i += 2 {
+		if !isNullConstant(cs[i], vs[i]) {
+			return i
+		}
+	}
+	if i := len(vs) - 1; len(vs)%2 == 1 && !isNullConstant(cs[i], vs[i]) {
+		return i
+	}
+	return 1
+}
+
+// alignScale casts the decimal values to the largest scale of them, since the
+// values are copied into the result as they are. It returns the casts of the
+// constants, which are freed by the caller.
+func alignScale(typ types.Type, cs []bool, vs []*vector.Vector, isValue func(int) bool, proc *process.Process) ([]*vector.Vector, error) {
+	var tmps []*vector.Vector
+
+	for i, v := range vs {
+		if isValue(i) && decimalScale(v) > typ.Precision {
+			typ.Precision = decimalScale(v)
+		}
+	}
+	for i, v := range vs {
+		if !isValue(i) || decimalScale(v) == typ.Precision {
+			continue
+		}
+		w, err := BinaryEval(Typecast, v.Typ.Oid, typ.Oid, cs[i], false, v, vector.New(typ), proc)
+		if err != nil {
+			putVectors(tmps, proc)
+			return nil, err
+		}
+		if vs[i] = w; cs[i] {
+			tmps = append(tmps, w)
+		}
+	}
+	return tmps, nil
+}
+
+func putVectors(vs []*vector.Vector, proc *process.Process) {
+	for _, v := range vs {
+		register.Put(proc, v)
+	}
+}
+
+// caseRow returns the row of a value taken by the i-th row of the result,
+// a constant or a value cast from a constant has only one row.
+func caseRow(i int, isConstant bool, v *vector.Vector) int64 {
+	if isConstant || v.Length() == 1 {
+		return 0
+	}
+	return int64(i)
+}
+
+func isNullConstant(isConstant bool, v *vector.Vector) bool {
+	return isConstant && v.Nsp.Contains(0)
+}
+
+// isTrue returns true if a constant condition is neither null nor zero.
+func isTrue(v *vector.Vector) bool {
+	if v.Nsp.Contains(0) {
+		return false
+	}
+	switch vs := v.Col.(type) {
+	case []int64:
+		return vs[0] != 0
+	case []float64:
+		return vs[0] != 0
+	}
+	return false
+}
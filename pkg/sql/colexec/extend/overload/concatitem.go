@@ -0,0 +1,198 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"encoding/binary"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/groupconcat"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"math"
+	"strconv"
+)
+
+func init() {
+	MultiOps[ConcatItem] = append(MultiOps[ConcatItem], &MultiOp{
+		Min:        2,
+		Max:        -1,
+		Typ:        types.T_int64,
+		ReturnType: types.T_varchar,
+		Fn:         concatItemFn,
+	})
+}
+
+// concatItemFn makes the items of group_concat. The first argument is the
+// constant number k of the values, which are followed by the pairs of an
+// order by key and a constant flag which is 1 for a descending key. The value
+// of an item is the concatenation of the k values, it is null if any of them
+// is null, and its sort key is the concatenation of the keys encoded so that
+// they are ordered as bytes.
+func concatItemFn(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	k := int(vs[0].Col.([]int64)[0])
+	n := multiLength(vs, cs)
+	col := &types.Bytes{
+		Offsets: make([]uint32, 0, n),
+		Lengths: make([]uint32, 0, n),
+	}
+	vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	var key, v []byte
+	for i := 0; i < n; i++ {
+		key, v = key[:0], v[:0]
+		isNull := false
+		for j := 1; j <= k; j++ {
+			row := caseRow(i, cs[j], vs[j])
+			if vs[j].Nsp.Contains(uint64(row)) {
+				isNull = true
+				break
+			}
+			v = appendText(v, vs[j], row)
+		}
+		if isNull {
+			vec.Nsp.Add(uint64(i))
+			col.Offsets = append(col.Offsets, uint32(len(col.Data)))
+			col.Lengths = append(col.Lengths, 0)
+			continue
+		}
+		for j := k + 1; j+1 < len(vs); j += 2 {
+			start := len(key)
+			key = appendSortKey(key, vs[j], caseRow(i, cs[j], vs[j]))
+			if vs[j+1].Col.([]int64)[0] != 0 {
+				for x := start; x < len(key); x++ {
+					key[x] = ^key[x]
+				}
+			}
+		}
+		offset := len(col.Data)
+		col.Data = groupconcat.AppendItem(col.Data, key, v)
+		col.Offsets = append(col.Offsets, uint32(offset))
+		col.Lengths = append(col.Lengths, uint32(len(col.Data)-offset))
+	}
+	if err := proc.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec.Data = col.Data
+	vec.SetCol(col)
+	multiFree(vs, cs, proc)
+	return vec, nil
+}
+
+// appendText appends the text of the i-th value of v to data.
+func appendText(data []byte, v *vector.Vector, i int64) []byte {
+	switch vs := v.Col.(type) {
+	case []int8:
+		return strconv.AppendInt(data, int64(vs[i]), 10)
+	case []int16:
+		return strconv.AppendInt(data, int64(vs[i]), 10)
+	case []int32:
+		return strconv.AppendInt(data, int64(vs[i]), 10)
+	case []int64:
+		return strconv.AppendInt(data, vs[i], 10)
+	case []uint8:
+		return strconv.AppendUint(data, uint64(vs[i]), 10)
+	case []uint16:
+		return strconv.AppendUint(data, uint64(vs[i]), 10)
+	case []uint32:
+		return strconv.AppendUint(data, uint64(vs[i]), 10)
+	case []uint64:
+		return strconv.AppendUint(data, vs[i], 10)
+	case []float32:
+		return strconv.AppendFloat(data, float64(vs[i]), 'f', -1, 32)
+	case []float64:
+		return strconv.AppendFloat(data, vs[i], 'f', -1, 64)
+	case []types.Decimal64:
+		return append(data, vs[i].Format(v.Typ.Precision)...)
+	case []types.Decimal128:
+		return append(data, vs[i].Format(v.Typ.Precision)...)
+	case []types.Date:
+		return append(data, vs[i].String()...)
+	case []types.Datetime:
+		return append(data, vs[i].String()...)
+	case *types.Bytes:
+		return append(data, vs.Get(i)...)
+	}
+	return data
+}
+
+// appendSortKey appends the encoding of the i-th value of v to data, the
+// encodings of two values compare as bytes in the same order as the values.
+// A null is smaller than any value.
+func appendSortKey(data []byte, v *vector.Vector, i int64) []byte {
+	if v.Nsp.Contains(uint64(i)) {
+		return append(data, 0)
+	}
+	data = append(data, 1)
+	switch vs := v.Col.(type) {
+	case []int8:
+		return appendInt(data, int64(vs[i]))
+	case []int16:
+		return appendInt(data, int64(vs[i]))
+	case []int32:
+		return appendInt(data, int64(vs[i]))
+	case []int64:
+		return appendInt(data, vs[i])
+	case []uint8:
+		return appendUint(data, uint64(vs[i]))
+	case []uint16:
+		return appendUint(data, uint64(vs[i]))
+	case []uint32:
+		return appendUint(data, uint64(vs[i]))
+	case []uint64:
+		return appendUint(data, vs[i])
+	case []float32:
+		return appendFloat(data, float64(vs[i]))
+	case []float64:
+		return appendFloat(data, vs[i])
+	case []types.Decimal64:
+		return appendInt(data, int64(vs[i]))
+	case []types.Decimal128:
+		return appendUint(appendInt(data, vs[i].Hi), vs[i].Lo)
+	case []types.Date:
+		return appendInt(data, int64(vs[i]))
+	case []types.Datetime:
+		return appendInt(data, int64(vs[i]))
+	case *types.Bytes:
+		// a zero byte is escaped, so that a string is smaller than its extensions
+		for _, c := range vs.Get(i) {
+			if data = append(data, c); c == 0 {
+				data = append(data, 0xFF)
+			}
+		}
+		return append(data, 0, 0)
+	}
+	return data
+}
+
+func appendInt(data []byte, v int64) []byte {
+	return appendUint(data, uint64(v)^(1<<63))
+}
+
+func appendUint(data []byte, v uint64) []byte {
+	var buf [8]byte
+
+	binary.BigEndian.PutUint64(buf[:], v)
+	return append(data, buf[:]...)
+}
+
+func appendFloat(data []byte, v float64) []byte {
+	if v == 0 {
+		v = 0 // -0 equals to 0
+	}
+	u := math.Float64bits(v)
+	if u&(1<<63) != 0 {
+		return appendUint(data, ^u)
+	}
+	return appendUint(data, u|(1<<63))
+}
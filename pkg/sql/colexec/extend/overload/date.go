@@ -0,0 +1,196 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"errors"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/dateadd"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/extract"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
+)
+
+var (
+	errDateUnit = errors.New("only day, week, month, quarter and year intervals can be added to a date")
+)
+
+func init() {
+	for _, op := range []int{DateAdd, DateSub} {
+		MultiOps[op] = []*MultiOp{
+			{
+				Min:        3,
+				Max:        3,
+				Typ:        types.T_date,
+				ReturnType: types.T_date,
+				Fn:         dateAddFn(op),
+			},
+			{
+				Min:        3,
+				Max:        3,
+				Typ:        types.T_datetime,
+				ReturnType: types.T_datetime,
+				Fn:         datetimeAddFn(op),
+			},
+		}
+	}
+	extracts := []struct {
+		op int
+		df func([]types.Date, []int64) []int64
+		tf func([]types.Datetime, []int64) []int64
+	}{
+		{Year, extract.DateYear, extract.DatetimeYear},
+		{Month, extract.DateMonth, extract.DatetimeMonth},
+		{Day, extract.DateDay, extract.DatetimeDay},
+	}
+	for _, e := range extracts {
+		df, tf := e.df, e.tf
+		MultiOps[e.op] = []*MultiOp{
+			{
+				Min:        1,
+				Max:        1,
+				Typ:        types.T_date,
+				ReturnType: types.T_int64,
+				Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+					xs := vs[0].Col.([]types.Date)
+					vec, err := register.Get(proc, int64(len(xs))*8, types.Type{Oid: types.T_int64, Size: 8})
+					if err != nil {
+						return nil, err
+					}
+					rs := encoding.DecodeInt64Slice(vec.Data)
+					rs = rs[:len(xs)]
+					vec.SetCol(df(xs, rs))
+					multiNulls(vec, vs, cs)
+					multiFree(vs, cs, proc)
+					return vec, nil
+				},
+			},
+			{
+				Min:        1,
+				Max:        1,
+				Typ:        types.T_datetime,
+				ReturnType: types.T_int64,
+				Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+					xs := vs[0].Col.([]types.Datetime)
+					vec, err := register.Get(proc, int64(len(xs))*8, types.Type{Oid: types.T_int64, Size: 8})
+					if err != nil {
+						return nil, err
+					}
+					rs := encoding.DecodeInt64Slice(vec.Data)
+					rs = rs[:len(xs)]
+					vec.SetCol(tf(xs, rs))
+					multiNulls(vec, vs, cs)
+					multiFree(vs, cs, proc)
+					return vec, nil
+				},
+			},
+		}
+	}
+	MultiOps[Now] = []*MultiOp{
+		{
+			Min:        0,
+			Max:        1,
+			Typ:        types.T_any,
+			ReturnType: types.T_datetime,
+			Fn: func(_ []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				vec, err := register.Get(proc, 8, types.Type{Oid: types.T_datetime, Size: 8})
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeDatetimeSlice(vec.Data)
+				// now() is of the precision of a second as in mysql
+				now := types.Now()
+				rs[0] = now - types.Datetime(now.MicroSec())
+				vec.SetCol(rs[:1])
+				return vec, nil
+			},
+		},
+	}
+}
+
+// intervals returns the number of units to add for each row and the unit of the interval.
+func intervals(op int, vs []*vector.Vector) ([]int64, int, error) {
+	ns, err := int64Values(vs[1])
+	if err != nil {
+		return nil, 0, err
+	}
+	if op == DateSub {
+		rs := make([]int64, len(ns))
+		for i, n := range ns {
+			rs[i] = -n
+		}
+		ns = rs
+	}
+	return ns, int(vs[2].Col.([]int64)[0]), nil
+}
+
+func dateAddFn(op int) func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		ns, unit, err := intervals(op, vs)
+		if err != nil {
+			return nil, err
+		}
+		if !dateadd.IsDateUnit(unit) {
+			return nil, errDateUnit
+		}
+		xs := vs[0].Col.([]types.Date)
+		if n := multiLength(vs, cs); len(xs) < n {
+			ys := make([]types.Date, n)
+			for i := range ys {
+				ys[i] = xs[0]
+			}
+			xs = ys
+		}
+		vec, err := register.Get(proc, int64(len(xs))*4, vs[0].Typ)
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeDateSlice(vec.Data)
+		rs = rs[:len(xs)]
+		vec.SetCol(dateadd.DateAdd(xs, ns, unit, rs))
+		multiNulls(vec, vs, cs)
+		multiFree(vs, cs, proc)
+		return vec, nil
+	}
+}
+
+func datetimeAddFn(op int) func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		ns, unit, err := intervals(op, vs)
+		if err != nil {
+			return nil, err
+		}
+		xs := vs[0].Col.([]types.Datetime)
+		if n := multiLength(vs, cs); len(xs) < n {
+			ys := make([]types.Datetime, n)
+			for i := range ys {
+				ys[i] = xs[0]
+			}
+			xs = ys
+		}
+		vec, err := register.Get(proc, int64(len(xs))*8, vs[0].Typ)
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeDatetimeSlice(vec.Data)
+		rs = rs[:len(xs)]
+		vec.SetCol(dateadd.DatetimeAdd(xs, ns, unit, rs))
+		multiNulls(vec, vs, cs)
+		multiFree(vs, cs, proc)
+		return vec, nil
+	}
+}
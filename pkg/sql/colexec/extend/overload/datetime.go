@@ -0,0 +1,240 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/eq"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ge"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/gt"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/le"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/lt"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ne"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/typecast"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
+)
+
+var dateTypes = []types.T{types.T_date, types.T_datetime}
+
+// The comparisons between a date and a string or a datetime are resolved by
+// the cast rules of binary.go, so that only dates of the same type are compared here.
+func init() {
+	for _, op := range []int{EQ, NE, LT, LE, GT, GE} {
+		for _, t := range dateTypes {
+			BinOps[op] = append(BinOps[op], newBinOp(t, t, types.T_sel, dateCompare(op)))
+		}
+	}
+	for _, d := range dateTypes {
+		BinOps[Typecast] = append(BinOps[Typecast], newBinOp(d, d, d, func(lv, _ *vector.Vector, _ *process.Process, _, _ bool) (*vector.Vector, error) {
+			return lv, nil
+		}))
+		for _, s := range decimalCharTypes {
+			BinOps[Typecast] = append(BinOps[Typecast], newBinOp(s, d, d, castCharToDate))
+			BinOps[Typecast] = append(BinOps[Typecast], newBinOp(d, s, s, castDateToChar))
+		}
+	}
+	BinOps[Typecast] = append(BinOps[Typecast],
+		newBinOp(types.T_date, types.T_datetime, types.T_datetime, castDateAndDatetime),
+		newBinOp(types.T_datetime, types.T_date, types.T_date, castDateAndDatetime))
+}
+
+// dateCompare returns the function of a comparison operator between two dates
+// or two datetimes, both of which are ordered as their integer values.
+func dateCompare(op int) binOpFunc {
+	return func(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+		n := decimalLength(lv, rv, lc, rc)
+		xs, ys := dateColumn(lv, n), dateColumn(rv, n)
+		vec, err := register.Get(proc, int64(n)*int64(SelsType.Size), SelsType)
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeInt64Slice(vec.Data)
+		rs = rs[:n]
+		if nsp := decimalNulls(lv, rv, lc, rc); nsp.Any() {
+			switch op {
+			case EQ:
+				rs = eq.Int64EqNullable(xs, ys, nsp.Np, rs)
+			case NE:
+				rs = ne.Int64NeNullable(xs, ys, nsp.Np, rs)
+			case LT:
+				rs = lt.Int64LtNullable(xs, ys, nsp.Np, rs)
+			case LE:
+				rs = le.Int64LeNullable(xs, ys, nsp.Np, rs)
+			case GT:
+				rs = gt.Int64GtNullable(xs, ys, nsp.Np, rs)
+			case GE:
+				rs = ge.Int64GeNullable(xs, ys, nsp.Np, rs)
+			}
+		} else {
+			switch op {
+			case EQ:
+				rs = eq.Int64Eq(xs, ys, rs)
+			case NE:
+				rs = ne.Int64Ne(xs, ys, rs)
+			case LT:
+				rs = lt.Int64Lt(xs, ys, rs)
+			case LE:
+				rs = le.Int64Le(xs, ys, rs)
+			case GT:
+				rs = gt.Int64Gt(xs, ys, rs)
+			case GE:
+				rs = ge.Int64Ge(xs, ys, rs)
+			}
+		}
+		vec.SetCol(rs)
+		decimalRelease(lv, rv, proc, lc, rc)
+		return vec, nil
+	}
+}
+
+// castCharToDate parses strings as the date type of rv, a string which is not
+// a valid date fails the cast.
+func castCharToDate(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	var err error
+
+	defer func() {
+		if lv.Ref == 0 {
+			register.Put(proc, lv)
+		}
+	}()
+	vs := lv.Col.(*types.Bytes)
+	n := len(vs.Offsets)
+	vec, err := register.Get(proc, int64(n)*int64(rv.Typ.Size), rv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	if rv.Typ.Oid == types.T_date {
+		rs := encoding.DecodeDateSlice(vec.Data)
+		rs = rs[:n]
+		for i := range rs {
+			if !lv.Nsp.Contains(uint64(i)) {
+				if rs[i], err = types.ParseDate(string(vs.Get(int64(i)))); err != nil {
+					break
+				}
+			}
+		}
+		vec.SetCol(rs)
+	} else {
+		rs := encoding.DecodeDatetimeSlice(vec.Data)
+		rs = rs[:n]
+		for i := range rs {
+			if !lv.Nsp.Contains(uint64(i)) {
+				if rs[i], err = types.ParseDatetime(string(vs.Get(int64(i)))); err != nil {
+					break
+				}
+			}
+		}
+		vec.SetCol(rs)
+	}
+	if err != nil {
+		register.Put(proc, vec)
+		return nil, err
+	}
+	vec.Nsp.Set(lv.Nsp)
+	return vec, nil
+}
+
+// castDateToChar formats dates as YYYY-MM-DD and datetimes as YYYY-MM-DD HH:MM:SS[.ffffff].
+func castDateToChar(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	var err error
+
+	defer func() {
+		if lv.Ref == 0 {
+			register.Put(proc, lv)
+		}
+	}()
+	n := lv.Length()
+	col := &types.Bytes{
+		Data:    make([]byte, 0, n),
+		Offsets: make([]uint32, 0, n),
+		Lengths: make([]uint32, 0, n),
+	}
+	switch vs := lv.Col.(type) {
+	case []types.Date:
+		col, err = typecast.DateToBytes(vs, col)
+	case []types.Datetime:
+		col, err = typecast.DatetimeToBytes(vs, col)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = proc.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(rv.Typ)
+	vec.Data = col.Data
+	vec.Nsp.Set(lv.Nsp)
+	vec.SetCol(col)
+	return vec, nil
+}
+
+// castDateAndDatetime converts between dates and datetimes, a date is the
+// midnight of its day and a datetime loses its time part.
+func castDateAndDatetime(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			register.Put(proc, lv)
+		}
+	}()
+	n := lv.Length()
+	vec, err := register.Get(proc, int64(n)*int64(rv.Typ.Size), rv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	switch vs := lv.Col.(type) {
+	case []types.Date:
+		rs := encoding.DecodeDatetimeSlice(vec.Data)
+		rs, _ = typecast.DateToDatetime(vs, rs[:n])
+		vec.SetCol(rs)
+	case []types.Datetime:
+		rs := encoding.DecodeDateSlice(vec.Data)
+		rs, _ = typecast.DatetimeToDate(vs, rs[:n])
+		vec.SetCol(rs)
+	default:
+		register.Put(proc, vec)
+		return nil, fmt.Errorf("cannot cast %s to %s", lv.Typ, rv.Typ)
+	}
+	vec.Nsp.Set(lv.Nsp)
+	return vec, nil
+}
+
+// dateColumn returns the values of a date or datetime vector as int64,
+// a constant is broadcast to n rows.
+func dateColumn(v *vector.Vector, n int) []int64 {
+	xs := make([]int64, v.Length())
+	switch vs := v.Col.(type) {
+	case []types.Date:
+		for i, x := range vs {
+			xs[i] = int64(x)
+		}
+	case []types.Datetime:
+		for i, x := range vs {
+			xs[i] = int64(x)
+		}
+	}
+	if len(xs) < n {
+		x := xs[0]
+		xs = make([]int64, n)
+		for i := range xs {
+			xs[i] = x
+		}
+	}
+	return xs
+}
@@ -0,0 +1,680 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/div"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/eq"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ge"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/gt"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/le"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/lt"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/mul"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ne"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/neg"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/sub"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/typecast"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
+)
+
+// DecimalDivScaleIncrement is the number of digits added to the scale of
+// the dividend for decimal division, like mysql's div_precision_increment.
+const DecimalDivScaleIncrement = 4
+
+var (
+	decimalTypes = []types.T{types.T_decimal64, types.T_decimal128}
+	// decimalIntTypes are the types which are converted to decimal exactly
+	// when mixed with a decimal operand.
+	decimalIntTypes = []types.T{
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	}
+	// decimalFloatTypes are the types which turn a decimal operand into float64.
+	decimalFloatTypes = []types.T{types.T_float32, types.T_float64}
+	decimalCharTypes  = []types.T{types.T_char, types.T_varchar}
+)
+
+// The decimal operators are appended to UnaryOps and BinOps before the return types are
+// collected by the init functions of unaryops.go and plus.go, so that
+// decimal.go must sort before them.
+func init() {
+	UnaryOps[UnaryMinus] = append(UnaryOps[UnaryMinus], &UnaryOp{
+		Typ:        types.T_decimal64,
+		ReturnType: types.T_decimal64,
+		Fn: func(v *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+			if v.Ref == 1 || v.Ref == 0 {
+				v.Ref = 0
+				vs := v.Col.([]types.Decimal64)
+				neg.Decimal64Neg(vs, vs)
+				return v, nil
+			}
+			vs := v.Col.([]types.Decimal64)
+			vec, err := register.Get(proc, int64(len(vs))*8, v.Typ)
+			if err != nil {
+				return nil, err
+			}
+			rs := encoding.DecodeDecimal64Slice(vec.Data)
+			rs = rs[:len(vs)]
+			vec.Nsp.Set(v.Nsp)
+			vec.SetCol(neg.Decimal64Neg(vs, rs))
+			return vec, nil
+		},
+	}, &UnaryOp{
+		Typ:        types.T_decimal128,
+		ReturnType: types.T_decimal128,
+		Fn: func(v *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+			if v.Ref == 1 || v.Ref == 0 {
+				v.Ref = 0
+				vs := v.Col.([]types.Decimal128)
+				neg.Decimal128Neg(vs, vs)
+				return v, nil
+			}
+			vs := v.Col.([]types.Decimal128)
+			vec, err := register.Get(proc, int64(len(vs))*16, v.Typ)
+			if err != nil {
+				return nil, err
+			}
+			rs := encoding.DecodeDecimal128Slice(vec.Data)
+			rs = rs[:len(vs)]
+			vec.Nsp.Set(v.Nsp)
+			vec.SetCol(neg.Decimal128Neg(vs, rs))
+			return vec, nil
+		},
+	})
+	for _, op := range []int{Plus, Minus, Mult, Div} {
+		for _, l := range decimalTypes {
+			for _, r := range decimalTypes {
+				BinOps[op] = append(BinOps[op], newBinOp(l, r, types.T_decimal128, decimalArith(op)))
+			}
+			for _, r := range decimalIntTypes {
+				BinOps[op] = append(BinOps[op], newBinOp(l, r, types.T_decimal128, decimalArith(op)))
+				BinOps[op] = append(BinOps[op], newBinOp(r, l, types.T_decimal128, decimalArith(op)))
+			}
+			for _, r := range decimalFloatTypes {
+				BinOps[op] = append(BinOps[op], newBinOp(l, r, types.T_float64, decimalFloatArith(op)))
+				BinOps[op] = append(BinOps[op], newBinOp(r, l, types.T_float64, decimalFloatArith(op)))
+			}
+		}
+	}
+	for _, op := range []int{EQ, NE, LT, LE, GT, GE} {
+		for _, l := range decimalTypes {
+			for _, r := range decimalTypes {
+				BinOps[op] = append(BinOps[op], newBinOp(l, r, types.T_sel, decimalCompare(op)))
+			}
+			for _, r := range decimalIntTypes {
+				BinOps[op] = append(BinOps[op], newBinOp(l, r, types.T_sel, decimalCompare(op)))
+				BinOps[op] = append(BinOps[op], newBinOp(r, l, types.T_sel, decimalCompare(op)))
+			}
+			for _, r := range decimalFloatTypes {
+				BinOps[op] = append(BinOps[op], newBinOp(l, r, types.T_sel, decimalFloatCompare(op)))
+				BinOps[op] = append(BinOps[op], newBinOp(r, l, types.T_sel, decimalFloatCompare(op)))
+			}
+		}
+	}
+	for _, d := range decimalTypes {
+		for _, s := range decimalTypes {
+			BinOps[Typecast] = append(BinOps[Typecast], newBinOp(s, d, d, castToDecimal))
+		}
+		for _, s := range decimalIntTypes {
+			BinOps[Typecast] = append(BinOps[Typecast], newBinOp(s, d, d, castToDecimal))
+			BinOps[Typecast] = append(BinOps[Typecast], newBinOp(d, s, s, castDecimalToNumeric))
+		}
+		for _, s := range decimalFloatTypes {
+			BinOps[Typecast] = append(BinOps[Typecast], newBinOp(s, d, d, castToDecimal))
+			BinOps[Typecast] = append(BinOps[Typecast], newBinOp(d, s, s, castDecimalToNumeric))
+		}
+		for _, s := range decimalCharTypes {
+			BinOps[Typecast] = append(BinOps[Typecast], newBinOp(s, d, d, castToDecimal))
+			BinOps[Typecast] = append(BinOps[Typecast], newBinOp(d, s, s, castDecimalToChar))
+		}
+	}
+}
+
+type binOpFunc func(*vector.Vector, *vector.Vector, *process.Process, bool, bool) (*vector.Vector, error)
+
+func newBinOp(l, r, ret types.T, fn binOpFunc) *BinOp {
+	return &BinOp{
+		LeftType:   l,
+		RightType:  r,
+		ReturnType: ret,
+		Fn:         fn,
+	}
+}
+
+// decimalArith returns the function of a decimal arithmetic operator, the
+// result is always a decimal128 and its scale is
+//
+//	plus, minus: max(s1, s2)
+//	mult: s1 + s2
+//	div: s1 + DecimalDivScaleIncrement
+func decimalArith(op int) binOpFunc {
+	return func(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+		ls, rs := decimalScale(lv), decimalScale(rv)
+		lscale, rscale := ls, rs
+		var scale int32
+		switch op {
+		case Plus, Minus:
+			if scale = ls; rs > scale {
+				scale = rs
+			}
+			lscale, rscale = scale, scale
+		case Mult:
+			scale = ls + rs
+		case Div:
+			scale = ls + DecimalDivScaleIncrement
+			lscale = scale + rs
+		}
+		if scale > types.MaxDecimal128Precision || lscale > types.MaxDecimal128Precision {
+			return nil, types.ErrDecimalOverflow
+		}
+		n := decimalLength(lv, rv, lc, rc)
+		xs, err := decimalColumn(lv, lscale, n)
+		if err != nil {
+			return nil, err
+		}
+		ys, err := decimalColumn(rv, rscale, n)
+		if err != nil {
+			return nil, err
+		}
+		nsp := decimalNulls(lv, rv, lc, rc)
+		if op == Div {
+			zero := types.Decimal128{}
+			for i, y := range ys {
+				if y == zero {
+					if !nsp.Contains(uint64(i)) {
+						return nil, ErrDivByZero
+					}
+					ys[i] = types.Decimal128FromInt64(1)
+				}
+			}
+		}
+		vec, err := register.Get(proc, int64(n)*16, types.Type{Oid: types.T_decimal128, Size: 16, Width: types.MaxDecimal128Precision, Precision: scale})
+		if err != nil {
+			return nil, err
+		}
+		vs := encoding.DecodeDecimal128Slice(vec.Data)
+		vs = vs[:n]
+		switch op {
+		case Plus:
+			add.Decimal128Add(xs, ys, vs)
+		case Minus:
+			sub.Decimal128Sub(xs, ys, vs)
+		case Mult:
+			mul.Decimal128Mul(xs, ys, vs)
+		case Div:
+			div.Decimal128Div(xs, ys, vs)
+		}
+		vec.Nsp = nsp
+		vec.SetCol(vs)
+		decimalRelease(lv, rv, proc, lc, rc)
+		return vec, nil
+	}
+}
+
+// decimalFloatArith returns the function of an arithmetic operator between
+// a decimal and a float, which is done in float64.
+func decimalFloatArith(op int) binOpFunc {
+	return func(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+		n := decimalLength(lv, rv, lc, rc)
+		xs, ys := floatColumn(lv, n), floatColumn(rv, n)
+		nsp := decimalNulls(lv, rv, lc, rc)
+		if op == Div {
+			for i, y := range ys {
+				if y == 0 {
+					if !nsp.Contains(uint64(i)) {
+						return nil, ErrDivByZero
+					}
+					ys[i] = 1
+				}
+			}
+		}
+		vec, err := register.Get(proc, int64(n)*8, types.Type{Oid: types.T_float64, Size: 8})
+		if err != nil {
+			return nil, err
+		}
+		vs := encoding.DecodeFloat64Slice(vec.Data)
+		vs = vs[:n]
+		switch op {
+		case Plus:
+			add.Float64Add(xs, ys, vs)
+		case Minus:
+			sub.Float64Sub(xs, ys, vs)
+		case Mult:
+			mul.Float64Mul(xs, ys, vs)
+		case Div:
+			div.Float64Div(xs, ys, vs)
+		}
+		vec.Nsp = nsp
+		vec.SetCol(vs)
+		decimalRelease(lv, rv, proc, lc, rc)
+		return vec, nil
+	}
+}
+
+// decimalCompare returns the function of a comparison operator between
+// decimals or a decimal and an integer, both sides are compared at the
+// larger scale.
+func decimalCompare(op int) binOpFunc {
+	return func(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+		scale := decimalScale(lv)
+		if s := decimalScale(rv); s > scale {
+			scale = s
+		}
+		n := decimalLength(lv, rv, lc, rc)
+		xs, err := decimalColumn(lv, scale, n)
+		if err != nil {
+			return nil, err
+		}
+		ys, err := decimalColumn(rv, scale, n)
+		if err != nil {
+			return nil, err
+		}
+		vec, err := register.Get(proc, int64(n)*int64(SelsType.Size), SelsType)
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeInt64Slice(vec.Data)
+		rs = rs[:n]
+		if nsp := decimalNulls(lv, rv, lc, rc); nsp.Any() {
+			switch op {
+			case EQ:
+				rs = eq.Decimal128EqNullable(xs, ys, nsp.Np, rs)
+			case NE:
+				rs = ne.Decimal128NeNullable(xs, ys, nsp.Np, rs)
+			case LT:
+				rs = lt.Decimal128LtNullable(xs, ys, nsp.Np, rs)
+			case LE:
+				rs = le.Decimal128LeNullable(xs, ys, nsp.Np, rs)
+			case GT:
+				rs = gt.Decimal128GtNullable(xs, ys, nsp.Np, rs)
+			case GE:
+				rs = ge.Decimal128GeNullable(xs, ys, nsp.Np, rs)
+			}
+		} else {
+			switch op {
+			case EQ:
+				rs = eq.Decimal128Eq(xs, ys, rs)
+			case NE:
+				rs = ne.Decimal128Ne(xs, ys, rs)
+			case LT:
+				rs = lt.Decimal128Lt(xs, ys, rs)
+			case LE:
+				rs = le.Decimal128Le(xs, ys, rs)
+			case GT:
+				rs = gt.Decimal128Gt(xs, ys, rs)
+			case GE:
+				rs = ge.Decimal128Ge(xs, ys, rs)
+			}
+		}
+		vec.SetCol(rs)
+		decimalRelease(lv, rv, proc, lc, rc)
+		return vec, nil
+	}
+}
+
+// decimalFloatCompare returns the function of a comparison operator between
+// a decimal and a float, which is done in float64.
+func decimalFloatCompare(op int) binOpFunc {
+	return func(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+		n := decimalLength(lv, rv, lc, rc)
+		xs, ys := floatColumn(lv, n), floatColumn(rv, n)
+		vec, err := register.Get(proc, int64(n)*int64(SelsType.Size), SelsType)
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeInt64Slice(vec.Data)
+		rs = rs[:n]
+		if nsp := decimalNulls(lv, rv, lc, rc); nsp.Any() {
+			switch op {
+			case EQ:
+				rs = eq.Float64EqNullable(xs, ys, nsp.Np, rs)
+			case NE:
+				rs = ne.Float64NeNullable(xs, ys, nsp.Np, rs)
+			case LT:
+				rs = lt.Float64LtNullable(xs, ys, nsp.Np, rs)
+			case LE:
+				rs = le.Float64LeNullable(xs, ys, nsp.Np, rs)
+			case GT:
+				rs = gt.Float64GtNullable(xs, ys, nsp.Np, rs)
+			case GE:
+				rs = ge.Float64GeNullable(xs, ys, nsp.Np, rs)
+			}
+		} else {
+			switch op {
+			case EQ:
+				rs = eq.Float64Eq(xs, ys, rs)
+			case NE:
+				rs = ne.Float64Ne(xs, ys, rs)
+			case LT:
+				rs = lt.Float64Lt(xs, ys, rs)
+			case LE:
+				rs = le.Float64Le(xs, ys, rs)
+			case GT:
+				rs = gt.Float64Gt(xs, ys, rs)
+			case GE:
+				rs = ge.Float64Ge(xs, ys, rs)
+			}
+		}
+		vec.SetCol(rs)
+		decimalRelease(lv, rv, proc, lc, rc)
+		return vec, nil
+	}
+}
+
+// castToDecimal casts numbers, strings and decimals to the decimal type of rv.
+func castToDecimal(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			register.Put(proc, lv)
+		}
+	}()
+	n := lv.Length()
+	xs, err := decimalColumn(lv, rv.Typ.Precision, n)
+	if err != nil {
+		return nil, err
+	}
+	vec, err := register.Get(proc, int64(n)*int64(rv.Typ.Size), rv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	if rv.Typ.Oid == types.T_decimal64 {
+		rs := encoding.DecodeDecimal64Slice(vec.Data)
+		rs = rs[:n]
+		if _, err := typecast.Decimal128ToDecimal64(xs, 0, rs); err != nil {
+			register.Put(proc, vec)
+			return nil, err
+		}
+		vec.SetCol(rs)
+	} else {
+		rs := encoding.DecodeDecimal128Slice(vec.Data)
+		rs = rs[:n]
+		copy(rs, xs)
+		vec.SetCol(rs)
+	}
+	vec.Nsp.Set(lv.Nsp)
+	return vec, nil
+}
+
+// castDecimalToNumeric casts decimals to integers, which are rounded, or floats.
+func castDecimalToNumeric(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			register.Put(proc, lv)
+		}
+	}()
+	var err error
+
+	n := lv.Length()
+	vec, err := register.Get(proc, int64(n)*int64(rv.Typ.Size), rv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	switch rv.Typ.Oid {
+	case types.T_float32:
+		rs := encoding.DecodeFloat32Slice(vec.Data)
+		_, err = typecast.Float64ToFloat32(floatColumn(lv, n), rs[:n])
+		vec.SetCol(rs[:n])
+	case types.T_float64:
+		rs := encoding.DecodeFloat64Slice(vec.Data)
+		copy(rs[:n], floatColumn(lv, n))
+		vec.SetCol(rs[:n])
+	default:
+		xs := make([]int64, n)
+		switch vs := lv.Col.(type) {
+		case []types.Decimal64:
+			_, err = typecast.Decimal64ToInt64(vs, lv.Typ.Precision, xs)
+		case []types.Decimal128:
+			_, err = typecast.Decimal128ToInt64(vs, lv.Typ.Precision, xs)
+		}
+		if err == nil {
+			err = castInt64s(xs, vec, n)
+		}
+	}
+	if err != nil {
+		register.Put(proc, vec)
+		return nil, err
+	}
+	vec.Nsp.Set(lv.Nsp)
+	return vec, nil
+}
+
+// castDecimalToChar formats decimals with their scale.
+func castDecimalToChar(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	var err error
+
+	defer func() {
+		if lv.Ref == 0 {
+			register.Put(proc, lv)
+		}
+	}()
+	n := lv.Length()
+	col := &types.Bytes{
+		Data:    make([]byte, 0, n),
+		Offsets: make([]uint32, 0, n),
+		Lengths: make([]uint32, 0, n),
+	}
+	switch vs := lv.Col.(type) {
+	case []types.Decimal64:
+		col, err = typecast.Decimal64ToBytes(vs, lv.Typ.Precision, col)
+	case []types.Decimal128:
+		col, err = typecast.Decimal128ToBytes(vs, lv.Typ.Precision, col)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = proc.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(rv.Typ)
+	vec.Data = col.Data
+	vec.Nsp.Set(lv.Nsp)
+	vec.SetCol(col)
+	return vec, nil
+}
+
+// castInt64s narrows xs into the integer column of vec.
+func castInt64s(xs []int64, vec *vector.Vector, n int) error {
+	var err error
+
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		rs := encoding.DecodeInt8Slice(vec.Data)[:n]
+		_, err = typecast.Int64ToInt8(xs, rs)
+		vec.SetCol(rs)
+	case types.T_int16:
+		rs := encoding.DecodeInt16Slice(vec.Data)[:n]
+		_, err = typecast.Int64ToInt16(xs, rs)
+		vec.SetCol(rs)
+	case types.T_int32:
+		rs := encoding.DecodeInt32Slice(vec.Data)[:n]
+		_, err = typecast.Int64ToInt32(xs, rs)
+		vec.SetCol(rs)
+	case types.T_int64:
+		rs := encoding.DecodeInt64Slice(vec.Data)[:n]
+		copy(rs, xs)
+		vec.SetCol(rs)
+	case types.T_uint8:
+		rs := encoding.DecodeUint8Slice(vec.Data)[:n]
+		_, err = typecast.Int64ToUint8(xs, rs)
+		vec.SetCol(rs)
+	case types.T_uint16:
+		rs := encoding.DecodeUint16Slice(vec.Data)[:n]
+		_, err = typecast.Int64ToUint16(xs, rs)
+		vec.SetCol(rs)
+	case types.T_uint32:
+		rs := encoding.DecodeUint32Slice(vec.Data)[:n]
+		_, err = typecast.Int64ToUint32(xs, rs)
+		vec.SetCol(rs)
+	case types.T_uint64:
+		rs := encoding.DecodeUint64Slice(vec.Data)[:n]
+		_, err = typecast.Int64ToUint64(xs, rs)
+		vec.SetCol(rs)
+	default:
+		err = fmt.Errorf("cannot cast decimal to %s", vec.Typ)
+	}
+	return err
+}
+
+func decimalScale(v *vector.Vector) int32 {
+	if types.IsDecimal(v.Typ.Oid) {
+		return v.Typ.Precision
+	}
+	return 0
+}
+
+// decimalLength returns the number of rows of a binary operation, a
+// constant side has only one value.
+func decimalLength(lv, rv *vector.Vector, lc, rc bool) int {
+	if lc && !rc {
+		return rv.Length()
+	}
+	return lv.Length()
+}
+
+func decimalNulls(lv, rv *vector.Vector, lc, rc bool) *nulls.Nulls {
+	nsp := new(nulls.Nulls)
+	switch {
+	case lc && !rc:
+		nsp.Set(rv.Nsp)
+	case !lc && rc:
+		nsp.Set(lv.Nsp)
+	default:
+		nsp.Set(lv.Nsp)
+		nsp.Set(rv.Nsp)
+	}
+	return nsp
+}
+
+func decimalRelease(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) {
+	if !lc && lv.Ref == 0 {
+		register.Put(proc, lv)
+	}
+	if !rc && rv.Ref == 0 {
+		register.Put(proc, rv)
+	}
+}
+
+// decimalColumn returns the values of v as decimal128 with the given scale,
+// a constant is broadcast to n rows.
+func decimalColumn(v *vector.Vector, scale int32, n int) ([]types.Decimal128, error) {
+	var err error
+
+	xs := make([]types.Decimal128, v.Length())
+	switch vs := v.Col.(type) {
+	case []types.Decimal64:
+		_, err = typecast.Decimal64ToDecimal128(vs, scale-v.Typ.Precision, xs)
+	case []types.Decimal128:
+		_, err = typecast.Decimal128ToDecimal128(vs, scale-v.Typ.Precision, xs)
+	case []int64:
+		_, err = typecast.Int64ToDecimal128(vs, scale, xs)
+	case []uint64:
+		_, err = typecast.Uint64ToDecimal128(vs, scale, xs)
+	case []float64:
+		_, err = typecast.Float64ToDecimal128(vs, scale, xs)
+	case []float32:
+		_, err = typecast.Float64ToDecimal128(floatColumn(v, len(xs)), scale, xs)
+	case *types.Bytes:
+		_, err = typecast.BytesToDecimal128(vs, types.MaxDecimal128Precision, scale, xs)
+	case []int8, []int16, []int32:
+		_, err = typecast.Int64ToDecimal128(int64Column(v), scale, xs)
+	case []uint8, []uint16, []uint32:
+		_, err = typecast.Uint64ToDecimal128(uint64Column(v), scale, xs)
+	default:
+		err = fmt.Errorf("cannot cast %s to decimal", v.Typ)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(xs) < n {
+		x := xs[0]
+		xs = make([]types.Decimal128, n)
+		for i := range xs {
+			xs[i] = x
+		}
+	}
+	return xs, nil
+}
+
+// floatColumn returns the values of v as float64, a constant is broadcast
+// to n rows.
+func floatColumn(v *vector.Vector, n int) []float64 {
+	xs := make([]float64, v.Length())
+	switch vs := v.Col.(type) {
+	case []types.Decimal64:
+		typecast.Decimal64ToFloat64(vs, v.Typ.Precision, xs)
+	case []types.Decimal128:
+		typecast.Decimal128ToFloat64(vs, v.Typ.Precision, xs)
+	case []float32:
+		for i, x := range vs {
+			xs[i] = float64(x)
+		}
+	case []float64:
+		copy(xs, vs)
+	}
+	if len(xs) < n {
+		x := xs[0]
+		xs = make([]float64, n)
+		for i := range xs {
+			xs[i] = x
+		}
+	}
+	return xs
+}
+
+func int64Column(v *vector.Vector) []int64 {
+	xs := make([]int64, v.Length())
+	switch vs := v.Col.(type) {
+	case []int8:
+		for i, x := range vs {
+			xs[i] = int64(x)
+		}
+	case []int16:
+		for i, x := range vs {
+			xs[i] = int64(x)
+		}
+	case []int32:
+		for i, x := range vs {
+			xs[i] = int64(x)
+		}
+	}
+	return xs
+}
+
+func uint64Column(v *vector.Vector) []uint64 {
+	xs := make([]uint64, v.Length())
+	switch vs := v.Col.(type) {
+	case []uint8:
+		for i, x := range vs {
+			xs[i] = uint64(x)
+		}
+	case []uint16:
+		for i, x := range vs {
+			xs[i] = uint64(x)
+		}
+	case []uint32:
+		for i, x := range vs {
+			xs[i] = uint64(x)
+		}
+	}
+	return xs
+}
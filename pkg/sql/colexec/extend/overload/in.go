@@ -0,0 +1,318 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"errors"
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
+)
+
+// ErrMoreThanOneRow is reported when a scalar subquery returns more than
+// one row for a row of the outer query.
+var ErrMoreThanOneRow = errors.New("subquery returns more than 1 row")
+
+// The arguments of in, notIn, exists and notExists are the k probe columns
+// followed by the k columns of the set, the set is a constant holding one
+// row per member:
+//	in(a, b, set_a, set_b) is (a, b) in ((set_a[0], set_b[0]), ...)
+// exists and notExists are the semi join and the anti join of the probes
+// with the set, they differ from in and notIn on null: a row containing
+// null is never in the set, so notExists selects it.
+//
+// The arguments of lookup are the k probe columns, the k key columns and
+// the value column, which holds one more row than the keys: the value
+// returned for the probes without a matching key.
+//	lookup(a, key_a, value) returns value[i] if a = key_a[i], otherwise value[n]
+func init() {
+	typs := []types.T{
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64,
+		types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_datetime,
+		types.T_char, types.T_varchar,
+	}
+	for _, typ := range typs {
+		MultiOps[In] = append(MultiOps[In], &MultiOp{
+			Min:        2,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_sel,
+			Fn:         inFn(In),
+		})
+		MultiOps[NotIn] = append(MultiOps[NotIn], &MultiOp{
+			Min:        2,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_sel,
+			Fn:         inFn(NotIn),
+		})
+		MultiOps[Exists] = append(MultiOps[Exists], &MultiOp{
+			Min:        2,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_sel,
+			Fn:         inFn(Exists),
+		})
+		MultiOps[NotExists] = append(MultiOps[NotExists], &MultiOp{
+			Min:        2,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_sel,
+			Fn:         inFn(NotExists),
+		})
+		MultiOps[Lookup] = append(MultiOps[Lookup], &MultiOp{
+			Min:        3,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_any,
+			Fn:         lookupFn,
+		})
+	}
+}
+
+// inFn makes the function of in, notIn, exists and notExists, which return
+// the rows of the probes found (not found) in the set. A row containing null
+// is never selected by in and notIn, and notIn selects nothing once the set
+// may contain the row because of a null member, as the result is unknown.
+func inFn(op int) func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		if len(vs)%2 != 0 {
+			return nil, fmt.Errorf("'%s' requires the same number of probes and sets", OpName[op])
+		}
+		k := len(vs) / 2
+		ps := vs[:k]
+		ss, err := castSets(op, ps, vs[k:], proc)
+		if err != nil {
+			return nil, err
+		}
+		defer freeSets(ss, vs[k:], proc)
+		mp, nulls, _ := setRows(ss)
+		empty := len(mp) == 0 && len(nulls) == 0
+		n := multiLength(ps, cs[:k])
+		vec, err := register.Get(proc, int64(n)*int64(SelsType.Size), SelsType)
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeInt64Slice(vec.Data)[:0]
+		var key []byte
+		keys := make([][]byte, k)
+		for i := 0; i < n; i++ {
+			var ok bool
+
+			key, ok = rowKey(key[:0], keys, ps, cs[:k], int64(i))
+			_, found := mp[string(key)]
+			switch op {
+			case In, Exists:
+				if ok && found {
+					rs = append(rs, int64(i))
+				}
+			case NotIn:
+				if empty || (ok && !found && !mayContain(nulls, keys)) {
+					rs = append(rs, int64(i))
+				}
+			case NotExists:
+				if !ok || !found {
+					rs = append(rs, int64(i))
+				}
+			}
+		}
+		vec.SetCol(rs)
+		multiFree(ps, cs[:k], proc)
+		return vec, nil
+	}
+}
+
+// lookupFn returns the value of the key matching each row of the probes.
+func lookupFn(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	if len(vs)%2 != 1 {
+		return nil, fmt.Errorf("'%s' requires the same number of probes and keys", OpName[Lookup])
+	}
+	k := len(vs) / 2
+	ps, rv := vs[:k], vs[2*k]
+	ks, err := castSets(Lookup, ps, vs[k:2*k], proc)
+	if err != nil {
+		return nil, err
+	}
+	defer freeSets(ks, vs[k:2*k], proc)
+	mp, _, dup := setRows(ks)
+	if dup {
+		return nil, ErrMoreThanOneRow
+	}
+	def := int64(rv.Length() - 1)
+	n := multiLength(ps, cs[:k])
+	vec := vector.New(rv.Typ)
+	var key []byte
+	keys := make([][]byte, k)
+	for i := 0; i < n; i++ {
+		var ok bool
+
+		row := def
+		if key, ok = rowKey(key[:0], keys, ps, cs[:k], int64(i)); ok {
+			if j, found := mp[string(key)]; found {
+				row = j
+			}
+		}
+		if err := vec.UnionOne(rv, row, proc); err != nil {
+			vec.Clean(proc)
+			return nil, err
+		}
+	}
+	vec.Ref = 0
+	multiFree(ps, cs[:k], proc)
+	return vec, nil
+}
+
+// castSets casts the columns of the set to the types of the probes.
+func castSets(op int, ps, ss []*vector.Vector, proc *process.Process) ([]*vector.Vector, error) {
+	rs := make([]*vector.Vector, len(ss))
+	for i, s := range ss {
+		rs[i] = s
+		if sameFamily(ps[i].Typ.Oid, s.Typ.Oid) && ps[i].Typ.Precision == s.Typ.Precision {
+			continue
+		}
+		v, err := BinaryEval(Typecast, s.Typ.Oid, ps[i].Typ.Oid, false, false, s, vector.New(ps[i].Typ), proc)
+		if err != nil {
+			freeSets(rs[:i], ss, proc)
+			return nil, fmt.Errorf("'%s' not yet implemented for %s, %s", OpName[op], ps[i].Typ, s.Typ)
+		}
+		rs[i] = v
+	}
+	return rs, nil
+}
+
+// freeSets frees the columns of the set which are cast.
+func freeSets(rs, ss []*vector.Vector, proc *process.Process) {
+	for i, r := range rs {
+		if r != ss[i] {
+			register.Put(proc, r)
+		}
+	}
+}
+
+// setRows indexes the rows of the set by their keys, the keys of each
+// column of the rows containing null are returned separately. It also
+// returns true if a key occurs more than once.
+func setRows(vs []*vector.Vector) (map[string]int64, [][][]byte, bool) {
+	var dup bool
+	var key []byte
+	var nulls [][][]byte
+
+	mp := make(map[string]int64)
+	cs := make([]bool, len(vs))
+	for i, n := 0, vs[0].Length(); i < n; i++ {
+		var ok bool
+
+		keys := make([][]byte, len(vs))
+		if key, ok = rowKey(key[:0], keys, vs, cs, int64(i)); !ok {
+			for j := range keys {
+				if keys[j] != nil {
+					keys[j] = append([]byte{}, keys[j]...)
+				}
+			}
+			nulls = append(nulls, keys)
+			continue
+		}
+		if _, ok := mp[string(key)]; ok {
+			dup = true
+			continue
+		}
+		mp[string(key)] = int64(i)
+	}
+	return mp, nulls, dup
+}
+
+// mayContain returns true if a row of the set containing null may be equal
+// to the row, the columns which are null compare equal to anything.
+func mayContain(nulls [][][]byte, keys [][]byte) bool {
+	for _, row := range nulls {
+		ok := true
+		for i, key := range row {
+			if key != nil && string(key) != string(keys[i]) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// rowKey encodes a row of the vectors into a key, the encoding of each
+// column is stored into keys, which is nil for a null column. It returns
+// false if any column of the row is null.
+func rowKey(key []byte, keys [][]byte, vs []*vector.Vector, cs []bool, row int64) ([]byte, bool) {
+	ok := true
+	for i, v := range vs {
+		r := row
+		if cs[i] {
+			r = 0
+		}
+		if v.Nsp.Contains(uint64(r)) {
+			keys[i] = nil
+			ok = false
+			continue
+		}
+		start := len(key)
+		key = appendValue(key, v, r)
+		keys[i] = key[start:len(key):len(key)]
+	}
+	return key, ok
+}
+
+func appendValue(key []byte, v *vector.Vector, row int64) []byte {
+	switch vs := v.Col.(type) {
+	case []int8:
+		return append(key, encoding.EncodeInt8(vs[row])...)
+	case []int16:
+		return append(key, encoding.EncodeInt16(vs[row])...)
+	case []int32:
+		return append(key, encoding.EncodeInt32(vs[row])...)
+	case []int64:
+		return append(key, encoding.EncodeInt64(vs[row])...)
+	case []uint8:
+		return append(key, encoding.EncodeUint8(vs[row])...)
+	case []uint16:
+		return append(key, encoding.EncodeUint16(vs[row])...)
+	case []uint32:
+		return append(key, encoding.EncodeUint32(vs[row])...)
+	case []uint64:
+		return append(key, encoding.EncodeUint64(vs[row])...)
+	case []float32:
+		return append(key, encoding.EncodeFloat32(vs[row])...)
+	case []float64:
+		return append(key, encoding.EncodeFloat64(vs[row])...)
+	case []types.Decimal64:
+		return append(key, encoding.EncodeDecimal64(vs[row])...)
+	case []types.Decimal128:
+		return append(key, encoding.EncodeDecimal128(vs[row])...)
+	case []types.Date:
+		return append(key, encoding.EncodeDate(vs[row])...)
+	case []types.Datetime:
+		return append(key, encoding.EncodeDatetime(vs[row])...)
+	case *types.Bytes:
+		data := vs.Get(row)
+		key = append(key, encoding.EncodeUint32(uint32(len(data)))...)
+		return append(key, data...)
+	}
+	return key
+}
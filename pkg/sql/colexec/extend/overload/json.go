@@ -0,0 +1,385 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
+)
+
+// jsonTypes are the types accepted as a json document, a string is parsed
+// as the text of a document.
+var jsonTypes = []types.T{types.T_json, types.T_char, types.T_varchar}
+
+func init() {
+	for _, typ := range jsonTypes {
+		MultiOps[JsonExtract] = append(MultiOps[JsonExtract], &MultiOp{
+			Min:        2,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_json,
+			Fn:         jsonExtractFn,
+		})
+		MultiOps[JsonUnquote] = append(MultiOps[JsonUnquote], &MultiOp{
+			Min:        1,
+			Max:        1,
+			Typ:        typ,
+			ReturnType: types.T_varchar,
+			Fn:         jsonUnquoteFn,
+		})
+		MultiOps[JsonContains] = append(MultiOps[JsonContains], &MultiOp{
+			Min:        2,
+			Max:        3,
+			Typ:        typ,
+			ReturnType: types.T_int64,
+			Fn:         jsonContainsFn,
+		})
+		MultiOps[JsonLength] = append(MultiOps[JsonLength], &MultiOp{
+			Min:        1,
+			Max:        2,
+			Typ:        typ,
+			ReturnType: types.T_int64,
+			Fn:         jsonLengthFn,
+		})
+		MultiOps[JsonKeys] = append(MultiOps[JsonKeys], &MultiOp{
+			Min:        1,
+			Max:        2,
+			Typ:        typ,
+			ReturnType: types.T_json,
+			Fn:         jsonKeysFn,
+		})
+		MultiOps[JsonValid] = append(MultiOps[JsonValid], &MultiOp{
+			Min:        1,
+			Max:        1,
+			Typ:        typ,
+			ReturnType: types.T_int64,
+			Fn:         jsonValidFn,
+		})
+	}
+	BinOps[Typecast] = append(BinOps[Typecast], newBinOp(types.T_json, types.T_json, types.T_json, func(lv, _ *vector.Vector, _ *process.Process, _, _ bool) (*vector.Vector, error) {
+		return lv, nil
+	}))
+	for _, s := range decimalCharTypes {
+		BinOps[Typecast] = append(BinOps[Typecast], newBinOp(s, types.T_json, types.T_json, castCharToJson))
+		BinOps[Typecast] = append(BinOps[Typecast], newBinOp(types.T_json, s, s, castJsonToChar))
+	}
+}
+
+// jsonArgs holds the arguments of a json function, the rows of a constant
+// argument are all its first value.
+type jsonArgs struct {
+	op    int
+	cs    []bool
+	vs    []*vector.Vector
+	paths map[string]*bytejson.Path
+}
+
+func newJsonArgs(op int, vs []*vector.Vector, cs []bool) (*jsonArgs, error) {
+	for i, v := range vs {
+		if cs[i] && v.Nsp.Contains(0) {
+			continue
+		}
+		switch v.Typ.Oid {
+		case types.T_json, types.T_char, types.T_varchar:
+		default:
+			return nil, fmt.Errorf("'%s' not yet implemented for %s", OpName[op], v.Typ)
+		}
+	}
+	return &jsonArgs{op: op, cs: cs, vs: vs, paths: make(map[string]*bytejson.Path)}, nil
+}
+
+// bytes returns the row i of the argument j, it returns false for null.
+func (a *jsonArgs) bytes(j, i int) ([]byte, bool) {
+	if a.cs[j] {
+		i = 0
+	}
+	if a.vs[j].Nsp.Contains(uint64(i)) {
+		return nil, false
+	}
+	return a.vs[j].Col.(*types.Bytes).Get(int64(i)), true
+}
+
+// document returns the row i of the argument j as a json document.
+func (a *jsonArgs) document(j, i int) (bytejson.ByteJson, bool, error) {
+	data, ok := a.bytes(j, i)
+	if !ok {
+		return nil, false, nil
+	}
+	if a.vs[j].Typ.Oid == types.T_json {
+		return bytejson.ByteJson(data), true, nil
+	}
+	bj, err := bytejson.ParseFromBytes(data)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid JSON text in argument %v to function %s: '%s'", j+1, OpName[a.op], data)
+	}
+	return bj, true, nil
+}
+
+// path returns the row i of the argument j as a json path.
+func (a *jsonArgs) path(j, i int) (*bytejson.Path, bool, error) {
+	data, ok := a.bytes(j, i)
+	if !ok {
+		return nil, false, nil
+	}
+	if p, ok := a.paths[string(data)]; ok {
+		return p, true, nil
+	}
+	p, err := bytejson.ParsePath(string(data))
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid JSON path expression in argument %v to function %s: '%s'", j+1, OpName[a.op], data)
+	}
+	a.paths[string(data)] = p
+	return p, true, nil
+}
+
+// locate returns the value of the row i of the document argument located by
+// the optional path argument, it returns false for null.
+func (a *jsonArgs) locate(i int) (bytejson.ByteJson, bool, error) {
+	bj, ok, err := a.document(0, i)
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	if len(a.vs) == 1 {
+		return bj, true, nil
+	}
+	p, ok, err := a.path(1, i)
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	bj, ok, err = bj.Locate(p)
+	if err != nil {
+		return nil, false, fmt.Errorf("'%s': %v", OpName[a.op], err)
+	}
+	return bj, ok, nil
+}
+
+// newJsonBytes returns an empty column of n strings or documents.
+func newJsonBytes(n int) *types.Bytes {
+	return &types.Bytes{
+		Data:    make([]byte, 0, n),
+		Offsets: make([]uint32, 0, n),
+		Lengths: make([]uint32, 0, n),
+	}
+}
+
+func appendJsonBytes(col *types.Bytes, data []byte) {
+	col.Offsets = append(col.Offsets, uint32(len(col.Data)))
+	col.Lengths = append(col.Lengths, uint32(len(data)))
+	col.Data = append(col.Data, data...)
+}
+
+// jsonDocumentFn makes the function of a json function which returns a json
+// document or a string for each row, fn returns false for null.
+func jsonDocumentFn(op int, typ types.Type, fn func(*jsonArgs, int) ([]byte, bool, error)) func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		args, err := newJsonArgs(op, vs, cs)
+		if err != nil {
+			return nil, err
+		}
+		n := multiLength(vs, cs)
+		col := newJsonBytes(n)
+		var nulls []int
+		for i := 0; i < n; i++ {
+			data, ok, err := fn(args, i)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				nulls = append(nulls, i)
+			}
+			appendJsonBytes(col, data)
+		}
+		vec, err := newBytesVector(col, typ, proc)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range nulls {
+			vec.Nsp.Add(uint64(i))
+		}
+		multiFree(vs, cs, proc)
+		return vec, nil
+	}
+}
+
+// jsonIntFn makes the function of a json function which returns an integer
+// for each row, fn returns false for null.
+func jsonIntFn(op int, fn func(*jsonArgs, int) (int64, bool, error)) func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		args, err := newJsonArgs(op, vs, cs)
+		if err != nil {
+			return nil, err
+		}
+		n := multiLength(vs, cs)
+		vec, err := register.Get(proc, int64(n)*8, types.Type{Oid: types.T_int64, Size: 8})
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeInt64Slice(vec.Data)
+		rs = rs[:n]
+		for i := range rs {
+			r, ok, err := fn(args, i)
+			if err != nil {
+				register.Put(proc, vec)
+				return nil, err
+			}
+			if !ok {
+				vec.Nsp.Add(uint64(i))
+			}
+			rs[i] = r
+		}
+		vec.SetCol(rs)
+		multiFree(vs, cs, proc)
+		return vec, nil
+	}
+}
+
+var jsonExtractFn = jsonDocumentFn(JsonExtract, types.Type{Oid: types.T_json, Size: 24}, func(a *jsonArgs, i int) ([]byte, bool, error) {
+	bj, ok, err := a.document(0, i)
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	ps := make([]*bytejson.Path, len(a.vs)-1)
+	for j := range ps {
+		if ps[j], ok, err = a.path(j+1, i); !ok || err != nil {
+			return nil, false, err
+		}
+	}
+	return bj.Extract(ps)
+})
+
+var jsonUnquoteFn = jsonDocumentFn(JsonUnquote, types.Type{Oid: types.T_varchar, Size: 24}, func(a *jsonArgs, i int) ([]byte, bool, error) {
+	data, ok := a.bytes(0, i)
+	if !ok {
+		return nil, false, nil
+	}
+	if a.vs[0].Typ.Oid == types.T_json {
+		return []byte(bytejson.ByteJson(data).Unquote()), true, nil
+	}
+	s, err := bytejson.UnquoteString(string(data))
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid JSON text in argument 1 to function %s: '%s'", OpName[JsonUnquote], data)
+	}
+	return []byte(s), true, nil
+})
+
+var jsonKeysFn = jsonDocumentFn(JsonKeys, types.Type{Oid: types.T_json, Size: 24}, func(a *jsonArgs, i int) ([]byte, bool, error) {
+	bj, ok, err := a.locate(i)
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	return bj.Keys()
+})
+
+var jsonContainsFn = jsonIntFn(JsonContains, func(a *jsonArgs, i int) (int64, bool, error) {
+	candidate, ok, err := a.document(1, i)
+	if !ok || err != nil {
+		return 0, false, err
+	}
+	bj, ok, err := a.document(0, i)
+	if !ok || err != nil {
+		return 0, false, err
+	}
+	if len(a.vs) == 3 {
+		p, ok, err := a.path(2, i)
+		if !ok || err != nil {
+			return 0, false, err
+		}
+		if bj, ok, err = bj.Locate(p); err != nil {
+			return 0, false, fmt.Errorf("'%s': %v", OpName[JsonContains], err)
+		} else if !ok {
+			return 0, false, nil
+		}
+	}
+	if bj.Contains(candidate) {
+		return 1, true, nil
+	}
+	return 0, true, nil
+})
+
+var jsonLengthFn = jsonIntFn(JsonLength, func(a *jsonArgs, i int) (int64, bool, error) {
+	bj, ok, err := a.locate(i)
+	if !ok || err != nil {
+		return 0, false, err
+	}
+	return bj.Length(), true, nil
+})
+
+var jsonValidFn = jsonIntFn(JsonValid, func(a *jsonArgs, i int) (int64, bool, error) {
+	data, ok := a.bytes(0, i)
+	if !ok {
+		return 0, false, nil
+	}
+	if a.vs[0].Typ.Oid == types.T_json || bytejson.Valid(data) {
+		return 1, true, nil
+	}
+	return 0, true, nil
+})
+
+// castCharToJson parses strings as json documents.
+func castCharToJson(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			register.Put(proc, lv)
+		}
+	}()
+	vs := lv.Col.(*types.Bytes)
+	col := newJsonBytes(len(vs.Data))
+	for i := range vs.Offsets {
+		if lv.Nsp.Contains(uint64(i)) {
+			appendJsonBytes(col, nil)
+			continue
+		}
+		bj, err := bytejson.ParseFromBytes(vs.Get(int64(i)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON text in argument 1 to function cast_as_json: '%s'", vs.Get(int64(i)))
+		}
+		appendJsonBytes(col, bj)
+	}
+	vec, err := newBytesVector(col, rv.Typ, proc)
+	if err != nil {
+		return nil, err
+	}
+	vec.Nsp.Set(lv.Nsp)
+	return vec, nil
+}
+
+// castJsonToChar formats json documents as their texts.
+func castJsonToChar(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			register.Put(proc, lv)
+		}
+	}()
+	vs := lv.Col.(*types.Bytes)
+	col := newJsonBytes(len(vs.Data))
+	for i := range vs.Offsets {
+		if lv.Nsp.Contains(uint64(i)) {
+			appendJsonBytes(col, nil)
+			continue
+		}
+		appendJsonBytes(col, []byte(bytejson.ByteJson(vs.Get(int64(i))).String()))
+	}
+	vec, err := newBytesVector(col, rv.Typ, proc)
+	if err != nil {
+		return nil, err
+	}
+	vec.Nsp.Set(lv.Nsp)
+	return vec, nil
+}
@@ -0,0 +1,1076 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"errors"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/abs"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ceil"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/floor"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/round"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
+)
+
+var (
+	errRoundDigits = errors.New("the number of decimal places of round must be a constant")
+)
+
+func init() {
+	MultiOps[Abs] = []*MultiOp{
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int8,
+			ReturnType: types.T_int8,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int8)
+				if v.Ref == 0 && !cs[0] {
+					abs.Int8Abs(xs, xs)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*1, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt8Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(abs.Int8Abs(xs, rs))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int16,
+			ReturnType: types.T_int16,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int16)
+				if v.Ref == 0 && !cs[0] {
+					abs.Int16Abs(xs, xs)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*2, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt16Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(abs.Int16Abs(xs, rs))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int32,
+			ReturnType: types.T_int32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int32)
+				if v.Ref == 0 && !cs[0] {
+					abs.Int32Abs(xs, xs)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(abs.Int32Abs(xs, rs))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int64,
+			ReturnType: types.T_int64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int64)
+				if v.Ref == 0 && !cs[0] {
+					abs.Int64Abs(xs, xs)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(abs.Int64Abs(xs, rs))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_float32,
+			ReturnType: types.T_float32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]float32)
+				if v.Ref == 0 && !cs[0] {
+					abs.Float32Abs(xs, xs)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeFloat32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(abs.Float32Abs(xs, rs))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_float64,
+			ReturnType: types.T_float64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]float64)
+				if v.Ref == 0 && !cs[0] {
+					abs.Float64Abs(xs, xs)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeFloat64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(abs.Float64Abs(xs, rs))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint8,
+			ReturnType: types.T_uint8,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint8)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*1, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint8Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint16,
+			ReturnType: types.T_uint16,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint16)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*2, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint16Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint32,
+			ReturnType: types.T_uint32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint32)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint64,
+			ReturnType: types.T_uint64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint64)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+	}
+
+	MultiOps[Floor] = []*MultiOp{
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_float32,
+			ReturnType: types.T_float32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]float32)
+				if v.Ref == 0 && !cs[0] {
+					floor.Float32Floor(xs, xs)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeFloat32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(floor.Float32Floor(xs, rs))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_float64,
+			ReturnType: types.T_float64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]float64)
+				if v.Ref == 0 && !cs[0] {
+					floor.Float64Floor(xs, xs)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeFloat64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(floor.Float64Floor(xs, rs))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int8,
+			ReturnType: types.T_int8,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int8)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*1, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt8Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int16,
+			ReturnType: types.T_int16,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int16)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*2, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt16Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int32,
+			ReturnType: types.T_int32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int32)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int64,
+			ReturnType: types.T_int64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int64)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint8,
+			ReturnType: types.T_uint8,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint8)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*1, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint8Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint16,
+			ReturnType: types.T_uint16,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint16)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*2, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint16Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint32,
+			ReturnType: types.T_uint32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint32)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint64,
+			ReturnType: types.T_uint64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint64)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+	}
+
+	MultiOps[Ceil] = []*MultiOp{
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_float32,
+			ReturnType: types.T_float32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]float32)
+				if v.Ref == 0 && !cs[0] {
+					ceil.Float32Ceil(xs, xs)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeFloat32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(ceil.Float32Ceil(xs, rs))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_float64,
+			ReturnType: types.T_float64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]float64)
+				if v.Ref == 0 && !cs[0] {
+					ceil.Float64Ceil(xs, xs)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeFloat64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(ceil.Float64Ceil(xs, rs))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int8,
+			ReturnType: types.T_int8,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int8)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*1, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt8Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int16,
+			ReturnType: types.T_int16,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int16)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*2, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt16Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int32,
+			ReturnType: types.T_int32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int32)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_int64,
+			ReturnType: types.T_int64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]int64)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint8,
+			ReturnType: types.T_uint8,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint8)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*1, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint8Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint16,
+			ReturnType: types.T_uint16,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint16)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*2, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint16Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint32,
+			ReturnType: types.T_uint32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint32)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        1,
+			Typ:        types.T_uint64,
+			ReturnType: types.T_uint64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				v := vs[0]
+				xs := v.Col.([]uint64)
+				if v.Ref == 0 && !cs[0] {
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				copy(rs, xs)
+				vec.SetCol(rs)
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+	}
+
+	MultiOps[Round] = []*MultiOp{
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_int8,
+			ReturnType: types.T_int8,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				if len(vs) > 1 {
+					if !cs[1] {
+						return nil, errRoundDigits
+					}
+					ds, err := int64Values(vs[1])
+					if err != nil {
+						return nil, err
+					}
+					digits = ds[0]
+				}
+				v := vs[0]
+				xs := v.Col.([]int8)
+				if v.Ref == 0 && !cs[0] {
+					round.Int8Round(xs, xs, digits)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*1, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt8Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(round.Int8Round(xs, rs, digits))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_int16,
+			ReturnType: types.T_int16,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				if len(vs) > 1 {
+					if !cs[1] {
+						return nil, errRoundDigits
+					}
+					ds, err := int64Values(vs[1])
+					if err != nil {
+						return nil, err
+					}
+					digits = ds[0]
+				}
+				v := vs[0]
+				xs := v.Col.([]int16)
+				if v.Ref == 0 && !cs[0] {
+					round.Int16Round(xs, xs, digits)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*2, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt16Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(round.Int16Round(xs, rs, digits))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_int32,
+			ReturnType: types.T_int32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				if len(vs) > 1 {
+					if !cs[1] {
+						return nil, errRoundDigits
+					}
+					ds, err := int64Values(vs[1])
+					if err != nil {
+						return nil, err
+					}
+					digits = ds[0]
+				}
+				v := vs[0]
+				xs := v.Col.([]int32)
+				if v.Ref == 0 && !cs[0] {
+					round.Int32Round(xs, xs, digits)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(round.Int32Round(xs, rs, digits))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_int64,
+			ReturnType: types.T_int64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				if len(vs) > 1 {
+					if !cs[1] {
+						return nil, errRoundDigits
+					}
+					ds, err := int64Values(vs[1])
+					if err != nil {
+						return nil, err
+					}
+					digits = ds[0]
+				}
+				v := vs[0]
+				xs := v.Col.([]int64)
+				if v.Ref == 0 && !cs[0] {
+					round.Int64Round(xs, xs, digits)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(round.Int64Round(xs, rs, digits))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_uint8,
+			ReturnType: types.T_uint8,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				if len(vs) > 1 {
+					if !cs[1] {
+						return nil, errRoundDigits
+					}
+					ds, err := int64Values(vs[1])
+					if err != nil {
+						return nil, err
+					}
+					digits = ds[0]
+				}
+				v := vs[0]
+				xs := v.Col.([]uint8)
+				if v.Ref == 0 && !cs[0] {
+					round.Uint8Round(xs, xs, digits)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*1, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint8Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(round.Uint8Round(xs, rs, digits))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_uint16,
+			ReturnType: types.T_uint16,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				if len(vs) > 1 {
+					if !cs[1] {
+						return nil, errRoundDigits
+					}
+					ds, err := int64Values(vs[1])
+					if err != nil {
+						return nil, err
+					}
+					digits = ds[0]
+				}
+				v := vs[0]
+				xs := v.Col.([]uint16)
+				if v.Ref == 0 && !cs[0] {
+					round.Uint16Round(xs, xs, digits)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*2, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint16Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(round.Uint16Round(xs, rs, digits))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_uint32,
+			ReturnType: types.T_uint32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				if len(vs) > 1 {
+					if !cs[1] {
+						return nil, errRoundDigits
+					}
+					ds, err := int64Values(vs[1])
+					if err != nil {
+						return nil, err
+					}
+					digits = ds[0]
+				}
+				v := vs[0]
+				xs := v.Col.([]uint32)
+				if v.Ref == 0 && !cs[0] {
+					round.Uint32Round(xs, xs, digits)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(round.Uint32Round(xs, rs, digits))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_uint64,
+			ReturnType: types.T_uint64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				if len(vs) > 1 {
+					if !cs[1] {
+						return nil, errRoundDigits
+					}
+					ds, err := int64Values(vs[1])
+					if err != nil {
+						return nil, err
+					}
+					digits = ds[0]
+				}
+				v := vs[0]
+				xs := v.Col.([]uint64)
+				if v.Ref == 0 && !cs[0] {
+					round.Uint64Round(xs, xs, digits)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeUint64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(round.Uint64Round(xs, rs, digits))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_float32,
+			ReturnType: types.T_float32,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				if len(vs) > 1 {
+					if !cs[1] {
+						return nil, errRoundDigits
+					}
+					ds, err := int64Values(vs[1])
+					if err != nil {
+						return nil, err
+					}
+					digits = ds[0]
+				}
+				v := vs[0]
+				xs := v.Col.([]float32)
+				if v.Ref == 0 && !cs[0] {
+					round.Float32Round(xs, xs, digits)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*4, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeFloat32Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(round.Float32Round(xs, rs, digits))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_float64,
+			ReturnType: types.T_float64,
+			Fn: func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				if len(vs) > 1 {
+					if !cs[1] {
+						return nil, errRoundDigits
+					}
+					ds, err := int64Values(vs[1])
+					if err != nil {
+						return nil, err
+					}
+					digits = ds[0]
+				}
+				v := vs[0]
+				xs := v.Col.([]float64)
+				if v.Ref == 0 && !cs[0] {
+					round.Float64Round(xs, xs, digits)
+					return v, nil
+				}
+				vec, err := register.Get(proc, int64(len(xs))*8, v.Typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeFloat64Slice(vec.Data)
+				rs = rs[:len(xs)]
+				vec.SetCol(round.Float64Round(xs, rs, digits))
+				vec.Nsp.Set(v.Nsp)
+				return vec, nil
+			},
+		},
+	}
+}
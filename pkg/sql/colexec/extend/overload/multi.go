@@ -19,10 +19,145 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
 )
 
-func MultiEval(op int, typ types.T, _ []bool, _ []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
-	return nil, fmt.Errorf("%s not yet implemented for %s", OpName[op], typ)
+// MultiOps contains the multiple operations indexed by operation type,
+// an operation is chosen by the type of its first argument.
+var MultiOps = map[int][]*MultiOp{}
+
+func MultiEval(op int, typ types.T, cs []bool, vs []*vector.Vector, p *process.Process) (*vector.Vector, error) {
+	if os, ok := MultiOps[op]; ok {
+		for _, o := range os {
+			if multiCheck(op, o.Typ, typ) && multiArgsCheck(o, len(vs)) {
+				return o.Fn(vs, p, cs)
+			}
+		}
+	}
+	return nil, fmt.Errorf("'%s' not yet implemented for %s", OpName[op], typ)
 }
 
-var MultiOps = map[int][]*MultiOp{}
+func multiCheck(_ int, arg types.T, val types.T) bool {
+	return arg == val
+}
+
+func multiArgsCheck(o *MultiOp, n int) bool {
+	return n >= o.Min && (o.Max < 0 || n <= o.Max)
+}
+
+// GetMultiOpReturnType returns the returnType of multi op and the type of its first argument.
+func GetMultiOpReturnType(op int, arg types.T) types.T {
+	for _, o := range MultiOps[op] {
+		if o.Typ == arg {
+			return o.ReturnType
+		}
+	}
+	return arg
+}
+
+// MultiArgs returns the minimum and maximum number of arguments accepted by a multi op.
+func MultiArgs(op int) (int, int, bool) {
+	os, ok := MultiOps[op]
+	if !ok || len(os) == 0 {
+		return 0, 0, false
+	}
+	return os[0].Min, os[0].Max, true
+}
+
+// multiLength returns the number of rows of the result,
+// it is one if all the arguments are constants.
+func multiLength(vs []*vector.Vector, cs []bool) int {
+	for i, v := range vs {
+		if !cs[i] {
+			return v.Length()
+		}
+	}
+	return 1
+}
+
+// multiNulls marks a row of vec as null if the row of any argument is null.
+func multiNulls(vec *vector.Vector, vs []*vector.Vector, cs []bool) {
+	for i, v := range vs {
+		if cs[i] {
+			if v.Nsp.Contains(0) {
+				for j, n := 0, vec.Length(); j < n; j++ {
+					vec.Nsp.Add(uint64(j))
+				}
+			}
+			continue
+		}
+		vec.Nsp.Set(v.Nsp)
+	}
+}
+
+// multiFree returns the temporary arguments to the register pool.
+func multiFree(vs []*vector.Vector, cs []bool, p *process.Process) {
+	for i, v := range vs {
+		if !cs[i] && v.Ref == 0 {
+			register.Put(p, v)
+		}
+	}
+}
+
+// int64Values returns the values of an integer argument as int64,
+// a constant argument is returned as a slice of length 1.
+func int64Values(v *vector.Vector) ([]int64, error) {
+	switch vs := v.Col.(type) {
+	case []int64:
+		return vs, nil
+	case []int32:
+		rs := make([]int64, len(vs))
+		for i, x := range vs {
+			rs[i] = int64(x)
+		}
+		return rs, nil
+	case []int16:
+		rs := make([]int64, len(vs))
+		for i, x := range vs {
+			rs[i] = int64(x)
+		}
+		return rs, nil
+	case []int8:
+		rs := make([]int64, len(vs))
+		for i, x := range vs {
+			rs[i] = int64(x)
+		}
+		return rs, nil
+	case []uint64:
+		rs := make([]int64, len(vs))
+		for i, x := range vs {
+			rs[i] = int64(x)
+		}
+		return rs, nil
+	case []uint32:
+		rs := make([]int64, len(vs))
+		for i, x := range vs {
+			rs[i] = int64(x)
+		}
+		return rs, nil
+	case []uint16:
+		rs := make([]int64, len(vs))
+		for i, x := range vs {
+			rs[i] = int64(x)
+		}
+		return rs, nil
+	case []uint8:
+		rs := make([]int64, len(vs))
+		for i, x := range vs {
+			rs[i] = int64(x)
+		}
+		return rs, nil
+	}
+	return nil, fmt.Errorf("'%s' is not an integer", v.Typ)
+}
+
+// newBytesVector wraps the strings built by a function into a vector.
+func newBytesVector(col *types.Bytes, typ types.Type, p *process.Process) (*vector.Vector, error) {
+	if err := p.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(typ)
+	vec.Data = col.Data
+	vec.SetCol(col)
+	return vec, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
+)
+
+func init() {
+	typs := []types.T{
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64,
+		types.T_char, types.T_varchar,
+		types.T_decimal64, types.T_decimal128, types.T_date, types.T_datetime,
+	}
+	for _, typ := range typs {
+		MultiOps[Coalesce] = append(MultiOps[Coalesce], &MultiOp{
+			Min:        1,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: typ,
+			Fn:         coalesceFn(Coalesce),
+		})
+		MultiOps[IfNull] = append(MultiOps[IfNull], &MultiOp{
+			Min:        2,
+			Max:        2,
+			Typ:        typ,
+			ReturnType: typ,
+			Fn:         coalesceFn(IfNull),
+		})
+	}
+	for _, typ := range typs {
+		MultiOps[IsNull] = append(MultiOps[IsNull], &MultiOp{
+			Min:        1,
+			Max:        1,
+			Typ:        typ,
+			ReturnType: types.T_sel,
+			Fn:         isNullFn(IsNull),
+		})
+		MultiOps[IsNotNull] = append(MultiOps[IsNotNull], &MultiOp{
+			Min:        1,
+			Max:        1,
+			Typ:        typ,
+			ReturnType: types.T_sel,
+			Fn:         isNullFn(IsNotNull),
+		})
+	}
+}
+
+// isNullFn makes the function of isNull and isNotNull, which return the rows
+// whose bit in the null bitmap of the argument is set (unset). Unlike the
+// comparisons, their result is never unknown.
+func isNullFn(op int) func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		v := vs[0]
+		n := multiLength(vs, cs)
+		vec, err := register.Get(proc, int64(n)*int64(SelsType.Size), SelsType)
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeInt64Slice(vec.Data)[:0]
+		switch {
+		case op == IsNull && v.Nsp.Any():
+			for _, row := range v.Nsp.Np.ToArray() {
+				if row < uint64(n) {
+					rs = append(rs, int64(row))
+				}
+			}
+		case op == IsNotNull:
+			for i := 0; i < n; i++ {
+				if !v.Nsp.Contains(uint64(i)) {
+					rs = append(rs, int64(i))
+				}
+			}
+		}
+		vec.SetCol(rs)
+		multiFree(vs, cs, proc)
+		return vec, nil
+	}
+}
+
+// coalesceFn makes the function of coalesce and ifnull,
+// which return the first non-null argument of each row.
+func coalesceFn(op int) func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		typ := vs[0].Typ
+		for _, v := range vs[1:] {
+			if !sameFamily(typ.Oid, v.Typ.Oid) {
+				return nil, fmt.Errorf("'%s' not yet implemented for %s, %s", OpName[op], typ, v.Typ)
+			}
+		}
+		if types.IsDecimal(typ.Oid) {
+			isValue := func(_ int) bool { return true }
+			tmps, err := alignScale(typ, cs, vs, isValue, proc)
+			if err != nil {
+				multiFree(vs, cs, proc)
+				return nil, err
+			}
+			defer putVectors(tmps, proc)
+			typ = vs[0].Typ
+		}
+		if !cs[0] && vs[0].Ref == 0 && !vs[0].Nsp.Any() {
+			multiFree(vs[1:], cs[1:], proc)
+			return vs[0], nil
+		}
+		n := multiLength(vs, cs)
+		vec := vector.New(typ)
+		for i := 0; i < n; i++ {
+			var ok bool
+
+			for j, v := range vs {
+				row := int64(i)
+				if cs[j] {
+					row = 0
+				}
+				if v.Nsp.Contains(uint64(row)) {
+					continue
+				}
+				if err := vec.UnionOne(v, row, proc); err != nil {
+					vec.Clean(proc)
+					return nil, err
+				}
+				ok = true
+				break
+			}
+			if !ok {
+				if err := vec.UnionNull(proc); err != nil {
+					vec.Clean(proc)
+					return nil, err
+				}
+			}
+		}
+		vec.Ref = 0
+		multiFree(vs, cs, proc)
+		return vec, nil
+	}
+}
+
+func sameFamily(a, b types.T) bool {
+	if a == b {
+		return true
+	}
+	return (a == types.T_char || a == types.T_varchar) && (b == types.T_char || b == types.T_varchar)
+}
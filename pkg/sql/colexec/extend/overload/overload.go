@@ -16,28 +16,40 @@
 package overload
 
 var LogicalOps = map[int]uint8{
-	Or:      0,
-	And:     0,
-	Like:    0,
-	NotLike: 0,
-	EQ:      0,
-	LT:      0,
-	LE:      0,
-	GT:      0,
-	GE:      0,
-	NE:      0,
+	Or:        0,
+	And:       0,
+	Like:      0,
+	NotLike:   0,
+	EQ:        0,
+	LT:        0,
+	LE:        0,
+	GT:        0,
+	GE:        0,
+	NE:        0,
+	IsNull:    0,
+	IsNotNull: 0,
+	In:        0,
+	NotIn:     0,
+	Exists:    0,
+	NotExists: 0,
 }
 
 var NegOps = map[int]int{
-	Or:   And,
-	And:  Or,
-	EQ:   NE,
-	LT:   GE,
-	LE:   GT,
-	GT:   LE,
-	GE:   LT,
-	NE:	  EQ,
-	Like: NotLike,
+	Or:        And,
+	And:       Or,
+	EQ:        NE,
+	LT:        GE,
+	LE:        GT,
+	GT:        LE,
+	GE:        LT,
+	NE:        EQ,
+	Like:      NotLike,
+	IsNull:    IsNotNull,
+	IsNotNull: IsNull,
+	In:        NotIn,
+	NotIn:     In,
+	Exists:    NotExists,
+	NotExists: Exists,
 }
 
 var OpTypes = map[int]int{
@@ -59,6 +71,35 @@ var OpTypes = map[int]int{
 	GT:         Binary,
 	GE:         Binary,
 	NE:         Binary,
+	Substring:  Multi,
+	Concat:     Multi,
+	Upper:      Multi,
+	Lower:      Multi,
+	Trim:       Multi,
+	LTrim:      Multi,
+	RTrim:      Multi,
+	Length:     Multi,
+	Abs:        Multi,
+	Round:      Multi,
+	Floor:      Multi,
+	Ceil:       Multi,
+	Coalesce:   Multi,
+	IfNull:     Multi,
+	DateAdd:    Multi,
+	DateSub:    Multi,
+	Year:       Multi,
+	Month:      Multi,
+	Day:        Multi,
+	Now:        Multi,
+	IsNull:     Multi,
+	IsNotNull:  Multi,
+	Case:       Multi,
+	ConcatItem: Multi,
+	In:         Multi,
+	NotIn:      Multi,
+	Exists:     Multi,
+	NotExists:  Multi,
+	Lookup:     Multi,
 }
 
 func IsLogical(op int) bool {
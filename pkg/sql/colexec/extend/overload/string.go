@@ -0,0 +1,183 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/concat"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/length"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/lower"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/substring"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/trim"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/upper"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/register"
+)
+
+func init() {
+	for _, typ := range []types.T{types.T_char, types.T_varchar} {
+		MultiOps[Substring] = append(MultiOps[Substring], &MultiOp{
+			Min:        2,
+			Max:        3,
+			Typ:        typ,
+			ReturnType: types.T_varchar,
+			Fn:         substringFn,
+		})
+		MultiOps[Concat] = append(MultiOps[Concat], &MultiOp{
+			Min:        1,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_varchar,
+			Fn:         concatFn,
+		})
+		MultiOps[Upper] = append(MultiOps[Upper], &MultiOp{
+			Min:        1,
+			Max:        1,
+			Typ:        typ,
+			ReturnType: typ,
+			Fn:         stringFn(upper.Upper),
+		})
+		MultiOps[Lower] = append(MultiOps[Lower], &MultiOp{
+			Min:        1,
+			Max:        1,
+			Typ:        typ,
+			ReturnType: typ,
+			Fn:         stringFn(lower.Lower),
+		})
+		MultiOps[Trim] = append(MultiOps[Trim], &MultiOp{
+			Min:        1,
+			Max:        1,
+			Typ:        typ,
+			ReturnType: typ,
+			Fn:         stringFn(trim.Trim),
+		})
+		MultiOps[LTrim] = append(MultiOps[LTrim], &MultiOp{
+			Min:        1,
+			Max:        1,
+			Typ:        typ,
+			ReturnType: typ,
+			Fn:         stringFn(trim.LTrim),
+		})
+		MultiOps[RTrim] = append(MultiOps[RTrim], &MultiOp{
+			Min:        1,
+			Max:        1,
+			Typ:        typ,
+			ReturnType: typ,
+			Fn:         stringFn(trim.RTrim),
+		})
+		MultiOps[Length] = append(MultiOps[Length], &MultiOp{
+			Min:        1,
+			Max:        1,
+			Typ:        typ,
+			ReturnType: types.T_int64,
+			Fn:         lengthFn,
+		})
+	}
+}
+
+// stringFn makes the function of a one-argument string operator.
+func stringFn(fn func(*types.Bytes, *types.Bytes) *types.Bytes) func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		xs := vs[0].Col.(*types.Bytes)
+		col := &types.Bytes{
+			Data:    make([]byte, 0, len(xs.Data)),
+			Offsets: make([]uint32, 0, len(xs.Offsets)),
+			Lengths: make([]uint32, 0, len(xs.Lengths)),
+		}
+		vec, err := newBytesVector(fn(xs, col), vs[0].Typ, proc)
+		if err != nil {
+			return nil, err
+		}
+		multiNulls(vec, vs, cs)
+		multiFree(vs, cs, proc)
+		return vec, nil
+	}
+}
+
+func substringFn(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	xs := vs[0].Col.(*types.Bytes)
+	starts, err := int64Values(vs[1])
+	if err != nil {
+		return nil, err
+	}
+	col := &types.Bytes{
+		Data:    make([]byte, 0, len(xs.Data)),
+		Offsets: make([]uint32, 0, len(xs.Offsets)),
+		Lengths: make([]uint32, 0, len(xs.Lengths)),
+	}
+	if len(vs) == 2 {
+		col = substring.Substring(xs, starts, col)
+	} else {
+		lengths, err := int64Values(vs[2])
+		if err != nil {
+			return nil, err
+		}
+		col = substring.SubstringWithLength(xs, starts, lengths, col)
+	}
+	vec, err := newBytesVector(col, types.Type{Oid: types.T_varchar, Size: 24}, proc)
+	if err != nil {
+		return nil, err
+	}
+	multiNulls(vec, vs, cs)
+	multiFree(vs, cs, proc)
+	return vec, nil
+}
+
+func concatFn(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	var size int
+
+	n := multiLength(vs, cs)
+	xs := make([]*types.Bytes, len(vs))
+	for i, v := range vs {
+		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar {
+			return nil, fmt.Errorf("'%s' not yet implemented for %s", OpName[Concat], v.Typ)
+		}
+		xs[i] = v.Col.(*types.Bytes)
+		if cs[i] {
+			size += len(xs[i].Get(0)) * n
+		} else {
+			size += len(xs[i].Data)
+		}
+	}
+	col := &types.Bytes{
+		Data:    make([]byte, 0, size),
+		Offsets: make([]uint32, 0, n),
+		Lengths: make([]uint32, 0, n),
+	}
+	vec, err := newBytesVector(concat.Concat(xs, cs, n, col), types.Type{Oid: types.T_varchar, Size: 24}, proc)
+	if err != nil {
+		return nil, err
+	}
+	multiNulls(vec, vs, cs)
+	multiFree(vs, cs, proc)
+	return vec, nil
+}
+
+func lengthFn(vs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	xs := vs[0].Col.(*types.Bytes)
+	vec, err := register.Get(proc, int64(len(xs.Lengths))*8, types.Type{Oid: types.T_int64, Size: 8})
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)
+	rs = rs[:len(xs.Lengths)]
+	vec.SetCol(length.StrLength(xs, rs))
+	multiNulls(vec, vs, cs)
+	multiFree(vs, cs, proc)
+	return vec, nil
+}
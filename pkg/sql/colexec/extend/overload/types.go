@@ -51,6 +51,51 @@ const (
 	GT
 	GE
 	NE
+
+	// multiple operator - scalar functions
+	Substring
+	Concat
+	Upper
+	Lower
+	Trim
+	LTrim
+	RTrim
+	Length
+	Abs
+	Round
+	Floor
+	Ceil
+	Coalesce
+	IfNull
+	DateAdd
+	DateSub
+	Year
+	Month
+	Day
+	Now
+	JsonExtract
+	JsonUnquote
+	JsonContains
+	JsonLength
+	JsonKeys
+	JsonValid
+
+	// multiple operator - null predicates
+	IsNull
+	IsNotNull
+
+	// multiple operator - conditional expression
+	Case
+
+	// multiple operator - argument of group_concat
+	ConcatItem
+
+	// multiple operator - membership and lookup of a set of rows
+	In
+	NotIn
+	Exists
+	NotExists
+	Lookup
 )
 
 var OpName = map[int]string{
@@ -77,6 +122,47 @@ var OpName = map[int]string{
 	GT: ">",
 	GE: ">=",
 	NE: "<>",
+
+	Substring: "substring",
+	Concat:    "concat",
+	Upper:     "upper",
+	Lower:     "lower",
+	Trim:      "trim",
+	LTrim:     "ltrim",
+	RTrim:     "rtrim",
+	Length:    "length",
+	Abs:       "abs",
+	Round:     "round",
+	Floor:     "floor",
+	Ceil:      "ceil",
+	Coalesce:  "coalesce",
+	IfNull:    "ifnull",
+	DateAdd:   "date_add",
+	DateSub:   "date_sub",
+	Year:      "year",
+	Month:     "month",
+	Day:       "day",
+	Now:       "now",
+
+	JsonExtract:  "json_extract",
+	JsonUnquote:  "json_unquote",
+	JsonContains: "json_contains",
+	JsonLength:   "json_length",
+	JsonKeys:     "json_keys",
+	JsonValid:    "json_valid",
+
+	IsNull:    "isNull",
+	IsNotNull: "isNotNull",
+
+	Case: "case",
+
+	ConcatItem: "concat_item",
+
+	In:        "in",
+	NotIn:     "notIn",
+	Exists:    "exists",
+	NotExists: "notExists",
+	Lookup:    "lookup",
 }
 
 var SelsType = types.Type{Oid: types.T_sel, Size: 8}
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/intmap/fastmap"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/aggfunc"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -122,30 +123,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		for i, e := range n.Es {
 			vec := bat.GetVector(ctr.attrs[ctr.is[i]])
 			if e.Agg == nil {
-				switch e.Op {
-				case aggregation.Avg:
-					e.Agg = aggfunc.NewAvg(vec.Typ)
-				case aggregation.Max:
-					e.Agg = aggfunc.NewMax(vec.Typ)
-				case aggregation.Min:
-					e.Agg = aggfunc.NewMin(vec.Typ)
-				case aggregation.Sum:
-					e.Agg = aggfunc.NewSum(vec.Typ)
-				case aggregation.Count:
-					e.Agg = aggfunc.NewCount(vec.Typ)
-				case aggregation.StarCount:
-					e.Agg = aggfunc.NewStarCount(vec.Typ)
-				case aggregation.SumCount:
-					e.Agg = aggfunc.NewSumCount(vec.Typ)
-				default:
+				if e.Agg = aggfunc.New(e, vec.Typ); e.Agg == nil {
 					bat.Clean(proc)
 					ctr.clean(proc)
-					return false, fmt.Errorf("unsupport aggregation operator '%v'", e.Op)
-				}
-				if e.Agg == nil {
-					bat.Clean(proc)
-					ctr.clean(proc)
-					return false, fmt.Errorf("unsupport sumcount aggregation operator '%v' for %s", e.Op, bat.Vecs[i+len(n.Gs)].Typ)
+					return false, fmt.Errorf("unsupport aggregation operator '%s' for %s", aggregation.AggName[e.Op], vec.Typ)
 				}
 				n.Es[i].Agg = e.Agg
 			}
@@ -160,19 +141,110 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		return false, err
 	}
 	bat.Clean(proc)
-	vecs, err := ctr.eval(ctr.rows, n.Es, proc)
+	rbat, err := ctr.result(n, proc)
 	if err != nil {
 		ctr.clean(proc)
 		return false, err
 	}
-	proc.Reg.InputBatch = &batch.Batch{
+	for ctr.part < len(ctr.parts) {
+		if err := ctr.groupPartition(rbat, n, proc); err != nil {
+			rbat.Clean(proc)
+			ctr.clean(proc)
+			return false, err
+		}
+	}
+	proc.Reg.InputBatch = rbat
+	ctr.clean(proc)
+	return false, nil
+}
+
+func (ctr *Container) result(n *Argument, proc *process.Process) (*batch.Batch, error) {
+	vecs, err := ctr.eval(ctr.rows, n.Es, proc)
+	if err != nil {
+		return nil, err
+	}
+	rbat := &batch.Batch{
 		Ro:    true,
 		Attrs: ctr.rattrs,
 		Vecs:  append(ctr.bat.Vecs, vecs...),
 	}
 	ctr.bat = nil
-	ctr.clean(proc)
-	return false, nil
+	return rbat, nil
+}
+
+// groupPartition groups the rows of the next spilled partition, and appends
+// the groups to rbat. The groups of a partition are freed before the next one.
+func (ctr *Container) groupPartition(rbat *batch.Batch, n *Argument, proc *process.Process) error {
+	f := ctr.parts[ctr.part]
+	ctr.part++
+	if f == nil {
+		return nil
+	}
+	if err := f.Flush(proc); err != nil {
+		return err
+	}
+	ctr.rows = 0
+	ctr.groups = make(map[uint64][]*hash.Group)
+	for {
+		bat, err := f.Read(proc)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			break
+		}
+		if ctr.bat == nil {
+			ctr.bat = batch.New(true, n.Gs)
+			for i, attr := range n.Gs {
+				ctr.bat.Vecs[i] = vector.New(bat.GetVector(attr).Typ)
+			}
+		}
+		err = ctr.batchGroup(bat.Vecs, n.Es, proc)
+		bat.Clean(proc)
+		if err != nil {
+			return err
+		}
+	}
+	f.Clean(proc)
+	ctr.parts[ctr.part-1] = nil
+	if ctr.bat == nil {
+		return nil
+	}
+	pbat, err := ctr.result(n, proc)
+	if err != nil {
+		return err
+	}
+	defer pbat.Clean(proc)
+	for sel, nsel := int64(0), int64(pbat.Vecs[0].Length()); sel < nsel; sel++ {
+		for i, vec := range rbat.Vecs {
+			if err := vec.UnionOne(pbat.Vecs[i], sel, proc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// spilling returns true if the rows of new groups are moved to the partitions.
+func (ctr *Container) spilling() bool {
+	return ctr.parts != nil && ctr.part == 0
+}
+
+// spill moves the sel-th row of vecs to the partition of its hash code h.
+func (ctr *Container) spill(h uint64, sel int64, vecs []*vector.Vector, proc *process.Process) error {
+	i := spill.Index(h)
+	if ctr.parts[i] == nil {
+		typs := make([]types.Type, len(vecs))
+		for j, vec := range vecs {
+			typs[j] = vec.Typ
+		}
+		f, err := spill.New(ctr.attrs, typs)
+		if err != nil {
+			return err
+		}
+		ctr.parts[i] = f
+	}
+	return ctr.parts[i].Append(vecs, sel, proc)
 }
 
 func (ctr *Container) eval(length int64, es []aggregation.Extend, proc *process.Process) ([]*vector.Vector, error) {
@@ -381,7 +453,87 @@ func (ctr *Container) eval(length int64, es []aggregation.Extend, proc *process.
 			}
 			vecs[i].Col = vs
 			vecs[i].Data = data
-		case types.T_char, types.T_varchar:
+		case types.T_decimal64:
+			data, err := proc.Alloc(length * 8)
+			if err != nil {
+				for j := 0; j < i; j++ {
+					vecs[j].Free(proc)
+				}
+				return nil, err
+			}
+			vs := encoding.DecodeDecimal64Slice(data)
+			for _, gs := range ctr.groups {
+				for _, g := range gs {
+					if v := g.Aggs[i].Eval(); v == nil {
+						vecs[i].Nsp.Add(uint64(g.Sel))
+					} else {
+						vs[g.Sel] = v.(types.Decimal64)
+					}
+				}
+			}
+			vecs[i].Col = vs
+			vecs[i].Data = data
+		case types.T_decimal128:
+			data, err := proc.Alloc(length * 16)
+			if err != nil {
+				for j := 0; j < i; j++ {
+					vecs[j].Free(proc)
+				}
+				return nil, err
+			}
+			vs := encoding.DecodeDecimal128Slice(data)
+			for _, gs := range ctr.groups {
+				for _, g := range gs {
+					if v := g.Aggs[i].Eval(); v == nil {
+						vecs[i].Nsp.Add(uint64(g.Sel))
+					} else {
+						vs[g.Sel] = v.(types.Decimal128)
+					}
+				}
+			}
+			vecs[i].Col = vs
+			vecs[i].Data = data
+		case types.T_date:
+			data, err := proc.Alloc(length * 4)
+			if err != nil {
+				for j := 0; j < i; j++ {
+					vecs[j].Free(proc)
+				}
+				return nil, err
+			}
+			vs := encoding.DecodeDateSlice(data)
+			for _, gs := range ctr.groups {
+				for _, g := range gs {
+					if v := g.Aggs[i].Eval(); v == nil {
+						vecs[i].Nsp.Add(uint64(g.Sel))
+					} else {
+						vs[g.Sel] = v.(types.Date)
+					}
+				}
+			}
+			vecs[i].Col = vs
+			vecs[i].Data = data
+		case types.T_datetime:
+			data, err := proc.Alloc(length * 8)
+			if err != nil {
+				for j := 0; j < i; j++ {
+					vecs[j].Free(proc)
+				}
+				return nil, err
+			}
+			vs := encoding.DecodeDatetimeSlice(data)
+			for _, gs := range ctr.groups {
+				for _, g := range gs {
+					if v := g.Aggs[i].Eval(); v == nil {
+						vecs[i].Nsp.Add(uint64(g.Sel))
+					} else {
+						vs[g.Sel] = v.(types.Datetime)
+					}
+				}
+			}
+			vecs[i].Col = vs
+			vecs[i].Data = data
+		case types.T_char, types.T_varchar, types.T_json:
 			size := 0
 			vs := make([][]byte, length)
 			for _, gs := range ctr.groups {
@@ -463,9 +615,17 @@ func (ctr *Container) unitGroup(start int, count int, vecs []*vector.Vector, es
 					remaining = g.Fill(remaining, ctr.matchs, vecs, ctr.bat.Vecs[:ctr.n], ctr.diffs, proc)
 					copy(ctr.diffs[:len(remaining)], ZeroBools[:len(remaining)])
 				}
-			} else {
+			} else if !ctr.spilling() {
 				ctr.groups[h] = make([]*hash.Group, 0, 8)
 			}
+			if ctr.spilling() {
+				for _, sel := range remaining {
+					if err = ctr.spill(h, sel, vecs, proc); err != nil {
+						return err
+					}
+				}
+				remaining = remaining[:0]
+			}
 			for len(remaining) > 0 {
 				g := hash.NewGroup(ctr.rows, ctr.is, es)
 				for i, vec := range ctr.bat.Vecs {
@@ -477,6 +637,16 @@ func (ctr *Container) unitGroup(start int, count int, vecs []*vector.Vector, es
 				ctr.groups[h] = append(ctr.groups[h], g)
 				remaining = g.Fill(remaining, ctr.matchs, vecs, ctr.bat.Vecs[:ctr.n], ctr.diffs, proc)
 				copy(ctr.diffs[:len(remaining)], ZeroBools[:len(remaining)])
+				if ctr.parts == nil && spill.Overflow(proc) {
+					// the groups in memory are kept, the rows of new groups are spilled
+					ctr.parts = make([]*spill.File, spill.Partitions)
+					for _, sel := range remaining {
+						if err = ctr.spill(h, sel, vecs, proc); err != nil {
+							return err
+						}
+					}
+					remaining = remaining[:0]
+				}
 			}
 			ctr.sels[ctr.slots.Vs[i][j]] = ctr.sels[ctr.slots.Vs[i][j]][:0]
 		}
@@ -507,4 +677,11 @@ func (ctr *Container) clean(proc *process.Process) {
 		ctr.bat.Clean(proc)
 		ctr.bat = nil
 	}
+	for _, f := range ctr.parts {
+		if f != nil {
+			f.Clean(proc)
+		}
+	}
+	ctr.parts = nil
+	ctr.part = 0
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/intmap/fastmap"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/aggfunc"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -115,24 +116,43 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				ctr.state = End
 				return true, nil
 			}
-			vecs, err := ctr.eval(ctr.rows, n.Es, proc)
+			rbat, err := ctr.result(n, proc)
 			if err != nil {
 				ctr.clean(proc)
 				ctr.state = End
 				return true, err
 			}
-			rbat := &batch.Batch{
-				Ro:    true,
-				Attrs: ctr.rattrs,
-				Vecs:  append(ctr.bat.Vecs, vecs...),
-			}
-			if !n.Flg {
-				rbat.Reduce(n.Gs, proc)
-			}
 			proc.Reg.InputBatch = rbat
-			ctr.bat = nil
+			if len(ctr.parts) > 0 {
+				for _, f := range ctr.parts {
+					if f == nil {
+						continue
+					}
+					if err := f.Flush(proc); err != nil {
+						ctr.clean(proc)
+						ctr.state = End
+						return true, err
+					}
+				}
+				ctr.state = Spill
+				return false, nil
+			}
 			ctr.state = End
 			return true, nil
+		case Spill:
+			ok, err := ctr.groupPartition(n, proc)
+			if err != nil {
+				ctr.clean(proc)
+				ctr.state = End
+				return true, err
+			}
+			if ctr.part == len(ctr.parts) {
+				ctr.state = End
+			}
+			if !ok {
+				continue
+			}
+			return ctr.state == End, nil
 		case End:
 			proc.Reg.InputBatch = nil
 			return true, nil
@@ -140,6 +160,85 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 }
 
+func (ctr *Container) result(n *Argument, proc *process.Process) (*batch.Batch, error) {
+	vecs, err := ctr.eval(ctr.rows, n.Es, proc)
+	if err != nil {
+		return nil, err
+	}
+	rbat := &batch.Batch{
+		Ro:    true,
+		Attrs: ctr.rattrs,
+		Vecs:  append(ctr.bat.Vecs, vecs...),
+	}
+	if !n.Flg {
+		rbat.Reduce(n.Gs, proc)
+	}
+	ctr.bat = nil
+	return rbat, nil
+}
+
+// groupPartition groups the rows of the next spilled partition, the result is
+// stored in the register of proc. It returns false if the partition is empty.
+func (ctr *Container) groupPartition(n *Argument, proc *process.Process) (bool, error) {
+	f := ctr.parts[ctr.part]
+	ctr.part++
+	if f == nil || f.Rows() == 0 {
+		return false, nil
+	}
+	ctr.rows = 0
+	ctr.groups = make(map[uint64][]*hash.Group)
+	for {
+		bat, err := f.Read(proc)
+		if err != nil {
+			return false, err
+		}
+		if bat == nil {
+			break
+		}
+		if ctr.bat == nil {
+			ctr.bat = batch.New(true, n.Gs)
+			for i, attr := range n.Gs {
+				ctr.bat.Vecs[i] = vector.New(bat.GetVector(attr).Typ)
+			}
+		}
+		err = ctr.batchGroup(bat.Vecs, n.Es, proc)
+		bat.Clean(proc)
+		if err != nil {
+			return false, err
+		}
+	}
+	f.Clean(proc)
+	ctr.parts[ctr.part-1] = nil
+	rbat, err := ctr.result(n, proc)
+	if err != nil {
+		return false, err
+	}
+	proc.Reg.InputBatch = rbat
+	return true, nil
+}
+
+// spilling returns true if the rows of new groups are moved to the partitions.
+func (ctr *Container) spilling() bool {
+	return ctr.parts != nil && ctr.state == Build
+}
+
+// spill moves the sel-th row of vecs to the partition of its hash code h.
+func (ctr *Container) spill(h uint64, sel int64, vecs []*vector.Vector, proc *process.Process) error {
+	i := spill.Index(h)
+	if ctr.parts[i] == nil {
+		typs := make([]types.Type, len(vecs))
+		for j, vec := range vecs {
+			typs[j] = vec.Typ
+		}
+		f, err := spill.New(ctr.attrs, typs)
+		if err != nil {
+			return err
+		}
+		ctr.parts[i] = f
+	}
+	return ctr.parts[i].Append(vecs, sel, proc)
+}
+
 func (ctr *Container) eval(length int64, es []aggregation.Extend, proc *process.Process) ([]*vector.Vector, error) {
 	vecs := make([]*vector.Vector, len(es))
 	for i, e := range es {
@@ -346,7 +445,87 @@ func (ctr *Container) eval(length int64, es []aggregation.Extend, proc *process.
 			}
 			vecs[i].Col = vs
 			vecs[i].Data = data
-		case types.T_char, types.T_varchar:
+		case types.T_decimal64:
+			data, err := proc.Alloc(length * 8)
+			if err != nil {
+				for j := 0; j < i; j++ {
+					vecs[j].Free(proc)
+				}
+				return nil, err
+			}
+			vs := encoding.DecodeDecimal64Slice(data)
+			for _, gs := range ctr.groups {
+				for _, g := range gs {
+					if v := g.Aggs[i].Eval(); v == nil {
+						vecs[i].Nsp.Add(uint64(g.Sel))
+					} else {
+						vs[g.Sel] = v.(types.Decimal64)
+					}
+				}
+			}
+			vecs[i].Col = vs
+			vecs[i].Data = data
+		case types.T_decimal128:
+			data, err := proc.Alloc(length * 16)
+			if err != nil {
+				for j := 0; j < i; j++ {
+					vecs[j].Free(proc)
+				}
+				return nil, err
+			}
+			vs := encoding.DecodeDecimal128Slice(data)
+			for _, gs := range ctr.groups {
+				for _, g := range gs {
+					if v := g.Aggs[i].Eval(); v == nil {
+						vecs[i].Nsp.Add(uint64(g.Sel))
+					} else {
+						vs[g.Sel] = v.(types.Decimal128)
+					}
+				}
+			}
+			vecs[i].Col = vs
+			vecs[i].Data = data
+		case types.T_date:
+			data, err := proc.Alloc(length * 4)
+			if err != nil {
+				for j := 0; j < i; j++ {
+					vecs[j].Free(proc)
+				}
+				return nil, err
+			}
+			vs := encoding.DecodeDateSlice(data)
+			for _, gs := range ctr.groups {
+				for _, g := range gs {
+					if v := g.Aggs[i].Eval(); v == nil {
+						vecs[i].Nsp.Add(uint64(g.Sel))
+					} else {
+						vs[g.Sel] = v.(types.Date)
+					}
+				}
+			}
+			vecs[i].Col = vs
+			vecs[i].Data = data
+		case types.T_datetime:
+			data, err := proc.Alloc(length * 8)
+			if err != nil {
+				for j := 0; j < i; j++ {
+					vecs[j].Free(proc)
+				}
+				return nil, err
+			}
+			vs := encoding.DecodeDatetimeSlice(data)
+			for _, gs := range ctr.groups {
+				for _, g := range gs {
+					if v := g.Aggs[i].Eval(); v == nil {
+						vecs[i].Nsp.Add(uint64(g.Sel))
+					} else {
+						vs[g.Sel] = v.(types.Datetime)
+					}
+				}
+			}
+			vecs[i].Col = vs
+			vecs[i].Data = data
+		case types.T_char, types.T_varchar, types.T_json:
 			size := 0
 			vs := make([][]byte, length)
 			for _, gs := range ctr.groups {
@@ -431,32 +610,11 @@ func (ctr *Container) build(n *Argument, proc *process.Process) error {
 				for i, e := range n.Es {
 					vec := bat.GetVector(ctr.attrs[ctr.is[i]])
 					if e.Agg == nil {
-						switch e.Op {
-						case aggregation.Avg:
-							e.Agg = aggfunc.NewAvg(vec.Typ)
-						case aggregation.Max:
-							e.Agg = aggfunc.NewMax(vec.Typ)
-						case aggregation.Min:
-							e.Agg = aggfunc.NewMin(vec.Typ)
-						case aggregation.Sum:
-							e.Agg = aggfunc.NewSum(vec.Typ)
-						case aggregation.Count:
-							e.Agg = aggfunc.NewCount(vec.Typ)
-						case aggregation.StarCount:
-							e.Agg = aggfunc.NewStarCount(vec.Typ)
-						case aggregation.SumCount:
-							e.Agg = aggfunc.NewSumCount(vec.Typ)
-						default:
-							reg.Ch = nil
-							reg.Wg.Done()
-							bat.Clean(proc)
-							return fmt.Errorf("unsupport aggregation operator '%v'", e.Op)
-						}
-						if e.Agg == nil {
+						if e.Agg = aggfunc.New(e, vec.Typ); e.Agg == nil {
 							reg.Ch = nil
 							reg.Wg.Done()
 							bat.Clean(proc)
-							return fmt.Errorf("unsupport sumcount aggregation operator '%v' for %s", e.Op, bat.Vecs[i+len(n.Gs)].Typ)
+							return fmt.Errorf("unsupport aggregation operator '%s' for %s", aggregation.AggName[e.Op], vec.Typ)
 						}
 						n.Es[i].Agg = e.Agg
 					}
@@ -507,9 +665,17 @@ func (ctr *Container) unitGroup(start int, count int, vecs []*vector.Vector, es
 					remaining = g.Fill(remaining, ctr.matchs, vecs, ctr.bat.Vecs[:ctr.n], ctr.diffs, proc)
 					copy(ctr.diffs[:len(remaining)], ZeroBools[:len(remaining)])
 				}
-			} else {
+			} else if !ctr.spilling() {
 				ctr.groups[h] = make([]*hash.Group, 0, 8)
 			}
+			if ctr.spilling() {
+				for _, sel := range remaining {
+					if err = ctr.spill(h, sel, vecs, proc); err != nil {
+						return err
+					}
+				}
+				remaining = remaining[:0]
+			}
 			for len(remaining) > 0 {
 				g := hash.NewGroup(ctr.rows, ctr.is, es)
 				{
@@ -523,6 +689,16 @@ func (ctr *Container) unitGroup(start int, count int, vecs []*vector.Vector, es
 				ctr.groups[h] = append(ctr.groups[h], g)
 				remaining = g.Fill(remaining, ctr.matchs, vecs, ctr.bat.Vecs[:ctr.n], ctr.diffs, proc)
 				copy(ctr.diffs[:len(remaining)], ZeroBools[:len(remaining)])
+				if ctr.state == Build && spill.Overflow(proc) {
+					// the groups in memory are kept, the rows of new groups are spilled
+					ctr.parts = make([]*spill.File, spill.Partitions)
+					for _, sel := range remaining {
+						if err = ctr.spill(h, sel, vecs, proc); err != nil {
+							return err
+						}
+					}
+					remaining = remaining[:0]
+				}
 				if proc.Size() > proc.Lim.Size {
 					return errors.New("out of memory")
 				}
@@ -556,6 +732,12 @@ func (ctr *Container) clean(proc *process.Process) {
 		ctr.bat.Clean(proc)
 		ctr.bat = nil
 	}
+	for _, f := range ctr.parts {
+		if f != nil {
+			f.Clean(proc)
+		}
+	}
+	ctr.parts = nil
 	{
 		for _, reg := range proc.Reg.MergeReceivers {
 			if reg.Ch != nil {
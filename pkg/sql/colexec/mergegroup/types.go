@@ -20,11 +20,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/hash"
 	"github.com/matrixorigin/matrixone/pkg/intmap/fastmap"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 )
 
 const (
 	Build = iota
 	Eval
+	Spill
 	End
 )
 
@@ -53,6 +55,10 @@ type Container struct {
 	vec    *vector.Vector
 	refer  map[string]uint64
 	groups map[uint64][]*hash.Group // hash code -> group list
+	// parts, the rows of the groups that do not fit in memory,
+	// they are partitioned by the hash code of the group.
+	parts []*spill.File
+	part  int // the next partition to be grouped
 }
 
 type Argument struct {
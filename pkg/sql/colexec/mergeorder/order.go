@@ -16,13 +16,17 @@ package mergeorder
 
 import (
 	"bytes"
+	"container/heap"
 	"errors"
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/partition"
 	"github.com/matrixorigin/matrixone/pkg/sort"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -63,6 +67,15 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			}
 			ctr.state = Eval
 		case Eval:
+			if len(ctr.runs) > 0 {
+				if err := ctr.prepareMerge(n, proc); err != nil {
+					ctr.clean(proc)
+					ctr.state = End
+					return true, err
+				}
+				ctr.state = Merge
+				continue
+			}
 			if ctr.bat == nil {
 				proc.Reg.InputBatch = nil
 				ctr.state = End
@@ -80,6 +93,25 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			ctr.clean(proc)
 			ctr.state = End
 			return true, nil
+		case Merge:
+			bat, err := ctr.merge(proc)
+			if err != nil {
+				ctr.clean(proc)
+				ctr.state = End
+				return true, err
+			}
+			if len(ctr.runs) == 0 {
+				ctr.state = End
+			}
+			if bat == nil {
+				proc.Reg.InputBatch = nil
+				return true, nil
+			}
+			if !n.Flg {
+				bat.Reduce(ctr.attrs, proc)
+			}
+			proc.Reg.InputBatch = bat
+			return ctr.state == End, nil
 		case End:
 			proc.Reg.InputBatch = nil
 			return true, nil
@@ -107,14 +139,17 @@ func (ctr *Container) build(n *Argument, proc *process.Process) error {
 				reg.Wg.Done()
 				continue
 			}
-			if ctr.bat == nil {
+			if ctr.battrs == nil {
 				bat.Reorder(ctr.attrs)
-				ctr.bat = batch.New(true, bat.Attrs)
+				ctr.battrs = append([]string{}, bat.Attrs...)
+			} else {
+				bat.Reorder(ctr.battrs)
+			}
+			if ctr.bat == nil {
+				ctr.bat = batch.New(true, ctr.battrs)
 				for i, vec := range bat.Vecs {
 					ctr.bat.Vecs[i] = vector.New(vec.Typ)
 				}
-			} else {
-				bat.Reorder(ctr.bat.Attrs)
 			}
 			if len(bat.Sels) > 0 {
 				bat.Shuffle(proc)
@@ -129,6 +164,14 @@ func (ctr *Container) build(n *Argument, proc *process.Process) error {
 					}
 				}
 			}
+			if spill.Overflow(proc) {
+				if err := ctr.spill(proc); err != nil {
+					reg.Ch = nil
+					reg.Wg.Done()
+					bat.Clean(proc)
+					return err
+				}
+			}
 			if proc.Size() > proc.Lim.Size {
 				reg.Ch = nil
 				reg.Wg.Done()
@@ -181,10 +224,117 @@ func (ctr *Container) eval(proc *process.Process) error {
 	return nil
 }
 
+// spill sorts the rows in memory and moves them to a new run on disk.
+func (ctr *Container) spill(proc *process.Process) error {
+	if err := ctr.eval(proc); err != nil {
+		return err
+	}
+	typs := make([]types.Type, len(ctr.bat.Vecs))
+	for i, vec := range ctr.bat.Vecs {
+		typs[i] = vec.Typ
+	}
+	f, err := spill.New(ctr.bat.Attrs, typs)
+	if err != nil {
+		return err
+	}
+	ctr.runs = append(ctr.runs, &run{f: f})
+	for _, sel := range ctr.bat.Sels {
+		if err := f.Append(ctr.bat.Vecs, sel, proc); err != nil {
+			return err
+		}
+	}
+	if err := f.Flush(proc); err != nil {
+		return err
+	}
+	ctr.bat.Clean(proc)
+	ctr.bat = nil
+	return nil
+}
+
+// prepareMerge spills the remaining rows and loads the first batch of each run.
+func (ctr *Container) prepareMerge(n *Argument, proc *process.Process) error {
+	if ctr.bat != nil {
+		if err := ctr.spill(proc); err != nil {
+			return err
+		}
+	}
+	ctr.cmps = make([]compare.Compare, len(n.Fs))
+	for i := 0; i < len(ctr.runs); i++ {
+		r := ctr.runs[i]
+		bat, err := r.f.Read(proc)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			r.f.Clean(proc)
+			ctr.runs = append(ctr.runs[:i], ctr.runs[i+1:]...)
+			i--
+			continue
+		}
+		r.bat = bat
+		for j, f := range n.Fs {
+			if ctr.cmps[j] == nil {
+				ctr.cmps[j] = compare.New(bat.Vecs[j].Typ.Oid, f.Type == Descending)
+			}
+		}
+	}
+	heap.Init(ctr)
+	return nil
+}
+
+// merge returns the next sorted batch of the runs, or nil if all the runs have been merged.
+func (ctr *Container) merge(proc *process.Process) (*batch.Batch, error) {
+	var bat *batch.Batch
+
+	for len(ctr.runs) > 0 {
+		r := ctr.runs[0]
+		if bat == nil {
+			bat = batch.New(true, ctr.battrs)
+			for i, vec := range r.bat.Vecs {
+				bat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		for i, vec := range bat.Vecs {
+			if err := vec.UnionOne(r.bat.Vecs[i], r.sel, proc); err != nil {
+				bat.Clean(proc)
+				return nil, err
+			}
+		}
+		if r.sel++; r.sel == int64(r.bat.Vecs[0].Length()) {
+			r.bat.Clean(proc)
+			next, err := r.f.Read(proc)
+			if err != nil {
+				r.bat = nil
+				bat.Clean(proc)
+				return nil, err
+			}
+			if r.bat, r.sel = next, 0; r.bat == nil {
+				r.f.Clean(proc)
+				heap.Pop(ctr)
+			} else {
+				heap.Fix(ctr, 0)
+			}
+		} else {
+			heap.Fix(ctr, 0)
+		}
+		if bat.Vecs[0].Length() >= spill.BatchRows {
+			break
+		}
+	}
+	return bat, nil
+}
+
 func (ctr *Container) clean(proc *process.Process) {
 	if ctr.bat != nil {
 		ctr.bat.Clean(proc)
 	}
+	for _, r := range ctr.runs {
+		if r.bat != nil {
+			r.bat.Clean(proc)
+		}
+		r.f.Clean(proc)
+	}
+	ctr.runs = nil
 	{
 		for _, reg := range proc.Reg.MergeReceivers {
 			if reg.Ch != nil {
@@ -16,12 +16,15 @@ package mergeorder
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 )
 
 const (
 	Build = iota
 	Eval
+	Merge
 	End
 )
 
@@ -36,10 +39,20 @@ const (
 )
 
 type Container struct {
-	state int
-	ds    []bool   // ds[i] == true: the attrs[i] are in descending order
-	attrs []string // sorted list of attributes
-	bat   *batch.Batch
+	state  int
+	ds     []bool   // ds[i] == true: the attrs[i] are in descending order
+	attrs  []string // sorted list of attributes
+	battrs []string // attributes of the merged batch
+	bat    *batch.Batch
+	runs   []*run // sorted runs spilled to disk, it is a heap during merging
+	cmps   []compare.Compare
+}
+
+// run is a sorted part of the rows spilled to disk.
+type run struct {
+	sel int64 // the current row of bat
+	bat *batch.Batch
+	f   *spill.File
 }
 
 type Field struct {
@@ -73,3 +86,37 @@ func (i Direction) String() string {
 	}
 	return directionName[i]
 }
+
+func (ctr *Container) compare(r, s *run) int {
+	for i, cmp := range ctr.cmps {
+		cmp.Set(0, r.bat.Vecs[i])
+		cmp.Set(1, s.bat.Vecs[i])
+		if c := cmp.Compare(0, 1, r.sel, s.sel); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func (ctr *Container) Len() int {
+	return len(ctr.runs)
+}
+
+func (ctr *Container) Less(i, j int) bool {
+	return ctr.compare(ctr.runs[i], ctr.runs[j]) < 0
+}
+
+func (ctr *Container) Swap(i, j int) {
+	ctr.runs[i], ctr.runs[j] = ctr.runs[j], ctr.runs[i]
+}
+
+func (ctr *Container) Push(x interface{}) {
+	ctr.runs = append(ctr.runs, x.(*run))
+}
+
+func (ctr *Container) Pop() interface{} {
+	n := len(ctr.runs) - 1
+	x := ctr.runs[n]
+	ctr.runs = ctr.runs[:n]
+	return x
+}
@@ -131,26 +131,8 @@ func (ctr *Container) processBatch(bat *batch.Batch, es []aggregation.Extend, pr
 		vec := bat.GetVector(e.Name)
 		{
 			if e.Agg == nil {
-				switch e.Op {
-				case aggregation.Avg:
-					e.Agg = aggfunc.NewAvg(vec.Typ)
-				case aggregation.Max:
-					e.Agg = aggfunc.NewMax(vec.Typ)
-				case aggregation.Min:
-					e.Agg = aggfunc.NewMin(vec.Typ)
-				case aggregation.Sum:
-					e.Agg = aggfunc.NewSum(vec.Typ)
-				case aggregation.Count:
-					e.Agg = aggfunc.NewCount(vec.Typ)
-				case aggregation.StarCount:
-					e.Agg = aggfunc.NewStarCount(vec.Typ)
-				case aggregation.SumCount:
-					e.Agg = aggfunc.NewSumCount(vec.Typ)
-				default:
-					return fmt.Errorf("unsupport aggregation operator '%v'", e.Op)
-				}
-				if e.Agg == nil {
-					return fmt.Errorf("unsupport sumcount aggregation operator '%v' for %s", e.Op, vec.Typ)
+				if e.Agg = aggfunc.New(e, vec.Typ); e.Agg == nil {
+					return fmt.Errorf("unsupport aggregation operator '%s' for %s", aggregation.AggName[e.Op], vec.Typ)
 				}
 				es[i].Agg = e.Agg
 			}
@@ -16,11 +16,16 @@ package order
 
 import (
 	"bytes"
+	"container/heap"
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/partition"
 	"github.com/matrixorigin/matrixone/pkg/sort"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -63,20 +68,41 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	if len(bat.Sels) > 0 {
 		bat.Shuffle(proc)
 	}
-	if err := ctr.processBatch(bat, proc); err != nil {
+	ok, err := ctr.processBatch(bat, proc)
+	if err != nil {
 		bat.Clean(proc)
 		return false, err
 	}
+	if !ok {
+		// external merge sort, the batch is freed once its sorted runs are on disk
+		err := ctr.spill(bat, proc)
+		bat.Clean(proc)
+		if err != nil {
+			ctr.clean(proc)
+			return false, err
+		}
+		rbat, err := ctr.merge(bat.Attrs, proc)
+		if err != nil {
+			ctr.clean(proc)
+			return false, err
+		}
+		proc.Reg.InputBatch = rbat
+		return false, nil
+	}
 	proc.Reg.InputBatch = bat
 	return false, nil
 }
 
-func (ctr *Container) processBatch(bat *batch.Batch, proc *process.Process) error {
-	ovec := bat.GetVector(ctr.attrs[0])
-	n := ovec.Length()
+// processBatch sorts bat in memory, it returns false if the memory of proc is running out.
+func (ctr *Container) processBatch(bat *batch.Batch, proc *process.Process) (bool, error) {
+	n := bat.Vecs[0].Length()
 	data, err := proc.Alloc(int64(n * 8))
 	if err != nil {
-		return err
+		return false, err
+	}
+	if n > 0 && spill.Overflow(proc) {
+		proc.Free(data)
+		return false, nil
 	}
 	sels := encoding.DecodeInt64Slice(data)
 	{
@@ -84,11 +110,18 @@ func (ctr *Container) processBatch(bat *batch.Batch, proc *process.Process) erro
 			sels[i] = int64(i)
 		}
 	}
+	ctr.sort(sels, bat)
+	bat.Sels = sels
+	bat.SelsData = data
+	return true, nil
+}
+
+// sort sorts the rows sels of bat.
+func (ctr *Container) sort(sels []int64, bat *batch.Batch) {
+	ovec := bat.GetVector(ctr.attrs[0])
 	sort.Sort(ctr.ds[0], sels, ovec)
 	if len(ctr.attrs) == 1 {
-		bat.Sels = sels
-		bat.SelsData = data
-		return nil
+		return
 	}
 	ps := make([]int64, 0, 16)
 	ds := make([]bool, len(sels))
@@ -105,7 +138,124 @@ func (ctr *Container) processBatch(bat *batch.Batch, proc *process.Process) erro
 		}
 		ovec = vec
 	}
-	bat.Sels = sels
-	bat.SelsData = data
+}
+
+// spill sorts the rows of bat in runs of at most spill.BatchRows rows, and moves each run to disk.
+func (ctr *Container) spill(bat *batch.Batch, proc *process.Process) error {
+	n := bat.Vecs[0].Length()
+	size := n
+	if size > spill.BatchRows {
+		size = spill.BatchRows
+	}
+	data, err := proc.Alloc(int64(size * 8))
+	if err != nil {
+		return err
+	}
+	defer proc.Free(data)
+	typs := make([]types.Type, len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		typs[i] = vec.Typ
+	}
+	ctr.is = make([]int, len(ctr.attrs))
+	for i, attr := range ctr.attrs {
+		for j, battr := range bat.Attrs {
+			if attr == battr {
+				ctr.is[i] = j
+			}
+		}
+	}
+	for start := 0; start < n; start += spill.BatchRows {
+		sels := encoding.DecodeInt64Slice(data)
+		if n-start < size {
+			sels = sels[:n-start]
+		}
+		for i := range sels {
+			sels[i] = int64(start + i)
+		}
+		ctr.sort(sels, bat)
+		f, err := spill.New(bat.Attrs, typs)
+		if err != nil {
+			return err
+		}
+		ctr.runs = append(ctr.runs, &run{f: f})
+		for _, sel := range sels {
+			if err := f.Append(bat.Vecs, sel, proc); err != nil {
+				return err
+			}
+		}
+		if err := f.Flush(proc); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// merge merges the sorted runs into a batch with the attributes attrs.
+func (ctr *Container) merge(attrs []string, proc *process.Process) (*batch.Batch, error) {
+	var bat *batch.Batch
+
+	ctr.cmps = make([]compare.Compare, len(ctr.attrs))
+	for i := 0; i < len(ctr.runs); i++ {
+		r := ctr.runs[i]
+		rbat, err := r.f.Read(proc)
+		if err != nil {
+			return nil, err
+		}
+		if rbat == nil {
+			r.f.Clean(proc)
+			ctr.runs = append(ctr.runs[:i], ctr.runs[i+1:]...)
+			i--
+			continue
+		}
+		r.bat = rbat
+		for j, k := range ctr.is {
+			if ctr.cmps[j] == nil {
+				ctr.cmps[j] = compare.New(rbat.Vecs[k].Typ.Oid, ctr.ds[j])
+			}
+		}
+	}
+	heap.Init(ctr)
+	for len(ctr.runs) > 0 {
+		r := ctr.runs[0]
+		if bat == nil {
+			bat = batch.New(true, attrs)
+			for i, vec := range r.bat.Vecs {
+				bat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		for i, vec := range bat.Vecs {
+			if err := vec.UnionOne(r.bat.Vecs[i], r.sel, proc); err != nil {
+				bat.Clean(proc)
+				return nil, err
+			}
+		}
+		if r.sel++; r.sel == int64(r.bat.Vecs[0].Length()) {
+			r.bat.Clean(proc)
+			next, err := r.f.Read(proc)
+			if err != nil {
+				r.bat = nil
+				bat.Clean(proc)
+				return nil, err
+			}
+			if r.bat, r.sel = next, 0; r.bat == nil {
+				r.f.Clean(proc)
+				heap.Pop(ctr)
+			} else {
+				heap.Fix(ctr, 0)
+			}
+		} else {
+			heap.Fix(ctr, 0)
+		}
+	}
+	return bat, nil
+}
+
+func (ctr *Container) clean(proc *process.Process) {
+	for _, r := range ctr.runs {
+		if r.bat != nil {
+			r.bat.Clean(proc)
+		}
+		r.f.Clean(proc)
+	}
+	ctr.runs = nil
+}
@@ -16,6 +16,9 @@ package order
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 )
 
 // Direction for ordering results.
@@ -30,7 +33,17 @@ const (
 
 type Container struct {
 	ds    []bool   // ds[i] == true: the attrs[i] are in descending order
+	is    []int    // is[i] is the index of the attrs[i] in the spilled batches
 	attrs []string // sorted list of attributes
+	runs  []*run   // sorted runs spilled to disk, it is a heap during merging
+	cmps  []compare.Compare
+}
+
+// run is a sorted part of the rows spilled to disk.
+type run struct {
+	sel int64 // the current row of bat
+	bat *batch.Batch
+	f   *spill.File
 }
 
 type Field struct {
@@ -63,3 +76,37 @@ func (i Direction) String() string {
 	}
 	return directionName[i]
 }
+
+func (ctr *Container) compare(r, s *run) int {
+	for i, cmp := range ctr.cmps {
+		cmp.Set(0, r.bat.Vecs[ctr.is[i]])
+		cmp.Set(1, s.bat.Vecs[ctr.is[i]])
+		if c := cmp.Compare(0, 1, r.sel, s.sel); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func (ctr *Container) Len() int {
+	return len(ctr.runs)
+}
+
+func (ctr *Container) Less(i, j int) bool {
+	return ctr.compare(ctr.runs[i], ctr.runs[j]) < 0
+}
+
+func (ctr *Container) Swap(i, j int) {
+	ctr.runs[i], ctr.runs[j] = ctr.runs[j], ctr.runs[i]
+}
+
+func (ctr *Container) Push(x interface{}) {
+	ctr.runs = append(ctr.runs, x.(*run))
+}
+
+func (ctr *Container) Pop() interface{} {
+	n := len(ctr.runs) - 1
+	x := ctr.runs[n]
+	ctr.runs = ctr.runs[:n]
+	return x
+}
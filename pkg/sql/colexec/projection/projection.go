@@ -66,6 +66,21 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			rbat.Vecs[i].Ref = n.Refer[n.Attrs[i]]
 		}
 	}
+	// the batch is only read for the number of rows
+	if !readsAttributes(n.Es) {
+		bat.Clean(proc)
+	}
 	proc.Reg.InputBatch = rbat
 	return false, nil
 }
+
+// readsAttributes returns true if some of the extends read an attribute,
+// a projection of functions such as now() reads none.
+func readsAttributes(es []extend.Extend) bool {
+	for _, e := range es {
+		if len(e.Attributes()) > 0 {
+			return true
+		}
+	}
+	return false
+}
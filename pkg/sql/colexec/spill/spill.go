@@ -0,0 +1,186 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/spillEngine/kv"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Overflow returns true if the memory used by proc nears the limit of its guest mmu,
+// the operators should move their intermediate results to disk.
+func Overflow(proc *process.Process) bool {
+	limit := proc.Gm.Limit
+	if proc.Lim.Size > 0 && proc.Lim.Size < limit {
+		limit = proc.Lim.Size
+	}
+	return proc.Size() > limit-limit>>2
+}
+
+// Index returns the partition of the hash code h, the high bits are used
+// because the low bits are used by the hash tables built on a partition.
+func Index(h uint64) int {
+	return int((h >> 32) % Partitions)
+}
+
+// New creates a temp file for the batches with the attributes attrs.
+func New(attrs []string, typs []types.Type) (*File, error) {
+	dir, err := os.MkdirTemp(Dir, "spill")
+	if err != nil {
+		return nil, err
+	}
+	db, err := kv.New(dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	return &File{
+		dir:   dir,
+		db:    db,
+		attrs: attrs,
+		typs:  typs,
+	}, nil
+}
+
+// Rows returns the number of rows written into f.
+func (f *File) Rows() int64 {
+	return f.rows
+}
+
+// Write stores the rows of bat, the vectors of bat must be in the order of the attributes of f.
+func (f *File) Write(bat *batch.Batch, proc *process.Process) error {
+	if len(bat.Sels) > 0 {
+		if err := bat.Shuffle(proc); err != nil {
+			return err
+		}
+	}
+	if err := f.Flush(proc); err != nil {
+		return err
+	}
+	if n := bat.Vecs[0].Length(); n > 0 {
+		f.rows += int64(n)
+		return f.write(bat.Vecs)
+	}
+	return nil
+}
+
+// Append buffers the sel-th row of vecs, the buffer is written when it is full,
+// or earlier if the memory of proc is running out.
+func (f *File) Append(vecs []*vector.Vector, sel int64, proc *process.Process) error {
+	if f.bat == nil {
+		f.bat = batch.New(true, f.attrs)
+		for i, typ := range f.typs {
+			f.bat.Vecs[i] = vector.New(typ)
+		}
+	}
+	for i, vec := range f.bat.Vecs {
+		if err := vec.UnionOne(vecs[i], sel, proc); err != nil {
+			return err
+		}
+	}
+	f.rows++
+	if n := f.bat.Vecs[0].Length(); n >= BatchRows || (n >= MinBatchRows && Overflow(proc)) {
+		return f.Flush(proc)
+	}
+	return nil
+}
+
+// Flush writes the buffered rows.
+func (f *File) Flush(proc *process.Process) error {
+	if f.bat == nil {
+		return nil
+	}
+	err := f.write(f.bat.Vecs)
+	f.bat.Clean(proc)
+	f.bat = nil
+	return err
+}
+
+// Read returns the next batch of f, or nil if all the batches have been read.
+// The memory of the batch is allocated from proc.
+func (f *File) Read(proc *process.Process) (*batch.Batch, error) {
+	if f.cur == f.n {
+		return nil, nil
+	}
+	bat := batch.New(true, f.attrs)
+	for i, typ := range f.typs {
+		k := key(f.cur, i)
+		size, err := f.db.Size(k)
+		if err != nil {
+			bat.Clean(proc)
+			return nil, err
+		}
+		data, err := f.db.Get(k, size, proc)
+		if err != nil {
+			bat.Clean(proc)
+			return nil, err
+		}
+		vec := vector.New(typ)
+		if err := vec.Read(data); err != nil {
+			proc.Free(data)
+			bat.Clean(proc)
+			return nil, err
+		}
+		vec.Or = false // the vector owns data now
+		vec.Ref = f.refs[i]
+		bat.Vecs[i] = vec
+	}
+	f.cur++
+	return bat, nil
+}
+
+// Rewind makes the next Read start from the first batch.
+func (f *File) Rewind() {
+	f.cur = 0
+}
+
+// Clean frees the buffered rows and removes the temp directory of f.
+func (f *File) Clean(proc *process.Process) {
+	if f.bat != nil {
+		f.bat.Clean(proc)
+		f.bat = nil
+	}
+	os.RemoveAll(f.dir)
+}
+
+func (f *File) write(vecs []*vector.Vector) error {
+	if f.refs == nil {
+		f.refs = make([]uint64, len(vecs))
+		for i, vec := range vecs {
+			f.refs[i] = vec.Ref
+		}
+	}
+	for i, vec := range vecs {
+		data, err := vec.Show()
+		if err != nil {
+			return err
+		}
+		if err := f.db.Set(key(f.n, i), data); err != nil {
+			return err
+		}
+	}
+	f.n++
+	return nil
+}
+
+func key(n, i int) string {
+	return fmt.Sprintf("%v.%v", n, i)
+}
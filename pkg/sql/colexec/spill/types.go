@@ -0,0 +1,51 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/spillEngine/kv"
+)
+
+const (
+	// BatchRows, number of rows of a spilled batch.
+	BatchRows = 8192
+	// MinBatchRows, number of rows of a spilled batch written under memory pressure.
+	MinBatchRows = 256
+	// Partitions, number of partitions used by the hash based operators.
+	Partitions = 16
+)
+
+var (
+	// Dir, the directory where the temp files are created.
+	Dir = os.TempDir()
+)
+
+// File is a sequence of batches stored in a local temp directory,
+// the batches are read back in the order of writing.
+type File struct {
+	n     int // number of batches written
+	cur   int // the next batch to read
+	rows  int64
+	dir   string
+	db    *kv.KV
+	attrs []string
+	typs  []types.Type
+	refs  []uint64     // reference counts of the vectors, they are not serialized
+	bat   *batch.Batch // rows buffered by Append
+}
@@ -45,7 +45,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	if proc.Reg.InputBatch == nil {
 		reg.Wg.Add(1)
 		reg.Ch <- nil
-		reg.Wg.Wait()
+		proc.WaitReceived(reg)
 		register.FreeRegisters(proc)
 		return true, nil
 	}
@@ -53,7 +53,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	if bat == nil || bat.Attrs == nil {
 		reg.Wg.Add(1)
 		reg.Ch <- bat
-		reg.Wg.Wait()
+		proc.WaitReceived(reg)
 		return false, nil
 	}
 	{
@@ -89,7 +89,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	reg.Ch <- bat
 	n.Proc.Gm.Alloc(size)
 	proc.Gm.Free(size)
-	reg.Wg.Wait()
+	proc.WaitReceived(reg)
 	return false, nil
 }
 
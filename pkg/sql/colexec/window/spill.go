@@ -0,0 +1,135 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"errors"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/hash"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// spill moves the collected rows to the partitions by the hash of the
+// common partition attributes, so every window partition is in one of them.
+func (ctr *Container) spill(proc *process.Process) error {
+	if len(ctr.keys) == 0 {
+		return errors.New("out of memory")
+	}
+	if ctr.parts == nil {
+		ctr.parts = make([]*spill.File, spill.Partitions)
+	}
+	bat := ctr.bat
+	ctr.bat = nil
+	defer bat.Clean(proc)
+	n := bat.Length()
+	hs := make([]uint64, n)
+	ks := make([]uint64, n)
+	for _, key := range ctr.keys {
+		vec := bat.GetVector(key)
+		for i := range ks {
+			ks[i] = 0
+		}
+		hash.Rehash(n, ks, vec)
+		for i := range hs {
+			if vec.Nsp.Contains(uint64(i)) {
+				ks[i] = 0
+			}
+			hs[i] = hs[i]*31 + ks[i]
+		}
+	}
+	for i, h := range hs {
+		j := spill.Index(h)
+		if ctr.parts[j] == nil {
+			f, err := spill.New(ctr.names, ctr.typs)
+			if err != nil {
+				return err
+			}
+			ctr.parts[j] = f
+		}
+		if err := ctr.parts[j].Append(bat.Vecs, int64(i), proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// load reads the rows of a partition back batch by batch.
+func (ctr *Container) load(f *spill.File, proc *process.Process) (*batch.Batch, error) {
+	bat := batch.New(true, ctr.names)
+	for i, typ := range ctr.typs {
+		bat.Vecs[i] = vector.New(typ)
+	}
+	for {
+		b, err := f.Read(proc)
+		if err != nil {
+			bat.Clean(proc)
+			return nil, err
+		}
+		if b == nil {
+			break
+		}
+		for sel, nsel := int64(0), int64(b.Vecs[0].Length()); sel < nsel; sel++ {
+			for i, vec := range bat.Vecs {
+				if err := vec.UnionOne(b.Vecs[i], sel, proc); err != nil {
+					b.Clean(proc)
+					bat.Clean(proc)
+					return nil, err
+				}
+			}
+		}
+		b.Clean(proc)
+	}
+	for i, vec := range bat.Vecs {
+		vec.Ref = ctr.refs[i]
+	}
+	return bat, nil
+}
+
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(proc)
+		ctr.bat = nil
+	}
+	for _, f := range ctr.parts {
+		if f != nil {
+			f.Clean(proc)
+		}
+	}
+	ctr.parts = nil
+	{
+		for _, reg := range proc.Reg.MergeReceivers {
+			if reg.Ch != nil {
+				v := <-reg.Ch
+				switch {
+				case v == nil:
+					reg.Ch = nil
+					reg.Wg.Done()
+				default:
+					bat := v.(*batch.Batch)
+					if bat == nil || bat.Attrs == nil {
+						reg.Ch = nil
+						reg.Wg.Done()
+					} else {
+						bat.Clean(proc)
+						reg.Ch = nil
+						reg.Wg.Done()
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
+)
+
+const (
+	Build = iota
+	Eval
+	End
+)
+
+// window function
+const (
+	RowNumber = iota
+	Rank
+	DenseRank
+	Lag
+	Lead
+	Aggregate
+)
+
+var FuncName = [...]string{
+	RowNumber: "row_number",
+	Rank:      "rank",
+	DenseRank: "dense_rank",
+	Lag:       "lag",
+	Lead:      "lead",
+	Aggregate: "aggregate",
+}
+
+// frame type
+const (
+	Rows = iota
+	Range
+)
+
+// frame bound type, the order of the values matters.
+const (
+	UnboundedPreceding = iota
+	Preceding
+	CurrentRow
+	Following
+	UnboundedFollowing
+)
+
+type Bound struct {
+	Type   int
+	Offset float64
+}
+
+type Frame struct {
+	Type  int
+	Start Bound
+	End   Bound
+}
+
+type Function struct {
+	Op  int
+	Agg aggregation.Extend // aggregation used when Op is Aggregate
+	// Name is the name of the argument attribute, it is
+	// empty for row_number, rank, dense_rank and count(*).
+	Name string
+	// Offset and Default are the parameters of lag and lead,
+	// a nil Default means null.
+	Offset  int64
+	Default *vector.Vector
+	Alias   string
+	Ps      []string      // partition by
+	Os      []order.Field // order by
+	Frame   Frame
+}
+
+type Container struct {
+	state int
+	part  int      // the next partition to evaluate
+	keys  []string // partition attributes shared by all the functions
+	attrs []string // attributes consumed by the functions
+	bat   *batch.Batch
+	// layout of the spilled rows
+	names []string
+	typs  []types.Type
+	refs  []uint64
+	parts []*spill.File
+}
+
+type Argument struct {
+	Fs    []Function
+	Refer map[string]uint64
+	Ctr   Container
+}
+
+// Attributes returns the attributes read by the function.
+func (f Function) Attributes() []string {
+	attrs := make([]string, 0, 1+len(f.Ps)+len(f.Os))
+	if len(f.Name) > 0 {
+		attrs = append(attrs, f.Name)
+	}
+	attrs = append(attrs, f.Ps...)
+	for _, o := range f.Os {
+		attrs = append(attrs, o.Attr)
+	}
+	return attrs
+}
+
+func (f Function) String() string {
+	var s string
+
+	switch f.Op {
+	case Aggregate:
+		s = fmt.Sprintf("%s(%s)", aggregation.AggName[f.Agg.Op], f.Name)
+	case Lag, Lead:
+		s = fmt.Sprintf("%s(%s, %v)", FuncName[f.Op], f.Name, f.Offset)
+	default:
+		s = FuncName[f.Op] + "()"
+	}
+	s += " over ("
+	for i, p := range f.Ps {
+		if i > 0 {
+			s += ", "
+		}
+		s += p
+	}
+	if len(f.Os) > 0 {
+		if len(f.Ps) > 0 {
+			s += " "
+		}
+		s += "order by "
+		for i, o := range f.Os {
+			if i > 0 {
+				s += ", "
+			}
+			s += o.String()
+		}
+	}
+	return fmt.Sprintf("%s) -> %s", s, f.Alias)
+}
@@ -0,0 +1,597 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/partition"
+	"github.com/matrixorigin/matrixone/pkg/sort"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/aggfunc"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"math"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString("ω([")
+	for i, f := range n.Fs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(f.String())
+	}
+	buf.WriteString("])")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	ctr := &n.Ctr
+	ctr.attrs = make([]string, 0, len(n.Fs))
+	for i, f := range n.Fs {
+		ctr.attrs = append(ctr.attrs, f.Attributes()...)
+		if i == 0 {
+			ctr.keys = append(ctr.keys, f.Ps...)
+			continue
+		}
+		keys := ctr.keys[:0]
+		for _, key := range ctr.keys {
+			for _, p := range f.Ps {
+				if p == key {
+					keys = append(keys, key)
+					break
+				}
+			}
+		}
+		ctr.keys = keys
+	}
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := &n.Ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(proc); err != nil {
+				ctr.clean(proc)
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Eval
+		case Eval:
+			bat, err := ctr.next(proc)
+			if err != nil {
+				ctr.clean(proc)
+				ctr.state = End
+				return true, err
+			}
+			if bat == nil {
+				proc.Reg.InputBatch = nil
+				ctr.clean(proc)
+				ctr.state = End
+				return true, nil
+			}
+			if err := ctr.eval(bat, n, proc); err != nil {
+				bat.Clean(proc)
+				ctr.clean(proc)
+				ctr.state = End
+				return true, err
+			}
+			bat.Reduce(ctr.attrs, proc)
+			proc.Reg.InputBatch = bat
+			return false, nil
+		case End:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// build collects all the rows, they are spilled to the local files
+// partitioned by the common partition attributes if the memory used
+// by the process exceeds the limitation.
+func (ctr *Container) build(proc *process.Process) error {
+	for {
+		if len(proc.Reg.MergeReceivers) == 0 {
+			break
+		}
+		for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
+			reg := proc.Reg.MergeReceivers[i]
+			v := <-reg.Ch
+			if v == nil {
+				reg.Ch = nil
+				reg.Wg.Done()
+				proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
+				i--
+				continue
+			}
+			bat := v.(*batch.Batch)
+			if bat == nil || bat.Attrs == nil {
+				reg.Wg.Done()
+				continue
+			}
+			if len(bat.Sels) > 0 {
+				bat.Shuffle(proc)
+			}
+			if ctr.names == nil {
+				ctr.names = append([]string{}, bat.Attrs...)
+				ctr.typs = make([]types.Type, len(bat.Vecs))
+				ctr.refs = make([]uint64, len(bat.Vecs))
+				for i, vec := range bat.Vecs {
+					ctr.typs[i] = vec.Typ
+					ctr.refs[i] = vec.Ref
+				}
+			} else {
+				bat.Reorder(ctr.names)
+			}
+			if ctr.bat == nil {
+				ctr.bat = batch.New(true, ctr.names)
+				for i, typ := range ctr.typs {
+					ctr.bat.Vecs[i] = vector.New(typ)
+				}
+			}
+			for sel, nsel := int64(0), int64(bat.Vecs[0].Length()); sel < nsel; sel++ {
+				for i, vec := range ctr.bat.Vecs {
+					if err := vec.UnionOne(bat.Vecs[i], sel, proc); err != nil {
+						reg.Ch = nil
+						reg.Wg.Done()
+						bat.Clean(proc)
+						return err
+					}
+				}
+			}
+			bat.Clean(proc)
+			if proc.Size() > proc.Lim.Size {
+				if err := ctr.spill(proc); err != nil {
+					reg.Ch = nil
+					reg.Wg.Done()
+					return err
+				}
+			}
+			reg.Wg.Done()
+		}
+	}
+	if ctr.parts != nil {
+		if ctr.bat != nil {
+			if err := ctr.spill(proc); err != nil {
+				return err
+			}
+		}
+		for _, f := range ctr.parts {
+			if f != nil {
+				if err := f.Flush(proc); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// next returns the next batch to be evaluated, it is all the rows
+// if nothing has been spilled, or else the rows of the next partition.
+func (ctr *Container) next(proc *process.Process) (*batch.Batch, error) {
+	if ctr.parts == nil {
+		bat := ctr.bat
+		ctr.bat = nil
+		if bat != nil && bat.Length() == 0 {
+			bat.Clean(proc)
+			return nil, nil
+		}
+		return bat, nil
+	}
+	for ctr.part < len(ctr.parts) {
+		f := ctr.parts[ctr.part]
+		ctr.part++
+		if f == nil || f.Rows() == 0 {
+			continue
+		}
+		bat, err := ctr.load(f, proc)
+		f.Clean(proc)
+		ctr.parts[ctr.part-1] = nil
+		return bat, err
+	}
+	return nil, nil
+}
+
+func (ctr *Container) eval(bat *batch.Batch, n *Argument, proc *process.Process) error {
+	bat.Cow()
+	for _, f := range n.Fs {
+		vec, err := ctr.evalFunction(bat, f, proc)
+		if err != nil {
+			return err
+		}
+		vec.Ref = n.Refer[f.Alias]
+		bat.Attrs = append(bat.Attrs, f.Alias)
+		bat.Vecs = append(bat.Vecs, vec)
+	}
+	return nil
+}
+
+// evalFunction sorts the batch by the partition and order attributes of f,
+// and returns the results of f for all the rows.
+func (ctr *Container) evalFunction(bat *batch.Batch, f Function, proc *process.Process) (*vector.Vector, error) {
+	n := bat.Length()
+	vecs := make([]*vector.Vector, 0, len(f.Ps)+len(f.Os))
+	ds := make([]bool, 0, len(f.Ps)+len(f.Os))
+	for _, p := range f.Ps {
+		vecs = append(vecs, bat.GetVector(p))
+		ds = append(ds, false)
+	}
+	for _, o := range f.Os {
+		vecs = append(vecs, bat.GetVector(o.Attr))
+		ds = append(ds, o.Type == order.Descending)
+	}
+	if len(vecs) > 0 {
+		if err := sortBatch(bat, vecs, ds, proc); err != nil {
+			return nil, err
+		}
+		for i := range vecs {
+			if i < len(f.Ps) {
+				vecs[i] = bat.GetVector(f.Ps[i])
+			} else {
+				vecs[i] = bat.GetVector(f.Os[i-len(f.Ps)].Attr)
+			}
+		}
+	}
+	sels := make([]int64, n)
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	parts, peers := boundaries(sels, vecs, len(f.Ps))
+	switch f.Op {
+	case RowNumber, Rank, DenseRank:
+		return evalRank(f.Op, n, parts, peers, proc)
+	case Lag, Lead:
+		return evalOffset(f, bat.GetVector(f.Name), parts, proc)
+	}
+	var keys []float64
+	if f.Frame.Type == Range && (isOffset(f.Frame.Start) || isOffset(f.Frame.End)) {
+		keys = rangeKeys(vecs[len(vecs)-1], f.Os[len(f.Os)-1].Type == order.Descending)
+	}
+	return evalAggregate(f, bat.GetVector(f.Name), sels, parts, peers, keys, proc)
+}
+
+// sortBatch sorts the rows of bat by vecs, ds[i] is true if vecs[i]
+// is in descending order.
+func sortBatch(bat *batch.Batch, vecs []*vector.Vector, ds []bool, proc *process.Process) error {
+	n := bat.Length()
+	data, err := proc.Alloc(int64(n * 8))
+	if err != nil {
+		return err
+	}
+	sels := encoding.DecodeInt64Slice(data)
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	sortRows(ds[0], sels, vecs[0])
+	ps := make([]int64, 0, 16)
+	diffs := make([]bool, n)
+	for i := 1; i < len(vecs); i++ {
+		ps = partition.Partition(sels, diffs, ps, vecs[i-1])
+		for j, k := 0, len(ps); j < k; j++ {
+			if j == k-1 {
+				sortRows(ds[i], sels[ps[j]:], vecs[i])
+			} else {
+				sortRows(ds[i], sels[ps[j]:ps[j+1]], vecs[i])
+			}
+		}
+	}
+	bat.Sels = sels
+	bat.SelsData = data
+	return bat.Shuffle(proc)
+}
+
+// sortRows sorts the rows sels by vec, the nulls come first in ascending
+// order and last in descending order as in mysql.
+func sortRows(desc bool, sels []int64, vec *vector.Vector) {
+	if !vec.Nsp.Any() {
+		sort.Sort(desc, sels, vec)
+		return
+	}
+	rows := make([]int64, 0, len(sels))
+	nulls := make([]int64, 0, len(sels))
+	for _, sel := range sels {
+		if vec.Nsp.Contains(uint64(sel)) {
+			nulls = append(nulls, sel)
+		} else {
+			rows = append(rows, sel)
+		}
+	}
+	if desc {
+		copy(sels[copy(sels, rows):], nulls)
+		sort.Sort(desc, sels[:len(rows)], vec)
+	} else {
+		copy(sels[copy(sels, nulls):], rows)
+		sort.Sort(desc, sels[len(nulls):], vec)
+	}
+}
+
+// boundaries returns the start of the partition and the end of the
+// partition for each row, and the same for the peers of each row. The
+// first np vectors of vecs are the partition attributes.
+func boundaries(sels []int64, vecs []*vector.Vector, np int) ([][2]int64, [][2]int64) {
+	diffs := make([]bool, len(sels))
+	ps := []int64{0}
+	for _, vec := range vecs[:np] {
+		ps = partition.Partition(sels, diffs, ps, vec)
+	}
+	parts := spans(ps, len(sels))
+	if np == len(vecs) {
+		return parts, parts
+	}
+	for _, vec := range vecs[np:] {
+		ps = partition.Partition(sels, diffs, ps, vec)
+	}
+	return parts, spans(ps, len(sels))
+}
+
+func spans(starts []int64, n int) [][2]int64 {
+	rs := make([][2]int64, n)
+	for i, start := range starts {
+		end := int64(n)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		for j := start; j < end; j++ {
+			rs[j] = [2]int64{start, end}
+		}
+	}
+	return rs
+}
+
+func evalRank(op int, n int, parts, peers [][2]int64, proc *process.Process) (*vector.Vector, error) {
+	data, err := proc.Alloc(int64(n * 8))
+	if err != nil {
+		return nil, err
+	}
+	vs := encoding.DecodeInt64Slice(data)[:n]
+	var dense int64
+	for i := range vs {
+		switch op {
+		case RowNumber:
+			vs[i] = int64(i) - parts[i][0] + 1
+		case Rank:
+			vs[i] = peers[i][0] - parts[i][0] + 1
+		case DenseRank:
+			switch {
+			case int64(i) == parts[i][0]:
+				dense = 1
+			case int64(i) == peers[i][0]:
+				dense++
+			}
+			vs[i] = dense
+		}
+	}
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Col = vs
+	vec.Data = data
+	return vec, nil
+}
+
+func evalOffset(f Function, arg *vector.Vector, parts [][2]int64, proc *process.Process) (*vector.Vector, error) {
+	var err error
+
+	vec := vector.New(arg.Typ)
+	for i, n := int64(0), int64(arg.Length()); i < n; i++ {
+		j := i - f.Offset
+		if f.Op == Lead {
+			j = i + f.Offset
+		}
+		switch {
+		case j >= parts[i][0] && j < parts[i][1]:
+			err = vec.UnionOne(arg, j, proc)
+		case f.Default != nil:
+			err = vec.UnionOne(f.Default, 0, proc)
+		default:
+			err = vec.UnionNull(proc)
+		}
+		if err != nil {
+			vec.Clean(proc)
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
+func evalAggregate(f Function, arg *vector.Vector, sels []int64, parts, peers [][2]int64, keys []float64, proc *process.Process) (*vector.Vector, error) {
+	agg := aggfunc.New(f.Agg, arg.Typ)
+	if agg == nil {
+		return nil, fmt.Errorf("unsupport aggregation operator '%s' for %s", aggregation.AggName[f.Agg.Op], arg.Typ)
+	}
+	vec := vector.New(agg.Type())
+	// filled is the end of the rows filled into agg, it is only
+	// used when the frame starts at the beginning of the partition.
+	var filled int64
+	for i, n := int64(0), int64(len(sels)); i < n; i++ {
+		start, end := frame(f.Frame, i, parts[i], peers[i], keys)
+		if f.Frame.Start.Type == UnboundedPreceding && i != parts[i][0] {
+			if end > filled {
+				if err := agg.Fill(sels[filled:end], arg); err != nil {
+					vec.Clean(proc)
+					return nil, err
+				}
+				filled = end
+			}
+		} else {
+			agg.Reset()
+			filled = start
+			if start < end {
+				if err := agg.Fill(sels[start:end], arg); err != nil {
+					vec.Clean(proc)
+					return nil, err
+				}
+				filled = end
+			}
+		}
+		w, err := agg.EvalCopy(proc)
+		if err != nil {
+			vec.Clean(proc)
+			return nil, err
+		}
+		err = vec.UnionOne(w, 0, proc)
+		w.Clean(proc)
+		if err != nil {
+			vec.Clean(proc)
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
+// frame returns the rows [start, end) in the frame of the row i.
+func frame(f Frame, i int64, part, peer [2]int64, keys []float64) (int64, int64) {
+	var start, end int64
+
+	switch f.Start.Type {
+	case UnboundedPreceding:
+		start = part[0]
+	case CurrentRow:
+		start = i
+		if f.Type == Range {
+			start = peer[0]
+		}
+	case Preceding, Following:
+		if f.Type == Rows {
+			start = i + int64(signed(f.Start))
+		} else {
+			start = search(keys, part, keys[i]+signed(f.Start), false)
+		}
+	}
+	switch f.End.Type {
+	case UnboundedFollowing:
+		end = part[1]
+	case CurrentRow:
+		end = i + 1
+		if f.Type == Range {
+			end = peer[1]
+		}
+	case Preceding, Following:
+		if f.Type == Rows {
+			end = i + int64(signed(f.End)) + 1
+		} else {
+			end = search(keys, part, keys[i]+signed(f.End), true)
+		}
+	}
+	if start < part[0] {
+		start = part[0]
+	}
+	if end > part[1] {
+		end = part[1]
+	}
+	if start > end {
+		start = end
+	}
+	return start, end
+}
+
+func isOffset(b Bound) bool {
+	return b.Type == Preceding || b.Type == Following
+}
+
+// signed returns the offset of the bound, which is negative if it precedes.
+func signed(b Bound) float64 {
+	if b.Type == Preceding {
+		return -b.Offset
+	}
+	return b.Offset
+}
+
+// search returns the first row of the partition whose key is not less than
+// v, or the first row whose key is greater than v if after is true.
+func search(keys []float64, part [2]int64, v float64, after bool) int64 {
+	lo, hi := part[0], part[1]
+	for lo < hi {
+		mid := int64(uint64(lo+hi) >> 1)
+		if keys[mid] < v || (after && keys[mid] == v) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// rangeKeys returns the keys of the range frame, the keys are negated if
+// they are in descending order, so the keys are always in ascending order.
+func rangeKeys(vec *vector.Vector, desc bool) []float64 {
+	keys := make([]float64, vec.Length())
+	switch vs := vec.Col.(type) {
+	case []int8:
+		for i, v := range vs {
+			keys[i] = float64(v)
+		}
+	case []int16:
+		for i, v := range vs {
+			keys[i] = float64(v)
+		}
+	case []int32:
+		for i, v := range vs {
+			keys[i] = float64(v)
+		}
+	case []int64:
+		for i, v := range vs {
+			keys[i] = float64(v)
+		}
+	case []uint8:
+		for i, v := range vs {
+			keys[i] = float64(v)
+		}
+	case []uint16:
+		for i, v := range vs {
+			keys[i] = float64(v)
+		}
+	case []uint32:
+		for i, v := range vs {
+			keys[i] = float64(v)
+		}
+	case []uint64:
+		for i, v := range vs {
+			keys[i] = float64(v)
+		}
+	case []float32:
+		for i, v := range vs {
+			keys[i] = float64(v)
+		}
+	case []float64:
+		copy(keys, vs)
+	case []types.Date:
+		for i, v := range vs {
+			keys[i] = float64(v)
+		}
+	}
+	if vec.Nsp.Any() {
+		// the nulls are only in the range of the nulls
+		for i := range keys {
+			if vec.Nsp.Contains(uint64(i)) {
+				keys[i] = math.Inf(-1)
+			}
+		}
+	}
+	if desc {
+		for i := range keys {
+			keys[i] = -keys[i]
+		}
+	}
+	return keys
+}
@@ -25,10 +25,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/build"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/alterTable"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/analyzeTable"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/createDatabase"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/createIndex"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/createTable"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/dedup"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/delete"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/dropDatabase"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/dropIndex"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/dropTable"
@@ -40,14 +43,19 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/op/naturalJoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/outerJoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/relation"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/showDatabases"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/showStats"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/showTables"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/summarize"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/union"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/update"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/opt"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -69,10 +77,22 @@ func New(db string, sql string, uid string,
 	}
 }
 
+// SetParams binds the values to the placeholders of a prepared statement.
+func (c *compile) SetParams(params []tree.Expr) {
+	c.params = params
+}
+
 // Build generates query execution list based on the result of sql parser.
 func (c *compile) Build() ([]*Exec, error) {
+	var err error
+	var stmts []tree.Statement
+
 	// stmts, err := tree.NewParser().Parse(c.sql)
-	stmts, err := parsers.Parse(dialect.MYSQL, c.sql)
+	if c.params != nil {
+		stmts, err = parsers.ParseWithParams(dialect.MYSQL, c.sql, c.params)
+	} else {
+		stmts, err = parsers.Parse(dialect.MYSQL, c.sql)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -93,14 +113,13 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 	// AST rewrite
 	e.stmt = rw.AstRewrite(e.stmt)
 	// generates relation algebra operator chain.
-	o, err := build.New(e.c.db, e.c.sql, e.c.e, e.c.proc).BuildStatement(e.stmt)
+	o, err := build.New(e.c.db, e.c.sql, e.c.e, e.c.proc, e.c.execute).BuildStatement(e.stmt)
 	if err != nil {
 		return err
 	}
-	// remove useless operators.
-	o = prune(o)
-	// optimize is not implemented for now.
-	o = opt.Optimize(rewrite(o, mergeCount(o, 0)))
+	// optimize the relation algebra operator chain.
+	o = opt.Optimize(o)
+	o = rewrite(o, mergeCount(o, 0))
 	if o == nil {
 		e.u = u
 		e.e = e.c.e
@@ -142,6 +161,20 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 			cs = append(cs, &Col{Typ: types.T_varchar, Name: "Key"})
 			cs = append(cs, &Col{Typ: types.T_varchar, Name: "Default"})
 			cs = append(cs, &Col{Typ: types.T_varchar, Name: "Extra"})
+		case *showStats.ShowStats:
+			cs = append(cs, &Col{Typ: types.T_varchar, Name: "Table"})
+			cs = append(cs, &Col{Typ: types.T_varchar, Name: "Column"})
+			cs = append(cs, &Col{Typ: types.T_int64, Name: "Row_count"})
+			cs = append(cs, &Col{Typ: types.T_int64, Name: "Null_count"})
+			cs = append(cs, &Col{Typ: types.T_int64, Name: "Distinct_count"})
+			cs = append(cs, &Col{Typ: types.T_varchar, Name: "Min_value"})
+			cs = append(cs, &Col{Typ: types.T_varchar, Name: "Max_value"})
+			cs = append(cs, &Col{Typ: types.T_int64, Name: "Buckets"})
+		case *analyzeTable.AnalyzeTable:
+			cs = append(cs, &Col{Typ: types.T_varchar, Name: "Table"})
+			cs = append(cs, &Col{Typ: types.T_varchar, Name: "Op"})
+			cs = append(cs, &Col{Typ: types.T_varchar, Name: "Msg_type"})
+			cs = append(cs, &Col{Typ: types.T_varchar, Name: "Msg_text"})
 		}
 	}
 	e.u = u
@@ -216,6 +249,26 @@ func (e *Exec) Run(ts uint64) error {
 				}
 				wg.Done()
 			}(e.scopes[i])
+		case Delete:
+			wg.Add(1)
+			go func(s *Scope) {
+				if rows, err := s.Delete(ts); err != nil {
+					e.err = err
+				} else {
+					e.SetAffectedRows(rows)
+				}
+				wg.Done()
+			}(e.scopes[i])
+		case Update:
+			wg.Add(1)
+			go func(s *Scope) {
+				if rows, err := s.Update(ts); err != nil {
+					e.err = err
+				} else {
+					e.SetAffectedRows(rows)
+				}
+				wg.Done()
+			}(e.scopes[i])
 		case Explain:
 			wg.Add(1)
 			go func(s *Scope) {
@@ -296,6 +349,30 @@ func (e *Exec) Run(ts uint64) error {
 				}
 				wg.Done()
 			}(e.scopes[i])
+		case AlterTable:
+			wg.Add(1)
+			go func(s *Scope) {
+				if err := s.AlterTable(ts); err != nil {
+					e.err = err
+				}
+				wg.Done()
+			}(e.scopes[i])
+		case AnalyzeTable:
+			wg.Add(1)
+			go func(s *Scope) {
+				if err := s.AnalyzeTable(ts, e.u, e.fill); err != nil {
+					e.err = err
+				}
+				wg.Done()
+			}(e.scopes[i])
+		case ShowStats:
+			wg.Add(1)
+			go func(s *Scope) {
+				if err := s.ShowStats(e.u, e.fill); err != nil {
+					e.err = err
+				}
+				wg.Done()
+			}(e.scopes[i])
 		}
 	}
 
@@ -308,6 +385,10 @@ func (c *compile) compileAlgebra(o op.OP) ([]*Scope, error) {
 	switch n := o.(type) {
 	case *insert.Insert:
 		return []*Scope{{Magic: Insert, Operator: o}}, nil
+	case *delete.Delete:
+		return []*Scope{{Magic: Delete, Operator: o, Proc: c.mutationProcess()}}, nil
+	case *update.Update:
+		return []*Scope{{Magic: Update, Operator: o, Proc: c.mutationProcess()}}, nil
 	case *explain.Explain:
 		return []*Scope{{Magic: Explain, Operator: o}}, nil
 	case *dropTable.DropTable:
@@ -328,6 +409,12 @@ func (c *compile) compileAlgebra(o op.OP) ([]*Scope, error) {
 		return []*Scope{{Magic: CreateIndex, Operator: o}}, nil
 	case *dropIndex.DropIndex:
 		return []*Scope{{Magic: DropIndex, Operator: o}}, nil
+	case *alterTable.AlterTable:
+		return []*Scope{{Magic: AlterTable, Operator: o}}, nil
+	case *analyzeTable.AnalyzeTable:
+		return []*Scope{{Magic: AnalyzeTable, Operator: o}}, nil
+	case *showStats.ShowStats:
+		return []*Scope{{Magic: ShowStats, Operator: o}}, nil
 	case *projection.Projection:
 		return c.compileOutput(n, make(map[string]uint64))
 	case *top.Top:
@@ -358,14 +445,20 @@ func (c *compile) compile(o op.OP, mp map[string]uint64) ([]*Scope, error) {
 		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%s' unsupprt now", o))
 	case *innerJoin.Join:
 		return c.compileInnerJoin(n, mp)
+	case *outerJoin.Join:
+		return c.compileOuterJoin(n, mp)
 	case *naturalJoin.Join:
 		return nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%s' unsupprt now", o))
+	case *union.Union:
+		return c.compileUnion(n, mp)
 	case *relation.Relation:
 		return c.compileRelation(n, mp)
 	case *restrict.Restrict:
 		return c.compileRestrict(n, mp)
 	case *summarize.Summarize:
 		return c.compileSummarize(n, mp)
+	case *window.Window:
+		return c.compileWindow(n, mp)
 	case *projection.Projection:
 		return c.compileProjection(n, mp)
 	}
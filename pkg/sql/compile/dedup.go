@@ -20,7 +20,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/transfer"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/dedup"
 	"github.com/matrixorigin/matrixone/pkg/vm"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"sync"
 )
@@ -42,7 +41,7 @@ func (c *compile) compileDedup(o *dedup.Dedup, mp map[string]uint64) ([]*Scope,
 		}
 	}
 	rs := new(Scope)
-	rs.Proc = process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+	rs.Proc = process.NewFromProc(c.proc)
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
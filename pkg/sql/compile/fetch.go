@@ -22,7 +22,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/op/limit"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/offset"
 	"github.com/matrixorigin/matrixone/pkg/vm"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"sync"
 )
@@ -34,7 +33,7 @@ func (c *compile) compileFetch(o *limit.Limit, mp map[string]uint64) ([]*Scope,
 		return nil, err
 	}
 	rs := new(Scope)
-	rs.Proc = process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+	rs.Proc = process.NewFromProc(c.proc)
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
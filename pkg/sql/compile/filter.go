@@ -0,0 +1,487 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"bytes"
+	"errors"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"math"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+)
+
+// errNullFilter is returned by the indexes for the filters of isNull and
+// isNotNull, which are only answered by the summarizers.
+var errNullFilter = errors.New("null filters are not supported by the indexes")
+
+// swapOps maps a comparison operator to the one used when its operands are swapped.
+var swapOps = map[int]int{
+	overload.EQ: overload.EQ,
+	overload.NE: overload.NE,
+	overload.LT: overload.GT,
+	overload.LE: overload.GE,
+	overload.GT: overload.LT,
+	overload.GE: overload.LE,
+}
+
+// filterSegment is a segment whose blocks and rows are restricted
+// to the ones which may satisfy the filters.
+type filterSegment struct {
+	engine.Segment
+	ids  []string
+	sels map[string][]int64
+	proc *process.Process
+}
+
+// filterBlock is a block which only returns the rows of sels.
+type filterBlock struct {
+	engine.Block
+	sels []int64
+	proc *process.Process
+}
+
+// extractFilters returns the conjuncts of e which compare an attribute
+// of the relation with a constant of the same type.
+func extractFilters(e extend.Extend, attrs map[string]types.Type) []*Filter {
+	var fs []*Filter
+
+	for _, e := range extend.AndExtends(e, []extend.Extend{}) {
+		if f := newFilter(e, attrs); f != nil {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// exactFilters returns the filters of e if every conjunct of e
+// can be converted into a filter.
+func exactFilters(e extend.Extend, attrs map[string]types.Type) ([]*Filter, bool) {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		return exactFilters(v.E, attrs)
+	case *extend.BinaryExtend:
+		if v.Op == overload.And {
+			lfs, ok := exactFilters(v.Left, attrs)
+			if !ok {
+				return nil, false
+			}
+			rfs, ok := exactFilters(v.Right, attrs)
+			if !ok {
+				return nil, false
+			}
+			return append(lfs, rfs...), true
+		}
+	}
+	if f := newFilter(e, attrs); f != nil {
+		return []*Filter{f}, true
+	}
+	return nil, false
+}
+
+func newFilter(e extend.Extend, attrs map[string]types.Type) *Filter {
+	if v, ok := e.(*extend.MultiExtend); ok {
+		if v.Op == overload.IsNull || v.Op == overload.IsNotNull {
+			return newNullFilter(v, attrs)
+		}
+		return newSetFilter(v, attrs)
+	}
+	v, ok := e.(*extend.BinaryExtend)
+	if !ok {
+		return nil
+	}
+	if _, ok = swapOps[v.Op]; !ok {
+		return nil
+	}
+	op := v.Op
+	attr, ok := stripParen(v.Left).(*extend.Attribute)
+	val, isValue := stripParen(v.Right).(*extend.ValueExtend)
+	if !ok || !isValue {
+		if attr, ok = stripParen(v.Right).(*extend.Attribute); !ok {
+			return nil
+		}
+		if val, ok = stripParen(v.Left).(*extend.ValueExtend); !ok {
+			return nil
+		}
+		op = swapOps[op]
+	}
+	typ, ok := attrs[attr.Name]
+	if !ok {
+		return nil
+	}
+	x, ok := filterValue(val.V, typ.Oid)
+	if !ok {
+		return nil
+	}
+	return &Filter{Op: op, Attr: attr.Name, Val: x}
+}
+
+// newNullFilter returns the filter of isNull and isNotNull whose argument
+// is an attribute of the relation.
+func newNullFilter(v *extend.MultiExtend, attrs map[string]types.Type) *Filter {
+	attr, ok := stripParen(v.Args[0]).(*extend.Attribute)
+	if !ok {
+		return nil
+	}
+	if _, ok := attrs[attr.Name]; !ok {
+		return nil
+	}
+	return &Filter{Op: v.Op, Attr: attr.Name}
+}
+
+// newSetFilter returns the filter of in and notIn whose probe is an attribute
+// of the relation and whose set has a single column without nulls.
+func newSetFilter(v *extend.MultiExtend, attrs map[string]types.Type) *Filter {
+	if (v.Op != overload.In && v.Op != overload.NotIn) || len(v.Args) != 2 {
+		return nil
+	}
+	attr, ok := stripParen(v.Args[0]).(*extend.Attribute)
+	if !ok {
+		return nil
+	}
+	set, ok := v.Args[1].(*extend.ValueExtend)
+	if !ok || set.V.Nsp.Any() {
+		return nil
+	}
+	typ, ok := attrs[attr.Name]
+	if !ok || typ.Oid != set.V.Typ.Oid {
+		return nil
+	}
+	var vals []interface{}
+	switch vs := set.V.Col.(type) {
+	case []int8:
+		for _, x := range vs {
+			vals = append(vals, x)
+		}
+	case []int16:
+		for _, x := range vs {
+			vals = append(vals, x)
+		}
+	case []int32:
+		for _, x := range vs {
+			vals = append(vals, x)
+		}
+	case []int64:
+		for _, x := range vs {
+			vals = append(vals, x)
+		}
+	case []uint8:
+		for _, x := range vs {
+			vals = append(vals, x)
+		}
+	case []uint16:
+		for _, x := range vs {
+			vals = append(vals, x)
+		}
+	case []uint32:
+		for _, x := range vs {
+			vals = append(vals, x)
+		}
+	case []uint64:
+		for _, x := range vs {
+			vals = append(vals, x)
+		}
+	case []float32:
+		for _, x := range vs {
+			vals = append(vals, x)
+		}
+	case []float64:
+		for _, x := range vs {
+			vals = append(vals, x)
+		}
+	default: // the values of the set are the keys of a map in the indexes
+		return nil
+	}
+	if len(vals) == 0 {
+		return nil
+	}
+	return &Filter{Op: v.Op, Attr: attr.Name, Vals: vals}
+}
+
+// filterValue converts the constant of vec into a value of type typ,
+// constants which can not be converted without loss are rejected.
+func filterValue(vec *vector.Vector, typ types.T) (interface{}, bool) {
+	if vec.Length() != 1 || vec.Nsp.Any() {
+		return nil, false
+	}
+	switch vs := vec.Col.(type) {
+	case []int64:
+		return intValue(vs[0], typ)
+	case []float64:
+		v := vs[0]
+		switch typ {
+		case types.T_float32:
+			if float64(float32(v)) == v {
+				return float32(v), true
+			}
+			return nil, false
+		case types.T_float64:
+			return v, true
+		}
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return nil, false
+		}
+		return intValue(int64(v), typ)
+	case *types.Bytes:
+		switch typ {
+		case types.T_char, types.T_varchar:
+			return append([]byte{}, vs.Get(0)...), true
+		case types.T_date:
+			v, err := types.ParseDate(string(vs.Get(0)))
+			return v, err == nil
+		case types.T_datetime:
+			v, err := types.ParseDatetime(string(vs.Get(0)))
+			return v, err == nil
+		}
+	case []types.Date:
+		if typ == types.T_date {
+			return vs[0], true
+		}
+	case []types.Datetime:
+		if typ == types.T_datetime {
+			return vs[0], true
+		}
+	}
+	return nil, false
+}
+
+func intValue(v int64, typ types.T) (interface{}, bool) {
+	switch typ {
+	case types.T_int8:
+		return int8(v), v >= math.MinInt8 && v <= math.MaxInt8
+	case types.T_int16:
+		return int16(v), v >= math.MinInt16 && v <= math.MaxInt16
+	case types.T_int32:
+		return int32(v), v >= math.MinInt32 && v <= math.MaxInt32
+	case types.T_int64:
+		return v, true
+	case types.T_uint8:
+		return uint8(v), v >= 0 && v <= math.MaxUint8
+	case types.T_uint16:
+		return uint16(v), v >= 0 && v <= math.MaxUint16
+	case types.T_uint32:
+		return uint32(v), v >= 0 && v <= math.MaxUint32
+	case types.T_uint64:
+		return uint64(v), v >= 0
+	case types.T_float32:
+		return float32(v), int64(float32(v)) == v
+	case types.T_float64:
+		return float64(v), int64(float64(v)) == v
+	}
+	return nil, false
+}
+
+func stripParen(e extend.Extend) extend.Extend {
+	if v, ok := e.(*extend.ParenExtend); ok {
+		return stripParen(v.E)
+	}
+	return e
+}
+
+// newFilterSegment returns a segment which skips the blocks and rows of seg
+// which can not satisfy fs according to the indexes of seg. The filters are
+// only hints, filters which can not be answered by the indexes are ignored,
+// so the conditions must be evaluated again over the rows read.
+func newFilterSegment(seg engine.Segment, fs []*Filter, proc *process.Process) engine.Segment {
+	if len(fs) == 0 {
+		return seg
+	}
+	ids := seg.Blocks()
+	if sm := seg.NewSummarizer(); sm != nil {
+		for _, f := range fs {
+			if ok, err := nullFilter(sm, f); err == nil && !ok {
+				ids = nil
+				break
+			}
+		}
+	}
+	if sf := seg.NewSparseFilter(); sf != nil && len(ids) > 0 {
+		for _, f := range fs {
+			if xs, err := sparseFilter(sf, f); err == nil {
+				ids = intersect(ids, xs)
+			}
+		}
+	}
+	s := &filterSegment{
+		Segment: seg,
+		ids:     ids,
+		proc:    proc,
+	}
+	var bm *roaring.Bitmap
+	if df := seg.NewFilter(); df != nil && len(ids) > 0 {
+		for _, f := range fs {
+			if x, err := denseFilter(df, f); err == nil {
+				if bm == nil {
+					bm = x
+				} else {
+					bm.And(x)
+				}
+			}
+		}
+	}
+	if bm != nil {
+		s.selects(bm)
+	}
+	return s
+}
+
+func (s *filterSegment) Blocks() []string {
+	return s.ids
+}
+
+func (s *filterSegment) Block(id string, proc *process.Process) engine.Block {
+	blk := s.Segment.Block(id, proc)
+	if sels, ok := s.sels[id]; ok && blk != nil {
+		return &filterBlock{Block: blk, sels: sels, proc: s.proc}
+	}
+	return blk
+}
+
+// selects converts the row numbers of the segment into the row numbers
+// of blocks and removes the blocks which have no rows left.
+func (s *filterSegment) selects(bm *roaring.Bitmap) {
+	var start uint64
+
+	ok := make(map[string]struct{})
+	for _, id := range s.ids {
+		ok[id] = struct{}{}
+	}
+	sels := make(map[string][]int64)
+	itr := bm.Iterator()
+	for _, id := range s.Segment.Blocks() {
+		blk := s.Segment.Block(id, s.proc)
+		if blk == nil || blk.Rows() < 0 {
+			return
+		}
+		end := start + uint64(blk.Rows())
+		var xs []int64
+		for itr.HasNext() && itr.PeekNext() < end {
+			if row := itr.Next(); row >= start {
+				xs = append(xs, int64(row-start))
+			}
+		}
+		if _, found := ok[id]; found && int64(len(xs)) < blk.Rows() {
+			sels[id] = xs
+		}
+		start = end
+	}
+	ids := s.ids[:0:0]
+	for _, id := range s.ids {
+		if xs, found := sels[id]; !found || len(xs) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	s.ids, s.sels = ids, sels
+}
+
+// Read passes the selected rows to the block if it can read them alone,
+// otherwise the rows not selected are removed after the block is read.
+func (b *filterBlock) Read(cs []uint64, attrs []string, compressed []*bytes.Buffer, deCompressed []*bytes.Buffer) (*batch.Batch, error) {
+	if sb, ok := b.Block.(engine.SelectBlock); ok {
+		return sb.ReadSels(b.sels, cs, attrs, compressed, deCompressed)
+	}
+	bat, err := b.Block.Read(cs, attrs, compressed, deCompressed)
+	if err != nil {
+		return nil, err
+	}
+	for i, vec := range bat.Vecs {
+		if bat.Vecs[i], err = vec.Shuffle(b.sels, b.proc); err != nil {
+			bat.Clean(b.proc)
+			return nil, err
+		}
+	}
+	return bat, nil
+}
+
+// nullFilter tells whether some rows of a segment may satisfy the filter,
+// filters of isNull and isNotNull are answered by the number of nulls.
+func nullFilter(sm engine.Summarizer, f *Filter) (bool, error) {
+	switch f.Op {
+	case overload.IsNull:
+		n, err := sm.NullCount(f.Attr, nil)
+		return n > 0, err
+	case overload.IsNotNull:
+		n, err := sm.Count(f.Attr, nil)
+		return n > 0, err
+	}
+	return true, nil
+}
+
+func sparseFilter(sf engine.SparseFilter, f *Filter) ([]string, error) {
+	switch f.Op {
+	case overload.IsNull, overload.IsNotNull:
+		return nil, errNullFilter
+	case overload.EQ:
+		return sf.Eq(f.Attr, f.Val)
+	case overload.NE:
+		return sf.Ne(f.Attr, f.Val)
+	case overload.LT:
+		return sf.Lt(f.Attr, f.Val)
+	case overload.LE:
+		return sf.Le(f.Attr, f.Val)
+	case overload.GT:
+		return sf.Gt(f.Attr, f.Val)
+	case overload.In:
+		return sf.In(f.Attr, f.Vals)
+	case overload.NotIn:
+		return sf.NotIn(f.Attr, f.Vals)
+	default:
+		return sf.Ge(f.Attr, f.Val)
+	}
+}
+
+func denseFilter(df engine.Filter, f *Filter) (*roaring.Bitmap, error) {
+	switch f.Op {
+	case overload.IsNull, overload.IsNotNull:
+		return nil, errNullFilter
+	case overload.EQ:
+		return df.Eq(f.Attr, f.Val)
+	case overload.NE:
+		return df.Ne(f.Attr, f.Val)
+	case overload.LT:
+		return df.Lt(f.Attr, f.Val)
+	case overload.LE:
+		return df.Le(f.Attr, f.Val)
+	case overload.GT:
+		return df.Gt(f.Attr, f.Val)
+	case overload.In:
+		return df.In(f.Attr, f.Vals)
+	case overload.NotIn:
+		return df.NotIn(f.Attr, f.Vals)
+	default:
+		return df.Ge(f.Attr, f.Val)
+	}
+}
+
+// intersect returns the ids of xs which are in ys, the order of xs is kept.
+func intersect(xs, ys []string) []string {
+	mp := make(map[string]struct{}, len(ys))
+	for _, y := range ys {
+		mp[y] = struct{}{}
+	}
+	rs := make([]string, 0, len(xs))
+	for _, x := range xs {
+		if _, ok := mp[x]; ok {
+			rs = append(rs, x)
+		}
+	}
+	return rs
+}
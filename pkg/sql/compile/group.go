@@ -20,7 +20,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/transfer"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/group"
 	"github.com/matrixorigin/matrixone/pkg/vm"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"sync"
 )
@@ -51,7 +50,7 @@ func (c *compile) compileGroup(o *group.Group, mp map[string]uint64) ([]*Scope,
 		return nil, err
 	}
 	rs := new(Scope)
-	rs.Proc = process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+	rs.Proc = process.NewFromProc(c.proc)
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
@@ -18,71 +18,61 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/inner"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/transfer"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/innerJoin"
 	"github.com/matrixorigin/matrixone/pkg/vm"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"strings"
 	"sync"
 )
 
 func (c *compile) compileInnerJoin(o *innerJoin.Join, mp map[string]uint64) ([]*Scope, error) {
-	rmp, smp := make(map[string]uint64), make(map[string]uint64)
-	{
-		ap := o.R.Attribute()
-		for k, v := range mp {
-			ss := strings.Split(k, ".")
-			if ss[0] != o.R.String() {
-				continue
-			}
-			if _, ok := ap[ss[1]]; ok {
-				rmp[ss[1]] = v
-			}
-		}
-		for _, attr := range o.Rattrs {
-			rmp[attr]++
-		}
-	}
-	{
-		ap := o.S.Attribute()
-		for k, v := range mp {
-			ss := strings.Split(k, ".")
-			if ss[0] != o.S.String() {
-				continue
-			}
-			if _, ok := ap[ss[1]]; ok {
-				smp[ss[1]] = v
-			}
-		}
-		for _, attr := range o.Sattrs {
-			smp[attr]++
-		}
-	}
-	rs, err := c.compile(o.R, rmp)
+	s, _, _, err := c.compileJoin(o.R, o.S, o.Rattrs, o.Sattrs, mp)
 	if err != nil {
 		return nil, err
 	}
-	ss, err := c.compile(o.S, smp)
+	s.Instructions = append(s.Instructions, vm.Instruction{
+		Code: vm.BagInnerJoin,
+		Arg: &inner.Argument{
+			Rattrs: o.Rattrs,
+			Sattrs: o.Sattrs,
+			R:      o.R.Name(),
+			S:      o.S.Name(),
+		},
+	})
+	return []*Scope{s}, nil
+}
+
+// compileJoin generates the merge scope of a join, the output of r is sent to
+// the first merge receiver and the output of s is sent to the second one.
+// It also returns the reference count of the attributes of r and s.
+func (c *compile) compileJoin(r, s op.OP, rattrs, sattrs []string, mp map[string]uint64) (*Scope, map[string]uint64, map[string]uint64, error) {
+	rmp, smp := inputReferences(r, rattrs, mp), inputReferences(s, sattrs, mp)
+	rs, err := c.compile(r, rmp)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ss, err := c.compile(s, smp)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
-	s := new(Scope)
-	s.Proc = process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
-	s.Proc.Lim = c.proc.Lim
-	s.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
+	js := new(Scope)
+	js.Proc = process.NewFromProc(c.proc)
+	js.Proc.Lim = c.proc.Lim
+	js.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
 	{
-		s.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		js.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Wg: new(sync.WaitGroup),
 			Ch: make(chan interface{}, 8),
 		}
-		s.Proc.Reg.MergeReceivers[1] = &process.WaitRegister{
+		js.Proc.Reg.MergeReceivers[1] = &process.WaitRegister{
 			Wg: new(sync.WaitGroup),
 			Ch: make(chan interface{}, 8),
 		}
 	}
 	rms := new(Scope)
 	{
-		rms.Proc = process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+		rms.Proc = process.NewFromProc(c.proc)
 		rms.Proc.Lim = c.proc.Lim
 		rms.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(rs))
 		{
@@ -113,14 +103,14 @@ func (c *compile) compileInnerJoin(o *innerJoin.Join, mp map[string]uint64) ([]*
 		rms.Instructions = append(rms.Instructions, vm.Instruction{
 			Code: vm.Transfer,
 			Arg: &transfer.Argument{
-				Proc: s.Proc,
-				Reg:  s.Proc.Reg.MergeReceivers[0],
+				Proc: js.Proc,
+				Reg:  js.Proc.Reg.MergeReceivers[0],
 			},
 		})
 	}
 	sms := new(Scope)
 	{
-		sms.Proc = process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+		sms.Proc = process.NewFromProc(c.proc)
 		sms.Proc.Lim = c.proc.Lim
 		sms.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
@@ -151,21 +141,36 @@ func (c *compile) compileInnerJoin(o *innerJoin.Join, mp map[string]uint64) ([]*
 		sms.Instructions = append(sms.Instructions, vm.Instruction{
 			Code: vm.Transfer,
 			Arg: &transfer.Argument{
-				Proc: s.Proc,
-				Reg:  s.Proc.Reg.MergeReceivers[1],
+				Proc: js.Proc,
+				Reg:  js.Proc.Reg.MergeReceivers[1],
 			},
 		})
 	}
-	s.Magic = Merge
-	s.PreScopes = []*Scope{rms, sms}
-	s.Instructions = append(s.Instructions, vm.Instruction{
-		Code: vm.BagInnerJoin,
-		Arg: &inner.Argument{
-			Rattrs: o.Rattrs,
-			Sattrs: o.Sattrs,
-			R:      o.R.String(),
-			S:      o.S.String(),
-		},
-	})
-	return []*Scope{s}, nil
+	js.Magic = Merge
+	js.PreScopes = []*Scope{rms, sms}
+	return js, rmp, smp, nil
+}
+
+// inputReferences returns the reference count of the attributes of a join
+// input, the attributes of an unnamed input are qualified by the inputs
+// below it and are seen by the parent of the join as they are.
+func inputReferences(o op.OP, attrs []string, mp map[string]uint64) map[string]uint64 {
+	rmp := make(map[string]uint64)
+	ap := o.Attribute()
+	for k, v := range mp {
+		if name := o.Name(); len(name) > 0 {
+			ss := strings.Split(k, ".")
+			if ss[0] != name {
+				continue
+			}
+			k = ss[1]
+		}
+		if _, ok := ap[k]; ok {
+			rmp[k] = v
+		}
+	}
+	for _, attr := range attrs {
+		rmp[attr]++
+	}
+	return rmp
 }
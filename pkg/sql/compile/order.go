@@ -21,7 +21,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/op/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
 	"github.com/matrixorigin/matrixone/pkg/vm"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"sync"
 )
@@ -46,7 +45,7 @@ func (c *compile) compileOrderOutput(o *order.Order, mp map[string]uint64) ([]*S
 		}
 	}
 	rs := new(Scope)
-	rs.Proc = process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+	rs.Proc = process.NewFromProc(c.proc)
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
@@ -112,7 +111,7 @@ func (c *compile) compileOrder(o *order.Order, mp map[string]uint64) ([]*Scope,
 		}
 	}
 	rs := new(Scope)
-	rs.Proc = process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+	rs.Proc = process.NewFromProc(c.proc)
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
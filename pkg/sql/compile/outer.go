@@ -0,0 +1,68 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/outer"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/outerJoin"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+)
+
+// compileOuterJoin compiles the outer join, the right outer join is
+// compiled as a left outer join with the two relations swapped.
+func (c *compile) compileOuterJoin(o *outerJoin.Join, mp map[string]uint64) ([]*Scope, error) {
+	r, s, rattrs, sattrs := o.R, o.S, o.Rattrs, o.Sattrs
+	if o.Type == outerJoin.Right {
+		r, s, rattrs, sattrs = o.S, o.R, o.Sattrs, o.Rattrs
+	}
+	if o.Cond != nil {
+		for _, attr := range o.Cond.Attributes() {
+			mp[attr]++
+		}
+	}
+	js, rmp, smp, err := c.compileJoin(r, s, rattrs, sattrs, mp)
+	if err != nil {
+		return nil, err
+	}
+	js.Instructions = append(js.Instructions, vm.Instruction{
+		Code: vm.BagOuterJoin,
+		Arg: &outer.Argument{
+			Full:   o.Type == outerJoin.Full,
+			R:      r.Name(),
+			S:      s.Name(),
+			Rattrs: rattrs,
+			Sattrs: sattrs,
+			Cond:   o.Cond,
+			Rrefer: rmp,
+			Srefer: smp,
+			Rtyps:  attributeTypes(r, rmp),
+			Styps:  attributeTypes(s, smp),
+		},
+	})
+	return []*Scope{js}, nil
+}
+
+func attributeTypes(o op.OP, mp map[string]uint64) map[string]types.Type {
+	typs := make(map[string]types.Type)
+	ap := o.Attribute()
+	for attr := range mp {
+		if typ, ok := ap[attr]; ok {
+			typs[attr] = typ
+		}
+	}
+	return typs
+}
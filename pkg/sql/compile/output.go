@@ -19,7 +19,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/transfer"
 	"github.com/matrixorigin/matrixone/pkg/vm"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"sync"
 )
@@ -46,7 +45,7 @@ func fillOutput(ss []*Scope, arg *output.Argument, proc *process.Process) []*Sco
 	}
 	// unreached code for now
 	rs := new(Scope)
-	rs.Proc = process.New(guest.New(proc.Gm.Limit, proc.Gm.Mmu))
+	rs.Proc = process.NewFromProc(proc)
 	rs.Proc.Lim = proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
@@ -19,6 +19,7 @@ import (
 	vprojection "github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"sort"
 )
 
 func (c *compile) compileOutput(o *projection.Projection, mp /*Reference Count*/ map[string]uint64) ([]*Scope, error) {
@@ -40,6 +41,7 @@ func (c *compile) compileOutput(o *projection.Projection, mp /*Reference Count*/
 			}
 			IncRef(e.E, mq)
 		}
+		rowAttribute(o, mq)
 		for k, v := range mq {
 			mp[k] += v
 		}
@@ -92,6 +94,7 @@ func (c *compile) compileProjection(o *projection.Projection, mp map[string]uint
 			}
 			IncRef(e.E, mq)
 		}
+		rowAttribute(o, mq)
 		for k, v := range mq {
 			mp[k] += v
 		}
@@ -141,3 +144,25 @@ func pushProjection(s *Scope, arg *vprojection.Argument) *Scope {
 	}
 	return s
 }
+
+// rowAttribute makes a projection which reads no attribute, such as the one
+// of now(), read the first attribute of its input for the number of rows.
+func rowAttribute(o *projection.Projection, mq map[string]uint64) {
+	for _, e := range o.Es {
+		if len(e.E.Attributes()) > 0 {
+			return
+		}
+	}
+	if attrs := o.Prev.ResultColumns(); len(attrs) > 0 {
+		mq[attrs[0]]++
+		return
+	}
+	attrs := make([]string, 0, len(o.Prev.Attribute()))
+	for attr := range o.Prev.Attribute() {
+		attrs = append(attrs, attr)
+	}
+	if len(attrs) > 0 {
+		sort.Strings(attrs)
+		mq[attrs[0]]++
+	}
+}
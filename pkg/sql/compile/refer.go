@@ -27,5 +27,9 @@ func IncRef(e extend.Extend, mp map[string]uint64) {
 	case *extend.BinaryExtend:
 		IncRef(v.Left, mp)
 		IncRef(v.Right, mp)
+	case *extend.MultiExtend:
+		for _, arg := range v.Args {
+			IncRef(arg, mp)
+		}
 	}
 }
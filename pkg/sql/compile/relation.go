@@ -19,7 +19,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/transfer"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/relation"
 	"github.com/matrixorigin/matrixone/pkg/vm"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"sync"
 )
@@ -41,7 +40,7 @@ func (c *compile) compileUnit(u *relation.Unit, o *relation.Relation, mp map[str
 	if n < mcpu {
 		ss := make([]*Scope, n)
 		for i, seg := range u.Segs {
-			proc := process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+			proc := process.NewFromProc(c.proc)
 			proc.Lim = c.proc.Lim
 			ss[i] = &Scope{
 				Proc:  proc,
@@ -55,7 +54,7 @@ func (c *compile) compileUnit(u *relation.Unit, o *relation.Relation, mp map[str
 			}
 		}
 		rs := new(Scope)
-		rs.Proc = process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+		rs.Proc = process.NewFromProc(c.proc)
 		rs.Proc.Lim = c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
@@ -88,7 +87,7 @@ func (c *compile) compileUnit(u *relation.Unit, o *relation.Relation, mp map[str
 	segs := u.Segs
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
-		proc := process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+		proc := process.NewFromProc(c.proc)
 		proc.Lim = c.proc.Lim
 		if i == mcpu-1 {
 			ss[i] = &Scope{
@@ -115,7 +114,7 @@ func (c *compile) compileUnit(u *relation.Unit, o *relation.Relation, mp map[str
 		}
 	}
 	rs := new(Scope)
-	rs.Proc = process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+	rs.Proc = process.NewFromProc(c.proc)
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
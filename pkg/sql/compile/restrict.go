@@ -15,9 +15,12 @@
 package compile
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	vrestrict "github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/relation"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/restrict"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/partition"
 )
 
 func (c *compile) compileRestrict(o *restrict.Restrict, mp map[string]uint64) ([]*Scope, error) {
@@ -27,10 +30,20 @@ func (c *compile) compileRestrict(o *restrict.Restrict, mp map[string]uint64) ([
 			mp[attr]++
 		}
 	}
+	var fs []*Filter
+	if r, ok := o.Prev.(*relation.Relation); ok {
+		fs = extractFilters(o.E, r.Attrs)
+		prunePartitions(r, fs)
+	}
 	ss, err := c.compile(o.Prev, mp)
 	if err != nil {
 		return nil, err
 	}
+	if len(fs) > 0 {
+		for _, s := range ss {
+			pushFilters(s, fs)
+		}
+	}
 	arg := &vrestrict.Argument{E: o.E}
 	if o.IsPD {
 		for i, s := range ss {
@@ -63,3 +76,56 @@ func pushRestrict(s *Scope, arg *vrestrict.Argument) *Scope {
 	return s
 
 }
+
+// prunePartitions removes the segments of the partitions which can not hold
+// the rows satisfying the filters.
+func prunePartitions(r *relation.Relation, fs []*Filter) {
+	if r.Partition == nil {
+		return
+	}
+	var mp map[string]struct{}
+	for _, f := range fs {
+		vals := f.Vals
+		if f.Op != overload.In {
+			vals = []interface{}{f.Val}
+		}
+		names, ok := partition.Prune(r.Partition, f.Attr, f.Op, vals)
+		if !ok {
+			continue
+		}
+		keep := make(map[string]struct{})
+		for _, name := range names {
+			if _, ok := mp[name]; ok || mp == nil {
+				keep[name] = struct{}{}
+			}
+		}
+		mp = keep
+	}
+	if mp == nil {
+		return
+	}
+	us := r.Us[:0]
+	for _, u := range r.Us {
+		segs := u.Segs[:0]
+		for _, seg := range u.Segs {
+			if _, ok := mp[seg.Partition]; ok {
+				segs = append(segs, seg)
+			}
+		}
+		if u.Segs = segs; len(segs) > 0 {
+			us = append(us, u)
+		}
+	}
+	r.Us = us
+}
+
+// pushFilters attaches the filters to the data sources of s, the restrict
+// is still evaluated because the filters may select more rows than needed.
+func pushFilters(s *Scope, fs []*Filter) {
+	if s.DataSource != nil {
+		s.DataSource.Filters = append(s.DataSource.Filters, fs...)
+	}
+	for _, p := range s.PreScopes {
+		pushFilters(p, fs)
+	}
+}
@@ -23,12 +23,15 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/op/naturalJoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/outerJoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/relation"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/summarize"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/union"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/window"
 )
 
 func rewrite(o op.OP, cnt int) op.OP {
@@ -97,6 +100,18 @@ func rewrite(o op.OP, cnt int) op.OP {
 		n.R = rewrite(n.R, cnt)
 		n.S = rewrite(n.S, cnt)
 		return n
+	case *outerJoin.Join:
+		cnt--
+		if cnt == 0 {
+			n.IsPD = true
+		}
+		n.R = rewrite(n.R, cnt)
+		n.S = rewrite(n.S, cnt)
+		return n
+	case *union.Union:
+		n.R = rewrite(n.R, mergeCount(n.R, 0))
+		n.S = rewrite(n.S, mergeCount(n.S, 0))
+		return n
 	case *relation.Relation:
 		return n
 	case *restrict.Restrict:
@@ -111,6 +126,10 @@ func rewrite(o op.OP, cnt int) op.OP {
 		}
 		n.Prev = rewrite(n.Prev, cnt)
 		return n
+	case *window.Window:
+		cnt--
+		n.Prev = rewrite(n.Prev, cnt)
+		return n
 	case *projection.Projection:
 		if cnt == 0 {
 			n.IsPD = true
@@ -142,12 +161,18 @@ func mergeCount(o op.OP, cnt int) int {
 		return mergeCount(n.R, cnt) + mergeCount(n.S, cnt) + 1
 	case *naturalJoin.Join:
 		return mergeCount(n.R, cnt) + mergeCount(n.S, cnt) + 1
+	case *outerJoin.Join:
+		return mergeCount(n.R, cnt) + mergeCount(n.S, cnt) + 1
+	case *union.Union:
+		return mergeCount(n.R, 0) + mergeCount(n.S, 0) + cnt + 1
 	case *relation.Relation:
 		return cnt
 	case *restrict.Restrict:
 		return mergeCount(n.Prev, cnt)
 	case *summarize.Summarize:
 		return mergeCount(n.Prev, cnt+1)
+	case *window.Window:
+		return mergeCount(n.Prev, cnt+1)
 	case *projection.Projection:
 		return mergeCount(n.Prev, cnt)
 	}
@@ -16,6 +16,8 @@ package compile
 
 import (
 	"bytes"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
@@ -30,6 +32,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/rpcserver"
 	"github.com/matrixorigin/matrixone/pkg/rpcserver/message"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/transfer"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/alterTable"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/analyzeTable"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/createDatabase"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/createIndex"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/createTable"
@@ -39,6 +43,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/op/insert"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/showColumns"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/showDatabases"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/showStats"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/showTables"
 	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
 	"github.com/matrixorigin/matrixone/pkg/sqlerror"
@@ -79,6 +84,14 @@ func (s *Scope) Run(e engine.Engine) error {
 				Version:  seg.Version,
 			}, s.Proc)
 		}
+		if s.DataSource.IsSummary {
+			if segs, err = s.summarize(r, segs); err != nil {
+				return sqlerror.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
+			}
+		}
+		for i, seg := range segs {
+			segs[i] = newFilterSegment(seg, s.DataSource.Filters, s.Proc)
+		}
 	}
 	if _, err := p.Run(segs, s.Proc); err != nil {
 		return sqlerror.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
@@ -137,7 +150,7 @@ func (s *Scope) RemoteRun(e engine.Engine) error {
 		if arg.Reg.Ch != nil {
 			arg.Reg.Wg.Add(1)
 			arg.Reg.Ch <- nil
-			arg.Reg.Wg.Wait()
+			s.Proc.WaitReceived(arg.Reg)
 		}
 	}()
 	encoder, decoder := rpcserver.NewCodec(1 << 30)
@@ -147,15 +160,30 @@ func (s *Scope) RemoteRun(e engine.Engine) error {
 	if _, err := conn.Connect(fmt.Sprintf("%v:%v", addr.IP, addr.Port+100), time.Second*3); err != nil {
 		return err
 	}
-	if err := protocol.EncodeScope(Transfer(s), &buf); err != nil {
+	ps := Transfer(s)
+	ps.Id = newScopeId()
+	if err := protocol.EncodeScope(ps, &buf); err != nil {
 		return err
 	}
-	if err := conn.WriteAndFlush(&message.Message{Data: buf.Bytes()}); err != nil {
+	if err := conn.WriteAndFlush(&message.Message{Cmd: protocol.ProcessCommand, Data: buf.Bytes()}); err != nil {
 		return err
 	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-s.Proc.Ctx.Done():
+			cancelRemote(addr, ps.Id)
+			conn.Close()
+		case <-done:
+		}
+	}()
 	for {
 		val, err := conn.Read()
 		if err != nil {
+			if perr := s.Proc.Err(); perr != nil {
+				return perr
+			}
 			return err
 		}
 		msg := val.(*message.Message)
@@ -177,11 +205,28 @@ func (s *Scope) RemoteRun(e engine.Engine) error {
 		}
 		arg.Reg.Wg.Add(1)
 		arg.Reg.Ch <- bat
-		arg.Reg.Wg.Wait()
+		s.Proc.WaitReceived(arg.Reg)
 	}
 	return nil
 }
 
+// cancelRemote asks the remote node to stop the execution of scope id.
+func cancelRemote(addr *net.TCPAddr, id string) {
+	encoder, decoder := rpcserver.NewCodec(1 << 30)
+	conn := goetty.NewIOSession(goetty.WithCodec(encoder, decoder))
+	defer conn.Close()
+	if _, err := conn.Connect(fmt.Sprintf("%v:%v", addr.IP, addr.Port+100), time.Second*3); err != nil {
+		return
+	}
+	conn.WriteAndFlush(&message.Message{Cmd: protocol.CancelCommand, Data: []byte(id)})
+}
+
+func newScopeId() string {
+	data := make([]byte, 16)
+	rand.Read(data)
+	return hex.EncodeToString(data)
+}
+
 func (s *Scope) Insert(ts uint64) (uint64, error) {
 	o, _ := s.Operator.(*insert.Insert)
 	defer o.R.Close()
@@ -440,6 +485,92 @@ func (s *Scope) ShowColumns(u interface{}, fill func(interface{}, *batch.Batch)
 	return fill(u, bat)
 }
 
+// ShowStats returns a row of statistics for each attribute whose
+// statistics are collected.
+func (s *Scope) ShowStats(u interface{}, fill func(interface{}, *batch.Batch) error) error {
+	o, _ := s.Operator.(*showStats.ShowStats)
+	defer o.R.Close()
+	var names, cols, mins, maxs [][]byte
+	var rows, nullCnts, ndvs, buckets []int64
+	var minNulls, maxNulls []uint64
+	for _, attr := range o.R.Attribute() {
+		st := o.R.ColumnStats(attr.Name)
+		if st == nil {
+			continue
+		}
+		if st.Min != nil {
+			mins = append(mins, []byte(st.Min.Format(attr.Type)))
+			maxs = append(maxs, []byte(st.Max.Format(attr.Type)))
+		} else {
+			minNulls = append(minNulls, uint64(len(cols)))
+			maxNulls = append(maxNulls, uint64(len(cols)))
+			mins = append(mins, nil)
+			maxs = append(maxs, nil)
+		}
+		var n int64
+		if st.Histogram != nil {
+			n = int64(len(st.Histogram.Buckets))
+		}
+		names = append(names, []byte(o.Table))
+		cols = append(cols, []byte(attr.Name))
+		rows = append(rows, st.Rows)
+		nullCnts = append(nullCnts, st.NullCount)
+		ndvs = append(ndvs, st.NDV())
+		buckets = append(buckets, n)
+	}
+	bat := batch.New(true, []string{"Table", "Column", "Row_count", "Null_count", "Distinct_count", "Min_value", "Max_value", "Buckets"})
+	for i, vs := range []interface{}{names, cols, rows, nullCnts, ndvs, mins, maxs, buckets} {
+		var vec *vector.Vector
+		switch vs := vs.(type) {
+		case [][]byte:
+			vec = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+			if err := vec.Append(vs); err != nil {
+				return err
+			}
+		case []int64:
+			vec = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+			if err := vec.Append(vs); err != nil {
+				return err
+			}
+		}
+		bat.Vecs[i] = vec
+	}
+	bat.Vecs[5].Nsp.Add(minNulls...)
+	bat.Vecs[6].Nsp.Add(maxNulls...)
+	return fill(u, bat)
+}
+
+// AnalyzeTable recollects the statistics of the relations, and returns a
+// row of the result for each of them like mysql does.
+func (s *Scope) AnalyzeTable(ts uint64, u interface{}, fill func(interface{}, *batch.Batch) error) error {
+	o, _ := s.Operator.(*analyzeTable.AnalyzeTable)
+	names := make([][]byte, len(o.Rs))
+	ops := make([][]byte, len(o.Rs))
+	typs := make([][]byte, len(o.Rs))
+	msgs := make([][]byte, len(o.Rs))
+	for i, r := range o.Rs {
+		names[i] = []byte(o.Tables[i])
+		ops[i] = []byte("analyze")
+		if err := r.Analyze(ts); err != nil {
+			typs[i] = []byte("Error")
+			msgs[i] = []byte(err.Error())
+		} else {
+			typs[i] = []byte("status")
+			msgs[i] = []byte("OK")
+		}
+		r.Close()
+	}
+	bat := batch.New(true, []string{"Table", "Op", "Msg_type", "Msg_text"})
+	for i, vs := range [][][]byte{names, ops, typs, msgs} {
+		vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+		if err := vec.Append(vs); err != nil {
+			return err
+		}
+		bat.Vecs[i] = vec
+	}
+	return fill(u, bat)
+}
+
 func (s *Scope) CreateIndex(ts uint64) error {
 	o, _ := s.Operator.(*createIndex.CreateIndex)
 	defer o.R.Close()
@@ -459,3 +590,29 @@ func (s *Scope) DropIndex(ts uint64) error {
 	}
 	return err
 }
+
+// AlterTable applies the options of ALTER TABLE to the relation in order.
+func (s *Scope) AlterTable(ts uint64) error {
+	o, _ := s.Operator.(*alterTable.AlterTable)
+	defer o.R.Close()
+	for _, opt := range o.Options {
+		var err error
+
+		switch opt.Typ {
+		case alterTable.AddColumn:
+			err = o.R.AddAttribute(ts, opt.Def)
+		case alterTable.DropColumn:
+			err = o.R.DelAttribute(ts, opt.Def)
+		case alterTable.RenameColumn:
+			err = o.R.RenameAttribute(ts, opt.OldName, opt.NewName)
+		case alterTable.AddPartition:
+			err = o.R.AddPartition(ts, opt.Partition)
+		case alterTable.DropPartition:
+			err = o.R.DropPartition(ts, opt.PartitionName)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package compile
+
+import (
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/opt"
+	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// execute runs the query of a subquery while the outer query is built, and
+// returns all the rows of its result columns.
+func (c *compile) execute(o op.OP) (*batch.Batch, error) {
+	var mu sync.Mutex
+
+	o = opt.Optimize(o)
+	o = rewrite(o, mergeCount(o, 0))
+	ss, err := c.compileAlgebra(o)
+	if err != nil {
+		return nil, err
+	}
+	mp := o.Attribute()
+	var attrs []string
+	for _, attr := range o.ResultColumns() {
+		if _, ok := mp[attr]; ok {
+			attrs = append(attrs, attr)
+		}
+	}
+	proc := process.NewFromProc(c.proc)
+	proc.Mp = mempool.New()
+	rbat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		rbat.Vecs[i] = vector.New(mp[attr])
+	}
+	defer rbat.Clean(proc)
+	fill := func(_ interface{}, bat *batch.Batch) error {
+		if bat == nil {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		sels := bat.Sels
+		if len(sels) == 0 {
+			for i, n := int64(0), int64(bat.Vecs[0].Length()); i < n; i++ {
+				sels = append(sels, i)
+			}
+		}
+		for i, attr := range attrs {
+			vec := bat.GetVector(attr)
+			for _, sel := range sels {
+				if err := rbat.Vecs[i].UnionOne(vec, sel, proc); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := runScopes(fillOutput(ss, &output.Argument{Func: fill, Attrs: attrs}, c.proc), c.e); err != nil {
+		return nil, err
+	}
+	return copyBatch(rbat)
+}
+
+// runScopes runs the scopes producing the rows of a query and waits for them.
+func runScopes(ss []*Scope, e engine.Engine) error {
+	var err error
+	var wg sync.WaitGroup
+
+	for i := range ss {
+		switch ss[i].Magic {
+		case Normal:
+			wg.Add(1)
+			go func(s *Scope) {
+				if rerr := s.Run(e); rerr != nil {
+					err = rerr
+				}
+				wg.Done()
+			}(ss[i])
+		case Merge:
+			wg.Add(1)
+			go func(s *Scope) {
+				if rerr := s.MergeRun(e); rerr != nil {
+					err = rerr
+				}
+				wg.Done()
+			}(ss[i])
+		}
+	}
+	wg.Wait()
+	return err
+}
+
+// copyBatch copies the batch into memory which is not managed by the
+// process, so that it survives the cleaning of the pipeline.
+func copyBatch(bat *batch.Batch) (*batch.Batch, error) {
+	if len(bat.Sels) > 0 {
+		return nil, sqlerror.New(errno.InternalError, "unexpected batch with selection")
+	}
+	var err error
+	rbat := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		if rbat.Vecs[i], err = copyVector(vec); err != nil {
+			return nil, err
+		}
+	}
+	return rbat, nil
+}
+
+func copyVector(vec *vector.Vector) (*vector.Vector, error) {
+	data, err := vec.Show()
+	if err != nil {
+		return nil, err
+	}
+	rvec := vector.New(vec.Typ)
+	rvec.Or = true
+	if err := rvec.Read(append([]byte{}, data...)); err != nil {
+		return nil, err
+	}
+	return rvec, nil
+}
@@ -21,7 +21,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/transfer"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/summarize"
 	"github.com/matrixorigin/matrixone/pkg/vm"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"sync"
 )
@@ -46,7 +45,7 @@ func (c *compile) compileSummarize(o *summarize.Summarize, mp map[string]uint64)
 		return nil, err
 	}
 	rs := new(Scope)
-	rs.Proc = process.New(guest.New(c.proc.Gm.Limit, c.proc.Gm.Mmu))
+	rs.Proc = process.NewFromProc(c.proc)
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
@@ -58,8 +57,9 @@ func (c *compile) compileSummarize(o *summarize.Summarize, mp map[string]uint64)
 		}
 	}
 	if o.IsPD {
+		isSummary := summarizable(o)
 		for i, s := range ss {
-			ss[i] = pushSummarize(s, refer, o)
+			ss[i] = pushSummarize(s, refer, o, isSummary)
 		}
 	}
 	for i, s := range ss {
@@ -93,10 +93,10 @@ func (c *compile) compileSummarize(o *summarize.Summarize, mp map[string]uint64)
 	return []*Scope{rs}, nil
 }
 
-func pushSummarize(s *Scope, refer map[string]uint64, o *summarize.Summarize) *Scope {
+func pushSummarize(s *Scope, refer map[string]uint64, o *summarize.Summarize, isSummary bool) *Scope {
 	if s.Magic == Merge || s.Magic == Remote {
 		for i := range s.PreScopes {
-			s.PreScopes[i] = pushSummarize(s.PreScopes[i], refer, o)
+			s.PreScopes[i] = pushSummarize(s.PreScopes[i], refer, o, isSummary)
 		}
 		s.Instructions[len(s.Instructions)-1] = vm.Instruction{
 			Code: vm.MergeSummarize,
@@ -115,6 +115,9 @@ func pushSummarize(s *Scope, refer map[string]uint64, o *summarize.Summarize) *S
 			},
 		})
 		s.Instructions[n], s.Instructions[n+1] = s.Instructions[n+1], s.Instructions[n]
+		if isSummary && s.DataSource != nil {
+			s.DataSource.IsSummary = true
+		}
 	}
 	return s
 }
@@ -126,6 +129,9 @@ func unitAggregates(es []aggregation.Extend) []aggregation.Extend {
 			Name:  e.Name,
 			Alias: e.Alias,
 			Op:    unitAggFuncs[e.Op],
+
+			Distinct:  e.Distinct,
+			Separator: e.Separator,
 		}
 	}
 	return rs
@@ -138,6 +144,9 @@ func mergeAggregates(es []aggregation.Extend) []aggregation.Extend {
 			Name:  e.Alias,
 			Alias: e.Alias,
 			Op:    mergeAggFuncs[e.Op],
+
+			Distinct:  e.Distinct,
+			Separator: e.Separator,
 		}
 	}
 	return rs
@@ -150,34 +159,70 @@ func remoteAggregates(es []aggregation.Extend) []aggregation.Extend {
 			Name:  e.Alias,
 			Alias: e.Alias,
 			Op:    remoteAggFuncs[e.Op],
+
+			Distinct:  e.Distinct,
+			Separator: e.Separator,
 		}
 	}
 	return rs
 }
 
 var unitAggFuncs map[int]int = map[int]int{
-	aggregation.Avg:       aggregation.SumCount,
-	aggregation.Max:       aggregation.Max,
-	aggregation.Min:       aggregation.Min,
-	aggregation.Sum:       aggregation.Sum,
-	aggregation.Count:     aggregation.Count,
-	aggregation.StarCount: aggregation.StarCount,
+	aggregation.Avg:                 aggregation.SumCount,
+	aggregation.Max:                 aggregation.Max,
+	aggregation.Min:                 aggregation.Min,
+	aggregation.Sum:                 aggregation.Sum,
+	aggregation.Count:               aggregation.Count,
+	aggregation.StarCount:           aggregation.StarCount,
+	aggregation.CountDistinct:       aggregation.DistinctSet,
+	aggregation.StdDevPop:           aggregation.Moment,
+	aggregation.StdDevSamp:          aggregation.Moment,
+	aggregation.VarPop:              aggregation.Moment,
+	aggregation.VarSamp:             aggregation.Moment,
+	aggregation.GroupConcat:         aggregation.ConcatList,
+	aggregation.BitAnd:              aggregation.BitAnd,
+	aggregation.BitOr:               aggregation.BitOr,
+	aggregation.BitXor:              aggregation.BitXor,
+	aggregation.AnyValue:            aggregation.AnyValue,
+	aggregation.ApproxCountDistinct: aggregation.HyperLogLog,
 }
 
 var mergeAggFuncs map[int]int = map[int]int{
-	aggregation.Avg:       aggregation.Avg,
-	aggregation.Max:       aggregation.Max,
-	aggregation.Min:       aggregation.Min,
-	aggregation.Sum:       aggregation.Sum,
-	aggregation.Count:     aggregation.Sum,
-	aggregation.StarCount: aggregation.Sum,
+	aggregation.Avg:                 aggregation.Avg,
+	aggregation.Max:                 aggregation.Max,
+	aggregation.Min:                 aggregation.Min,
+	aggregation.Sum:                 aggregation.Sum,
+	aggregation.Count:               aggregation.Sum,
+	aggregation.StarCount:           aggregation.Sum,
+	aggregation.CountDistinct:       aggregation.CountDistinct,
+	aggregation.StdDevPop:           aggregation.StdDevPop,
+	aggregation.StdDevSamp:          aggregation.StdDevSamp,
+	aggregation.VarPop:              aggregation.VarPop,
+	aggregation.VarSamp:             aggregation.VarSamp,
+	aggregation.GroupConcat:         aggregation.GroupConcat,
+	aggregation.BitAnd:              aggregation.BitAnd,
+	aggregation.BitOr:               aggregation.BitOr,
+	aggregation.BitXor:              aggregation.BitXor,
+	aggregation.AnyValue:            aggregation.AnyValue,
+	aggregation.ApproxCountDistinct: aggregation.ApproxCountDistinct,
 }
 
 var remoteAggFuncs map[int]int = map[int]int{
-	aggregation.Avg:       aggregation.SumCount,
-	aggregation.Max:       aggregation.Max,
-	aggregation.Min:       aggregation.Min,
-	aggregation.Sum:       aggregation.Sum,
-	aggregation.Count:     aggregation.Sum,
-	aggregation.StarCount: aggregation.Sum,
+	aggregation.Avg:                 aggregation.SumCount,
+	aggregation.Max:                 aggregation.Max,
+	aggregation.Min:                 aggregation.Min,
+	aggregation.Sum:                 aggregation.Sum,
+	aggregation.Count:               aggregation.Sum,
+	aggregation.StarCount:           aggregation.Sum,
+	aggregation.CountDistinct:       aggregation.DistinctSet,
+	aggregation.StdDevPop:           aggregation.Moment,
+	aggregation.StdDevSamp:          aggregation.Moment,
+	aggregation.VarPop:              aggregation.Moment,
+	aggregation.VarSamp:             aggregation.Moment,
+	aggregation.GroupConcat:         aggregation.ConcatList,
+	aggregation.BitAnd:              aggregation.BitAnd,
+	aggregation.BitOr:               aggregation.BitOr,
+	aggregation.BitXor:              aggregation.BitXor,
+	aggregation.AnyValue:            aggregation.AnyValue,
+	aggregation.ApproxCountDistinct: aggregation.HyperLogLog,
 }
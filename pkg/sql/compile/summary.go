@@ -0,0 +1,276 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	vsummarize "github.com/matrixorigin/matrixone/pkg/sql/colexec/summarize"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/relation"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/summarize"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+)
+
+// summarizable returns true if the aggregates of o may be answered by
+// the summarizers of segments, which is the case when o aggregates the
+// attributes of a relation and the restrict between them can be fully
+// evaluated by the filters of segments.
+func summarizable(o *summarize.Summarize) bool {
+	prev := o.Prev
+	for {
+		n, ok := prev.(*projection.Projection)
+		if !ok {
+			break
+		}
+		for _, e := range n.Es {
+			if attr, ok := e.E.(*extend.Attribute); !ok || attr.Name != e.Alias {
+				return false
+			}
+		}
+		prev = n.Prev
+	}
+	if n, ok := prev.(*restrict.Restrict); ok {
+		r, ok := n.Prev.(*relation.Relation)
+		if !ok {
+			return false
+		}
+		if _, ok = exactFilters(n.E, r.Attrs); !ok {
+			return false
+		}
+		prev = r
+	}
+	r, ok := prev.(*relation.Relation)
+	if !ok {
+		return false
+	}
+	for _, e := range o.Es {
+		switch e.Op {
+		case aggregation.StarCount:
+		case aggregation.Count, aggregation.Max, aggregation.Min, aggregation.Sum:
+			if _, ok := r.Attrs[e.Name]; !ok {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// summarize sends the partial aggregates of the segments which can be answered
+// by their summarizers to the instructions following the summarize instruction,
+// and returns the segments which still have to be read.
+func (s *Scope) summarize(r engine.Relation, segs []engine.Segment) ([]engine.Segment, error) {
+	k := -1
+	for i, in := range s.Instructions {
+		if in.Code == vm.Summarize {
+			k = i
+			break
+		}
+	}
+	if k < 0 {
+		return segs, nil
+	}
+	arg := s.Instructions[k].Arg.(*vsummarize.Argument)
+	typs := make(map[string]types.Type)
+	for _, attr := range r.Attribute() {
+		typs[attr.Name] = attr.Type
+	}
+	rs := make([]engine.Segment, 0, len(segs))
+	for _, seg := range segs {
+		bat, ok := summarizeSegment(seg, s.DataSource.Filters, arg, typs)
+		if !ok {
+			rs = append(rs, seg)
+			continue
+		}
+		if bat == nil {
+			continue
+		}
+		s.Proc.Reg.InputBatch = bat
+		if _, err := vm.Run(s.Instructions[k+1:], s.Proc); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+// summarizeSegment returns the partial aggregates of seg, it returns false if
+// the indexes of seg can not answer them, and a nil batch if no row is selected.
+func summarizeSegment(seg engine.Segment, fs []*Filter, arg *vsummarize.Argument, typs map[string]types.Type) (*batch.Batch, bool) {
+	sm := seg.NewSummarizer()
+	if sm == nil {
+		return nil, false
+	}
+	var bm *roaring.Bitmap
+	if len(fs) > 0 {
+		df := seg.NewFilter()
+		if df == nil {
+			return nil, false
+		}
+		for _, f := range fs {
+			// the rows selected are exact only if the attribute has a bsi index
+			if _, err := sm.Count(f.Attr, nil); err != nil {
+				return nil, false
+			}
+			x, err := denseFilter(df, f)
+			if err != nil {
+				return nil, false
+			}
+			if bm == nil {
+				bm = x
+			} else {
+				bm.And(x)
+			}
+		}
+	}
+	rows := seg.Rows()
+	if bm != nil {
+		rows = int64(bm.GetCardinality())
+	}
+	if rows <= 0 {
+		return nil, rows == 0
+	}
+	attrs := make([]string, len(arg.Es))
+	for i, e := range arg.Es {
+		attrs[i] = e.Alias
+	}
+	bat := batch.New(true, attrs)
+	for i, e := range arg.Es {
+		var vec *vector.Vector
+
+		switch e.Op {
+		case aggregation.StarCount:
+			vec = int64Vector(rows)
+		case aggregation.Count:
+			n, err := sm.Count(e.Name, bm)
+			if err != nil {
+				return nil, false
+			}
+			vec = int64Vector(int64(n))
+		case aggregation.Sum:
+			sum, n, err := sm.Sum(e.Name, bm)
+			if err != nil || n == 0 {
+				return nil, false
+			}
+			if vec = sumVector(sum, typs[e.Name]); vec == nil {
+				return nil, false
+			}
+		case aggregation.Max, aggregation.Min:
+			var v interface{}
+			var err error
+
+			if e.Op == aggregation.Max {
+				v, err = sm.Max(e.Name, bm)
+			} else {
+				v, err = sm.Min(e.Name, bm)
+			}
+			if err != nil || v == nil {
+				return nil, false
+			}
+			if vec = valueVector(v, typs[e.Name]); vec == nil {
+				return nil, false
+			}
+		default:
+			return nil, false
+		}
+		vec.Ref = arg.Refer[e.Alias]
+		bat.Vecs[i] = vec
+	}
+	return bat, true
+}
+
+func int64Vector(v int64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8, Width: 8})
+	vec.Col = []int64{v}
+	return vec
+}
+
+// sumVector returns the partial sum of an attribute of type typ,
+// the summarizers only support the sum of integers.
+func sumVector(sum int64, typ types.Type) *vector.Vector {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		vec := vector.New(aggregation.ReturnType(aggregation.Sum, typ))
+		vec.Col = []int64{sum}
+		return vec
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		vec := vector.New(aggregation.ReturnType(aggregation.Sum, typ))
+		vec.Col = []uint64{uint64(sum)}
+		return vec
+	}
+	return nil
+}
+
+// valueVector returns a vector of type typ which contains v, it returns
+// nil if the type of v does not match typ.
+func valueVector(v interface{}, typ types.Type) *vector.Vector {
+	var ok bool
+
+	vec := vector.New(typ)
+	switch typ.Oid {
+	case types.T_int8:
+		var x int8
+		x, ok = v.(int8)
+		vec.Col = []int8{x}
+	case types.T_int16:
+		var x int16
+		x, ok = v.(int16)
+		vec.Col = []int16{x}
+	case types.T_int32:
+		var x int32
+		x, ok = v.(int32)
+		vec.Col = []int32{x}
+	case types.T_int64:
+		var x int64
+		x, ok = v.(int64)
+		vec.Col = []int64{x}
+	case types.T_uint8:
+		var x uint8
+		x, ok = v.(uint8)
+		vec.Col = []uint8{x}
+	case types.T_uint16:
+		var x uint16
+		x, ok = v.(uint16)
+		vec.Col = []uint16{x}
+	case types.T_uint32:
+		var x uint32
+		x, ok = v.(uint32)
+		vec.Col = []uint32{x}
+	case types.T_uint64:
+		var x uint64
+		x, ok = v.(uint64)
+		vec.Col = []uint64{x}
+	case types.T_float32:
+		var x float32
+		x, ok = v.(float32)
+		vec.Col = []float32{x}
+	case types.T_float64:
+		var x float64
+		x, ok = v.(float64)
+		vec.Col = []float64{x}
+	}
+	if !ok {
+		return nil
+	}
+	return vec
+}
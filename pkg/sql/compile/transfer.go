@@ -35,6 +35,14 @@ func Transfer(s *Scope) protocol.Scope {
 			ps.Data.Segs[i].IsRemote = seg.IsRemote
 			ps.Data.Segs[i].TabletId = seg.TabletId
 		}
+		ps.Data.IsSummary = s.DataSource.IsSummary
+		ps.Data.Filters = make([]protocol.Filter, len(s.DataSource.Filters))
+		for i, f := range s.DataSource.Filters {
+			ps.Data.Filters[i].Op = f.Op
+			ps.Data.Filters[i].Attr = f.Attr
+			ps.Data.Filters[i].Val = f.Val
+			ps.Data.Filters[i].Vals = f.Vals
+		}
 	}
 	ps.Ss = make([]protocol.Scope, len(s.PreScopes))
 	for i := range s.PreScopes {
@@ -41,6 +41,11 @@ const (
 	ShowColumns
 	CreateIndex
 	DropIndex
+	Delete
+	Update
+	AlterTable
+	AnalyzeTable
+	ShowStats
 )
 
 // Source contains information of a relation which will be used in execution,
@@ -51,6 +56,23 @@ type Source struct {
 	RefCount map[string]uint64
 	// Segments contains the segment list of input data.
 	Segments []*relation.Segment
+	// Filters contains the conditions which are used to skip
+	// blocks and rows by the indexes of segments.
+	Filters []*Filter
+	// IsSummary is true if the aggregates of the scope can be
+	// answered by the summarizers of segments.
+	IsSummary bool
+}
+
+// Filter is a comparison between an attribute and a constant,
+// Op is one of overload.EQ, NE, LT, LE, GT and GE, or one of
+// overload.In, NotIn, IsNull and IsNotNull.
+type Filter struct {
+	Op   int
+	Attr string
+	Val  interface{}
+	// Vals is the set of values of in and notIn
+	Vals []interface{}
 }
 
 // Scope is the output of the compile process.
@@ -108,6 +130,8 @@ type compile struct {
 	uid string
 	// sql sql text.
 	sql string
+	// params values bound to the placeholders of the sql.
+	params []tree.Expr
 	// e db engine instance.
 	e engine.Engine
 	// proc stores the execution context.
@@ -0,0 +1,133 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	bdifference "github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/difference"
+	bintersect "github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/intersect"
+	bunion "github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/union"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergededup"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/transfer"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/union"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"sync"
+)
+
+func (c *compile) compileUnion(o *union.Union, mp map[string]uint64) ([]*Scope, error) {
+	attrs := o.ResultColumns()
+	rmp, smp := make(map[string]uint64), make(map[string]uint64)
+	{
+		for _, attr := range attrs {
+			switch {
+			case o.Type == union.UnionOp && o.All:
+				rmp[attr], smp[attr] = mp[attr], mp[attr]
+			case o.Type == union.UnionOp:
+				rmp[attr], smp[attr] = mp[attr]+1, mp[attr]+1
+			default: // the rows of S are only compared
+				rmp[attr], smp[attr] = mp[attr]+1, 1
+			}
+		}
+	}
+	rs, err := c.compile(o.R, rmp)
+	if err != nil {
+		return nil, err
+	}
+	ss, err := c.compile(o.S, smp)
+	if err != nil {
+		return nil, err
+	}
+	if o.Type == union.UnionOp {
+		us := c.newMergeScope(append(rs, ss...))
+		if o.All {
+			us.Instructions = append(us.Instructions, vm.Instruction{
+				Code: vm.BagUnion,
+				Arg: &bunion.Argument{
+					R: o.R.Name(),
+					S: o.S.Name(),
+				},
+			})
+		} else {
+			us.Instructions = append(us.Instructions, vm.Instruction{
+				Code: vm.MergeDedup,
+				Arg:  &mergededup.Argument{Attrs: attrs},
+			})
+		}
+		return []*Scope{us}, nil
+	}
+	rms, sms := c.newMergeScope(rs), c.newMergeScope(ss)
+	rms.Instructions = append(rms.Instructions, vm.Instruction{
+		Code: vm.Merge,
+		Arg:  &merge.Argument{},
+	})
+	sms.Instructions = append(sms.Instructions, vm.Instruction{
+		Code: vm.Merge,
+		Arg:  &merge.Argument{},
+	})
+	us := c.newMergeScope([]*Scope{rms, sms})
+	switch o.Type {
+	case union.IntersectOp:
+		us.Instructions = append(us.Instructions, vm.Instruction{
+			Code: vm.BagIntersect,
+			Arg: &bintersect.Argument{
+				R:     o.R.Name(),
+				S:     o.S.Name(),
+				All:   o.All,
+				Attrs: attrs,
+			},
+		})
+	case union.ExceptOp:
+		us.Instructions = append(us.Instructions, vm.Instruction{
+			Code: vm.BagDifference,
+			Arg: &bdifference.Argument{
+				R:     o.R.Name(),
+				S:     o.S.Name(),
+				All:   o.All,
+				Attrs: attrs,
+			},
+		})
+	}
+	return []*Scope{us}, nil
+}
+
+// newMergeScope returns a merge scope which receives the outputs of ss,
+// the i-th scope of ss is sent to the i-th receiver.
+func (c *compile) newMergeScope(ss []*Scope) *Scope {
+	rs := new(Scope)
+	rs.Proc = process.NewFromProc(c.proc)
+	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
+	{
+		for i, j := 0, len(ss); i < j; i++ {
+			rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+				Wg: new(sync.WaitGroup),
+				Ch: make(chan interface{}, 8),
+			}
+		}
+	}
+	for i, s := range ss {
+		ss[i].Instructions = append(s.Instructions, vm.Instruction{
+			Code: vm.Transfer,
+			Arg: &transfer.Argument{
+				Proc: rs.Proc,
+				Reg:  rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	rs.PreScopes = ss
+	rs.Magic = Merge
+	return rs
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package compile
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/mutation"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/delete"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/update"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func (s *Scope) Delete(ts uint64) (uint64, error) {
+	o, _ := s.Operator.(*delete.Delete)
+	defer o.R.Close()
+	return o.R.Delete(ts, mutation.NewFilter(o.E), s.Proc)
+}
+
+// Update replaces the selected rows with the new rows in a single step of
+// the relation, the rows are not buffered by the scope.
+func (s *Scope) Update(ts uint64) (uint64, error) {
+	o, _ := s.Operator.(*update.Update)
+	defer o.R.Close()
+	return o.R.Update(ts, mutation.NewUpdate(o.E, o.Attrs, o.Sets), s.Proc)
+}
+
+// mutationProcess returns the process the filter and the new values of a
+// mutation are evaluated with, its memory is charged to the query.
+func (c *compile) mutationProcess() *process.Process {
+	proc := process.NewFromProc(c.proc)
+	proc.Lim = c.proc.Lim
+	proc.Mp = mempool.New()
+	return proc
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/transfer"
+	vwindow "github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/window"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"sync"
+)
+
+func (c *compile) compileWindow(o *window.Window, mp map[string]uint64) ([]*Scope, error) {
+	refer := make(map[string]uint64)
+	{
+		for _, f := range o.Fs {
+			if v, ok := mp[f.Alias]; ok {
+				refer[f.Alias] = v
+				delete(mp, f.Alias)
+			} else {
+				refer[f.Alias]++
+			}
+		}
+		for _, f := range o.Fs {
+			for _, attr := range f.Attributes() {
+				mp[attr]++
+			}
+		}
+	}
+	ss, err := c.compile(o.Prev, mp)
+	if err != nil {
+		return nil, err
+	}
+	rs := new(Scope)
+	rs.Proc = process.NewFromProc(c.proc)
+	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
+	{
+		for i, j := 0, len(ss); i < j; i++ {
+			rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+				Wg: new(sync.WaitGroup),
+				Ch: make(chan interface{}, 8),
+			}
+		}
+	}
+	for i, s := range ss {
+		ss[i].Instructions = append(s.Instructions, vm.Instruction{
+			Code: vm.Transfer,
+			Arg: &transfer.Argument{
+				Proc: rs.Proc,
+				Reg:  rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	rs.PreScopes = ss
+	rs.Magic = Merge
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Code: vm.Window,
+		Arg: &vwindow.Argument{
+			Fs:    o.Fs,
+			Refer: refer,
+		},
+	})
+	return []*Scope{rs}, nil
+}
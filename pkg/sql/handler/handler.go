@@ -16,6 +16,7 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/rpcserver/message"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
@@ -29,8 +30,9 @@ import (
 
 func New(engine engine.Engine, proc *process.Process) *Handler {
 	return &Handler{
-		engine: engine,
-		proc:   proc,
+		engine:  engine,
+		proc:    proc,
+		cancels: make(map[string]context.CancelFunc),
 	}
 }
 
@@ -39,7 +41,21 @@ func (hp *Handler) Process(_ uint64, val interface{}, conn goetty.IOSession) err
 	if err != nil {
 		return err
 	}
-	s := recoverScope(ps, hp.proc)
+	proc := process.NewFromProc(hp.proc)
+	proc.Lim = hp.proc.Lim
+	proc.Ctx, proc.Cancel = context.WithCancel(context.Background())
+	defer proc.Cancel()
+	if len(ps.Id) > 0 {
+		hp.Lock()
+		hp.cancels[ps.Id] = proc.Cancel
+		hp.Unlock()
+		defer func() {
+			hp.Lock()
+			delete(hp.cancels, ps.Id)
+			hp.Unlock()
+		}()
+	}
+	s := recoverScope(ps, proc)
 	s.Instructions[len(s.Instructions)-1] = vm.Instruction{
 		Code: vm.Output,
 		Arg: &output.Argument{
@@ -53,6 +69,18 @@ func (hp *Handler) Process(_ uint64, val interface{}, conn goetty.IOSession) err
 	return conn.WriteAndFlush(&message.Message{Sid: 1})
 }
 
+// Cancel stops the running scope whose id is carried by the message.
+func (hp *Handler) Cancel(_ uint64, val interface{}, _ goetty.IOSession) error {
+	id := string(val.(*message.Message).Data)
+	hp.Lock()
+	cancel, ok := hp.cancels[id]
+	hp.Unlock()
+	if ok {
+		cancel()
+	}
+	return nil
+}
+
 func writeBack(u interface{}, bat *batch.Batch) error {
 	var buf bytes.Buffer
 
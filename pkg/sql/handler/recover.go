@@ -20,7 +20,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/op/relation"
 	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
 	"github.com/matrixorigin/matrixone/pkg/vm"
-	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"sync"
 )
@@ -32,7 +31,7 @@ func recoverScope(ps protocol.Scope, proc *process.Process) *compile.Scope {
 	if s.Magic == compile.Remote {
 		s.Magic = compile.Merge
 	}
-	s.Proc = process.New(guest.New(proc.Gm.Limit, proc.Gm.Mmu))
+	s.Proc = process.NewFromProc(proc)
 	s.Proc.Lim = proc.Lim
 	s.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ps.Ss))
 	{
@@ -58,6 +57,16 @@ func recoverScope(ps protocol.Scope, proc *process.Process) *compile.Scope {
 				TabletId: seg.TabletId,
 			}
 		}
+		s.DataSource.IsSummary = ps.Data.IsSummary
+		s.DataSource.Filters = make([]*compile.Filter, len(ps.Data.Filters))
+		for i, f := range ps.Data.Filters {
+			s.DataSource.Filters[i] = &compile.Filter{
+				Op:   f.Op,
+				Attr: f.Attr,
+				Val:  f.Val,
+				Vals: f.Vals,
+			}
+		}
 	}
 	s.PreScopes = make([]*compile.Scope, len(ps.Ss))
 	for i := range ps.Ss {
@@ -15,6 +15,9 @@
 package handler
 
 import (
+	"context"
+	"sync"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -22,4 +25,7 @@ import (
 type Handler struct {
 	engine engine.Engine
 	proc   *process.Process
+	// cancels, records the cancel functions of running scopes by scope id.
+	sync.Mutex
+	cancels map[string]context.CancelFunc
 }
@@ -0,0 +1,249 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mutation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func NewFilter(e extend.Extend) *Filter {
+	return &Filter{E: e}
+}
+
+func NewUpdate(e extend.Extend, attrs []string, sets map[string]extend.Extend) *Update {
+	return &Update{
+		E:     e,
+		Attrs: attrs,
+		Sets:  sets,
+	}
+}
+
+func (f *Filter) Attributes() []string {
+	if f.E == nil {
+		return nil
+	}
+	return f.E.Attributes()
+}
+
+// Filter returns the positions of the rows of bat for which E is true, like
+// restrict, an attribute is treated as true.
+func (f *Filter) Filter(bat *batch.Batch, proc *process.Process) ([]int64, error) {
+	if _, ok := f.E.(*extend.Attribute); f.E == nil || ok {
+		sels := make([]int64, bat.Length())
+		for i := range sels {
+			sels[i] = int64(i)
+		}
+		return sels, nil
+	}
+	keep(bat)
+	vec, _, err := f.E.Eval(bat, proc)
+	if err != nil {
+		return nil, err
+	}
+	sels, ok := vec.Col.([]int64)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a filter", f.E)
+	}
+	sels = append([]int64{}, sels...)
+	vec.Clean(proc)
+	return sels, nil
+}
+
+func (f *Filter) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := f.encode(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (u *Update) Attributes() []string {
+	return u.Attrs
+}
+
+func (u *Update) Filter(bat *batch.Batch, proc *process.Process) ([]int64, error) {
+	return NewFilter(u.E).Filter(bat, proc)
+}
+
+// Update returns the rows of bat at sels with the attributes in Sets
+// replaced by the value of their extend.
+func (u *Update) Update(bat *batch.Batch, sels []int64, proc *process.Process) (*batch.Batch, error) {
+	var err error
+
+	sbat := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		if sbat.Vecs[i], err = shuffle(vec, sels, proc); err != nil {
+			sbat.Clean(proc)
+			return nil, err
+		}
+	}
+	keep(sbat)
+	rbat := batch.New(true, u.Attrs)
+	for i, attr := range u.Attrs {
+		e, ok := u.Sets[attr]
+		if !ok {
+			if rbat.Vecs[i] = sbat.GetVector(attr); rbat.Vecs[i] == nil {
+				return nil, fmt.Errorf("unknown column '%s'", attr)
+			}
+			continue
+		}
+		vec, _, err := e.Eval(sbat, proc)
+		if err != nil {
+			return nil, err
+		}
+		// constants are expanded to the rows selected
+		if len(e.Attributes()) == 0 {
+			if vec, err = expand(vec, len(sels), proc); err != nil {
+				return nil, err
+			}
+		}
+		rbat.Vecs[i] = vec
+	}
+	return rbat, nil
+}
+
+func (u *Update) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := NewFilter(u.E).encode(&buf); err != nil {
+		return nil, err
+	}
+	data, err := encoding.Encode(u.Attrs)
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(encoding.EncodeUint32(uint32(len(data))))
+	buf.Write(data)
+	buf.Write(encoding.EncodeUint32(uint32(len(u.Sets))))
+	for _, attr := range u.Attrs {
+		e, ok := u.Sets[attr]
+		if !ok {
+			continue
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(attr))))
+		buf.WriteString(attr)
+		if err := protocol.EncodeExtend(e, &buf); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalFilter decodes the filter encoded by Filter.Marshal.
+func UnmarshalFilter(data []byte) (*Filter, error) {
+	f := new(Filter)
+	if _, err := f.decode(data); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalUpdate decodes the update encoded by Update.Marshal.
+func UnmarshalUpdate(data []byte) (*Update, error) {
+	f := new(Filter)
+	data, err := f.decode(data)
+	if err != nil {
+		return nil, err
+	}
+	u := &Update{E: f.E, Sets: make(map[string]extend.Extend)}
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if err := encoding.Decode(data[:n], &u.Attrs); err != nil {
+		return nil, err
+	}
+	data = data[n:]
+	cnt := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	for i := uint32(0); i < cnt; i++ {
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		attr := string(data[:n])
+		e, remaing, err := protocol.DecodeExtend(data[n:])
+		if err != nil {
+			return nil, err
+		}
+		u.Sets[attr] = e
+		data = remaing
+	}
+	return u, nil
+}
+
+func (f *Filter) encode(buf *bytes.Buffer) error {
+	if f.E == nil {
+		buf.WriteByte(0)
+		return nil
+	}
+	buf.WriteByte(1)
+	return protocol.EncodeExtend(f.E, buf)
+}
+
+func (f *Filter) decode(data []byte) ([]byte, error) {
+	if data[0] == 0 {
+		return data[1:], nil
+	}
+	e, data, err := protocol.DecodeExtend(data[1:])
+	if err != nil {
+		return nil, err
+	}
+	f.E = e
+	return data, nil
+}
+
+// keep keeps the columns of bat from being reused by the evaluation of
+// the extends.
+func keep(bat *batch.Batch) {
+	for _, vec := range bat.Vecs {
+		if vec.Ref < 2 {
+			vec.Ref = 2
+		}
+	}
+}
+
+// shuffle returns a copy of the rows of vec at sels.
+func shuffle(vec *vector.Vector, sels []int64, proc *process.Process) (*vector.Vector, error) {
+	rvec, err := vec.Dup(proc)
+	if err != nil {
+		return nil, err
+	}
+	// the nulls are filtered in place by the shuffle
+	rvec.Nsp = &nulls.Nulls{}
+	if vec.Nsp.Np != nil {
+		rvec.Nsp.Np = vec.Nsp.Np.Clone()
+	}
+	return rvec.Shuffle(sels, proc)
+}
+
+// expand repeats the constant n times.
+func expand(cv *vector.Vector, n int, proc *process.Process) (*vector.Vector, error) {
+	vec := vector.New(cv.Typ)
+	for i := 0; i < n; i++ {
+		if err := vec.UnionOne(cv, 0, proc); err != nil {
+			vec.Clean(proc)
+			return nil, err
+		}
+		if cv.Nsp.Contains(0) {
+			vec.Nsp.Add(uint64(i))
+		}
+	}
+	return vec, nil
+}
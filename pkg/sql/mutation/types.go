@@ -0,0 +1,32 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mutation
+
+import "github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+
+// Filter is the engine.RowFilter selecting the rows for which E is true,
+// all the rows are selected if E is nil.
+type Filter struct {
+	E extend.Extend
+}
+
+// Update is the engine.RowUpdate replacing the rows for which E is true,
+// or all the rows if E is nil. The attributes in Sets take the value of
+// their extend and the others keep their value.
+type Update struct {
+	E     extend.Extend
+	Attrs []string                 // attributes of the relation
+	Sets  map[string]extend.Extend // new values of the updated attributes
+}
@@ -0,0 +1,33 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package alterTable
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func New(r engine.Relation, opts []Option) *AlterTable {
+	return &AlterTable{R: r, Options: opts}
+}
+
+func (n *AlterTable) String() string {
+	return "ALTER TABLE"
+}
+
+func (n *AlterTable) Name() string                     { return "" }
+func (n *AlterTable) Rename(_ string)                  {}
+func (n *AlterTable) ResultColumns() []string          { return nil }
+func (n *AlterTable) SetColumns(_ []string)            {}
+func (n *AlterTable) Attribute() map[string]types.Type { return nil }
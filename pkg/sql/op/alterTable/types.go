@@ -0,0 +1,44 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package alterTable
+
+import "github.com/matrixorigin/matrixone/pkg/vm/engine"
+
+const (
+	AddColumn = iota
+	DropColumn
+	RenameColumn
+	AddPartition
+	DropPartition
+)
+
+// Option is one of the changes made by ALTER TABLE, the options
+// are applied in order.
+type Option struct {
+	Typ int
+	// Def is the attribute added or dropped
+	Def engine.TableDef
+	// OldName and NewName are the names of the attribute renamed
+	OldName string
+	NewName string
+	// Partition is the definition of the partitions added
+	Partition *engine.PartitionBy
+	// PartitionName is the name of the partition dropped
+	PartitionName string
+}
+
+type AlterTable struct {
+	R       engine.Relation
+	Options []Option
+}
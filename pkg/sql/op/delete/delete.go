@@ -0,0 +1,47 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package delete
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func New(id, db string, e extend.Extend, r engine.Relation) *Delete {
+	return &Delete{
+		R:  r,
+		E:  e,
+		ID: id,
+		DB: db,
+	}
+}
+
+func (n *Delete) String() string {
+	var buf bytes.Buffer
+
+	buf.WriteString(fmt.Sprintf("DELETE FROM %s.%s", n.DB, n.ID))
+	if n.E != nil {
+		buf.WriteString(fmt.Sprintf(" WHERE %s", n.E))
+	}
+	return buf.String()
+}
+
+func (n *Delete) Name() string                     { return "" }
+func (n *Delete) Rename(_ string)                  {}
+func (n *Delete) ResultColumns() []string          { return nil }
+func (n *Delete) SetColumns(_ []string)            {}
+func (n *Delete) Attribute() map[string]types.Type { return nil }
@@ -0,0 +1,39 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outerJoin
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+)
+
+const (
+	Left = iota
+	Right
+	Full
+)
+
+type Join struct {
+	R      op.OP
+	S      op.OP
+	Type   int
+	IsPD   bool // can be push down?
+	ID     string
+	Rattrs []string
+	Sattrs []string
+	Cond   extend.Extend // the conditions of ON besides the equalities of Rattrs and Sattrs
+	Attrs  map[string]types.Type
+}
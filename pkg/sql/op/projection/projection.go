@@ -53,10 +53,20 @@ func New(prev op.OP, es []*Extend) (*Projection, error) {
 			attrs[e.Alias] = types.Type{Oid: typ, Size: 4}
 		case types.T_float64:
 			attrs[e.Alias] = types.Type{Oid: typ, Size: 8}
+		case types.T_decimal64:
+			attrs[e.Alias] = types.Type{Oid: typ, Size: 8}
+		case types.T_decimal128:
+			attrs[e.Alias] = types.Type{Oid: typ, Size: 16}
+		case types.T_date:
+			attrs[e.Alias] = types.Type{Oid: typ, Size: 4}
+		case types.T_datetime:
+			attrs[e.Alias] = types.Type{Oid: typ, Size: 8}
 		case types.T_char:
 			attrs[e.Alias] = types.Type{Oid: typ, Size: 24}
 		case types.T_varchar:
 			attrs[e.Alias] = types.Type{Oid: typ, Size: 24}
+		case types.T_json:
+			attrs[e.Alias] = types.Type{Oid: typ, Size: 24}
 		case types.T_sel:
 			attrs[e.Alias] = types.Type{Oid: typ, Size: 8}
 		}
@@ -39,21 +39,23 @@ func New(s bool, name, schema string, r engine.Relation) *Relation {
 		for _, seg := range segs {
 			if u, ok := mp[seg.Node.Addr]; ok {
 				u.Segs = append(u.Segs, &Segment{
-					IsRemote: false,
-					Id:       seg.Id,
-					Node:     seg.Node,
-					Version:  seg.Version,
-					GroupId:  seg.GroupId,
-					TabletId: seg.TabletId,
+					IsRemote:  false,
+					Id:        seg.Id,
+					Node:      seg.Node,
+					Version:   seg.Version,
+					GroupId:   seg.GroupId,
+					TabletId:  seg.TabletId,
+					Partition: seg.Partition,
 				})
 			} else {
 				mp[seg.Node.Addr] = &Unit{[]*Segment{&Segment{
-					IsRemote: false,
-					Id:       seg.Id,
-					Node:     seg.Node,
-					Version:  seg.Version,
-					GroupId:  seg.GroupId,
-					TabletId: seg.TabletId,
+					IsRemote:  false,
+					Id:        seg.Id,
+					Node:      seg.Node,
+					Version:   seg.Version,
+					GroupId:   seg.GroupId,
+					TabletId:  seg.TabletId,
+					Partition: seg.Partition,
 				}}, seg.Node}
 			}
 		}
@@ -62,15 +64,16 @@ func New(s bool, name, schema string, r engine.Relation) *Relation {
 		}
 	}
 	return &Relation{
-		S:     s,
-		R:     r,
-		Us:    us,
-		Rs:    cols,
-		Cols:  cols,
-		Rid:   name,
-		ID:    name,
-		Attrs: attrs,
-		DB:    schema,
+		S:         s,
+		R:         r,
+		Us:        us,
+		Rs:        cols,
+		Cols:      cols,
+		Rid:       name,
+		ID:        name,
+		Attrs:     attrs,
+		DB:        schema,
+		Partition: r.Partition(),
 	}
 }
 
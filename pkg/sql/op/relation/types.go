@@ -27,6 +27,8 @@ type Segment struct {
 	GroupId  string
 	TabletId string
 	Node     metadata.Node
+	// Partition is the partition of the segment
+	Partition string
 }
 
 type Unit struct {
@@ -44,4 +46,7 @@ type Relation struct {
 	Cols  []string
 	R     engine.Relation
 	Attrs map[string]types.Type
+	// Partition is the partition definition of the relation, it is
+	// nil if the relation is not partitioned
+	Partition *engine.PartitionBy
 }
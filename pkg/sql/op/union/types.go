@@ -0,0 +1,38 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package union
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+)
+
+const (
+	UnionOp = iota
+	IntersectOp
+	ExceptOp
+)
+
+// Union is a set operation between two selects whose columns
+// are matched by position, the result has the columns of R.
+type Union struct {
+	R     op.OP
+	S     op.OP
+	Type  int
+	All   bool // keep the duplicates?
+	ID    string
+	Rs    []string // result columns
+	Attrs map[string]types.Type
+}
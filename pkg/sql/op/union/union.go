@@ -0,0 +1,73 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package union
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+)
+
+var opNames = [...]string{
+	UnionOp:     "∪",
+	IntersectOp: "∩",
+	ExceptOp:    "-",
+}
+
+func New(r, s op.OP, typ int, all bool) *Union {
+	rs := r.ResultColumns()
+	attrs := make(map[string]types.Type)
+	{
+		mp := r.Attribute()
+		for _, attr := range rs {
+			attrs[attr] = mp[attr]
+		}
+	}
+	return &Union{
+		R:     r,
+		S:     s,
+		Type:  typ,
+		All:   all,
+		Rs:    rs,
+		Attrs: attrs,
+	}
+}
+
+func (n *Union) Name() string {
+	return n.ID
+}
+
+func (n *Union) String() string {
+	if n.All {
+		return fmt.Sprintf("(%s) %s ALL (%s)", n.R, opNames[n.Type], n.S)
+	}
+	return fmt.Sprintf("(%s) %s (%s)", n.R, opNames[n.Type], n.S)
+}
+
+func (n *Union) Rename(name string) {
+	n.ID = name
+}
+
+func (n *Union) ResultColumns() []string {
+	return n.Rs
+}
+
+func (n *Union) SetColumns(cs []string) {
+	n.Rs = cs
+}
+
+func (n *Union) Attribute() map[string]types.Type {
+	return n.Attrs
+}
@@ -0,0 +1,30 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package update
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// Update replaces the rows selected by E with their new rows in a single
+// step of the relation.
+type Update struct {
+	ID    string
+	DB    string
+	E     extend.Extend            // rows for which E is true are updated, all rows if E is nil
+	Attrs []string                 // columns of the table
+	Sets  map[string]extend.Extend // new values of the updated columns
+	R     engine.Relation
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package update
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func New(id, db string, e extend.Extend, attrs []string, sets map[string]extend.Extend, r engine.Relation) *Update {
+	return &Update{
+		R:     r,
+		E:     e,
+		ID:    id,
+		DB:    db,
+		Attrs: attrs,
+		Sets:  sets,
+	}
+}
+
+func (n *Update) String() string {
+	var buf bytes.Buffer
+
+	buf.WriteString(fmt.Sprintf("UPDATE %s.%s", n.DB, n.ID))
+	for _, attr := range n.Attrs {
+		if e, ok := n.Sets[attr]; ok {
+			buf.WriteString(fmt.Sprintf(" %s = %s", attr, e))
+		}
+	}
+	if n.E != nil {
+		buf.WriteString(fmt.Sprintf(" WHERE %s", n.E))
+	}
+	return buf.String()
+}
+
+func (n *Update) Name() string                     { return "" }
+func (n *Update) Rename(_ string)                  {}
+func (n *Update) ResultColumns() []string          { return nil }
+func (n *Update) SetColumns(_ []string)            {}
+func (n *Update) Attribute() map[string]types.Type { return nil }
@@ -0,0 +1,87 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+)
+
+func New(prev op.OP, fs []window.Function) (*Window, error) {
+	attrs := make(map[string]types.Type)
+	{
+		for k, v := range prev.Attribute() {
+			attrs[k] = v
+		}
+	}
+	rs := append([]string{}, prev.ResultColumns()...)
+	for _, f := range fs {
+		if _, ok := attrs[f.Alias]; ok {
+			return nil, sqlerror.New(errno.AmbiguousAlias, fmt.Sprintf("alias '%s' is ambiguous", f.Alias))
+		}
+		switch f.Op {
+		case window.RowNumber, window.Rank, window.DenseRank:
+			attrs[f.Alias] = types.Type{Oid: types.T_int64, Size: 8}
+		case window.Lag, window.Lead:
+			attrs[f.Alias] = attrs[f.Name]
+		default:
+			attrs[f.Alias] = aggregation.ReturnType(f.Agg.Op, f.Agg.Agg.Type())
+		}
+		rs = append(rs, f.Alias)
+	}
+	return &Window{
+		Rs:    rs,
+		Fs:    fs,
+		Prev:  prev,
+		Attrs: attrs,
+	}, nil
+}
+
+func (n *Window) String() string {
+	r := fmt.Sprintf("%s -> ω([", n.Prev)
+	for i, f := range n.Fs {
+		if i > 0 {
+			r += ", "
+		}
+		r += f.String()
+	}
+	r += "])"
+	return r
+}
+
+func (n *Window) Name() string {
+	return n.ID
+}
+
+func (n *Window) Rename(name string) {
+	n.ID = name
+}
+
+func (n *Window) ResultColumns() []string {
+	return n.Rs
+}
+
+func (n *Window) SetColumns(cs []string) {
+	n.Rs = cs
+}
+
+func (n *Window) Attribute() map[string]types.Type {
+	return n.Attrs
+}
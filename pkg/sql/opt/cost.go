@@ -0,0 +1,189 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opt
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/dedup"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/innerJoin"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/outerJoin"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/product"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/relation"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/summarize"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/union"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/window"
+	"math"
+)
+
+// selectivity of predicates which can not be estimated from statistics,
+// the values follow the usual System R defaults.
+const (
+	EqSelectivity      = 0.1
+	RangeSelectivity   = 1.0 / 3
+	LikeSelectivity    = 0.25
+	DefaultSelectivity = 0.5
+	GroupSelectivity   = 0.1
+)
+
+// stats is the estimated output of an operator.
+type stats struct {
+	rows float64
+	size float64 // bytes
+}
+
+func (s stats) width() float64 {
+	if s.rows < 1 {
+		return s.size
+	}
+	return s.size / s.rows
+}
+
+// estimate returns the estimated output of o, the statistics of relations
+// come from the storage engine.
+func estimate(o op.OP) stats {
+	switch n := o.(type) {
+	case *relation.Relation:
+		return estimateRelation(n)
+	case *restrict.Restrict:
+		st := estimate(n.Prev)
+		sel := selectivity(n.E)
+		return stats{st.rows * sel, st.size * sel}
+	case *projection.Projection:
+		st := estimate(n.Prev)
+		return stats{st.rows, st.rows * width(n.Attrs)}
+	case *product.Product:
+		r, s := estimate(n.R), estimate(n.S)
+		rows := r.rows * s.rows
+		return stats{rows, rows * (r.width() + s.width())}
+	case *innerJoin.Join:
+		r, s := estimate(n.R), estimate(n.S)
+		rows := joinRows(r, s)
+		return stats{rows, rows * (r.width() + s.width())}
+	case *outerJoin.Join:
+		r, s := estimate(n.R), estimate(n.S)
+		rows := joinRows(r, s)
+		if rows < r.rows {
+			rows = r.rows
+		}
+		if rows < s.rows {
+			rows = s.rows
+		}
+		return stats{rows, rows * (r.width() + s.width())}
+	case *union.Union:
+		r, s := estimate(n.R), estimate(n.S)
+		return stats{r.rows + s.rows, r.size + s.size}
+	case *summarize.Summarize:
+		return stats{1, width(n.Attrs)}
+	case *group.Group:
+		st := estimate(n.Prev)
+		rows := st.rows * GroupSelectivity
+		return stats{rows, rows * width(n.Attrs)}
+	case *dedup.Dedup:
+		st := estimate(n.Prev)
+		return stats{st.rows * DefaultSelectivity, st.size * DefaultSelectivity}
+	case *order.Order:
+		return estimate(n.Prev)
+	case *window.Window:
+		st := estimate(n.Prev)
+		return stats{st.rows, st.rows * width(n.Attrs)}
+	case *limit.Limit:
+		return limitStats(estimate(n.Prev), n.Limit)
+	case *top.Top:
+		return limitStats(estimate(n.Prev), n.Limit)
+	}
+	return stats{}
+}
+
+func estimateRelation(n *relation.Relation) stats {
+	var st stats
+
+	if n.R == nil {
+		return st
+	}
+	st.rows = float64(n.R.Rows())
+	for _, attr := range n.Cols {
+		st.size += float64(n.R.Size(attr))
+	}
+	if st.size == 0 {
+		st.size = st.rows * width(n.Attrs)
+	}
+	return st
+}
+
+// joinRows estimates the output of an equi-join, the join attributes
+// are assumed to be a key of the larger side.
+func joinRows(r, s stats) float64 {
+	rows := r.rows * s.rows
+	if m := math.Max(r.rows, s.rows); m > 1 {
+		rows /= m
+	}
+	return rows
+}
+
+func limitStats(st stats, n int64) stats {
+	if rows := float64(n); rows < st.rows {
+		return stats{rows, rows * st.width()}
+	}
+	return st
+}
+
+func width(attrs map[string]types.Type) float64 {
+	var w float64
+
+	for _, typ := range attrs {
+		w += float64(typ.Size)
+	}
+	return w
+}
+
+// selectivity returns the estimated fraction of rows satisfying e.
+func selectivity(e extend.Extend) float64 {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		return selectivity(v.E)
+	case *extend.UnaryExtend:
+		if v.Op == overload.Not {
+			return 1 - selectivity(v.E)
+		}
+	case *extend.BinaryExtend:
+		switch v.Op {
+		case overload.And:
+			return selectivity(v.Left) * selectivity(v.Right)
+		case overload.Or:
+			l, r := selectivity(v.Left), selectivity(v.Right)
+			return l + r - l*r
+		case overload.EQ:
+			return EqSelectivity
+		case overload.NE:
+			return 1 - EqSelectivity
+		case overload.LT, overload.LE, overload.GT, overload.GE:
+			return RangeSelectivity
+		case overload.Like:
+			return LikeSelectivity
+		case overload.NotLike:
+			return 1 - LikeSelectivity
+		}
+	}
+	return DefaultSelectivity
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opt
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+)
+
+// conjuncts splits e into the list of predicates which are joined by AND.
+func conjuncts(e extend.Extend, es []extend.Extend) []extend.Extend {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		if isAnd(v.E) {
+			return conjuncts(v.E, es)
+		}
+	case *extend.BinaryExtend:
+		if v.Op == overload.And {
+			return conjuncts(v.Right, conjuncts(v.Left, es))
+		}
+	}
+	return append(es, e)
+}
+
+func isAnd(e extend.Extend) bool {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		return isAnd(v.E)
+	case *extend.BinaryExtend:
+		return v.Op == overload.And
+	}
+	return false
+}
+
+// and joins a non-empty list of predicates by AND.
+func and(es []extend.Extend) extend.Extend {
+	e := es[0]
+	for i := 1; i < len(es); i++ {
+		e = &extend.BinaryExtend{Op: overload.And, Left: e, Right: es[i]}
+	}
+	return e
+}
+
+// equiJoin returns the two attributes of an equality predicate between attributes.
+func equiJoin(e extend.Extend) (*extend.Attribute, *extend.Attribute, bool) {
+	if v, ok := e.(*extend.ParenExtend); ok {
+		return equiJoin(v.E)
+	}
+	v, ok := e.(*extend.BinaryExtend)
+	if !ok || v.Op != overload.EQ {
+		return nil, nil, false
+	}
+	left, ok := stripParen(v.Left).(*extend.Attribute)
+	if !ok {
+		return nil, nil, false
+	}
+	right, ok := stripParen(v.Right).(*extend.Attribute)
+	if !ok || left.Type != right.Type || left.Name == right.Name {
+		return nil, nil, false
+	}
+	return left, right, true
+}
+
+func stripParen(e extend.Extend) extend.Extend {
+	if v, ok := e.(*extend.ParenExtend); ok {
+		return stripParen(v.E)
+	}
+	return e
+}
+
+// substitute returns a copy of e in which every attribute found in mp is replaced.
+func substitute(e extend.Extend, mp map[string]extend.Extend) extend.Extend {
+	switch v := e.(type) {
+	case *extend.Attribute:
+		if r, ok := mp[v.Name]; ok {
+			return r
+		}
+		return v
+	case *extend.ParenExtend:
+		return &extend.ParenExtend{E: substitute(v.E, mp)}
+	case *extend.UnaryExtend:
+		return &extend.UnaryExtend{Op: v.Op, E: substitute(v.E, mp)}
+	case *extend.BinaryExtend:
+		return &extend.BinaryExtend{Op: v.Op, Left: substitute(v.Left, mp), Right: substitute(v.Right, mp)}
+	case *extend.MultiExtend:
+		args := make([]extend.Extend, len(v.Args))
+		for i, arg := range v.Args {
+			args[i] = substitute(arg, mp)
+		}
+		return &extend.MultiExtend{Op: v.Op, Args: args}
+	}
+	return e
+}
@@ -0,0 +1,265 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opt
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/innerJoin"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/product"
+	"strings"
+)
+
+// graph is a tree of products and inner joins flattened into
+// its inputs and the predicates over them.
+type graph struct {
+	rs  []op.OP
+	sts []stats
+	// attrs maps the attribute name seen above the tree to the input
+	// which owns it, ambiguous attributes are mapped to -1.
+	attrs map[string]int
+	// locals maps the attribute name seen above the tree to the
+	// attribute name of each input.
+	locals []map[string]extend.Extend
+}
+
+// predicate is a predicate of a graph and the inputs it refers.
+type predicate struct {
+	e  extend.Extend
+	rs []int
+}
+
+// optimizeJoin rebuilds the join tree o filtered by es, the predicates which
+// refer to only one input are pushed into it, the equality predicates between
+// inputs become join conditions, and the inputs are joined greedily starting
+// from the cheapest one.
+func optimizeJoin(o op.OP, es []extend.Extend) op.OP {
+	g := &graph{attrs: make(map[string]int)}
+	es = g.flatten(o, es, true)
+	ps, rs := g.predicates(es)
+	{
+		ess := make([][]extend.Extend, len(g.rs))
+		for _, p := range ps {
+			if len(p.rs) == 1 {
+				ess[p.rs[0]] = append(ess[p.rs[0]], substitute(p.e, g.locals[p.rs[0]]))
+			}
+		}
+		g.sts = make([]stats, len(g.rs))
+		for i, r := range g.rs {
+			g.rs[i] = rename(pushdown(r, ess[i]), r.Name())
+			g.sts[i] = estimate(g.rs[i])
+		}
+	}
+	var jps []*predicate
+	for _, p := range ps {
+		if len(p.rs) > 1 {
+			jps = append(jps, p)
+		}
+	}
+	return rename(newRestrict(g.join(jps), rs), o.Name())
+}
+
+// flatten collects the inputs of o and the join conditions of inner joins.
+func (g *graph) flatten(o op.OP, es []extend.Extend, isRoot bool) []extend.Extend {
+	if isRoot || len(o.Name()) == 0 {
+		switch n := o.(type) {
+		case *product.Product:
+			es = g.flatten(n.R, es, false)
+			return g.flatten(n.S, es, false)
+		case *innerJoin.Join:
+			es = g.flatten(n.R, es, false)
+			es = g.flatten(n.S, es, false)
+			for i := range n.Rattrs {
+				es = append(es, &extend.BinaryExtend{
+					Op:    overload.EQ,
+					Left:  attribute(n.R, n.Rattrs[i]),
+					Right: attribute(n.S, n.Sattrs[i]),
+				})
+			}
+			return es
+		}
+	}
+	i := len(g.rs)
+	g.rs = append(g.rs, o)
+	g.locals = append(g.locals, make(map[string]extend.Extend))
+	for k, typ := range o.Attribute() {
+		name := qualify(o, k)
+		if _, ok := g.attrs[name]; ok {
+			g.attrs[name] = -1
+			continue
+		}
+		g.attrs[name] = i
+		g.locals[i][name] = &extend.Attribute{Name: k, Type: typ.Oid}
+	}
+	return es
+}
+
+// predicates returns the predicates which refer to inputs of g and
+// the others which must be evaluated over the whole tree.
+func (g *graph) predicates(es []extend.Extend) ([]*predicate, []extend.Extend) {
+	var rs []extend.Extend
+	var ps []*predicate
+
+	for _, e := range es {
+		p := &predicate{e: e}
+		for _, attr := range e.Attributes() {
+			i, ok := g.attrs[attr]
+			if !ok || i < 0 {
+				p.rs = nil
+				break
+			}
+			p.rs = appendInput(p.rs, i)
+		}
+		if len(p.rs) == 0 {
+			rs = append(rs, e)
+			continue
+		}
+		ps = append(ps, p)
+	}
+	return ps, rs
+}
+
+// join builds a left deep join tree of the inputs, at each step the input
+// which produces the smallest result is joined, inputs which are connected
+// by a join condition are preferred to cartesian products.
+func (g *graph) join(ps []*predicate) op.OP {
+	used := make([]bool, len(g.rs))
+	first := 0
+	for j := range g.rs {
+		if g.sts[j].rows < g.sts[first].rows {
+			first = j
+		}
+	}
+	used[first] = true
+	o, st := g.rs[first], g.sts[first]
+	for k := 1; k < len(g.rs); k++ {
+		i, isJoin := -1, false
+		var rows float64
+		for j := range g.rs {
+			if used[j] {
+				continue
+			}
+			ok := g.isConnected(ps, used, j)
+			r := st.rows * g.sts[j].rows
+			if ok {
+				r = joinRows(st, g.sts[j])
+			}
+			if i < 0 || (ok && !isJoin) || (ok == isJoin && r < rows) {
+				i, isJoin, rows = j, ok, r
+			}
+		}
+		used[i] = true
+		var es []extend.Extend
+		var rattrs, sattrs []string
+		for j, p := range ps {
+			if p == nil || !g.isCovered(p, used) {
+				continue
+			}
+			if l, r, ok := equiJoin(p.e); ok && isJoin {
+				li, ri := g.attrs[l.Name], g.attrs[r.Name]
+				switch {
+				case li == i && ri != i:
+					rattrs = append(rattrs, local(o, r.Name))
+					sattrs = append(sattrs, l.Name)
+					ps[j] = nil
+					continue
+				case ri == i && li != i:
+					rattrs = append(rattrs, local(o, l.Name))
+					sattrs = append(sattrs, r.Name)
+					ps[j] = nil
+					continue
+				}
+			}
+			es = append(es, p.e)
+			ps[j] = nil
+		}
+		for j, attr := range sattrs {
+			sattrs[j] = g.locals[i][attr].(*extend.Attribute).Name
+		}
+		o = newRestrict(g.newJoin(o, g.rs[i], st, g.sts[i], rattrs, sattrs), es)
+		st = estimate(o)
+	}
+	return o
+}
+
+// newJoin returns the join of r and s, the smaller one becomes
+// the build side of the hash join.
+func (g *graph) newJoin(r, s op.OP, rst, sst stats, rattrs, sattrs []string) op.OP {
+	if len(rattrs) == 0 {
+		return product.New(r, s)
+	}
+	if sst.size > rst.size || (sst.size == rst.size && sst.rows > rst.rows) {
+		return innerJoin.New(s, r, sattrs, rattrs)
+	}
+	return innerJoin.New(r, s, rattrs, sattrs)
+}
+
+// isConnected returns true if there is an equality predicate between
+// input i and the inputs which have been joined.
+func (g *graph) isConnected(ps []*predicate, used []bool, i int) bool {
+	for _, p := range ps {
+		if p == nil {
+			continue
+		}
+		l, r, ok := equiJoin(p.e)
+		if !ok {
+			continue
+		}
+		li, ri := g.attrs[l.Name], g.attrs[r.Name]
+		if (li == i && used[ri]) || (ri == i && used[li]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *graph) isCovered(p *predicate, used []bool) bool {
+	for _, i := range p.rs {
+		if !used[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func appendInput(rs []int, i int) []int {
+	for _, r := range rs {
+		if r == i {
+			return rs
+		}
+	}
+	return append(rs, i)
+}
+
+// attribute returns the attribute k of o as seen by the parent of o.
+func attribute(o op.OP, k string) *extend.Attribute {
+	return &extend.Attribute{Name: qualify(o, k), Type: o.Attribute()[k].Oid}
+}
+
+func qualify(o op.OP, k string) string {
+	if name := o.Name(); len(name) > 0 {
+		return name + "." + k
+	}
+	return k
+}
+
+// local returns the attribute name of o of an attribute seen by the parent of o.
+func local(o op.OP, attr string) string {
+	if name := o.Name(); len(name) > 0 {
+		return strings.TrimPrefix(attr, name+".")
+	}
+	return attr
+}
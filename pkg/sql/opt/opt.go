@@ -15,9 +15,127 @@
 package opt
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/op"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/dedup"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/innerJoin"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/naturalJoin"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/offset"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/outerJoin"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/product"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/summarize"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/union"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/window"
 )
 
+// Optimize rewrites the relation algebra operator chain:
+//  1. removes useless projections.
+//  2. pushes predicates down toward the relations.
+//  3. turns products with equality predicates into inner joins.
+//  4. reorders joins according to the statistics of relations.
 func Optimize(o op.OP) op.OP {
+	if o == nil {
+		return nil
+	}
+	return optimize(prune(o))
+}
+
+func optimize(o op.OP) op.OP {
+	switch n := o.(type) {
+	case *top.Top:
+		n.Prev = optimize(n.Prev)
+	case *dedup.Dedup:
+		n.Prev = optimize(n.Prev)
+	case *group.Group:
+		n.Prev = optimize(n.Prev)
+	case *limit.Limit:
+		n.Prev = optimize(n.Prev)
+	case *offset.Offset:
+		n.Prev = optimize(n.Prev)
+	case *order.Order:
+		n.Prev = optimize(n.Prev)
+	case *summarize.Summarize:
+		n.Prev = optimize(n.Prev)
+	case *window.Window:
+		n.Prev = optimize(n.Prev)
+	case *projection.Projection:
+		n.Prev = optimize(n.Prev)
+	case *naturalJoin.Join:
+		n.R = optimize(n.R)
+		n.S = optimize(n.S)
+	case *outerJoin.Join:
+		n.R = optimize(n.R)
+		n.S = optimize(n.S)
+	case *union.Union:
+		n.R = optimize(n.R)
+		n.S = optimize(n.S)
+	case *product.Product:
+		return optimizeJoin(n, nil)
+	case *innerJoin.Join:
+		return optimizeJoin(n, nil)
+	case *restrict.Restrict:
+		return rename(pushdown(n.Prev, conjuncts(n.E, nil)), n.ID)
+	}
+	return o
+}
+
+// pushdown moves the predicates es as close to the relations as possible.
+func pushdown(o op.OP, es []extend.Extend) op.OP {
+	switch n := o.(type) {
+	case *restrict.Restrict:
+		return rename(pushdown(n.Prev, conjuncts(n.E, es)), n.ID)
+	case *product.Product:
+		return optimizeJoin(n, es)
+	case *innerJoin.Join:
+		return optimizeJoin(n, es)
+	case *projection.Projection:
+		var pes, rs []extend.Extend
+
+		mp := make(map[string]extend.Extend)
+		for _, e := range n.Es {
+			mp[e.Alias] = e.E
+		}
+		for _, e := range es {
+			if isCovered(e, mp) {
+				pes = append(pes, substitute(e, mp))
+			} else {
+				rs = append(rs, e)
+			}
+		}
+		n.Prev = pushdown(n.Prev, pes)
+		return newRestrict(n, rs)
+	}
+	return newRestrict(optimize(o), es)
+}
+
+// newRestrict returns a restrict of o by es which has the same name as o.
+func newRestrict(o op.OP, es []extend.Extend) op.OP {
+	if len(es) == 0 {
+		return o
+	}
+	r := restrict.New(o, and(es))
+	r.Rename(o.Name())
+	return r
+}
+
+func rename(o op.OP, name string) op.OP {
+	if len(name) > 0 {
+		o.Rename(name)
+	}
 	return o
 }
+
+func isCovered(e extend.Extend, mp map[string]extend.Extend) bool {
+	for _, attr := range e.Attributes() {
+		if _, ok := mp[attr]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -1,19 +1,24 @@
-package compile
+package opt
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/op"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/dedup"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/group"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/innerJoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/limit"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/naturalJoin"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/outerJoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/relation"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/summarize"
 	"github.com/matrixorigin/matrixone/pkg/sql/op/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/union"
+	"github.com/matrixorigin/matrixone/pkg/sql/op/window"
 )
 
 func prune(o op.OP) op.OP {
@@ -30,6 +35,9 @@ func prune(o op.OP) op.OP {
 	case *limit.Limit:
 		n.Prev = prune(n.Prev)
 		return n
+	case *offset.Offset:
+		n.Prev = prune(n.Prev)
+		return n
 	case *order.Order:
 		n.Prev = prune(n.Prev)
 		return n
@@ -45,6 +53,14 @@ func prune(o op.OP) op.OP {
 		n.R = prune(n.R)
 		n.S = prune(n.S)
 		return n
+	case *outerJoin.Join:
+		n.R = prune(n.R)
+		n.S = prune(n.S)
+		return n
+	case *union.Union:
+		n.R = prune(n.R)
+		n.S = prune(n.S)
+		return n
 	case *relation.Relation:
 		return n
 	case *restrict.Restrict:
@@ -53,6 +69,9 @@ func prune(o op.OP) op.OP {
 	case *summarize.Summarize:
 		n.Prev = prune(n.Prev)
 		return n
+	case *window.Window:
+		n.Prev = prune(n.Prev)
+		return n
 	case *projection.Projection:
 		o = pruneProjection(n)
 		n, _ = o.(*projection.Projection)
@@ -62,10 +81,18 @@ func prune(o op.OP) op.OP {
 	return o
 }
 
+// pruneProjection removes the projections below n which are either the same
+// as n or only rename attributes.
 func pruneProjection(n *projection.Projection) op.OP {
-	es := n.Es
 	for prev, ok := n.Prev.(*projection.Projection); ok; prev, ok = n.Prev.(*projection.Projection) {
-		if projectionExtendEq(es, prev.Es) {
+		if projectionExtendEq(n.Es, prev.Es) {
+			n.Prev = prev.Prev
+			continue
+		}
+		if mp, ok := projectionRename(prev.Es); ok {
+			for _, e := range n.Es {
+				e.E = substitute(e.E, mp)
+			}
 			n.Prev = prev.Prev
 			continue
 		}
@@ -74,6 +101,19 @@ func pruneProjection(n *projection.Projection) op.OP {
 	return n
 }
 
+// projectionRename returns the mapping from alias to attribute if all
+// the extends are attributes.
+func projectionRename(es []*projection.Extend) (map[string]extend.Extend, bool) {
+	mp := make(map[string]extend.Extend)
+	for _, e := range es {
+		if _, ok := e.E.(*extend.Attribute); !ok {
+			return nil, false
+		}
+		mp[e.Alias] = e.E
+	}
+	return mp, true
+}
+
 func projectionExtendEq(xs, ys []*projection.Extend) bool {
 	if len(xs) != len(ys) {
 		return false
@@ -33,6 +33,33 @@ func Parse(sql string) ([]tree.Statement, error) {
 	return lexer.stmts, nil
 }
 
+// ParseWithParams parses the sql of a prepared statement, the placeholders
+// are replaced with the values of params in order.
+func ParseWithParams(sql string, params []tree.Expr) ([]tree.Statement, error) {
+	lexer := NewLexer(dialect.MYSQL, sql)
+	lexer.params = params
+	if yyParse(lexer) != 0 {
+		return nil, lexer.scanner.LastError
+	}
+	if lexer.nparams != len(params) {
+		return nil, fmt.Errorf("incorrect arguments, %v placeholders but %v values", lexer.nparams, len(params))
+	}
+	return lexer.stmts, nil
+}
+
+// Prepare parses the sql of a prepared statement,
+// it returns the statement and the number of its placeholders.
+func Prepare(sql string) (tree.Statement, int, error) {
+	lexer := NewLexer(dialect.MYSQL, sql)
+	if yyParse(lexer) != 0 {
+		return nil, 0, lexer.scanner.LastError
+	}
+	if len(lexer.stmts) != 1 {
+		return nil, 0, errors.New("syntax error, or too many sql to prepare")
+	}
+	return lexer.stmts[0], lexer.nparams, nil
+}
+
 func ParseOne(sql string) (tree.Statement, error) {
 	lexer := NewLexer(dialect.MYSQL, sql)
 	if yyParse(lexer) != 0 {
@@ -47,6 +74,10 @@ func ParseOne(sql string) (tree.Statement, error) {
 type Lexer struct {
 	scanner *scanner.Scanner
 	stmts   []tree.Statement
+	// params are the values bound to the placeholders, nil if the placeholders are kept
+	params []tree.Expr
+	// nparams is the number of placeholders
+	nparams int
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
@@ -84,6 +115,22 @@ func (l *Lexer) AppendStmt(stmt tree.Statement) {
 	l.stmts = append(l.stmts, stmt)
 }
 
+// param returns the value of the placeholder name, which is ":v" followed by its position.
+func (l *Lexer) param(name string) (tree.Expr, error) {
+	offset, err := strconv.Atoi(name[2:])
+	if err != nil {
+		return nil, err
+	}
+	l.nparams = offset
+	if l.params == nil {
+		return tree.NewParamExpr(offset), nil
+	}
+	if offset > len(l.params) {
+		return nil, fmt.Errorf("incorrect arguments, no value for the placeholder %v", offset)
+	}
+	return l.params[offset-1], nil
+}
+
 func (l *Lexer) toInt(lval *yySymType, str string) int {
 	ival, err := strconv.ParseUint(str, 10, 64)
 	if err != nil {
@@ -20,395 +20,414 @@ import (
 
 const LEX_ERROR = 57346
 const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const INSERT = 57350
-const UPDATE = 57351
-const DELETE = 57352
-const FROM = 57353
-const WHERE = 57354
-const GROUP = 57355
-const HAVING = 57356
-const ORDER = 57357
-const BY = 57358
-const LIMIT = 57359
-const OFFSET = 57360
-const FOR = 57361
-const ALL = 57362
-const DISTINCT = 57363
-const DISTINCTROW = 57364
-const AS = 57365
-const EXISTS = 57366
-const ASC = 57367
-const DESC = 57368
-const INTO = 57369
-const DUPLICATE = 57370
-const DEFAULT = 57371
-const SET = 57372
-const LOCK = 57373
-const KEYS = 57374
-const VALUES = 57375
-const LAST_INSERT_ID = 57376
-const NEXT = 57377
-const VALUE = 57378
-const SHARE = 57379
-const MODE = 57380
-const SQL_NO_CACHE = 57381
-const SQL_CACHE = 57382
-const JOIN = 57383
-const STRAIGHT_JOIN = 57384
-const LEFT = 57385
-const RIGHT = 57386
-const INNER = 57387
-const OUTER = 57388
-const CROSS = 57389
-const NATURAL = 57390
-const USE = 57391
-const FORCE = 57392
-const ON = 57393
-const USING = 57394
-const SUBQUERY_AS_EXPR = 57395
-const ID = 57396
-const AT_ID = 57397
-const AT_AT_ID = 57398
-const STRING = 57399
-const VALUE_ARG = 57400
-const LIST_ARG = 57401
-const COMMENT = 57402
-const COMMENT_KEYWORD = 57403
-const INTEGRAL = 57404
-const HEX = 57405
-const HEXNUM = 57406
-const BIT_LITERAL = 57407
-const FLOAT = 57408
-const NULL = 57409
-const TRUE = 57410
-const FALSE = 57411
-const EMPTY_FROM_CLAUSE = 57412
-const LOWER_THAN_CHARSET = 57413
-const CHARSET = 57414
-const UNIQUE = 57415
-const KEY = 57416
-const OR = 57417
-const XOR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const LE = 57427
-const GE = 57428
-const NE = 57429
-const NULL_SAFE_EQUAL = 57430
-const IS = 57431
-const LIKE = 57432
-const REGEXP = 57433
-const IN = 57434
-const ASSIGNMENT = 57435
-const SHIFT_LEFT = 57436
-const SHIFT_RIGHT = 57437
-const DIV = 57438
-const MOD = 57439
-const UNARY = 57440
-const COLLATE = 57441
-const BINARY = 57442
-const UNDERSCORE_BINARY = 57443
-const INTERVAL = 57444
-const BEGIN = 57445
-const START = 57446
-const TRANSACTION = 57447
-const COMMIT = 57448
-const ROLLBACK = 57449
-const WORK = 57450
-const CONSISTENT = 57451
-const SNAPSHOT = 57452
-const CHAIN = 57453
-const NO = 57454
-const RELEASE = 57455
-const BIT = 57456
-const TINYINT = 57457
-const SMALLINT = 57458
-const MEDIUMINT = 57459
-const INT = 57460
-const INTEGER = 57461
-const BIGINT = 57462
-const INTNUM = 57463
-const REAL = 57464
-const DOUBLE = 57465
-const FLOAT_TYPE = 57466
-const DECIMAL = 57467
-const NUMERIC = 57468
-const TIME = 57469
-const TIMESTAMP = 57470
-const DATETIME = 57471
-const YEAR = 57472
-const CHAR = 57473
-const VARCHAR = 57474
-const BOOL = 57475
-const CHARACTER = 57476
-const VARBINARY = 57477
-const NCHAR = 57478
-const TEXT = 57479
-const TINYTEXT = 57480
-const MEDIUMTEXT = 57481
-const LONGTEXT = 57482
-const BLOB = 57483
-const TINYBLOB = 57484
-const MEDIUMBLOB = 57485
-const LONGBLOB = 57486
-const JSON = 57487
-const ENUM = 57488
-const GEOMETRY = 57489
-const POINT = 57490
-const LINESTRING = 57491
-const POLYGON = 57492
-const GEOMETRYCOLLECTION = 57493
-const MULTIPOINT = 57494
-const MULTILINESTRING = 57495
-const MULTIPOLYGON = 57496
-const INT1 = 57497
-const INT2 = 57498
-const INT3 = 57499
-const INT4 = 57500
-const INT8 = 57501
-const CREATE = 57502
-const ALTER = 57503
-const DROP = 57504
-const RENAME = 57505
-const ANALYZE = 57506
-const ADD = 57507
-const SCHEMA = 57508
-const TABLE = 57509
-const INDEX = 57510
-const VIEW = 57511
-const TO = 57512
-const IGNORE = 57513
-const IF = 57514
-const PRIMARY = 57515
-const COLUMN = 57516
-const CONSTRAINT = 57517
-const SPATIAL = 57518
-const FULLTEXT = 57519
-const FOREIGN = 57520
-const KEY_BLOCK_SIZE = 57521
-const SHOW = 57522
-const DESCRIBE = 57523
-const EXPLAIN = 57524
-const DATE = 57525
-const ESCAPE = 57526
-const REPAIR = 57527
-const OPTIMIZE = 57528
-const TRUNCATE = 57529
-const MAXVALUE = 57530
-const PARTITION = 57531
-const REORGANIZE = 57532
-const LESS = 57533
-const THAN = 57534
-const PROCEDURE = 57535
-const TRIGGER = 57536
-const STATUS = 57537
-const VARIABLES = 57538
-const ROLE = 57539
-const PROXY = 57540
-const AVG_ROW_LENGTH = 57541
-const STORAGE = 57542
-const DISK = 57543
-const MEMORY = 57544
-const CHECKSUM = 57545
-const COMPRESSION = 57546
-const DATA = 57547
-const DIRECTORY = 57548
-const DELAY_KEY_WRITE = 57549
-const ENCRYPTION = 57550
-const ENGINE = 57551
-const MAX_ROWS = 57552
-const MIN_ROWS = 57553
-const PACK_KEYS = 57554
-const ROW_FORMAT = 57555
-const STATS_AUTO_RECALC = 57556
-const STATS_PERSISTENT = 57557
-const STATS_SAMPLE_PAGES = 57558
-const DYNAMIC = 57559
-const COMPRESSED = 57560
-const REDUNDANT = 57561
-const COMPACT = 57562
-const FIXED = 57563
-const COLUMN_FORMAT = 57564
-const AUTO_RANDOM = 57565
-const RESTRICT = 57566
-const CASCADE = 57567
-const ACTION = 57568
-const PARTIAL = 57569
-const SIMPLE = 57570
-const CHECK = 57571
-const ENFORCED = 57572
-const RANGE = 57573
-const LIST = 57574
-const ALGORITHM = 57575
-const LINEAR = 57576
-const PARTITIONS = 57577
-const SUBPARTITION = 57578
-const SUBPARTITIONS = 57579
-const PARSER = 57580
-const VISIBLE = 57581
-const INVISIBLE = 57582
-const BTREE = 57583
-const HASH = 57584
-const RTREE = 57585
-const EXPIRE = 57586
-const ACCOUNT = 57587
-const UNLOCK = 57588
-const DAY = 57589
-const NEVER = 57590
-const SECOND = 57591
-const ASCII = 57592
-const COALESCE = 57593
-const COLLATION = 57594
-const HOUR = 57595
-const MICROSECOND = 57596
-const MINUTE = 57597
-const MONTH = 57598
-const QUARTER = 57599
-const REPEAT = 57600
-const REVERSE = 57601
-const ROW_COUNT = 57602
-const WEEK = 57603
-const REVOKE = 57604
-const FUNCTION = 57605
-const PRIVILEGES = 57606
-const TABLESPACE = 57607
-const EXECUTE = 57608
-const SUPER = 57609
-const GRANT = 57610
-const OPTION = 57611
-const REFERENCES = 57612
-const REPLICATION = 57613
-const SLAVE = 57614
-const CLIENT = 57615
-const USAGE = 57616
-const RELOAD = 57617
-const FILE = 57618
-const TEMPORARY = 57619
-const ROUTINE = 57620
-const EVENT = 57621
-const SHUTDOWN = 57622
-const NULLX = 57623
-const AUTO_INCREMENT = 57624
-const APPROXNUM = 57625
-const SIGNED = 57626
-const UNSIGNED = 57627
-const ZEROFILL = 57628
-const USER = 57629
-const IDENTIFIED = 57630
-const CIPHER = 57631
-const ISSUER = 57632
-const X509 = 57633
-const SUBJECT = 57634
-const SAN = 57635
-const REQUIRE = 57636
-const SSL = 57637
-const NONE = 57638
-const PASSWORD = 57639
-const MAX_QUERIES_PER_HOUR = 57640
-const MAX_UPDATES_PER_HOUR = 57641
-const MAX_CONNECTIONS_PER_HOUR = 57642
-const MAX_USER_CONNECTIONS = 57643
-const FORMAT = 57644
-const CONNECTION = 57645
-const LOAD = 57646
-const INFILE = 57647
-const TERMINATED = 57648
-const OPTIONALLY = 57649
-const ENCLOSED = 57650
-const ESCAPED = 57651
-const STARTING = 57652
-const LINES = 57653
-const DATABASES = 57654
-const TABLES = 57655
-const EXTENDED = 57656
-const FULL = 57657
-const PROCESSLIST = 57658
-const FIELDS = 57659
-const COLUMNS = 57660
-const OPEN = 57661
-const ERRORS = 57662
-const WARNINGS = 57663
-const INDEXES = 57664
-const NAMES = 57665
-const GLOBAL = 57666
-const SESSION = 57667
-const ISOLATION = 57668
-const LEVEL = 57669
-const READ = 57670
-const WRITE = 57671
-const ONLY = 57672
-const REPEATABLE = 57673
-const COMMITTED = 57674
-const UNCOMMITTED = 57675
-const SERIALIZABLE = 57676
-const LOCAL = 57677
-const EXCEPT = 57678
-const CURRENT_TIMESTAMP = 57679
-const DATABASE = 57680
-const CURRENT_TIME = 57681
-const LOCALTIME = 57682
-const LOCALTIMESTAMP = 57683
-const UTC_DATE = 57684
-const UTC_TIME = 57685
-const UTC_TIMESTAMP = 57686
-const REPLACE = 57687
-const CONVERT = 57688
-const SEPARATOR = 57689
-const CURRENT_DATE = 57690
-const CURRENT_USER = 57691
-const CURRENT_ROLE = 57692
-const MATCH = 57693
-const AGAINST = 57694
-const BOOLEAN = 57695
-const LANGUAGE = 57696
-const WITH = 57697
-const QUERY = 57698
-const EXPANSION = 57699
-const ADDDATE = 57700
-const BIT_AND = 57701
-const BIT_OR = 57702
-const BIT_XOR = 57703
-const CAST = 57704
-const COUNT = 57705
-const APPROX_COUNT_DISTINCT = 57706
-const APPROX_PERCENTILE = 57707
-const CURDATE = 57708
-const CURTIME = 57709
-const DATE_ADD = 57710
-const DATE_SUB = 57711
-const EXTRACT = 57712
-const GROUP_CONCAT = 57713
-const MAX = 57714
-const MID = 57715
-const MIN = 57716
-const NOW = 57717
-const POSITION = 57718
-const SESSION_USER = 57719
-const STD = 57720
-const STDDEV = 57721
-const STDDEV_POP = 57722
-const STDDEV_SAMP = 57723
-const SUBDATE = 57724
-const SUBSTR = 57725
-const SUBSTRING = 57726
-const SUM = 57727
-const SYSDATE = 57728
-const SYSTEM_USER = 57729
-const TRANSLATE = 57730
-const TRIM = 57731
-const VARIANCE = 57732
-const VAR_POP = 57733
-const VAR_SAMP = 57734
-const AVG = 57735
-const UNUSED = 57736
+const EXCEPT = 57348
+const INTERSECT = 57349
+const SELECT = 57350
+const STREAM = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const OFFSET = 57362
+const FOR = 57363
+const ALL = 57364
+const DISTINCT = 57365
+const DISTINCTROW = 57366
+const AS = 57367
+const EXISTS = 57368
+const ASC = 57369
+const DESC = 57370
+const INTO = 57371
+const DUPLICATE = 57372
+const DEFAULT = 57373
+const SET = 57374
+const LOCK = 57375
+const KEYS = 57376
+const VALUES = 57377
+const LAST_INSERT_ID = 57378
+const NEXT = 57379
+const VALUE = 57380
+const SHARE = 57381
+const MODE = 57382
+const SQL_NO_CACHE = 57383
+const SQL_CACHE = 57384
+const JOIN = 57385
+const STRAIGHT_JOIN = 57386
+const LEFT = 57387
+const RIGHT = 57388
+const FULL = 57389
+const INNER = 57390
+const OUTER = 57391
+const CROSS = 57392
+const NATURAL = 57393
+const USE = 57394
+const FORCE = 57395
+const ON = 57396
+const USING = 57397
+const SUBQUERY_AS_EXPR = 57398
+const ID = 57399
+const AT_ID = 57400
+const AT_AT_ID = 57401
+const STRING = 57402
+const VALUE_ARG = 57403
+const LIST_ARG = 57404
+const COMMENT = 57405
+const COMMENT_KEYWORD = 57406
+const INTEGRAL = 57407
+const HEX = 57408
+const HEXNUM = 57409
+const BIT_LITERAL = 57410
+const FLOAT = 57411
+const NULL = 57412
+const TRUE = 57413
+const FALSE = 57414
+const EMPTY_FROM_CLAUSE = 57415
+const LOWER_THAN_CHARSET = 57416
+const CHARSET = 57417
+const UNIQUE = 57418
+const KEY = 57419
+const OR = 57420
+const XOR = 57421
+const AND = 57422
+const NOT = 57423
+const BETWEEN = 57424
+const CASE = 57425
+const WHEN = 57426
+const THEN = 57427
+const ELSE = 57428
+const END = 57429
+const LE = 57430
+const GE = 57431
+const NE = 57432
+const NULL_SAFE_EQUAL = 57433
+const IS = 57434
+const LIKE = 57435
+const REGEXP = 57436
+const IN = 57437
+const ASSIGNMENT = 57438
+const JSON_EXTRACT_OP = 57439
+const JSON_UNQUOTE_EXTRACT_OP = 57440
+const SHIFT_LEFT = 57441
+const SHIFT_RIGHT = 57442
+const DIV = 57443
+const MOD = 57444
+const UNARY = 57445
+const COLLATE = 57446
+const BINARY = 57447
+const UNDERSCORE_BINARY = 57448
+const INTERVAL = 57449
+const BEGIN = 57450
+const START = 57451
+const TRANSACTION = 57452
+const COMMIT = 57453
+const ROLLBACK = 57454
+const WORK = 57455
+const CONSISTENT = 57456
+const SNAPSHOT = 57457
+const CHAIN = 57458
+const NO = 57459
+const RELEASE = 57460
+const BIT = 57461
+const TINYINT = 57462
+const SMALLINT = 57463
+const MEDIUMINT = 57464
+const INT = 57465
+const INTEGER = 57466
+const BIGINT = 57467
+const INTNUM = 57468
+const REAL = 57469
+const DOUBLE = 57470
+const FLOAT_TYPE = 57471
+const DECIMAL = 57472
+const NUMERIC = 57473
+const TIME = 57474
+const TIMESTAMP = 57475
+const DATETIME = 57476
+const YEAR = 57477
+const CHAR = 57478
+const VARCHAR = 57479
+const BOOL = 57480
+const CHARACTER = 57481
+const VARBINARY = 57482
+const NCHAR = 57483
+const TEXT = 57484
+const TINYTEXT = 57485
+const MEDIUMTEXT = 57486
+const LONGTEXT = 57487
+const BLOB = 57488
+const TINYBLOB = 57489
+const MEDIUMBLOB = 57490
+const LONGBLOB = 57491
+const JSON = 57492
+const ENUM = 57493
+const GEOMETRY = 57494
+const POINT = 57495
+const LINESTRING = 57496
+const POLYGON = 57497
+const GEOMETRYCOLLECTION = 57498
+const MULTIPOINT = 57499
+const MULTILINESTRING = 57500
+const MULTIPOLYGON = 57501
+const INT1 = 57502
+const INT2 = 57503
+const INT3 = 57504
+const INT4 = 57505
+const INT8 = 57506
+const CREATE = 57507
+const ALTER = 57508
+const DROP = 57509
+const RENAME = 57510
+const ANALYZE = 57511
+const ADD = 57512
+const SCHEMA = 57513
+const TABLE = 57514
+const INDEX = 57515
+const VIEW = 57516
+const TO = 57517
+const IGNORE = 57518
+const IF = 57519
+const PRIMARY = 57520
+const COLUMN = 57521
+const CONSTRAINT = 57522
+const SPATIAL = 57523
+const FULLTEXT = 57524
+const FOREIGN = 57525
+const KEY_BLOCK_SIZE = 57526
+const SHOW = 57527
+const DESCRIBE = 57528
+const EXPLAIN = 57529
+const DATE = 57530
+const ESCAPE = 57531
+const REPAIR = 57532
+const OPTIMIZE = 57533
+const TRUNCATE = 57534
+const MAXVALUE = 57535
+const PARTITION = 57536
+const REORGANIZE = 57537
+const LESS = 57538
+const THAN = 57539
+const PROCEDURE = 57540
+const TRIGGER = 57541
+const STATUS = 57542
+const VARIABLES = 57543
+const ROLE = 57544
+const PROXY = 57545
+const AVG_ROW_LENGTH = 57546
+const STORAGE = 57547
+const DISK = 57548
+const MEMORY = 57549
+const CHECKSUM = 57550
+const COMPRESSION = 57551
+const DATA = 57552
+const DIRECTORY = 57553
+const DELAY_KEY_WRITE = 57554
+const ENCRYPTION = 57555
+const ENGINE = 57556
+const MAX_ROWS = 57557
+const MIN_ROWS = 57558
+const PACK_KEYS = 57559
+const ROW_FORMAT = 57560
+const STATS_AUTO_RECALC = 57561
+const STATS_PERSISTENT = 57562
+const STATS_SAMPLE_PAGES = 57563
+const DYNAMIC = 57564
+const COMPRESSED = 57565
+const REDUNDANT = 57566
+const COMPACT = 57567
+const FIXED = 57568
+const COLUMN_FORMAT = 57569
+const AUTO_RANDOM = 57570
+const RESTRICT = 57571
+const CASCADE = 57572
+const ACTION = 57573
+const PARTIAL = 57574
+const SIMPLE = 57575
+const CHECK = 57576
+const ENFORCED = 57577
+const RANGE = 57578
+const LIST = 57579
+const ALGORITHM = 57580
+const LINEAR = 57581
+const PARTITIONS = 57582
+const SUBPARTITION = 57583
+const SUBPARTITIONS = 57584
+const PARSER = 57585
+const VISIBLE = 57586
+const INVISIBLE = 57587
+const BTREE = 57588
+const HASH = 57589
+const RTREE = 57590
+const EXPIRE = 57591
+const ACCOUNT = 57592
+const UNLOCK = 57593
+const DAY = 57594
+const NEVER = 57595
+const SECOND = 57596
+const ASCII = 57597
+const COALESCE = 57598
+const COLLATION = 57599
+const HOUR = 57600
+const MICROSECOND = 57601
+const MINUTE = 57602
+const MONTH = 57603
+const QUARTER = 57604
+const REPEAT = 57605
+const REVERSE = 57606
+const ROW_COUNT = 57607
+const WEEK = 57608
+const REVOKE = 57609
+const FUNCTION = 57610
+const PRIVILEGES = 57611
+const TABLESPACE = 57612
+const EXECUTE = 57613
+const SUPER = 57614
+const GRANT = 57615
+const OPTION = 57616
+const REFERENCES = 57617
+const REPLICATION = 57618
+const SLAVE = 57619
+const CLIENT = 57620
+const USAGE = 57621
+const RELOAD = 57622
+const FILE = 57623
+const TEMPORARY = 57624
+const ROUTINE = 57625
+const EVENT = 57626
+const SHUTDOWN = 57627
+const NULLX = 57628
+const AUTO_INCREMENT = 57629
+const APPROXNUM = 57630
+const SIGNED = 57631
+const UNSIGNED = 57632
+const ZEROFILL = 57633
+const USER = 57634
+const IDENTIFIED = 57635
+const CIPHER = 57636
+const ISSUER = 57637
+const X509 = 57638
+const SUBJECT = 57639
+const SAN = 57640
+const REQUIRE = 57641
+const SSL = 57642
+const NONE = 57643
+const PASSWORD = 57644
+const MAX_QUERIES_PER_HOUR = 57645
+const MAX_UPDATES_PER_HOUR = 57646
+const MAX_CONNECTIONS_PER_HOUR = 57647
+const MAX_USER_CONNECTIONS = 57648
+const FORMAT = 57649
+const CONNECTION = 57650
+const LOAD = 57651
+const INFILE = 57652
+const TERMINATED = 57653
+const OPTIONALLY = 57654
+const ENCLOSED = 57655
+const ESCAPED = 57656
+const STARTING = 57657
+const LINES = 57658
+const DATABASES = 57659
+const TABLES = 57660
+const EXTENDED = 57661
+const PROCESSLIST = 57662
+const FIELDS = 57663
+const COLUMNS = 57664
+const OPEN = 57665
+const ERRORS = 57666
+const WARNINGS = 57667
+const INDEXES = 57668
+const NAMES = 57669
+const GLOBAL = 57670
+const SESSION = 57671
+const ISOLATION = 57672
+const LEVEL = 57673
+const READ = 57674
+const WRITE = 57675
+const ONLY = 57676
+const REPEATABLE = 57677
+const COMMITTED = 57678
+const UNCOMMITTED = 57679
+const SERIALIZABLE = 57680
+const LOCAL = 57681
+const CURRENT_TIMESTAMP = 57682
+const DATABASE = 57683
+const CURRENT_TIME = 57684
+const LOCALTIME = 57685
+const LOCALTIMESTAMP = 57686
+const UTC_DATE = 57687
+const UTC_TIME = 57688
+const UTC_TIMESTAMP = 57689
+const REPLACE = 57690
+const CONVERT = 57691
+const SEPARATOR = 57692
+const CURRENT_DATE = 57693
+const CURRENT_USER = 57694
+const CURRENT_ROLE = 57695
+const OVER = 57696
+const ROWS = 57697
+const ROW = 57698
+const CURRENT = 57699
+const PRECEDING = 57700
+const FOLLOWING = 57701
+const UNBOUNDED = 57702
+const ROW_NUMBER = 57703
+const RANK = 57704
+const DENSE_RANK = 57705
+const LAG = 57706
+const LEAD = 57707
+const MATCH = 57708
+const AGAINST = 57709
+const BOOLEAN = 57710
+const LANGUAGE = 57711
+const WITH = 57712
+const QUERY = 57713
+const EXPANSION = 57714
+const ADDDATE = 57715
+const BIT_AND = 57716
+const BIT_OR = 57717
+const BIT_XOR = 57718
+const CAST = 57719
+const COUNT = 57720
+const APPROX_COUNT_DISTINCT = 57721
+const APPROX_PERCENTILE = 57722
+const CURDATE = 57723
+const CURTIME = 57724
+const DATE_ADD = 57725
+const DATE_SUB = 57726
+const EXTRACT = 57727
+const GROUP_CONCAT = 57728
+const MAX = 57729
+const MID = 57730
+const MIN = 57731
+const NOW = 57732
+const POSITION = 57733
+const SESSION_USER = 57734
+const STD = 57735
+const STDDEV = 57736
+const STDDEV_POP = 57737
+const STDDEV_SAMP = 57738
+const SUBDATE = 57739
+const SUBSTR = 57740
+const SUBSTRING = 57741
+const SUM = 57742
+const SYSDATE = 57743
+const SYSTEM_USER = 57744
+const TRANSLATE = 57745
+const TRIM = 57746
+const VARIANCE = 57747
+const VAR_POP = 57748
+const VAR_SAMP = 57749
+const AVG = 57750
+const KILL = 57751
+const PREPARE = 57752
+const DEALLOCATE = 57753
+const STATS = 57754
+const UNUSED = 57755
 
 var yyToknames = [...]string{
 	"$end",
@@ -416,6 +435,8 @@ var yyToknames = [...]string{
 	"$unk",
 	"LEX_ERROR",
 	"UNION",
+	"EXCEPT",
+	"INTERSECT",
 	"SELECT",
 	"STREAM",
 	"INSERT",
@@ -455,6 +476,7 @@ var yyToknames = [...]string{
 	"STRAIGHT_JOIN",
 	"LEFT",
 	"RIGHT",
+	"FULL",
 	"INNER",
 	"OUTER",
 	"CROSS",
@@ -511,6 +533,8 @@ var yyToknames = [...]string{
 	"REGEXP",
 	"IN",
 	"ASSIGNMENT",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
 	"'|'",
 	"'&'",
 	"SHIFT_LEFT",
@@ -742,7 +766,6 @@ var yyToknames = [...]string{
 	"DATABASES",
 	"TABLES",
 	"EXTENDED",
-	"FULL",
 	"PROCESSLIST",
 	"FIELDS",
 	"COLUMNS",
@@ -763,7 +786,6 @@ var yyToknames = [...]string{
 	"UNCOMMITTED",
 	"SERIALIZABLE",
 	"LOCAL",
-	"EXCEPT",
 	"CURRENT_TIMESTAMP",
 	"DATABASE",
 	"CURRENT_TIME",
@@ -778,6 +800,18 @@ var yyToknames = [...]string{
 	"CURRENT_DATE",
 	"CURRENT_USER",
 	"CURRENT_ROLE",
+	"OVER",
+	"ROWS",
+	"ROW",
+	"CURRENT",
+	"PRECEDING",
+	"FOLLOWING",
+	"UNBOUNDED",
+	"ROW_NUMBER",
+	"RANK",
+	"DENSE_RANK",
+	"LAG",
+	"LEAD",
 	"MATCH",
 	"AGAINST",
 	"BOOLEAN",
@@ -821,6 +855,10 @@ var yyToknames = [...]string{
 	"VAR_POP",
 	"VAR_SAMP",
 	"AVG",
+	"KILL",
+	"PREPARE",
+	"DEALLOCATE",
+	"STATS",
 	"UNUSED",
 	"';'",
 	"'@'",
@@ -832,1665 +870,2060 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:5526
+//line mysql_sql.y:6168
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 50,
-	17, 310,
-	-2, 301,
-	-1, 54,
-	185, 447,
-	-2, 482,
 	-1, 63,
-	212, 233,
-	213, 233,
-	-2, 253,
-	-1, 304,
-	58, 1155,
-	413, 1155,
-	-2, 91,
-	-1, 323,
-	58, 580,
-	413, 580,
-	-2, 445,
-	-1, 324,
-	58, 438,
-	413, 438,
-	-2, 446,
-	-1, 330,
-	17, 311,
-	-2, 303,
-	-1, 560,
-	54, 699,
-	-2, 1192,
-	-1, 561,
-	54, 700,
-	-2, 1193,
-	-1, 562,
-	54, 701,
-	-2, 1194,
-	-1, 569,
-	54, 758,
-	-2, 1160,
-	-1, 570,
-	54, 760,
-	-2, 1171,
-	-1, 706,
-	1, 472,
-	412, 472,
-	-2, 479,
-	-1, 816,
-	17, 310,
-	-2, 637,
-	-1, 854,
-	119, 878,
-	-2, 876,
-	-1, 856,
-	119, 392,
-	-2, 873,
-	-1, 857,
-	119, 393,
-	-2, 874,
-	-1, 1037,
-	1, 473,
-	412, 473,
-	-2, 479,
-	-1, 1383,
-	1, 519,
-	206, 519,
-	412, 519,
-	-2, 479,
-	-1, 1385,
-	246, 605,
-	-2, 586,
-	-1, 1473,
-	1, 520,
-	206, 520,
-	412, 520,
-	-2, 479,
-	-1, 1500,
-	246, 605,
-	-2, 587,
-	-1, 1821,
-	55, 494,
-	56, 494,
-	-2, 479,
-	-1, 1825,
-	55, 494,
-	56, 494,
-	-2, 479,
-	-1, 1837,
-	55, 498,
-	56, 498,
-	-2, 479,
-	-1, 1840,
-	55, 499,
-	56, 499,
-	-2, 479,
+	19, 345,
+	-2, 336,
+	-1, 67,
+	190, 490,
+	-2, 525,
+	-1, 76,
+	217, 268,
+	218, 268,
+	-2, 288,
+	-1, 321,
+	61, 1280,
+	432, 1280,
+	-2, 98,
+	-1, 340,
+	61, 629,
+	432, 629,
+	-2, 488,
+	-1, 341,
+	61, 481,
+	432, 481,
+	-2, 489,
+	-1, 360,
+	19, 346,
+	-2, 338,
+	-1, 602,
+	57, 817,
+	-2, 1307,
+	-1, 603,
+	57, 818,
+	-2, 1308,
+	-1, 606,
+	57, 816,
+	-2, 1312,
+	-1, 609,
+	57, 755,
+	-2, 1317,
+	-1, 610,
+	57, 756,
+	-2, 1318,
+	-1, 611,
+	57, 757,
+	-2, 1319,
+	-1, 613,
+	57, 815,
+	-2, 1322,
+	-1, 614,
+	57, 814,
+	-2, 1323,
+	-1, 618,
+	57, 758,
+	-2, 1329,
+	-1, 619,
+	57, 759,
+	-2, 1330,
+	-1, 622,
+	57, 856,
+	-2, 1285,
+	-1, 623,
+	57, 858,
+	-2, 1296,
+	-1, 785,
+	1, 515,
+	431, 515,
+	-2, 522,
+	-1, 898,
+	19, 345,
+	-2, 686,
+	-1, 948,
+	124, 987,
+	-2, 985,
+	-1, 950,
+	124, 435,
+	-2, 982,
+	-1, 951,
+	124, 436,
+	-2, 983,
+	-1, 1146,
+	1, 516,
+	431, 516,
+	-2, 522,
+	-1, 1352,
+	251, 654,
+	-2, 635,
+	-1, 1524,
+	251, 654,
+	-2, 636,
+	-1, 1651,
+	1, 568,
+	211, 568,
+	431, 568,
+	-2, 522,
+	-1, 1739,
+	1, 569,
+	211, 569,
+	431, 569,
+	-2, 522,
+	-1, 1766,
+	58, 537,
+	59, 537,
+	-2, 522,
+	-1, 1827,
+	58, 537,
+	59, 537,
+	-2, 522,
+	-1, 1943,
+	58, 541,
+	59, 541,
+	-2, 522,
+	-1, 1981,
+	58, 542,
+	59, 542,
+	-2, 522,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 15413
+const yyLast = 19102
 
 var yyAct = [...]int{
-	698, 1086, 1827, 1825, 1824, 1832, 1798, 573, 1770, 689,
-	571, 1087, 1682, 590, 1740, 1729, 1787, 1730, 1468, 1707,
-	522, 690, 1027, 79, 487, 520, 281, 756, 1609, 429,
-	82, 1469, 1598, 380, 291, 1378, 1210, 1442, 79, 293,
-	1448, 1305, 1450, 1282, 1501, 1299, 1453, 325, 325, 549,
-	1313, 1186, 1287, 1030, 840, 1244, 743, 78, 572, 1329,
-	530, 851, 286, 841, 598, 50, 854, 683, 845, 49,
-	381, 582, 491, 1120, 1477, 736, 1180, 711, 79, 684,
-	285, 18, 740, 1038, 700, 1085, 331, 330, 658, 651,
-	50, 276, 1088, 542, 512, 758, 431, 789, 1010, 1002,
-	279, 296, 474, 713, 373, 675, 295, 417, 297, 712,
-	75, 1017, 405, 1013, 446, 1406, 73, 498, 1674, 686,
-	1283, 1181, 1167, 374, 1696, 1174, 494, 730, 357, 466,
-	287, 395, 394, 350, 725, 726, 391, 488, 489, 360,
-	50, 390, 1719, 486, 499, 342, 485, 488, 489, 715,
-	1733, 1734, 692, 461, 327, 1744, 18, 387, 389, 531,
-	457, 393, 1610, 1611, 1612, 1613, 300, 300, 1607, 1666,
-	1669, 1705, 696, 1288, 1289, 1290, 1291, 1155, 1717, 410,
-	1189, 1187, 1184, 1188, 1190, 1317, 1183, 1182, 737, 1189,
-	1187, 1015, 1188, 1190, 1013, 361, 1597, 1521, 1520, 452,
-	448, 1394, 1314, 459, 460, 1466, 458, 1368, 447, 1601,
-	676, 767, 768, 766, 1437, 1714, 1413, 1417, 1419, 1421,
-	1423, 1424, 1426, 496, 1429, 1427, 1428, 453, 1721, 1408,
-	1409, 1410, 1411, 1392, 1393, 1414, 678, 1395, 1732, 1396,
-	1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405, 1412,
-	1673, 392, 344, 1817, 1316, 1833, 1433, 1416, 1418, 1420,
-	1422, 1425, 341, 340, 1751, 1684, 1436, 1192, 1193, 1194,
-	1716, 1680, 1681, 354, 1684, 1758, 79, 409, 1786, 1591,
-	1808, 355, 1762, 336, 1561, 1407, 1560, 1292, 329, 1723,
-	1724, 1690, 508, 484, 483, 450, 1790, 495, 408, 1175,
-	455, 1834, 396, 456, 1586, 1828, 677, 451, 454, 1799,
-	1676, 1677, 433, 384, 1549, 404, 1582, 449, 1330, 1245,
-	475, 497, 434, 1664, 1171, 1061, 477, 1021, 1369, 479,
-	1455, 1454, 384, 365, 1208, 443, 1057, 728, 407, 1059,
-	1058, 1338, 1336, 1337, 502, 50, 1335, 729, 1334, 1333,
-	1331, 500, 501, 1197, 1056, 1434, 727, 439, 362, 1812,
-	513, 363, 438, 345, 1774, 1285, 1219, 1165, 1164, 1154,
-	325, 514, 1555, 335, 1150, 1051, 381, 381, 381, 1025,
-	997, 412, 367, 366, 771, 751, 386, 653, 527, 1199,
-	519, 413, 406, 801, 492, 1791, 1275, 470, 545, 1135,
-	513, 1794, 1332, 1090, 1089, 386, 525, 650, 1199, 544,
-	1722, 514, 1277, 1784, 656, 409, 79, 79, 79, 79,
-	1300, 1012, 488, 489, 343, 352, 1675, 353, 360, 1063,
-	1283, 481, 351, 349, 348, 356, 659, 358, 359, 476,
-	1000, 478, 738, 325, 325, 409, 325, 433, 1761, 469,
-	1032, 433, 511, 533, 411, 1415, 480, 434, 488, 489,
-	673, 434, 1276, 1198, 325, 325, 1016, 463, 50, 445,
-	1640, 1011, 703, 518, 766, 325, 507, 325, 1168, 706,
-	697, 79, 1593, 701, 647, 490, 1592, 493, 1432, 300,
-	1095, 467, 1577, 1587, 1588, 720, 332, 325, 705, 515,
-	516, 517, 1435, 1339, 1340, 532, 1220, 1788, 1789, 325,
-	381, 1823, 325, 1189, 1187, 708, 1188, 1190, 718, 482,
-	1127, 1584, 510, 672, 744, 1583, 768, 766, 752, 1804,
-	744, 694, 1098, 671, 1125, 1126, 1124, 325, 325, 755,
-	79, 1100, 702, 679, 695, 769, 660, 661, 662, 663,
-	1775, 717, 688, 1028, 1029, 1082, 709, 710, 772, 1807,
-	364, 759, 1708, 300, 722, 691, 1083, 388, 693, 284,
-	11, 760, 282, 6, 757, 767, 768, 766, 818, 1752,
-	3, 716, 707, 704, 435, 436, 437, 523, 817, 1748,
-	1703, 739, 1746, 526, 283, 5, 300, 714, 721, 1662,
-	1806, 749, 521, 1726, 753, 1024, 767, 768, 766, 825,
-	746, 747, 748, 1661, 735, 734, 536, 537, 538, 539,
-	540, 435, 436, 437, 523, 767, 768, 766, 300, 368,
-	435, 436, 437, 523, 1654, 1226, 819, 820, 821, 822,
-	1635, 816, 1023, 524, 754, 11, 390, 1634, 6, 846,
-	848, 435, 436, 437, 1380, 823, 402, 300, 1633, 1249,
-	1651, 1649, 1248, 795, 850, 767, 768, 766, 838, 310,
-	5, 309, 313, 305, 856, 1641, 1643, 1644, 1645, 1642,
-	524, 1647, 1630, 301, 857, 767, 768, 766, 1624, 524,
-	767, 768, 766, 1637, 320, 1621, 1650, 1648, 1620, 830,
-	802, 803, 804, 805, 806, 807, 808, 801, 79, 1541,
-	1381, 1540, 1617, 391, 1539, 281, 1536, 1646, 390, 1374,
-	50, 1713, 1053, 804, 805, 806, 807, 808, 801, 1636,
-	1373, 325, 1372, 759, 767, 768, 766, 1371, 849, 389,
-	1270, 654, 1041, 760, 1359, 1698, 1497, 998, 1700, 1688,
-	855, 1687, 325, 435, 436, 437, 996, 1638, 744, 744,
-	744, 1631, 1007, 545, 1354, 79, 767, 768, 766, 1627,
-	1040, 1079, 1080, 1626, 544, 1625, 1054, 1599, 1076, 1077,
-	1078, 1039, 1579, 1211, 1045, 1382, 767, 768, 766, 1096,
-	1097, 1047, 1020, 1049, 1297, 1826, 1296, 1093, 1042, 1043,
-	1044, 838, 1295, 1294, 1022, 1479, 1048, 834, 1046, 833,
-	1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117,
-	1118, 1119, 1050, 832, 1084, 1129, 1130, 1075, 714, 1064,
-	1065, 1066, 655, 1138, 1837, 1348, 1133, 1060, 1222, 1842,
-	303, 302, 306, 1836, 1835, 1067, 1815, 1140, 308, 1252,
-	300, 1072, 1222, 1251, 744, 1595, 1073, 767, 768, 766,
-	312, 809, 810, 802, 803, 804, 805, 806, 807, 808,
-	801, 1069, 1128, 750, 680, 1699, 1091, 1092, 1692, 1094,
-	1347, 1019, 1818, 1122, 1101, 1102, 1103, 1104, 1602, 1105,
-	1106, 1107, 1346, 775, 776, 777, 778, 779, 780, 1146,
-	773, 1460, 767, 768, 766, 1345, 1814, 1813, 1019, 1802,
-	1153, 1344, 1136, 1459, 767, 768, 766, 1343, 1019, 1801,
-	1342, 1139, 1458, 1141, 1773, 1772, 1483, 767, 768, 766,
-	1805, 1441, 1142, 767, 768, 766, 1383, 1487, 1318, 767,
-	768, 766, 767, 768, 766, 1258, 307, 311, 681, 1255,
-	315, 682, 1545, 1735, 317, 318, 319, 1476, 1253, 321,
-	322, 1478, 1480, 1482, 1250, 1484, 1485, 1486, 1488, 1489,
-	1490, 1492, 1493, 1494, 1495, 800, 799, 809, 810, 802,
-	803, 804, 805, 806, 807, 808, 801, 799, 809, 810,
-	802, 803, 804, 805, 806, 807, 808, 801, 1156, 334,
-	409, 1071, 1725, 1659, 1660, 1328, 1659, 1658, 1327, 333,
-	1605, 1604, 1159, 1231, 325, 1160, 1228, 325, 1162, 1221,
-	409, 659, 325, 1496, 1326, 1207, 1178, 767, 768, 766,
-	767, 768, 766, 1137, 1131, 1176, 1177, 674, 701, 764,
-	1475, 1170, 1545, 1544, 1363, 1362, 767, 768, 766, 652,
-	535, 534, 1205, 1222, 1349, 1491, 767, 768, 766, 1222,
-	1341, 1481, 325, 1222, 1230, 1222, 1229, 1152, 1151, 1148,
-	1147, 79, 79, 1196, 1019, 1018, 1793, 1603, 74, 744,
-	22, 37, 23, 762, 1222, 1157, 389, 1143, 1158, 1172,
-	462, 995, 999, 1166, 441, 74, 1227, 22, 37, 23,
-	1223, 442, 1384, 1224, 1225, 1214, 1215, 74, 1013, 1259,
-	1218, 1179, 1039, 1232, 1233, 1234, 1235, 1236, 1237, 1238,
-	1202, 1195, 1203, 443, 1132, 1239, 71, 1838, 1204, 1209,
-	74, 1206, 1071, 1026, 1260, 1201, 1169, 1242, 1243, 1212,
-	509, 74, 440, 71, 1247, 443, 441, 1783, 1777, 846,
-	1213, 1264, 1759, 1265, 1256, 71, 1756, 646, 1754, 1702,
-	1657, 1655, 1273, 1653, 325, 1590, 1443, 1449, 325, 325,
-	1451, 1528, 325, 1527, 1376, 1268, 842, 1123, 648, 1200,
-	1161, 1062, 1055, 839, 1241, 1269, 837, 836, 816, 71,
-	835, 831, 79, 390, 790, 828, 1122, 826, 1240, 824,
-	71, 409, 798, 1009, 1263, 797, 796, 1257, 794, 793,
-	792, 791, 50, 788, 787, 786, 1266, 1262, 1271, 79,
-	1323, 1267, 1307, 785, 784, 783, 1298, 782, 1261, 781,
-	812, 1274, 815, 649, 1325, 444, 1035, 1293, 1766, 1281,
-	1003, 1004, 1764, 1301, 1302, 1731, 813, 814, 811, 1191,
-	800, 799, 809, 810, 802, 803, 804, 805, 806, 807,
-	808, 801, 1070, 1006, 464, 1358, 419, 422, 423, 424,
-	420, 744, 421, 425, 668, 1356, 294, 1310, 1357, 669,
-	325, 1322, 1008, 665, 666, 664, 1323, 1278, 1280, 667,
-	1737, 1822, 1308, 1309, 1149, 528, 1353, 1350, 670, 529,
-	423, 424, 1040, 1284, 1352, 1033, 1504, 74, 1355, 22,
-	37, 23, 1028, 1029, 1365, 1361, 1360, 724, 427, 1090,
-	1089, 1366, 1379, 1440, 326, 1377, 468, 62, 398, 400,
-	401, 69, 1439, 472, 473, 1367, 1778, 1745, 1709, 1370,
-	1706, 1507, 1671, 1670, 1375, 1668, 1618, 1502, 1438, 1321,
-	38, 334, 471, 1515, 1516, 71, 333, 1320, 1503, 1163,
-	1217, 333, 652, 1431, 1462, 275, 325, 325, 1767, 1444,
-	79, 1445, 1446, 1447, 1768, 1767, 1768, 409, 426, 1452,
-	346, 1, 1736, 652, 1769, 409, 1474, 1456, 1701, 1739,
-	589, 574, 1508, 1497, 1663, 1606, 1704, 1665, 1470, 1364,
-	1430, 1457, 1608, 1542, 1467, 1173, 1307, 465, 1781, 1144,
-	1145, 1465, 419, 422, 423, 424, 420, 1040, 421, 425,
-	610, 65, 66, 414, 67, 68, 1498, 600, 1522, 827,
-	1523, 1524, 1525, 1526, 419, 422, 423, 424, 420, 601,
-	421, 425, 645, 1550, 399, 599, 1529, 1530, 1531, 1532,
-	1537, 1315, 1479, 800, 799, 809, 810, 802, 803, 804,
-	805, 806, 807, 808, 801, 339, 397, 1514, 1533, 1518,
-	1535, 1534, 347, 1596, 1519, 1538, 1551, 1099, 54, 64,
-	72, 1134, 1831, 1547, 1821, 1463, 1464, 1797, 1776, 1683,
-	1816, 1715, 1543, 1757, 1510, 1750, 1679, 1548, 63, 61,
-	60, 298, 731, 503, 371, 1760, 378, 657, 1546, 1286,
-	1185, 1554, 1031, 1014, 685, 1578, 1509, 1511, 79, 299,
-	1672, 1552, 1553, 1614, 1556, 1557, 1558, 1559, 1656, 1379,
-	1562, 1563, 1564, 1565, 1566, 1567, 1568, 1569, 1570, 1571,
-	1572, 1573, 1574, 1575, 1580, 1576, 409, 337, 1034, 338,
-	1037, 1036, 1594, 1619, 774, 1121, 829, 547, 581, 575,
-	1312, 1600, 1311, 1513, 1517, 719, 25, 1470, 428, 1616,
-	765, 852, 81, 1483, 1052, 1652, 1505, 1615, 1779, 853,
-	46, 1741, 588, 587, 1487, 433, 47, 586, 585, 418,
-	416, 415, 290, 289, 1632, 434, 1216, 1319, 761, 763,
-	1622, 1623, 1512, 1728, 1476, 1727, 1628, 1629, 1478, 1480,
-	1482, 1694, 1484, 1485, 1486, 1488, 1489, 1490, 1492, 1493,
-	1494, 1495, 48, 800, 799, 809, 810, 802, 803, 804,
-	805, 806, 807, 808, 801, 1695, 1589, 1667, 1639, 1585,
-	1678, 1581, 1689, 1473, 1472, 1499, 1500, 1506, 1685, 1686,
-	1390, 1391, 1386, 1388, 79, 1389, 1387, 1385, 409, 1306,
-	1304, 1303, 1005, 1001, 843, 847, 403, 1693, 1272, 699,
-	1496, 76, 288, 1074, 541, 70, 1697, 17, 16, 1470,
-	15, 1691, 45, 44, 43, 42, 14, 1475, 757, 8,
-	1710, 1711, 41, 40, 39, 13, 12, 36, 35, 34,
-	33, 32, 1491, 1743, 31, 1718, 1720, 30, 1481, 29,
-	28, 27, 26, 9, 53, 52, 1742, 51, 19, 1712,
-	20, 21, 59, 58, 57, 56, 55, 24, 1753, 1747,
-	1755, 10, 7, 4, 2, 1749, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1771, 1765, 1763, 0, 0,
-	0, 0, 0, 0, 0, 409, 0, 409, 0, 0,
-	0, 0, 0, 0, 1780, 0, 1782, 0, 0, 0,
-	1785, 0, 0, 0, 1743, 1796, 0, 0, 0, 0,
-	0, 0, 0, 0, 409, 1792, 0, 1742, 1795, 0,
-	0, 1800, 0, 1803, 0, 0, 0, 0, 0, 0,
-	0, 1771, 1809, 0, 0, 0, 1811, 0, 0, 0,
-	0, 0, 0, 1819, 0, 0, 0, 0, 0, 0,
-	0, 1820, 0, 0, 0, 0, 0, 0, 1830, 0,
-	1829, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1841, 1840, 1839, 1830, 970, 956, 0, 918, 972, 890,
-	906, 980, 908, 909, 944, 868, 927, 208, 904, 860,
-	893, 894, 862, 901, 863, 891, 920, 151, 889, 959,
-	930, 178, 978, 180, 0, 0, 238, 193, 0, 0,
-	923, 961, 925, 949, 917, 945, 876, 938, 973, 905,
-	942, 974, 0, 0, 0, 0, 435, 436, 437, 0,
-	0, 0, 0, 134, 0, 0, 0, 0, 0, 941,
-	966, 903, 0, 0, 877, 971, 924, 943, 0, 861,
-	939, 0, 866, 869, 979, 964, 898, 899, 0, 0,
-	0, 0, 0, 0, 0, 921, 926, 946, 914, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 895, 0,
-	934, 0, 0, 0, 871, 867, 0, 919, 0, 124,
-	243, 257, 135, 234, 271, 139, 241, 130, 207, 230,
-	126, 255, 240, 190, 172, 173, 125, 0, 225, 149,
-	162, 146, 205, 968, 969, 145, 274, 870, 265, 128,
-	129, 264, 204, 252, 256, 191, 185, 127, 254, 189,
-	184, 176, 153, 168, 217, 183, 218, 169, 195, 194,
-	196, 990, 991, 992, 993, 994, 875, 0, 896, 947,
-	0, 859, 955, 962, 916, 267, 965, 913, 912, 219,
-	0, 0, 242, 164, 163, 177, 960, 892, 902, 897,
-	900, 228, 210, 967, 933, 215, 226, 181, 253, 220,
-	258, 244, 266, 950, 221, 120, 245, 148, 192, 132,
-	133, 144, 150, 152, 154, 155, 201, 202, 213, 233,
-	246, 247, 248, 147, 140, 227, 141, 166, 142, 121,
-	235, 143, 122, 214, 251, 131, 161, 223, 188, 123,
-	187, 216, 250, 249, 0, 0, 0, 0, 0, 0,
-	158, 858, 262, 0, 206, 957, 864, 874, 872, 910,
-	935, 936, 937, 982, 952, 954, 953, 981, 231, 0,
-	0, 0, 0, 0, 171, 212, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 865, 0,
-	239, 260, 273, 263, 911, 883, 922, 272, 886, 884,
-	951, 885, 940, 983, 197, 198, 199, 200, 907, 138,
-	931, 915, 984, 985, 986, 987, 988, 989, 888, 963,
-	157, 165, 0, 167, 137, 211, 160, 270, 174, 203,
-	170, 236, 175, 182, 224, 269, 209, 229, 136, 259,
-	237, 186, 159, 882, 887, 881, 928, 929, 975, 976,
-	977, 948, 873, 958, 878, 880, 879, 932, 119, 606,
-	179, 268, 222, 156, 0, 0, 0, 0, 0, 208,
-	0, 0, 0, 0, 0, 583, 0, 0, 0, 151,
-	745, 0, 0, 178, 0, 180, 0, 0, 238, 193,
-	1461, 0, 0, 0, 622, 630, 0, 0, 0, 0,
-	261, 0, 741, 0, 0, 576, 0, 0, 548, 612,
-	611, 591, 0, 0, 0, 134, 592, 0, 0, 0,
-	593, 596, 594, 595, 0, 0, 614, 0, 0, 0,
-	0, 0, 546, 580, 0, 800, 799, 809, 810, 802,
-	803, 804, 805, 806, 807, 808, 801, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 577, 578, 0, 0,
-	0, 0, 607, 0, 579, 0, 0, 742, 0, 597,
-	0, 124, 243, 257, 135, 234, 271, 139, 241, 130,
-	207, 230, 126, 255, 240, 190, 172, 173, 125, 0,
-	225, 149, 162, 146, 205, 604, 605, 145, 570, 602,
-	265, 128, 129, 264, 204, 252, 256, 191, 185, 127,
-	254, 189, 184, 176, 153, 168, 217, 183, 218, 169,
-	195, 194, 196, 800, 799, 809, 810, 802, 803, 804,
-	805, 806, 807, 808, 801, 0, 0, 267, 0, 0,
-	620, 219, 0, 0, 242, 164, 163, 177, 0, 0,
-	0, 603, 0, 228, 210, 633, 0, 215, 226, 181,
-	253, 220, 258, 244, 266, 0, 221, 120, 245, 148,
-	192, 132, 133, 144, 150, 152, 154, 155, 201, 202,
-	213, 233, 246, 247, 248, 147, 140, 227, 141, 166,
-	142, 121, 235, 143, 122, 214, 251, 131, 161, 223,
-	188, 123, 187, 216, 250, 249, 0, 0, 0, 0,
-	0, 0, 158, 0, 262, 618, 206, 632, 613, 615,
-	616, 619, 623, 624, 625, 626, 627, 629, 631, 634,
-	231, 0, 0, 0, 0, 0, 171, 212, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 239, 260, 273, 569, 0, 0, 0, 272,
-	0, 0, 0, 0, 0, 608, 197, 198, 199, 200,
-	621, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 157, 165, 0, 167, 137, 211, 160, 270,
-	174, 203, 170, 236, 175, 182, 224, 269, 209, 229,
-	136, 259, 237, 186, 159, 640, 617, 639, 641, 642,
-	638, 643, 644, 628, 584, 0, 636, 635, 637, 0,
-	119, 0, 179, 268, 222, 156, 83, 550, 551, 552,
-	553, 554, 555, 556, 91, 557, 93, 94, 95, 96,
-	558, 98, 559, 100, 101, 102, 560, 561, 562, 563,
-	107, 108, 109, 564, 565, 112, 113, 114, 115, 566,
-	567, 568, 261, 606, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 208, 0, 0, 0, 0, 0, 583,
-	0, 0, 0, 151, 1810, 0, 0, 178, 0, 180,
-	0, 0, 238, 193, 0, 0, 0, 0, 622, 630,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 576,
-	0, 0, 548, 612, 611, 591, 0, 1351, 0, 134,
-	592, 0, 0, 0, 593, 596, 594, 595, 0, 0,
-	614, 0, 0, 0, 0, 0, 546, 580, 800, 799,
-	809, 810, 802, 803, 804, 805, 806, 807, 808, 801,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	577, 578, 0, 0, 0, 0, 607, 0, 579, 0,
-	0, 609, 0, 597, 0, 124, 243, 257, 135, 234,
-	271, 139, 241, 130, 207, 230, 126, 255, 240, 190,
-	172, 173, 125, 0, 225, 149, 162, 146, 205, 604,
-	605, 145, 570, 602, 265, 128, 129, 264, 204, 252,
-	256, 191, 185, 127, 254, 189, 184, 176, 153, 168,
-	217, 183, 218, 169, 195, 194, 196, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 267, 0, 0, 620, 219, 0, 0, 242, 164,
-	163, 177, 0, 0, 0, 603, 0, 228, 210, 633,
-	0, 215, 226, 181, 253, 220, 258, 244, 266, 0,
-	221, 120, 245, 148, 192, 132, 133, 144, 150, 152,
-	154, 155, 201, 202, 213, 233, 246, 247, 248, 147,
-	140, 227, 141, 166, 142, 121, 235, 143, 122, 214,
-	251, 131, 161, 223, 188, 123, 187, 216, 250, 249,
-	0, 0, 0, 0, 0, 0, 158, 0, 262, 618,
-	206, 632, 613, 615, 616, 619, 623, 624, 625, 626,
-	627, 629, 631, 634, 231, 0, 0, 0, 0, 0,
-	171, 212, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 239, 260, 273, 569,
-	0, 0, 0, 272, 0, 0, 0, 0, 0, 608,
-	197, 198, 199, 200, 621, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 157, 165, 0, 167,
-	137, 211, 160, 270, 174, 203, 170, 236, 175, 182,
-	224, 269, 209, 229, 136, 259, 237, 186, 159, 640,
-	617, 639, 641, 642, 638, 643, 644, 628, 584, 0,
-	636, 635, 637, 0, 119, 0, 179, 268, 222, 156,
-	83, 550, 551, 552, 553, 554, 555, 556, 91, 557,
-	93, 94, 95, 96, 558, 98, 559, 100, 101, 102,
-	560, 561, 562, 563, 107, 108, 109, 564, 565, 112,
-	113, 114, 115, 566, 567, 568, 261, 606, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 208, 0, 0,
-	0, 0, 0, 583, 0, 0, 0, 151, 745, 0,
-	0, 178, 0, 180, 0, 0, 238, 193, 1254, 0,
-	0, 0, 622, 630, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 576, 0, 0, 548, 612, 611, 591,
-	0, 0, 0, 134, 592, 0, 0, 0, 593, 596,
-	594, 595, 0, 0, 614, 0, 0, 0, 0, 0,
-	546, 580, 0, 0, 800, 799, 809, 810, 802, 803,
-	804, 805, 806, 807, 808, 801, 0, 0, 0, 0,
-	0, 0, 0, 0, 577, 578, 0, 0, 0, 0,
-	607, 0, 579, 0, 0, 609, 0, 597, 0, 124,
-	243, 257, 135, 234, 271, 139, 241, 130, 207, 230,
-	126, 255, 240, 190, 172, 173, 125, 0, 225, 149,
-	162, 146, 205, 604, 605, 145, 570, 602, 265, 128,
-	129, 264, 204, 252, 256, 191, 185, 127, 254, 189,
-	184, 176, 153, 168, 217, 183, 218, 169, 195, 194,
-	196, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 267, 0, 0, 620, 219,
-	0, 0, 242, 164, 163, 177, 0, 0, 0, 603,
-	0, 228, 210, 633, 0, 215, 226, 181, 253, 220,
-	258, 244, 266, 0, 221, 120, 245, 148, 192, 132,
-	133, 144, 150, 152, 154, 155, 201, 202, 213, 233,
-	246, 247, 248, 147, 140, 227, 141, 166, 142, 121,
-	235, 143, 122, 214, 251, 131, 161, 223, 188, 123,
-	187, 216, 250, 249, 0, 0, 0, 0, 0, 0,
-	158, 0, 262, 618, 206, 632, 613, 615, 616, 619,
-	623, 624, 625, 626, 627, 629, 631, 634, 231, 0,
-	0, 0, 0, 0, 171, 212, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	239, 260, 273, 569, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 608, 197, 198, 199, 200, 621, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	157, 165, 0, 167, 137, 211, 160, 270, 174, 203,
-	170, 236, 175, 182, 224, 269, 209, 229, 136, 259,
-	237, 186, 159, 640, 617, 639, 641, 642, 638, 643,
-	644, 628, 584, 0, 636, 635, 637, 0, 119, 0,
-	179, 268, 222, 156, 83, 550, 551, 552, 553, 554,
-	555, 556, 91, 557, 93, 94, 95, 96, 558, 98,
-	559, 100, 101, 102, 560, 561, 562, 563, 107, 108,
-	109, 564, 565, 112, 113, 114, 115, 566, 567, 568,
-	261, 74, 0, 606, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 208, 0, 0, 0, 0, 0, 583,
-	0, 0, 0, 151, 0, 0, 0, 178, 0, 180,
-	0, 0, 238, 193, 0, 0, 0, 0, 622, 630,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 576,
-	0, 0, 548, 612, 611, 591, 0, 1246, 0, 134,
-	592, 0, 0, 0, 593, 596, 594, 595, 0, 0,
-	614, 0, 0, 0, 0, 0, 546, 580, 800, 799,
-	809, 810, 802, 803, 804, 805, 806, 807, 808, 801,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	577, 578, 0, 0, 0, 0, 607, 0, 579, 0,
-	0, 609, 0, 597, 0, 124, 243, 257, 135, 234,
-	271, 139, 241, 130, 207, 230, 126, 255, 240, 190,
-	172, 173, 125, 0, 225, 149, 162, 146, 205, 604,
-	605, 145, 570, 602, 265, 128, 129, 264, 204, 252,
-	256, 191, 185, 127, 254, 189, 184, 176, 153, 168,
-	217, 183, 218, 169, 195, 194, 196, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 267, 0, 0, 620, 219, 0, 0, 242, 164,
-	163, 177, 0, 0, 0, 603, 0, 228, 210, 633,
-	0, 215, 226, 181, 253, 220, 258, 244, 266, 0,
-	221, 120, 245, 148, 192, 132, 133, 144, 150, 152,
-	154, 155, 201, 202, 213, 233, 246, 247, 248, 147,
-	140, 227, 141, 166, 142, 121, 235, 143, 122, 214,
-	251, 131, 161, 223, 188, 123, 187, 216, 250, 249,
-	0, 0, 0, 0, 0, 0, 158, 0, 262, 618,
-	206, 632, 613, 615, 616, 619, 623, 624, 625, 626,
-	627, 629, 631, 634, 231, 0, 0, 0, 0, 0,
-	171, 212, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 239, 260, 273, 569,
-	0, 0, 0, 272, 0, 0, 0, 0, 0, 608,
-	197, 198, 199, 200, 621, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 157, 165, 0, 167,
-	137, 211, 160, 270, 174, 203, 170, 236, 175, 182,
-	224, 269, 209, 229, 136, 259, 237, 186, 159, 640,
-	617, 639, 641, 642, 638, 643, 644, 628, 584, 0,
-	636, 635, 637, 0, 119, 0, 179, 268, 222, 156,
-	83, 550, 551, 552, 553, 554, 555, 556, 91, 557,
-	93, 94, 95, 96, 558, 98, 559, 100, 101, 102,
-	560, 561, 562, 563, 107, 108, 109, 564, 565, 112,
-	113, 114, 115, 566, 567, 568, 261, 606, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 208, 0, 0,
-	0, 0, 0, 583, 0, 0, 0, 151, 0, 0,
-	0, 178, 0, 180, 0, 0, 238, 193, 0, 0,
-	0, 0, 622, 630, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 576, 0, 0, 548, 612, 611, 591,
-	0, 0, 0, 134, 592, 0, 0, 0, 593, 596,
-	594, 595, 0, 0, 614, 0, 0, 0, 0, 0,
-	546, 580, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 577, 578, 543, 0, 0, 0,
-	607, 0, 579, 0, 0, 609, 0, 597, 0, 124,
-	243, 257, 135, 234, 271, 139, 241, 130, 207, 230,
-	126, 255, 240, 190, 172, 173, 125, 0, 225, 149,
-	162, 146, 205, 604, 605, 145, 570, 602, 265, 128,
-	129, 264, 204, 252, 256, 191, 185, 127, 254, 189,
-	184, 176, 153, 168, 217, 183, 218, 169, 195, 194,
-	196, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 267, 0, 0, 620, 219,
-	0, 0, 242, 164, 163, 177, 0, 0, 0, 603,
-	0, 228, 210, 633, 0, 215, 226, 181, 253, 220,
-	258, 244, 266, 0, 221, 120, 245, 148, 192, 132,
-	133, 144, 150, 152, 154, 155, 201, 202, 213, 233,
-	246, 247, 248, 147, 140, 227, 141, 166, 142, 121,
-	235, 143, 122, 214, 251, 131, 161, 223, 188, 123,
-	187, 216, 250, 249, 0, 0, 0, 0, 0, 0,
-	158, 0, 262, 618, 206, 632, 613, 615, 616, 619,
-	623, 624, 625, 626, 627, 629, 631, 634, 231, 0,
-	0, 0, 0, 0, 171, 212, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	239, 260, 273, 569, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 608, 197, 198, 199, 200, 621, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	157, 165, 0, 167, 137, 211, 160, 270, 174, 203,
-	170, 236, 175, 182, 224, 269, 209, 229, 136, 259,
-	237, 186, 159, 640, 617, 639, 641, 642, 638, 643,
-	644, 628, 584, 0, 636, 635, 637, 0, 119, 0,
-	179, 268, 222, 156, 83, 550, 551, 552, 553, 554,
-	555, 556, 91, 557, 93, 94, 95, 96, 558, 98,
-	559, 100, 101, 102, 560, 561, 562, 563, 107, 108,
-	109, 564, 565, 112, 113, 114, 115, 566, 567, 568,
-	261, 606, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 208, 0, 0, 0, 0, 0, 583, 0, 0,
-	0, 151, 0, 0, 0, 178, 0, 180, 0, 0,
-	238, 193, 0, 0, 0, 0, 622, 630, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 576, 0, 0,
-	548, 612, 611, 591, 0, 0, 0, 134, 592, 0,
-	0, 0, 593, 596, 594, 595, 0, 0, 614, 0,
-	0, 0, 0, 0, 546, 580, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 577, 578,
-	0, 0, 0, 0, 607, 0, 579, 0, 0, 609,
-	0, 597, 0, 124, 243, 257, 135, 234, 271, 139,
-	241, 130, 207, 230, 126, 255, 240, 190, 172, 173,
-	125, 0, 225, 149, 162, 146, 205, 604, 605, 145,
-	570, 602, 265, 128, 129, 264, 204, 252, 256, 191,
-	185, 127, 254, 189, 184, 176, 153, 168, 217, 183,
-	218, 169, 195, 194, 196, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
-	0, 0, 620, 219, 0, 0, 242, 164, 163, 177,
-	0, 0, 0, 603, 0, 228, 210, 633, 0, 215,
-	226, 181, 253, 220, 258, 244, 266, 0, 221, 120,
-	245, 148, 192, 132, 133, 144, 150, 152, 154, 155,
-	201, 202, 213, 233, 246, 247, 248, 147, 140, 227,
-	141, 166, 142, 121, 235, 143, 122, 214, 251, 131,
-	161, 223, 188, 123, 187, 216, 250, 249, 0, 0,
-	0, 0, 0, 0, 158, 0, 262, 618, 206, 632,
-	613, 615, 616, 619, 623, 624, 625, 626, 627, 629,
-	631, 634, 231, 0, 0, 0, 0, 0, 171, 212,
-	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 239, 260, 273, 569, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 608, 197, 198,
-	199, 200, 621, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 157, 165, 0, 167, 137, 211,
-	160, 270, 174, 203, 170, 236, 175, 182, 224, 269,
-	209, 229, 136, 259, 237, 186, 159, 640, 617, 639,
-	641, 642, 638, 643, 644, 628, 584, 0, 636, 635,
-	637, 0, 119, 0, 179, 268, 222, 156, 83, 550,
-	551, 552, 553, 554, 555, 556, 91, 557, 93, 94,
-	95, 96, 558, 98, 559, 100, 101, 102, 560, 561,
-	562, 563, 107, 108, 109, 564, 565, 112, 113, 114,
-	115, 566, 567, 568, 261, 606, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 208, 0, 0, 0, 0,
-	0, 583, 0, 0, 0, 151, 0, 0, 0, 178,
-	0, 180, 0, 0, 238, 193, 0, 0, 0, 0,
-	622, 630, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 576, 0, 0, 548, 612, 611, 591, 0, 0,
-	0, 134, 592, 0, 0, 0, 593, 596, 594, 595,
-	0, 0, 614, 0, 0, 0, 0, 0, 0, 580,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 577, 578, 0, 0, 0, 0, 607, 0,
-	579, 0, 0, 609, 0, 597, 0, 124, 243, 257,
-	135, 234, 271, 139, 241, 130, 207, 230, 126, 255,
-	240, 190, 172, 173, 125, 0, 225, 149, 162, 146,
-	205, 604, 605, 145, 570, 602, 265, 128, 129, 264,
-	204, 252, 256, 191, 185, 127, 254, 189, 184, 176,
-	153, 168, 217, 183, 218, 169, 195, 194, 196, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 267, 0, 0, 620, 219, 0, 0,
-	242, 164, 163, 177, 0, 0, 0, 603, 0, 228,
-	210, 633, 0, 215, 226, 181, 253, 220, 258, 244,
-	266, 0, 221, 120, 245, 148, 192, 132, 133, 144,
-	150, 152, 154, 155, 201, 202, 213, 233, 246, 247,
-	248, 147, 140, 227, 141, 166, 142, 121, 235, 143,
-	122, 214, 251, 131, 161, 223, 188, 123, 187, 216,
-	250, 249, 0, 0, 0, 0, 0, 0, 158, 0,
-	262, 618, 206, 632, 613, 615, 616, 619, 623, 624,
-	625, 626, 627, 629, 631, 634, 231, 0, 0, 0,
-	0, 0, 171, 212, 0, 232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 239, 260,
-	273, 569, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 608, 197, 198, 199, 200, 621, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 157, 165,
-	0, 167, 137, 211, 160, 270, 174, 203, 170, 236,
-	175, 182, 224, 269, 209, 229, 136, 259, 237, 186,
-	159, 640, 617, 639, 641, 642, 638, 643, 644, 628,
-	584, 0, 636, 635, 637, 0, 119, 0, 179, 268,
-	222, 156, 83, 550, 551, 552, 553, 554, 555, 556,
-	91, 557, 93, 94, 95, 96, 558, 98, 559, 100,
-	101, 102, 560, 561, 562, 563, 107, 108, 109, 564,
-	565, 112, 113, 114, 115, 566, 567, 568, 261, 606,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 208,
-	0, 0, 0, 0, 0, 583, 0, 0, 0, 151,
-	0, 0, 0, 178, 0, 180, 0, 0, 238, 193,
-	0, 0, 0, 0, 622, 630, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 548, 612,
-	611, 591, 0, 0, 0, 134, 592, 0, 0, 0,
-	593, 596, 594, 595, 0, 0, 614, 0, 0, 0,
-	0, 0, 546, 580, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 577, 578, 0, 0,
-	0, 0, 607, 0, 579, 0, 0, 609, 0, 597,
-	0, 124, 243, 257, 135, 234, 271, 139, 241, 130,
-	207, 230, 126, 255, 240, 190, 172, 173, 125, 0,
-	225, 149, 162, 146, 205, 604, 605, 145, 570, 602,
-	265, 128, 129, 264, 204, 252, 256, 191, 185, 127,
-	254, 189, 184, 176, 153, 168, 217, 183, 218, 169,
-	195, 194, 196, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
-	620, 219, 0, 0, 242, 164, 163, 177, 0, 0,
-	0, 603, 0, 228, 210, 633, 0, 215, 226, 181,
-	253, 220, 258, 244, 266, 0, 221, 120, 245, 148,
-	192, 132, 133, 144, 150, 152, 154, 155, 201, 202,
-	213, 233, 246, 247, 248, 147, 140, 227, 141, 166,
-	142, 121, 235, 143, 122, 214, 251, 131, 161, 223,
-	188, 123, 187, 216, 250, 249, 0, 0, 0, 0,
-	0, 0, 158, 0, 262, 618, 206, 632, 613, 615,
-	616, 619, 623, 624, 625, 626, 627, 629, 631, 634,
-	231, 0, 0, 0, 0, 0, 171, 212, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 239, 260, 273, 569, 0, 0, 0, 272,
-	0, 0, 0, 0, 0, 608, 197, 198, 199, 200,
-	621, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 157, 165, 0, 167, 137, 211, 160, 270,
-	174, 203, 170, 236, 175, 182, 224, 269, 209, 229,
-	136, 259, 237, 186, 159, 640, 617, 639, 641, 642,
-	638, 643, 644, 628, 584, 0, 636, 635, 637, 0,
-	119, 0, 179, 268, 222, 156, 83, 550, 551, 552,
-	553, 554, 555, 556, 91, 557, 93, 94, 95, 96,
-	558, 98, 559, 100, 101, 102, 560, 561, 562, 563,
-	107, 108, 109, 564, 565, 112, 113, 114, 115, 566,
-	567, 568, 261, 310, 0, 309, 313, 305, 0, 0,
-	0, 0, 0, 0, 0, 208, 0, 301, 0, 0,
-	0, 0, 0, 0, 0, 151, 0, 0, 320, 178,
-	0, 180, 0, 0, 238, 193, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 323, 0, 0, 324, 0, 0,
-	0, 134, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 243, 257,
-	135, 234, 271, 139, 241, 130, 207, 230, 126, 255,
-	240, 190, 172, 173, 125, 0, 225, 149, 162, 146,
-	205, 0, 0, 145, 274, 0, 265, 128, 129, 264,
-	204, 252, 256, 191, 185, 127, 254, 189, 184, 176,
-	153, 168, 217, 183, 218, 169, 195, 194, 196, 0,
-	0, 0, 0, 0, 303, 302, 306, 0, 0, 0,
-	0, 0, 308, 267, 0, 0, 0, 219, 0, 0,
-	242, 164, 163, 177, 312, 0, 0, 0, 0, 228,
-	210, 0, 0, 215, 226, 181, 253, 220, 304, 244,
-	266, 0, 328, 120, 245, 148, 192, 132, 133, 144,
-	150, 152, 154, 155, 201, 202, 213, 233, 246, 247,
-	248, 147, 140, 227, 141, 166, 142, 121, 235, 143,
-	122, 214, 251, 131, 161, 223, 188, 123, 187, 216,
-	250, 249, 0, 0, 0, 0, 0, 0, 158, 0,
-	262, 0, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 231, 0, 0, 0,
-	307, 311, 314, 212, 315, 316, 0, 0, 317, 318,
-	319, 0, 0, 321, 322, 0, 0, 0, 239, 260,
-	273, 263, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 0, 197, 198, 199, 200, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 157, 165,
-	0, 167, 137, 211, 160, 270, 174, 203, 170, 236,
-	175, 182, 224, 269, 209, 229, 136, 259, 237, 186,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 179, 268,
-	222, 156, 83, 84, 85, 86, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 261, 310,
-	0, 309, 313, 305, 0, 0, 0, 0, 0, 0,
-	0, 208, 0, 301, 0, 0, 0, 0, 0, 0,
-	0, 151, 0, 0, 320, 178, 0, 180, 0, 0,
-	238, 193, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	323, 0, 0, 324, 0, 0, 0, 134, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 243, 257, 135, 234, 271, 139,
-	241, 130, 207, 230, 126, 255, 240, 190, 172, 173,
-	125, 0, 225, 149, 162, 146, 205, 0, 0, 145,
-	274, 0, 265, 128, 129, 264, 204, 252, 256, 191,
-	185, 127, 254, 189, 184, 176, 153, 168, 217, 183,
-	218, 169, 195, 194, 196, 0, 0, 0, 0, 0,
-	303, 302, 306, 0, 0, 0, 0, 0, 308, 267,
-	0, 0, 0, 219, 0, 0, 242, 164, 163, 177,
-	312, 0, 0, 0, 0, 228, 210, 0, 0, 215,
-	226, 181, 253, 220, 304, 244, 266, 0, 221, 120,
-	245, 148, 192, 132, 133, 144, 150, 152, 154, 155,
-	201, 202, 213, 233, 246, 247, 248, 147, 140, 227,
-	141, 166, 142, 121, 235, 143, 122, 214, 251, 131,
-	161, 223, 188, 123, 187, 216, 250, 249, 0, 0,
-	0, 0, 0, 0, 158, 0, 262, 0, 206, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 231, 0, 0, 0, 307, 311, 314, 212,
-	315, 316, 0, 0, 317, 318, 319, 0, 0, 321,
-	322, 0, 0, 0, 239, 260, 273, 263, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 0, 197, 198,
-	199, 200, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 157, 165, 0, 167, 137, 211,
-	160, 270, 174, 203, 170, 236, 175, 182, 224, 269,
-	209, 229, 136, 259, 237, 186, 159, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 179, 268, 222, 156, 83, 84,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 261, 74, 0, 22, 37, 23,
-	0, 0, 0, 0, 0, 0, 0, 208, 277, 0,
-	0, 0, 0, 0, 0, 0, 0, 151, 0, 0,
-	0, 178, 0, 180, 0, 0, 238, 193, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 71, 0, 0, 80, 0, 0, 0,
-	0, 0, 0, 134, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	243, 257, 135, 234, 271, 139, 241, 130, 207, 230,
-	126, 255, 240, 190, 172, 173, 125, 0, 225, 149,
-	162, 146, 205, 0, 0, 145, 274, 0, 265, 128,
-	129, 264, 204, 252, 256, 191, 185, 127, 254, 189,
-	184, 176, 153, 168, 217, 183, 218, 169, 195, 194,
-	196, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	280, 0, 0, 0, 0, 267, 0, 0, 0, 219,
-	0, 0, 242, 164, 163, 177, 0, 0, 0, 0,
-	0, 228, 210, 0, 0, 215, 226, 181, 253, 220,
-	258, 244, 266, 0, 221, 120, 245, 148, 192, 132,
-	133, 144, 150, 152, 154, 155, 201, 202, 213, 233,
-	246, 247, 248, 147, 140, 227, 141, 166, 142, 121,
-	235, 143, 122, 214, 251, 131, 161, 223, 188, 123,
-	187, 216, 250, 249, 0, 0, 0, 0, 0, 0,
-	158, 0, 262, 0, 206, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 231, 0,
-	0, 0, 0, 0, 171, 212, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	239, 260, 273, 263, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 0, 197, 198, 199, 200, 278, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	157, 165, 0, 167, 137, 211, 160, 270, 174, 203,
-	170, 236, 175, 182, 224, 269, 209, 229, 136, 259,
-	237, 186, 159, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	179, 268, 222, 156, 83, 84, 85, 86, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	261, 208, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 151, 370, 0, 0, 178, 0, 180, 0, 0,
-	238, 193, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 382, 383, 0, 0, 0, 0, 134, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 384, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 243, 257, 135, 234, 271, 139,
-	241, 130, 207, 230, 126, 255, 240, 190, 172, 173,
-	125, 0, 225, 149, 162, 146, 205, 0, 0, 145,
-	274, 386, 265, 128, 385, 264, 204, 252, 256, 191,
-	185, 127, 254, 189, 184, 176, 153, 168, 217, 183,
-	218, 169, 195, 194, 196, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
-	0, 0, 0, 219, 0, 0, 242, 164, 163, 177,
-	0, 0, 0, 0, 0, 228, 210, 0, 0, 215,
-	226, 181, 253, 220, 258, 244, 266, 369, 221, 120,
-	245, 148, 192, 132, 133, 144, 150, 152, 154, 155,
-	201, 202, 213, 233, 246, 247, 248, 147, 140, 227,
-	141, 166, 142, 121, 235, 143, 122, 214, 251, 131,
-	161, 223, 188, 123, 187, 216, 250, 249, 0, 0,
-	0, 0, 0, 0, 158, 0, 262, 0, 206, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 231, 0, 0, 0, 0, 0, 171, 212,
-	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 239, 260, 273, 263, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 372, 197, 198,
-	199, 200, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 157, 165, 0, 167, 137, 211,
-	160, 270, 174, 379, 375, 376, 175, 182, 224, 269,
-	209, 229, 136, 259, 237, 377, 159, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 179, 268, 222, 156, 83, 84,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 261, 208, 0, 0, 0, 0,
-	770, 0, 0, 0, 0, 151, 0, 0, 0, 178,
-	0, 180, 0, 0, 238, 193, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 134, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 767, 768, 766, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 243, 257,
-	135, 234, 271, 139, 241, 130, 207, 230, 126, 255,
-	240, 190, 172, 173, 125, 0, 225, 149, 162, 146,
-	205, 0, 0, 145, 274, 0, 265, 128, 129, 264,
-	204, 252, 256, 191, 185, 127, 254, 189, 184, 176,
-	153, 168, 217, 183, 218, 169, 195, 194, 196, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 267, 0, 0, 0, 219, 0, 0,
-	242, 164, 163, 177, 0, 0, 0, 0, 0, 228,
-	210, 0, 0, 215, 226, 181, 253, 220, 258, 244,
-	266, 0, 221, 120, 245, 148, 192, 132, 133, 144,
-	150, 152, 154, 155, 201, 202, 213, 233, 246, 247,
-	248, 147, 140, 227, 141, 166, 142, 121, 235, 143,
-	122, 214, 251, 131, 161, 223, 188, 123, 187, 216,
-	250, 249, 0, 0, 0, 0, 0, 0, 158, 0,
-	262, 0, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 231, 0, 0, 0,
-	0, 0, 171, 212, 0, 232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 239, 260,
-	273, 263, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 0, 197, 198, 199, 200, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 157, 165,
-	0, 167, 137, 211, 160, 270, 174, 203, 170, 236,
-	175, 182, 224, 269, 209, 229, 136, 259, 237, 186,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 179, 268,
-	222, 156, 83, 84, 85, 86, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 261, 208,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 151,
-	0, 0, 0, 178, 0, 180, 0, 0, 238, 193,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 382,
-	383, 0, 0, 0, 0, 134, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 384, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 243, 257, 135, 234, 271, 139, 241, 130,
-	207, 230, 126, 255, 240, 190, 172, 173, 125, 0,
-	225, 149, 162, 146, 205, 0, 0, 145, 274, 386,
-	265, 128, 385, 264, 204, 252, 256, 191, 185, 127,
-	254, 189, 184, 176, 153, 168, 217, 183, 218, 169,
-	195, 194, 196, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
-	0, 219, 0, 0, 242, 164, 163, 177, 0, 0,
-	0, 0, 0, 228, 210, 0, 0, 215, 226, 181,
-	253, 220, 258, 244, 266, 0, 221, 120, 245, 148,
-	192, 132, 133, 144, 150, 152, 154, 155, 201, 202,
-	213, 233, 246, 247, 248, 147, 140, 227, 141, 166,
-	142, 121, 235, 143, 122, 214, 251, 131, 161, 223,
-	188, 123, 187, 216, 250, 249, 0, 0, 0, 0,
-	0, 0, 158, 0, 262, 0, 206, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	231, 0, 0, 0, 0, 0, 171, 212, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 239, 260, 273, 263, 0, 0, 0, 272,
-	0, 0, 0, 0, 0, 0, 197, 198, 199, 200,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 157, 165, 0, 167, 137, 211, 160, 270,
-	174, 379, 375, 376, 175, 182, 224, 269, 209, 229,
-	136, 259, 237, 377, 159, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 179, 268, 222, 156, 83, 84, 85, 86,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 261, 208, 0, 504, 0, 0, 0, 0,
-	0, 0, 0, 151, 505, 0, 0, 178, 0, 180,
-	0, 0, 238, 193, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 323, 0, 0, 324, 0, 0, 0, 134,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 243, 257, 135, 234,
-	271, 139, 241, 130, 207, 230, 126, 255, 240, 190,
-	172, 173, 125, 0, 225, 149, 162, 146, 205, 0,
-	0, 145, 274, 0, 265, 128, 129, 264, 204, 252,
-	256, 191, 185, 127, 254, 189, 184, 176, 153, 168,
-	217, 183, 218, 169, 195, 194, 196, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 267, 0, 0, 0, 219, 0, 0, 242, 164,
-	163, 177, 0, 0, 0, 0, 0, 228, 210, 0,
-	0, 215, 226, 181, 253, 220, 258, 244, 266, 0,
-	221, 120, 245, 148, 192, 132, 133, 144, 150, 152,
-	154, 155, 201, 202, 213, 233, 246, 247, 248, 147,
-	140, 227, 141, 166, 142, 121, 235, 143, 122, 214,
-	251, 131, 161, 223, 188, 123, 187, 216, 250, 249,
-	0, 0, 0, 0, 0, 0, 158, 0, 262, 0,
-	206, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 231, 0, 0, 0, 0, 0,
-	171, 212, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 239, 260, 273, 263,
-	0, 0, 0, 272, 0, 0, 0, 0, 506, 0,
-	197, 198, 199, 200, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 157, 165, 0, 167,
-	137, 211, 160, 270, 174, 203, 170, 236, 175, 182,
-	224, 269, 209, 229, 136, 259, 237, 186, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 179, 268, 222, 156,
-	83, 84, 85, 86, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 261, 74, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 151,
-	0, 0, 0, 178, 0, 180, 0, 0, 238, 193,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 71, 0, 844, 80, 0,
-	0, 0, 0, 0, 0, 134, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 243, 257, 135, 234, 271, 139, 241, 130,
-	207, 230, 126, 255, 240, 190, 172, 173, 125, 0,
-	225, 149, 162, 146, 205, 0, 0, 145, 274, 0,
-	265, 128, 129, 264, 204, 252, 256, 191, 185, 127,
-	254, 189, 184, 176, 153, 168, 217, 183, 218, 169,
-	195, 194, 196, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
-	0, 219, 0, 0, 242, 164, 163, 177, 0, 0,
-	0, 0, 0, 228, 210, 0, 0, 215, 226, 181,
-	253, 220, 258, 244, 266, 0, 221, 120, 245, 148,
-	192, 132, 133, 144, 150, 152, 154, 155, 201, 202,
-	213, 233, 246, 247, 248, 147, 140, 227, 141, 166,
-	142, 121, 235, 143, 122, 214, 251, 131, 161, 223,
-	188, 123, 187, 216, 250, 249, 0, 0, 0, 0,
-	0, 0, 158, 0, 262, 0, 206, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	231, 0, 0, 0, 0, 0, 171, 212, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 239, 260, 273, 263, 0, 0, 0, 272,
-	0, 0, 0, 0, 0, 0, 197, 198, 199, 200,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 157, 165, 0, 167, 137, 211, 160, 270,
-	174, 203, 170, 236, 175, 182, 224, 269, 209, 229,
-	136, 259, 237, 186, 159, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 179, 268, 222, 156, 83, 84, 85, 86,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 261, 208, 0, 733, 0, 0, 0, 0,
-	0, 0, 0, 151, 0, 0, 0, 178, 0, 180,
-	0, 0, 238, 193, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 323, 0, 0, 324, 0, 0, 0, 134,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 243, 257, 135, 234,
-	271, 139, 241, 130, 207, 230, 126, 255, 240, 190,
-	172, 173, 125, 0, 225, 149, 162, 146, 205, 0,
-	0, 145, 274, 0, 265, 128, 129, 264, 204, 252,
-	256, 191, 185, 127, 254, 189, 184, 176, 153, 168,
-	217, 183, 218, 169, 195, 194, 196, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 267, 0, 0, 0, 219, 0, 0, 242, 164,
-	163, 177, 0, 0, 0, 0, 0, 228, 210, 0,
-	0, 215, 226, 181, 253, 220, 258, 244, 266, 0,
-	221, 120, 245, 148, 192, 132, 133, 144, 150, 152,
-	154, 155, 201, 202, 213, 233, 246, 247, 248, 147,
-	140, 227, 141, 166, 142, 121, 235, 143, 122, 214,
-	251, 131, 161, 223, 188, 123, 187, 216, 250, 249,
-	0, 0, 0, 0, 0, 0, 158, 0, 262, 0,
-	206, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 231, 0, 0, 0, 0, 0,
-	171, 212, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 239, 260, 273, 263,
-	0, 0, 0, 272, 0, 0, 0, 0, 732, 0,
-	197, 198, 199, 200, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 157, 165, 0, 167,
-	137, 211, 160, 270, 174, 203, 170, 236, 175, 182,
-	224, 269, 209, 229, 136, 259, 237, 186, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 179, 268, 222, 156,
-	83, 84, 85, 86, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	777, 626, 2046, 2026, 755, 1167, 1931, 1983, 1520, 645,
+	2000, 624, 1829, 1988, 1827, 1916, 1896, 535, 1202, 1785,
+	571, 1904, 1903, 498, 569, 1136, 1406, 1735, 91, 416,
+	1734, 298, 1546, 308, 1521, 1164, 1826, 1492, 1515, 1568,
+	1680, 1203, 1706, 1646, 1525, 91, 310, 1560, 1327, 1558,
+	593, 459, 1497, 828, 342, 342, 1139, 934, 94, 351,
+	352, 90, 1584, 579, 361, 1439, 303, 945, 749, 948,
+	939, 715, 1253, 1237, 752, 62, 625, 909, 1321, 935,
+	539, 302, 23, 417, 635, 821, 941, 825, 804, 779,
+	91, 1743, 750, 1147, 722, 1201, 586, 423, 1116, 1509,
+	312, 1107, 296, 560, 654, 63, 407, 867, 461, 1204,
+	741, 314, 313, 446, 791, 793, 521, 1123, 293, 476,
+	358, 357, 773, 434, 87, 353, 85, 348, 317, 317,
+	1923, 1306, 1493, 1119, 1322, 63, 1836, 1845, 1373, 504,
+	1843, 1844, 910, 1719, 546, 1711, 304, 1313, 421, 542,
+	356, 383, 390, 496, 792, 344, 580, 810, 811, 487,
+	424, 360, 536, 537, 408, 393, 795, 758, 491, 23,
+	880, 879, 889, 890, 882, 883, 884, 885, 886, 887,
+	888, 881, 547, 2030, 1914, 1960, 374, 1963, 1973, 425,
+	349, 534, 63, 533, 536, 537, 1917, 1918, 1919, 1920,
+	1998, 1971, 762, 1498, 1499, 1500, 1501, 1294, 1119, 439,
+	1793, 1330, 1328, 1325, 1329, 1331, 822, 1324, 1323, 1330,
+	1328, 1572, 1329, 1331, 1569, 1121, 394, 1361, 1678, 1545,
+	1544, 1732, 478, 489, 490, 488, 1636, 477, 1841, 1692,
+	355, 1975, 1380, 1384, 1386, 1388, 1390, 1391, 1393, 1688,
+	1398, 1394, 1395, 1396, 1397, 1375, 1376, 1377, 1378, 1359,
+	1360, 1381, 1718, 1362, 1922, 1363, 1364, 1365, 1366, 1367,
+	1368, 1369, 1370, 1371, 1372, 1379, 1571, 742, 1333, 1334,
+	1335, 482, 1691, 1383, 1385, 1387, 1389, 1392, 1968, 359,
+	2065, 2008, 1897, 91, 438, 376, 1970, 1933, 2015, 437,
+	387, 1987, 486, 744, 1672, 373, 372, 1585, 388, 483,
+	2021, 1374, 1929, 1930, 1811, 1933, 346, 1663, 543, 1314,
+	1810, 1977, 1978, 1502, 1925, 1926, 368, 1939, 556, 463,
+	1594, 1592, 1593, 1595, 485, 1591, 1898, 1590, 1589, 1586,
+	532, 531, 1440, 1522, 1831, 1949, 395, 1667, 1945, 1799,
+	1450, 91, 433, 1587, 502, 503, 1171, 522, 354, 436,
+	505, 1958, 1565, 1310, 1179, 1127, 524, 464, 1906, 1637,
+	526, 350, 473, 743, 1689, 1349, 807, 480, 1348, 806,
+	807, 813, 805, 399, 499, 468, 1708, 1707, 1404, 481,
+	484, 1588, 1177, 1176, 1175, 91, 814, 550, 1174, 479,
+	441, 1991, 63, 812, 342, 396, 377, 548, 549, 397,
+	417, 417, 417, 2070, 469, 837, 367, 2050, 465, 466,
+	467, 572, 1495, 1413, 881, 1976, 1879, 589, 544, 1304,
+	1303, 845, 846, 844, 401, 400, 714, 1293, 1485, 1846,
+	1847, 574, 1289, 720, 438, 91, 91, 91, 91, 723,
+	1924, 1160, 385, 1134, 386, 1830, 1102, 849, 384, 382,
+	381, 389, 1493, 391, 392, 375, 1141, 513, 588, 536,
+	537, 717, 823, 342, 342, 438, 342, 463, 317, 573,
+	756, 463, 536, 537, 1687, 523, 576, 525, 1122, 512,
+	475, 1382, 1596, 1597, 342, 342, 506, 507, 508, 509,
+	1307, 1944, 1266, 1992, 555, 342, 493, 342, 771, 91,
+	765, 767, 739, 1948, 711, 464, 566, 567, 510, 464,
+	568, 1690, 342, 360, 342, 1665, 785, 582, 91, 1664,
+	774, 91, 772, 527, 530, 545, 538, 63, 541, 1668,
+	1669, 442, 499, 800, 435, 784, 342, 378, 317, 581,
+	757, 1330, 1328, 776, 1329, 1331, 780, 342, 417, 798,
+	342, 787, 760, 540, 788, 738, 1487, 360, 775, 737,
+	1907, 1908, 528, 768, 563, 564, 565, 838, 418, 896,
+	897, 317, 1206, 1205, 745, 754, 561, 761, 418, 847,
+	781, 724, 725, 726, 727, 1118, 2060, 562, 317, 559,
+	2042, 1510, 829, 759, 1181, 1105, 440, 770, 829, 829,
+	575, 796, 789, 790, 808, 1989, 1990, 797, 1486, 1262,
+	1338, 1259, 1612, 3, 783, 1261, 1258, 1260, 1264, 1265,
+	1805, 317, 850, 1263, 1880, 1882, 1883, 1884, 1881, 465,
+	466, 467, 572, 786, 1254, 570, 1445, 1117, 900, 301,
+	11, 1254, 824, 420, 834, 835, 1340, 819, 846, 844,
+	801, 529, 1198, 420, 820, 782, 1340, 831, 832, 833,
+	558, 899, 1211, 1199, 465, 466, 467, 572, 1244, 907,
+	889, 890, 882, 883, 884, 885, 886, 887, 888, 881,
+	794, 362, 1242, 1243, 1241, 911, 465, 466, 467, 1648,
+	573, 299, 6, 300, 5, 424, 1912, 901, 902, 903,
+	904, 431, 844, 940, 942, 1674, 872, 2020, 845, 846,
+	844, 905, 1726, 892, 875, 895, 1614, 845, 846, 844,
+	1339, 1673, 398, 1658, 898, 573, 1414, 11, 950, 893,
+	894, 891, 924, 2071, 2057, 880, 879, 889, 890, 882,
+	883, 884, 885, 886, 887, 888, 881, 1649, 944, 2019,
+	1725, 1890, 2009, 1214, 91, 916, 884, 885, 886, 887,
+	888, 881, 1216, 1763, 1888, 422, 951, 2005, 1458, 1996,
+	943, 1900, 845, 846, 844, 1724, 1723, 91, 424, 6,
+	1886, 5, 1874, 1103, 1873, 298, 1872, 1129, 1889, 1149,
+	1137, 1138, 1162, 845, 846, 844, 438, 1168, 845, 846,
+	844, 1887, 1869, 774, 402, 1876, 342, 425, 1863, 1860,
+	1150, 1859, 1101, 1457, 63, 1828, 949, 1885, 1112, 853,
+	854, 855, 856, 857, 858, 1745, 851, 1840, 342, 1839,
+	1779, 589, 1448, 91, 1768, 1447, 845, 846, 844, 1195,
+	1196, 775, 1875, 1684, 845, 846, 844, 1856, 1126, 1683,
+	1151, 1152, 1153, 1679, 1642, 1172, 676, 1641, 845, 846,
+	844, 1640, 1154, 829, 829, 829, 1639, 1148, 1480, 845,
+	846, 844, 588, 718, 1212, 1213, 1192, 1193, 1194, 497,
+	317, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233,
+	1234, 1235, 1236, 1200, 924, 1209, 1246, 1247, 1156, 1191,
+	1158, 1188, 1187, 1157, 1159, 1155, 1178, 2051, 1222, 1182,
+	1183, 1184, 880, 879, 889, 890, 882, 883, 884, 885,
+	886, 887, 888, 881, 1271, 2001, 1269, 1189, 1849, 2032,
+	1185, 882, 883, 884, 885, 886, 887, 888, 881, 1967,
+	1255, 1280, 1281, 794, 465, 466, 467, 676, 1749, 764,
+	845, 846, 844, 1937, 1936, 1877, 1870, 1273, 1274, 1753,
+	1245, 1207, 1208, 2058, 1210, 1866, 1239, 1943, 829, 1217,
+	1218, 1219, 1865, 1220, 1221, 1864, 1838, 1223, 1224, 1742,
+	1285, 1848, 2039, 1744, 1746, 1748, 360, 1750, 1751, 1752,
+	1754, 1755, 1756, 1758, 1759, 1760, 1761, 1292, 1267, 1783,
+	1727, 1407, 1681, 845, 846, 844, 1660, 1270, 1528, 1272,
+	880, 879, 889, 890, 882, 883, 884, 885, 886, 887,
+	888, 881, 845, 846, 844, 1133, 1650, 1275, 1276, 880,
+	879, 889, 890, 882, 883, 884, 885, 886, 887, 888,
+	881, 1608, 1507, 1506, 1531, 1762, 1505, 1504, 1249, 1248,
+	1526, 1128, 920, 919, 918, 719, 1539, 1540, 1625, 1125,
+	2066, 1527, 1741, 1132, 880, 879, 889, 890, 882, 883,
+	884, 885, 886, 887, 888, 881, 1676, 1757, 1656, 1624,
+	845, 846, 844, 1747, 1611, 1911, 845, 846, 844, 1655,
+	2063, 1910, 1420, 1295, 836, 438, 1832, 1532, 1125, 2055,
+	723, 845, 846, 844, 1605, 1784, 845, 846, 844, 1604,
+	342, 1453, 1603, 342, 1416, 1452, 438, 1782, 342, 1125,
+	2054, 1309, 91, 1602, 1772, 1319, 845, 846, 844, 1721,
+	1315, 845, 846, 844, 845, 846, 844, 1298, 2049, 2048,
+	1299, 2018, 2017, 1301, 1715, 845, 846, 844, 845, 846,
+	844, 1346, 1714, 1601, 1696, 1316, 438, 1651, 1296, 1600,
+	1399, 942, 1401, 1573, 1317, 1318, 1468, 780, 1337, 1456,
+	342, 1583, 1538, 1459, 1542, 845, 846, 844, 1416, 1986,
+	1441, 845, 846, 844, 763, 1979, 1311, 1308, 1454, 1297,
+	1451, 1350, 1425, 845, 846, 844, 845, 846, 844, 1534,
+	1422, 1582, 1305, 880, 879, 889, 890, 882, 883, 884,
+	885, 886, 887, 888, 881, 1320, 1342, 829, 1415, 1421,
+	1403, 1533, 1535, 845, 846, 844, 1426, 1343, 1148, 1344,
+	1336, 1942, 1941, 1795, 1909, 86, 1417, 1581, 1405, 1418,
+	1419, 1402, 1347, 1431, 1279, 1437, 1438, 1400, 1250, 1434,
+	1427, 1428, 1429, 1430, 1278, 1432, 1433, 1408, 1277, 845,
+	846, 844, 1470, 1409, 1345, 845, 846, 844, 1268, 1541,
+	845, 846, 844, 364, 366, 365, 1795, 1794, 940, 740,
+	1474, 1529, 1475, 1442, 84, 363, 1446, 1778, 1777, 1774,
+	1775, 1483, 86, 342, 27, 44, 28, 342, 342, 1774,
+	1773, 342, 1655, 1654, 1478, 1435, 424, 1460, 1461, 1436,
+	1631, 1630, 1416, 1606, 583, 1444, 1239, 86, 492, 27,
+	44, 28, 471, 91, 1416, 1598, 716, 584, 1416, 1466,
+	1100, 842, 438, 1416, 1465, 898, 1473, 363, 1455, 438,
+	1168, 84, 1479, 1416, 1424, 1462, 1463, 1464, 1472, 2059,
+	1481, 1476, 1467, 1477, 1416, 1423, 1508, 472, 1471, 1291,
+	1290, 1484, 1287, 1286, 1416, 1503, 84, 1516, 2041, 1491,
+	1104, 1488, 1490, 1125, 1124, 470, 840, 1776, 1416, 471,
+	1282, 63, 1548, 1549, 1550, 1551, 880, 879, 889, 890,
+	882, 883, 884, 885, 886, 887, 888, 881, 1554, 1555,
+	1556, 1557, 473, 91, 1578, 1517, 1518, 1652, 1119, 86,
+	716, 1291, 1469, 1412, 473, 1251, 1519, 879, 889, 890,
+	882, 883, 884, 885, 886, 887, 888, 881, 1511, 1512,
+	1163, 1135, 763, 1130, 1562, 1563, 710, 557, 1564, 448,
+	451, 452, 453, 454, 449, 86, 450, 455, 1580, 2035,
+	2016, 2013, 2011, 393, 1995, 1899, 1892, 1620, 712, 1547,
+	443, 1771, 1769, 1616, 1561, 1559, 1671, 1644, 1619, 1577,
+	936, 448, 451, 452, 453, 454, 449, 1613, 450, 455,
+	342, 1599, 1610, 1553, 1552, 1767, 1578, 1240, 1351, 1621,
+	1622, 1623, 1607, 1341, 84, 1300, 1256, 1180, 1173, 933,
+	1615, 829, 932, 931, 1617, 930, 929, 1609, 928, 927,
+	926, 925, 923, 1628, 922, 921, 917, 868, 1629, 914,
+	2037, 912, 1647, 908, 1645, 84, 878, 877, 876, 874,
+	1659, 873, 91, 1626, 1627, 871, 870, 869, 1635, 1638,
+	866, 1643, 865, 1647, 448, 451, 452, 453, 454, 449,
+	864, 450, 455, 863, 1632, 862, 1685, 861, 1115, 1657,
+	860, 859, 713, 474, 1144, 1675, 1661, 880, 879, 889,
+	890, 882, 883, 884, 885, 886, 887, 888, 881, 1108,
+	1109, 1653, 501, 311, 1682, 1695, 1953, 1951, 1905, 1332,
+	1131, 1111, 494, 1114, 1113, 734, 736, 1686, 452, 453,
+	454, 735, 729, 728, 732, 730, 1288, 1694, 1697, 1698,
+	733, 731, 1699, 1700, 1701, 2023, 577, 1722, 578, 1149,
+	1137, 1138, 1728, 1494, 342, 342, 1142, 1720, 91, 1633,
+	1709, 1703, 1702, 1705, 1704, 438, 1634, 457, 343, 769,
+	1736, 1206, 1205, 438, 519, 520, 1713, 517, 518, 1712,
+	427, 429, 430, 511, 1740, 515, 516, 2036, 2031, 2002,
+	1999, 1733, 1965, 1731, 880, 879, 889, 890, 882, 883,
+	884, 885, 886, 887, 888, 881, 1964, 1962, 1516, 1857,
+	1765, 1764, 364, 366, 365, 1693, 1618, 1576, 514, 363,
+	1575, 1411, 716, 1302, 363, 1955, 1954, 1954, 1729, 1730,
+	500, 292, 1955, 815, 456, 379, 1, 2022, 2045, 1994,
+	1781, 2025, 766, 644, 627, 1957, 1913, 1997, 1959, 1789,
+	1915, 1850, 1312, 495, 1283, 1284, 1710, 669, 668, 667,
+	666, 656, 913, 657, 709, 428, 655, 1780, 1570, 371,
+	426, 380, 1801, 1677, 1543, 1215, 1257, 1895, 1766, 2034,
+	1932, 2064, 1969, 2014, 2007, 1928, 1790, 1798, 1791, 1796,
+	315, 816, 438, 1804, 551, 405, 1947, 1736, 414, 1797,
+	721, 1496, 1326, 1140, 1120, 751, 316, 1921, 1853, 1770,
+	369, 1143, 370, 1146, 1852, 1145, 438, 852, 1789, 1238,
+	915, 1736, 1837, 1252, 1443, 906, 1842, 591, 634, 628,
+	1851, 1833, 1567, 1858, 1566, 1537, 799, 30, 1792, 458,
+	843, 946, 1855, 1854, 93, 1891, 1161, 947, 2027, 1717,
+	1716, 1982, 1449, 643, 642, 463, 641, 640, 639, 447,
+	1894, 1802, 1803, 445, 1806, 1807, 1808, 1809, 444, 307,
+	1812, 1813, 1814, 1815, 1816, 1817, 1818, 1819, 1820, 1821,
+	1822, 1823, 1824, 1825, 1893, 306, 1871, 1410, 1574, 839,
+	841, 1536, 1902, 464, 1901, 1834, 1835, 1670, 1878, 1666,
+	1662, 1938, 1927, 1739, 1738, 1523, 1934, 1935, 1524, 1530,
+	1357, 1358, 1353, 91, 1355, 1356, 1354, 1352, 1514, 1513,
+	1110, 1106, 937, 432, 1861, 1862, 1482, 778, 88, 305,
+	1867, 1868, 1190, 585, 83, 347, 1940, 19, 1946, 22,
+	21, 20, 18, 17, 1789, 1950, 499, 1952, 16, 15,
+	52, 1961, 1956, 51, 50, 49, 14, 8, 48, 47,
+	46, 13, 12, 42, 41, 40, 39, 1972, 1974, 38,
+	37, 36, 35, 34, 33, 32, 31, 9, 1981, 1980,
+	66, 65, 64, 24, 25, 26, 72, 71, 802, 1993,
+	803, 43, 70, 69, 68, 2003, 2004, 29, 10, 7,
+	4, 2, 0, 2010, 0, 2012, 0, 1984, 0, 2006,
+	0, 0, 0, 0, 1894, 0, 2029, 0, 0, 0,
+	0, 0, 0, 0, 0, 2028, 0, 0, 0, 0,
+	0, 0, 438, 2033, 438, 0, 2038, 756, 2040, 756,
+	0, 0, 1966, 0, 0, 2047, 2044, 0, 2043, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 438, 2053, 2052, 0, 2056, 756, 0, 0,
+	2029, 2062, 0, 2061, 0, 0, 0, 0, 0, 2028,
+	1984, 2047, 2067, 0, 0, 0, 0, 0, 0, 0,
+	0, 2072, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1068, 995,
+	1015, 1053, 2069, 1013, 1070, 984, 1001, 1078, 1003, 1004,
+	1040, 962, 1023, 223, 999, 954, 987, 988, 956, 996,
+	957, 985, 1016, 166, 983, 1056, 1026, 193, 1076, 195,
+	0, 0, 254, 208, 0, 0, 1019, 1058, 1021, 1046,
+	179, 1012, 1041, 970, 1034, 1071, 1000, 1038, 1072, 0,
+	0, 0, 0, 465, 466, 467, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 1037, 1063, 998, 0,
+	0, 971, 1069, 1020, 1039, 0, 955, 1035, 0, 960,
+	963, 1077, 1061, 992, 993, 0, 0, 0, 0, 0,
+	0, 0, 1017, 1022, 1043, 1009, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 989, 0, 1030,
+	0, 0, 0, 965, 961, 0, 1014, 0, 138, 259,
+	274, 149, 250, 288, 153, 257, 144, 222, 246, 140,
+	272, 256, 205, 187, 188, 139, 0, 241, 164, 176,
+	161, 220, 1065, 1066, 160, 291, 964, 282, 142, 143,
+	281, 219, 269, 273, 206, 200, 141, 271, 204, 199,
+	191, 168, 183, 232, 198, 233, 184, 210, 209, 211,
+	1095, 1096, 1097, 1098, 1099, 969, 0, 990, 1044, 0,
+	953, 1052, 1059, 1011, 284, 1062, 1008, 1007, 235, 0,
+	0, 258, 178, 177, 192, 1057, 986, 997, 991, 994,
+	244, 225, 1064, 1029, 230, 242, 196, 270, 236, 275,
+	260, 283, 1047, 237, 134, 262, 163, 207, 146, 147,
+	159, 165, 167, 169, 170, 216, 217, 228, 249, 263,
+	264, 265, 162, 154, 243, 155, 180, 156, 135, 251,
+	157, 136, 229, 268, 145, 175, 239, 203, 137, 202,
+	231, 267, 266, 0, 0, 0, 0, 0, 0, 173,
+	952, 279, 0, 221, 1054, 958, 968, 966, 1005, 1031,
+	1032, 1033, 1080, 1049, 1051, 1050, 1079, 247, 0, 0,
+	0, 0, 0, 186, 227, 0, 248, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 959, 0, 255,
+	277, 290, 280, 1006, 977, 1018, 289, 980, 978, 1048,
+	979, 1036, 1088, 212, 213, 214, 215, 1002, 152, 1027,
+	1010, 1089, 1090, 1091, 1092, 1093, 1094, 982, 1060, 172,
+	0, 182, 151, 226, 174, 287, 189, 218, 185, 252,
+	190, 197, 240, 286, 224, 245, 150, 276, 253, 201,
+	976, 981, 975, 1024, 1025, 1073, 1074, 1075, 1045, 967,
+	1055, 972, 974, 973, 1042, 1086, 1085, 158, 234, 181,
+	1067, 1087, 1081, 1082, 1083, 1084, 1028, 131, 0, 194,
+	285, 238, 171, 0, 0, 0, 0, 0, 0, 662,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 223,
+	0, 0, 0, 0, 0, 636, 0, 0, 0, 166,
+	830, 0, 0, 193, 0, 195, 0, 0, 254, 208,
+	133, 132, 261, 278, 686, 694, 179, 0, 0, 0,
+	0, 0, 0, 826, 0, 0, 629, 0, 0, 592,
+	676, 675, 646, 652, 0, 0, 148, 647, 0, 0,
+	0, 648, 651, 649, 650, 0, 0, 678, 0, 0,
+	0, 0, 0, 590, 633, 0, 637, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 630,
+	631, 0, 0, 0, 0, 663, 0, 632, 0, 0,
+	827, 0, 653, 0, 138, 259, 274, 149, 250, 288,
+	153, 257, 144, 222, 246, 140, 272, 256, 205, 187,
+	188, 139, 0, 241, 164, 176, 161, 220, 660, 661,
+	160, 623, 658, 282, 142, 143, 281, 219, 269, 273,
+	206, 200, 141, 271, 204, 199, 191, 168, 183, 232,
+	198, 233, 184, 210, 209, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	284, 0, 0, 684, 235, 0, 0, 258, 178, 177,
+	192, 0, 0, 0, 659, 0, 244, 225, 697, 0,
+	230, 242, 196, 270, 236, 275, 260, 283, 0, 237,
+	134, 262, 163, 207, 146, 147, 159, 165, 167, 169,
+	170, 216, 217, 228, 249, 263, 264, 265, 162, 154,
+	243, 155, 180, 156, 135, 251, 157, 136, 229, 268,
+	145, 175, 239, 203, 137, 202, 231, 267, 266, 0,
+	0, 0, 0, 0, 0, 173, 0, 279, 682, 221,
+	696, 677, 679, 680, 683, 687, 688, 689, 690, 691,
+	693, 695, 698, 247, 0, 0, 0, 0, 0, 186,
+	227, 0, 248, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 277, 290, 622, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 664, 212,
+	213, 214, 215, 685, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 182, 151, 226,
+	174, 287, 189, 218, 185, 252, 190, 197, 240, 286,
+	224, 245, 150, 276, 253, 201, 704, 681, 703, 705,
+	706, 702, 707, 708, 692, 638, 0, 700, 699, 701,
+	0, 0, 0, 158, 234, 181, 0, 670, 671, 672,
+	673, 674, 0, 131, 0, 194, 285, 238, 171, 95,
+	594, 595, 596, 597, 598, 599, 600, 103, 601, 602,
+	603, 107, 604, 605, 606, 607, 608, 113, 114, 609,
+	610, 611, 612, 119, 613, 614, 615, 616, 124, 125,
+	617, 618, 619, 620, 621, 662, 133, 132, 261, 278,
+	0, 0, 0, 0, 0, 223, 0, 0, 0, 0,
+	0, 636, 0, 0, 0, 166, 2068, 0, 0, 193,
+	0, 195, 0, 0, 254, 208, 0, 0, 0, 0,
+	686, 694, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 629, 0, 0, 592, 676, 675, 646, 652,
+	0, 0, 148, 647, 0, 0, 0, 648, 651, 649,
+	650, 0, 0, 678, 0, 0, 0, 0, 0, 590,
+	633, 0, 637, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 630, 631, 0, 0, 0,
+	0, 663, 0, 632, 0, 0, 665, 0, 653, 0,
+	138, 259, 274, 149, 250, 288, 153, 257, 144, 222,
+	246, 140, 272, 256, 205, 187, 188, 139, 0, 241,
+	164, 176, 161, 220, 660, 661, 160, 623, 658, 282,
+	142, 143, 281, 219, 269, 273, 206, 200, 141, 271,
+	204, 199, 191, 168, 183, 232, 198, 233, 184, 210,
+	209, 211, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 284, 0, 0, 684,
+	235, 0, 0, 258, 178, 177, 192, 0, 0, 0,
+	659, 0, 244, 225, 697, 0, 230, 242, 196, 270,
+	236, 275, 260, 283, 0, 237, 134, 262, 163, 207,
+	146, 147, 159, 165, 167, 169, 170, 216, 217, 228,
+	249, 263, 264, 265, 162, 154, 243, 155, 180, 156,
+	135, 251, 157, 136, 229, 268, 145, 175, 239, 203,
+	137, 202, 231, 267, 266, 0, 0, 0, 0, 0,
+	0, 173, 0, 279, 682, 221, 696, 677, 679, 680,
+	683, 687, 688, 689, 690, 691, 693, 695, 698, 247,
+	0, 0, 0, 0, 0, 186, 227, 0, 248, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 277, 290, 622, 0, 0, 0, 289, 0,
+	0, 0, 0, 0, 664, 212, 213, 214, 215, 685,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 182, 151, 226, 174, 287, 189, 218,
+	185, 252, 190, 197, 240, 286, 224, 245, 150, 276,
+	253, 201, 704, 681, 703, 705, 706, 702, 707, 708,
+	692, 638, 0, 700, 699, 701, 0, 0, 0, 158,
+	234, 181, 0, 670, 671, 672, 673, 674, 0, 131,
+	0, 194, 285, 238, 171, 95, 594, 595, 596, 597,
+	598, 599, 600, 103, 601, 602, 603, 107, 604, 605,
+	606, 607, 608, 113, 114, 609, 610, 611, 612, 119,
+	613, 614, 615, 616, 124, 125, 617, 618, 619, 620,
+	621, 662, 133, 132, 261, 278, 0, 0, 0, 0,
+	0, 223, 0, 0, 0, 0, 0, 636, 0, 0,
+	0, 166, 0, 0, 0, 193, 0, 195, 0, 0,
+	254, 208, 0, 0, 0, 0, 686, 694, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 629, 0,
+	0, 592, 676, 675, 646, 652, 0, 0, 148, 647,
+	0, 0, 0, 648, 651, 649, 650, 0, 0, 678,
+	0, 0, 0, 0, 0, 590, 633, 0, 637, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 630, 631, 0, 0, 0, 0, 663, 0, 632,
+	0, 0, 665, 0, 653, 0, 138, 259, 274, 149,
+	250, 288, 153, 257, 144, 222, 246, 140, 272, 256,
+	205, 187, 188, 139, 0, 241, 164, 176, 161, 220,
+	660, 661, 160, 623, 658, 282, 142, 143, 281, 219,
+	269, 273, 206, 200, 141, 271, 204, 199, 191, 168,
+	183, 232, 198, 233, 184, 210, 209, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 284, 0, 0, 684, 235, 0, 0, 258,
+	178, 177, 192, 0, 0, 0, 659, 0, 244, 225,
+	697, 1985, 230, 242, 196, 270, 236, 275, 260, 283,
+	0, 237, 134, 262, 163, 207, 146, 147, 159, 165,
+	167, 169, 170, 216, 217, 228, 249, 263, 264, 265,
+	162, 154, 243, 155, 180, 156, 135, 251, 157, 136,
+	229, 268, 145, 175, 239, 203, 137, 202, 231, 267,
+	266, 0, 0, 0, 0, 0, 0, 173, 0, 279,
+	682, 221, 696, 677, 679, 680, 683, 687, 688, 689,
+	690, 691, 693, 695, 698, 247, 0, 0, 0, 0,
+	0, 186, 227, 0, 248, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 277, 290,
+	622, 0, 0, 0, 289, 0, 0, 0, 0, 0,
+	664, 212, 213, 214, 215, 685, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 182,
+	151, 226, 174, 287, 189, 218, 185, 252, 190, 197,
+	240, 286, 224, 245, 150, 276, 253, 201, 704, 681,
+	703, 705, 706, 702, 707, 708, 692, 638, 0, 700,
+	699, 701, 0, 0, 0, 158, 234, 181, 0, 670,
+	671, 672, 673, 674, 0, 131, 0, 194, 285, 238,
+	171, 95, 594, 595, 596, 597, 598, 599, 600, 103,
+	601, 602, 603, 107, 604, 605, 606, 607, 608, 113,
+	114, 609, 610, 611, 612, 119, 613, 614, 615, 616,
+	124, 125, 617, 618, 619, 620, 621, 662, 133, 132,
+	261, 278, 0, 0, 0, 0, 0, 223, 0, 0,
+	0, 0, 0, 636, 0, 0, 0, 166, 0, 0,
+	0, 193, 0, 195, 0, 0, 254, 208, 0, 0,
+	0, 0, 686, 694, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 629, 0, 0, 592, 676, 675,
+	646, 652, 0, 0, 148, 647, 0, 0, 0, 648,
+	651, 649, 650, 0, 0, 678, 0, 0, 0, 0,
+	0, 0, 633, 1786, 637, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 630, 631, 0,
+	0, 0, 0, 663, 0, 632, 0, 0, 665, 0,
+	653, 0, 138, 259, 274, 149, 250, 288, 153, 257,
+	144, 222, 246, 140, 272, 256, 205, 187, 188, 139,
+	0, 241, 164, 176, 161, 220, 660, 661, 160, 623,
+	658, 282, 142, 143, 281, 219, 269, 273, 206, 200,
+	141, 271, 204, 199, 191, 168, 183, 232, 198, 233,
+	184, 210, 209, 211, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
+	0, 684, 235, 0, 0, 258, 178, 177, 192, 0,
+	0, 0, 659, 0, 244, 225, 697, 0, 230, 242,
+	196, 270, 236, 275, 260, 283, 0, 237, 134, 262,
+	163, 207, 146, 147, 159, 165, 167, 169, 170, 216,
+	217, 228, 249, 263, 264, 265, 162, 154, 243, 155,
+	180, 156, 135, 251, 157, 136, 229, 268, 145, 175,
+	239, 203, 137, 202, 231, 267, 266, 0, 0, 0,
+	0, 0, 0, 173, 0, 279, 682, 221, 696, 677,
+	679, 680, 683, 687, 688, 689, 690, 691, 693, 695,
+	698, 247, 0, 0, 0, 0, 0, 186, 227, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 277, 290, 622, 0, 0, 0,
+	289, 0, 0, 0, 0, 0, 664, 212, 213, 214,
+	215, 685, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 182, 151, 226, 174, 287,
+	189, 218, 185, 252, 190, 197, 240, 286, 224, 245,
+	150, 276, 253, 201, 704, 681, 703, 705, 706, 702,
+	707, 708, 692, 638, 0, 700, 699, 701, 0, 0,
+	0, 1788, 234, 181, 1787, 670, 671, 672, 673, 674,
+	0, 131, 0, 194, 285, 238, 171, 95, 594, 595,
+	596, 597, 598, 599, 600, 103, 601, 602, 603, 107,
+	604, 605, 606, 607, 608, 113, 114, 609, 610, 611,
+	612, 119, 613, 614, 615, 616, 124, 125, 617, 618,
+	619, 620, 621, 662, 133, 132, 261, 278, 0, 0,
+	0, 0, 0, 223, 0, 0, 0, 0, 0, 636,
+	0, 0, 0, 166, 830, 0, 0, 193, 0, 195,
+	0, 0, 254, 208, 0, 0, 0, 0, 686, 694,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	629, 0, 0, 592, 676, 675, 646, 652, 0, 0,
+	148, 647, 0, 0, 0, 648, 651, 649, 650, 0,
+	0, 678, 0, 0, 0, 0, 0, 590, 633, 0,
+	637, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 630, 631, 0, 0, 0, 0, 663,
+	0, 632, 0, 0, 665, 0, 653, 0, 138, 259,
+	274, 149, 250, 288, 153, 257, 144, 222, 246, 140,
+	272, 256, 205, 187, 188, 139, 0, 241, 164, 176,
+	161, 220, 660, 661, 160, 623, 658, 282, 142, 143,
+	281, 219, 269, 273, 206, 200, 141, 271, 204, 199,
+	191, 168, 183, 232, 198, 233, 184, 210, 209, 211,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 284, 0, 0, 684, 235, 0,
+	0, 258, 178, 177, 192, 0, 0, 0, 659, 0,
+	244, 225, 697, 0, 230, 242, 196, 270, 236, 275,
+	260, 283, 0, 237, 134, 262, 163, 207, 146, 147,
+	159, 165, 167, 169, 170, 216, 217, 228, 249, 263,
+	264, 265, 162, 154, 243, 155, 180, 156, 135, 251,
+	157, 136, 229, 268, 145, 175, 239, 203, 137, 202,
+	231, 267, 266, 0, 0, 0, 0, 0, 0, 173,
+	0, 279, 682, 221, 696, 677, 679, 680, 683, 687,
+	688, 689, 690, 691, 693, 695, 698, 247, 0, 0,
+	0, 0, 0, 186, 227, 0, 248, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	277, 290, 622, 0, 0, 0, 289, 0, 0, 0,
+	0, 0, 664, 212, 213, 214, 215, 685, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 182, 151, 226, 174, 287, 189, 218, 185, 252,
+	190, 197, 240, 286, 224, 245, 150, 276, 253, 201,
+	704, 681, 703, 705, 706, 702, 707, 708, 692, 638,
+	0, 700, 699, 701, 0, 0, 0, 158, 234, 181,
+	0, 670, 671, 672, 673, 674, 0, 131, 0, 194,
+	285, 238, 171, 95, 594, 595, 596, 597, 598, 599,
+	600, 103, 601, 602, 603, 107, 604, 605, 606, 607,
+	608, 113, 114, 609, 610, 611, 612, 119, 613, 614,
+	615, 616, 124, 125, 617, 618, 619, 620, 621, 0,
+	133, 132, 261, 278, 86, 0, 662, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 223, 0, 0, 0,
+	0, 0, 636, 0, 0, 0, 166, 0, 0, 0,
+	193, 0, 195, 0, 0, 254, 208, 0, 0, 0,
+	0, 686, 694, 179, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 629, 0, 0, 592, 676, 675, 646,
+	652, 0, 0, 148, 647, 0, 0, 0, 648, 651,
+	649, 650, 0, 0, 678, 0, 0, 0, 0, 0,
+	590, 633, 0, 637, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 630, 631, 0, 0,
+	0, 0, 663, 0, 632, 0, 0, 665, 0, 653,
+	0, 138, 259, 274, 149, 250, 288, 153, 257, 144,
+	222, 246, 140, 272, 256, 205, 187, 188, 139, 0,
+	241, 164, 176, 161, 220, 660, 661, 160, 623, 658,
+	282, 142, 143, 281, 219, 269, 273, 206, 200, 141,
+	271, 204, 199, 191, 168, 183, 232, 198, 233, 184,
+	210, 209, 211, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 284, 0, 0,
+	684, 235, 0, 0, 258, 178, 177, 192, 0, 0,
+	0, 659, 0, 244, 225, 697, 0, 230, 242, 196,
+	270, 236, 275, 260, 283, 0, 237, 134, 262, 163,
+	207, 146, 147, 159, 165, 167, 169, 170, 216, 217,
+	228, 249, 263, 264, 265, 162, 154, 243, 155, 180,
+	156, 135, 251, 157, 136, 229, 268, 145, 175, 239,
+	203, 137, 202, 231, 267, 266, 0, 0, 0, 0,
+	0, 0, 173, 0, 279, 682, 221, 696, 677, 679,
+	680, 683, 687, 688, 689, 690, 691, 693, 695, 698,
+	247, 0, 0, 0, 0, 0, 186, 227, 0, 248,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 255, 277, 290, 622, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 664, 212, 213, 214, 215,
+	685, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 172, 0, 182, 151, 226, 174, 287, 189,
+	218, 185, 252, 190, 197, 240, 286, 224, 245, 150,
+	276, 253, 201, 704, 681, 703, 705, 706, 702, 707,
+	708, 692, 638, 0, 700, 699, 701, 0, 0, 0,
+	158, 234, 181, 0, 670, 671, 672, 673, 674, 0,
+	131, 0, 194, 285, 238, 171, 95, 594, 595, 596,
+	597, 598, 599, 600, 103, 601, 602, 603, 107, 604,
+	605, 606, 607, 608, 113, 114, 609, 610, 611, 612,
+	119, 613, 614, 615, 616, 124, 125, 617, 618, 619,
+	620, 621, 662, 133, 132, 261, 278, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 636, 0,
+	0, 0, 166, 0, 0, 0, 193, 0, 195, 0,
+	0, 254, 208, 0, 0, 0, 0, 686, 694, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 629,
+	0, 0, 592, 676, 675, 646, 652, 0, 0, 148,
+	647, 0, 0, 0, 648, 651, 649, 650, 0, 0,
+	678, 0, 0, 0, 0, 0, 590, 633, 0, 637,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 630, 631, 587, 0, 0, 0, 663, 0,
+	632, 0, 0, 665, 0, 653, 0, 138, 259, 274,
+	149, 250, 288, 153, 257, 144, 222, 246, 140, 272,
+	256, 205, 187, 188, 139, 0, 241, 164, 176, 161,
+	220, 660, 661, 160, 623, 658, 282, 142, 143, 281,
+	219, 269, 273, 206, 200, 141, 271, 204, 199, 191,
+	168, 183, 232, 198, 233, 184, 210, 209, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 284, 0, 0, 684, 235, 0, 0,
+	258, 178, 177, 192, 0, 0, 0, 659, 0, 244,
+	225, 697, 0, 230, 242, 196, 270, 236, 275, 260,
+	283, 0, 237, 134, 262, 163, 207, 146, 147, 159,
+	165, 167, 169, 170, 216, 217, 228, 249, 263, 264,
+	265, 162, 154, 243, 155, 180, 156, 135, 251, 157,
+	136, 229, 268, 145, 175, 239, 203, 137, 202, 231,
+	267, 266, 0, 0, 0, 0, 0, 0, 173, 0,
+	279, 682, 221, 696, 677, 679, 680, 683, 687, 688,
+	689, 690, 691, 693, 695, 698, 247, 0, 0, 0,
+	0, 0, 186, 227, 0, 248, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 255, 277,
+	290, 622, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 664, 212, 213, 214, 215, 685, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 0,
+	182, 151, 226, 174, 287, 189, 218, 185, 252, 190,
+	197, 240, 286, 224, 245, 150, 276, 253, 201, 704,
+	681, 703, 705, 706, 702, 707, 708, 692, 638, 0,
+	700, 699, 701, 0, 0, 0, 158, 234, 181, 0,
+	670, 671, 672, 673, 674, 0, 131, 0, 194, 285,
+	238, 171, 95, 594, 595, 596, 597, 598, 599, 600,
+	103, 601, 602, 603, 107, 604, 605, 606, 607, 608,
+	113, 114, 609, 610, 611, 612, 119, 613, 614, 615,
+	616, 124, 125, 617, 618, 619, 620, 621, 662, 133,
+	132, 261, 278, 0, 0, 0, 0, 0, 223, 0,
+	0, 0, 0, 0, 636, 0, 0, 0, 166, 0,
+	0, 0, 193, 0, 195, 0, 0, 254, 208, 0,
+	0, 0, 0, 686, 694, 179, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 629, 0, 0, 592, 676,
+	675, 646, 652, 0, 0, 148, 647, 0, 0, 0,
+	648, 651, 649, 650, 0, 0, 678, 0, 0, 0,
+	0, 0, 0, 633, 0, 637, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 630, 631,
+	0, 0, 0, 0, 663, 0, 632, 0, 0, 665,
+	0, 653, 0, 138, 259, 274, 149, 250, 288, 153,
+	257, 144, 222, 246, 140, 272, 256, 205, 187, 188,
+	139, 0, 241, 164, 176, 161, 220, 660, 661, 160,
+	623, 658, 282, 142, 143, 281, 219, 269, 273, 206,
+	200, 141, 271, 204, 199, 191, 168, 183, 232, 198,
+	233, 184, 210, 209, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 284,
+	0, 0, 684, 235, 0, 0, 258, 178, 177, 192,
+	0, 0, 0, 659, 0, 244, 225, 697, 0, 230,
+	242, 196, 270, 236, 275, 260, 283, 0, 237, 134,
+	262, 163, 207, 146, 147, 159, 165, 167, 169, 170,
+	216, 217, 228, 249, 263, 264, 265, 162, 154, 243,
+	155, 180, 156, 135, 251, 157, 136, 229, 268, 145,
+	175, 239, 203, 137, 202, 231, 267, 266, 0, 0,
+	0, 0, 0, 0, 173, 0, 279, 682, 221, 696,
+	677, 679, 680, 683, 687, 688, 689, 690, 691, 693,
+	695, 698, 247, 0, 0, 0, 0, 0, 186, 227,
+	0, 248, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 255, 277, 290, 622, 0, 0,
+	0, 289, 0, 0, 0, 0, 0, 664, 212, 213,
+	214, 215, 685, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 0, 182, 151, 226, 174,
+	287, 189, 218, 185, 252, 190, 197, 240, 286, 224,
+	245, 150, 276, 253, 201, 704, 681, 703, 705, 706,
+	702, 707, 708, 692, 638, 0, 700, 699, 701, 0,
+	0, 0, 1788, 234, 181, 1787, 670, 671, 672, 673,
+	674, 0, 131, 0, 194, 285, 238, 171, 95, 594,
+	595, 596, 597, 598, 599, 600, 103, 601, 602, 603,
+	107, 604, 605, 606, 607, 608, 113, 114, 609, 610,
+	611, 612, 119, 613, 614, 615, 616, 124, 125, 617,
+	618, 619, 620, 621, 662, 133, 132, 261, 278, 0,
+	0, 0, 0, 0, 223, 0, 0, 0, 0, 0,
+	636, 0, 0, 0, 166, 0, 0, 0, 193, 0,
+	195, 0, 0, 254, 208, 0, 0, 0, 0, 686,
+	694, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 629, 0, 0, 592, 676, 675, 646, 652, 0,
+	0, 148, 647, 0, 0, 0, 648, 651, 649, 650,
+	0, 0, 678, 0, 0, 0, 0, 0, 590, 633,
+	0, 637, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 630, 631, 0, 0, 0, 0,
+	663, 0, 632, 0, 0, 665, 0, 653, 0, 138,
+	259, 274, 149, 250, 288, 153, 257, 144, 222, 246,
+	140, 272, 256, 205, 187, 188, 139, 0, 241, 164,
+	176, 161, 220, 660, 661, 160, 623, 658, 282, 142,
+	143, 281, 219, 269, 273, 206, 200, 141, 271, 204,
+	199, 191, 168, 183, 232, 198, 233, 184, 210, 209,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 284, 0, 0, 684, 235,
+	0, 0, 258, 178, 177, 192, 0, 0, 0, 659,
+	0, 244, 225, 697, 0, 230, 242, 196, 270, 236,
+	275, 260, 283, 0, 237, 134, 262, 163, 207, 146,
+	147, 159, 165, 167, 169, 170, 216, 217, 228, 249,
+	263, 264, 265, 162, 154, 243, 155, 180, 156, 135,
+	251, 157, 136, 229, 268, 145, 175, 239, 203, 137,
+	202, 231, 267, 266, 0, 0, 0, 0, 0, 0,
+	173, 0, 279, 682, 221, 696, 677, 679, 680, 683,
+	687, 688, 689, 690, 691, 693, 695, 698, 247, 0,
+	0, 0, 0, 0, 186, 227, 0, 248, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	255, 277, 290, 622, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 664, 212, 213, 214, 215, 685, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 182, 151, 226, 174, 287, 189, 218, 185,
+	252, 190, 197, 240, 286, 224, 245, 150, 276, 253,
+	201, 704, 681, 703, 705, 706, 702, 707, 708, 692,
+	638, 0, 700, 699, 701, 0, 0, 0, 158, 234,
+	181, 0, 670, 671, 672, 673, 674, 0, 131, 0,
+	194, 285, 238, 171, 95, 594, 595, 596, 597, 598,
+	599, 600, 103, 601, 602, 603, 107, 604, 605, 606,
+	607, 608, 113, 114, 609, 610, 611, 612, 119, 613,
+	614, 615, 616, 124, 125, 617, 618, 619, 620, 621,
+	662, 133, 132, 261, 278, 0, 0, 0, 0, 0,
+	223, 0, 0, 0, 0, 0, 636, 0, 0, 0,
+	166, 0, 0, 0, 193, 0, 195, 0, 0, 254,
+	208, 0, 0, 0, 0, 686, 694, 179, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 629, 0, 0,
+	592, 676, 675, 646, 652, 0, 0, 148, 647, 0,
+	0, 0, 648, 651, 649, 650, 0, 0, 678, 0,
+	0, 0, 0, 0, 0, 633, 0, 637, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	630, 631, 0, 0, 0, 0, 663, 0, 632, 0,
+	0, 665, 0, 653, 0, 138, 259, 274, 149, 250,
+	288, 153, 257, 144, 222, 246, 140, 272, 256, 205,
+	187, 188, 139, 0, 241, 164, 176, 161, 220, 660,
+	661, 160, 623, 658, 282, 142, 143, 281, 219, 269,
+	273, 206, 200, 141, 271, 204, 199, 191, 168, 183,
+	232, 198, 233, 184, 210, 209, 211, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 284, 0, 0, 684, 235, 0, 0, 258, 178,
+	177, 192, 0, 0, 0, 659, 0, 244, 225, 697,
+	0, 230, 242, 196, 270, 236, 275, 260, 283, 0,
+	237, 134, 262, 163, 207, 146, 147, 159, 165, 167,
+	169, 170, 216, 217, 228, 249, 263, 264, 265, 162,
+	154, 243, 155, 180, 156, 135, 251, 157, 136, 229,
+	268, 145, 175, 239, 203, 137, 202, 231, 267, 266,
+	0, 0, 0, 0, 0, 0, 173, 0, 279, 682,
+	221, 696, 677, 679, 680, 683, 687, 688, 689, 690,
+	691, 693, 695, 698, 247, 0, 0, 0, 0, 0,
+	186, 227, 0, 248, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 255, 277, 290, 622,
+	0, 0, 0, 289, 0, 0, 0, 0, 0, 664,
+	212, 213, 214, 215, 685, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 0, 182, 151,
+	226, 174, 287, 189, 218, 185, 252, 190, 197, 240,
+	286, 224, 245, 150, 276, 253, 201, 704, 681, 703,
+	705, 706, 702, 707, 708, 692, 638, 0, 700, 699,
+	701, 0, 0, 0, 158, 234, 181, 0, 670, 671,
+	672, 673, 674, 0, 131, 0, 194, 285, 238, 171,
+	95, 594, 595, 596, 597, 598, 599, 600, 103, 601,
+	602, 603, 107, 604, 605, 606, 607, 608, 113, 114,
+	609, 610, 611, 612, 119, 613, 614, 615, 616, 124,
+	125, 617, 618, 619, 620, 621, 662, 133, 132, 261,
+	278, 0, 0, 0, 0, 0, 223, 0, 0, 0,
+	0, 0, 636, 0, 0, 0, 166, 0, 0, 0,
+	193, 0, 195, 0, 0, 254, 208, 0, 0, 0,
+	0, 686, 694, 179, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 592, 676, 675, 646,
+	652, 0, 0, 148, 647, 0, 0, 0, 648, 651,
+	649, 650, 0, 0, 678, 0, 0, 0, 0, 0,
+	590, 633, 0, 637, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 630, 631, 0, 0,
+	0, 0, 663, 0, 632, 0, 0, 665, 0, 653,
+	0, 138, 259, 274, 149, 250, 288, 153, 257, 144,
+	222, 246, 140, 272, 256, 205, 187, 188, 139, 0,
+	241, 164, 176, 161, 220, 660, 661, 160, 623, 658,
+	282, 142, 143, 281, 219, 269, 273, 206, 200, 141,
+	271, 204, 199, 191, 168, 183, 232, 198, 233, 184,
+	210, 209, 211, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 284, 0, 0,
+	684, 235, 0, 0, 258, 178, 177, 192, 0, 0,
+	0, 659, 0, 244, 225, 697, 0, 230, 242, 196,
+	270, 236, 275, 260, 283, 0, 237, 134, 262, 163,
+	207, 146, 147, 159, 165, 167, 169, 170, 216, 217,
+	228, 249, 263, 264, 265, 162, 154, 243, 155, 180,
+	156, 135, 251, 157, 136, 229, 268, 145, 175, 239,
+	203, 137, 202, 231, 267, 266, 0, 0, 0, 0,
+	0, 0, 173, 0, 279, 682, 221, 696, 677, 679,
+	680, 683, 687, 688, 689, 690, 691, 693, 695, 698,
+	247, 0, 0, 0, 0, 0, 186, 227, 0, 248,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 255, 277, 290, 622, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 664, 212, 213, 214, 215,
+	685, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 172, 0, 182, 151, 226, 174, 287, 189,
+	218, 185, 252, 190, 197, 240, 286, 224, 245, 150,
+	276, 253, 201, 704, 681, 703, 705, 706, 702, 707,
+	708, 692, 638, 0, 700, 699, 701, 0, 0, 0,
+	158, 234, 181, 0, 670, 671, 672, 673, 674, 0,
+	131, 0, 194, 285, 238, 171, 95, 594, 595, 596,
+	597, 598, 599, 600, 103, 601, 602, 603, 107, 604,
+	605, 606, 607, 608, 113, 114, 609, 610, 611, 612,
+	119, 613, 614, 615, 616, 124, 125, 617, 618, 619,
+	620, 621, 0, 133, 132, 261, 278, 327, 0, 326,
+	330, 322, 0, 0, 0, 0, 0, 0, 0, 223,
+	0, 318, 0, 0, 0, 0, 0, 0, 0, 166,
+	0, 0, 337, 193, 0, 195, 0, 0, 254, 208,
+	0, 0, 0, 0, 0, 0, 179, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 340,
+	0, 0, 341, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 138, 259, 274, 149, 250, 288,
+	153, 257, 144, 222, 246, 140, 272, 256, 205, 187,
+	188, 139, 0, 241, 164, 176, 161, 220, 0, 0,
+	160, 291, 0, 282, 142, 143, 281, 219, 269, 273,
+	206, 200, 141, 271, 204, 199, 191, 168, 183, 232,
+	198, 233, 184, 210, 209, 211, 0, 0, 0, 0,
+	0, 320, 319, 323, 0, 0, 0, 0, 0, 325,
+	284, 0, 0, 0, 235, 0, 0, 258, 178, 177,
+	192, 329, 0, 0, 0, 0, 244, 225, 0, 0,
+	230, 242, 196, 270, 236, 321, 260, 283, 0, 345,
+	134, 262, 163, 207, 146, 147, 159, 165, 167, 169,
+	170, 216, 217, 228, 249, 263, 264, 265, 162, 154,
+	243, 155, 180, 156, 135, 251, 157, 136, 229, 268,
+	145, 175, 239, 203, 137, 202, 231, 267, 266, 0,
+	0, 0, 0, 0, 0, 173, 0, 279, 0, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 247, 0, 0, 0, 324, 328, 331,
+	227, 332, 333, 0, 0, 334, 335, 336, 0, 0,
+	338, 339, 0, 0, 0, 255, 277, 290, 280, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 212,
+	213, 214, 215, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 182, 151, 226,
+	174, 287, 189, 218, 185, 252, 190, 197, 240, 286,
+	224, 245, 150, 276, 253, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 158, 234, 181, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 194, 285, 238, 171, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 0, 133, 132, 261, 278,
+	327, 0, 326, 330, 322, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 318, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 337, 193, 0, 195, 0,
+	0, 254, 208, 0, 0, 0, 0, 0, 0, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 340, 0, 0, 341, 0, 0, 0, 148,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 259, 274,
+	149, 250, 288, 153, 257, 144, 222, 246, 140, 272,
+	256, 205, 187, 188, 139, 0, 241, 164, 176, 161,
+	220, 0, 0, 160, 291, 0, 282, 142, 143, 281,
+	219, 269, 273, 206, 200, 141, 271, 204, 199, 191,
+	168, 183, 232, 198, 233, 184, 210, 209, 211, 0,
+	0, 0, 0, 0, 320, 319, 323, 0, 0, 0,
+	0, 0, 325, 284, 0, 0, 0, 235, 0, 0,
+	258, 178, 177, 192, 329, 0, 0, 0, 0, 244,
+	225, 0, 0, 230, 242, 196, 270, 236, 321, 260,
+	283, 0, 237, 134, 262, 163, 207, 146, 147, 159,
+	165, 167, 169, 170, 216, 217, 228, 249, 263, 264,
+	265, 162, 154, 243, 155, 180, 156, 135, 251, 157,
+	136, 229, 268, 145, 175, 239, 203, 137, 202, 231,
+	267, 266, 0, 0, 0, 0, 0, 0, 173, 0,
+	279, 0, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 247, 0, 0, 0,
+	324, 328, 331, 227, 332, 333, 0, 0, 334, 335,
+	336, 0, 0, 338, 339, 0, 0, 0, 255, 277,
+	290, 280, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 212, 213, 214, 215, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 0,
+	182, 151, 226, 174, 287, 189, 218, 185, 252, 190,
+	197, 240, 286, 224, 245, 150, 276, 253, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 158, 234, 181, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 194, 285,
+	238, 171, 95, 96, 97, 98, 99, 100, 101, 102,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 261, 208, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 151, 0, 0,
-	0, 178, 0, 180, 0, 0, 238, 193, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1738, 80, 612, 0, 0,
-	0, 0, 0, 134, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	243, 257, 135, 234, 271, 139, 241, 130, 207, 230,
-	126, 255, 240, 190, 172, 173, 125, 0, 225, 149,
-	162, 146, 205, 0, 0, 145, 274, 0, 265, 128,
-	129, 264, 204, 252, 256, 191, 185, 127, 254, 189,
-	184, 176, 153, 168, 217, 183, 218, 169, 195, 194,
-	196, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 267, 0, 0, 0, 219,
-	0, 0, 242, 164, 163, 177, 0, 0, 0, 0,
-	0, 228, 210, 0, 0, 215, 226, 181, 253, 220,
-	258, 244, 266, 0, 221, 120, 245, 148, 192, 132,
-	133, 144, 150, 152, 154, 155, 201, 202, 213, 233,
-	246, 247, 248, 147, 140, 227, 141, 166, 142, 121,
-	235, 143, 122, 214, 251, 131, 161, 223, 188, 123,
-	187, 216, 250, 249, 0, 0, 0, 0, 0, 0,
-	158, 0, 262, 0, 206, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 231, 0,
-	0, 0, 0, 0, 171, 212, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	239, 260, 273, 263, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 0, 197, 198, 199, 200, 0, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	157, 165, 0, 167, 137, 211, 160, 270, 174, 203,
-	170, 236, 175, 182, 224, 269, 209, 229, 136, 259,
-	237, 186, 159, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	179, 268, 222, 156, 83, 84, 85, 86, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	261, 208, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 151, 0, 0, 0, 178, 0, 180, 0, 0,
-	238, 193, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 0, 0, 687, 0, 0, 0, 134, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 243, 257, 135, 234, 271, 139,
-	241, 130, 207, 230, 126, 255, 240, 190, 172, 173,
-	125, 0, 225, 149, 162, 146, 205, 0, 0, 145,
-	274, 0, 265, 128, 129, 264, 204, 252, 256, 191,
-	185, 127, 254, 189, 184, 176, 153, 168, 217, 183,
-	218, 169, 195, 194, 196, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
-	0, 0, 0, 219, 0, 0, 242, 164, 163, 177,
-	0, 0, 0, 0, 0, 228, 210, 0, 0, 215,
-	226, 181, 253, 220, 258, 244, 266, 0, 221, 120,
-	245, 148, 192, 132, 133, 144, 150, 152, 154, 155,
-	201, 202, 213, 233, 246, 247, 248, 147, 140, 227,
-	141, 166, 142, 121, 235, 143, 122, 214, 251, 131,
-	161, 223, 188, 123, 187, 216, 250, 249, 0, 0,
-	0, 0, 0, 0, 158, 0, 262, 0, 206, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 231, 0, 0, 0, 0, 0, 171, 212,
-	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 239, 260, 273, 263, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 1279, 197, 198,
-	199, 200, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 157, 165, 0, 167, 137, 211,
-	160, 270, 174, 203, 170, 236, 175, 182, 224, 269,
-	209, 229, 136, 259, 237, 186, 159, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 179, 268, 222, 156, 83, 84,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 261, 208, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 151, 1068, 0, 0, 178,
-	0, 180, 0, 0, 238, 193, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 0, 0, 687, 0, 0,
-	0, 134, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 243, 257,
-	135, 234, 271, 139, 241, 130, 207, 230, 126, 255,
-	240, 190, 172, 173, 125, 0, 225, 149, 162, 146,
-	205, 0, 0, 145, 274, 0, 265, 128, 129, 264,
-	204, 252, 256, 191, 185, 127, 254, 189, 184, 176,
-	153, 168, 217, 183, 218, 169, 195, 194, 196, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 267, 0, 0, 0, 219, 0, 0,
-	242, 164, 163, 177, 0, 0, 0, 0, 0, 228,
-	210, 0, 0, 215, 226, 181, 253, 220, 258, 244,
-	266, 0, 221, 120, 245, 148, 192, 132, 133, 144,
-	150, 152, 154, 155, 201, 202, 213, 233, 246, 247,
-	248, 147, 140, 227, 141, 166, 142, 121, 235, 143,
-	122, 214, 251, 131, 161, 223, 188, 123, 187, 216,
-	250, 249, 0, 0, 0, 0, 0, 0, 158, 0,
-	262, 0, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 231, 0, 0, 0,
-	0, 0, 171, 212, 0, 232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 239, 260,
-	273, 263, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 0, 197, 198, 199, 200, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 157, 165,
-	0, 167, 137, 211, 160, 270, 174, 203, 170, 236,
-	175, 182, 224, 269, 209, 229, 136, 259, 237, 186,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 179, 268,
-	222, 156, 83, 84, 85, 86, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 0, 133,
+	132, 261, 278, 86, 0, 27, 44, 28, 0, 0,
+	0, 0, 0, 0, 0, 223, 294, 0, 0, 0,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 193,
+	0, 195, 0, 0, 254, 208, 0, 0, 0, 0,
+	0, 0, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 84, 0, 0, 92, 0, 0, 0, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	138, 259, 274, 149, 250, 288, 153, 257, 144, 222,
+	246, 140, 272, 256, 205, 187, 188, 139, 0, 241,
+	164, 176, 161, 220, 0, 0, 160, 291, 0, 282,
+	142, 143, 281, 219, 269, 273, 206, 200, 141, 271,
+	204, 199, 191, 168, 183, 232, 198, 233, 184, 210,
+	209, 211, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 0, 0, 0, 284, 0, 0, 0,
+	235, 0, 0, 258, 178, 177, 192, 0, 0, 0,
+	0, 0, 244, 225, 0, 0, 230, 242, 196, 270,
+	236, 275, 260, 283, 0, 237, 134, 262, 163, 207,
+	146, 147, 159, 165, 167, 169, 170, 216, 217, 228,
+	249, 263, 264, 265, 162, 154, 243, 155, 180, 156,
+	135, 251, 157, 136, 229, 268, 145, 175, 239, 203,
+	137, 202, 231, 267, 266, 0, 0, 0, 0, 0,
+	0, 173, 0, 279, 0, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 186, 227, 0, 248, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 277, 290, 280, 0, 0, 0, 289, 0,
+	0, 0, 0, 0, 0, 212, 213, 214, 215, 295,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 182, 151, 226, 174, 287, 189, 218,
+	185, 252, 190, 197, 240, 286, 224, 245, 150, 276,
+	253, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
+	234, 181, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 194, 285, 238, 171, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 223, 133, 132, 261, 278, 0, 0, 0, 0,
+	0, 166, 404, 0, 0, 193, 0, 195, 0, 0,
+	254, 208, 0, 0, 0, 0, 0, 0, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 92, 412, 413, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 418,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 259, 274, 149,
+	250, 288, 153, 257, 144, 222, 246, 140, 272, 256,
+	205, 187, 188, 139, 0, 241, 164, 176, 161, 220,
+	0, 0, 160, 291, 420, 282, 142, 419, 281, 219,
+	269, 273, 206, 200, 141, 271, 204, 199, 191, 168,
+	183, 232, 198, 233, 184, 210, 209, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 284, 0, 0, 0, 235, 0, 0, 258,
+	178, 177, 192, 0, 0, 0, 0, 0, 244, 225,
+	0, 0, 230, 242, 196, 270, 236, 275, 260, 283,
+	403, 237, 134, 262, 163, 207, 146, 147, 159, 165,
+	167, 169, 170, 216, 217, 228, 249, 263, 264, 265,
+	162, 154, 243, 155, 180, 156, 135, 251, 157, 136,
+	229, 268, 145, 175, 239, 203, 137, 202, 231, 267,
+	266, 0, 0, 0, 0, 0, 0, 173, 0, 279,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 247, 0, 0, 0, 0,
+	0, 186, 227, 0, 248, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 277, 290,
+	280, 0, 0, 0, 289, 0, 0, 0, 0, 0,
+	406, 212, 213, 214, 215, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 182,
+	151, 226, 174, 287, 189, 415, 409, 410, 190, 197,
+	240, 286, 224, 245, 150, 276, 253, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 158, 234, 181, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 194, 285, 238,
+	171, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 129, 130, 223, 133, 132,
+	261, 278, 848, 0, 0, 0, 0, 166, 0, 0,
+	0, 193, 0, 195, 0, 0, 254, 208, 0, 0,
+	0, 0, 0, 0, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 845, 846,
+	844, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 259, 274, 149, 250, 288, 153, 257,
+	144, 222, 246, 140, 272, 256, 205, 187, 188, 139,
+	0, 241, 164, 176, 161, 220, 0, 0, 160, 291,
+	0, 282, 142, 143, 281, 219, 269, 273, 206, 200,
+	141, 271, 204, 199, 191, 168, 183, 232, 198, 233,
+	184, 210, 209, 211, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
+	0, 0, 235, 0, 0, 258, 178, 177, 192, 0,
+	0, 0, 0, 0, 244, 225, 0, 0, 230, 242,
+	196, 270, 236, 275, 260, 283, 0, 237, 134, 262,
+	163, 207, 146, 147, 159, 165, 167, 169, 170, 216,
+	217, 228, 249, 263, 264, 265, 162, 154, 243, 155,
+	180, 156, 135, 251, 157, 136, 229, 268, 145, 175,
+	239, 203, 137, 202, 231, 267, 266, 0, 0, 0,
+	0, 0, 0, 173, 0, 279, 0, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 186, 227, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 277, 290, 280, 0, 0, 0,
+	289, 0, 0, 0, 0, 0, 0, 212, 213, 214,
+	215, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 182, 151, 226, 174, 287,
+	189, 218, 185, 252, 190, 197, 240, 286, 224, 245,
+	150, 276, 253, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 158, 234, 181, 0, 0, 0, 0, 0, 0,
+	0, 131, 0, 194, 285, 238, 171, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 223, 133, 132, 261, 278, 0, 0,
+	0, 0, 0, 166, 0, 0, 0, 193, 0, 195,
+	0, 0, 254, 208, 0, 0, 0, 0, 0, 0,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 92, 412, 413, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 418, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 259,
+	274, 149, 250, 288, 153, 257, 144, 222, 246, 140,
+	272, 256, 205, 187, 188, 139, 0, 241, 164, 176,
+	161, 220, 0, 0, 160, 291, 420, 282, 142, 419,
+	281, 219, 269, 273, 206, 200, 141, 271, 204, 199,
+	191, 168, 183, 232, 198, 233, 184, 210, 209, 211,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 284, 0, 0, 0, 235, 0,
+	0, 258, 178, 177, 192, 0, 0, 0, 0, 0,
+	244, 225, 0, 0, 230, 242, 196, 270, 236, 275,
+	260, 283, 0, 237, 134, 262, 163, 207, 146, 147,
+	159, 165, 167, 169, 170, 216, 217, 228, 249, 263,
+	264, 265, 162, 154, 243, 155, 180, 156, 135, 251,
+	157, 136, 229, 268, 145, 175, 239, 203, 137, 202,
+	231, 267, 266, 0, 0, 0, 0, 0, 0, 173,
+	0, 279, 0, 221, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 186, 227, 0, 248, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	277, 290, 280, 0, 0, 0, 289, 0, 0, 0,
+	0, 0, 0, 212, 213, 214, 215, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 182, 151, 226, 174, 287, 189, 415, 409, 410,
+	190, 197, 240, 286, 224, 245, 150, 276, 253, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 158, 234, 181,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 194,
+	285, 238, 171, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 0,
+	133, 132, 261, 278, 223, 0, 552, 0, 0, 0,
+	0, 0, 0, 0, 166, 553, 0, 0, 193, 0,
+	195, 0, 0, 254, 208, 0, 0, 0, 0, 0,
+	0, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 340, 0, 0, 341, 0, 0,
+	0, 148, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 138,
+	259, 274, 149, 250, 288, 153, 257, 144, 222, 246,
+	140, 272, 256, 205, 187, 188, 139, 0, 241, 164,
+	176, 161, 220, 0, 0, 160, 291, 0, 282, 142,
+	143, 281, 219, 269, 273, 206, 200, 141, 271, 204,
+	199, 191, 168, 183, 232, 198, 233, 184, 210, 209,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 284, 0, 0, 0, 235,
+	0, 0, 258, 178, 177, 192, 0, 0, 0, 0,
+	0, 244, 225, 0, 0, 230, 242, 196, 270, 236,
+	275, 260, 283, 0, 237, 134, 262, 163, 207, 146,
+	147, 159, 165, 167, 169, 170, 216, 217, 228, 249,
+	263, 264, 265, 162, 154, 243, 155, 180, 156, 135,
+	251, 157, 136, 229, 268, 145, 175, 239, 203, 137,
+	202, 231, 267, 266, 0, 0, 0, 0, 0, 0,
+	173, 0, 279, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
+	0, 0, 0, 0, 186, 227, 0, 248, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	255, 277, 290, 280, 0, 0, 0, 289, 0, 0,
+	0, 0, 554, 0, 212, 213, 214, 215, 0, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 182, 151, 226, 174, 287, 189, 218, 185,
+	252, 190, 197, 240, 286, 224, 245, 150, 276, 253,
+	201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 158, 234,
+	181, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	194, 285, 238, 171, 95, 96, 97, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 261, 208,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 151,
-	0, 0, 0, 178, 0, 180, 0, 0, 238, 193,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 612,
-	0, 0, 0, 0, 0, 134, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 243, 257, 135, 234, 271, 139, 241, 130,
-	207, 230, 126, 255, 240, 190, 172, 173, 125, 0,
-	225, 149, 162, 146, 205, 0, 0, 145, 274, 0,
-	265, 128, 129, 264, 204, 252, 256, 191, 185, 127,
-	254, 189, 184, 176, 153, 168, 217, 183, 218, 169,
-	195, 194, 196, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
-	0, 219, 0, 0, 242, 164, 163, 177, 0, 0,
-	0, 0, 0, 228, 210, 0, 0, 215, 226, 181,
-	253, 220, 258, 244, 266, 0, 221, 120, 245, 148,
-	192, 132, 133, 144, 150, 152, 154, 155, 201, 202,
-	213, 233, 246, 247, 248, 147, 140, 227, 141, 166,
-	142, 121, 235, 143, 122, 214, 251, 131, 161, 223,
-	188, 123, 187, 216, 250, 249, 0, 0, 0, 0,
-	0, 0, 158, 0, 262, 0, 206, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	231, 0, 0, 0, 0, 0, 171, 212, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 239, 260, 273, 263, 0, 0, 0, 272,
-	0, 0, 0, 0, 0, 0, 197, 198, 199, 200,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 157, 165, 0, 167, 137, 211, 160, 270,
-	174, 203, 170, 236, 175, 182, 224, 269, 209, 229,
-	136, 259, 237, 186, 159, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 179, 268, 222, 156, 83, 84, 85, 86,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 261, 208, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 151, 0, 0, 0, 178, 0, 180,
-	0, 0, 238, 193, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1471,
-	0, 0, 80, 0, 0, 0, 0, 0, 0, 134,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 243, 257, 135, 234,
-	271, 139, 241, 130, 207, 230, 126, 255, 240, 190,
-	172, 173, 125, 0, 225, 149, 162, 146, 205, 0,
-	0, 145, 274, 0, 265, 128, 129, 264, 204, 252,
-	256, 191, 185, 127, 254, 189, 184, 176, 153, 168,
-	217, 183, 218, 169, 195, 194, 196, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 267, 0, 0, 0, 219, 0, 0, 242, 164,
-	163, 177, 0, 0, 0, 0, 0, 228, 210, 0,
-	0, 215, 226, 181, 253, 220, 258, 244, 266, 0,
-	221, 120, 245, 148, 192, 132, 133, 144, 150, 152,
-	154, 155, 201, 202, 213, 233, 246, 247, 248, 147,
-	140, 227, 141, 166, 142, 121, 235, 143, 122, 214,
-	251, 131, 161, 223, 188, 123, 187, 216, 250, 249,
-	0, 0, 0, 0, 0, 0, 158, 0, 262, 0,
-	206, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 231, 0, 0, 0, 0, 0,
-	171, 212, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 239, 260, 273, 263,
-	0, 0, 0, 272, 0, 0, 0, 0, 0, 0,
-	197, 198, 199, 200, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 157, 165, 0, 167,
-	137, 211, 160, 270, 174, 203, 170, 236, 175, 182,
-	224, 269, 209, 229, 136, 259, 237, 186, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 179, 268, 222, 156,
-	83, 84, 85, 86, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	86, 133, 132, 261, 278, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 166, 0, 0, 0, 193, 0, 195, 0,
+	0, 254, 208, 0, 0, 0, 0, 0, 0, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
+	0, 938, 92, 0, 0, 0, 0, 0, 0, 148,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 259, 274,
+	149, 250, 288, 153, 257, 144, 222, 246, 140, 272,
+	256, 205, 187, 188, 139, 0, 241, 164, 176, 161,
+	220, 0, 0, 160, 291, 0, 282, 142, 143, 281,
+	219, 269, 273, 206, 200, 141, 271, 204, 199, 191,
+	168, 183, 232, 198, 233, 184, 210, 209, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 284, 0, 0, 0, 235, 0, 0,
+	258, 178, 177, 192, 0, 0, 0, 0, 0, 244,
+	225, 0, 0, 230, 242, 196, 270, 236, 275, 260,
+	283, 0, 237, 134, 262, 163, 207, 146, 147, 159,
+	165, 167, 169, 170, 216, 217, 228, 249, 263, 264,
+	265, 162, 154, 243, 155, 180, 156, 135, 251, 157,
+	136, 229, 268, 145, 175, 239, 203, 137, 202, 231,
+	267, 266, 0, 0, 0, 0, 0, 0, 173, 0,
+	279, 0, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 247, 0, 0, 0,
+	0, 0, 186, 227, 0, 248, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 255, 277,
+	290, 280, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 212, 213, 214, 215, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 0,
+	182, 151, 226, 174, 287, 189, 218, 185, 252, 190,
+	197, 240, 286, 224, 245, 150, 276, 253, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 158, 234, 181, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 194, 285,
+	238, 171, 95, 96, 97, 98, 99, 100, 101, 102,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 261, 208, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 151, 0, 0,
-	0, 178, 0, 180, 0, 0, 238, 193, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 80, 0, 0, 687,
-	0, 0, 0, 134, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	243, 257, 135, 234, 271, 139, 241, 130, 207, 230,
-	126, 255, 240, 190, 172, 173, 125, 0, 225, 149,
-	162, 146, 205, 0, 0, 145, 274, 0, 265, 128,
-	129, 264, 204, 252, 256, 191, 185, 127, 254, 189,
-	184, 176, 153, 168, 217, 183, 218, 169, 195, 194,
-	196, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 267, 0, 0, 0, 219,
-	0, 0, 242, 164, 163, 177, 0, 0, 0, 0,
-	0, 228, 210, 0, 0, 215, 226, 181, 253, 220,
-	258, 244, 266, 0, 221, 120, 245, 148, 192, 132,
-	133, 144, 150, 152, 154, 155, 201, 202, 213, 233,
-	246, 247, 248, 147, 140, 227, 141, 166, 142, 121,
-	235, 143, 122, 214, 251, 131, 161, 223, 188, 123,
-	187, 216, 250, 249, 0, 0, 0, 0, 0, 0,
-	158, 0, 262, 0, 206, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 231, 0,
-	0, 0, 0, 0, 171, 212, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	239, 260, 273, 263, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 0, 197, 198, 199, 200, 0, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	157, 165, 0, 167, 137, 211, 160, 270, 174, 203,
-	170, 236, 175, 182, 224, 269, 209, 229, 136, 259,
-	237, 186, 159, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	179, 268, 222, 156, 83, 84, 85, 86, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	261, 208, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 151, 0, 0, 0, 178, 0, 180, 0, 0,
-	238, 193, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 0, 0, 0, 0, 0, 0, 134, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 243, 257, 135, 234, 271, 139,
-	241, 130, 207, 230, 126, 255, 240, 190, 172, 173,
-	125, 0, 225, 149, 162, 146, 205, 0, 0, 145,
-	274, 0, 265, 128, 129, 264, 204, 252, 256, 191,
-	185, 127, 254, 189, 184, 176, 153, 168, 217, 183,
-	218, 169, 195, 194, 196, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
-	0, 0, 0, 219, 0, 0, 242, 164, 163, 177,
-	0, 0, 0, 0, 0, 228, 210, 0, 0, 215,
-	226, 181, 253, 220, 258, 244, 266, 0, 221, 120,
-	245, 148, 192, 132, 133, 144, 150, 152, 154, 155,
-	201, 202, 213, 233, 246, 247, 248, 147, 140, 227,
-	141, 166, 142, 121, 235, 143, 122, 214, 251, 131,
-	161, 223, 188, 123, 187, 216, 250, 249, 0, 0,
-	0, 0, 0, 0, 158, 0, 262, 0, 206, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 231, 0, 0, 0, 0, 0, 171, 212,
-	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 239, 260, 273, 263, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 0, 197, 198,
-	199, 200, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 157, 165, 0, 167, 137, 211,
-	160, 270, 174, 203, 170, 236, 175, 182, 224, 269,
-	209, 229, 136, 259, 237, 186, 159, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 179, 268, 222, 156, 83, 84,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 261, 208, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 151, 0, 0, 0, 178,
-	0, 180, 0, 0, 238, 193, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 292, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 134, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 243, 257,
-	135, 234, 271, 139, 241, 130, 207, 230, 126, 255,
-	240, 190, 172, 173, 125, 0, 225, 149, 162, 146,
-	205, 0, 0, 145, 274, 0, 265, 128, 129, 264,
-	204, 252, 256, 191, 185, 127, 254, 189, 184, 176,
-	153, 168, 217, 183, 218, 169, 195, 194, 196, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 267, 0, 0, 0, 219, 0, 0,
-	242, 164, 163, 177, 0, 0, 0, 0, 0, 228,
-	210, 0, 0, 215, 226, 181, 253, 220, 258, 244,
-	266, 0, 221, 120, 245, 148, 192, 132, 133, 144,
-	150, 152, 154, 155, 201, 202, 213, 233, 246, 247,
-	248, 147, 140, 227, 141, 166, 142, 121, 235, 143,
-	122, 214, 251, 131, 161, 223, 188, 123, 187, 216,
-	250, 249, 0, 0, 0, 0, 0, 0, 158, 0,
-	262, 0, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 231, 0, 0, 0,
-	0, 0, 171, 212, 0, 232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 239, 260,
-	273, 263, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 0, 197, 198, 199, 200, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 157, 165,
-	0, 167, 137, 211, 160, 270, 174, 203, 170, 236,
-	175, 182, 224, 269, 209, 229, 136, 259, 237, 186,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 179, 268,
-	222, 156, 83, 84, 85, 86, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 261, 208,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 151,
-	0, 0, 0, 178, 0, 180, 0, 0, 238, 193,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 0,
-	0, 0, 0, 0, 0, 134, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1081, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 243, 257, 135, 234, 271, 139, 241, 130,
-	207, 230, 126, 255, 240, 190, 172, 173, 125, 0,
-	225, 149, 162, 146, 205, 0, 0, 145, 274, 0,
-	265, 128, 129, 264, 204, 252, 256, 191, 185, 127,
-	254, 189, 184, 176, 153, 168, 217, 183, 218, 169,
-	195, 194, 196, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
-	0, 219, 0, 0, 242, 164, 163, 177, 0, 0,
-	0, 0, 0, 228, 210, 0, 0, 215, 226, 181,
-	253, 220, 258, 244, 266, 0, 221, 120, 245, 148,
-	192, 132, 133, 144, 150, 152, 154, 155, 201, 202,
-	213, 233, 246, 247, 248, 147, 140, 227, 141, 166,
-	142, 121, 235, 143, 122, 214, 251, 131, 161, 223,
-	188, 123, 187, 216, 250, 249, 0, 0, 0, 0,
-	0, 0, 158, 0, 262, 0, 206, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	231, 0, 0, 0, 0, 0, 171, 212, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 239, 260, 273, 263, 0, 0, 0, 272,
-	0, 0, 0, 0, 0, 0, 197, 198, 199, 200,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 157, 165, 0, 167, 137, 211, 160, 270,
-	174, 203, 170, 236, 175, 182, 224, 269, 209, 229,
-	136, 259, 237, 186, 159, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 179, 268, 222, 156, 83, 84, 85, 86,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 261, 208, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 151, 0, 0, 0, 178, 0, 180,
-	0, 0, 238, 193, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 323, 0, 0, 324, 0, 0, 0, 134,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 243, 257, 135, 234,
-	271, 139, 241, 130, 207, 230, 126, 255, 240, 190,
-	172, 173, 125, 0, 225, 149, 162, 146, 205, 0,
-	0, 145, 274, 0, 265, 128, 129, 264, 204, 252,
-	256, 191, 185, 127, 254, 189, 184, 176, 153, 168,
-	217, 183, 218, 169, 195, 194, 196, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 267, 0, 0, 0, 219, 0, 0, 242, 164,
-	163, 177, 0, 0, 0, 0, 0, 228, 210, 0,
-	0, 215, 226, 181, 253, 220, 258, 244, 266, 0,
-	221, 120, 245, 148, 192, 132, 133, 144, 150, 152,
-	154, 155, 201, 202, 213, 233, 246, 247, 248, 147,
-	140, 227, 141, 166, 142, 121, 235, 143, 122, 214,
-	251, 131, 161, 223, 188, 123, 187, 216, 250, 249,
-	0, 0, 0, 0, 0, 0, 158, 0, 262, 0,
-	206, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 231, 0, 0, 0, 0, 0,
-	171, 212, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 239, 260, 273, 263,
-	0, 0, 0, 272, 0, 0, 0, 0, 0, 0,
-	197, 198, 199, 200, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 157, 165, 0, 167,
-	137, 211, 160, 270, 174, 203, 170, 236, 175, 182,
-	224, 269, 209, 229, 136, 259, 237, 186, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 179, 268, 222, 156,
-	83, 84, 85, 86, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 261, 208, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 151, 0, 0,
-	0, 178, 0, 180, 0, 0, 238, 193, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 80, 0, 0, 687,
-	0, 0, 0, 134, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	243, 257, 135, 234, 271, 139, 241, 130, 207, 230,
-	126, 255, 240, 190, 172, 173, 125, 0, 225, 149,
-	162, 146, 205, 0, 0, 145, 274, 0, 265, 128,
-	129, 264, 204, 252, 256, 191, 185, 127, 254, 189,
-	184, 176, 153, 168, 217, 183, 218, 169, 195, 194,
-	196, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 267, 0, 0, 0, 219,
-	0, 0, 242, 164, 163, 177, 0, 0, 0, 0,
-	0, 228, 210, 0, 0, 215, 226, 181, 253, 220,
-	258, 244, 266, 0, 221, 120, 245, 148, 192, 132,
-	133, 144, 150, 152, 154, 155, 201, 202, 213, 233,
-	246, 247, 248, 147, 140, 227, 141, 166, 142, 121,
-	235, 143, 122, 214, 251, 131, 161, 223, 188, 123,
-	187, 216, 250, 249, 0, 0, 0, 0, 0, 0,
-	158, 0, 262, 0, 206, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 231, 0,
-	0, 0, 0, 0, 171, 212, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	239, 260, 273, 723, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 0, 197, 198, 199, 200, 0, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	157, 165, 0, 167, 137, 211, 160, 270, 174, 203,
-	170, 236, 175, 182, 224, 269, 209, 229, 136, 259,
-	237, 186, 159, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	179, 268, 222, 156, 83, 84, 85, 86, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	261, 208, 0, 0, 0, 0, 0, 0, 0, 0,
-	77, 151, 0, 0, 0, 178, 0, 180, 0, 0,
-	238, 193, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 0, 0, 0, 0, 0, 0, 134, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 243, 257, 135, 234, 271, 139,
-	241, 130, 207, 230, 126, 255, 240, 190, 172, 173,
-	125, 0, 225, 149, 162, 146, 205, 0, 0, 145,
-	274, 0, 265, 128, 129, 264, 204, 252, 256, 191,
-	185, 127, 254, 189, 184, 176, 153, 168, 217, 183,
-	218, 169, 195, 194, 196, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
-	0, 0, 0, 219, 0, 0, 242, 164, 163, 177,
-	0, 0, 0, 0, 0, 228, 210, 0, 0, 215,
-	226, 181, 253, 220, 258, 244, 266, 0, 221, 120,
-	245, 148, 192, 132, 133, 144, 150, 152, 154, 155,
-	201, 202, 213, 233, 246, 247, 248, 147, 140, 227,
-	141, 166, 142, 121, 235, 143, 122, 214, 251, 131,
-	161, 223, 188, 123, 187, 216, 250, 249, 0, 0,
-	0, 0, 0, 0, 158, 0, 262, 0, 206, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 231, 0, 0, 0, 0, 0, 171, 212,
-	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 239, 260, 273, 263, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 0, 197, 198,
-	199, 200, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 157, 165, 0, 167, 137, 211,
-	160, 270, 174, 203, 170, 236, 175, 182, 224, 269,
-	209, 229, 136, 259, 237, 186, 159, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 179, 268, 222, 156, 83, 84,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 261, 208, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 151, 0, 0, 0, 178,
-	0, 180, 0, 0, 238, 193, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 134, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 243, 257,
-	135, 234, 271, 139, 241, 130, 207, 230, 126, 255,
-	240, 190, 172, 173, 125, 0, 225, 149, 162, 146,
-	205, 0, 0, 145, 274, 0, 265, 128, 129, 264,
-	204, 252, 256, 191, 185, 127, 254, 189, 184, 176,
-	153, 168, 217, 183, 218, 169, 195, 194, 196, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 267, 0, 0, 0, 219, 0, 0,
-	242, 164, 163, 177, 0, 0, 0, 0, 0, 228,
-	210, 0, 0, 215, 226, 181, 253, 220, 258, 244,
-	266, 0, 221, 120, 245, 148, 192, 132, 133, 144,
-	150, 152, 154, 155, 201, 202, 213, 233, 246, 247,
-	248, 147, 140, 227, 141, 166, 142, 121, 235, 143,
-	122, 214, 251, 131, 161, 223, 188, 123, 187, 216,
-	250, 249, 0, 0, 0, 0, 0, 0, 158, 0,
-	262, 0, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 231, 0, 0, 0,
-	0, 0, 171, 212, 0, 232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 239, 260,
-	273, 263, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 0, 197, 198, 199, 200, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 157, 165,
-	0, 167, 137, 211, 160, 270, 174, 203, 170, 236,
-	175, 182, 224, 269, 209, 229, 136, 259, 237, 186,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 179, 268,
-	222, 156, 83, 84, 85, 86, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 261, 208,
-	0, 0, 0, 0, 430, 0, 0, 0, 0, 151,
-	0, 0, 0, 178, 0, 180, 0, 0, 238, 193,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 435, 436,
-	437, 432, 0, 0, 0, 134, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 243, 257, 135, 234, 271, 139, 241, 130,
-	207, 230, 126, 255, 240, 190, 172, 173, 125, 0,
-	225, 149, 162, 146, 205, 0, 0, 145, 274, 0,
-	265, 128, 129, 264, 204, 252, 256, 191, 185, 127,
-	254, 189, 184, 176, 153, 168, 217, 183, 218, 169,
-	195, 194, 196, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
-	0, 219, 0, 0, 242, 164, 163, 177, 0, 0,
-	0, 0, 0, 228, 210, 0, 0, 215, 226, 181,
-	253, 220, 258, 244, 266, 0, 221, 120, 245, 148,
-	192, 132, 133, 144, 150, 152, 154, 155, 201, 202,
-	213, 233, 246, 247, 248, 147, 140, 227, 141, 166,
-	142, 121, 235, 143, 122, 214, 251, 131, 161, 223,
-	188, 123, 187, 216, 250, 249, 0, 0, 0, 0,
-	0, 0, 158, 0, 262, 0, 206, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	231, 0, 0, 0, 0, 0, 171, 212, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 239, 260, 273, 263, 0, 0, 0, 272,
-	0, 0, 0, 0, 0, 0, 197, 198, 199, 200,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 157, 165, 0, 167, 137, 211, 160, 270,
-	174, 203, 170, 236, 175, 182, 224, 269, 209, 229,
-	136, 259, 237, 186, 159, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 208, 0, 0,
-	119, 0, 179, 268, 222, 156, 0, 151, 0, 0,
-	0, 178, 0, 180, 0, 0, 238, 193, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 435, 436, 437, 432,
-	0, 0, 261, 134, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	243, 257, 135, 234, 271, 139, 241, 130, 207, 230,
-	126, 255, 240, 190, 172, 173, 125, 0, 225, 149,
-	162, 146, 205, 0, 0, 145, 274, 0, 265, 128,
-	129, 264, 204, 252, 256, 191, 185, 127, 254, 189,
-	184, 176, 153, 168, 217, 183, 218, 169, 195, 194,
-	196, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 267, 0, 0, 0, 219,
-	0, 0, 242, 164, 163, 177, 0, 0, 0, 0,
-	0, 228, 210, 0, 0, 215, 226, 181, 253, 220,
-	258, 244, 266, 0, 221, 120, 245, 148, 192, 132,
-	133, 144, 150, 152, 154, 155, 201, 202, 213, 233,
-	246, 247, 248, 147, 140, 227, 141, 166, 142, 121,
-	235, 143, 122, 214, 251, 131, 161, 223, 188, 123,
-	187, 216, 250, 249, 0, 0, 0, 0, 0, 0,
-	158, 0, 262, 0, 206, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 231, 0,
-	0, 0, 0, 0, 171, 212, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	239, 260, 273, 263, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 0, 197, 198, 199, 200, 0, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	157, 165, 0, 167, 137, 211, 160, 270, 174, 203,
-	170, 236, 175, 182, 224, 269, 209, 229, 136, 259,
-	237, 186, 159, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 208, 0, 0, 119, 0,
-	179, 268, 222, 156, 0, 151, 0, 0, 0, 178,
-	0, 180, 0, 0, 238, 193, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 435, 436, 437, 0, 0, 0,
-	261, 134, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 243, 257,
-	135, 234, 271, 139, 241, 130, 207, 230, 126, 255,
-	240, 190, 172, 173, 125, 0, 225, 149, 162, 146,
-	205, 0, 0, 145, 274, 0, 265, 128, 129, 264,
-	204, 252, 256, 191, 185, 127, 254, 189, 184, 176,
-	153, 168, 217, 183, 218, 169, 195, 194, 196, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 267, 0, 0, 0, 219, 0, 0,
-	242, 164, 163, 177, 0, 0, 0, 0, 0, 228,
-	210, 0, 0, 215, 226, 181, 253, 220, 258, 244,
-	266, 0, 221, 120, 245, 148, 192, 132, 133, 144,
-	150, 152, 154, 155, 201, 202, 213, 233, 246, 247,
-	248, 147, 140, 227, 141, 166, 142, 121, 235, 143,
-	122, 214, 251, 131, 161, 223, 188, 123, 187, 216,
-	250, 249, 0, 0, 0, 0, 0, 1497, 158, 0,
-	262, 0, 206, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 231, 0, 0, 0,
-	0, 1040, 171, 212, 0, 232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 239, 260,
-	273, 263, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 0, 197, 198, 199, 200, 1479, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 157, 165,
-	0, 167, 137, 211, 160, 270, 174, 203, 170, 236,
-	175, 182, 224, 269, 209, 229, 136, 259, 237, 186,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 179, 268,
-	222, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1483, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1487, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1476, 0,
-	0, 0, 1478, 1480, 1482, 0, 1484, 1485, 1486, 1488,
-	1489, 1490, 1492, 1493, 1494, 1495, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1496, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1475, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1491, 0, 0, 0,
-	0, 0, 1481,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 0, 133,
+	132, 261, 278, 223, 0, 818, 0, 0, 0, 0,
+	0, 0, 0, 166, 0, 0, 0, 193, 0, 195,
+	0, 0, 254, 208, 0, 0, 0, 0, 0, 0,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 340, 0, 0, 341, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 259,
+	274, 149, 250, 288, 153, 257, 144, 222, 246, 140,
+	272, 256, 205, 187, 188, 139, 0, 241, 164, 176,
+	161, 220, 0, 0, 160, 291, 0, 282, 142, 143,
+	281, 219, 269, 273, 206, 200, 141, 271, 204, 199,
+	191, 168, 183, 232, 198, 233, 184, 210, 209, 211,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 284, 0, 0, 0, 235, 0,
+	0, 258, 178, 177, 192, 0, 0, 0, 0, 0,
+	244, 225, 0, 0, 230, 242, 196, 270, 236, 275,
+	260, 283, 0, 237, 134, 262, 163, 207, 146, 147,
+	159, 165, 167, 169, 170, 216, 217, 228, 249, 263,
+	264, 265, 162, 154, 243, 155, 180, 156, 135, 251,
+	157, 136, 229, 268, 145, 175, 239, 203, 137, 202,
+	231, 267, 266, 0, 0, 0, 0, 0, 0, 173,
+	0, 279, 0, 221, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 186, 227, 0, 248, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	277, 290, 280, 0, 0, 0, 289, 0, 0, 0,
+	0, 817, 0, 212, 213, 214, 215, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 182, 151, 226, 174, 287, 189, 218, 185, 252,
+	190, 197, 240, 286, 224, 245, 150, 276, 253, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 158, 234, 181,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 194,
+	285, 238, 171, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 223,
+	133, 132, 261, 278, 0, 0, 0, 0, 0, 166,
+	0, 0, 0, 193, 0, 195, 0, 0, 254, 208,
+	0, 0, 0, 0, 0, 0, 179, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2024, 92,
+	676, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 138, 259, 274, 149, 250, 288,
+	153, 257, 144, 222, 246, 140, 272, 256, 205, 187,
+	188, 139, 0, 241, 164, 176, 161, 220, 0, 0,
+	160, 291, 0, 282, 142, 143, 281, 219, 269, 273,
+	206, 200, 141, 271, 204, 199, 191, 168, 183, 232,
+	198, 233, 184, 210, 209, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	284, 0, 0, 0, 235, 0, 0, 258, 178, 177,
+	192, 0, 0, 0, 0, 0, 244, 225, 0, 0,
+	230, 242, 196, 270, 236, 275, 260, 283, 0, 237,
+	134, 262, 163, 207, 146, 147, 159, 165, 167, 169,
+	170, 216, 217, 228, 249, 263, 264, 265, 162, 154,
+	243, 155, 180, 156, 135, 251, 157, 136, 229, 268,
+	145, 175, 239, 203, 137, 202, 231, 267, 266, 0,
+	0, 0, 0, 0, 0, 173, 0, 279, 0, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 186,
+	227, 0, 248, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 277, 290, 280, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 212,
+	213, 214, 215, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 182, 151, 226,
+	174, 287, 189, 218, 185, 252, 190, 197, 240, 286,
+	224, 245, 150, 276, 253, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 158, 234, 181, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 194, 285, 238, 171, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 223, 133, 132, 261, 278,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 193,
+	0, 195, 0, 0, 254, 208, 0, 0, 0, 0,
+	0, 0, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 0, 0, 753, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	138, 259, 274, 149, 250, 288, 153, 257, 144, 222,
+	246, 140, 272, 256, 205, 187, 188, 139, 0, 241,
+	164, 176, 161, 220, 0, 0, 160, 291, 0, 282,
+	142, 143, 281, 219, 269, 273, 206, 200, 141, 271,
+	204, 199, 191, 168, 183, 232, 198, 233, 184, 210,
+	209, 211, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 284, 0, 0, 0,
+	235, 0, 0, 258, 178, 177, 192, 0, 0, 0,
+	0, 0, 244, 225, 0, 0, 230, 242, 196, 270,
+	236, 275, 260, 283, 0, 237, 134, 262, 163, 207,
+	146, 147, 159, 165, 167, 169, 170, 216, 217, 228,
+	249, 263, 264, 265, 162, 154, 243, 155, 180, 156,
+	135, 251, 157, 136, 229, 268, 145, 175, 239, 203,
+	137, 202, 231, 267, 266, 0, 0, 0, 0, 0,
+	0, 173, 0, 279, 0, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 186, 227, 0, 248, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 277, 290, 280, 0, 0, 0, 289, 0,
+	0, 0, 0, 0, 1489, 212, 213, 214, 215, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 182, 151, 226, 174, 287, 189, 218,
+	185, 252, 190, 197, 240, 286, 224, 245, 150, 276,
+	253, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
+	234, 181, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 194, 285, 238, 171, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 223, 133, 132, 261, 278, 0, 0, 0, 0,
+	0, 166, 1186, 0, 0, 193, 0, 195, 0, 0,
+	254, 208, 0, 0, 0, 0, 0, 0, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 92, 0, 0, 753, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 259, 274, 149,
+	250, 288, 153, 257, 144, 222, 246, 140, 272, 256,
+	205, 187, 188, 139, 0, 241, 164, 176, 161, 220,
+	0, 0, 160, 291, 0, 282, 142, 143, 281, 219,
+	269, 273, 206, 200, 141, 271, 204, 199, 191, 168,
+	183, 232, 198, 233, 184, 210, 209, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 284, 0, 0, 0, 235, 0, 0, 258,
+	178, 177, 192, 0, 0, 0, 0, 0, 244, 225,
+	0, 0, 230, 242, 196, 270, 236, 275, 260, 283,
+	0, 237, 134, 262, 163, 207, 146, 147, 159, 165,
+	167, 169, 170, 216, 217, 228, 249, 263, 264, 265,
+	162, 154, 243, 155, 180, 156, 135, 251, 157, 136,
+	229, 268, 145, 175, 239, 203, 137, 202, 231, 267,
+	266, 0, 0, 0, 0, 0, 0, 173, 0, 279,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 247, 0, 0, 0, 0,
+	0, 186, 227, 0, 248, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 277, 290,
+	280, 0, 0, 0, 289, 0, 0, 0, 0, 0,
+	0, 212, 213, 214, 215, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 182,
+	151, 226, 174, 287, 189, 218, 185, 252, 190, 197,
+	240, 286, 224, 245, 150, 276, 253, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 158, 234, 181, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 194, 285, 238,
+	171, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 129, 130, 223, 133, 132,
+	261, 278, 0, 0, 0, 0, 0, 166, 0, 0,
+	0, 193, 0, 195, 0, 0, 254, 208, 0, 0,
+	0, 0, 0, 0, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 92, 676, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 259, 274, 149, 250, 288, 153, 257,
+	144, 222, 246, 140, 272, 256, 205, 187, 188, 139,
+	0, 241, 164, 176, 161, 220, 0, 0, 160, 291,
+	0, 282, 142, 143, 281, 219, 269, 273, 206, 200,
+	141, 271, 204, 199, 191, 168, 183, 232, 198, 233,
+	184, 210, 209, 211, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
+	0, 0, 235, 0, 0, 258, 178, 177, 192, 0,
+	0, 0, 0, 0, 244, 225, 0, 0, 230, 242,
+	196, 270, 236, 275, 260, 283, 0, 237, 134, 262,
+	163, 207, 146, 147, 159, 165, 167, 169, 170, 216,
+	217, 228, 249, 263, 264, 265, 162, 154, 243, 155,
+	180, 156, 135, 251, 157, 136, 229, 268, 145, 175,
+	239, 203, 137, 202, 231, 267, 266, 0, 0, 0,
+	0, 0, 0, 173, 0, 279, 0, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 186, 227, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 277, 290, 280, 0, 0, 0,
+	289, 0, 0, 0, 0, 0, 0, 212, 213, 214,
+	215, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 182, 151, 226, 174, 287,
+	189, 218, 185, 252, 190, 197, 240, 286, 224, 245,
+	150, 276, 253, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 158, 234, 181, 0, 0, 0, 0, 0, 0,
+	0, 131, 0, 194, 285, 238, 171, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 223, 133, 132, 261, 278, 0, 0,
+	0, 0, 0, 166, 0, 0, 0, 193, 0, 195,
+	0, 0, 254, 208, 0, 0, 0, 0, 0, 0,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1737, 0, 0, 92, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 259,
+	274, 149, 250, 288, 153, 257, 144, 222, 246, 140,
+	272, 256, 205, 187, 188, 139, 0, 241, 164, 176,
+	161, 220, 0, 0, 160, 291, 0, 282, 142, 143,
+	281, 219, 269, 273, 206, 200, 141, 271, 204, 199,
+	191, 168, 183, 232, 198, 233, 184, 210, 209, 211,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 284, 0, 0, 0, 235, 0,
+	0, 258, 178, 177, 192, 0, 0, 0, 0, 0,
+	244, 225, 0, 0, 230, 242, 196, 270, 236, 275,
+	260, 283, 0, 237, 134, 262, 163, 207, 146, 147,
+	159, 165, 167, 169, 170, 216, 217, 228, 249, 263,
+	264, 265, 162, 154, 243, 155, 180, 156, 135, 251,
+	157, 136, 229, 268, 145, 175, 239, 203, 137, 202,
+	231, 267, 266, 0, 0, 0, 0, 0, 0, 173,
+	0, 279, 0, 221, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 186, 227, 0, 248, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	277, 290, 280, 0, 0, 0, 289, 0, 0, 0,
+	0, 0, 0, 212, 213, 214, 215, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 182, 151, 226, 174, 287, 189, 218, 185, 252,
+	190, 197, 240, 286, 224, 245, 150, 276, 253, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 158, 234, 181,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 194,
+	285, 238, 171, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 223,
+	133, 132, 261, 278, 0, 0, 0, 0, 0, 166,
+	0, 0, 0, 193, 0, 195, 0, 0, 254, 208,
+	0, 0, 0, 0, 0, 0, 179, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 0, 753, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 138, 259, 274, 149, 250, 288,
+	153, 257, 144, 222, 246, 140, 272, 256, 205, 187,
+	188, 139, 0, 241, 164, 176, 161, 220, 0, 0,
+	160, 291, 0, 282, 142, 143, 281, 219, 269, 273,
+	206, 200, 141, 271, 204, 199, 191, 168, 183, 232,
+	198, 233, 184, 210, 209, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	284, 0, 0, 0, 235, 0, 0, 258, 178, 177,
+	192, 0, 0, 0, 0, 0, 244, 225, 0, 0,
+	230, 242, 196, 270, 236, 275, 260, 283, 0, 237,
+	134, 262, 163, 207, 146, 147, 159, 165, 167, 169,
+	170, 216, 217, 228, 249, 263, 264, 265, 162, 154,
+	243, 155, 180, 156, 135, 251, 157, 136, 229, 268,
+	145, 175, 239, 203, 137, 202, 231, 267, 266, 0,
+	0, 0, 0, 0, 0, 173, 0, 279, 0, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 186,
+	227, 0, 248, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 277, 290, 280, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 212,
+	213, 214, 215, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 182, 151, 226,
+	174, 287, 189, 218, 185, 252, 190, 197, 240, 286,
+	224, 245, 150, 276, 253, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 158, 234, 181, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 194, 285, 238, 171, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 223, 133, 132, 261, 278,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 193,
+	0, 195, 0, 0, 254, 208, 0, 0, 0, 0,
+	0, 0, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1579, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	138, 259, 274, 149, 250, 288, 153, 257, 144, 222,
+	246, 140, 272, 256, 205, 187, 188, 139, 0, 241,
+	164, 176, 161, 220, 0, 0, 160, 291, 0, 282,
+	142, 143, 281, 219, 269, 273, 206, 200, 141, 271,
+	204, 199, 191, 168, 183, 232, 198, 233, 184, 210,
+	209, 211, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 284, 0, 0, 0,
+	235, 0, 0, 258, 178, 177, 192, 0, 0, 0,
+	0, 0, 244, 225, 0, 0, 230, 242, 196, 270,
+	236, 275, 260, 283, 0, 237, 134, 262, 163, 207,
+	146, 147, 159, 165, 167, 169, 170, 216, 217, 228,
+	249, 263, 264, 265, 162, 154, 243, 155, 180, 156,
+	135, 251, 157, 136, 229, 268, 145, 175, 239, 203,
+	137, 202, 231, 267, 266, 0, 0, 0, 0, 0,
+	0, 173, 0, 279, 0, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 186, 227, 0, 248, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 277, 290, 280, 0, 0, 0, 289, 0,
+	0, 0, 0, 0, 0, 212, 213, 214, 215, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 182, 151, 226, 174, 287, 189, 218,
+	185, 252, 190, 197, 240, 286, 224, 245, 150, 276,
+	253, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
+	234, 181, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 194, 285, 238, 171, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 223, 133, 132, 261, 278, 0, 0, 0, 0,
+	0, 166, 0, 0, 0, 193, 0, 195, 0, 0,
+	254, 208, 0, 0, 0, 0, 0, 0, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 309, 0,
+	0, 92, 0, 0, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 259, 274, 149,
+	250, 288, 153, 257, 144, 222, 246, 140, 272, 256,
+	205, 187, 188, 139, 0, 241, 164, 176, 161, 220,
+	0, 0, 160, 291, 0, 282, 142, 143, 281, 219,
+	269, 273, 206, 200, 141, 271, 204, 199, 191, 168,
+	183, 232, 198, 233, 184, 210, 209, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 284, 0, 0, 0, 235, 0, 0, 258,
+	178, 177, 192, 0, 0, 0, 0, 0, 244, 225,
+	0, 0, 230, 242, 196, 270, 236, 275, 260, 283,
+	0, 237, 134, 262, 163, 207, 146, 147, 159, 165,
+	167, 169, 170, 216, 217, 228, 249, 263, 264, 265,
+	162, 154, 243, 155, 180, 156, 135, 251, 157, 136,
+	229, 268, 145, 175, 239, 203, 137, 202, 231, 267,
+	266, 0, 0, 0, 0, 0, 0, 173, 0, 279,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 247, 0, 0, 0, 0,
+	0, 186, 227, 0, 248, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 277, 290,
+	280, 0, 0, 0, 289, 0, 0, 0, 0, 0,
+	0, 212, 213, 214, 215, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 182,
+	151, 226, 174, 287, 189, 218, 185, 252, 190, 197,
+	240, 286, 224, 245, 150, 276, 253, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 158, 234, 181, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 194, 285, 238,
+	171, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 129, 130, 223, 133, 132,
+	261, 278, 0, 0, 0, 0, 0, 166, 0, 0,
+	0, 193, 0, 195, 0, 0, 254, 208, 0, 0,
+	0, 0, 0, 0, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 259, 274, 149, 250, 288, 153, 257,
+	144, 222, 246, 140, 272, 256, 205, 187, 188, 139,
+	0, 241, 164, 176, 161, 220, 0, 0, 160, 291,
+	0, 282, 142, 143, 281, 219, 269, 273, 206, 200,
+	141, 271, 204, 199, 191, 168, 183, 232, 198, 233,
+	184, 210, 209, 211, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
+	0, 0, 235, 1169, 0, 258, 178, 177, 192, 0,
+	0, 0, 0, 0, 244, 225, 0, 0, 230, 242,
+	196, 270, 236, 275, 260, 283, 0, 237, 134, 262,
+	163, 207, 146, 147, 159, 165, 167, 169, 170, 216,
+	217, 228, 249, 263, 264, 265, 162, 154, 243, 155,
+	180, 156, 135, 251, 157, 136, 229, 268, 145, 175,
+	239, 203, 137, 202, 231, 267, 266, 0, 0, 0,
+	0, 0, 0, 173, 0, 279, 0, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 186, 227, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 277, 290, 280, 0, 0, 0,
+	289, 0, 0, 0, 0, 0, 0, 212, 213, 214,
+	215, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 182, 151, 226, 174, 287,
+	189, 218, 185, 252, 190, 197, 240, 286, 224, 245,
+	150, 276, 253, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 158, 234, 181, 0, 0, 0, 0, 0, 0,
+	0, 131, 0, 194, 285, 238, 171, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 223, 133, 132, 261, 278, 0, 0,
+	0, 0, 0, 166, 0, 0, 0, 193, 0, 195,
+	0, 0, 254, 208, 0, 0, 0, 0, 0, 0,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 259,
+	274, 149, 250, 288, 153, 257, 144, 222, 246, 140,
+	272, 256, 205, 187, 188, 139, 0, 241, 164, 176,
+	161, 220, 0, 0, 160, 291, 0, 282, 142, 143,
+	281, 219, 269, 273, 206, 200, 141, 271, 204, 199,
+	191, 168, 183, 232, 198, 233, 184, 210, 209, 211,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 284, 0, 0, 0, 235, 1165,
+	0, 258, 178, 177, 192, 0, 0, 0, 0, 0,
+	244, 225, 0, 0, 230, 242, 196, 270, 236, 275,
+	260, 283, 0, 237, 134, 262, 163, 207, 146, 147,
+	159, 165, 167, 169, 170, 216, 217, 228, 249, 263,
+	264, 265, 162, 154, 243, 155, 180, 156, 135, 251,
+	157, 136, 229, 268, 145, 175, 239, 203, 137, 202,
+	231, 267, 266, 0, 0, 0, 0, 0, 0, 173,
+	0, 279, 0, 221, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 186, 227, 0, 248, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	277, 290, 280, 0, 0, 0, 289, 0, 0, 0,
+	0, 0, 0, 212, 213, 214, 215, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 182, 151, 226, 174, 287, 189, 218, 185, 252,
+	190, 197, 240, 286, 224, 245, 150, 276, 253, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 158, 234, 181,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 194,
+	285, 238, 171, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 223,
+	133, 132, 261, 278, 0, 0, 0, 0, 0, 166,
+	0, 0, 0, 193, 0, 195, 0, 0, 254, 208,
+	0, 0, 0, 0, 0, 0, 179, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1197, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 138, 259, 274, 149, 250, 288,
+	153, 257, 144, 222, 246, 140, 272, 256, 205, 187,
+	188, 139, 0, 241, 164, 176, 161, 220, 0, 0,
+	160, 291, 0, 282, 142, 143, 281, 219, 269, 273,
+	206, 200, 141, 271, 204, 199, 191, 168, 183, 232,
+	198, 233, 184, 210, 209, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	284, 0, 0, 0, 235, 0, 0, 258, 178, 177,
+	192, 0, 0, 0, 0, 0, 244, 225, 0, 0,
+	230, 242, 196, 270, 236, 275, 260, 283, 0, 237,
+	134, 262, 163, 207, 146, 147, 159, 165, 167, 169,
+	170, 216, 217, 228, 249, 263, 264, 265, 162, 154,
+	243, 155, 180, 156, 135, 251, 157, 136, 229, 268,
+	145, 175, 239, 203, 137, 202, 231, 267, 266, 0,
+	0, 0, 0, 0, 0, 173, 0, 279, 0, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 186,
+	227, 0, 248, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 277, 290, 280, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 212,
+	213, 214, 215, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 182, 151, 226,
+	174, 287, 189, 218, 185, 252, 190, 197, 240, 286,
+	224, 245, 150, 276, 253, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 158, 234, 181, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 194, 285, 238, 171, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 223, 133, 132, 261, 278,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 193,
+	0, 195, 0, 0, 254, 208, 0, 0, 0, 0,
+	0, 0, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 340, 0, 0, 341, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	138, 259, 274, 149, 250, 288, 153, 257, 144, 222,
+	246, 140, 272, 256, 205, 187, 188, 139, 0, 241,
+	164, 176, 161, 220, 0, 0, 160, 291, 0, 282,
+	142, 143, 281, 219, 269, 273, 206, 200, 141, 271,
+	204, 199, 191, 168, 183, 232, 198, 233, 184, 210,
+	209, 211, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 284, 0, 0, 0,
+	235, 0, 0, 258, 178, 177, 192, 0, 0, 0,
+	0, 0, 244, 225, 0, 0, 230, 242, 196, 270,
+	236, 275, 260, 283, 0, 237, 134, 262, 163, 207,
+	146, 147, 159, 165, 167, 169, 170, 216, 217, 228,
+	249, 263, 264, 265, 162, 154, 243, 155, 180, 156,
+	135, 251, 157, 136, 229, 268, 145, 175, 239, 203,
+	137, 202, 231, 267, 266, 0, 0, 0, 0, 0,
+	0, 173, 0, 279, 0, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 186, 227, 0, 248, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 277, 290, 280, 0, 0, 0, 289, 0,
+	0, 0, 0, 0, 0, 212, 213, 214, 215, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 182, 151, 226, 174, 287, 189, 218,
+	185, 252, 190, 197, 240, 286, 224, 245, 150, 276,
+	253, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
+	234, 181, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 194, 285, 238, 171, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 223, 133, 132, 261, 278, 0, 0, 0, 0,
+	0, 166, 0, 0, 0, 193, 0, 195, 0, 0,
+	254, 208, 0, 0, 0, 0, 0, 0, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 92, 0, 0, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 259, 274, 149,
+	250, 288, 153, 257, 144, 222, 246, 140, 272, 256,
+	205, 187, 188, 139, 0, 241, 164, 176, 161, 220,
+	0, 0, 160, 291, 0, 282, 142, 143, 281, 219,
+	269, 273, 206, 200, 141, 271, 204, 199, 191, 168,
+	183, 232, 198, 233, 184, 210, 209, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 284, 0, 0, 0, 235, 1169, 0, 258,
+	178, 177, 192, 0, 0, 0, 0, 0, 244, 225,
+	0, 0, 1170, 242, 196, 270, 236, 275, 260, 283,
+	0, 237, 134, 262, 163, 207, 146, 147, 159, 165,
+	167, 169, 170, 216, 217, 228, 249, 263, 264, 265,
+	162, 154, 243, 155, 180, 156, 135, 251, 157, 136,
+	229, 268, 145, 175, 239, 203, 137, 202, 231, 267,
+	266, 0, 0, 0, 0, 0, 0, 173, 0, 279,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 247, 0, 0, 0, 0,
+	0, 186, 227, 0, 248, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 277, 290,
+	280, 0, 0, 0, 289, 0, 0, 0, 0, 0,
+	0, 212, 213, 214, 215, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 182,
+	151, 226, 174, 287, 189, 218, 185, 252, 190, 197,
+	240, 286, 224, 245, 150, 276, 253, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 158, 234, 181, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 194, 285, 238,
+	171, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 129, 130, 223, 133, 132,
+	261, 278, 0, 0, 0, 0, 0, 166, 0, 0,
+	0, 193, 0, 195, 0, 0, 254, 208, 0, 0,
+	0, 0, 0, 0, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 259, 274, 149, 250, 288, 153, 257,
+	144, 222, 246, 140, 272, 256, 205, 187, 188, 139,
+	0, 241, 164, 176, 161, 220, 0, 0, 160, 291,
+	0, 282, 142, 143, 281, 219, 269, 273, 206, 200,
+	141, 271, 204, 199, 191, 168, 183, 232, 198, 233,
+	184, 210, 209, 211, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
+	0, 0, 235, 1165, 0, 258, 178, 177, 192, 0,
+	0, 0, 0, 0, 244, 225, 0, 0, 1166, 242,
+	196, 270, 236, 275, 260, 283, 0, 237, 134, 262,
+	163, 207, 146, 147, 159, 165, 167, 169, 170, 216,
+	217, 228, 249, 263, 264, 265, 162, 154, 243, 155,
+	180, 156, 135, 251, 157, 136, 229, 268, 145, 175,
+	239, 203, 137, 202, 231, 267, 266, 0, 0, 0,
+	0, 0, 0, 173, 0, 279, 0, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 186, 227, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 277, 290, 280, 0, 0, 0,
+	289, 0, 0, 0, 0, 0, 0, 212, 213, 214,
+	215, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 182, 151, 226, 174, 287,
+	189, 218, 185, 252, 190, 197, 240, 286, 224, 245,
+	150, 276, 253, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 158, 234, 181, 0, 0, 0, 0, 0, 0,
+	0, 131, 0, 194, 285, 238, 171, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 129, 130, 223, 133, 132, 261, 278, 0, 0,
+	0, 0, 0, 166, 0, 0, 0, 193, 0, 195,
+	0, 0, 254, 208, 0, 0, 0, 0, 0, 0,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 92, 0, 0, 753, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 259,
+	274, 149, 250, 288, 153, 257, 144, 222, 246, 140,
+	272, 256, 205, 187, 188, 139, 0, 241, 164, 176,
+	161, 220, 0, 0, 160, 291, 0, 282, 142, 143,
+	281, 219, 269, 273, 206, 200, 141, 271, 204, 199,
+	191, 168, 183, 232, 198, 233, 184, 210, 209, 211,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 284, 0, 0, 0, 235, 0,
+	0, 258, 178, 177, 192, 0, 0, 0, 0, 0,
+	244, 225, 0, 0, 230, 242, 196, 270, 236, 275,
+	260, 283, 0, 237, 134, 262, 163, 207, 146, 147,
+	159, 165, 167, 169, 170, 216, 217, 228, 249, 263,
+	264, 265, 162, 154, 243, 155, 180, 156, 135, 251,
+	157, 136, 229, 268, 145, 175, 239, 203, 137, 202,
+	231, 267, 266, 0, 0, 0, 0, 0, 0, 173,
+	0, 279, 0, 221, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 186, 227, 0, 248, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	277, 290, 809, 0, 0, 0, 289, 0, 0, 0,
+	0, 0, 0, 212, 213, 214, 215, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 182, 151, 226, 174, 287, 189, 218, 185, 252,
+	190, 197, 240, 286, 224, 245, 150, 276, 253, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 158, 234, 181,
+	0, 0, 0, 0, 0, 0, 0, 131, 0, 194,
+	285, 238, 171, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 129, 130, 223,
+	133, 132, 261, 278, 0, 0, 0, 0, 89, 166,
+	0, 0, 0, 193, 0, 195, 0, 0, 254, 208,
+	0, 0, 0, 0, 0, 0, 179, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 138, 259, 274, 149, 250, 288,
+	153, 257, 144, 222, 246, 140, 272, 256, 205, 187,
+	188, 139, 0, 241, 164, 176, 161, 220, 0, 0,
+	160, 291, 0, 282, 142, 143, 281, 219, 269, 273,
+	206, 200, 141, 271, 204, 199, 191, 168, 183, 232,
+	198, 233, 184, 210, 209, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	284, 0, 0, 0, 235, 0, 0, 258, 178, 177,
+	192, 0, 0, 0, 0, 0, 244, 225, 0, 0,
+	230, 242, 196, 270, 236, 275, 260, 283, 0, 237,
+	134, 262, 163, 207, 146, 147, 159, 165, 167, 169,
+	170, 216, 217, 228, 249, 263, 264, 265, 162, 154,
+	243, 155, 180, 156, 135, 251, 157, 136, 229, 268,
+	145, 175, 239, 203, 137, 202, 231, 267, 266, 0,
+	0, 0, 0, 0, 0, 173, 0, 279, 0, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 186,
+	227, 0, 248, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 277, 290, 280, 0,
+	0, 0, 289, 0, 0, 0, 0, 0, 0, 212,
+	213, 214, 215, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 182, 151, 226,
+	174, 287, 189, 218, 185, 252, 190, 197, 240, 286,
+	224, 245, 150, 276, 253, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 158, 234, 181, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 194, 285, 238, 171, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 129, 130, 223, 133, 132, 261, 278,
+	0, 0, 0, 0, 0, 166, 0, 0, 0, 193,
+	0, 195, 0, 0, 254, 208, 0, 0, 0, 0,
+	0, 0, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	138, 259, 274, 149, 250, 288, 153, 257, 144, 222,
+	246, 140, 272, 256, 205, 187, 188, 139, 0, 241,
+	164, 176, 161, 220, 0, 0, 160, 291, 0, 282,
+	142, 143, 281, 219, 269, 273, 206, 200, 141, 271,
+	204, 199, 191, 168, 183, 232, 198, 233, 184, 210,
+	209, 211, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 284, 0, 0, 0,
+	235, 0, 0, 258, 178, 177, 192, 0, 0, 0,
+	0, 0, 244, 225, 0, 0, 230, 242, 196, 270,
+	236, 275, 260, 283, 0, 237, 134, 262, 163, 207,
+	146, 147, 159, 165, 167, 169, 170, 216, 217, 228,
+	249, 263, 264, 265, 162, 154, 243, 155, 180, 156,
+	135, 251, 157, 136, 229, 268, 145, 175, 239, 203,
+	137, 202, 231, 267, 266, 0, 0, 0, 0, 0,
+	0, 173, 0, 279, 0, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 186, 227, 0, 248, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 277, 290, 280, 0, 0, 0, 289, 0,
+	0, 0, 0, 0, 0, 212, 213, 214, 215, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 182, 151, 226, 174, 287, 189, 218,
+	185, 252, 190, 197, 240, 286, 224, 245, 150, 276,
+	253, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
+	234, 181, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 194, 285, 238, 171, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 223, 133, 132, 261, 278, 0, 0, 0, 0,
+	0, 166, 0, 0, 0, 193, 0, 195, 0, 0,
+	254, 208, 0, 0, 0, 0, 0, 0, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 465, 466, 467, 462, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 259, 274, 149,
+	250, 288, 153, 257, 144, 222, 246, 140, 272, 256,
+	205, 187, 188, 139, 0, 241, 164, 176, 161, 220,
+	0, 0, 160, 291, 0, 282, 142, 143, 281, 219,
+	269, 273, 206, 200, 141, 271, 204, 199, 191, 168,
+	183, 232, 198, 233, 184, 210, 209, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 284, 0, 0, 0, 235, 0, 0, 258,
+	178, 177, 192, 0, 0, 0, 0, 0, 244, 225,
+	0, 0, 230, 242, 196, 270, 236, 275, 260, 283,
+	0, 237, 134, 262, 163, 207, 146, 147, 159, 165,
+	167, 169, 170, 216, 217, 228, 249, 263, 264, 265,
+	162, 154, 243, 155, 180, 156, 135, 251, 157, 136,
+	229, 268, 145, 175, 239, 203, 137, 202, 231, 267,
+	266, 0, 0, 0, 0, 0, 0, 173, 0, 279,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 247, 0, 0, 0, 0,
+	0, 186, 227, 0, 248, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 277, 290,
+	280, 0, 0, 0, 289, 0, 0, 0, 0, 0,
+	0, 212, 213, 214, 215, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 182,
+	151, 226, 174, 287, 189, 218, 185, 252, 190, 197,
+	240, 286, 224, 245, 150, 276, 253, 201, 0, 0,
+	0, 0, 0, 0, 223, 0, 0, 0, 0, 460,
+	0, 0, 0, 0, 166, 158, 234, 181, 193, 0,
+	195, 0, 0, 254, 208, 131, 0, 194, 285, 238,
+	171, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 465, 466, 467, 462, 0, 0,
+	0, 148, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 133, 132,
+	261, 278, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 138,
+	259, 274, 149, 250, 288, 153, 257, 144, 222, 246,
+	140, 272, 256, 205, 187, 188, 139, 0, 241, 164,
+	176, 161, 220, 0, 0, 160, 291, 0, 282, 142,
+	143, 281, 219, 269, 273, 206, 200, 141, 271, 204,
+	199, 191, 168, 183, 232, 198, 233, 184, 210, 209,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 284, 0, 0, 0, 235,
+	0, 0, 258, 178, 177, 192, 0, 0, 0, 0,
+	0, 244, 225, 0, 0, 230, 242, 196, 270, 236,
+	275, 260, 283, 0, 237, 134, 262, 163, 207, 146,
+	147, 159, 165, 167, 169, 170, 216, 217, 228, 249,
+	263, 264, 265, 162, 154, 243, 155, 180, 156, 135,
+	251, 157, 136, 229, 268, 145, 175, 239, 203, 137,
+	202, 231, 267, 266, 0, 0, 0, 0, 0, 0,
+	173, 0, 279, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
+	0, 0, 0, 0, 186, 227, 0, 248, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	255, 277, 290, 280, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 212, 213, 214, 215, 0, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 182, 151, 226, 174, 287, 189, 218, 185,
+	252, 190, 197, 240, 286, 224, 245, 150, 276, 253,
+	201, 0, 0, 0, 0, 0, 0, 223, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 158, 234,
+	181, 193, 0, 195, 0, 0, 254, 208, 131, 0,
+	194, 285, 238, 171, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 465, 466, 467,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 133, 132, 261, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 259, 274, 149, 250, 288, 153, 257,
+	144, 222, 246, 140, 272, 256, 205, 187, 188, 139,
+	0, 241, 164, 176, 161, 220, 0, 0, 160, 291,
+	0, 282, 142, 143, 281, 219, 269, 273, 206, 200,
+	141, 271, 204, 199, 191, 168, 183, 232, 198, 233,
+	184, 210, 209, 211, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
+	0, 0, 235, 0, 0, 258, 178, 177, 192, 0,
+	0, 0, 0, 0, 244, 225, 0, 0, 230, 242,
+	196, 270, 236, 275, 260, 283, 0, 237, 134, 262,
+	163, 207, 146, 147, 159, 165, 167, 169, 170, 216,
+	217, 228, 249, 263, 264, 265, 162, 154, 243, 155,
+	180, 156, 135, 251, 157, 136, 229, 268, 145, 175,
+	239, 203, 137, 202, 231, 267, 266, 0, 86, 1763,
+	27, 44, 28, 173, 0, 279, 0, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 75, 0,
+	0, 247, 82, 0, 0, 1149, 0, 186, 227, 0,
+	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 45, 255, 277, 290, 280, 84, 0, 0,
+	289, 0, 1800, 0, 0, 0, 0, 212, 213, 214,
+	215, 1745, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 182, 151, 226, 174, 287,
+	189, 218, 185, 252, 190, 197, 240, 286, 224, 245,
+	150, 276, 253, 201, 0, 0, 0, 0, 0, 0,
+	0, 1763, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 158, 234, 181, 0, 78, 79, 0, 80, 81,
+	0, 131, 0, 194, 285, 238, 171, 1149, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1745, 133, 132, 261, 278, 0, 0,
+	0, 0, 67, 77, 61, 0, 57, 327, 0, 326,
+	330, 322, 0, 0, 1749, 0, 0, 0, 0, 0,
+	0, 318, 76, 74, 73, 1753, 0, 0, 0, 0,
+	0, 0, 337, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1742, 0, 0, 0, 1744,
+	1746, 1748, 0, 1750, 1751, 1752, 1754, 1755, 1756, 1758,
+	1759, 1760, 1761, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 53, 0, 0, 0, 59, 0,
+	54, 1762, 0, 0, 0, 0, 1749, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1753, 1741, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1757, 0, 0, 55, 1742, 0, 1747,
+	0, 1744, 1746, 1748, 0, 1750, 1751, 1752, 1754, 1755,
+	1756, 1758, 1759, 1760, 1761, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 320, 319, 323, 0, 0, 0, 0, 0, 325,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1762, 0, 746, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1741, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1757, 56, 58, 60, 0,
+	0, 1747, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 324, 328, 747,
+	0, 332, 748, 0, 0, 334, 335, 336, 0, 0,
+	338, 339,
 }
 
 var yyPact = [...]int{
-	1301, -1000, -302, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	18620, -1000, -307, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 16869, 1698, -1000,
+	7915, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 13621, 17275, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 7492, 7069, 89, -198, 182, 17275, 17275,
+	-302, -69, -1000, 1687, -1000, -1000, -1000, 107, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 118, 37, 275, 282,
+	300, 300, 8321, 1687, 1447, -1000, 1638, 18620, 141, 17275,
+	-1000, 420, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 13353, 1354, -1000, 6249, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 11777, 13747, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 5843, 5437, 66, -1000,
-	1346, -1000, -1000, -1000, 69, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 96, -109, 233, 239, 253, 253, 6643,
-	1346, 1135, -53, -1000, 1308, 1301, 109, 13747, -1000, 273,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2507,68 +2940,74 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 13621, 17275, -116, 514, -1000, 1294, 417, -1000,
+	-1000, -1000, -1000, 1438, -1000, -1000, -1000, 1622, 18024, 1447,
+	-1000, 1331, 1354, -1000, -1000, 1516, -1000, 58, -49, -68,
+	90, -1000, -1000, 115, -1000, -1000, -1000, -1000, -1000, -32,
+	-1000, -56, -1000, -61, -1000, -1000, -1000, -167, -1000, -1000,
+	-1000, -1000, -1000, 1274, 314, 1548, -203, 821, -1000, -1000,
+	17275, 1697, 1537, 17275, 17275, 166, 166, 166, 166, 166,
+	-1000, 1644, 1447, 1680, 1643, 1635, 1632, 163, 163, 176,
+	163, 181, -1000, -1000, -1000, -1000, -1000, -1000, 559, 559,
+	123, -1000, -1000, -144, 1416, 463, 1416, -40, -1000, -1000,
+	-1000, -1000, -1000, -1000, 17275, 166, -1000, -205, -1000, 274,
+	-1000, 262, -1000, 9544, 109, 1389, 578, -1000, 494, 17275,
+	17275, 17275, 494, 494, 396, 614, 579, 362, -1000, 1594,
+	1596, 1644, 1447, -1000, 1265, 1278, 4982, -1000, -1000, -1000,
+	-1000, -1000, 1411, 1515, -1000, 17275, 1406, -1000, 347, 815,
+	1002, -1000, 17275, 17275, 13621, 13621, 13621, 13621, -1000, 1570,
+	1569, -1000, 1572, 1571, 1562, 1563, 18367, -1000, -1000, -1000,
+	17681, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1230, 1687,
+	88, 18799, 12809, 15245, 17275, 12809, -1000, -1000, -1000, -1000,
+	-1000, -168, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 88, 12809, 12809, -125, -1000, -1000, 1384, -1000,
+	896, 805, -1000, -1000, 12809, 1623, 15245, 17275, 17275, 18367,
+	-1000, 5814, -1000, -1000, 5814, -1000, -1000, -1000, -1000, -1000,
+	-1000, 12809, 581, 15245, 894, 17275, 163, 17275, -1000, -1000,
+	17275, 463, 463, -1000, 559, 559, -1000, -1000, -169, 1688,
+	6230, -176, 17275, 163, 195, 16463, -193, 272, 247, 263,
+	-1000, -1000, 1707, -1000, -1000, 1366, 10373, 9133, 153, 12809,
+	2479, -1000, -1000, 494, 494, 494, 2479, 2479, 1044, 295,
+	-1000, -1000, -1000, -1000, -1000, -1000, 17275, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1328, -1000, -1000, 8727, 333,
+	5814, 737, 1514, -1000, 1513, 1510, 1508, 1506, 1503, 1495,
+	1493, 1470, -1000, -1000, 1490, 1489, -1000, 1488, 1470, -1000,
+	-1000, -1000, 1484, -1000, -1000, 1482, 1470, 1481, -1000, -1000,
+	1480, 1479, -1000, -1000, 639, -1000, 475, -1000, -1000, 4566,
+	6230, 6230, 6230, 6230, -1000, -1000, 1478, 5814, 1476, -1000,
+	-1000, -1000, -229, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 6646, -1000, 1474, 1472, 1470, 1469, 1001,
+	1000, 999, 1468, 1467, 1465, 6230, 1464, 1463, 1462, 1461,
+	1459, 1458, 1456, 1455, 1452, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 11777, 13747, -141, 365, -1000,
-	1089, 272, -1000, -1000, -1000, -1000, 1393, -1000, -1000, -1000,
-	1295, 14141, 1135, -1000, 1091, 1090, -1000, -1000, 1181, -1000,
-	56, -73, -95, 13, -1000, -1000, 86, -1000, -1000, -1000,
-	-1000, -1000, -26, -1000, -80, -1000, -86, -1000, -1000, -1000,
-	-177, -1000, -1000, -1000, -1000, -1000, 1039, 280, 1213, -223,
-	-1000, 1309, 1135, 1336, 1313, 131, 131, 141, 131, 145,
-	-1000, -1000, -1000, -1000, -1000, -1000, 420, 81, -1000, -1000,
-	-187, -193, 297, -193, -58, -1000, -1000, -1000, -1000, -1000,
-	-1000, 132, -1000, -228, -1000, 223, -1000, 214, -1000, 7825,
-	78, 1085, 433, -1000, 311, 13747, 13747, 13747, 271, 573,
-	564, 269, -1000, -1000, -1000, 1265, 1269, 1309, 1135, -1000,
-	995, 994, 132, 132, 132, 132, 132, 3819, -1000, -1000,
-	-1000, -1000, -1000, 1124, 1179, -1000, 13747, 1371, -1000, 268,
-	676, 772, -1000, 13747, 13747, 11777, 11777, 11777, 11777, -1000,
-	1244, 1242, -1000, 1243, 1233, 1257, 14837, -1000, -1000, -1000,
-	14489, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 981, 1346,
-	26, 663, 10989, 12565, 13747, 10989, -1000, -1000, -1000, -1000,
-	-1000, -178, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 26, 10989, 10989, -150, -1000, -1000, 4223, -1000,
-	-1000, 4223, -1000, -1000, 10989, 391, 12565, 696, 13747, 131,
-	13747, -1000, -1000, 297, 297, -1000, 420, 420, -1000, -1000,
-	-181, 1350, 4627, -197, 13747, 131, 12959, 1293, -212, 230,
-	208, 219, -1000, -1000, -226, -1000, -1000, 1068, 8625, 7431,
-	128, 10989, 2201, -1000, -1000, 311, 311, 311, 2201, 816,
-	270, -1000, -1000, -1000, -1000, -1000, -1000, 13747, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 10989, 12565, 13747, 13747,
-	14837, 1028, -1000, -1000, 7037, 265, 4223, 804, 1175, -1000,
-	1173, 1171, 1170, 1169, 1161, 1160, 1159, 1140, 1157, 1156,
-	-1000, -1000, -1000, 1155, 1154, 1140, 1152, 1151, 1148, -1000,
-	-1000, 1149, -1000, -1000, -1000, -1000, 3415, 4627, 4627, 4627,
-	4627, -1000, -1000, 1146, 1145, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 5031, -1000, 1143,
-	1141, 1140, 1137, 763, 749, 747, 1136, 1133, 1132, 4627,
-	1129, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1423, -1000, 9962, 17275, -1000, 1682, 5814, 2093, -1000, 1319,
+	332, 1322, -1000, 513, 1535, 1547, 1535, -1000, -1000, -1000,
+	-1000, 1561, -1000, 1560, -1000, 1525, -1000, -1000, -1000, -1000,
+	-1000, 535, -1000, -1000, -1000, -1000, -1000, -56, -61, 1360,
+	-1000, -85, 56, -1000, -1000, 1325, -1000, -1000, -1000, 535,
+	1360, 173, 998, 17275, -1000, -1000, 1385, -1000, 1360, -1000,
+	1366, 1546, 1384, -1000, -1000, -1000, 1015, 329, 1383, -1000,
+	773, 150, 1610, 1366, 1519, 1598, 17275, -1000, 1688, 1688,
+	1688, 463, 18367, 559, 17275, 559, -1000, -1000, 559, -1000,
+	327, 17275, 1382, -1000, -1000, 16057, 15651, 160, 150, 1451,
+	-1000, -1000, 266, 259, 258, 15245, 172, -1000, -1000, 1366,
+	-1000, -1000, -1000, 1450, 512, -1000, -1000, 6230, -1000, 646,
+	-1000, 2479, 2479, 2479, -1000, -1000, 494, 11591, -1000, 1688,
+	4982, -1000, 13621, -1000, 5814, 5814, 5814, -1000, 17275, 14839,
+	-1000, 589, 6230, -1000, -1000, -1000, -1000, -1000, -1000, 5814,
+	1629, 1629, 1629, 5814, 560, 5814, 5814, -1000, 704, 1629,
+	1629, 1629, -1000, 1629, 1629, -1000, 5814, 1629, 1629, 6230,
+	6230, 6230, 6230, 6230, 6230, 6230, 6230, 6230, 6230, 6230,
+	6230, 1440, 592, 6230, 6230, 6230, 996, 995, 1278, 1199,
+	1367, -1000, -1000, -1000, -1000, -1000, 563, 646, 5814, -1000,
+	1449, 350, 5814, -1000, 1219, -1000, -1000, 5814, -1000, -1000,
+	-1000, 5814, 6230, 5814, -1000, 5814, 5814, 1629, 1629, 1209,
+	1205, 1195, 5814, 5814, 1332, -1000, 4143, 1314, 1581, -1000,
+	318, 1311, -1000, 1644, 646, -1000, 313, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1122, -1000, 8231, 13747,
-	-1000, 1341, 4223, 1839, -1000, 1072, 261, 1037, -1000, 351,
-	1189, 1212, 1189, -1000, -1000, -1000, -1000, 1241, -1000, 1162,
-	-1000, -1000, -1000, -1000, -1000, 364, -1000, -1000, -1000, -1000,
-	-1000, -80, -86, 1053, -1000, -114, 53, -1000, -1000, 1019,
-	-1000, -1000, -1000, 364, 1053, 140, 744, 587, 260, 1078,
-	-1000, 528, 139, 1281, 1068, 1184, 1273, 13747, 1350, 1350,
-	1350, 297, 14837, 420, 13747, 420, -1000, -1000, 420, -1000,
-	256, 13747, 139, 1128, -1000, -1000, -1000, 227, 206, 210,
-	12565, 138, -1000, -1000, 1068, -1000, -1000, -1000, 1127, 340,
-	-1000, -1000, 4627, -1000, 497, -1000, 2201, 2201, 2201, -1000,
-	311, 9807, -1000, 1053, 1068, 1211, 1077, -1000, -1000, -1000,
-	-1000, 1350, 3819, -1000, 11777, -1000, 4223, 4223, 4223, -1000,
-	13747, 12171, -1000, 485, 4627, -1000, -1000, -1000, -1000, -1000,
-	-1000, 4223, 1299, 1299, 1299, 4223, 383, 4223, 4223, -1000,
-	476, 1299, 1299, 1299, 1299, -1000, 1299, 1299, 1299, 4627,
-	4627, 4627, 4627, 4627, 4627, 4627, 4627, 4627, 4627, 4627,
-	4627, 1123, 437, 4627, 4627, 4627, 994, 978, 1069, -1000,
-	-1000, -1000, -1000, -1000, 4223, 133, 4223, -1000, 977, -1000,
-	-1000, 4223, -1000, -1000, -1000, 4223, 4627, 4223, -1000, 1299,
-	1032, -1000, 3009, 1014, 1261, -1000, 255, 1012, -1000, 1309,
-	497, -1000, 250, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2582,127 +3021,142 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -143, -1000, 13747, 1341, 13747,
-	4223, -1000, -1000, 4223, 1126, -1000, 4223, -1000, -1000, -1000,
-	1348, 249, 248, 10989, -1000, 106, 10989, -1000, -1000, 13747,
-	137, 10989, -63, 4223, 4223, 13747, 4223, -1000, -1000, -1000,
-	-251, -1000, -126, -1000, 1198, 9, -1000, 1273, -1000, 238,
-	-1000, 1125, -1000, -1000, -1000, 1350, -1000, 297, -1000, 297,
-	420, 13747, -1000, -1000, -251, 969, -1000, -1000, -1000, 204,
-	1068, 10989, 723, 128, -1000, -1000, -1000, 2201, -1000, -1000,
-	13747, 13747, 1347, -1000, 1055, 1225, -1000, 447, 394, -1000,
-	247, -1000, -1000, 436, -1000, 963, 1029, 497, 4223, -1000,
-	-1000, 4223, 4223, 612, 4223, 960, 1010, 1008, -1000, 957,
-	-1000, 4223, 4223, 4223, 4223, 4223, 4223, 4223, 758, 885,
-	-1000, 616, 616, 281, 281, 281, 281, 281, 595, 595,
-	-1000, -1000, -1000, 3415, 1123, 4627, 4627, 4627, 118, 2272,
-	3397, -1000, 4223, 607, -1000, -1000, 908, -1000, 797, 902,
-	2993, 893, 4223, 1122, 889, 1054, -1000, 1101, 13747, 1122,
-	13747, -1000, 13747, -1000, 1839, 675, -1000, 1309, -1000, 497,
-	497, 13747, 497, 10989, 289, 355, -1000, 9413, 10989, -1000,
-	-1000, 10989, 58, 1276, -1000, -1000, 497, 497, 246, -1000,
-	-1000, -142, -1000, -1000, -1000, 207, -1000, 743, 742, 736,
-	734, 13747, -1000, -1000, -1000, -1000, 331, 331, 331, 1265,
-	13747, -1000, 1350, 1350, 297, -1000, -60, -120, -1000, 1053,
-	882, -1000, -1000, -1000, -1000, -1000, 1343, 1333, 11777, 11383,
-	-1000, -1000, 4223, 968, 952, 949, 202, 1004, -1000, -1000,
-	-1000, -1000, 864, 861, 855, 849, 836, 824, 779, 998,
-	-1000, 118, 2272, 2587, -1000, 4627, 4627, 708, 202, 527,
-	-1000, -1000, 527, -1000, 4627, -1000, 688, -1000, -1000, 3009,
-	1122, -1000, -1000, 1032, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 989, -1000, 1053, -1000, -1000, -1000, -1000, 10989,
-	1298, 139, -1000, -78, 144, 13747, -142, -1000, 672, 667,
-	665, 654, -117, -1000, -1000, -1000, -1000, -1000, 1120, 527,
-	-1000, 594, 725, 880, 1047, -1000, -1000, 85, -1000, -1000,
-	1350, -1000, -60, -1000, 225, 237, -47, 1332, -1000, -1000,
-	4223, 4223, 1225, -1000, -1000, 497, -1000, -1000, -1000, 875,
-	1112, 1112, -1000, 1112, 1112, 1112, 1113, 1116, 1113, 195,
-	195, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 4627, -1000, -1000, -1000, 866, 857, 845, 2184, -1000,
-	-1000, 1032, -1000, 13747, -1000, 10989, 10989, -252, -81, 13747,
-	-1000, -1000, -1000, -1000, -1000, -1000, 10595, -1000, -1000, -1000,
-	-1000, -1000, -1000, 15092, 13747, 1277, -104, -1000, -1000, -1000,
-	1112, -1000, 1112, 1112, 1112, 1112, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1119, 1117, -1000, 1112, 1112,
-	1112, 1112, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1113, 1116, 1113,
-	-1000, -1000, -1000, -1000, 651, -1000, -1000, -1000, 723, 497,
-	1029, -1000, -1000, 649, -1000, -1000, -1000, -1000, -1000, 646,
-	-1000, 644, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -197, 987, -1000,
-	1112, 4223, 108, 1388, -1000, 331, 331, 257, 331, 331,
-	331, 331, 63, 61, 331, 331, 331, 331, 331, 331,
-	331, 331, 331, 331, 331, 331, 331, 331, -1000, -1000,
-	1277, -1000, -1000, 422, 4627, -1000, -1000, 722, 594, 287,
-	275, 1111, -1000, 33, 409, 405, -1000, 13747, 798, -107,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 717, 717, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -55, -1000, -1000, 832,
-	1022, 955, -160, -161, -1000, 10595, 1287, 656, -1000, 1330,
-	15092, -1000, 633, 630, 331, 331, 623, 715, 713, 709,
-	331, 331, 617, 701, 14489, 593, 582, 575, 664, 697,
-	441, 652, 632, 631, 13747, 1109, -1000, -1000, 2272, -1000,
+	-118, -1000, 17275, 1682, 17275, 5814, -1000, -1000, 5814, 1448,
+	-1000, 5814, -1000, -1000, -1000, -1000, 1690, 306, 305, 12809,
+	-1000, 113, 12809, -1000, -1000, 17275, 171, 12809, -46, -1000,
+	805, 17275, 5814, 5814, 17275, 5814, -1000, -1000, -1000, -253,
+	-1000, -100, -1000, 1545, 15, -1000, 1598, -1000, 500, -1000,
+	1446, -1000, -1000, -1000, 1688, -1000, 463, -1000, 463, 559,
+	17275, -1000, -1000, 191, -1000, 17275, 1441, 106, -1000, 17275,
+	17275, 17275, -253, 1171, -1000, -1000, -1000, 253, 1366, 12809,
+	948, 153, -1000, -1000, -1000, 2479, -1000, -1000, 1686, -1000,
+	1365, 1511, -1000, 576, 629, -1000, 299, -1000, -1000, 663,
+	-1000, 1169, 1316, 646, 5814, -1000, -1000, 5814, 5814, 1077,
+	5814, 1151, 1306, 1295, -1000, 1143, -1000, 5814, 5814, 5814,
+	5814, 5814, 1194, 5814, 5814, 572, 1320, -1000, 654, 654,
+	307, 307, 307, 307, 307, 831, 831, -1000, -1000, -1000,
+	4566, 1440, 6230, 6230, 6230, 136, 816, 1107, -1000, -1000,
+	-1000, 5814, 556, -1000, 5814, 787, 139, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1141, -1000, 1066,
+	1139, 1290, 1120, 765, 1125, 5814, 5814, -229, -229, -229,
+	1285, 1280, 1423, 1117, 1364, -1000, 1237, 17275, 1423, 17275,
+	-1000, 17275, -1000, 2093, 810, -1000, 1644, -1000, 646, 646,
+	17275, 646, 12809, 326, 506, -1000, 11185, 12809, -1000, -1000,
+	12809, 75, 1604, -1000, -1000, -1000, -1000, 646, 646, 298,
+	-1000, -1000, -117, -1000, -1000, -1000, 240, -1000, 994, 993,
+	990, 989, 17275, -1000, -1000, -1000, -1000, 509, 509, 509,
+	1594, 17275, -1000, 1688, 1688, 463, -1000, -1000, 14433, 14027,
+	-1000, 132, 987, -77, -1000, -1000, -1000, 1412, -1000, 1412,
+	1412, 1412, 1412, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1437, 1436, -1000, 1412, 1412, 1412, 1412, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	569, 1107, -1000, -1000, 1106, -1000, -1000, -1000, 951, -1000,
-	948, -1000, -1000, 548, -1000, 534, 135, -158, -161, -1000,
-	1329, -155, 1327, 1326, 54, -1000, -1000, 1287, 23, -1000,
-	-1000, -1000, 527, 527, -1000, -1000, -1000, -1000, 691, 689,
+	-1000, -1000, -1000, -1000, 1418, 1417, 1417, 1417, 1418, -1000,
+	1363, 170, -43, -89, -1000, 1360, 1114, -1000, -1000, -1000,
+	1684, 1679, 13621, 13215, -1000, -1000, 5814, 1188, 1152, 1122,
+	186, 1276, -1000, -1000, -1000, -1000, 1330, 1110, 1104, 1074,
+	1063, -1000, 1060, 1055, 1264, -1000, 136, 816, 968, -1000,
+	6230, 6230, 1035, 531, -1000, 5814, 637, 186, 358, 1682,
+	1678, -1000, -1000, 358, -1000, 6230, -1000, 5814, 5814, 5814,
+	1030, 1009, -1000, -1000, -1000, -229, -229, -1000, -1000, 4143,
+	1423, -1000, -1000, 1332, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1262, -1000, 1360, -1000, -1000, -1000, -1000, 12809,
+	1621, 150, -1000, -54, 180, 17275, -117, -1000, 808, 803,
+	799, 796, -92, -1000, -1000, -1000, -1000, -1000, 1420, 358,
+	-1000, 636, 973, 1108, 1359, -1000, -1000, -1000, -1000, 1688,
+	1254, -1000, 1028, -1000, 987, -1000, -1000, 660, 6230, -1000,
+	-1000, 953, 636, 286, 316, 1419, -1000, 53, 651, 635,
+	-1000, 17275, 1026, -80, -1000, -1000, -1000, 795, -1000, -1000,
+	-1000, -1000, 949, 949, -1000, -1000, -1000, -1000, -1000, 791,
+	-1000, 785, -1000, -1000, -1000, 17275, -1000, -43, -1000, 216,
+	251, -27, 1677, -1000, -1000, 5814, 5814, 1511, -1000, -1000,
+	646, -1000, -1000, -1000, 1105, 1412, 1412, -1000, -1000, 1412,
+	1412, 1412, 1418, 1417, 1418, 1417, 246, 246, -1000, -222,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6230, -1000,
+	-1000, -1000, -1000, 646, 5814, 1103, 1095, -110, 5814, 1080,
+	1568, 727, 701, 951, -1000, -1000, -1000, -1000, -1000, 1332,
+	-1000, 17275, -1000, 12809, 12809, -255, -60, 17275, -1000, -1000,
+	-1000, -1000, -1000, -1000, 12403, -1000, -1000, -1000, -1000, -1000,
+	-1000, 18726, 17275, -1000, -1000, 132, 1460, -1000, -1000, 816,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 776, 1415, -1000, -1000, 1414, -1000, -1000, -1000, 1075,
+	1251, -1000, 1241, 1329, 1239, -1000, -1000, -1000, -1000, 772,
+	-1000, -1000, -1000, 948, 646, 1316, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 74, 13747, 822, 4223, -244, 10595, -1000, 685,
-	-1000, 819, 692, 1105, 525, -152, 1324, -1000, 502, 1322,
-	502, 502, -1000, 331, 661, -40, -1000, -1000, -1000, 18,
-	124, 88, -1000, 151, -1000, -1000, -1000, -1000, -1000, -1000,
-	71, 946, -1000, 547, 1194, -1000, -94, 897, -1000, -1000,
-	-1000, 1260, 9019, -173, -1000, 1321, 532, -1000, -1000, 502,
-	-1000, -1000, 524, -1000, 696, 11, 514, 4627, 1104, 4627,
-	1102, 25, 1098, -1000, -1000, -1000, 201, -1000, -1000, 1191,
-	1187, 1365, -1000, -1000, -1000, -1000, -1000, 13747, -1000, 869,
-	-1000, -1000, -1000, 245, -1000, 490, -1000, -1000, -1000, -1000,
-	1094, 1320, -1000, 1522, 13747, 1352, 13747, 1093, 324, 4627,
-	-1000, -1000, 31, -1000, 1367, -1000, 1358, 266, 266, 1021,
-	-1000, 312, -1000, 10201, 13747, -1000, -1000, 103, 14, -1000,
-	863, -1000, 853, 13747, 464, 874, -1000, -1000, -1000, -1000,
-	530, 37, -1000, 13747, 2605, -1000, 240, 851, -1000, 789,
-	-1, -1000, -1000, 826, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 497, 13747, -1000, 103, 1258, -1000, 446, -1000, -1000,
-	-1000, 741, 97, -1000, -1000, 741, 2, -1000, 92, -1000,
-	-1000, 788, -1000, 777, 1073, -1000, 2, 15092, 4223, -1000,
-	15092, 783, -1000,
+	1068, 946, -1000, 646, -1000, -1000, 1056, 3727, -1000, -1000,
+	1316, -1000, -1000, -1000, 5814, -1000, 5814, -1000, -1000, -1000,
+	-1000, -1000, -1000, -114, 1228, -1000, 1412, 5814, 138, 18624,
+	-1000, 509, 509, 510, 509, 509, 509, 509, 92, 86,
+	509, 509, 509, 509, 509, 509, 509, 509, 509, 509,
+	509, 509, 509, 509, -1000, -1000, 768, 242, 1047, 5814,
+	-247, 12403, -1000, -1000, 923, -1000, 771, -1000, 769, -31,
+	-1000, -1000, -1000, -1000, -1000, -1000, 5398, -235, -236, 64,
+	932, 879, -176, 17275, -1000, 12403, 1603, 798, -1000, 1671,
+	18726, -1000, 753, 751, 509, 509, 750, 922, 919, 912,
+	509, 509, 744, 903, 17681, 728, 726, 724, 784, 902,
+	395, 759, 743, 730, 17275, 1409, -1000, 768, 34, -1000,
+	122, 1408, -1000, 722, 1544, -1000, 321, 1185, -1000, 1042,
+	1036, -1000, 623, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-149, -132, -1000, 63, -1000, -1000, 1603, 59, -1000, -1000,
+	-1000, 358, 358, -1000, -1000, -1000, -1000, 901, 900, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 105, 17275, -1000, -1000, 1183, -1000, 917, 291, 5814,
+	261, -1000, -1000, 1543, 1542, 1694, -1000, -1000, -1000, -1000,
+	-1000, -1000, 5398, 168, -147, -132, -1000, 1669, -143, 1668,
+	1654, -1000, 509, 886, 28, -1000, -1000, -1000, 39, 144,
+	131, -1000, 161, -1000, -1000, -1000, -1000, -1000, -1000, 98,
+	1136, -1000, 34, 18726, -1000, 3311, 1130, -1000, -1000, 49,
+	-1000, 1701, -1000, 1695, 369, 369, -1000, 1407, 711, -128,
+	1652, -1000, 872, 1651, 872, 872, 709, -1000, 894, 33,
+	694, 6230, 1405, 6230, 1404, 43, 1403, -1000, -1000, -1000,
+	-1000, 18726, 1093, -1000, 646, -1000, -1000, -1000, -1000, -1000,
+	-1000, 686, 62, -1000, 1593, 10779, -150, -1000, 1650, 876,
+	-1000, -1000, 872, -1000, -1000, -1000, -1000, 1402, 1649, -1000,
+	1471, 17275, 933, 17275, 1321, 508, 6230, -1000, 3311, -1000,
+	-1000, -1000, -1000, 17275, -1000, 1090, -1000, -1000, -1000, 293,
+	-1000, 854, -1000, -1000, -1000, 132, 41, -1000, 1071, -1000,
+	1050, 17275, 676, 914, -1000, 1301, -1000, 504, -1000, 11997,
+	17275, -1000, 1041, 31, -1000, -1000, 1011, -1000, -1000, 17275,
+	2895, -1000, 289, -1000, -1000, 675, -1000, -1000, -1000, 646,
+	17275, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 580, 1734, 1733, 594, 572, 1732, 1731, 1727, 1726,
-	1725, 1724, 1723, 1722, 1721, 1720, 1718, 1717, 1715, 1714,
-	1713, 1712, 1711, 1710, 1709, 1707, 1704, 1701, 1700, 1699,
-	1698, 1697, 569, 1696, 1695, 1694, 1693, 1692, 1689, 100,
-	1686, 1685, 1684, 1683, 1682, 1680, 1678, 1677, 80, 69,
-	1675, 64, 116, 1674, 93, 1673, 62, 130, 1672, 1671,
-	22, 84, 1669, 87, 86, 60, 159, 68, 1668, 1666,
-	1665, 1664, 99, 1663, 1662, 1661, 1660, 41, 34, 27,
-	1659, 59, 1657, 1656, 1655, 1653, 1652, 1651, 1650, 46,
-	44, 1647, 1646, 1645, 1644, 1643, 25, 1642, 35, 1641,
-	1639, 1638, 1636, 1635, 1611, 16, 15, 17, 1605, 1603,
-	1602, 2, 1599, 1598, 89, 1597, 1596, 1593, 496, 1592,
-	1591, 1590, 107, 1589, 92, 1588, 1587, 1583, 1582, 21,
-	1581, 32, 1579, 33, 1574, 1572, 66, 30, 45, 61,
-	1571, 1570, 1568, 96, 20, 119, 0, 95, 29, 1566,
-	91, 103, 1565, 72, 133, 77, 36, 1563, 50, 1562,
-	1560, 1559, 49, 10, 1558, 58, 11, 55, 1557, 73,
-	85, 1, 63, 1556, 97, 1555, 1554, 83, 1551, 1550,
-	102, 74, 1549, 1548, 1547, 18, 1528, 31, 1523, 1520,
-	106, 108, 1519, 1514, 1513, 79, 67, 53, 1512, 1510,
-	51, 1509, 76, 52, 88, 1507, 560, 1506, 75, 43,
-	1505, 104, 1504, 123, 94, 82, 1503, 1502, 101, 1276,
-	105, 1501, 98, 9, 1497, 1496, 12, 1495, 24, 1493,
-	1491, 1490, 1489, 6, 1488, 1487, 1484, 3, 5, 1482,
-	4, 71, 1481, 1477, 37, 40, 42, 1474, 1473, 1472,
-	223, 1466, 1465, 1451, 1450, 1445, 1444, 1442, 54, 1439,
-	1429, 1427, 1420, 56, 1410, 1409, 1407, 1405, 1403, 28,
-	1402, 19, 1397, 1396, 1395, 1394, 13, 1391, 1390, 14,
-	1389, 1388, 7, 8, 1384, 1382, 1381, 1380, 109, 1378,
-}
-
-//line mysql_sql.y:5526
+	0, 623, 1981, 1980, 703, 701, 1979, 1978, 1977, 1974,
+	1973, 1972, 1971, 88, 1970, 1968, 1967, 1966, 1965, 1964,
+	1963, 1962, 1961, 1960, 1957, 1956, 1955, 1954, 1953, 1952,
+	1951, 1950, 1949, 1946, 1945, 1944, 1943, 649, 1942, 1941,
+	1940, 1939, 1938, 1937, 102, 1936, 1935, 1934, 1933, 1930,
+	1929, 1928, 1923, 1922, 1921, 1920, 1919, 1917, 1915, 81,
+	75, 1914, 104, 126, 1913, 96, 1912, 66, 146, 1909,
+	1908, 25, 89, 1907, 97, 64, 63, 156, 70, 1906,
+	1903, 86, 1902, 101, 1901, 1900, 1899, 1898, 38, 33,
+	23, 35, 62, 1897, 1896, 1895, 1894, 1892, 1891, 1890,
+	42, 44, 1889, 1888, 1885, 1884, 1883, 24, 1881, 43,
+	1880, 1879, 1878, 1877, 1876, 1875, 13, 22, 21, 1874,
+	1872, 1871, 12, 1870, 1869, 71, 1868, 1867, 1865, 691,
+	1849, 1848, 1843, 113, 1839, 109, 1838, 1837, 1836, 1834,
+	1833, 77, 1832, 1831, 1830, 19, 1829, 5, 1828, 40,
+	1827, 29, 1826, 1824, 69, 58, 99, 67, 1821, 1820,
+	1819, 108, 20, 74, 0, 1818, 122, 51, 1817, 118,
+	115, 1816, 80, 151, 114, 26, 1815, 39, 1814, 1812,
+	1809, 50, 11, 1808, 76, 41, 65, 1807, 73, 1805,
+	1804, 7, 72, 1803, 95, 18, 79, 1800, 107, 1799,
+	1797, 93, 1795, 1793, 116, 91, 1792, 1791, 1790, 30,
+	1789, 27, 1788, 1787, 100, 111, 1786, 1785, 1784, 92,
+	68, 56, 1783, 1782, 48, 1781, 78, 52, 94, 1780,
+	732, 1778, 85, 37, 1776, 106, 1775, 164, 103, 87,
+	1774, 1771, 112, 1593, 110, 1770, 98, 4, 1767, 1765,
+	6, 1764, 17, 1763, 1762, 1761, 1760, 34, 1759, 8,
+	1758, 14, 16, 1757, 36, 84, 1756, 1755, 32, 49,
+	47, 1754, 1753, 1751, 139, 1750, 1749, 1748, 1747, 1746,
+	1745, 1744, 57, 1743, 1742, 1741, 1740, 1739, 1738, 1737,
+	1736, 53, 1735, 1734, 1733, 1732, 1731, 15, 1730, 10,
+	1728, 1727, 1726, 1725, 9, 1724, 1723, 1722, 3, 1721,
+	1719, 1, 2, 1718, 1717, 1716, 154, 1715, 1714,
+}
+
+//line mysql_sql.y:6168
 type yySymType struct {
 	union interface{}
 	id    int
@@ -2716,6 +3170,16 @@ func (st *yySymType) aliasedTableExprUnion() *tree.AliasedTableExpr {
 	return v
 }
 
+func (st *yySymType) alterTableOptionUnion() tree.AlterTableOption {
+	v, _ := st.union.(tree.AlterTableOption)
+	return v
+}
+
+func (st *yySymType) alterTableOptionsUnion() []tree.AlterTableOption {
+	v, _ := st.union.([]tree.AlterTableOption)
+	return v
+}
+
 func (st *yySymType) attributeNullUnion() tree.AttributeNull {
 	v, _ := st.union.(tree.AttributeNull)
 	return v
@@ -2816,6 +3280,21 @@ func (st *yySymType) fieldsListUnion() []*tree.Fields {
 	return v
 }
 
+func (st *yySymType) frameBoundUnion() *tree.FrameBound {
+	v, _ := st.union.(*tree.FrameBound)
+	return v
+}
+
+func (st *yySymType) frameClauseUnion() *tree.FrameClause {
+	v, _ := st.union.(*tree.FrameClause)
+	return v
+}
+
+func (st *yySymType) frameTypeUnion() tree.FrameType {
+	v, _ := st.union.(tree.FrameType)
+	return v
+}
+
 func (st *yySymType) fromUnion() *tree.From {
 	v, _ := st.union.(*tree.From)
 	return v
@@ -2896,6 +3375,11 @@ func (st *yySymType) keyPartsUnion() []*tree.KeyPart {
 	return v
 }
 
+func (st *yySymType) killOptionUnion() tree.KillOption {
+	v, _ := st.union.(tree.KillOption)
+	return v
+}
+
 func (st *yySymType) lengthOptUnion() int32 {
 	v, _ := st.union.(int32)
 	return v
@@ -3231,229 +3715,275 @@ func (st *yySymType) varExprUnion() *tree.VarExpr {
 	return v
 }
 
+func (st *yySymType) varExprsUnion() []*tree.VarExpr {
+	v, _ := st.union.([]*tree.VarExpr)
+	return v
+}
+
+func (st *yySymType) whenUnion() *tree.When {
+	v, _ := st.union.(*tree.When)
+	return v
+}
+
+func (st *yySymType) whensUnion() []*tree.When {
+	v, _ := st.union.([]*tree.When)
+	return v
+}
+
 func (st *yySymType) whereUnion() *tree.Where {
 	v, _ := st.union.(*tree.Where)
 	return v
 }
 
+func (st *yySymType) windowSpecUnion() *tree.WindowSpec {
+	v, _ := st.union.(*tree.WindowSpec)
+	return v
+}
+
 func (st *yySymType) zeroFillOptUnion() bool {
 	v, _ := st.union.(bool)
 	return v
 }
 
 var yyR1 = [...]int{
-	0, 286, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 315, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	47, 285, 285, 284, 284, 283, 283, 282, 282, 282,
-	281, 281, 281, 280, 280, 279, 279, 277, 277, 278,
-	276, 275, 275, 274, 274, 272, 272, 273, 273, 268,
-	268, 270, 270, 269, 269, 269, 269, 271, 267, 267,
-	267, 266, 266, 46, 46, 46, 209, 209, 45, 45,
-	222, 222, 222, 222, 222, 220, 220, 220, 220, 219,
-	219, 218, 218, 223, 223, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 40, 40,
-	40, 40, 43, 44, 216, 216, 216, 216, 216, 217,
-	217, 217, 41, 42, 42, 208, 208, 212, 212, 211,
-	211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
-	211, 207, 207, 215, 215, 215, 214, 214, 213, 213,
-	34, 34, 34, 37, 36, 206, 206, 206, 206, 206,
-	206, 206, 206, 35, 35, 35, 35, 35, 35, 33,
-	33, 32, 205, 205, 204, 39, 39, 39, 39, 38,
-	38, 38, 38, 38, 38, 38, 149, 149, 149, 7,
-	31, 31, 250, 250, 159, 159, 160, 160, 158, 158,
-	158, 158, 158, 158, 253, 254, 156, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 30, 287, 287,
-	287, 28, 29, 249, 249, 249, 27, 26, 25, 24,
-	24, 23, 22, 22, 153, 153, 155, 155, 151, 288,
-	288, 228, 228, 154, 154, 21, 21, 152, 152, 134,
-	150, 150, 150, 6, 8, 8, 8, 8, 8, 13,
-	12, 11, 10, 9, 5, 4, 257, 257, 257, 257,
-	257, 71, 71, 67, 67, 258, 258, 172, 265, 265,
-	264, 264, 263, 263, 69, 69, 70, 70, 59, 59,
-	48, 48, 49, 49, 49, 65, 65, 66, 66, 66,
-	64, 64, 63, 62, 62, 61, 60, 60, 60, 51,
-	51, 50, 50, 50, 50, 50, 118, 118, 118, 52,
-	251, 251, 251, 256, 256, 115, 115, 116, 116, 114,
-	114, 53, 53, 54, 54, 54, 54, 113, 113, 112,
-	55, 55, 56, 56, 58, 58, 58, 58, 123, 123,
-	122, 122, 122, 122, 74, 74, 121, 120, 120, 120,
-	73, 73, 72, 72, 68, 68, 57, 57, 119, 289,
-	289, 117, 142, 142, 142, 148, 148, 141, 141, 141,
-	147, 147, 143, 143, 144, 144, 144, 3, 3, 3,
-	16, 16, 16, 14, 202, 202, 201, 201, 203, 203,
-	203, 203, 197, 197, 198, 198, 198, 198, 199, 199,
-	199, 200, 200, 200, 200, 196, 196, 195, 193, 193,
-	193, 194, 194, 194, 194, 194, 194, 145, 145, 15,
-	190, 190, 191, 191, 191, 192, 192, 184, 184, 184,
-	184, 19, 188, 188, 189, 189, 189, 189, 189, 185,
-	185, 187, 187, 183, 183, 183, 183, 18, 182, 182,
-	180, 180, 178, 178, 179, 179, 177, 177, 177, 181,
-	181, 17, 252, 252, 224, 224, 227, 227, 234, 234,
-	235, 235, 233, 233, 240, 240, 239, 239, 238, 238,
-	237, 237, 236, 236, 231, 231, 230, 230, 225, 225,
-	225, 225, 225, 226, 226, 229, 229, 232, 232, 94,
-	94, 95, 95, 95, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 97, 97, 97, 101, 101, 101, 101, 101,
-	101, 96, 96, 96, 98, 98, 98, 79, 79, 78,
-	78, 75, 75, 76, 76, 77, 80, 130, 130, 130,
-	146, 146, 146, 129, 129, 129, 93, 93, 92, 92,
-	90, 90, 90, 90, 90, 90, 90, 90, 90, 90,
-	90, 90, 90, 210, 210, 157, 157, 157, 110, 108,
-	108, 109, 109, 109, 109, 106, 107, 105, 105, 105,
-	105, 105, 104, 104, 103, 103, 103, 186, 186, 102,
-	102, 100, 100, 100, 99, 99, 99, 241, 163, 163,
-	163, 163, 163, 163, 163, 163, 163, 163, 163, 163,
-	163, 165, 165, 165, 165, 165, 165, 165, 165, 165,
-	165, 165, 165, 165, 165, 165, 165, 165, 165, 165,
-	81, 81, 81, 81, 81, 81, 81, 81, 81, 81,
-	81, 89, 89, 89, 128, 128, 128, 128, 128, 128,
-	128, 128, 128, 128, 128, 128, 128, 128, 128, 262,
-	262, 262, 125, 127, 127, 126, 126, 126, 126, 126,
-	126, 126, 126, 126, 126, 126, 126, 173, 173, 174,
-	174, 259, 259, 259, 259, 259, 259, 260, 260, 261,
-	261, 261, 261, 255, 255, 255, 255, 255, 255, 255,
-	255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-	255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-	255, 164, 124, 124, 124, 242, 175, 170, 170, 171,
-	171, 166, 166, 166, 166, 166, 168, 168, 168, 168,
-	162, 162, 162, 162, 162, 162, 162, 162, 162, 167,
-	167, 169, 169, 176, 176, 176, 176, 176, 176, 91,
-	91, 91, 91, 243, 161, 161, 161, 161, 161, 161,
-	82, 82, 82, 82, 86, 86, 88, 88, 88, 88,
-	88, 88, 88, 88, 88, 88, 88, 88, 88, 88,
-	87, 87, 87, 85, 85, 85, 85, 85, 83, 83,
-	83, 83, 83, 83, 83, 83, 83, 83, 83, 83,
-	83, 83, 83, 84, 131, 131, 244, 244, 245, 245,
-	246, 246, 246, 247, 247, 247, 248, 248, 133, 133,
-	133, 138, 138, 132, 132, 139, 139, 140, 140, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 137, 135, 135, 135, 135, 135, 135, 135, 135,
-	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
-	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
-	135, 135, 135, 135, 135, 135, 135, 135,
+	1, 1, 1, 1, 1, 52, 165, 165, 314, 314,
+	313, 313, 312, 312, 311, 311, 311, 310, 310, 310,
+	309, 309, 308, 308, 305, 305, 306, 304, 303, 303,
+	302, 302, 300, 300, 301, 301, 296, 296, 298, 298,
+	297, 297, 297, 297, 299, 295, 295, 295, 294, 294,
+	51, 51, 51, 233, 233, 50, 50, 246, 246, 246,
+	246, 246, 244, 244, 244, 244, 243, 243, 242, 242,
+	247, 247, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 45, 45, 45, 45, 48,
+	49, 240, 240, 240, 240, 240, 241, 241, 241, 46,
+	47, 47, 232, 232, 236, 236, 235, 235, 235, 235,
+	235, 235, 235, 235, 235, 235, 235, 235, 235, 231,
+	231, 239, 239, 239, 238, 238, 237, 237, 39, 39,
+	39, 42, 41, 230, 230, 230, 230, 230, 230, 230,
+	230, 40, 40, 40, 40, 40, 40, 57, 53, 58,
+	58, 58, 54, 54, 55, 55, 307, 307, 56, 56,
+	38, 38, 37, 229, 229, 228, 44, 44, 44, 44,
+	43, 43, 43, 43, 43, 43, 43, 168, 168, 168,
+	7, 7, 12, 12, 15, 15, 13, 13, 13, 13,
+	13, 14, 14, 36, 36, 274, 274, 178, 178, 179,
+	179, 177, 177, 177, 177, 177, 177, 277, 278, 175,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 35, 34, 317, 317, 317, 32, 33, 273, 273,
+	273, 31, 30, 29, 28, 28, 27, 26, 26, 172,
+	172, 174, 174, 170, 316, 316, 252, 252, 173, 173,
+	25, 25, 171, 171, 152, 169, 169, 169, 6, 8,
+	8, 8, 8, 8, 17, 16, 11, 10, 9, 5,
+	4, 281, 281, 281, 281, 281, 82, 82, 78, 78,
+	282, 282, 196, 293, 293, 292, 292, 291, 291, 80,
+	80, 81, 81, 70, 70, 59, 59, 60, 60, 60,
+	76, 76, 77, 77, 77, 75, 75, 74, 73, 73,
+	72, 71, 71, 71, 62, 62, 61, 61, 61, 61,
+	61, 129, 129, 129, 129, 129, 129, 129, 129, 129,
+	63, 275, 275, 275, 280, 280, 126, 126, 127, 127,
+	125, 125, 64, 64, 65, 65, 65, 65, 124, 124,
+	123, 66, 66, 67, 67, 69, 69, 69, 69, 134,
+	134, 133, 133, 133, 133, 133, 133, 85, 85, 132,
+	131, 131, 131, 84, 84, 83, 83, 79, 79, 68,
+	68, 130, 318, 318, 128, 160, 160, 160, 167, 167,
+	159, 159, 159, 166, 166, 161, 161, 162, 162, 162,
+	3, 3, 3, 20, 20, 20, 18, 226, 226, 225,
+	225, 227, 227, 227, 227, 221, 221, 222, 222, 222,
+	222, 223, 223, 223, 224, 224, 224, 224, 220, 220,
+	219, 217, 217, 217, 218, 218, 218, 218, 218, 218,
+	163, 163, 19, 214, 214, 215, 215, 215, 216, 216,
+	208, 208, 208, 208, 23, 212, 212, 213, 213, 213,
+	213, 213, 209, 209, 211, 211, 207, 207, 207, 207,
+	22, 206, 206, 204, 204, 202, 202, 203, 203, 201,
+	201, 201, 205, 205, 21, 276, 276, 248, 248, 251,
+	251, 258, 258, 259, 259, 257, 257, 264, 264, 263,
+	263, 262, 262, 261, 261, 260, 260, 260, 260, 143,
+	143, 191, 191, 255, 255, 254, 254, 249, 249, 249,
+	249, 249, 250, 250, 253, 253, 256, 256, 105, 105,
+	106, 106, 106, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 108, 108, 108, 112, 112, 112, 112, 112, 112,
+	107, 107, 107, 109, 109, 109, 90, 90, 89, 89,
+	86, 86, 87, 87, 88, 91, 148, 148, 148, 164,
+	164, 164, 147, 147, 147, 104, 104, 103, 103, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 101, 234, 234, 176, 176, 176, 121, 119, 119,
+	120, 120, 120, 120, 117, 118, 116, 116, 116, 116,
+	116, 115, 115, 114, 114, 114, 210, 210, 113, 113,
+	111, 111, 111, 110, 110, 110, 265, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	184, 184, 184, 184, 184, 184, 184, 184, 184, 184,
+	184, 184, 184, 184, 184, 184, 184, 184, 184, 184,
+	184, 184, 184, 92, 92, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 100, 100, 100, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 288, 288, 288, 289, 289,
+	290, 290, 140, 140, 140, 140, 140, 140, 141, 142,
+	142, 144, 144, 144, 146, 146, 145, 145, 145, 145,
+	145, 136, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 137, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 137, 197, 197, 198, 198, 189, 189, 193,
+	193, 192, 190, 190, 286, 286, 286, 287, 287, 283,
+	283, 283, 283, 283, 283, 284, 284, 285, 285, 285,
+	285, 279, 279, 279, 279, 279, 279, 279, 279, 279,
+	279, 279, 279, 279, 279, 279, 279, 279, 279, 279,
+	279, 279, 279, 279, 279, 279, 279, 279, 279, 183,
+	135, 135, 135, 266, 266, 266, 266, 266, 266, 266,
+	266, 266, 199, 194, 194, 195, 195, 185, 185, 185,
+	185, 185, 187, 187, 187, 187, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 186, 186, 188, 188, 200,
+	200, 200, 200, 200, 200, 102, 102, 102, 102, 267,
+	180, 180, 180, 180, 180, 180, 180, 93, 93, 93,
+	93, 97, 97, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 98, 98, 98,
+	98, 98, 96, 96, 96, 96, 96, 94, 94, 94,
+	94, 94, 94, 94, 94, 94, 94, 94, 94, 94,
+	94, 94, 95, 149, 149, 268, 268, 269, 269, 270,
+	270, 270, 271, 271, 271, 272, 272, 151, 151, 151,
+	156, 156, 150, 150, 157, 157, 158, 158, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	14, 0, 2, 1, 3, 3, 3, 1, 3, 5,
-	0, 2, 3, 1, 3, 1, 1, 1, 1, 1,
-	1, 0, 3, 0, 3, 0, 3, 0, 3, 0,
-	2, 1, 2, 3, 4, 3, 3, 1, 0, 1,
-	1, 0, 1, 9, 4, 7, 0, 3, 7, 4,
-	1, 3, 3, 3, 1, 0, 1, 1, 1, 1,
-	3, 1, 4, 1, 3, 1, 2, 1, 1, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	1, 2, 1, 2, 2, 1, 1, 1, 3, 2,
-	2, 2, 2, 2, 2, 2, 1, 1, 1, 1,
-	1, 1, 3, 6, 3, 1, 1, 1, 1, 1,
-	1, 1, 2, 4, 6, 1, 4, 1, 3, 3,
-	4, 4, 4, 3, 5, 2, 4, 4, 2, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	1, 1, 1, 2, 2, 0, 4, 2, 4, 1,
-	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
-	1, 7, 1, 3, 3, 1, 1, 1, 1, 2,
-	3, 4, 7, 2, 5, 3, 1, 1, 1, 1,
-	7, 9, 0, 2, 0, 1, 1, 2, 2, 2,
-	1, 4, 2, 2, 3, 0, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 5, 1, 1,
-	1, 5, 5, 0, 1, 1, 2, 2, 3, 6,
-	7, 4, 7, 8, 0, 2, 0, 2, 2, 1,
-	1, 1, 1, 0, 1, 4, 5, 1, 3, 1,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 4,
-	4, 6, 4, 4, 6, 4, 2, 1, 5, 4,
-	4, 1, 3, 1, 3, 1, 3, 3, 0, 1,
-	1, 3, 1, 1, 0, 4, 1, 3, 2, 1,
-	1, 1, 3, 2, 3, 0, 1, 2, 4, 4,
-	0, 1, 3, 1, 3, 2, 0, 1, 1, 3,
-	3, 1, 3, 3, 3, 3, 1, 2, 2, 7,
-	0, 1, 1, 1, 1, 0, 2, 0, 3, 0,
-	2, 1, 3, 1, 2, 3, 5, 0, 1, 2,
-	1, 3, 1, 1, 4, 4, 4, 3, 2, 2,
-	2, 3, 2, 3, 0, 2, 1, 1, 2, 2,
-	0, 1, 2, 4, 1, 3, 1, 3, 3, 0,
-	1, 2, 0, 1, 2, 1, 1, 0, 1, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 6, 0, 2, 1, 2, 2, 2,
-	2, 2, 0, 1, 2, 2, 2, 2, 1, 3,
-	2, 2, 2, 2, 2, 1, 3, 2, 1, 3,
-	2, 0, 3, 3, 5, 5, 4, 1, 1, 4,
-	1, 3, 1, 3, 2, 1, 1, 0, 1, 1,
-	1, 11, 0, 2, 3, 2, 3, 1, 1, 1,
-	3, 3, 4, 0, 2, 2, 2, 5, 1, 1,
-	0, 3, 0, 1, 1, 2, 4, 4, 4, 0,
-	1, 10, 0, 1, 0, 6, 0, 4, 0, 3,
-	1, 3, 4, 5, 0, 3, 1, 3, 2, 3,
-	1, 2, 0, 6, 0, 2, 0, 2, 4, 5,
-	4, 5, 1, 6, 5, 0, 3, 0, 1, 0,
-	1, 1, 3, 2, 3, 3, 4, 4, 3, 3,
-	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4, 5, 0, 2, 2, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
-	3, 0, 1, 1, 3, 1, 3, 1, 3, 5,
-	1, 1, 1, 1, 3, 5, 0, 1, 1, 2,
-	1, 2, 2, 1, 1, 2, 2, 2, 2, 2,
-	1, 5, 6, 1, 2, 0, 1, 2, 5, 0,
-	1, 1, 1, 2, 2, 3, 3, 1, 1, 2,
-	2, 2, 0, 1, 2, 2, 2, 0, 3, 0,
-	3, 1, 1, 1, 1, 1, 1, 1, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	1, 1, 1, 1, 3, 5, 2, 2, 2, 2,
-	1, 1, 2, 6, 6, 6, 1, 1, 1, 1,
-	2, 2, 1, 2, 2, 2, 2, 2, 2, 2,
-	2, 0, 1, 1, 5, 4, 4, 5, 5, 5,
-	5, 4, 5, 5, 5, 5, 5, 5, 5, 1,
-	1, 1, 4, 2, 2, 4, 2, 2, 4, 6,
-	2, 2, 2, 4, 6, 4, 2, 0, 1, 2,
-	3, 1, 1, 1, 1, 1, 1, 0, 2, 1,
+	1, 1, 1, 1, 0, 15, 0, 2, 0, 2,
+	1, 3, 3, 3, 1, 3, 5, 0, 2, 3,
+	1, 3, 1, 1, 1, 1, 1, 1, 0, 3,
+	0, 3, 0, 3, 0, 3, 0, 2, 1, 2,
+	3, 4, 3, 3, 1, 0, 1, 1, 0, 1,
+	9, 4, 7, 0, 3, 7, 4, 1, 3, 3,
+	3, 1, 0, 1, 1, 1, 1, 3, 1, 4,
+	1, 3, 1, 2, 1, 1, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 1, 2, 1,
+	2, 2, 1, 1, 1, 3, 2, 2, 2, 2,
+	2, 2, 2, 1, 1, 1, 1, 1, 1, 3,
+	6, 3, 1, 1, 1, 1, 1, 1, 1, 2,
+	4, 6, 1, 4, 1, 3, 3, 4, 4, 4,
+	3, 3, 5, 2, 4, 4, 2, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
+	1, 2, 2, 0, 4, 2, 4, 1, 5, 3,
+	2, 1, 2, 2, 4, 4, 5, 3, 3, 0,
+	1, 1, 4, 4, 2, 4, 1, 3, 3, 3,
+	2, 1, 7, 1, 3, 3, 1, 1, 1, 1,
+	2, 3, 4, 7, 2, 5, 3, 1, 1, 1,
+	1, 1, 4, 4, 1, 3, 2, 3, 2, 3,
+	5, 5, 3, 7, 9, 0, 2, 0, 1, 1,
+	2, 2, 2, 1, 4, 2, 2, 3, 0, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 4, 5, 1, 1, 1, 5, 5, 0, 1,
+	1, 2, 2, 3, 6, 7, 4, 7, 8, 0,
+	2, 0, 2, 2, 1, 1, 1, 1, 0, 1,
+	4, 5, 1, 3, 1, 1, 3, 5, 1, 1,
+	1, 1, 1, 1, 4, 4, 6, 4, 4, 6,
+	4, 2, 1, 5, 4, 4, 1, 3, 1, 3,
+	1, 3, 3, 0, 1, 1, 3, 1, 1, 0,
+	4, 1, 3, 2, 1, 1, 1, 3, 2, 3,
+	0, 1, 2, 4, 4, 0, 1, 3, 1, 3,
+	2, 0, 1, 1, 3, 3, 1, 3, 3, 3,
+	3, 1, 2, 2, 1, 2, 2, 1, 2, 2,
+	7, 0, 1, 1, 1, 1, 0, 2, 0, 3,
+	0, 2, 1, 3, 1, 2, 3, 5, 0, 1,
+	2, 1, 3, 1, 1, 4, 4, 4, 3, 2,
+	2, 2, 3, 2, 3, 2, 3, 0, 2, 1,
+	1, 2, 2, 0, 1, 2, 4, 1, 3, 1,
+	3, 3, 0, 1, 2, 0, 1, 2, 1, 1,
+	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 6, 0, 2, 1,
+	2, 2, 2, 2, 2, 0, 1, 2, 2, 2,
+	2, 1, 3, 2, 2, 2, 2, 2, 1, 3,
+	2, 1, 3, 2, 0, 3, 3, 5, 5, 4,
+	1, 1, 4, 1, 3, 1, 3, 2, 1, 1,
+	0, 1, 1, 1, 11, 0, 2, 3, 2, 3,
+	1, 1, 1, 3, 3, 4, 0, 2, 2, 2,
+	5, 1, 1, 0, 3, 0, 1, 1, 2, 4,
+	4, 4, 0, 1, 10, 0, 1, 0, 6, 0,
+	4, 0, 3, 1, 3, 4, 5, 0, 3, 1,
+	3, 2, 3, 1, 2, 0, 4, 6, 5, 1,
+	3, 1, 1, 0, 2, 0, 2, 4, 5, 4,
+	5, 1, 6, 5, 0, 3, 0, 1, 0, 1,
+	1, 3, 2, 3, 3, 4, 4, 3, 3, 3,
+	3, 4, 4, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
+	5, 0, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 3,
+	0, 1, 1, 3, 1, 3, 1, 3, 5, 1,
+	1, 1, 1, 3, 5, 0, 1, 1, 2, 1,
+	2, 2, 1, 1, 2, 2, 2, 2, 2, 1,
+	5, 6, 1, 2, 0, 1, 2, 5, 0, 1,
+	1, 1, 2, 2, 3, 3, 1, 1, 2, 2,
+	2, 0, 1, 2, 2, 2, 0, 3, 0, 3,
+	1, 1, 1, 1, 1, 1, 1, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 1,
+	1, 3, 3, 1, 1, 3, 5, 2, 2, 2,
+	2, 1, 1, 2, 5, 6, 6, 6, 1, 1,
+	1, 1, 1, 2, 2, 1, 1, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 0, 1, 1, 5,
+	4, 4, 5, 5, 5, 5, 4, 5, 5, 5,
+	5, 5, 7, 5, 5, 1, 1, 1, 1, 1,
+	0, 2, 4, 4, 4, 5, 5, 2, 6, 0,
+	3, 0, 2, 5, 1, 1, 2, 2, 2, 2,
+	2, 4, 2, 6, 8, 6, 8, 4, 6, 2,
+	2, 4, 2, 2, 4, 6, 2, 2, 2, 4,
+	6, 4, 2, 0, 1, 2, 3, 0, 1, 1,
+	2, 4, 0, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 2, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 0, 1, 1, 1, 3, 0, 1, 1,
-	3, 3, 3, 3, 2, 1, 3, 4, 3, 1,
-	3, 4, 4, 5, 3, 4, 5, 6, 1, 0,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 0, 1, 1, 3, 3, 3, 3,
+	2, 1, 3, 4, 3, 1, 3, 4, 4, 5,
+	3, 4, 5, 6, 1, 0, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	2, 2, 1, 2, 2, 2, 2, 2, 2, 2,
 	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 1, 1, 2, 1, 1, 1, 1, 1,
+	4, 4, 1, 1, 3, 0, 3, 0, 5, 0,
+	3, 5, 0, 1, 1, 0, 1, 1, 2, 2,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 2, 1, 2, 2, 2, 2, 2, 2,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 4, 4, 1, 1, 3, 0, 3, 0, 5,
-	0, 3, 5, 0, 1, 1, 0, 1, 1, 2,
-	2, 0, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3486,399 +4016,445 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -286, -2, -1, -3, -4, -5, -6, -38, -20,
-	-7, -32, -33, -34, -40, -45, -46, -47, -48, -16,
-	-15, -14, 8, 10, -8, -149, -21, -22, -23, -24,
-	-25, -26, -27, -28, -29, -30, -31, 9, 49, -35,
-	-36, -37, -41, -42, -43, -44, 279, 285, 321, -49,
-	-51, -17, -18, -19, 177, -9, -10, -11, -12, -13,
-	199, 198, 26, 197, 178, 120, 121, 123, 124, 30,
-	-50, 54, 179, -52, 6, 412, -59, 27, -78, -146,
-	57, -135, -137, 375, 376, 377, 378, 379, 380, 381,
-	382, 383, 384, 385, 386, 387, 388, 389, 390, 391,
-	392, 393, 394, 395, 396, 397, 398, 399, 400, 401,
-	402, 403, 404, 405, 406, 407, 408, 409, 410, 369,
-	216, 240, 243, 250, 120, 137, 131, 158, 150, 151,
-	128, 246, 220, 221, 64, 123, 349, 335, 320, 126,
-	235, 237, 239, 242, 222, 146, 142, 234, 218, 140,
-	223, 28, 224, 163, 225, 226, 374, 331, 261, 353,
-	337, 247, 141, 195, 194, 332, 238, 334, 164, 168,
-	341, 285, 135, 136, 339, 343, 162, 196, 32, 371,
-	34, 208, 344, 166, 161, 157, 352, 251, 249, 160,
-	134, 156, 219, 38, 170, 169, 171, 315, 316, 317,
-	318, 227, 228, 340, 153, 143, 265, 129, 18, 347,
-	203, 336, 286, 229, 244, 206, 252, 165, 167, 190,
-	210, 215, 373, 248, 345, 139, 207, 236, 202, 348,
-	130, 279, 288, 230, 124, 241, 342, 351, 37, 301,
-	133, 127, 193, 121, 212, 217, 231, 232, 233, 254,
-	253, 245, 154, 209, 159, 132, 155, 122, 211, 350,
-	302, 411, 263, 304, 152, 149, 213, 186, 372, 346,
-	338, 125, 308, 303, 147, 11, -150, 19, 319, -39,
-	181, -146, -5, -4, -32, -48, -56, -57, -58, -117,
-	-119, -78, 54, -146, -219, -190, -218, -191, -221, -192,
-	-145, 20, 178, 177, 211, 10, 179, 283, 185, 8,
-	6, 284, 197, 9, 285, 287, 288, 291, 292, 293,
-	31, 296, 297, 57, 60, -146, -219, -190, 215, 222,
-	-63, -64, -118, 15, 5, 304, 214, -184, -182, -252,
-	194, 193, 76, 355, 183, 294, -287, -249, 338, 337,
-	-154, 336, 329, 331, 177, 185, 339, 32, 341, 342,
-	332, 304, 125, 122, -206, 80, 130, 129, -206, 214,
-	29, -212, 314, -211, -213, 341, 342, 352, -207, 340,
-	-133, -146, 58, 59, 75, 151, 148, -64, -118, -63,
-	-49, -51, 304, 214, 185, 184, 355, -251, 20, -256,
-	21, 22, -1, -69, 206, -78, 119, -56, -129, -146,
-	320, 89, -39, 119, 30, -120, -121, -122, -123, 41,
-	45, 47, 42, 43, 44, 48, -289, 23, -142, -148,
-	23, -143, 60, -144, -137, 57, 58, 59, -49, -51,
-	51, 55, 11, 55, 54, 413, 58, 281, 295, 304,
-	282, 294, 186, 214, 295, 214, 329, 186, 286, 289,
-	290, 330, 51, 187, 51, -266, 352, -66, 17, -52,
-	-51, 16, 20, 21, -180, 189, -180, 185, -180, 184,
-	-288, 11, 99, 213, 212, 333, 330, -228, 334, 335,
-	-154, -153, 97, -154, 184, 355, -250, 189, 345, 372,
-	128, 129, 130, -216, 20, 29, 313, -190, 214, 55,
-	89, 19, -214, 89, 100, -213, -213, -213, -214, 119,
-	-96, 29, -144, 60, 116, -96, 29, 119, 30, 30,
-	-65, -66, -52, -51, 56, 56, -250, -250, -250, -250,
-	-250, -53, -54, 107, -166, -146, 81, -168, 57, -162,
-	376, 377, 378, 379, 380, 381, 382, 384, 389, 391,
-	395, 396, 397, 398, 402, 403, 408, 409, 410, 304,
-	147, -163, -165, -282, -277, -161, 54, 105, 106, 113,
-	82, -164, -241, 24, 363, -125, -126, -127, -128, -278,
-	-276, 60, 65, 69, 71, 72, 70, 118, -51, -255,
-	-261, -259, 148, 200, 144, 145, 8, 111, 314, 116,
-	-262, 59, 58, 267, 75, 268, 269, 355, 264, 270,
-	189, 319, 43, 271, 272, 273, 274, 275, 362, 276,
-	44, 277, 266, 204, 278, 366, 365, 367, 359, 356,
-	354, 357, 358, 360, 361, -257, 33, -48, 54, 54,
-	-146, -114, 12, 119, 65, 60, -146, -205, -204, -129,
-	-57, -57, -57, -57, 41, 41, 41, 46, 41, 46,
-	41, -122, -143, -148, 56, -220, 184, 280, 210, -218,
-	211, 285, 288, -196, -195, -193, -145, 60, -191, -223,
-	-129, -145, 330, -220, -196, -195, 322, -166, -146, -62,
-	-61, -166, -196, 81, -190, -144, -146, -180, -78, -153,
-	-153, -155, -288, -151, -288, 330, -114, -165, -228, -152,
-	-146, -180, -196, 304, 24, 346, 347, 126, 129, 128,
-	353, -217, 313, 20, -190, -211, -208, 60, 314, -195,
-	-215, 51, 116, -263, -166, 29, -214, -214, -214, -215,
-	57, 115, -146, -196, -190, -146, -79, -78, -147, -144,
-	-137, -113, 55, -112, 11, -141, 80, 78, 79, -146,
-	23, 119, -166, 96, -176, 89, 90, 91, 92, 93,
-	94, 54, 54, 54, 54, 54, 54, 54, 54, -174,
-	54, 54, 54, 54, 54, -174, 54, 54, 54, 102,
-	101, 112, 105, 106, 107, 108, 109, 110, 111, 103,
-	104, 99, 81, 97, 98, 83, -51, -166, -171, -165,
-	-165, -165, -165, -241, 54, -166, 54, -260, 54, -173,
-	-174, 54, 60, 60, 60, 54, 54, 54, -165, 54,
-	-258, -172, 54, -71, 56, -67, -146, -70, -146, -64,
-	-166, -139, -140, -132, -136, -143, -144, -137, 262, 182,
-	20, 80, 23, 25, 267, 299, 83, 116, 16, 84,
-	148, 115, 269, 363, 268, 177, 47, 75, 365, 367,
-	366, 356, 354, 306, 310, 312, 309, 355, 329, 29,
-	10, 26, 198, 21, 22, 109, 179, 200, 87, 88,
-	201, 24, 199, 72, 19, 50, 11, 319, 13, 14,
-	270, 305, 189, 188, 99, 322, 185, 45, 8, 118,
-	27, 96, 307, 41, 77, 43, 97, 17, 357, 358,
-	31, 321, 368, 205, 111, 271, 272, 273, 48, 81,
-	313, 70, 51, 78, 15, 46, 98, 180, 362, 44,
-	214, 311, 275, 277, 276, 183, 6, 266, 364, 30,
-	197, 42, 184, 330, 86, 187, 71, 204, 144, 145,
-	5, 76, 9, 49, 52, 359, 360, 361, 33, 85,
-	12, 278, 274, 314, 323, 324, 325, 326, 327, 328,
-	172, 173, 174, 175, 176, 19, -39, 119, -114, 55,
-	89, -73, -72, 51, 52, -74, 51, -72, 41, 41,
-	-222, 107, 57, 55, -194, 305, 413, 58, 56, 55,
-	-222, 187, 60, 55, 18, 119, 55, -60, 25, 26,
-	-197, -198, 311, 24, -183, 52, -178, -179, -177, -181,
-	29, -78, -114, -114, -114, -153, -147, -155, -150, -155,
-	-151, 119, -134, -146, -197, 54, 127, 130, 130, 129,
-	-190, 187, 54, 89, -215, -215, -215, -214, 29, -145,
-	51, 55, -114, -54, -55, -56, -166, -166, -166, -146,
-	-146, 107, 70, 81, -162, -170, -171, -166, -124, 21,
-	20, -124, -124, -166, -124, 107, -171, -171, 56, -243,
-	65, -124, -124, -124, -124, -124, -124, -124, -163, -163,
-	-163, -163, -163, -163, -163, -163, -163, -163, -163, -163,
-	-169, -175, -241, 54, 99, 97, 98, 83, -165, -163,
-	-163, 56, 55, -166, -242, 266, -170, 56, -171, -170,
-	-163, -170, -124, 55, -265, -264, -263, 56, 55, 33,
-	119, 56, 55, -65, 119, 320, -146, -64, -204, -166,
-	-166, 54, -166, 11, 119, 119, -195, 16, 372, -145,
-	-129, 187, -196, -267, 188, 362, -166, -166, -146, -61,
-	-202, 372, 313, 312, 308, -199, -200, 307, 309, 306,
-	310, 51, 258, 259, 260, -177, -133, 115, 225, 151,
-	54, -114, -153, -153, -155, -146, -202, 56, 130, -196,
-	-156, 60, -208, -215, -78, -78, -116, 13, 55, 119,
-	70, 56, 55, -166, -166, -166, 23, -171, 56, 56,
-	56, 56, -166, -166, -166, -166, -166, -166, -166, -171,
-	-169, -165, -163, -163, -167, 201, 80, -166, 55, 52,
-	56, 56, 52, 56, 55, 56, -166, -172, 56, 55,
-	33, -48, -67, -258, -146, -146, -139, -136, -144, -137,
-	65, -65, -68, -146, -196, 107, 107, 57, -145, 314,
-	-145, -196, -209, 372, 27, 119, -201, -203, 315, 316,
-	317, 318, 80, -200, 60, 60, 60, 60, -78, -138,
-	89, -138, -138, -75, -76, -77, -80, -129, -114, -114,
-	-153, -159, -160, -158, 262, -253, 314, 305, 56, -115,
-	14, 16, -56, -146, 107, -166, 56, 56, 56, -81,
-	116, 148, 200, 147, 146, 144, 140, 141, 139, 301,
-	302, 56, 56, 56, 56, 56, 56, 56, 56, 56,
-	-167, 80, -165, -162, 56, -81, -96, -96, -163, 56,
-	-263, -258, 56, 55, -145, 16, 23, -197, 285, 184,
-	-203, 65, 65, 65, 65, -200, 54, -96, -98, -144,
-	60, 116, 60, 56, 55, -82, -86, -83, -85, -84,
-	-88, -87, 148, 149, 116, 152, 154, 155, 156, 157,
-	158, 159, 160, 161, 162, 163, 30, 200, 144, 145,
-	146, 147, 164, 131, 150, 370, 172, 132, 173, 133,
-	174, 134, 175, 135, 136, 176, 137, 140, 141, 139,
-	-114, -158, 263, 31, 118, 265, 29, 261, 16, -166,
-	-171, 56, -244, 54, -244, -244, -244, -244, -245, 54,
-	-246, 54, -245, -89, 136, 135, -89, -162, 56, 56,
-	56, 56, -146, -145, -145, -209, 286, -78, -185, -187,
-	-129, 54, -94, -95, -111, 299, 216, -181, 220, 64,
-	221, 320, 222, 185, 224, 225, 226, 196, 227, 228,
-	229, 314, 230, 231, 232, 233, 282, 5, -77, -93,
-	-92, -90, 70, 81, 29, 299, -91, 64, 115, 239,
-	217, 240, -110, -157, 190, 76, 77, 287, 192, -247,
-	302, 301, -244, -244, -244, -244, -244, 54, 54, -244,
-	-244, -244, -244, -245, -246, -245, 65, -254, -156, 65,
-	65, 65, -268, -228, 56, 55, -244, -166, -224, 206,
-	55, -111, -138, -138, -133, 115, -138, -138, -138, -138,
-	223, 223, -138, -138, -138, -138, -138, -138, -138, -138,
-	-138, -138, -138, -138, -138, -138, -90, 70, -163, 60,
-	-98, -99, 29, 238, 234, -100, 29, 218, 219, -102,
-	54, 246, 77, 77, -78, 57, -248, 303, -131, 60,
-	-131, 264, 56, 55, 56, 55, -274, 328, -270, -269,
-	323, 324, 325, 326, -188, -187, -60, 56, 16, -111,
-	65, 65, -138, -138, 65, 60, 60, 60, -138, -138,
-	65, 60, -148, 65, 65, 65, 65, 29, 60, -101,
-	29, 234, 238, 235, 236, 237, 65, 29, 65, 29,
-	65, 29, -146, 54, 65, 54, -186, 54, 56, 55,
-	56, 65, 65, -275, 188, -272, 327, -269, 16, 325,
-	16, 16, -189, 196, 64, 372, 256, 257, -60, -225,
-	248, 249, -226, -232, 251, -96, -96, 60, 60, -97,
-	217, -79, 56, -166, -104, -103, 368, -185, 60, 56,
-	56, -281, 54, 65, -273, 323, 16, -271, 60, 16,
-	-271, -271, -138, 60, 255, -230, 252, 54, -228, 54,
-	-228, 77, 259, 218, 219, 56, 56, -108, -109, -106,
-	-107, 51, 332, 244, 245, 56, -285, 30, 56, -280,
-	-279, -130, -276, -146, 328, 16, 60, -271, 65, -144,
-	-227, 253, 65, -163, 54, -163, 54, -229, 250, 54,
-	-210, 247, 81, -107, 51, -106, 51, 10, 9, -284,
-	-283, -282, 56, 55, 119, 60, -234, 54, 16, 56,
-	-223, 56, -223, 54, 89, -163, 247, -105, 241, 242,
-	30, 129, -105, 55, 89, -279, -146, -235, -233, 206,
-	-226, 56, 56, -223, 65, 56, 70, 29, 243, -283,
-	29, -166, 119, 56, 55, 57, -231, 254, 56, -146,
-	-233, -236, 33, 65, -240, -237, 54, -111, 208, -240,
-	-111, -239, -238, 253, 209, 56, 55, 57, 54, -238,
-	-237, -171, 56,
+	-1000, -315, -2, -1, -3, -4, -5, -6, -43, -24,
+	-7, -37, -38, -39, -45, -50, -51, -52, -53, -57,
+	-54, -55, -56, -59, -20, -19, -18, 10, 12, -8,
+	-168, -25, -26, -27, -28, -29, -30, -31, -32, -33,
+	-34, -35, -36, -12, 11, 52, -40, -41, -42, -46,
+	-47, -48, -49, 284, 290, 326, 426, 186, 427, 288,
+	428, 184, -60, -62, -21, -22, -23, 182, -9, -10,
+	-11, -16, -17, 204, 203, 28, 202, 183, 125, 126,
+	128, 129, 32, -61, 57, -63, 8, 431, -70, 29,
+	-89, -164, 60, -153, -155, 390, 391, 392, 393, 394,
+	395, 396, 397, 398, 399, 400, 401, 402, 403, 404,
+	405, 406, 407, 408, 409, 410, 411, 412, 413, 414,
+	415, 416, 417, 418, 419, 420, 421, 422, 423, 424,
+	425, 384, 428, 427, 221, 245, 248, 255, 125, 142,
+	136, 163, 155, 156, 133, 251, 225, 226, 67, 128,
+	353, 339, 325, 131, 240, 242, 244, 247, 374, 227,
+	151, 147, 239, 223, 145, 228, 30, 229, 168, 230,
+	231, 389, 336, 266, 341, 252, 146, 200, 199, 47,
+	243, 376, 338, 169, 173, 345, 290, 140, 141, 343,
+	347, 167, 201, 34, 386, 36, 213, 348, 171, 166,
+	162, 356, 256, 254, 165, 139, 161, 224, 40, 175,
+	174, 176, 320, 321, 322, 323, 232, 233, 344, 158,
+	148, 270, 134, 20, 351, 208, 340, 291, 234, 249,
+	211, 257, 170, 172, 375, 195, 215, 220, 388, 253,
+	349, 144, 212, 241, 207, 352, 135, 284, 293, 235,
+	129, 246, 346, 355, 39, 306, 138, 132, 198, 126,
+	217, 429, 222, 236, 237, 238, 259, 258, 250, 159,
+	214, 164, 137, 160, 127, 216, 354, 307, 430, 268,
+	309, 157, 154, 218, 191, 387, 350, 342, 130, 313,
+	308, 152, 13, -169, 21, 324, -44, 186, -164, -5,
+	-4, -37, -59, -67, -68, -69, -128, -130, -89, 57,
+	-164, -243, -214, -242, -215, -245, -216, -163, 22, 183,
+	182, 216, 12, 184, 288, 190, 10, 8, 289, 202,
+	11, 290, 292, 293, 296, 297, 298, 33, 301, 302,
+	60, 63, -164, -243, -214, 220, 227, -58, 325, 388,
+	189, -164, -164, 427, 427, 309, 219, 190, 189, 358,
+	-74, -75, -129, 17, 5, 7, 6, 309, 219, -208,
+	-206, -276, 199, 198, 79, 358, 188, 299, 429, -317,
+	-273, 342, 341, -173, 340, 334, 336, 182, 190, 343,
+	34, 345, 346, 47, 189, 309, 130, 127, -230, 83,
+	135, 134, -230, 219, 31, -236, 319, -235, -237, 345,
+	346, 356, 61, 62, -231, 344, -151, -164, 78, 156,
+	153, -75, -129, -74, -60, -62, -275, 22, -280, 23,
+	24, -1, -80, 211, -89, 124, -67, -147, -164, 325,
+	92, -44, 124, 32, -131, -132, -133, -134, 43, 48,
+	50, 44, 45, 46, 47, 51, -318, 25, -160, -167,
+	25, -161, 63, -162, -155, 60, 61, 62, -60, -62,
+	54, 58, 13, 58, 57, 432, 61, 286, 300, 309,
+	287, 299, 191, 219, 300, 219, 334, 191, 291, 294,
+	295, 335, 54, 192, 54, -294, 356, 68, -90, -89,
+	13, 55, -164, -164, -274, 194, -274, -274, -274, -274,
+	-77, 19, -63, -62, 18, 22, 23, 22, 23, 22,
+	23, -204, 194, -204, 190, -204, 189, -316, 13, 102,
+	-316, 218, 217, 337, 335, -252, 338, 339, -173, -172,
+	100, -173, 189, 358, -89, -274, 349, 387, 133, 134,
+	135, -240, 22, 31, 318, -214, 219, 58, 92, 21,
+	-238, 92, 103, -237, -237, -237, -238, -238, 124, -107,
+	31, -162, 63, 121, -107, 31, 124, 32, 32, -76,
+	-77, -63, -62, 59, 59, -64, -65, 112, -185, -164,
+	84, -187, 60, -181, 391, 392, 393, 394, 395, 396,
+	397, 399, 400, 401, 403, 404, 405, 406, 407, 410,
+	411, 412, 413, 415, 416, 417, 418, 421, 422, 423,
+	424, 425, 309, 152, -182, -184, -311, -305, -180, 57,
+	110, 111, 118, 85, -183, -265, 26, 87, 366, -136,
+	-137, -138, -139, -140, -306, -304, 63, 68, 72, 74,
+	75, 73, 64, 123, -62, -279, -285, -283, 153, 205,
+	149, 150, 10, 116, 319, 121, -286, -287, -288, -289,
+	378, 379, 380, 381, 382, 62, 61, 272, 78, 273,
+	274, 358, 269, 275, 194, 324, 45, 276, 277, 278,
+	279, 280, 365, 281, 46, 282, 271, 209, 283, 369,
+	368, 370, 362, 359, 357, 360, 361, 363, 364, -281,
+	35, -59, 57, 57, -164, -125, 14, 124, 68, 63,
+	-164, -229, -228, -147, -68, -68, -68, -68, 43, 43,
+	43, 49, 43, 49, 43, 49, 43, -133, -161, -167,
+	59, -244, 189, 285, 215, -242, 216, 290, 293, -220,
+	-219, -217, -163, 63, -215, -247, -147, -163, 335, -244,
+	-220, -219, 327, 58, 63, -304, -307, -304, -220, 26,
+	-214, -164, -90, -166, -162, -155, -185, -164, -73, -72,
+	-185, -220, 84, -214, -162, -164, -204, -89, -89, -172,
+	-172, -174, -316, -170, -316, 335, -125, -184, -252, -171,
+	-164, -204, -15, -14, -13, 187, 184, 185, -220, 309,
+	350, 351, 131, 134, 133, 6, -241, 318, 22, -214,
+	-235, -232, 63, 319, -219, -239, 54, 121, -291, -185,
+	31, -238, -238, -238, -239, -239, 60, 120, -164, -124,
+	58, -123, 13, -159, 83, 81, 82, -164, 25, 124,
+	-185, 99, -200, 92, 93, 94, 95, 96, 97, 57,
+	57, 57, 57, 57, 57, 57, 57, -198, 57, 57,
+	57, 57, -198, 57, 57, -198, 57, 57, 57, 107,
+	106, 117, 110, 111, 112, 113, 114, 115, 116, 108,
+	109, 102, 84, 100, 101, 86, 104, 105, -62, -185,
+	-195, -184, -184, -184, -184, -265, -189, -185, 57, -141,
+	371, -185, 57, -284, 57, -197, -198, 57, 63, 63,
+	63, 57, 57, 57, -184, 57, 57, 57, 57, 57,
+	57, 57, 57, 57, -282, -196, 57, -82, 59, -78,
+	-164, -81, -164, -75, -185, -157, -158, -150, -154, -161,
+	-162, -155, 267, 187, 22, 83, 25, 27, 272, 304,
+	86, 121, 18, 87, 153, 120, 274, 366, 273, 182,
+	50, 78, 368, 370, 369, 359, 357, 311, 315, 317,
+	314, 358, 334, 31, 12, 28, 203, 23, 24, 114,
+	184, 205, 90, 91, 206, 6, 26, 204, 75, 21,
+	53, 13, 324, 15, 16, 275, 310, 194, 193, 102,
+	327, 190, 48, 10, 123, 7, 29, 99, 312, 43,
+	80, 45, 100, 19, 360, 361, 33, 326, 383, 210,
+	116, 276, 277, 278, 51, 84, 318, 73, 54, 81,
+	17, 49, 371, 101, 185, 365, 46, 219, 316, 280,
+	282, 281, 188, 8, 271, 367, 32, 202, 44, 189,
+	335, 89, 192, 74, 209, 149, 150, 377, 5, 79,
+	11, 52, 55, 362, 363, 364, 35, 88, 14, 283,
+	279, 379, 380, 381, 382, 373, 372, 378, 319, 328,
+	329, 330, 331, 332, 333, 177, 178, 179, 180, 181,
+	21, -44, 124, -125, 58, 92, -84, -83, 54, 55,
+	-85, 54, -83, 43, 43, 43, -246, 112, 60, 58,
+	-218, 310, 432, 61, 59, 58, -246, 192, 63, -89,
+	58, 54, 58, 20, 124, 58, -71, 27, 28, -221,
+	-222, 316, 26, -207, 55, -202, -203, -201, -205, 31,
+	-89, -125, -125, -125, -172, -166, -174, -169, -174, -170,
+	124, -152, -164, 58, -91, 196, 211, -147, -164, 196,
+	211, 196, -221, 57, 132, 135, 135, 134, -214, 192,
+	57, 92, -239, -239, -239, -238, 31, -163, -125, -65,
+	-66, -67, -185, -185, -185, -164, -164, 112, 73, 84,
+	-181, -194, -195, -185, -135, 23, 22, -135, -135, -185,
+	-135, 112, -195, -195, 59, -267, 68, -135, -135, -135,
+	-135, -135, -185, -135, -135, -182, -182, -182, -182, -182,
+	-182, -182, -182, -182, -182, -182, -182, -188, -199, -265,
+	57, 102, 100, 101, 86, -184, -182, -182, 63, 63,
+	59, 58, -193, -192, 88, -185, 57, -266, 276, 271,
+	277, 275, 269, 283, 278, 279, 152, -194, 59, -195,
+	-194, -182, -194, -185, -185, -135, -135, 59, 59, 59,
+	-195, -195, 58, -293, -292, -291, 59, 58, 35, 124,
+	59, 58, -76, 124, 325, -164, -75, -228, -185, -185,
+	57, -185, 13, 124, 124, -219, 18, 387, -163, -147,
+	192, -220, -295, 193, 365, -304, -89, -185, -185, -164,
+	-72, -226, 387, 318, 317, 313, -223, -224, 312, 314,
+	311, 315, 54, 263, 264, 265, -201, -151, 120, 230,
+	156, 57, -125, -172, -172, -174, -164, -13, 187, 184,
+	-91, 57, -93, -97, -94, -96, -95, -99, -98, 153,
+	154, 121, 157, 159, 160, 161, 162, 163, 164, 165,
+	166, 167, 168, 32, 205, 149, 150, 151, 152, 169,
+	136, 155, 385, 177, 137, 178, 138, 179, 139, 180,
+	140, 141, 181, 142, 145, 146, 147, 148, 144, -164,
+	-81, -164, -226, 59, 135, -220, -175, 63, -232, -239,
+	-127, 15, 58, 124, 73, 59, 58, -185, -185, -185,
+	25, -195, 59, 59, 59, 59, -195, -185, -185, -185,
+	-185, 59, -185, -185, -195, -188, -184, -182, -182, -186,
+	206, 83, -185, -190, -192, 90, -185, 58, 55, -142,
+	211, 59, 59, 55, 59, 58, 59, 58, 13, 58,
+	-185, -185, -141, -141, -141, 59, 59, -196, 59, 58,
+	35, -59, -78, -282, -164, -164, -157, -154, -162, -155,
+	68, -76, -79, -164, -220, 112, 112, 60, -163, 319,
+	-163, -220, -233, 387, 29, 124, -225, -227, 320, 321,
+	322, 323, 83, -224, 63, 63, 63, 63, -89, -156,
+	92, -156, -156, -86, -87, -88, -91, -125, -125, -172,
+	-259, -257, 211, -104, -103, -101, 73, 84, 31, 304,
+	-102, 67, 120, 244, 222, 245, -121, -176, 195, 79,
+	80, 292, 197, -271, 307, 306, -268, 57, -268, -268,
+	-268, -268, 57, 57, -268, -268, -268, -268, -269, 57,
+	-270, 57, -270, -270, -269, 192, -178, -179, -177, 267,
+	-277, 319, 310, 59, -126, 16, 18, -67, -164, 112,
+	-185, 59, 59, 59, -92, 121, 153, 167, 205, 152,
+	151, 149, 145, 146, 144, 147, 306, 307, 59, -75,
+	59, 59, 59, 59, 59, 59, 59, -186, 83, -184,
+	-181, 59, 91, -185, 89, -92, -107, -75, 18, -107,
+	-182, -185, -185, -185, 59, 59, -141, -141, -291, -282,
+	59, 58, -163, 18, 25, -221, 290, 189, -227, 68,
+	68, 68, 68, -224, 57, -107, -109, -162, 63, 121,
+	63, 59, 58, -125, 59, 58, 60, -101, 73, -182,
+	63, -109, -110, 31, 243, 239, -111, 31, 223, 224,
+	-113, 57, 251, 80, 80, -89, 60, -272, 308, 68,
+	-149, 63, -149, 68, 68, -164, -177, 268, 33, 123,
+	270, 31, 266, 18, -185, -195, 59, -268, -268, -268,
+	-268, -268, -269, -270, -269, -270, -100, 141, 140, -100,
+	-290, 367, -181, -185, 59, 59, -144, -146, 372, 253,
+	-195, 59, 59, 59, 58, 59, 21, 59, -164, -163,
+	-163, -233, 291, -89, -209, -211, -147, 57, -105, -106,
+	-122, 304, 221, -205, 225, 67, 226, 325, 227, 190,
+	229, 230, 231, 201, 232, 233, 234, 319, 235, 236,
+	237, 238, 287, 5, -88, -257, -260, 35, 68, 57,
+	-210, 57, 59, 59, 58, 59, 58, 59, 58, 68,
+	-278, -175, 59, 63, 59, -145, 86, 377, 374, -182,
+	-185, -185, -165, 324, 59, 58, -268, -185, -248, 211,
+	58, -122, -156, -156, -151, 120, -156, -156, -156, -156,
+	228, 228, -156, -156, -156, -156, -156, -156, -156, -156,
+	-156, -156, -156, -156, -156, -156, -264, -261, 57, -122,
+	213, 102, 59, -185, -115, -114, 383, -209, 63, 68,
+	68, 269, -145, 375, 376, 373, 375, 376, 59, 59,
+	-296, -252, -164, -212, -211, -71, 59, 18, -122, 68,
+	68, -156, -156, 68, 63, 63, 63, -156, -156, 68,
+	63, -167, 68, 68, 68, 68, 31, 63, -112, 31,
+	239, 243, 240, 241, 242, 68, 31, 68, 31, 68,
+	31, -164, 57, -264, -122, -263, -262, 258, 214, 57,
+	59, -119, -120, -117, -118, 54, 47, 249, 250, 59,
+	59, 59, 83, -302, 333, -298, -297, 328, 329, 330,
+	331, -213, 201, 67, 387, 261, 262, -71, -249, 253,
+	254, -250, -256, 256, -107, -107, 63, 63, -108, 222,
+	-90, 59, 58, 60, 210, 57, -195, -234, 252, 84,
+	-118, 54, -117, 54, 12, 11, -145, -303, 193, -300,
+	332, -297, 18, 330, 18, 18, -156, 63, 260, -254,
+	257, 57, -252, 57, -252, 80, 264, 223, 224, 59,
+	-262, -261, -143, -191, -185, 210, 59, 252, -116, 246,
+	247, 32, 134, -116, -310, 57, 68, -301, 328, 18,
+	-299, 63, 18, -299, -299, 68, -162, -251, 258, 68,
+	-182, 57, -182, 57, -253, 255, 57, 59, 58, 73,
+	31, 248, -314, 32, 59, -309, -308, -148, -304, -164,
+	333, 18, 63, -299, -258, 57, 18, 59, -247, 59,
+	-247, 57, 92, -182, -191, -313, -312, -311, 59, 58,
+	124, 63, -259, -250, 59, 59, -247, 68, 59, 58,
+	92, -308, -164, 59, -255, 259, 59, -312, 31, -185,
+	124, 68, -164,
 }
 
 var yyDef = [...]int{
-	19, -2, 1, 2, 4, 5, 6, 7, 8, 9,
-	10, 11, 12, 13, 14, 15, 16, 17, 18, 397,
-	398, 399, 0, 0, 263, 0, 217, 218, 219, 220,
-	221, 222, 223, 224, 225, 226, 199, 0, 180, 160,
-	161, 162, 118, 119, 120, 121, 0, 0, 0, 300,
-	-2, 400, 401, 402, -2, 264, 265, 266, 267, 268,
-	196, 197, 198, -2, 0, 173, 0, 165, 165, 0,
-	310, 0, 0, 321, 330, 19, 294, 0, 299, 569,
-	580, 581, 582, 1172, 1173, 1174, 1175, 1176, 1177, 1178,
-	1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188,
-	1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198,
-	1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1016,
-	1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026,
-	1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036,
-	1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046,
-	1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056,
-	1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066,
-	1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076,
-	1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086,
-	1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
-	1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106,
-	1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116,
-	1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126,
-	1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136,
-	1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146,
-	1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156,
-	1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166,
-	1167, 1168, 1169, 1170, 1171, 0, 189, 0, 0, 193,
-	0, 260, 185, 186, 187, 188, 0, 352, 353, 376,
-	379, 382, 0, 179, 0, 0, 79, 440, 81, 442,
-	0, 85, 87, 88, -2, 92, 93, 94, 95, 96,
-	97, 98, 0, 100, 1068, 102, 1129, 105, 106, 107,
-	0, 116, 117, -2, -2, 437, 0, 0, 1118, 61,
-	-2, 0, 0, 0, 326, 470, 470, 0, 470, 0,
-	448, 449, 450, 468, 469, 483, 0, 0, 236, 237,
-	0, 253, 244, 253, 0, 228, 229, 230, 234, 235,
-	254, 202, 174, 175, 164, 0, 169, 0, 163, 0,
-	0, 132, 0, 137, 0, 1067, 1133, 1083, 0, 1100,
-	0, 158, 151, 152, 868, 1026, 0, 305, 0, 311,
-	0, 310, 202, 202, 202, 202, 202, 0, 331, 332,
-	333, 334, 3, 0, 0, 298, 0, 339, 190, 583,
-	0, 0, 195, 0, 0, 0, 0, 0, 0, 367,
-	0, 0, 366, 0, 0, 0, 0, 380, 381, 383,
-	0, 385, 386, 392, 393, 394, 395, 396, 0, 310,
-	75, 0, 0, 0, 0, 0, 444, 86, 115, 89,
-	90, 0, 110, 112, 114, 113, 99, 111, 101, 103,
-	104, 109, 75, 0, 0, 0, 62, 304, 0, 323,
-	325, 0, 327, 328, 0, 0, 0, 0, 0, 470,
-	0, 249, 250, 244, 244, 238, 246, 0, 251, 252,
-	0, 339, 0, 0, 0, 470, 0, 0, 0, 0,
-	167, 0, 172, 122, 127, 125, 126, 128, 0, 0,
-	0, 0, 0, 156, 157, 0, 0, 0, 0, 0,
-	145, 148, 561, 562, 563, 149, 150, 0, 869, 870,
-	302, 306, 322, 324, 319, 320, 0, 0, 0, 0,
-	0, 347, 341, 343, 387, 27, 0, 775, 580, 779,
-	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1181, 1186, 1188,
-	-2, -2, -2, 1195, 1199, 1200, 1205, 1206, 1207, -2,
-	-2, 788, 650, 651, 652, 653, 0, 0, 0, 0,
-	0, 660, 661, 0, 0, 666, 667, 668, 669, 37,
-	38, 804, 805, 806, 807, 808, 809, 742, 637, 0,
-	727, 717, 0, 737, 755, 756, 0, 0, 0, 0,
-	0, 39, 40, 733, 734, 735, 736, 738, 739, 740,
-	741, 743, 744, 745, 746, 747, 748, 749, 750, 751,
-	752, 753, 754, 757, 759, 729, 730, 731, 732, 721,
-	722, 723, 724, 725, 726, 275, 0, 277, 0, 0,
-	570, 310, 0, 0, 191, 0, 261, 339, 182, 0,
-	370, 364, 0, 357, 368, 369, 360, 0, 362, 0,
-	358, 359, 377, 384, 378, 0, 76, 77, 78, 80,
-	91, 0, 0, 69, 425, 431, 428, 438, 441, 0,
-	83, 443, 108, 0, 64, 0, 0, 307, 27, 312,
-	313, 316, 412, 0, 439, 463, -2, 0, 339, 339,
-	339, 244, 0, 246, 0, 246, 241, 245, 0, 255,
-	257, 0, 412, 1160, 203, 176, 177, 0, 0, 171,
-	0, 0, 129, 130, 131, 138, 133, 135, 0, 0,
-	139, 153, 154, 155, 292, 293, 0, 0, 0, 143,
-	0, 0, 159, 269, 270, 0, 272, 567, 273, 390,
-	391, 339, 0, 348, 0, 344, 0, 0, 0, 388,
-	0, 0, 774, 0, 0, 793, 794, 795, 796, 797,
-	798, 767, 762, 762, 762, 0, 762, 0, 0, 703,
-	0, 762, 762, 762, 762, 704, 762, 762, 762, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, -2, 769, 0, 656,
-	657, 658, 659, 662, 0, 0, 767, 706, 0, 707,
-	718, 0, 710, 711, 712, 767, 0, 767, 716, 762,
-	276, 285, 288, 0, 0, 281, 283, 0, 296, 305,
-	340, 584, 0, 875, -2, 877, -2, -2, 879, 880,
-	881, 882, 883, 884, 885, 886, 887, 888, 889, 890,
-	891, 892, 893, 894, 895, 896, 897, 898, 899, 900,
-	901, 902, 903, 904, 905, 906, 907, 908, 909, 910,
-	911, 912, 913, 914, 915, 916, 917, 918, 919, 920,
-	921, 922, 923, 924, 925, 926, 927, 928, 929, 930,
-	931, 932, 933, 934, 935, 936, 937, 938, 939, 940,
-	941, 942, 943, 944, 945, 946, 947, 948, 949, 950,
-	951, 952, 953, 954, 955, 956, 957, 958, 959, 960,
-	961, 962, 963, 964, 965, 966, 967, 968, 969, 970,
-	971, 972, 973, 974, 975, 976, 977, 978, 979, 980,
-	981, 982, 983, 984, 985, 986, 987, 988, 989, 990,
-	991, 992, 993, 994, 995, 996, 997, 998, 999, 1000,
-	1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010,
-	1011, 1012, 1013, 1014, 1015, 0, 194, 0, 310, 0,
-	0, 354, 371, 0, 0, 355, 0, 356, 361, 363,
-	0, 70, 74, 0, 427, 0, 0, 430, 82, 0,
-	0, 0, 58, 0, 0, 0, 0, 315, 317, 318,
-	404, 413, 0, 471, 0, 0, 467, -2, 474, 0,
-	480, 0, 227, 231, 232, 339, 247, 244, 248, 244,
-	246, 0, 256, 259, 404, 0, 178, 166, 168, 0,
-	124, 0, 0, 0, 140, 141, 142, 0, 146, 147,
-	0, 0, 337, 342, 349, 350, 771, 772, 773, 389,
-	28, 345, 776, 0, 778, 0, 768, 769, 0, 763,
-	764, 0, 0, 0, 0, 0, 0, 0, 719, 0,
-	803, 0, 0, 0, 0, 0, 0, 0, 638, 639,
-	640, 641, 642, 643, 644, 645, 646, 647, 648, 649,
-	780, 791, 792, 0, 0, 0, 0, 0, 789, 784,
-	0, 654, 0, 0, 761, 765, 0, 728, 0, 0,
-	0, 0, 0, 0, 0, 289, 290, 0, 0, 0,
-	0, 295, 0, 274, 0, 0, 262, 305, 183, 184,
-	372, 0, 365, 0, 0, 0, 426, 0, 0, 429,
-	84, 0, 66, 0, 59, 60, 308, 309, 28, 314,
-	403, 0, 414, 415, 416, 417, 418, 0, 0, 0,
-	0, 0, 464, 465, 466, 475, 871, 871, 871, 0,
-	571, 239, 339, 339, 244, 258, 204, 0, 170, 123,
-	0, 216, 134, 144, 271, 568, 335, 0, 0, 0,
-	777, 702, 0, 0, 0, 0, 0, 0, 691, 685,
-	686, 720, 0, 0, 0, 0, 0, 0, 0, 0,
-	781, 789, 785, 0, 782, 0, 0, 770, 0, 0,
-	705, 708, 0, 713, 0, 715, 0, 286, 287, 0,
-	0, 280, 282, 279, 284, 297, 585, 876, 873, 874,
-	192, 181, 0, 374, 68, 71, 72, 73, 432, 0,
-	433, 412, 65, 0, 0, 0, 405, 406, 0, 0,
-	0, 0, 0, 420, 421, 422, 423, 424, 0, 0,
-	872, 0, 0, 0, 572, 573, 575, 0, 242, 240,
-	339, 200, 205, 206, 0, 210, 0, 0, 136, 329,
-	0, 0, 351, 29, 346, 770, 687, 688, 689, 0,
-	856, 856, 672, 856, 856, 856, 858, 860, 858, 681,
-	681, 690, 692, 693, 696, 694, 697, 698, 684, 766,
-	783, 0, 790, 786, 655, 0, 0, 0, 0, 695,
-	291, 278, 373, 0, 436, 0, 0, 66, 0, 0,
-	407, 408, 409, 410, 411, 419, 0, 476, 477, 564,
-	565, 566, 478, -2, 0, -2, 863, 811, 812, 813,
-	856, 815, 856, 856, 856, 856, 842, 843, 844, 845,
-	846, 847, 848, 849, 850, 0, 0, 833, 856, 856,
-	856, 856, 853, 816, 817, 818, 819, 820, 821, 822,
-	823, 824, 825, 826, 827, 828, 829, 858, 860, 858,
-	243, 207, 208, 209, 0, 212, 213, 215, 0, 336,
-	338, 663, 670, 0, 671, 673, 674, 675, 676, 0,
-	677, 0, 678, 679, 682, 683, 680, 787, 664, 665,
-	709, 714, 375, 434, 435, 63, 67, 49, 0, 459,
-	856, 0, 484, -2, 521, 871, 871, 0, 871, 871,
-	871, 871, 0, 0, 871, 871, 871, 871, 871, 871,
-	871, 871, 871, 871, 871, 871, 871, 871, 574, 576,
-	-2, 588, 590, 0, 0, 593, 594, 0, 0, 0,
-	0, 629, 600, 0, 0, 801, 802, 0, 606, 866,
-	864, 865, 814, 838, 839, 840, 841, 0, 0, 834,
-	835, 836, 837, 830, 831, 832, 0, 214, 201, 0,
-	0, 0, 43, 0, 452, 0, 316, 0, 481, 0,
-	479, 523, 0, 0, 871, 871, 0, 0, 0, 0,
-	871, 871, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 589, 591, 592, 595,
-	596, 597, 634, 635, 636, 598, 631, 632, 633, 599,
-	0, 0, 799, 800, 627, 607, 810, 867, 0, 854,
-	0, 211, 857, 0, 861, 0, 41, 45, 50, 51,
-	0, 0, 0, 0, 451, 460, 461, 316, 517, 522,
-	524, 525, 0, 0, 528, 529, 530, 531, 0, 0,
-	534, 535, 536, 537, 538, 539, 540, 541, 542, 543,
-	555, 556, 557, 558, 559, 560, 544, 545, 546, 547,
-	548, 549, 552, 0, 0, 0, 622, 0, 851, 0,
-	852, 0, 0, 30, 0, 47, 0, 52, 0, 0,
-	0, 0, 453, 871, 0, 0, 457, 458, 462, 506,
-	0, 0, 512, 0, 518, 526, 527, 532, 533, 550,
-	0, 0, 630, 0, 609, 623, 0, 0, 855, 859,
-	862, 21, 0, 0, 44, 0, 0, 53, 57, 0,
-	55, 56, 0, 455, 0, 486, 0, 0, 0, 0,
-	0, 515, 0, 553, 554, 551, 601, 608, 610, 611,
-	612, 0, 624, 625, 626, 628, 20, 0, 31, 0,
-	33, 35, 36, 577, 42, 0, 46, 54, 454, 456,
-	488, 0, 507, 0, 0, 0, 0, 0, 0, 0,
-	602, 603, 0, 613, 0, 614, 0, 0, 0, 22,
-	23, 0, 32, 0, 0, 48, 485, 0, 517, 508,
-	0, 510, 0, 0, 0, 0, 604, 615, 617, 618,
-	0, 0, 616, 0, 0, 34, 578, 0, 490, 0,
-	504, 509, 511, 0, 516, 514, 619, 621, 620, 24,
-	25, 26, 0, 489, 0, 502, 487, 0, 513, 579,
-	491, -2, 0, 505, 492, -2, 0, 500, 0, 493,
-	501, 0, 496, 0, 0, 495, 0, -2, 0, 497,
-	-2, 0, 503,
+	24, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+	20, 21, 22, 23, 440, 441, 442, 0, 0, 298,
+	0, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 220, 221, 0, 201, 168, 169, 170, 125,
+	126, 127, 128, 0, 0, 0, 189, 0, 0, 0,
+	0, 0, 335, -2, 443, 444, 445, -2, 299, 300,
+	301, 302, 303, 217, 218, 219, -2, 0, 181, 0,
+	173, 173, 0, 345, 0, 356, 371, 24, 329, 0,
+	334, 618, 629, 630, 631, 1297, 1298, 1299, 1300, 1301,
+	1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311,
+	1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321,
+	1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331,
+	1332, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144,
+	1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154,
+	1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164,
+	1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174,
+	1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184,
+	1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194,
+	1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204,
+	1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214,
+	1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224,
+	1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234,
+	1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244,
+	1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254,
+	1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264,
+	1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274,
+	1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284,
+	1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294,
+	1295, 1296, 0, 210, 0, 0, 214, 0, 295, 206,
+	207, 208, 209, 0, 393, 394, 419, 422, 425, 0,
+	200, 0, 0, 86, 483, 88, 485, 0, 92, 94,
+	95, -2, 99, 100, 101, 102, 103, 104, 105, 0,
+	107, 1191, 109, 1253, 112, 113, 114, 0, 123, 124,
+	-2, -2, 480, 0, 0, 1242, 68, 0, 190, 191,
+	0, 0, 194, 0, 0, 235, 235, 235, 235, 235,
+	-2, 0, 0, 0, 361, 364, 367, 513, 513, 0,
+	513, 0, 491, 492, 493, 511, 512, 526, 0, 0,
+	0, 271, 272, 0, 288, 279, 288, 0, 263, 264,
+	265, 269, 270, 289, 0, 235, 182, 183, 172, 0,
+	177, 0, 171, 0, 0, 139, 0, 144, 0, 1190,
+	1257, 1206, 159, 160, 0, 1223, 0, 166, 977, 1148,
+	0, 340, 0, 346, 0, 345, 0, 372, 373, 374,
+	375, 3, 0, 0, 333, 0, 380, 211, 632, 0,
+	0, 216, 0, 0, 0, 0, 0, 0, 410, 0,
+	0, 409, 0, 0, 0, 0, 0, 423, 424, 426,
+	0, 428, 429, 435, 436, 437, 438, 439, 0, 345,
+	82, 0, 0, 0, 0, 0, 487, 93, 122, 96,
+	97, 0, 117, 119, 121, 120, 106, 118, 108, 110,
+	111, 116, 82, 0, 0, 0, 69, 188, 187, 616,
+	0, 0, 198, 199, 0, 0, 0, 0, 0, 0,
+	339, 0, 358, 360, 0, 362, 363, 365, 366, 368,
+	369, 0, 0, 0, 0, 0, 513, 0, 284, 285,
+	0, 279, 279, 273, 281, 0, 286, 287, 0, 380,
+	0, 0, 0, 513, 0, 0, 0, 0, 175, 0,
+	180, 129, 134, 132, 133, 135, 0, 0, 0, 0,
+	0, 164, 165, 0, 0, 0, 0, 0, 0, 153,
+	156, 610, 611, 612, 157, 158, 0, 978, 979, 337,
+	341, 357, 359, 354, 355, 388, 382, 384, 430, 34,
+	0, 881, 629, 885, 1298, 1299, 1300, 1301, 1302, 1303,
+	1304, 1306, -2, -2, 1310, 1311, -2, 1313, 1314, -2,
+	-2, -2, 1320, -2, -2, 1324, 1325, 1328, -2, -2,
+	1331, 1332, -2, -2, 894, 699, 700, 703, 704, 0,
+	0, 0, 0, 0, 711, 712, 0, 807, 0, 718,
+	719, 720, 721, 722, 44, 45, 910, 911, 912, 913,
+	914, 915, 916, 840, 686, 0, 825, 803, 0, 835,
+	853, 854, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 46, 47, 831, 832, 833,
+	834, 836, 837, 838, 839, 841, 842, 843, 844, 845,
+	846, 847, 848, 849, 850, 851, 852, 855, 857, 827,
+	828, 829, 830, 819, 820, 821, 822, 823, 824, 310,
+	0, 312, 0, 0, 619, 345, 0, 0, 212, 0,
+	296, 380, 203, 0, 413, 407, 0, 398, 411, 412,
+	401, 0, 403, 0, 405, 0, 399, 400, 420, 427,
+	421, 0, 83, 84, 85, 87, 98, 0, 0, 76,
+	468, 474, 471, 481, 484, 0, 90, 486, 115, 0,
+	71, 0, 0, 0, 192, 193, 195, 196, 304, 236,
+	305, 0, 307, 308, 433, 434, 342, 34, 347, 348,
+	351, 455, 0, 482, 506, -2, 0, 261, 380, 380,
+	380, 279, 0, 281, 0, 281, 276, 280, 0, 290,
+	292, 0, 222, 223, 224, 0, 0, 0, 455, 1285,
+	184, 185, 0, 0, 179, 0, 0, 136, 137, 138,
+	145, 140, 142, 0, 0, 146, 161, 162, 163, 327,
+	328, 0, 0, 0, 150, 151, 0, 0, 167, 380,
+	0, 389, 0, 385, 0, 0, 0, 431, 0, 0,
+	880, 0, 0, 899, 900, 901, 902, 903, 904, 873,
+	860, 860, 860, 0, 860, 0, 0, 789, 0, 860,
+	860, 860, 782, 860, 860, 790, 0, 860, 860, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, -2, 875,
+	0, 707, 708, 709, 710, 713, 0, 808, 0, 767,
+	0, 0, 873, 792, 0, 793, 804, 0, 796, 797,
+	798, 873, 0, 873, 802, 0, 0, 860, 860, 0,
+	0, 0, 0, 0, 311, 320, 323, 0, 0, 316,
+	318, 0, 331, 340, 381, 633, 0, 984, -2, 986,
+	-2, -2, 988, 989, 990, 991, 992, 993, 994, 995,
+	996, 997, 998, 999, 1000, 1001, 1002, 1003, 1004, 1005,
+	1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015,
+	1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025,
+	1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035,
+	1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045,
+	1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055,
+	1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065,
+	1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075,
+	1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085,
+	1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095,
+	1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105,
+	1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115,
+	1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125,
+	1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135,
+	0, 215, 0, 345, 0, 0, 395, 414, 0, 0,
+	396, 0, 397, 402, 404, 406, 0, 77, 81, 0,
+	470, 0, 0, 473, 89, 0, 0, 0, 65, 617,
+	0, 0, 0, 0, 0, 0, 350, 352, 353, 447,
+	456, 0, 514, 0, 0, 510, -2, 517, 0, 523,
+	0, 262, 266, 267, 380, 282, 279, 283, 279, 281,
+	0, 291, 294, 0, 226, 0, 1235, 0, 228, 0,
+	1235, 0, 447, 0, 186, 174, 176, 0, 131, 0,
+	0, 0, 147, 148, 149, 0, 154, 155, 378, 383,
+	390, 391, 877, 878, 879, 432, 35, 386, 882, 0,
+	884, 0, 874, 875, 0, 861, 862, 0, 0, 0,
+	0, 0, 0, 0, 805, 0, 909, 0, 0, 0,
+	0, 0, 0, 0, 0, 687, 688, 689, 690, 691,
+	692, 693, 694, 695, 696, 697, 698, 886, 897, 898,
+	0, 0, 0, 0, 0, 895, 890, 0, 701, 702,
+	705, 0, 812, 809, 0, 0, 769, 859, 863, 864,
+	865, 866, 867, 868, 869, 870, 871, 0, 826, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 324, 325, 0, 0, 0, 0,
+	330, 0, 309, 0, 0, 297, 340, 204, 205, 415,
+	0, 408, 0, 0, 0, 469, 0, 0, 472, 91,
+	0, 73, 0, 66, 67, 197, 306, 343, 344, 35,
+	349, 446, 0, 457, 458, 459, 460, 461, 0, 0,
+	0, 0, 0, 507, 508, 509, 518, 980, 980, 980,
+	0, 620, 274, 380, 380, 279, 293, 225, 0, 0,
+	227, 0, -2, 972, 918, 919, 920, 965, 922, 965,
+	965, 965, 965, 951, 952, 953, 954, 955, 956, 957,
+	958, 959, 0, 0, 942, 965, 965, 965, 965, 962,
+	923, 924, 925, 926, 927, 928, 929, 930, 931, 932,
+	933, 934, 935, 936, 967, 969, 969, 969, 967, 229,
+	232, 0, 237, 0, 178, 130, 0, 249, 141, 152,
+	376, 0, 0, 0, 883, 781, 0, 0, 0, 0,
+	0, 0, 746, 740, 741, 806, 345, 0, 0, 0,
+	0, 787, 0, 0, 0, 887, 895, 891, 0, 888,
+	0, 0, 876, 0, 810, 0, 0, 0, 0, 345,
+	0, 791, 794, 0, 799, 0, 801, 0, 0, 0,
+	0, 0, 762, 763, 764, 0, 0, 321, 322, 0,
+	0, 315, 317, 314, 319, 332, 634, 985, 982, 983,
+	213, 202, 0, 417, 75, 78, 79, 80, 475, 0,
+	476, 455, 72, 0, 0, 0, 448, 449, 0, 0,
+	0, 0, 0, 463, 464, 465, 466, 467, 0, 0,
+	981, 0, 0, 0, 621, 622, 624, 277, 275, 380,
+	0, 533, 0, 625, -2, 637, 639, 0, 0, 642,
+	643, 0, 0, 0, 0, 678, 649, 0, 0, 907,
+	908, 0, 655, 975, 973, 974, 921, 0, 947, 948,
+	949, 950, 0, 0, 943, 944, 945, 946, 937, 0,
+	938, 0, 939, 940, 941, 0, 233, 238, 239, 0,
+	243, 0, 0, 143, 370, 0, 0, 392, 36, 387,
+	876, 742, 743, 744, 0, 965, 965, 725, 726, 965,
+	965, 965, 967, 969, 967, 969, 736, 736, 745, 760,
+	747, 748, 751, 749, 754, 739, 872, 889, 0, 896,
+	892, 706, 714, 813, 0, 0, 0, 771, 0, 0,
+	0, 0, 0, 0, 750, 753, 765, 766, 326, 313,
+	416, 0, 479, 0, 0, 73, 0, 0, 450, 451,
+	452, 453, 454, 462, 0, 519, 520, 613, 614, 615,
+	521, -2, 0, 278, 231, 0, 545, 638, 640, 641,
+	644, 645, 646, 683, 684, 685, 647, 680, 681, 682,
+	648, 0, 0, 905, 906, 676, 656, 917, 976, 0,
+	0, 963, 0, 0, 0, 230, 240, 241, 242, 0,
+	245, 246, 248, 0, 377, 379, 715, 723, 724, 727,
+	728, 729, 730, 731, 732, 733, 734, 737, 738, 735,
+	0, 0, 893, 811, 716, 717, 0, 0, 774, 775,
+	770, 795, 800, 783, 0, 785, 0, 788, 418, 477,
+	478, 70, 74, 26, 0, 502, 965, 0, 527, -2,
+	570, 980, 980, 0, 980, 980, 980, 980, 0, 0,
+	980, 980, 980, 980, 980, 980, 980, 980, 980, 980,
+	980, 980, 980, 980, 623, 534, -2, 0, 0, 0,
+	671, 0, 966, 960, 0, 961, 0, 970, 0, 0,
+	247, 234, 752, 761, 768, 772, 0, 0, 1163, 0,
+	0, 0, 56, 0, 495, 0, 351, 0, 524, 0,
+	522, 572, 0, 0, 980, 980, 0, 0, 0, 0,
+	980, 980, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 535, -2, 0, 543,
+	0, 0, 679, 0, 658, 672, 0, 0, 964, 0,
+	0, 244, 0, 776, 777, 778, 779, 780, 784, 786,
+	50, 0, 27, 494, 503, 504, 351, 566, 571, 573,
+	574, 0, 0, 577, 578, 579, 580, 0, 0, 583,
+	584, 585, 586, 587, 588, 589, 590, 591, 592, 604,
+	605, 606, 607, 608, 609, 593, 594, 595, 596, 597,
+	598, 601, 0, 536, 544, 0, 539, 0, 0, 0,
+	650, 657, 659, 660, 661, 0, 673, 674, 675, 677,
+	968, 971, 0, 48, 52, 57, 58, 0, 0, 0,
+	0, 496, 980, 0, 0, 500, 501, 505, 555, 0,
+	0, 561, 0, 567, 575, 576, 581, 582, 599, 0,
+	0, 538, 0, -2, 546, 0, 0, 651, 652, 0,
+	662, 0, 663, 0, 0, 0, 773, 37, 0, 54,
+	0, 59, 0, 0, 0, 0, 0, 498, 0, 529,
+	0, 0, 0, 0, 0, 564, 0, 602, 603, 600,
+	540, -2, 0, 549, 551, 552, 548, 653, 664, 666,
+	667, 0, 0, 665, 28, 0, 0, 51, 0, 0,
+	60, 64, 0, 62, 63, 497, 499, 531, 0, 556,
+	0, 0, 0, 0, 0, 0, 0, 547, 0, 668,
+	670, 669, 25, 0, 38, 0, 40, 42, 43, 626,
+	49, 0, 53, 61, 528, 0, 566, 557, 0, 559,
+	0, 0, 0, 0, 550, 29, 30, 0, 39, 0,
+	0, 55, 0, 553, 558, 560, 0, 565, 563, 0,
+	0, 41, 627, 532, 530, 0, 562, 31, 32, 33,
+	0, 554, 628,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
-	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 412,
-	90, 89, 91, 3, 413, 3, 3, 3, 3, 3,
+	3, 3, 3, 85, 3, 3, 3, 115, 107, 3,
+	57, 59, 112, 110, 58, 111, 124, 113, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 431,
+	93, 92, 94, 3, 432, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 117, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 101, 3, 113,
+	3, 3, 3, 3, 106, 3, 118,
 }
 
 var yyTok2 = [...]int{
@@ -3887,12 +4463,12 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 57, 58, 59, 60, 61, 62, 63, 64,
+	52, 53, 54, 55, 56, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 103, 104, 109, 111, 114, 115, 116, 117,
-	118, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	86, 87, 88, 89, 90, 91, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 108, 109, 114,
+	116, 119, 120, 121, 122, 123, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -3938,7 +4514,11 @@ var yyTok3 = [...]int{
 	57720, 395, 57721, 396, 57722, 397, 57723, 398, 57724, 399,
 	57725, 400, 57726, 401, 57727, 402, 57728, 403, 57729, 404,
 	57730, 405, 57731, 406, 57732, 407, 57733, 408, 57734, 409,
-	57735, 410, 57736, 411, 0,
+	57735, 410, 57736, 411, 57737, 412, 57738, 413, 57739, 414,
+	57740, 415, 57741, 416, 57742, 417, 57743, 418, 57744, 419,
+	57745, 420, 57746, 421, 57747, 422, 57748, 423, 57749, 424,
+	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
+	57755, 430, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -4288,7 +4868,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:451
+//line mysql_sql.y:490
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -4296,82 +4876,95 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:457
+//line mysql_sql.y:496
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 18:
+	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:479
+//line mysql_sql.y:523
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 19:
+	case 24:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:483
+//line mysql_sql.y:527
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 20:
-		yyDollar = yyS[yypt-14 : yypt+1]
+	case 25:
+		yyDollar = yyS[yypt-15 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:489
+//line mysql_sql.y:533
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
 				File:              yyDollar[5].str,
 				DuplicateHandling: yyDollar[6].duplicateKeyUnion(),
 				Table:             yyDollar[9].tableNameUnion(),
-				Fields:            yyDollar[10].fieldsUnion(),
-				Lines:             yyDollar[11].linesUnion(),
-				IgnoredLines:      uint64(yyDollar[12].int64ValUnion()),
-				ColumnList:        yyDollar[13].loadColumnsUnion(),
-				Assignments:       yyDollar[14].updateExprsUnion(),
+				FileFormat:        yyDollar[10].str,
+				Fields:            yyDollar[11].fieldsUnion(),
+				Lines:             yyDollar[12].linesUnion(),
+				IgnoredLines:      uint64(yyDollar[13].int64ValUnion()),
+				ColumnList:        yyDollar[14].loadColumnsUnion(),
+				Assignments:       yyDollar[15].updateExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 21:
+	case 26:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:549
+		{
+			yyVAL.str = ""
+		}
+	case 27:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:553
+		{
+			yyVAL.str = strings.ToLower(yyDollar[2].str)
+		}
+	case 28:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:504
+//line mysql_sql.y:558
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 22:
+	case 29:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:508
+//line mysql_sql.y:562
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:514
+//line mysql_sql.y:568
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:518
+//line mysql_sql.y:572
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:524
+//line mysql_sql.y:578
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4379,10 +4972,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 33:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:531
+//line mysql_sql.y:585
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4390,58 +4983,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:540
+//line mysql_sql.y:594
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 35:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:544
+//line mysql_sql.y:598
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 36:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:548
+//line mysql_sql.y:602
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 37:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:553
+//line mysql_sql.y:607
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 38:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:557
+//line mysql_sql.y:611
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:561
+//line mysql_sql.y:615
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:567
+//line mysql_sql.y:621
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4451,10 +5044,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:576
+//line mysql_sql.y:630
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4464,42 +5057,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:587
+//line mysql_sql.y:641
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:591
+//line mysql_sql.y:645
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:597
+//line mysql_sql.y:651
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:601
+//line mysql_sql.y:655
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:607
+//line mysql_sql.y:661
 		{
 			v := strings.ToLower(yyDollar[1].str)
 			isGlobal := false
@@ -4513,10 +5106,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:622
+//line mysql_sql.y:676
 		{
 			yyLOCAL = &tree.VarExpr{
 				Name:   yyDollar[1].str,
@@ -4525,34 +5118,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 48:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:631
+//line mysql_sql.y:685
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:635
+//line mysql_sql.y:689
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 50:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:640
+//line mysql_sql.y:694
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:644
+//line mysql_sql.y:698
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -4560,42 +5153,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 52:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:652
+//line mysql_sql.y:706
 		{
 			yyVAL.str = ""
 		}
-	case 46:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:656
+//line mysql_sql.y:710
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 47:
+	case 54:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:661
+//line mysql_sql.y:715
 		{
 			yyVAL.str = "\n"
 		}
-	case 48:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:665
+//line mysql_sql.y:719
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 49:
+	case 56:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:670
+//line mysql_sql.y:724
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 50:
+	case 57:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:674
+//line mysql_sql.y:728
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -4618,36 +5211,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:698
+//line mysql_sql.y:752
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 59:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:702
+//line mysql_sql.y:756
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:708
+//line mysql_sql.y:762
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 61:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:714
+//line mysql_sql.y:768
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -4660,10 +5253,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:726
+//line mysql_sql.y:780
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -4675,10 +5268,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:737
+//line mysql_sql.y:791
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -4690,50 +5283,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 65:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:754
+//line mysql_sql.y:808
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:758
+//line mysql_sql.y:812
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:762
+//line mysql_sql.y:816
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 68:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:767
+//line mysql_sql.y:821
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:771
+//line mysql_sql.y:825
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 70:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:777
+//line mysql_sql.y:831
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -4744,10 +5337,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:787
+//line mysql_sql.y:841
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -4756,10 +5349,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 72:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:795
+//line mysql_sql.y:849
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -4769,26 +5362,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 73:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:805
+//line mysql_sql.y:859
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:809
+//line mysql_sql.y:863
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 75:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:819
+//line mysql_sql.y:873
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -4799,10 +5392,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 76:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:829
+//line mysql_sql.y:883
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -4811,30 +5404,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:839
+//line mysql_sql.y:893
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:845
+//line mysql_sql.y:899
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:851
+//line mysql_sql.y:905
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -4842,10 +5435,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:858
+//line mysql_sql.y:912
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -4854,10 +5447,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:866
+//line mysql_sql.y:920
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -4865,58 +5458,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 82:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:874
+//line mysql_sql.y:928
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:878
+//line mysql_sql.y:932
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:882
+//line mysql_sql.y:936
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:886
+//line mysql_sql.y:940
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:892
+//line mysql_sql.y:946
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:896
+//line mysql_sql.y:950
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:902
+//line mysql_sql.y:956
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -4924,10 +5517,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 89:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:909
+//line mysql_sql.y:963
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -4935,418 +5528,418 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:918
+//line mysql_sql.y:972
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:922
+//line mysql_sql.y:976
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:928
+//line mysql_sql.y:982
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:932
+//line mysql_sql.y:986
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:936
+//line mysql_sql.y:990
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:940
+//line mysql_sql.y:994
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 96:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:944
+//line mysql_sql.y:998
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:948
+//line mysql_sql.y:1002
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:952
+//line mysql_sql.y:1006
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:956
+//line mysql_sql.y:1010
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:960
+//line mysql_sql.y:1014
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:964
+//line mysql_sql.y:1018
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:968
+//line mysql_sql.y:1022
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:972
+//line mysql_sql.y:1026
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:976
+//line mysql_sql.y:1030
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:980
+//line mysql_sql.y:1034
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:984
+//line mysql_sql.y:1038
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:988
+//line mysql_sql.y:1042
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:992
+//line mysql_sql.y:1046
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:996
+//line mysql_sql.y:1050
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1000
+//line mysql_sql.y:1054
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1004
+//line mysql_sql.y:1058
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1008
+//line mysql_sql.y:1062
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1012
+//line mysql_sql.y:1066
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1016
+//line mysql_sql.y:1070
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1020
+//line mysql_sql.y:1074
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1024
+//line mysql_sql.y:1078
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1028
+//line mysql_sql.y:1082
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1032
+//line mysql_sql.y:1086
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1036
+//line mysql_sql.y:1090
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1040
+//line mysql_sql.y:1094
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1044
+//line mysql_sql.y:1098
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1048
+//line mysql_sql.y:1102
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1052
+//line mysql_sql.y:1106
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1056
+//line mysql_sql.y:1110
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 122:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1068
+//line mysql_sql.y:1122
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 130:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1074
+//line mysql_sql.y:1128
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1082
+//line mysql_sql.y:1136
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1086
+//line mysql_sql.y:1140
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1090
+//line mysql_sql.y:1144
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1094
+//line mysql_sql.y:1148
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1098
+//line mysql_sql.y:1152
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1104
+//line mysql_sql.y:1158
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1108
+//line mysql_sql.y:1162
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1112
+//line mysql_sql.y:1166
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 139:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1118
+//line mysql_sql.y:1172
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 140:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1124
+//line mysql_sql.y:1178
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 141:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1128
+//line mysql_sql.y:1182
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1135
+//line mysql_sql.y:1189
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 137:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1141
+//line mysql_sql.y:1195
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1145
+//line mysql_sql.y:1199
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1151
+//line mysql_sql.y:1205
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5355,10 +5948,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1159
+//line mysql_sql.y:1213
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5368,10 +5961,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1168
+//line mysql_sql.y:1222
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5380,10 +5973,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 149:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1176
+//line mysql_sql.y:1230
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5392,10 +5985,21 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 150:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line mysql_sql.y:1238
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				Name:  yyDollar[1].str,
+				Value: yyDollar[3].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1184
+//line mysql_sql.y:1245
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5404,10 +6008,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 152:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1192
+//line mysql_sql.y:1253
 		{
 			v := strings.ToLower(yyDollar[1].str)
 			if v != "session" && v != "global" && v != "local" {
@@ -5426,10 +6030,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1210
+//line mysql_sql.y:1271
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5437,10 +6041,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 154:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1217
+//line mysql_sql.y:1278
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5448,10 +6052,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 155:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1224
+//line mysql_sql.y:1285
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -5460,10 +6064,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1232
+//line mysql_sql.y:1293
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5471,10 +6075,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1239
+//line mysql_sql.y:1300
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5482,10 +6086,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1246
+//line mysql_sql.y:1307
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5493,198 +6097,302 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1259
+//line mysql_sql.y:1320
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1263
+//line mysql_sql.y:1324
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1267
+//line mysql_sql.y:1328
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1273
+//line mysql_sql.y:1334
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 157:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1277
+//line mysql_sql.y:1338
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 159:
+	case 167:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1284
+//line mysql_sql.y:1345
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 163:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1295
+//line mysql_sql.y:1356
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1301
+//line mysql_sql.y:1362
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 173:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1306
+//line mysql_sql.y:1367
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 174:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1310
+//line mysql_sql.y:1371
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1314
+//line mysql_sql.y:1375
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1318
+//line mysql_sql.y:1379
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1322
+//line mysql_sql.y:1383
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 178:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1326
+//line mysql_sql.y:1387
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1330
+//line mysql_sql.y:1391
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1334
+//line mysql_sql.y:1395
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1340
+//line mysql_sql.y:1401
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1344
+//line mysql_sql.y:1405
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1348
+//line mysql_sql.y:1409
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1352
+//line mysql_sql.y:1413
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1357
+//line mysql_sql.y:1418
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 186:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1362
+//line mysql_sql.y:1423
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 187:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1429
+		{
+			yyLOCAL = tree.NewAnalyzeTable(yyDollar[3].tableNamesUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 188:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1435
+		{
+			yyLOCAL = tree.NewKill(yyDollar[2].killOptionUnion(), uint64(yyDollar[3].item.(int64)))
+		}
+		yyVAL.union = yyLOCAL
+	case 189:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.KillOption
+//line mysql_sql.y:1440
+		{
+			yyLOCAL = tree.KillConnection
+		}
+		yyVAL.union = yyLOCAL
+	case 190:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.KillOption
+//line mysql_sql.y:1444
+		{
+			yyLOCAL = tree.KillConnection
+		}
+		yyVAL.union = yyLOCAL
+	case 191:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.KillOption
+//line mysql_sql.y:1448
+		{
+			yyLOCAL = tree.KillQuery
+		}
+		yyVAL.union = yyLOCAL
+	case 192:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1454
+		{
+			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), tree.NewNumVal(constant.MakeString(yyDollar[4].str), yyDollar[4].str, false))
+		}
+		yyVAL.union = yyLOCAL
+	case 193:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1458
+		{
+			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1368
+//line mysql_sql.y:1464
+		{
+			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 195:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1468
+		{
+			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 196:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.VarExpr
+//line mysql_sql.y:1474
+		{
+			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 197:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.VarExpr
+//line mysql_sql.y:1478
+		{
+			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 198:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1484
+		{
+			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 199:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1488
+		{
+			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 200:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1494
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1372
+//line mysql_sql.y:1498
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 202:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1378
+//line mysql_sql.y:1504
 		{
 			yyLOCAL = &tree.Update{
 				Table:   yyDollar[2].tableExprUnion(),
@@ -5695,100 +6403,188 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1391
+//line mysql_sql.y:1517
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1395
+//line mysql_sql.y:1521
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 205:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1401
+//line mysql_sql.y:1527
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1410
+//line mysql_sql.y:1536
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1416
+//line mysql_sql.y:1542
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1421
+//line mysql_sql.y:1547
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 212:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1426
+//line mysql_sql.y:1552
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 213:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1430
+//line mysql_sql.y:1556
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1434
+//line mysql_sql.y:1560
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "row")
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 215:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1438
+//line mysql_sql.y:1564
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[5].statementUnion(), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 216:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1442
+//line mysql_sql.y:1568
 		{
 			yyLOCAL = tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "")
 		}
 		yyVAL.union = yyLOCAL
-	case 200:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 222:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1584
+		{
+			yyLOCAL = tree.NewAlterTable(*yyDollar[3].tableNameUnion(), yyDollar[4].alterTableOptionsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 223:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1588
+		{
+			yyLOCAL = tree.NewAlterTable(*yyDollar[3].tableNameUnion(), []tree.AlterTableOption{yyDollar[4].alterTableOptionUnion()})
+		}
+		yyVAL.union = yyLOCAL
+	case 224:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.AlterTableOption
+//line mysql_sql.y:1594
+		{
+			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 225:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.AlterTableOption
+//line mysql_sql.y:1598
+		{
+			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 226:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1604
+		{
+			yyLOCAL = tree.NewAlterOptionAddColumn(yyDollar[2].columnTableDefUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 227:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1608
+		{
+			yyLOCAL = tree.NewAlterOptionAddColumn(yyDollar[3].columnTableDefUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 228:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1612
+		{
+			yyLOCAL = tree.NewAlterOptionDropColumn(tree.Identifier(yyDollar[2].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 229:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1616
+		{
+			yyLOCAL = tree.NewAlterOptionDropColumn(tree.Identifier(yyDollar[3].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 230:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1620
+		{
+			yyLOCAL = tree.NewAlterOptionRenameColumn(tree.Identifier(yyDollar[3].str), tree.Identifier(yyDollar[5].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 231:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1626
+		{
+			yyLOCAL = tree.NewAlterOptionAddPartition(yyDollar[4].partitionsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 232:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1630
+		{
+			yyLOCAL = tree.NewAlterOptionDropPartition(yyDollar[3].identifierListUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 233:
+		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1457
+//line mysql_sql.y:1636
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -5800,10 +6596,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 234:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1468
+//line mysql_sql.y:1647
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -5816,118 +6612,126 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 235:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1481
+//line mysql_sql.y:1660
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 236:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1485
+//line mysql_sql.y:1664
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 237:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1490
+//line mysql_sql.y:1669
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1494
+//line mysql_sql.y:1673
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 206:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1500
+//line mysql_sql.y:1679
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1504
+//line mysql_sql.y:1683
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 208:
+	case 241:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1510
+//line mysql_sql.y:1689
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 209:
+	case 242:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1514
+//line mysql_sql.y:1693
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1518
+//line mysql_sql.y:1697
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 244:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1522
+//line mysql_sql.y:1701
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1526
+//line mysql_sql.y:1705
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 246:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1530
+//line mysql_sql.y:1709
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1536
+//line mysql_sql.y:1715
 		{
 			yyVAL.item = nil
 		}
-	case 215:
+	case 248:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1541
+//line mysql_sql.y:1720
 		{
 			yyVAL.item = nil
 		}
-	case 227:
+	case 261:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1742
+		{
+			yyLOCAL = tree.NewShowStats(*yyDollar[4].tableNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 262:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1562
+//line mysql_sql.y:1748
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -5935,10 +6739,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 266:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1576
+//line mysql_sql.y:1762
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -5947,10 +6751,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 267:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1586
+//line mysql_sql.y:1772
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -5959,58 +6763,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 233:
+	case 268:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1595
+//line mysql_sql.y:1781
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1599
+//line mysql_sql.y:1785
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1603
+//line mysql_sql.y:1789
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1609
+//line mysql_sql.y:1795
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1615
+//line mysql_sql.y:1801
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1621
+//line mysql_sql.y:1807
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 239:
+	case 274:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1627
+//line mysql_sql.y:1813
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -6021,10 +6825,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 240:
+	case 275:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1637
+//line mysql_sql.y:1823
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -6035,18 +6839,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1649
+//line mysql_sql.y:1835
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 277:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1655
+//line mysql_sql.y:1841
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -6059,10 +6863,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 278:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1667
+//line mysql_sql.y:1853
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -6075,118 +6879,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 279:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1680
+//line mysql_sql.y:1866
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1684
+//line mysql_sql.y:1870
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 281:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1689
+//line mysql_sql.y:1875
 		{
 			yyVAL.str = ""
 		}
-	case 247:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1693
+//line mysql_sql.y:1879
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 248:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1699
+//line mysql_sql.y:1885
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 288:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1712
+//line mysql_sql.y:1898
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1716
+//line mysql_sql.y:1902
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1722
+//line mysql_sql.y:1908
 		{
 			yyLOCAL = &tree.ShowCreate{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 291:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1726
+//line mysql_sql.y:1912
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1732
+//line mysql_sql.y:1918
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1736
+//line mysql_sql.y:1922
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[1].str, yyDollar[3].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1745
+//line mysql_sql.y:1931
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1749
+//line mysql_sql.y:1935
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[1].str, yyDollar[3].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 297:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1753
+//line mysql_sql.y:1939
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[1].str, yyDollar[3].str, yyDollar[5].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 304:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1769
+//line mysql_sql.y:1955
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6194,10 +6998,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 305:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1778
+//line mysql_sql.y:1964
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6205,10 +7009,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 306:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1787
+//line mysql_sql.y:1973
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -6217,26 +7021,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 307:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1797
+//line mysql_sql.y:1983
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 308:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1803
+//line mysql_sql.y:1989
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 309:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1809
+//line mysql_sql.y:1995
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -6246,10 +7050,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 310:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1824
+//line mysql_sql.y:2010
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -6257,10 +7061,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1833
+//line mysql_sql.y:2019
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6268,20 +7072,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1840
+//line mysql_sql.y:2026
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 278:
+	case 313:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1846
+//line mysql_sql.y:2032
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6290,10 +7094,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1854
+//line mysql_sql.y:2040
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6301,10 +7105,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 315:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1861
+//line mysql_sql.y:2047
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -6312,310 +7116,310 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1870
+//line mysql_sql.y:2056
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1874
+//line mysql_sql.y:2060
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1880
+//line mysql_sql.y:2066
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 284:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1884
+//line mysql_sql.y:2070
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 285:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:1890
+//line mysql_sql.y:2076
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:1894
+//line mysql_sql.y:2080
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 322:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:1900
+//line mysql_sql.y:2086
 		{
 			yyLOCAL = yyDollar[2].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 323:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:1905
+//line mysql_sql.y:2091
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:1912
+//line mysql_sql.y:2098
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:1916
+//line mysql_sql.y:2102
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1923
+//line mysql_sql.y:2109
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 329:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1928
+//line mysql_sql.y:2114
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 330:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1932
+//line mysql_sql.y:2118
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1938
+//line mysql_sql.y:2124
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1942
+//line mysql_sql.y:2128
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:1948
+//line mysql_sql.y:2134
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:1952
+//line mysql_sql.y:2138
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:1959
+//line mysql_sql.y:2145
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:1965
+//line mysql_sql.y:2151
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:1969
+//line mysql_sql.y:2155
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:1973
+//line mysql_sql.y:2159
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 340:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:1978
+//line mysql_sql.y:2164
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:1982
+//line mysql_sql.y:2168
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:1988
+//line mysql_sql.y:2174
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 343:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:1992
+//line mysql_sql.y:2178
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
+	case 344:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:1996
+//line mysql_sql.y:2182
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 345:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2001
+//line mysql_sql.y:2187
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 346:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2005
+//line mysql_sql.y:2191
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 347:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2011
+//line mysql_sql.y:2197
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2017
+//line mysql_sql.y:2203
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2021
+//line mysql_sql.y:2207
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2027
+//line mysql_sql.y:2213
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 351:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2032
+//line mysql_sql.y:2218
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2036
+//line mysql_sql.y:2222
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2040
+//line mysql_sql.y:2226
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2047
+//line mysql_sql.y:2233
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 355:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2051
+//line mysql_sql.y:2237
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2057
+//line mysql_sql.y:2243
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2061
+//line mysql_sql.y:2247
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -6626,10 +7430,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2071
+//line mysql_sql.y:2257
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -6640,10 +7444,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2081
+//line mysql_sql.y:2267
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -6654,10 +7458,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2091
+//line mysql_sql.y:2277
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -6668,10 +7472,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2103
+//line mysql_sql.y:2289
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -6680,10 +7484,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2111
+//line mysql_sql.y:2297
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -6692,10 +7496,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2119
+//line mysql_sql.y:2305
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -6704,10 +7508,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2129
+	case 364:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2313
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 365:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2321
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 366:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2329
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 367:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2337
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 368:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2345
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 369:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2353
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 370:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line mysql_sql.y:2363
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -6719,130 +7595,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 371:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2141
+//line mysql_sql.y:2375
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2145
+//line mysql_sql.y:2379
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2149
+//line mysql_sql.y:2383
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 376:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2158
+//line mysql_sql.y:2392
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2162
+//line mysql_sql.y:2396
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 378:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2167
+//line mysql_sql.y:2401
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2171
+//line mysql_sql.y:2405
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 380:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2176
+//line mysql_sql.y:2410
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2180
+//line mysql_sql.y:2414
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2186
+//line mysql_sql.y:2420
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 383:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2190
+//line mysql_sql.y:2424
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2196
+//line mysql_sql.y:2430
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 385:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2200
+//line mysql_sql.y:2434
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2204
+//line mysql_sql.y:2438
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 387:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2208
+//line mysql_sql.y:2442
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 388:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2214
+//line mysql_sql.y:2448
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -6851,44 +7727,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2222
+//line mysql_sql.y:2456
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 390:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2228
+//line mysql_sql.y:2462
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2236
+//line mysql_sql.y:2470
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2240
+//line mysql_sql.y:2474
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 395:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2250
+//line mysql_sql.y:2484
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -6898,10 +7774,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 396:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2259
+//line mysql_sql.y:2493
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -6911,10 +7787,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2269
+//line mysql_sql.y:2503
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -6924,10 +7800,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2278
+//line mysql_sql.y:2512
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -6936,146 +7812,161 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 399:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2288
+//line mysql_sql.y:2522
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 359:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2292
+//line mysql_sql.y:2526
 		{
-			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
+			switch yyDollar[2].str {
+			case tree.JOIN_TYPE_LEFT:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
-			} else {
+			case tree.JOIN_TYPE_RIGHT:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
+			default:
+				yyVAL.str = tree.JOIN_TYPE_NATURAL_FULL
 			}
 		}
-	case 360:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2302
+//line mysql_sql.y:2539
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 361:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2306
+//line mysql_sql.y:2543
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 362:
+	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2310
+//line mysql_sql.y:2547
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 363:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2314
+//line mysql_sql.y:2551
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 364:
+	case 405:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:2555
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 406:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line mysql_sql.y:2559
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 407:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2320
+//line mysql_sql.y:2565
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2324
+//line mysql_sql.y:2569
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2330
+//line mysql_sql.y:2575
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 367:
+	case 410:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2336
+//line mysql_sql.y:2581
 		{
 			yyVAL.str = ""
 		}
-	case 368:
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2340
+//line mysql_sql.y:2585
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 369:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2344
+//line mysql_sql.y:2589
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 370:
+	case 413:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2350
+//line mysql_sql.y:2595
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 414:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2354
+//line mysql_sql.y:2599
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2360
+//line mysql_sql.y:2605
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 416:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2364
+//line mysql_sql.y:2609
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 417:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2370
+//line mysql_sql.y:2615
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2374
+//line mysql_sql.y:2619
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 419:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2380
+//line mysql_sql.y:2625
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2384
+//line mysql_sql.y:2629
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -7085,28 +7976,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2396
+//line mysql_sql.y:2641
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 422:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2401
+//line mysql_sql.y:2646
 		{
 		}
-	case 380:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2402
+//line mysql_sql.y:2647
 		{
 		}
-	case 381:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2406
+//line mysql_sql.y:2651
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -7116,46 +8007,46 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 425:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2418
+//line mysql_sql.y:2664
 		{
 			yyVAL.str = ""
 		}
-	case 383:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2422
+//line mysql_sql.y:2668
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 384:
+	case 427:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2426
+//line mysql_sql.y:2672
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 387:
+	case 430:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2435
+//line mysql_sql.y:2681
 		{
 			yyVAL.str = ""
 		}
-	case 388:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2439
+//line mysql_sql.y:2685
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 389:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2443
+//line mysql_sql.y:2689
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 403:
+	case 446:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2472
+//line mysql_sql.y:2718
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -7165,189 +8056,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 447:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2482
+//line mysql_sql.y:2728
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 448:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2486
+//line mysql_sql.y:2732
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2492
+//line mysql_sql.y:2738
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2496
+//line mysql_sql.y:2742
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2502
+//line mysql_sql.y:2748
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 452:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2506
+//line mysql_sql.y:2752
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2510
+//line mysql_sql.y:2756
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 454:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2514
+//line mysql_sql.y:2760
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 455:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2520
+//line mysql_sql.y:2766
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2527
+//line mysql_sql.y:2773
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2532
+//line mysql_sql.y:2778
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2537
+//line mysql_sql.y:2783
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2542
+//line mysql_sql.y:2788
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2548
+//line mysql_sql.y:2794
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2552
+//line mysql_sql.y:2798
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 463:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2556
+//line mysql_sql.y:2802
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 464:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2562
+//line mysql_sql.y:2808
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 465:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2566
+//line mysql_sql.y:2812
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 466:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2570
+//line mysql_sql.y:2816
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 467:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2574
+//line mysql_sql.y:2820
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2580
+//line mysql_sql.y:2826
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 469:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2584
+//line mysql_sql.y:2830
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2590
+//line mysql_sql.y:2836
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -7359,42 +8250,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2603
+//line mysql_sql.y:2849
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2607
+//line mysql_sql.y:2853
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 473:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2611
+//line mysql_sql.y:2857
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 474:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2616
+//line mysql_sql.y:2862
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2620
+//line mysql_sql.y:2866
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -7402,20 +8293,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2627
+//line mysql_sql.y:2873
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 477:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2633
+//line mysql_sql.y:2879
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -7424,10 +8315,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 478:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2641
+//line mysql_sql.y:2887
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -7435,20 +8326,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 479:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2648
+//line mysql_sql.y:2894
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 482:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2660
+//line mysql_sql.y:2906
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -7456,82 +8347,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2669
+//line mysql_sql.y:2915
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2673
+//line mysql_sql.y:2919
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2679
+//line mysql_sql.y:2925
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2683
+//line mysql_sql.y:2929
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 487:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2687
+//line mysql_sql.y:2933
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 490:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2696
+//line mysql_sql.y:2942
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2700
+//line mysql_sql.y:2946
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2704
+//line mysql_sql.y:2950
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2708
+//line mysql_sql.y:2954
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 494:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2714
+//line mysql_sql.y:2960
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -7550,18 +8441,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 495:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2733
+//line mysql_sql.y:2979
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2737
+//line mysql_sql.y:2983
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -7582,115 +8473,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 497:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2759
+//line mysql_sql.y:3005
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2763
+//line mysql_sql.y:3009
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 499:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2767
+//line mysql_sql.y:3013
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2771
+//line mysql_sql.y:3017
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2775
+//line mysql_sql.y:3021
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:2781
+//line mysql_sql.y:3027
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 503:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:2785
+//line mysql_sql.y:3031
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:2791
+//line mysql_sql.y:3037
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 505:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:2796
+//line mysql_sql.y:3042
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 506:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:2801
+//line mysql_sql.y:3047
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:2805
+//line mysql_sql.y:3051
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 508:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:2809
+//line mysql_sql.y:3055
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 509:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:2813
+//line mysql_sql.y:3059
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 510:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2819
+//line mysql_sql.y:3065
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -7699,98 +8590,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 513:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2833
+//line mysql_sql.y:3079
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2837
+//line mysql_sql.y:3083
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 515:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:2842
+//line mysql_sql.y:3088
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:2846
+//line mysql_sql.y:3092
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:2852
+//line mysql_sql.y:3098
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:2856
+//line mysql_sql.y:3102
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 519:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:2862
+//line mysql_sql.y:3108
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 520:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:2866
+//line mysql_sql.y:3112
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 521:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:2870
+//line mysql_sql.y:3116
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 522:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2875
+//line mysql_sql.y:3121
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2879
+//line mysql_sql.y:3125
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 524:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2885
+//line mysql_sql.y:3131
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -7802,34 +8693,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 525:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2897
+//line mysql_sql.y:3143
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2901
+//line mysql_sql.y:3147
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:2906
+//line mysql_sql.y:3152
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 528:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:2910
+//line mysql_sql.y:3156
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -7839,18 +8730,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 529:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:2920
+//line mysql_sql.y:3166
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 530:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:2924
+//line mysql_sql.y:3170
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -7859,42 +8750,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 531:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:2933
+//line mysql_sql.y:3179
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 532:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:2937
+//line mysql_sql.y:3183
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:2943
+//line mysql_sql.y:3189
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 534:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:2947
+//line mysql_sql.y:3193
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:2953
+//line mysql_sql.y:3199
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -7904,10 +8795,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 536:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:2962
+//line mysql_sql.y:3208
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -7917,42 +8808,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 537:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:2972
+//line mysql_sql.y:3218
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 538:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:2976
+//line mysql_sql.y:3222
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 539:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:2982
+//line mysql_sql.y:3228
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 540:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:2986
+//line mysql_sql.y:3232
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 541:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:2992
+//line mysql_sql.y:3238
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -7960,10 +8851,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:2999
+//line mysql_sql.y:3245
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -7971,50 +8862,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3008
+//line mysql_sql.y:3254
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 544:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3012
+//line mysql_sql.y:3258
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 545:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3017
+//line mysql_sql.y:3263
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 546:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3267
+		{
+			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 547:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3021
+//line mysql_sql.y:3271
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 548:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3275
+		{
+			vl := make([]tree.Exprs, len(yyDollar[4].exprsUnion()))
+			for i, e := range yyDollar[4].exprsUnion() {
+				if t, ok := e.(*tree.Tuple); ok {
+					vl[i] = t.Exprs
+				} else {
+					vl[i] = tree.Exprs{e}
+				}
+			}
+			yyLOCAL = tree.NewValuesIn(vl)
+		}
+		yyVAL.union = yyLOCAL
+	case 549:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:3289
+		{
+			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 550:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:3293
+		{
+			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 552:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:3300
+		{
+			yyLOCAL = tree.NewMaxValue()
+		}
+		yyVAL.union = yyLOCAL
+	case 553:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3026
+//line mysql_sql.y:3305
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3030
+//line mysql_sql.y:3309
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8024,18 +8963,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 555:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3040
+//line mysql_sql.y:3319
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3044
+//line mysql_sql.y:3323
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8045,10 +8984,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 557:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3055
+//line mysql_sql.y:3334
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8057,10 +8996,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 558:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3063
+//line mysql_sql.y:3342
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8069,10 +9008,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 559:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3071
+//line mysql_sql.y:3350
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8081,10 +9020,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 560:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3079
+//line mysql_sql.y:3358
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8093,10 +9032,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 562:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3090
+//line mysql_sql.y:3369
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -8107,10 +9046,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 563:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3100
+//line mysql_sql.y:3379
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -8120,664 +9059,664 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 564:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3110
+//line mysql_sql.y:3389
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3114
+//line mysql_sql.y:3393
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 566:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3119
+//line mysql_sql.y:3398
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3123
+//line mysql_sql.y:3402
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 568:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3128
+//line mysql_sql.y:3407
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3132
+//line mysql_sql.y:3411
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3138
+//line mysql_sql.y:3417
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 571:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3142
+//line mysql_sql.y:3421
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3146
+//line mysql_sql.y:3425
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 573:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3152
+//line mysql_sql.y:3431
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 574:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3156
+//line mysql_sql.y:3435
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 575:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3160
+//line mysql_sql.y:3439
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 576:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3164
+//line mysql_sql.y:3443
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3168
+//line mysql_sql.y:3447
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3172
+//line mysql_sql.y:3451
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 579:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3176
+//line mysql_sql.y:3455
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3180
+//line mysql_sql.y:3459
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 581:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3184
+//line mysql_sql.y:3463
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 582:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3188
+//line mysql_sql.y:3467
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 583:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3192
+//line mysql_sql.y:3471
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 584:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3196
+//line mysql_sql.y:3475
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 585:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3200
+//line mysql_sql.y:3479
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3204
+//line mysql_sql.y:3483
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 587:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3208
+//line mysql_sql.y:3487
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3212
+//line mysql_sql.y:3491
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3216
+//line mysql_sql.y:3495
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 590:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3220
+//line mysql_sql.y:3499
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3224
+//line mysql_sql.y:3503
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3228
+//line mysql_sql.y:3507
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3232
+//line mysql_sql.y:3511
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 594:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3236
+//line mysql_sql.y:3515
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3240
+//line mysql_sql.y:3519
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 596:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3244
+//line mysql_sql.y:3523
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 597:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3248
+//line mysql_sql.y:3527
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3252
+//line mysql_sql.y:3531
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3256
+//line mysql_sql.y:3535
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 600:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3260
+//line mysql_sql.y:3539
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 601:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3266
+//line mysql_sql.y:3545
 		{
 			yyVAL.str = ""
 		}
-	case 553:
+	case 602:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3270
+//line mysql_sql.y:3549
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 554:
+	case 603:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3274
+//line mysql_sql.y:3553
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 555:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3280
+//line mysql_sql.y:3559
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3284
+//line mysql_sql.y:3563
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3288
+//line mysql_sql.y:3567
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3292
+//line mysql_sql.y:3571
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3296
+//line mysql_sql.y:3575
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3300
+//line mysql_sql.y:3579
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3316
+//line mysql_sql.y:3595
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3320
+//line mysql_sql.y:3599
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3329
+//line mysql_sql.y:3608
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3334
+//line mysql_sql.y:3613
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 620:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3340
+//line mysql_sql.y:3619
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3347
+//line mysql_sql.y:3626
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 623:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3351
+//line mysql_sql.y:3630
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3357
+//line mysql_sql.y:3636
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3363
+//line mysql_sql.y:3642
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3369
+//line mysql_sql.y:3648
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 627:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3373
+//line mysql_sql.y:3652
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 628:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3377
+//line mysql_sql.y:3656
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3388
+//line mysql_sql.y:3667
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3392
+//line mysql_sql.y:3671
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 634:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3396
+//line mysql_sql.y:3675
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 635:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3401
+//line mysql_sql.y:3680
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3405
+//line mysql_sql.y:3684
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3411
+//line mysql_sql.y:3690
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 638:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3415
+//line mysql_sql.y:3694
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3421
+//line mysql_sql.y:3700
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 640:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3425
+//line mysql_sql.y:3704
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3429
+//line mysql_sql.y:3708
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 642:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3433
+//line mysql_sql.y:3712
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3437
+//line mysql_sql.y:3716
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3441
+//line mysql_sql.y:3720
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false))
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3445
+//line mysql_sql.y:3724
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 646:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3449
+//line mysql_sql.y:3728
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 647:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3453
+//line mysql_sql.y:3732
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 648:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3457
+//line mysql_sql.y:3736
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3461
+//line mysql_sql.y:3740
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 650:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3465
+//line mysql_sql.y:3744
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 651:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3469
+//line mysql_sql.y:3748
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3479
+//line mysql_sql.y:3758
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 653:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3483
+//line mysql_sql.y:3762
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 654:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3488
+//line mysql_sql.y:3767
 		{
 			yyVAL.str = ""
 		}
-	case 606:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3492
+//line mysql_sql.y:3771
 		{
 			yyVAL.str = ""
 		}
-	case 607:
+	case 656:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3496
+//line mysql_sql.y:3775
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 608:
+	case 657:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:3502
+//line mysql_sql.y:3781
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -8788,10 +9727,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 658:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3513
+//line mysql_sql.y:3792
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -8799,10 +9738,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3523
+//line mysql_sql.y:3802
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -8810,10 +9749,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3530
+//line mysql_sql.y:3809
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -8821,10 +9760,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 662:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3537
+//line mysql_sql.y:3816
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -8832,10 +9771,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 663:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3544
+//line mysql_sql.y:3823
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -8843,355 +9782,392 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3553
+//line mysql_sql.y:3832
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3559
+//line mysql_sql.y:3838
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3565
+//line mysql_sql.y:3844
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3569
+//line mysql_sql.y:3848
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3573
+//line mysql_sql.y:3852
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3577
+//line mysql_sql.y:3856
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 670:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3581
+//line mysql_sql.y:3860
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 671:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:3586
+//line mysql_sql.y:3865
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 673:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:3593
+//line mysql_sql.y:3872
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:3597
+//line mysql_sql.y:3876
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 675:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:3601
+//line mysql_sql.y:3880
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 676:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3606
+//line mysql_sql.y:3885
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3610
+//line mysql_sql.y:3889
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 678:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3615
+//line mysql_sql.y:3894
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 679:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3619
+//line mysql_sql.y:3898
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:3635
+//line mysql_sql.y:3914
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3641
+//line mysql_sql.y:3920
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3645
+//line mysql_sql.y:3924
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3649
+//line mysql_sql.y:3928
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 690:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3653
+//line mysql_sql.y:3932
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 691:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3657
+//line mysql_sql.y:3936
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 692:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3661
+//line mysql_sql.y:3940
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 693:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3665
+//line mysql_sql.y:3944
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 694:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3669
+//line mysql_sql.y:3948
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3673
+//line mysql_sql.y:3952
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3677
+//line mysql_sql.y:3956
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 697:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3681
+//line mysql_sql.y:3960
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 698:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3685
+//line mysql_sql.y:3964
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3689
+//line mysql_sql.y:3968
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3695
+//line mysql_sql.y:3974
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 701:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:3978
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 702:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:3986
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			extract := &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)},
+			}
+			name = tree.SetUnresolvedName("json_unquote")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{extract},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3699
+//line mysql_sql.y:3999
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3703
+//line mysql_sql.y:4003
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3707
+//line mysql_sql.y:4007
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 706:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3711
+//line mysql_sql.y:4011
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3715
+//line mysql_sql.y:4015
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 708:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3719
+//line mysql_sql.y:4019
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 709:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3723
+//line mysql_sql.y:4023
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 710:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3727
+//line mysql_sql.y:4027
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3731
+//line mysql_sql.y:4031
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3735
+//line mysql_sql.y:4035
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3739
+//line mysql_sql.y:4039
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 714:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4044
+		{
+			yyLOCAL = tree.NewCaseExpr(yyDollar[2].exprUnion(), yyDollar[3].whensUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 715:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3744
+//line mysql_sql.y:4048
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 716:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3748
+//line mysql_sql.y:4052
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 717:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3752
+//line mysql_sql.y:4056
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
@@ -9201,42 +10177,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3761
+//line mysql_sql.y:4065
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3765
+//line mysql_sql.y:4069
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3769
+//line mysql_sql.y:4073
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 721:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3773
+//line mysql_sql.y:4077
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 722:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4081
+		{
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 723:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:3779
+//line mysql_sql.y:4087
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9250,10 +10234,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:3792
+//line mysql_sql.y:4100
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9267,10 +10251,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 725:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:4113
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.JsonFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_JSON),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:3805
+//line mysql_sql.y:4125
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9283,10 +10283,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:3817
+//line mysql_sql.y:4137
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9301,10 +10301,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:3831
+//line mysql_sql.y:4151
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9320,10 +10320,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 729:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:3846
+//line mysql_sql.y:4166
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9339,10 +10339,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:3861
+//line mysql_sql.y:4181
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9358,10 +10358,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 731:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:3876
+//line mysql_sql.y:4196
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9377,10 +10377,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:3891
+//line mysql_sql.y:4211
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9396,10 +10396,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 733:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:3906
+//line mysql_sql.y:4226
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.DecimalFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DECIMAL),
+					DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+					Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 734:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:4240
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9413,10 +10431,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 735:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:3919
+//line mysql_sql.y:4253
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9431,15 +10449,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 736:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3934
+//line mysql_sql.y:4268
 		{
 		}
-	case 684:
+	case 739:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:3940
+//line mysql_sql.y:4274
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9449,10 +10467,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 740:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:3949
+//line mysql_sql.y:4283
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9461,10 +10479,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 741:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:3957
+//line mysql_sql.y:4291
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9473,10 +10491,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 742:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:3965
+//line mysql_sql.y:4299
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9486,10 +10504,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 743:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:3974
+//line mysql_sql.y:4308
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9499,10 +10517,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 744:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:3983
+//line mysql_sql.y:4317
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9512,10 +10530,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 745:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:3992
+//line mysql_sql.y:4326
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9525,10 +10543,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 746:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4001
+//line mysql_sql.y:4335
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumVal(constant.MakeString("*"), "*", false)
@@ -9538,10 +10556,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 747:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4010
+//line mysql_sql.y:4344
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9551,10 +10569,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 748:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4019
+//line mysql_sql.y:4353
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9564,10 +10582,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 749:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4028
+//line mysql_sql.y:4362
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9577,10 +10595,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 750:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4037
+//line mysql_sql.y:4371
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9590,10 +10608,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 751:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4046
+//line mysql_sql.y:4380
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9603,10 +10621,25 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 752:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4389
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			sep := tree.NewNumVal(constant.MakeString(yyDollar[6].str), yyDollar[6].str, false)
+			yyLOCAL = &tree.FuncExpr{
+				Func:    tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:   append(yyDollar[4].exprsUnion(), sep),
+				Type:    yyDollar[3].funcTypeUnion(),
+				OrderBy: yyDollar[5].orderByUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 753:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4055
+//line mysql_sql.y:4400
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9616,10 +10649,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 754:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4064
+//line mysql_sql.y:4409
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9629,10 +10662,197 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 760:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:4428
+		{
+			yyVAL.str = ","
+		}
+	case 761:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:4432
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 762:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4438
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				WindowSpec: yyDollar[4].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 763:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4446
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				WindowSpec: yyDollar[4].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 764:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4454
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				WindowSpec: yyDollar[4].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 765:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4462
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 766:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4471
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 767:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4480
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[2].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 768:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:4487
+		{
+			yyLOCAL = tree.NewWindowSpec(yyDollar[3].exprsUnion(), yyDollar[4].orderByUnion(), yyDollar[5].frameClauseUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 769:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4492
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 770:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4496
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 771:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4501
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 772:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4505
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[2].frameBoundUnion(), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 773:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4509
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[3].frameBoundUnion(), yyDollar[5].frameBoundUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 774:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:4515
+		{
+			yyLOCAL = tree.FRAME_ROWS
+		}
+		yyVAL.union = yyLOCAL
+	case 775:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:4519
+		{
+			yyLOCAL = tree.FRAME_RANGE
+		}
+		yyVAL.union = yyLOCAL
+	case 776:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4525
+		{
+			yyLOCAL = tree.NewFrameBound(tree.UNBOUNDED_PRECEDING, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 777:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4529
+		{
+			yyLOCAL = tree.NewFrameBound(tree.UNBOUNDED_FOLLOWING, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 778:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4533
+		{
+			yyLOCAL = tree.NewFrameBound(tree.CURRENT_ROW, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 779:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4537
+		{
+			yyLOCAL = tree.NewFrameBound(tree.PRECEDING, yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 780:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4541
+		{
+			yyLOCAL = tree.NewFrameBound(tree.FOLLOWING, yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 781:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4080
+//line mysql_sql.y:4547
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9641,10 +10861,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 782:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4565
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			var es tree.Exprs = nil
+			if yyDollar[2].exprUnion() != nil {
+				es = append(es, yyDollar[2].exprUnion())
+			}
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: es,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 783:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4577
+		{
+			name := tree.SetUnresolvedName("substring")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 784:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4585
+		{
+			name := tree.SetUnresolvedName("substring")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 785:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4593
+		{
+			name := tree.SetUnresolvedName("substring")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 786:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4601
+		{
+			name := tree.SetUnresolvedName("substring")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 787:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4609
+		{
+			name := tree.SetUnresolvedName("trim")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 788:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4617
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4098
+//line mysql_sql.y:4625
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -9657,10 +10965,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 790:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4110
+//line mysql_sql.y:4637
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -9673,10 +10981,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 791:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4124
+//line mysql_sql.y:4651
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9685,10 +10993,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 792:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4132
+//line mysql_sql.y:4659
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -9696,10 +11004,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4139
+//line mysql_sql.y:4666
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -9712,10 +11020,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 794:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4151
+//line mysql_sql.y:4678
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -9724,10 +11032,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 795:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4159
+//line mysql_sql.y:4686
 		{
 			cn := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
 			es := yyDollar[3].exprsUnion()
@@ -9739,10 +11047,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4170
+//line mysql_sql.y:4697
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("date")
@@ -9752,10 +11060,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 797:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4179
+//line mysql_sql.y:4706
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("time")
@@ -9765,10 +11073,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4188
+//line mysql_sql.y:4715
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("timestamp")
@@ -9778,10 +11086,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 799:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4197
+//line mysql_sql.y:4724
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -9790,10 +11098,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 800:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4205
+//line mysql_sql.y:4732
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -9804,10 +11112,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 801:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4215
+//line mysql_sql.y:4742
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -9816,10 +11124,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 802:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4223
+//line mysql_sql.y:4750
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -9828,52 +11136,108 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 803:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4232
+//line mysql_sql.y:4759
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 804:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4236
+//line mysql_sql.y:4763
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 805:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4242
+//line mysql_sql.y:4769
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 806:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4246
+//line mysql_sql.y:4773
 		{
 			yyLOCAL = yyDollar[2].numValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 807:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4259
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4778
 		{
+			yyLOCAL = nil
 		}
-	case 728:
+		yyVAL.union = yyLOCAL
+	case 808:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4782
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 809:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.When
+//line mysql_sql.y:4788
+		{
+			yyLOCAL = []*tree.When{yyDollar[1].whenUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4261
+		var yyLOCAL []*tree.When
+//line mysql_sql.y:4792
 		{
+			yyLOCAL = append(yyDollar[1].whensUnion(), yyDollar[2].whenUnion())
 		}
-	case 761:
+		yyVAL.union = yyLOCAL
+	case 811:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.When
+//line mysql_sql.y:4798
+		{
+			yyLOCAL = tree.NewWhen(yyDollar[2].exprUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 812:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4803
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 813:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4807
+		{
+			yyLOCAL = yyDollar[2].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 825:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:4829
+		{
+		}
+	case 826:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:4831
+		{
+		}
+	case 859:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4301
+//line mysql_sql.y:4871
 		{
 			yyLOCAL = &tree.IntervalExpr{
 				Expr: yyDollar[2].exprUnion(),
@@ -9881,322 +11245,386 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 860:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4309
+//line mysql_sql.y:4879
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 861:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4313
+//line mysql_sql.y:4883
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4317
+//line mysql_sql.y:4887
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 863:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:4893
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MICROSECOND
+		}
+		yyVAL.union = yyLOCAL
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IntervalType
-//line mysql_sql.y:4323
+//line mysql_sql.y:4897
 		{
 			yyLOCAL = tree.INTERVAL_TYPE_SECOND
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 865:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:4901
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MINUTE
+		}
+		yyVAL.union = yyLOCAL
+	case 866:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:4905
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_HOUR
+		}
+		yyVAL.union = yyLOCAL
+	case 867:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:4909
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_DAY
+		}
+		yyVAL.union = yyLOCAL
+	case 868:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:4913
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_WEEK
+		}
+		yyVAL.union = yyLOCAL
+	case 869:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:4917
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MONTH
+		}
+		yyVAL.union = yyLOCAL
+	case 870:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:4921
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_QUARTER
+		}
+		yyVAL.union = yyLOCAL
+	case 871:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:4925
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_YEAR
+		}
+		yyVAL.union = yyLOCAL
+	case 872:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:4329
+//line mysql_sql.y:4931
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 873:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4334
+//line mysql_sql.y:4936
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 874:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4338
+//line mysql_sql.y:4940
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 875:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4344
+//line mysql_sql.y:4946
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 876:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4348
+//line mysql_sql.y:4950
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 877:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4355
+//line mysql_sql.y:4957
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 878:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4359
+//line mysql_sql.y:4961
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 879:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4363
+//line mysql_sql.y:4965
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 880:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4367
+//line mysql_sql.y:4969
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4371
+//line mysql_sql.y:4973
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 882:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4377
+//line mysql_sql.y:4979
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 883:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4381
+//line mysql_sql.y:4983
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 884:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4385
+//line mysql_sql.y:4987
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 886:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4392
+//line mysql_sql.y:4994
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 887:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4396
+//line mysql_sql.y:4998
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 888:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4400
+//line mysql_sql.y:5002
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 889:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4404
+//line mysql_sql.y:5006
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 890:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4408
+//line mysql_sql.y:5010
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 891:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4412
+//line mysql_sql.y:5014
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 892:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4416
+//line mysql_sql.y:5018
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 893:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4420
+//line mysql_sql.y:5022
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 895:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4426
+//line mysql_sql.y:5028
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 896:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4430
+//line mysql_sql.y:5032
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 897:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4436
+//line mysql_sql.y:5038
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 898:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4440
+//line mysql_sql.y:5042
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 899:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4447
+//line mysql_sql.y:5049
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 900:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4451
+//line mysql_sql.y:5053
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 901:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4455
+//line mysql_sql.y:5057
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4459
+//line mysql_sql.y:5061
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 903:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4463
+//line mysql_sql.y:5065
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 904:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4467
+//line mysql_sql.y:5069
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 905:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4474
+//line mysql_sql.y:5076
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 906:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4478
+//line mysql_sql.y:5080
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 907:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4482
+//line mysql_sql.y:5084
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 908:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4486
+//line mysql_sql.y:5088
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 909:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:4492
+//line mysql_sql.y:5094
 		{
 			ival, errStr := getInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -10210,87 +11638,100 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 910:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4507
+//line mysql_sql.y:5109
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 911:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4511
+//line mysql_sql.y:5113
 		{
 			ival := getUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 912:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4516
+//line mysql_sql.y:5118
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 913:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4521
+//line mysql_sql.y:5123
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(true), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 914:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4525
+//line mysql_sql.y:5127
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(false), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 915:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4529
+//line mysql_sql.y:5131
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeUnknown(), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 916:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5135
+		{
+			v, err := yylex.(*Lexer).param(yyDollar[1].str)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyLOCAL = v
+		}
+		yyVAL.union = yyLOCAL
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4539
+//line mysql_sql.y:5149
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 921:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4550
+//line mysql_sql.y:5160
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 922:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4555
+//line mysql_sql.y:5165
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 923:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4561
+//line mysql_sql.y:5171
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10303,10 +11744,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 924:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4573
+//line mysql_sql.y:5183
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10319,10 +11760,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 925:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4585
+//line mysql_sql.y:5195
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10335,10 +11776,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4597
+//line mysql_sql.y:5207
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10352,10 +11793,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 927:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4610
+//line mysql_sql.y:5220
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10369,10 +11810,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 928:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4623
+//line mysql_sql.y:5233
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10386,10 +11827,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 929:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4636
+//line mysql_sql.y:5246
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10403,10 +11844,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 930:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4649
+//line mysql_sql.y:5259
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10420,10 +11861,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 931:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4662
+//line mysql_sql.y:5272
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10437,10 +11878,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 932:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4675
+//line mysql_sql.y:5285
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10454,10 +11895,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 933:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4688
+//line mysql_sql.y:5298
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10471,10 +11912,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4701
+//line mysql_sql.y:5311
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10488,10 +11929,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4714
+//line mysql_sql.y:5324
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10505,10 +11946,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 936:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4727
+//line mysql_sql.y:5337
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10522,10 +11963,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 937:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4742
+//line mysql_sql.y:5352
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10541,10 +11982,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 938:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4757
+//line mysql_sql.y:5367
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10560,10 +12001,46 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 939:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:5382
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.DecimalFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DECIMAL),
+					DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+					Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 940:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:5396
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.DecimalFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DECIMAL),
+					DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+					Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 941:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4784
+//line mysql_sql.y:5410
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10579,10 +12056,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4801
+//line mysql_sql.y:5427
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10595,10 +12072,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 943:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4813
+//line mysql_sql.y:5439
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10614,10 +12091,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 944:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4828
+//line mysql_sql.y:5454
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10633,10 +12110,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 945:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4843
+//line mysql_sql.y:5469
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10652,10 +12129,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 946:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4858
+//line mysql_sql.y:5484
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10670,10 +12147,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 947:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4874
+//line mysql_sql.y:5500
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10687,10 +12164,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 948:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4887
+//line mysql_sql.y:5513
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10704,10 +12181,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 949:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4900
+//line mysql_sql.y:5526
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10721,10 +12198,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 950:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4913
+//line mysql_sql.y:5539
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10738,10 +12215,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 951:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4926
+//line mysql_sql.y:5552
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10754,10 +12231,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4938
+//line mysql_sql.y:5564
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10770,10 +12247,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 953:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4950
+//line mysql_sql.y:5576
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10786,10 +12263,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4962
+//line mysql_sql.y:5588
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10802,10 +12279,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4974
+//line mysql_sql.y:5600
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10818,10 +12295,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4986
+//line mysql_sql.y:5612
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10834,10 +12311,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4998
+//line mysql_sql.y:5624
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10850,10 +12327,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5010
+//line mysql_sql.y:5636
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10866,10 +12343,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5022
+//line mysql_sql.y:5648
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10882,10 +12359,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 960:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5034
+//line mysql_sql.y:5660
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10899,10 +12376,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 961:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5047
+//line mysql_sql.y:5673
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10916,10 +12393,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5062
+//line mysql_sql.y:5688
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10932,43 +12409,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5085
+//line mysql_sql.y:5711
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 964:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5090
+//line mysql_sql.y:5716
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 965:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5096
+//line mysql_sql.y:5722
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 966:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5100
+//line mysql_sql.y:5726
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 967:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5106
+//line mysql_sql.y:5732
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 0,
@@ -10976,10 +12453,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 968:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5113
+//line mysql_sql.y:5739
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -10987,10 +12464,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 969:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5122
+//line mysql_sql.y:5748
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 0,
@@ -10998,10 +12475,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 970:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5129
+//line mysql_sql.y:5755
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -11009,10 +12486,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 971:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5136
+//line mysql_sql.y:5762
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -11020,52 +12497,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 972:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5145
+//line mysql_sql.y:5771
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5149
+//line mysql_sql.y:5775
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5153
+//line mysql_sql.y:5779
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 975:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5159
+//line mysql_sql.y:5785
 		{
 		}
-	case 867:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5161
+//line mysql_sql.y:5787
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 980:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5171
+//line mysql_sql.y:5797
 		{
 			yyVAL.str = ""
 		}
-	case 872:
+	case 981:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5175
+//line mysql_sql.y:5801
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
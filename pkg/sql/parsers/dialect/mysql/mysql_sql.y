@@ -119,6 +119,12 @@ import (
     subquery *tree.Subquery
     intervalType tree.IntervalType
     funcExpr *tree.FuncExpr
+    windowSpec *tree.WindowSpec
+    frameClause *tree.FrameClause
+    frameBound *tree.FrameBound
+    frameType tree.FrameType
+    when *tree.When
+    whens []*tree.When
 
     roles []*tree.Role
     role *tree.Role
@@ -131,6 +137,8 @@ import (
     resourceOption tree.ResourceOption
     resourceOptions []tree.ResourceOption
     unresolvedObjectName *tree.UnresolvedObjectName
+    alterTableOption tree.AlterTableOption
+    alterTableOptions []tree.AlterTableOption
     lengthOpt int32
     unsignedOpt bool
     zeroFillOpt bool
@@ -142,22 +150,24 @@ import (
     strs []string
 
     duplicateKey tree.DuplicateKey
+    killOption tree.KillOption
     fields *tree.Fields
     fieldsList []*tree.Fields
     lines *tree.Lines
     varExpr *tree.VarExpr
+    varExprs []*tree.VarExpr
     loadColumn tree.LoadColumn
     loadColumns []tree.LoadColumn
 }
 
 %token LEX_ERROR
-%left <str> UNION
+%left <str> UNION EXCEPT INTERSECT
 %token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK KEYS
 %token <str> VALUES LAST_INSERT_ID
 %token <str> NEXT VALUE SHARE MODE
 %token <str> SQL_NO_CACHE SQL_CACHE
-%left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
+%left <str> JOIN STRAIGHT_JOIN LEFT RIGHT FULL INNER OUTER CROSS NATURAL USE FORCE
 %left <str> ON USING
 %left <str> SUBQUERY_AS_EXPR
 %left <str> '(' ',' ')'
@@ -174,6 +184,7 @@ import (
 %right <str> NOT '!'
 %left <str> BETWEEN CASE WHEN THEN ELSE END
 %left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN ASSIGNMENT
+%token <str> JSON_EXTRACT_OP JSON_UNQUOTE_EXTRACT_OP
 %left <str> '|'
 %left <str> '&'
 %left <str> SHIFT_LEFT SHIFT_RIGHT
@@ -240,11 +251,11 @@ import (
 %token <str> LOAD INFILE TERMINATED OPTIONALLY ENCLOSED ESCAPED STARTING LINES
 
 // Supported SHOW tokens
-%token <str> DATABASES TABLES EXTENDED FULL PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES
+%token <str> DATABASES TABLES EXTENDED PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
-%token <str> LOCAL EXCEPT
+%token <str> LOCAL
 
 // Functions
 %token <str> CURRENT_TIMESTAMP DATABASE
@@ -254,6 +265,10 @@ import (
 %token <str> SEPARATOR
 %token <str> CURRENT_DATE CURRENT_USER CURRENT_ROLE
 
+// Window
+%token <str> OVER ROWS ROW CURRENT PRECEDING FOLLOWING UNBOUNDED
+%token <str> ROW_NUMBER RANK DENSE_RANK LAG LEAD
+
 // Match
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
@@ -264,6 +279,15 @@ import (
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG
 
+// Kill
+%token <str> KILL
+
+// Prepared statement
+%token <str> PREPARE DEALLOCATE
+
+// Statistics
+%token <str> STATS
+
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <str> UNUSED
 
@@ -271,18 +295,23 @@ import (
 %type <statements> stmt_list
 %type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt
 %type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt
+%type <statement> alter_table_stmt
+%type <alterTableOption> alter_table_option alter_partition_option
+%type <alterTableOptions> alter_table_option_list
 %type <statement> drop_role_stmt drop_user_stmt
 %type <statement> create_user_stmt create_role_stmt
 %type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt
 %type <statement> show_tables_stmt show_process_stmt show_errors_stmt show_warnings_stmt
-%type <statement> show_variables_stmt show_status_stmt show_index_stmt
+%type <statement> show_variables_stmt show_status_stmt show_index_stmt show_stats_stmt
 %type <statement> alter_user_stmt update_stmt use_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
 %type <statement> revoke_stmt grant_stmt
-%type <statement> load_data_stmt
+%type <statement> load_data_stmt kill_stmt
+%type <statement> prepare_stmt execute_stmt deallocate_stmt analyze_stmt
+%type <killOption> kill_option_opt
 
 %type <select> select_stmt select_no_parens
 %type <selectStatement> simple_select select_with_parens simple_select_clause
@@ -329,7 +358,12 @@ import (
 %type <funcExpr> function_call_generic
 %type <funcExpr> function_call_keyword
 %type <funcExpr> function_call_nonkeyword
-%type <funcExpr> function_call_aggregate
+%type <funcExpr> function_call_aggregate function_call_window
+%type <windowSpec> window_spec
+%type <exprs> window_partition_by_opt partition_value_list
+%type <frameClause> window_frame_opt
+%type <frameBound> window_frame_bound
+%type <frameType> window_frame_type
 
 %type <unresolvedName> column_name column_name_unresolved
 %type <strs> enum_values
@@ -338,6 +372,7 @@ import (
 %type <str> reserved_keyword non_reserved_keyword
 %type <str> equal_opt reserved_sql_id reserved_table_id
 %type <str> as_name_opt as_opt_id table_id id_or_var name_string ident
+%type <str> load_format_opt
 %type <str> database_id table_alias explain_sym
 %type <unresolvedObjectName> unresolved_object_name table_column_name
 %type <unresolvedObjectName> table_name_unresolved
@@ -352,6 +387,9 @@ import (
 %type <expr> bit_expr interval_expr
 %type <expr> simple_expr
 %type <expr> expression like_escape_opt boolean_primary col_tuple
+%type <expr> case_expr_opt else_opt partition_value
+%type <when> when_clause
+%type <whens> when_clause_list
 %type <exprs> expression_list_opt
 %type <exprs> expression_list row_value
 %type <expr> datatime_precision_opt datatime_precision
@@ -420,8 +458,8 @@ import (
 %type <str> name_confict distinct_keyword
 %type <insert> insert_data
 %type <rowsExprs> values_list
-%type <str> name_datatime_precision braces_opt name_braces
-%type <str> std_dev_pop
+%type <str> name_datatime_precision braces_opt name_braces substr_name date_add_name
+%type <str> std_dev_pop var_pop separator_opt
 %type <expr> expr_or_default
 %type <exprs> data_values data_opt row_value
 
@@ -433,6 +471,7 @@ import (
 %type <lines> load_lines
 %type <int64Val> ignore_lines
 %type <varExpr> user_variable variable system_variable
+%type <varExprs> user_variable_list
 %type <loadColumn> columns_or_variable
 %type <loadColumns> columns_or_variable_list columns_or_variable_list_opt
 %type <unresolvedName> normal_ident
@@ -475,6 +514,11 @@ stmt:
 |   revoke_stmt
 |   grant_stmt
 |   load_data_stmt
+|   kill_stmt
+|   analyze_stmt
+|   prepare_stmt
+|   execute_stmt
+|   deallocate_stmt
 |   select_stmt
     {
         $$ = $1
@@ -485,21 +529,31 @@ stmt:
     }
 
 load_data_stmt:
-    LOAD DATA local_opt INFILE STRING duplicate_opt INTO TABLE table_name load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
+    LOAD DATA local_opt INFILE STRING duplicate_opt INTO TABLE table_name load_format_opt load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
     {
         $$ = &tree.Load{
             Local: $3,
             File: $5,
             DuplicateHandling: $6,
             Table: $9,
-            Fields: $10,
-            Lines: $11,
-            IgnoredLines: uint64($12),
-            ColumnList: $13,
-            Assignments: $14,
+            FileFormat: $10,
+            Fields: $11,
+            Lines: $12,
+            IgnoredLines: uint64($13),
+            ColumnList: $14,
+            Assignments: $15,
         }
     }
 
+load_format_opt:
+    {
+        $$ = ""
+    }
+|   FORMAT ident
+    {
+        $$ = strings.ToLower($2)
+    }
+
 load_set_spec_opt:
     {
         $$ = nil
@@ -1180,7 +1234,14 @@ var_assignment:
             Value: $4,
         }
     }
-|   id_prefix_at equal_or_assignment set_expr
+|   AT_ID equal_or_assignment set_expr
+    {
+        $$ = &tree.VarAssignmentExpr{
+            Name: $1,
+            Value: $3,
+        }
+    }
+|   AT_AT_ID equal_or_assignment set_expr
     {
         $$ = &tree.VarAssignmentExpr{
             System: true,
@@ -1363,6 +1424,71 @@ begin_stmt:
         $$ = &tree.BeginTransaction{}
     }
 
+analyze_stmt:
+    ANALYZE TABLE table_name_list
+    {
+        $$ = tree.NewAnalyzeTable($3)
+    }
+
+kill_stmt:
+    KILL kill_option_opt INTEGRAL
+    {
+        $$ = tree.NewKill($2, uint64($3.(int64)))
+    }
+
+kill_option_opt:
+    {
+        $$ = tree.KillConnection
+    }
+|   CONNECTION
+    {
+        $$ = tree.KillConnection
+    }
+|   QUERY
+    {
+        $$ = tree.KillQuery
+    }
+
+prepare_stmt:
+    PREPARE ident FROM STRING
+    {
+        $$ = tree.NewPrepareStmt(tree.Identifier($2), tree.NewNumVal(constant.MakeString($4), $4, false))
+    }
+|   PREPARE ident FROM user_variable
+    {
+        $$ = tree.NewPrepareStmt(tree.Identifier($2), $4)
+    }
+
+execute_stmt:
+    EXECUTE ident
+    {
+        $$ = tree.NewExecute(tree.Identifier($2), nil)
+    }
+|   EXECUTE ident USING user_variable_list
+    {
+        $$ = tree.NewExecute(tree.Identifier($2), $4)
+    }
+
+user_variable_list:
+    user_variable
+    {
+        $$ = []*tree.VarExpr{$1}
+    }
+|   user_variable_list ',' user_variable
+    {
+        $$ = append($1, $3)
+    }
+
+deallocate_stmt:
+    DEALLOCATE PREPARE ident
+    {
+        $$ = tree.NewDeallocate(tree.Identifier($3))
+    }
+|   DROP PREPARE ident
+    {
+        $$ = tree.NewDeallocate(tree.Identifier($3))
+    }
+
 use_stmt:
     USE ident
     {
@@ -1450,8 +1576,61 @@ explain_sym:
 
 alter_stmt:
     alter_user_stmt
+|   alter_table_stmt
 // |    alter_ddl_stmt
 
+alter_table_stmt:
+    ALTER TABLE table_name alter_table_option_list
+    {
+        $$ = tree.NewAlterTable(*$3, $4)
+    }
+|   ALTER TABLE table_name alter_partition_option
+    {
+        $$ = tree.NewAlterTable(*$3, []tree.AlterTableOption{$4})
+    }
+
+alter_table_option_list:
+    alter_table_option
+    {
+        $$ = []tree.AlterTableOption{$1}
+    }
+|   alter_table_option_list ',' alter_table_option
+    {
+        $$ = append($1, $3)
+    }
+
+alter_table_option:
+    ADD column_def
+    {
+        $$ = tree.NewAlterOptionAddColumn($2)
+    }
+|   ADD COLUMN column_def
+    {
+        $$ = tree.NewAlterOptionAddColumn($3)
+    }
+|   DROP ident
+    {
+        $$ = tree.NewAlterOptionDropColumn(tree.Identifier($2))
+    }
+|   DROP COLUMN ident
+    {
+        $$ = tree.NewAlterOptionDropColumn(tree.Identifier($3))
+    }
+|   RENAME COLUMN ident TO ident
+    {
+        $$ = tree.NewAlterOptionRenameColumn(tree.Identifier($3), tree.Identifier($5))
+    }
+
+alter_partition_option:
+    ADD PARTITION '(' partition_list ')'
+    {
+        $$ = tree.NewAlterOptionAddPartition($4)
+    }
+|   DROP PARTITION partition_id_list
+    {
+        $$ = tree.NewAlterOptionDropPartition($3)
+    }
+
 alter_user_stmt:
     ALTER USER exists_opt user_spec_list require_clause_opt conn_options pwd_or_lck_opt
     {
@@ -1556,6 +1735,13 @@ show_stmt:
 |   show_variables_stmt
 |   show_status_stmt
 |   show_index_stmt
+|   show_stats_stmt
+
+show_stats_stmt:
+    SHOW STATS from_or_in table_name
+    {
+        $$ = tree.NewShowStats(*$4)
+    }
 
 show_index_stmt:
     SHOW index_kwd from_or_in table_name where_expression_opt
@@ -2123,6 +2309,54 @@ union_op:
             Distinct: true,
         }
     }
+|   INTERSECT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   INTERSECT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   INTERSECT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: true,
+        }
+    }
+|   EXCEPT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   EXCEPT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   EXCEPT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: true,
+        }
+    }
 
 simple_select_clause:
     SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
@@ -2290,10 +2524,13 @@ natural_join:
     }
 |   NATURAL outer_join
     {
-        if $2 == tree.JOIN_TYPE_LEFT {
+        switch $2 {
+        case tree.JOIN_TYPE_LEFT:
             $$ = tree.JOIN_TYPE_NATURAL_LEFT
-        } else {
+        case tree.JOIN_TYPE_RIGHT:
             $$ = tree.JOIN_TYPE_NATURAL_RIGHT
+        default:
+            $$ = tree.JOIN_TYPE_NATURAL_FULL
         }
     }
 
@@ -2314,6 +2551,14 @@ outer_join:
     {
         $$ = tree.JOIN_TYPE_RIGHT
     }
+|   FULL JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
+|   FULL OUTER JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
 
 on_expression_opt:
     %prec JOIN
@@ -2415,6 +2660,7 @@ aliased_table_name:
 
 
 as_opt_id:
+    %prec JOIN
     {
         $$ = ""
     }
@@ -3017,10 +3263,43 @@ values_opt:
     {
         $$ = nil
     }
-|   VALUES LESS THAN '(' expression_list ')'
+|   VALUES LESS THAN MAXVALUE
+    {
+        $$ = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+    }
+|   VALUES LESS THAN '(' partition_value_list ')'
     {
         $$ = &tree.ValuesLessThan{ValueList: $5}
     }
+|   VALUES IN '(' expression_list ')'
+    {
+        vl := make([]tree.Exprs, len($4))
+        for i, e := range $4 {
+            if t, ok := e.(*tree.Tuple); ok {
+                vl[i] = t.Exprs
+            } else {
+                vl[i] = tree.Exprs{e}
+            }
+        }
+        $$ = tree.NewValuesIn(vl)
+    }
+
+partition_value_list:
+    partition_value
+    {
+        $$ = tree.Exprs{$1}
+    }
+|   partition_value_list ',' partition_value
+    {
+        $$ = append($1, $3)
+    }
+
+partition_value:
+    expression
+|   MAXVALUE
+    {
+        $$ = tree.NewMaxValue()
+    }
 
 sub_partition_num_opt:
     {
@@ -3695,6 +3974,27 @@ simple_expr:
     {
         $$ = $1
     }
+|   normal_ident JSON_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, tree.NewNumVal(constant.MakeString($3), $3, false)},
+        }
+    }
+|   normal_ident JSON_UNQUOTE_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        extract := &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, tree.NewNumVal(constant.MakeString($3), $3, false)},
+        }
+        name = tree.SetUnresolvedName("json_unquote")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{extract},
+        }
+    }
 |   variable
     {
         $$ = $1
@@ -3740,6 +4040,10 @@ simple_expr:
         $2.Exists = true
         $$ = $2
     }
+|   CASE case_expr_opt when_clause_list else_opt END
+    {
+        $$ = tree.NewCaseExpr($2, $3, $4)
+    }
 |   CAST '(' expression AS cast_type ')' 
     {
         $$ = tree.NewCastExpr($3, $5)
@@ -3773,6 +4077,10 @@ simple_expr:
     {
         $$ = $1
     }
+|   function_call_window
+    {
+        $$ = $1
+    }
 
 cast_type:
     BINARY length_opt
@@ -3801,6 +4109,18 @@ cast_type:
 	        },
         }
     }
+|   JSON
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		        Family: tree.JsonFamily,
+                FamilyString: $1,
+		        Locale: &locale,
+		        Oid:    uint32(defines.MYSQL_TYPE_JSON),
+	        },
+        }
+    }
 |   DATE
     {
         locale := ""
@@ -3902,6 +4222,20 @@ cast_type:
 	        },
         }
     }
+|   DECIMAL decimal_length_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		        Family: tree.DecimalFamily,
+                FamilyString: $1,
+		        Locale: &locale,
+		        Oid:    uint32(defines.MYSQL_TYPE_DECIMAL),
+                DisplayWith: $2.DisplayWith,
+                Precision: $2.Precision,
+	        },
+        }
+    }
 |   SIGNED integer_opt
     {
         locale := ""
@@ -4051,7 +4385,18 @@ function_call_aggregate:
             Type: $3,
         }
     }
-|   VAR_POP '(' func_type_opt expression ')'
+|   GROUP_CONCAT '(' func_type_opt expression_list order_by_opt separator_opt ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        sep := tree.NewNumVal(constant.MakeString($6), $6, false)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: append($4, sep),
+            Type: $3,
+            OrderBy: $5,
+        }
+    }
+|   var_pop '(' func_type_opt expression ')'
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         $$ = &tree.FuncExpr{
@@ -4075,6 +4420,128 @@ std_dev_pop:
 |   STDDEV
 |   STDDEV_POP
 
+var_pop:
+    VARIANCE
+|   VAR_POP
+
+separator_opt:
+    {
+        $$ = ","
+    }
+|   SEPARATOR STRING
+    {
+        $$ = $2
+    }
+
+function_call_window:
+    ROW_NUMBER '(' ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            WindowSpec: $4,
+        }
+    }
+|   RANK '(' ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            WindowSpec: $4,
+        }
+    }
+|   DENSE_RANK '(' ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            WindowSpec: $4,
+        }
+    }
+|   LAG '(' expression_list ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|   LEAD '(' expression_list ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|   function_call_aggregate window_spec
+    {
+        $1.WindowSpec = $2
+        $$ = $1
+    }
+
+window_spec:
+    OVER '(' window_partition_by_opt order_by_opt window_frame_opt ')'
+    {
+        $$ = tree.NewWindowSpec($3, $4, $5)
+    }
+
+window_partition_by_opt:
+    {
+        $$ = nil
+    }
+|   PARTITION BY expression_list
+    {
+        $$ = $3
+    }
+
+window_frame_opt:
+    {
+        $$ = nil
+    }
+|   window_frame_type window_frame_bound
+    {
+        $$ = tree.NewFrameClause($1, $2, nil)
+    }
+|   window_frame_type BETWEEN window_frame_bound AND window_frame_bound
+    {
+        $$ = tree.NewFrameClause($1, $3, $5)
+    }
+
+window_frame_type:
+    ROWS
+    {
+        $$ = tree.FRAME_ROWS
+    }
+|   RANGE
+    {
+        $$ = tree.FRAME_RANGE
+    }
+
+window_frame_bound:
+    UNBOUNDED PRECEDING
+    {
+        $$ = tree.NewFrameBound(tree.UNBOUNDED_PRECEDING, nil)
+    }
+|   UNBOUNDED FOLLOWING
+    {
+        $$ = tree.NewFrameBound(tree.UNBOUNDED_FOLLOWING, nil)
+    }
+|   CURRENT ROW
+    {
+        $$ = tree.NewFrameBound(tree.CURRENT_ROW, nil)
+    }
+|   bit_expr PRECEDING
+    {
+        $$ = tree.NewFrameBound(tree.PRECEDING, $1)
+    }
+|   bit_expr FOLLOWING
+    {
+        $$ = tree.NewFrameBound(tree.FOLLOWING, $1)
+    }
+
 function_call_generic:
     ID '(' expression_list_opt ')'
     {
@@ -4094,7 +4561,67 @@ function_call_generic:
 //     }
 
 function_call_nonkeyword:
-    CURTIME datatime_precision
+    NOW datatime_precision
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        var es tree.Exprs = nil
+        if $2 != nil {
+            es = append(es, $2)
+        }
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: es,
+        }
+    }
+|   substr_name '(' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName("substring")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+|   substr_name '(' expression ',' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName("substring")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5, $7},
+        }
+    }
+|   substr_name '(' expression FROM expression ')'
+    {
+        name := tree.SetUnresolvedName("substring")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+|   substr_name '(' expression FROM expression FOR expression ')'
+    {
+        name := tree.SetUnresolvedName("substring")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5, $7},
+        }
+    }
+|   TRIM '(' expression ')'
+    {
+        name := tree.SetUnresolvedName("trim")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3},
+        }
+    }
+|   date_add_name '(' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+|   CURTIME datatime_precision
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         var es tree.Exprs = nil
@@ -4247,6 +4774,49 @@ datatime_precision:
         $$ = $2
     }
 
+case_expr_opt:
+    {
+        $$ = nil
+    }
+|   expression
+    {
+        $$ = $1
+    }
+
+when_clause_list:
+    when_clause
+    {
+        $$ = []*tree.When{$1}
+    }
+|   when_clause_list when_clause
+    {
+        $$ = append($1, $2)
+    }
+
+when_clause:
+    WHEN expression THEN expression
+    {
+        $$ = tree.NewWhen($2, $4)
+    }
+
+else_opt:
+    {
+        $$ = nil
+    }
+|   ELSE expression
+    {
+        $$ = $2
+    }
+
+substr_name:
+    SUBSTRING
+|   SUBSTR
+|   MID
+
+date_add_name:
+    DATE_ADD
+|   DATE_SUB
+
 name_datatime_precision:
     CURRENT_TIME
 |   CURRENT_TIMESTAMP
@@ -4319,10 +4889,42 @@ func_type_opt:
     }
 
 interval_type:
-    SECOND
+    MICROSECOND
+    {
+        $$ = tree.INTERVAL_TYPE_MICROSECOND
+    }
+|   SECOND
     {
         $$ = tree.INTERVAL_TYPE_SECOND
     }
+|   MINUTE
+    {
+        $$ = tree.INTERVAL_TYPE_MINUTE
+    }
+|   HOUR
+    {
+        $$ = tree.INTERVAL_TYPE_HOUR
+    }
+|   DAY
+    {
+        $$ = tree.INTERVAL_TYPE_DAY
+    }
+|   WEEK
+    {
+        $$ = tree.INTERVAL_TYPE_WEEK
+    }
+|   MONTH
+    {
+        $$ = tree.INTERVAL_TYPE_MONTH
+    }
+|   QUARTER
+    {
+        $$ = tree.INTERVAL_TYPE_QUARTER
+    }
+|   YEAR
+    {
+        $$ = tree.INTERVAL_TYPE_YEAR
+    }
 
 tuple_expression:
     '(' expression_list ')'
@@ -4529,10 +5131,18 @@ literal:
     {
         $$ = tree.NewNumVal(constant.MakeUnknown(), "", false)
     }
+|   VALUE_ARG
+    {
+        v, err := yylex.(*Lexer).param($1)
+        if err != nil {
+            yylex.Error(err.Error())
+            return 1
+        }
+        $$ = v
+    }
 // |   HEX
 // |   BIT_LITERAL
 // |   HEXNUM
-// |   VALUE_ARG
 
 column_type:
     numeric_type unsigned_opt zero_fill_opt
@@ -4768,18 +5378,34 @@ decimal_type:
 	        },
         }
     }
-// |   DECIMAL decimal_length_opt
-//     {
-//         $$ = tree.TYPE_DOUBLE
-//         $$.InternalType.DisplayWith = $2.DisplayWith
-//         $$.InternalType.Precision = $2.Precision
-//     }
-// |   NUMERIC decimal_length_opt
-//     {
-//         $$ = tree.TYPE_DOUBLE
-//         $$.InternalType.DisplayWith = $2.DisplayWith
-//         $$.InternalType.Precision = $2.Precision
-//     }
+|   DECIMAL decimal_length_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		        Family: tree.DecimalFamily,
+                FamilyString: $1,
+		        Locale: &locale,
+		        Oid:    uint32(defines.MYSQL_TYPE_DECIMAL),
+                DisplayWith: $2.DisplayWith,
+                Precision: $2.Precision,
+	        },
+        }
+    }
+|   NUMERIC decimal_length_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		        Family: tree.DecimalFamily,
+                FamilyString: $1,
+		        Locale: &locale,
+		        Oid:    uint32(defines.MYSQL_TYPE_DECIMAL),
+                DisplayWith: $2.DisplayWith,
+                Precision: $2.Precision,
+	        },
+        }
+    }
 |   REAL float_length_opt
     {
         locale := ""
@@ -5232,6 +5858,7 @@ reserved_keyword:
 |   ELSE
 |   END
 |   ESCAPE
+|   EXCEPT
 |   EXISTS
 |   EXPLAIN
 |   FALSE
@@ -5251,6 +5878,7 @@ reserved_keyword:
 |   INNER
 |   INSERT
 |   INTERVAL
+|   INTERSECT
 |   INTO
 |   IS
 |   ISSUER
@@ -5277,6 +5905,7 @@ reserved_keyword:
 |   OR
 |   ORDER
 |   OUTER
+|   OVER
 |   REGEXP
 |   RENAME
 |   REPLACE
@@ -5301,6 +5930,7 @@ reserved_keyword:
 |   TRUNCATE
 |   TIME
 |   TIMESTAMP
+|   UNBOUNDED
 |   UNION
 |   UNIQUE
 |   UPDATE
@@ -5314,6 +5944,13 @@ reserved_keyword:
 |   WHERE
 |   WEEK
 |   QUARTER
+|   RANK
+|   DENSE_RANK
+|   LAG
+|   LEAD
+|   ROW
+|   ROWS
+|   ROW_NUMBER
 |   PASSWORD
 |   TERMINATED
 |   OPTIONALLY
@@ -5329,6 +5966,8 @@ reserved_keyword:
 
 non_reserved_keyword:
     AGAINST
+|   DEALLOCATE
+|   PREPARE
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
 |   ACTION
@@ -5353,6 +5992,7 @@ non_reserved_keyword:
 |   COMPACT
 |   COLUMN_FORMAT
 |   CASCADE
+|   CURRENT
 |   DATA
 |   DATETIME
 |   DECIMAL
@@ -5368,7 +6008,6 @@ non_reserved_keyword:
 |   EXPANSION
 |   EXTENDED
 |   EXPIRE
-|   EXCEPT
 |   ERRORS
 |   ENFORCED
 |   FLOAT_TYPE
@@ -5376,6 +6015,7 @@ non_reserved_keyword:
 |   FULLTEXT
 |   FULL
 |   FIXED
+|   FOLLOWING
 |   FIELDS
 |   GEOMETRY
 |   GEOMETRYCOLLECTION
@@ -5428,6 +6068,7 @@ non_reserved_keyword:
 |   PARTITIONS
 |   POINT
 |   POLYGON
+|   PRECEDING
 |   PRIMARY
 |   PROCEDURE
 |   PROXY
@@ -5454,6 +6095,7 @@ non_reserved_keyword:
 |   SPATIAL
 |   START
 |   STATUS
+|   STATS
 |   STORAGE
 |   STATS_AUTO_RECALC
 |   STATS_PERSISTENT
@@ -15,6 +15,7 @@
 package mysql
 
 import (
+	"go/constant"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -56,6 +57,41 @@ var (
 		output string
 	}{{
 		input: "create table td2 (a int default (((1 + 2 * 3 / 2 + (123)))), b int)",
+	}, {
+		input: "analyze table t1",
+	}, {
+		input: "analyze table t1, db.t2",
+	}, {
+		input: "show stats from t1",
+	}, {
+		input:  "show stats in db.t1",
+		output: "show stats from db.t1",
+	}, {
+		input: "select stats from t1",
+	}, {
+		input: "kill 12",
+	}, {
+		input:  "kill connection 12",
+		output: "kill 12",
+	}, {
+		input: "kill query 12",
+	}, {
+		input: "prepare s1 from 'select * from t where a = ?'",
+	}, {
+		input: "prepare s1 from @sql",
+	}, {
+		input: "execute s1",
+	}, {
+		input: "execute s1 using @a, @b",
+	}, {
+		input: "deallocate prepare s1",
+	}, {
+		input:  "drop prepare s1",
+		output: "deallocate prepare s1",
+	}, {
+		input: "select * from t where a = ? and b in (?, ?) limit ?",
+	}, {
+		input: "insert into t values (?, ?)",
 	}, {
 		input: `revoke super(a, b, c) 
 				on procedure db.func 
@@ -104,6 +140,12 @@ var (
 	}, {
 		input:  "load data infile 'data.txt' into table db.a",
 		output: "load data infile data.txt into table db.a",
+	}, {
+		input:  "load data infile 'data.parquet' into table db.a format PARQUET",
+		output: "load data infile data.parquet into table db.a format parquet",
+	}, {
+		input:  "load data local infile 'data.json' ignore into table a format jsonlines ignore 1 lines",
+		output: "load data local infile data.json ignore into table a format jsonlines ignore 1 lines",
 	}, {
 		input:  "show tables from test01 where tables_in_test01 like '%t2%'",
 		output: "show tables from test01 where tables_in_test01 like %t2%",
@@ -274,6 +316,16 @@ var (
 		input: "create table A (a int) partition by range columns (a, b, db.t.c)",
 	}, {
 		input: "create table A (a int) partition by range(1 + 21)",
+	}, {
+		input:  "create table A (a int) partition by range(a) (partition p0 values less than (10), partition p1 values less than maxvalue)",
+		output: "create table A (a int) partition by range(a) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
+	}, {
+		input: "create table A (a int, b int) partition by range columns (a, b) (partition p0 values less than (10, maxvalue), partition p1 values less than (maxvalue, maxvalue))",
+	}, {
+		input:  "create table A (a int, b varchar(10)) partition by list columns (a, b) (partition p0 values in ((1, 'x'), (2, 'y')), partition p1 values in ((3, null)))",
+		output: "create table A (a int, b varchar(10)) partition by list columns (a, b) (partition p0 values in ((1, x), (2, y)), partition p1 values in ((3, null)))",
+	}, {
+		input: "create table A (a int) partition by list(a) (partition p0 values in (1, 3, 5), partition p1 values in (2))",
 	}, {
 		input: "create table A (a int storage disk constraint cx check (b + c) enforced)",
 	}, {
@@ -451,6 +503,23 @@ var (
 		input: "drop role r1",
 	}, {
 		input: "drop role if exists r1, r2, r3",
+	}, {
+		input:  "alter table t add c int default 7",
+		output: "alter table t add column c int default 7",
+	}, {
+		input: "alter table db.t add column c varchar(10) not null, drop column b",
+	}, {
+		input:  "alter table t drop b",
+		output: "alter table t drop column b",
+	}, {
+		input: "alter table t rename column a to b",
+	}, {
+		input: "alter table t add partition (partition p2 values less than (30), partition p3 values less than (maxvalue))",
+	}, {
+		input: "alter table t drop partition p0, p1",
+	}, {
+		input:  "alter table t drop partition",
+		output: "alter table t drop column partition",
 	}, {
 		input: "drop index if exists idx1 on db.t",
 	}, {
@@ -540,6 +609,10 @@ var (
 		input: "create table db.t (db.t.a int(20) null)",
 	}, {
 		input: "create table t (a float(20, 20) not null, b int(20) null, c int(30) null)",
+	}, {
+		input: "create table t (a decimal(10, 2) not null, b numeric(20, 4), c decimal(30))",
+	}, {
+		input: "select cast(a as decimal(10, 2)) from t1",
 	}, {
 		input: "create table t1 (t time(3) null, dt datetime(6) null, ts timestamp(1) null)",
 	}, {
@@ -564,6 +637,48 @@ var (
 		input: "select * from t union all select c from t1",
 	}, {
 		input: "select * from t union distinct select c from t1",
+	}, {
+		input: "select a from t intersect select c from t1",
+	}, {
+		input: "select case when a > 1 then 1 when a < 0 then -1 else 0 end from t",
+	}, {
+		input: "select case a when 1 then b end, if(a > 1, b, c), coalesce(a, b, 0) from t",
+	}, {
+		input: "select count(distinct a), stddev_samp(b), variance(b), bit_xor(c), any_value(d) from t group by e",
+	}, {
+		input:  "select group_concat(a) from t",
+		output: "select group_concat(a separator ',') from t",
+	}, {
+		input: "select group_concat(distinct a, b order by c desc, a separator ';') from t",
+	}, {
+		input: "select a, row_number() over (partition by b order by c desc) from t",
+	}, {
+		input: "select rank() over (order by a), dense_rank() over (order by a) from t",
+	}, {
+		input: "select lag(a) over (order by b), lead(a, 2, 0) over (partition by c order by b) from t",
+	}, {
+		input: "select sum(a) over (partition by b order by c rows between unbounded preceding and current row) from t",
+	}, {
+		input: "select avg(a) over (order by b range between 1 preceding and 1 following), count(*) over () from t",
+	}, {
+		input: "select max(a) over (order by b rows 2 preceding) from t",
+	}, {
+		input:  "select min(a) over (rows between current row and unbounded following) m from t",
+		output: "select min(a) over (rows between current row and unbounded following) as m from t",
+	}, {
+		input:  "select a->'$.b[0]', t.a->>\"$.c\" from t where a -> '$.d' > 1",
+		output: "select json_extract(a, $.b[0]), json_unquote(json_extract(t.a, $.c)) from t where json_extract(a, $.d) > 1",
+	}, {
+		input:  "select cast(a as json), json_contains(a, '1', '$.b') from t",
+		output: "select cast(a as json), json_contains(a, 1, $.b) from t",
+	}, {
+		input: "create table t (a int, b json)",
+	}, {
+		input: "select a from t intersect all select c from t1",
+	}, {
+		input: "select a from t except select c from t1",
+	}, {
+		input: "select a from t except distinct select c from t1 union select d from t2",
 	}, {
 		input: "select * from (select a from t) as t1",
 	}, {
@@ -583,6 +698,21 @@ var (
 		output: "select * from t1 right join t2 on 123",
 	}, {
 		input: "select * from t1 natural left join t2",
+	}, {
+		input:  "select * from t1 left outer join t2 on t1.a = t2.a",
+		output: "select * from t1 left join t2 on t1.a = t2.a",
+	}, {
+		input: "select * from t1 full join t2 on t1.a = t2.a",
+	}, {
+		input:  "select * from t1 full outer join t2 on t1.a = t2.a",
+		output: "select * from t1 full join t2 on t1.a = t2.a",
+	}, {
+		input: "select substring(a, 2, 3), trim(a), now() from t1",
+	}, {
+		input:  "select substr(a from 2 for 3), mid(a, 1) from t1",
+		output: "select substring(a, 2, 3), substring(a, 1) from t1",
+	}, {
+		input: "select date_add(a, interval 1 day), date_sub(a, interval 2 month) from t1",
 	}, {
 		input:  "select 1",
 		output: "select 1 from dual",
@@ -645,3 +775,35 @@ func TestMulti(t *testing.T) {
 		}
 	}
 }
+
+func TestParams(t *testing.T) {
+	sql := "select * from t where a = ? and b = ?"
+	ast, n, err := Prepare(sql)
+	if err != nil {
+		t.Fatalf("Prepare(%q) err: %v", sql, err)
+	}
+	if n != 2 {
+		t.Errorf("Prepare(%q) got %v placeholders", sql, n)
+	}
+	if out := tree.String(ast, dialect.MYSQL); out != sql {
+		t.Errorf("Parsing failed. \nExpected/Got:\n%s\n%s", sql, out)
+	}
+
+	params := []tree.Expr{
+		tree.NewNumVal(constant.MakeInt64(1), "1", false),
+		tree.NewNumVal(constant.MakeString("x"), "x", false),
+	}
+	asts, err := ParseWithParams(sql, params)
+	if err != nil {
+		t.Fatalf("ParseWithParams(%q) err: %v", sql, err)
+	}
+	if out := tree.String(asts[0], dialect.MYSQL); out != "select * from t where a = 1 and b = x" {
+		t.Errorf("Binding failed, got: %s", out)
+	}
+	if _, err = ParseWithParams(sql, params[:1]); err == nil {
+		t.Errorf("ParseWithParams(%q) with 1 value should fail", sql)
+	}
+	if _, err = ParseWithParams(sql, append(params, params[0])); err == nil {
+		t.Errorf("ParseWithParams(%q) with 3 values should fail", sql)
+	}
+}
@@ -171,10 +171,11 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 			}
 		case '>':
 			s.skip(1)
-			// TODO:
-			// JSON_UNQUOTE_EXTRACT_OP
-			// JSON_EXTRACT_OP
-			return 0, ""
+			if s.cur() == '>' {
+				s.skip(1)
+				return JSON_UNQUOTE_EXTRACT_OP, ""
+			}
+			return JSON_EXTRACT_OP, ""
 		}
 		return int(ch), ""
 	case '<':
@@ -47,6 +47,7 @@ func initTokens(dialectType dialect.DialectType) {
 		EXTRACT = MYSQL_EXTRACT
 		MAX = MYSQL_MAX
 		MID = MYSQL_MID
+		MIN = MYSQL_MIN
 		NOW = MYSQL_NOW
 		POSITION = MYSQL_POSITION
 		SESSION_USER = MYSQL_SESSION_USER
@@ -139,6 +140,7 @@ func initTokens(dialectType dialect.DialectType) {
 		SHUTDOWN = MYSQL_SHUTDOWN
 		REVOKE = MYSQL_REVOKE
 		EXCEPT = MYSQL_EXCEPT
+		INTERSECT = MYSQL_INTERSECT
 		LOCAL = MYSQL_LOCAL
 		ASSIGNMENT = MYSQL_ASSIGNMENT
 		NO = MYSQL_NO
@@ -260,6 +262,8 @@ func initTokens(dialectType dialect.DialectType) {
 		GE = MYSQL_GE
 		NE = MYSQL_NE
 		NULL_SAFE_EQUAL = MYSQL_NULL_SAFE_EQUAL
+		JSON_EXTRACT_OP = MYSQL_JSON_EXTRACT_OP
+		JSON_UNQUOTE_EXTRACT_OP = MYSQL_JSON_UNQUOTE_EXTRACT_OP
 		IS = MYSQL_IS
 		LIKE = MYSQL_LIKE
 		REGEXP = MYSQL_REGEXP
@@ -397,6 +401,18 @@ func initTokens(dialectType dialect.DialectType) {
 		SUBSTRING = MYSQL_SUBSTRING
 		GROUP_CONCAT = MYSQL_GROUP_CONCAT
 		SEPARATOR = MYSQL_SEPARATOR
+		OVER = MYSQL_OVER
+		ROWS = MYSQL_ROWS
+		ROW = MYSQL_ROW
+		CURRENT = MYSQL_CURRENT
+		PRECEDING = MYSQL_PRECEDING
+		FOLLOWING = MYSQL_FOLLOWING
+		UNBOUNDED = MYSQL_UNBOUNDED
+		ROW_NUMBER = MYSQL_ROW_NUMBER
+		RANK = MYSQL_RANK
+		DENSE_RANK = MYSQL_DENSE_RANK
+		LAG = MYSQL_LAG
+		LEAD = MYSQL_LEAD
 		MATCH = MYSQL_MATCH
 		AGAINST = MYSQL_AGAINST
 		BOOLEAN = MYSQL_BOOLEAN
@@ -404,6 +420,10 @@ func initTokens(dialectType dialect.DialectType) {
 		WITH = MYSQL_WITH
 		QUERY = MYSQL_QUERY
 		EXPANSION = MYSQL_EXPANSION
+		KILL = MYSQL_KILL
+		PREPARE = MYSQL_PREPARE
+		DEALLOCATE = MYSQL_DEALLOCATE
+		STATS = MYSQL_STATS
 		UNUSED = MYSQL_UNUSED
 
 	case dialect.POSTGRESQL:
@@ -488,6 +508,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"sysdate":                  SYSDATE,
 		"create":                   CREATE,
 		"cross":                    CROSS,
+		"current":                  CURRENT,
 		"current_date":             CURRENT_DATE,
 		"current_time":             CURRENT_TIME,
 		"current_timestamp":        CURRENT_TIMESTAMP,
@@ -505,12 +526,14 @@ func initTokens(dialectType dialect.DialectType) {
 		"date":                     DATE,
 		"data":                     DATA,
 		"datetime":                 DATETIME,
+		"deallocate":               DEALLOCATE,
 		"dec":                      UNUSED,
 		"decimal":                  DECIMAL,
 		"declare":                  UNUSED,
 		"default":                  DEFAULT,
 		"delayed":                  UNUSED,
 		"delete":                   DELETE,
+		"dense_rank":               DENSE_RANK,
 		"desc":                     DESC,
 		"describe":                 DESCRIBE,
 		"deterministic":            UNUSED,
@@ -549,6 +572,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"float":                    FLOAT_TYPE,
 		"float4":                   UNUSED,
 		"float8":                   UNUSED,
+		"following":                FOLLOWING,
 		"for":                      FOR,
 		"force":                    FORCE,
 		"foreign":                  FOREIGN,
@@ -593,6 +617,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"int4":                     INT4,
 		"int8":                     INT8,
 		"integer":                  INTEGER,
+		"intersect":                INTERSECT,
 		"interval":                 INTERVAL,
 		"into":                     INTO,
 		"invisible":                INVISIBLE,
@@ -606,9 +631,11 @@ func initTokens(dialectType dialect.DialectType) {
 		"key":                      KEY,
 		"keys":                     KEYS,
 		"key_block_size":           KEY_BLOCK_SIZE,
-		"kill":                     UNUSED,
+		"kill":                     KILL,
+		"lag":                      LAG,
 		"language":                 LANGUAGE,
 		"last_insert_id":           LAST_INSERT_ID,
+		"lead":                     LEAD,
 		"leading":                  UNUSED,
 		"leave":                    UNUSED,
 		"left":                     LEFT,
@@ -679,6 +706,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"out":                      UNUSED,
 		"outer":                    OUTER,
 		"outfile":                  UNUSED,
+		"over":                     OVER,
 		"parser":                   PARSER,
 		"partition":                PARTITION,
 		"partitions":               PARTITIONS,
@@ -687,7 +715,9 @@ func initTokens(dialectType dialect.DialectType) {
 		"pack_keys":                PACK_KEYS,
 		"point":                    POINT,
 		"polygon":                  POLYGON,
+		"preceding":                PRECEDING,
 		"precision":                UNUSED,
+		"prepare":                  PREPARE,
 		"primary":                  PRIMARY,
 		"processlist":              PROCESSLIST,
 		"procedure":                PROCEDURE,
@@ -696,6 +726,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"query":                    QUERY,
 		"quarter":                  QUARTER,
 		"range":                    RANGE,
+		"rank":                     RANK,
 		"read":                     READ,
 		"reads":                    UNUSED,
 		"redundant":                REDUNDANT,
@@ -723,7 +754,10 @@ func initTokens(dialectType dialect.DialectType) {
 		"rollback":                 ROLLBACK,
 		"role":                     ROLE,
 		"routine":                  ROUTINE,
+		"row":                      ROW,
 		"row_format":               ROW_FORMAT,
+		"row_number":               ROW_NUMBER,
+		"rows":                     ROWS,
 		"row_count":                ROW_COUNT,
 		"rtree":                    RTREE,
 		"schema":                   SCHEMA,
@@ -759,6 +793,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"start":                    START,
 		"starting":                 STARTING,
 		"status":                   STATUS,
+		"stats":                    STATS,
 		"stats_auto_recalc":        STATS_AUTO_RECALC,
 		"stats_persistent":         STATS_PERSISTENT,
 		"stats_sample_pages":       STATS_SAMPLE_PAGES,
@@ -786,6 +821,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"trigger":                  TRIGGER,
 		"true":                     TRUE,
 		"truncate":                 TRUNCATE,
+		"unbounded":                UNBOUNDED,
 		"uncommitted":              UNCOMMITTED,
 		"undo":                     UNUSED,
 		"union":                    UNION,
@@ -832,6 +868,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"extract":                  EXTRACT,
 		"max":                      MAX,
 		"mid":                      MID,
+		"min":                      MIN,
 		"now":                      NOW,
 		"position":                 POSITION,
 		"session_user":             SESSION_USER,
@@ -955,6 +992,7 @@ var (
 	USAGE                    int
 	REVOKE                   int
 	EXCEPT                   int
+	INTERSECT                int
 	LOCAL                    int
 	ASSIGNMENT               int
 	RELEASE                  int
@@ -1076,6 +1114,8 @@ var (
 	GE                       int
 	NE                       int
 	NULL_SAFE_EQUAL          int
+	JSON_EXTRACT_OP          int
+	JSON_UNQUOTE_EXTRACT_OP  int
 	IS                       int
 	LIKE                     int
 	REGEXP                   int
@@ -1213,6 +1253,18 @@ var (
 	SUBSTRING                int
 	GROUP_CONCAT             int
 	SEPARATOR                int
+	OVER                     int
+	ROWS                     int
+	ROW                      int
+	CURRENT                  int
+	PRECEDING                int
+	FOLLOWING                int
+	UNBOUNDED                int
+	ROW_NUMBER               int
+	RANK                     int
+	DENSE_RANK               int
+	LAG                      int
+	LEAD                     int
 	MATCH                    int
 	AGAINST                  int
 	BOOLEAN                  int
@@ -1220,6 +1272,10 @@ var (
 	WITH                     int
 	QUERY                    int
 	EXPANSION                int
+	KILL                     int
+	PREPARE                  int
+	DEALLOCATE               int
+	STATS                    int
 	CURRENT_USER             int
 	CURRENT_ROLE             int
 	MONTH                    int
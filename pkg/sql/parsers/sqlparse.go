@@ -44,3 +44,23 @@ func ParseOne(dialectType dialect.DialectType, sql string) (tree.Statement, erro
 		return nil, errors.New("type of dialect error")
 	}
 }
+
+// ParseWithParams parses the sql of a prepared statement with the values of its placeholders.
+func ParseWithParams(dialectType dialect.DialectType, sql string, params []tree.Expr) ([]tree.Statement, error) {
+	switch dialectType {
+	case dialect.MYSQL:
+		return mysql.ParseWithParams(sql, params)
+	default:
+		return nil, errors.New("type of dialect error")
+	}
+}
+
+// Prepare parses the sql of a prepared statement, it returns the number of placeholders as well.
+func Prepare(dialectType dialect.DialectType, sql string) (tree.Statement, int, error) {
+	switch dialectType {
+	case dialect.MYSQL:
+		return mysql.Prepare(sql)
+	default:
+		return nil, 0, errors.New("type of dialect error")
+	}
+}
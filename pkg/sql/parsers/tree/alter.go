@@ -82,3 +82,132 @@ func NewAlterUser(ife bool, iuf bool, uf *User, u []*User, r []*Role, t []TlsOpt
 		MiscOpts:   m,
 	}
 }
+
+//ALTER TABLE statement
+type AlterTable struct {
+	statementImpl
+	Table   TableName
+	Options []AlterTableOption
+}
+
+func (node *AlterTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter table ")
+	node.Table.Format(ctx)
+	prefix := " "
+	for _, opt := range node.Options {
+		ctx.WriteString(prefix)
+		opt.Format(ctx)
+		prefix = ", "
+	}
+}
+
+func NewAlterTable(t TableName, o []AlterTableOption) *AlterTable {
+	return &AlterTable{
+		Table:   t,
+		Options: o,
+	}
+}
+
+//the option of ALTER TABLE
+type AlterTableOption interface {
+	NodeFormatter
+}
+
+type alterTableOptionImpl struct {
+	AlterTableOption
+}
+
+//ADD [COLUMN] column_definition
+type AlterOptionAddColumn struct {
+	alterTableOptionImpl
+	Column *ColumnTableDef
+}
+
+func (node *AlterOptionAddColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("add column ")
+	node.Column.Format(ctx)
+}
+
+func NewAlterOptionAddColumn(c *ColumnTableDef) *AlterOptionAddColumn {
+	return &AlterOptionAddColumn{
+		Column: c,
+	}
+}
+
+//DROP [COLUMN] column_name
+type AlterOptionDropColumn struct {
+	alterTableOptionImpl
+	Name Identifier
+}
+
+func (node *AlterOptionDropColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop column ")
+	ctx.WriteString(string(node.Name))
+}
+
+func NewAlterOptionDropColumn(n Identifier) *AlterOptionDropColumn {
+	return &AlterOptionDropColumn{
+		Name: n,
+	}
+}
+
+//RENAME COLUMN old_name TO new_name
+type AlterOptionRenameColumn struct {
+	alterTableOptionImpl
+	OldName Identifier
+	NewName Identifier
+}
+
+func (node *AlterOptionRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	ctx.WriteString(string(node.OldName))
+	ctx.WriteString(" to ")
+	ctx.WriteString(string(node.NewName))
+}
+
+func NewAlterOptionRenameColumn(o, n Identifier) *AlterOptionRenameColumn {
+	return &AlterOptionRenameColumn{
+		OldName: o,
+		NewName: n,
+	}
+}
+
+//ADD PARTITION (partition_definition, ...)
+type AlterOptionAddPartition struct {
+	alterTableOptionImpl
+	Partitions []*Partition
+}
+
+func (node *AlterOptionAddPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("add partition (")
+	for i, p := range node.Partitions {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		p.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+func NewAlterOptionAddPartition(p []*Partition) *AlterOptionAddPartition {
+	return &AlterOptionAddPartition{
+		Partitions: p,
+	}
+}
+
+//DROP PARTITION partition_names
+type AlterOptionDropPartition struct {
+	alterTableOptionImpl
+	Names IdentifierList
+}
+
+func (node *AlterOptionDropPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop partition ")
+	node.Names.Format(ctx)
+}
+
+func NewAlterOptionDropPartition(n IdentifierList) *AlterOptionDropPartition {
+	return &AlterOptionDropPartition{
+		Names: n,
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// ANALYZE TABLE statement
+type AnalyzeTable struct {
+	statementImpl
+	Tables TableNames
+}
+
+func (node *AnalyzeTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("analyze table ")
+	node.Tables.Format(ctx)
+}
+
+func NewAnalyzeTable(ts TableNames) *AnalyzeTable {
+	return &AnalyzeTable{Tables: ts}
+}
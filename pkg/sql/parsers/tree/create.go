@@ -1449,6 +1449,23 @@ type ValuesIn struct {
 	ValueList []Exprs
 }
 
+func (node *ValuesIn) Format(ctx *FmtCtx) {
+	ctx.WriteString("values in (")
+	for i, vl := range node.ValueList {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		if len(vl) == 1 {
+			vl.Format(ctx)
+			continue
+		}
+		ctx.WriteByte('(')
+		vl.Format(ctx)
+		ctx.WriteByte(')')
+	}
+	ctx.WriteByte(')')
+}
+
 func NewValuesIn(vl []Exprs) *ValuesIn {
 	return &ValuesIn{
 		ValueList: vl,
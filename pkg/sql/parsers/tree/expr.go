@@ -528,6 +528,9 @@ type FuncExpr struct {
 
 	//aggregations which specify an order.
 	OrderBy OrderBy
+
+	//the window specification of a window function call.
+	WindowSpec *WindowSpec
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -538,8 +541,27 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 		ctx.WriteString(node.Type.ToString())
 		ctx.WriteByte(' ')
 	}
-	node.Exprs.Format(ctx)
+	exprs := node.Exprs
+	var sep Expr
+	// the separator of group_concat is kept as its last argument
+	if name, ok := node.Func.FunctionReference.(*UnresolvedName); ok && name.Parts[0] == "group_concat" && len(exprs) > 1 {
+		exprs, sep = exprs[:len(exprs)-1], exprs[len(exprs)-1]
+	}
+	exprs.Format(ctx)
+	if len(node.OrderBy) > 0 {
+		ctx.WriteByte(' ')
+		node.OrderBy.Format(ctx)
+	}
+	if sep != nil {
+		ctx.WriteString(" separator '")
+		sep.Format(ctx)
+		ctx.WriteByte('\'')
+	}
 	ctx.WriteByte(')')
+	if node.WindowSpec != nil {
+		ctx.WriteByte(' ')
+		node.WindowSpec.Format(ctx)
+	}
 }
 
 func NewFuncExpr(ft FuncType, name *UnresolvedName, e Exprs, order OrderBy) *FuncExpr {
@@ -552,6 +574,129 @@ func NewFuncExpr(ft FuncType, name *UnresolvedName, e Exprs, order OrderBy) *Fun
 	}
 }
 
+//the OVER clause of a window function call.
+type WindowSpec struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+func (node *WindowSpec) Format(ctx *FmtCtx) {
+	ctx.WriteString("over (")
+	prefix := ""
+	if len(node.PartitionBy) > 0 {
+		ctx.WriteString("partition by ")
+		node.PartitionBy.Format(ctx)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		ctx.WriteString(prefix)
+		node.OrderBy.Format(ctx)
+		prefix = " "
+	}
+	if node.Frame != nil {
+		ctx.WriteString(prefix)
+		node.Frame.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+func NewWindowSpec(ps Exprs, order OrderBy, f *FrameClause) *WindowSpec {
+	return &WindowSpec{
+		PartitionBy: ps,
+		OrderBy:     order,
+		Frame:       f,
+	}
+}
+
+// FrameType specifies the unit of a window frame.
+type FrameType int
+
+const (
+	FRAME_ROWS FrameType = iota
+	FRAME_RANGE
+)
+
+func (t FrameType) ToString() string {
+	switch t {
+	case FRAME_ROWS:
+		return "rows"
+	case FRAME_RANGE:
+		return "range"
+	default:
+		return "Unknown FrameType"
+	}
+}
+
+//the frame of a window, the end is nil when only the start is given.
+type FrameClause struct {
+	Type  FrameType
+	Start *FrameBound
+	End   *FrameBound
+}
+
+func (node *FrameClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Type.ToString())
+	ctx.WriteByte(' ')
+	if node.End == nil {
+		node.Start.Format(ctx)
+		return
+	}
+	ctx.WriteString("between ")
+	node.Start.Format(ctx)
+	ctx.WriteString(" and ")
+	node.End.Format(ctx)
+}
+
+func NewFrameClause(t FrameType, start, end *FrameBound) *FrameClause {
+	return &FrameClause{
+		Type:  t,
+		Start: start,
+		End:   end,
+	}
+}
+
+// FrameBoundType specifies the kind of a window frame bound.
+type FrameBoundType int
+
+const (
+	UNBOUNDED_PRECEDING FrameBoundType = iota
+	PRECEDING
+	CURRENT_ROW
+	FOLLOWING
+	UNBOUNDED_FOLLOWING
+)
+
+//a bound of a window frame, Expr is the offset of PRECEDING and FOLLOWING.
+type FrameBound struct {
+	Type FrameBoundType
+	Expr Expr
+}
+
+func (node *FrameBound) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case UNBOUNDED_PRECEDING:
+		ctx.WriteString("unbounded preceding")
+	case PRECEDING:
+		node.Expr.Format(ctx)
+		ctx.WriteString(" preceding")
+	case CURRENT_ROW:
+		ctx.WriteString("current row")
+	case FOLLOWING:
+		node.Expr.Format(ctx)
+		ctx.WriteString(" following")
+	case UNBOUNDED_FOLLOWING:
+		ctx.WriteString("unbounded following")
+	}
+}
+
+func NewFrameBound(t FrameBoundType, e Expr) *FrameBound {
+	return &FrameBound{
+		Type: t,
+		Expr: e,
+	}
+}
+
 //type reference
 type ResolvableTypeReference interface {
 }
@@ -635,6 +780,23 @@ type CaseExpr struct {
 	Else  Expr
 }
 
+func (node *CaseExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("case")
+	if node.Expr != nil {
+		ctx.WriteByte(' ')
+		node.Expr.Format(ctx)
+	}
+	for _, w := range node.Whens {
+		ctx.WriteByte(' ')
+		w.Format(ctx)
+	}
+	if node.Else != nil {
+		ctx.WriteString(" else ")
+		node.Else.Format(ctx)
+	}
+	ctx.WriteString(" end")
+}
+
 func NewCaseExpr(e Expr, w []*When, el Expr) *CaseExpr {
 	return &CaseExpr{
 		Expr:  e,
@@ -649,6 +811,13 @@ type When struct {
 	Val  Expr
 }
 
+func (node *When) Format(ctx *FmtCtx) {
+	ctx.WriteString("when ")
+	node.Cond.Format(ctx)
+	ctx.WriteString(" then ")
+	node.Val.Format(ctx)
+}
+
 func NewWhen(c, v Expr) *When {
 	return &When{
 		Cond: c,
@@ -661,8 +830,24 @@ type IntervalType int
 
 func (node *IntervalType) ToString() string {
 	switch *node {
+	case INTERVAL_TYPE_MICROSECOND:
+		return "microsecond"
 	case INTERVAL_TYPE_SECOND:
 		return "second"
+	case INTERVAL_TYPE_MINUTE:
+		return "minute"
+	case INTERVAL_TYPE_HOUR:
+		return "hour"
+	case INTERVAL_TYPE_DAY:
+		return "day"
+	case INTERVAL_TYPE_WEEK:
+		return "week"
+	case INTERVAL_TYPE_MONTH:
+		return "month"
+	case INTERVAL_TYPE_QUARTER:
+		return "quarter"
+	case INTERVAL_TYPE_YEAR:
+		return "year"
 	default:
 		return "Unknown IntervalType"
 	}
@@ -761,6 +946,10 @@ type MaxValue struct {
 	exprImpl
 }
 
+func (node *MaxValue) Format(ctx *FmtCtx) {
+	ctx.WriteString("maxvalue")
+}
+
 func NewMaxValue() *MaxValue {
 	return &MaxValue{}
 }
@@ -778,6 +967,21 @@ Local-Variable: DECLARE statement
 System Variable: Global System Variable, Session System Variable
 */
 
+// ParamExpr is the placeholder '?' of a prepared statement,
+// Offset is its position in the statement starting from 1.
+type ParamExpr struct {
+	exprImpl
+	Offset int
+}
+
+func (node *ParamExpr) Format(ctx *FmtCtx) {
+	ctx.WriteByte('?')
+}
+
+func NewParamExpr(offset int) *ParamExpr {
+	return &ParamExpr{Offset: offset}
+}
+
 type VarExpr struct {
 	exprImpl
 	Name   string
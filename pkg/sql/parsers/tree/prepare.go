@@ -0,0 +1,82 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "go/constant"
+
+// PrepareStmt statement, PREPARE name FROM 'sql' or PREPARE name FROM @var
+type PrepareStmt struct {
+	statementImpl
+	Name Identifier
+	// Sql is a string literal or a user variable
+	Sql Expr
+}
+
+func (node *PrepareStmt) Format(ctx *FmtCtx) {
+	ctx.WriteString("prepare ")
+	node.Name.Format(ctx)
+	ctx.WriteString(" from ")
+	if v, ok := node.Sql.(*NumVal); ok && v.Value.Kind() == constant.String {
+		ctx.WriteByte('\'')
+		ctx.WriteString(constant.StringVal(v.Value))
+		ctx.WriteByte('\'')
+		return
+	}
+	node.Sql.Format(ctx)
+}
+
+func NewPrepareStmt(name Identifier, sql Expr) *PrepareStmt {
+	return &PrepareStmt{Name: name, Sql: sql}
+}
+
+// Execute statement, EXECUTE name [USING @var [, @var] ...]
+type Execute struct {
+	statementImpl
+	Name      Identifier
+	Variables []*VarExpr
+}
+
+func (node *Execute) Format(ctx *FmtCtx) {
+	ctx.WriteString("execute ")
+	node.Name.Format(ctx)
+	if len(node.Variables) > 0 {
+		ctx.WriteString(" using ")
+		for i, v := range node.Variables {
+			if i > 0 {
+				ctx.WriteString(", ")
+			}
+			v.Format(ctx)
+		}
+	}
+}
+
+func NewExecute(name Identifier, vs []*VarExpr) *Execute {
+	return &Execute{Name: name, Variables: vs}
+}
+
+// Deallocate statement, {DEALLOCATE | DROP} PREPARE name
+type Deallocate struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *Deallocate) Format(ctx *FmtCtx) {
+	ctx.WriteString("deallocate prepare ")
+	node.Name.Format(ctx)
+}
+
+func NewDeallocate(name Identifier) *Deallocate {
+	return &Deallocate{Name: name}
+}
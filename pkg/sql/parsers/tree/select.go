@@ -255,6 +255,7 @@ const (
 	JOIN_TYPE_NATURAL       = "NATURAL"
 	JOIN_TYPE_NATURAL_LEFT  = "NATURAL LEFT"
 	JOIN_TYPE_NATURAL_RIGHT = "NATURAL RIGHT"
+	JOIN_TYPE_NATURAL_FULL  = "NATURAL FULL"
 )
 
 //the table expression
@@ -307,3 +307,18 @@ func NewShowIndex(t TableName, w *Where) *ShowIndex {
 		Where:     w,
 	}
 }
+
+//show stats statement
+type ShowStats struct {
+	showImpl
+	Table TableName
+}
+
+func (node *ShowStats) Format(ctx *FmtCtx) {
+	ctx.WriteString("show stats from ")
+	node.Table.Format(ctx)
+}
+
+func NewShowStats(t TableName) *ShowStats {
+	return &ShowStats{Table: t}
+}
@@ -42,6 +42,8 @@ const (
 	BlobFamily
 
 	GeometryFamily
+
+	DecimalFamily
 )
 
 type IntervalDurationField struct {
@@ -108,6 +108,8 @@ type Load struct {
 	File              string
 	DuplicateHandling DuplicateKey
 	Table             *TableName
+	//the format of the file, the delimited text if it is empty
+	FileFormat string
 	//Partition
 	//Fields
 	Fields *Fields
@@ -140,6 +142,11 @@ func (node *Load) Format(ctx *FmtCtx) {
 	ctx.WriteString(" into table ")
 	node.Table.Format(ctx)
 
+	if node.FileFormat != "" {
+		ctx.WriteString(" format ")
+		ctx.WriteString(node.FileFormat)
+	}
+
 	if node.Fields != nil {
 		ctx.WriteByte(' ')
 		node.Fields.Format(ctx)
@@ -170,7 +177,7 @@ func (node *Load) Format(ctx *FmtCtx) {
 	}
 }
 
-func NewLoad(l bool, f string, d DuplicateKey, t *TableName,
+func NewLoad(l bool, f string, d DuplicateKey, t *TableName, ff string,
 	fie *Fields, li *Lines, il uint64, cl []LoadColumn,
 	a UpdateExprs) *Load {
 	return &Load{
@@ -178,6 +185,7 @@ func NewLoad(l bool, f string, d DuplicateKey, t *TableName,
 		File:              f,
 		DuplicateHandling: d,
 		Table:             t,
+		FileFormat:        ff,
 		Fields:            fie,
 		Lines:             li,
 		IgnoredLines:      il,
@@ -186,6 +194,12 @@ func NewLoad(l bool, f string, d DuplicateKey, t *TableName,
 	}
 }
 
+//the formats of the file of LOAD DATA besides the delimited text
+const (
+	LOAD_FORMAT_PARQUET   = "parquet"
+	LOAD_FORMAT_JSONLINES = "jsonlines"
+)
+
 type DuplicateKey interface{}
 
 type duplicateKeyImpl struct {
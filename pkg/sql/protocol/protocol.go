@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/inner"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/outer"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dedup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/group"
@@ -50,14 +51,22 @@ func init() {
 	gob.Register(Extend{})
 	gob.Register(TopArgument{})
 	gob.Register(JoinArgument{})
+	gob.Register(OuterJoinArgument{})
 	gob.Register(GroupArgument{})
 	gob.Register(ProjectionArgument{})
 
 	gob.Register(Source{})
 	gob.Register(Segment{})
+	gob.Register(Filter{})
+
+	gob.Register(types.Decimal128{})
+	gob.Register(types.Date(0))
+	gob.Register(types.Datetime(0))
 }
 
 func EncodeScope(s Scope, buf *bytes.Buffer) error {
+	buf.Write(encoding.EncodeUint32(uint32(len(s.Id))))
+	buf.WriteString(s.Id)
 	buf.Write(encoding.EncodeUint32(uint32(s.Magic)))
 	{
 		data, err := encoding.Encode(s.Data)
@@ -83,6 +92,13 @@ func DecodeScope(data []byte) (Scope, []byte, error) {
 	var s Scope
 	var err error
 
+	if n := encoding.DecodeUint32(data[:4]); n > 0 {
+		data = data[4:]
+		s.Id = string(data[:n])
+		data = data[n:]
+	} else {
+		data = data[4:]
+	}
 	s.Magic = int(encoding.DecodeUint32(data[:4]))
 	data = data[4:]
 	{
@@ -176,6 +192,9 @@ func EncodeInstruction(in vm.Instruction, buf *bytes.Buffer) error {
 				Op:    e.Op,
 				Name:  e.Name,
 				Alias: e.Alias,
+
+				Distinct:  e.Distinct,
+				Separator: e.Separator,
 			}
 		}
 		data, err := encoding.Encode(GroupArgument{Gs: arg.Gs, Es: es, Refer: arg.Refer})
@@ -218,6 +237,9 @@ func EncodeInstruction(in vm.Instruction, buf *bytes.Buffer) error {
 				Op:    e.Op,
 				Name:  e.Name,
 				Alias: e.Alias,
+
+				Distinct:  e.Distinct,
+				Separator: e.Separator,
 			}
 		}
 		data, err := encoding.Encode(GroupArgument{Es: es, Refer: arg.Refer})
@@ -256,6 +278,30 @@ func EncodeInstruction(in vm.Instruction, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint32(uint32(len(data))))
 		buf.Write(data)
 		return nil
+	case vm.BagOuterJoin:
+		arg := in.Arg.(*outer.Argument)
+		data, err := encoding.Encode(OuterJoinArgument{
+			Full:   arg.Full,
+			R:      arg.R,
+			S:      arg.S,
+			Rattrs: arg.Rattrs,
+			Sattrs: arg.Sattrs,
+			Rrefer: arg.Rrefer,
+			Srefer: arg.Srefer,
+			Rtyps:  arg.Rtyps,
+			Styps:  arg.Styps,
+		})
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(data))))
+		buf.Write(data)
+		if arg.Cond == nil {
+			buf.Write(encoding.EncodeUint32(0))
+			return nil
+		}
+		buf.Write(encoding.EncodeUint32(1))
+		return EncodeExtend(arg.Cond, buf)
 	case vm.Merge:
 		return nil
 	case vm.MergeTop:
@@ -307,6 +353,9 @@ func EncodeInstruction(in vm.Instruction, buf *bytes.Buffer) error {
 				Op:    e.Op,
 				Name:  e.Name,
 				Alias: e.Alias,
+
+				Distinct:  e.Distinct,
+				Separator: e.Separator,
 			}
 		}
 		data, err := encoding.Encode(GroupArgument{Gs: arg.Gs, Es: es, Refer: arg.Refer})
@@ -324,6 +373,9 @@ func EncodeInstruction(in vm.Instruction, buf *bytes.Buffer) error {
 				Op:    e.Op,
 				Name:  e.Name,
 				Alias: e.Alias,
+
+				Distinct:  e.Distinct,
+				Separator: e.Separator,
 			}
 		}
 		data, err := encoding.Encode(GroupArgument{Es: es, Refer: arg.Refer})
@@ -393,6 +445,8 @@ func DecodeInstruction(data []byte) (vm.Instruction, []byte, error) {
 				es[i].Op = e.Op
 				es[i].Name = e.Name
 				es[i].Alias = e.Alias
+				es[i].Distinct = e.Distinct
+				es[i].Separator = e.Separator
 			}
 		}
 		in.Arg = &group.Argument{
@@ -454,6 +508,8 @@ func DecodeInstruction(data []byte) (vm.Instruction, []byte, error) {
 				es[i].Op = e.Op
 				es[i].Name = e.Name
 				es[i].Alias = e.Alias
+				es[i].Distinct = e.Distinct
+				es[i].Separator = e.Separator
 			}
 		}
 		in.Arg = &summarize.Argument{
@@ -503,6 +559,37 @@ func DecodeInstruction(data []byte) (vm.Instruction, []byte, error) {
 			Sattrs: arg.Sattrs,
 		}
 		data = data[n:]
+	case vm.BagOuterJoin:
+		var arg OuterJoinArgument
+
+		data = data[4:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if err := encoding.Decode(data[:n], &arg); err != nil {
+			return in, nil, err
+		}
+		in.Arg = &outer.Argument{
+			Full:   arg.Full,
+			R:      arg.R,
+			S:      arg.S,
+			Rattrs: arg.Rattrs,
+			Sattrs: arg.Sattrs,
+			Rrefer: arg.Rrefer,
+			Srefer: arg.Srefer,
+			Rtyps:  arg.Rtyps,
+			Styps:  arg.Styps,
+		}
+		data = data[n:]
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			e, rdata, err := DecodeExtend(data)
+			if err != nil {
+				return in, nil, err
+			}
+			in.Arg.(*outer.Argument).Cond = e
+			data = rdata
+		}
 	case vm.Merge:
 		data = data[4:]
 		in.Arg = &merge.Argument{}
@@ -575,6 +662,8 @@ func DecodeInstruction(data []byte) (vm.Instruction, []byte, error) {
 				es[i].Op = e.Op
 				es[i].Name = e.Name
 				es[i].Alias = e.Alias
+				es[i].Distinct = e.Distinct
+				es[i].Separator = e.Separator
 			}
 		}
 		in.Arg = &mergegroup.Argument{
@@ -599,6 +688,8 @@ func DecodeInstruction(data []byte) (vm.Instruction, []byte, error) {
 				es[i].Op = e.Op
 				es[i].Name = e.Name
 				es[i].Alias = e.Alias
+				es[i].Distinct = e.Distinct
+				es[i].Separator = e.Separator
 			}
 		}
 		in.Arg = &mergesum.Argument{
@@ -637,6 +728,16 @@ func EncodePartition(def *engine.PartitionBy, buf *bytes.Buffer) error {
 	} else {
 		buf.Write(encoding.EncodeUint32(0))
 	}
+	if len(def.Hash) > 0 {
+		data, err := encoding.Encode(def.Hash)
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(data))))
+		buf.Write(data)
+	} else {
+		buf.Write(encoding.EncodeUint32(0))
+	}
 	return nil
 }
 
@@ -677,6 +778,15 @@ func DecodePartition(data []byte) (*engine.PartitionBy, []byte, error) {
 	} else {
 		data = data[4:]
 	}
+	if n := encoding.DecodeUint32(data[:4]); n > 0 {
+		data = data[4:]
+		if err := encoding.Decode(data[:n], &def.Hash); err != nil {
+			return nil, nil, err
+		}
+		data = data[n:]
+	} else {
+		data = data[4:]
+	}
 	return def, data, nil
 }
 
@@ -695,6 +805,8 @@ func EncodeListPartition(def engine.ListPartition, buf *bytes.Buffer) error {
 				return err
 			}
 		}
+	} else {
+		buf.Write(encoding.EncodeUint32(0))
 	}
 	if def.Subpartition != nil {
 		return EncodePartition(def.Subpartition, buf)
@@ -753,6 +865,8 @@ func EncodeRangePartition(def engine.RangePartition, buf *bytes.Buffer) error {
 				return err
 			}
 		}
+	} else {
+		buf.Write(encoding.EncodeUint32(0))
 	}
 	if n := len(def.To); n > 0 {
 		buf.Write(encoding.EncodeUint32(uint32(n)))
@@ -761,6 +875,8 @@ func EncodeRangePartition(def engine.RangePartition, buf *bytes.Buffer) error {
 				return err
 			}
 		}
+	} else {
+		buf.Write(encoding.EncodeUint32(0))
 	}
 	if def.Subpartition != nil {
 		return EncodePartition(def.Subpartition, buf)
@@ -806,7 +922,7 @@ func DecodeRangePartition(data []byte) (engine.RangePartition, []byte, error) {
 	} else {
 		data = data[4:]
 	}
-	if typ == ListWithSub {
+	if typ == RangeWithSub {
 		sub, remaing, err := DecodePartition(data)
 		if err != nil {
 			return def, nil, err
@@ -842,6 +958,16 @@ func EncodeExtend(e extend.Extend, buf *bytes.Buffer) error {
 			return err
 		}
 		return EncodeExtend(v.Right, buf)
+	case *extend.MultiExtend:
+		buf.WriteByte(Multi)
+		buf.Write(encoding.EncodeUint32(uint32(v.Op)))
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Args))))
+		for _, arg := range v.Args {
+			if err := EncodeExtend(arg, buf); err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 	return fmt.Errorf("'%s' not yet support", e)
 }
@@ -905,6 +1031,22 @@ func DecodeExtend(data []byte) (extend.Extend, []byte, error) {
 		}
 		e.Left, e.Right = le, re
 		return e, data, nil
+	case Multi:
+		e := new(extend.MultiExtend)
+		data = data[1:]
+		e.Op = int(encoding.DecodeUint32(data[:4]))
+		data = data[4:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		for i := uint32(0); i < n; i++ {
+			arg, remaing, err := DecodeExtend(data)
+			if err != nil {
+				return nil, nil, err
+			}
+			e.Args = append(e.Args, arg)
+			data = remaing
+		}
+		return e, data, nil
 	}
 	return nil, nil, fmt.Errorf("'%v' extend not yet support", data[0])
 }
@@ -1105,7 +1247,63 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		vs := v.Col.([]float64)
 		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
 		buf.Write(encoding.EncodeFloat64Slice(vs))
-	case types.T_char, types.T_varchar:
+	case types.T_decimal64:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Decimal64)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeDecimal64Slice(vs))
+	case types.T_decimal128:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Decimal128)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeDecimal128Slice(vs))
+	case types.T_date:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Date)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeDateSlice(vs))
+	case types.T_datetime:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Datetime)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeDatetimeSlice(vs))
+	case types.T_char, types.T_varchar, types.T_json:
 		buf.Write(encoding.EncodeType(v.Typ))
 		buf.Write(encoding.EncodeUint64(v.Ref))
 		nb, err := v.Nsp.Show()
@@ -1381,7 +1579,95 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 			data = data[4:]
 		}
 		return v, data, nil
-	case types.T_char, types.T_varchar:
+	case types.T_decimal64:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeDecimal64Slice(data[:n*8])
+			data = data[n*8:]
+		} else {
+			data = data[4:]
+		}
+		return v, data, nil
+	case types.T_decimal128:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeDecimal128Slice(data[:n*16])
+			data = data[n*16:]
+		} else {
+			data = data[4:]
+		}
+		return v, data, nil
+	case types.T_date:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeDateSlice(data[:n*4])
+			data = data[n*4:]
+		} else {
+			data = data[4:]
+		}
+		return v, data, nil
+	case types.T_datetime:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeDatetimeSlice(data[:n*8])
+			data = data[n*8:]
+		} else {
+			data = data[4:]
+		}
+		return v, data, nil
+	case types.T_char, types.T_varchar, types.T_json:
 		v := vector.New(typ)
 		v.Or = true
 		v.Ref = encoding.DecodeUint64(data[:8])
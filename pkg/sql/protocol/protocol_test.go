@@ -21,9 +21,18 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/aggfunc"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation/groupconcat"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/outer"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -63,6 +72,89 @@ func TestBatch(t *testing.T) {
 	}
 }
 
+func TestPartialStates(t *testing.T) {
+	proc := process.New(guest.New(1<<40, host.New(1<<40)))
+	proc.Mp = mempool.New()
+	items := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	items.Append([][]byte{
+		groupconcat.AppendItem(nil, []byte{2}, []byte("x")),
+		groupconcat.AppendItem(nil, []byte{1}, []byte("y")),
+	})
+	empty := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	empty.Append([]float64{0})
+	empty.Nsp.Add(0)
+	tests := []struct {
+		partial, final int
+		vec            *vector.Vector
+		want           interface{}
+	}{
+		{aggregation.DistinctSet, aggregation.CountDistinct, NewStrVector([]byte("x")), int64(1)},
+		{aggregation.DistinctSet, aggregation.CountDistinct, empty, int64(0)},
+		{aggregation.Moment, aggregation.VarPop, NewFloatVector(1.5), float64(0)},
+		{aggregation.Moment, aggregation.VarSamp, empty, nil},
+		{aggregation.ConcatList, aggregation.GroupConcat, items, []byte("y,y,x,x")},
+		{aggregation.HyperLogLog, aggregation.ApproxCountDistinct, NewStrVector([]byte("x")), int64(1)},
+		{aggregation.BitXor, aggregation.BitXor, NewIntVector(5), uint64(0)},
+	}
+	for _, tt := range tests {
+		final := aggfunc.New(aggregation.Extend{Op: tt.final, Separator: ","}, types.Type{Oid: types.T_tuple, Size: 24})
+		if tt.partial == tt.final {
+			final = aggfunc.New(aggregation.Extend{Op: tt.final}, tt.vec.Typ)
+		}
+		// the partial states of two nodes are sent to the node merging them
+		for i := 0; i < 2; i++ {
+			var buf bytes.Buffer
+
+			partial := aggfunc.New(aggregation.Extend{Op: tt.partial}, tt.vec.Typ)
+			require.NoError(t, partial.Fill(nil, tt.vec))
+			vec, err := partial.EvalCopy(proc)
+			require.NoError(t, err)
+			require.NoError(t, EncodeVector(vec, &buf))
+			nvec, _, err := DecodeVector(buf.Bytes())
+			require.NoError(t, err)
+			require.NoError(t, final.Fill(nil, nvec))
+		}
+		require.Equal(t, tt.want, final.Eval(), aggregation.AggName[tt.final])
+	}
+}
+
+func TestOuterJoin(t *testing.T) {
+	cond := &extend.BinaryExtend{
+		Op:    overload.GT,
+		Left:  &extend.Attribute{Name: "S.price", Type: types.T_float64},
+		Right: &extend.ValueExtend{V: NewFloatVector(5)},
+	}
+	for _, e := range []extend.Extend{nil, cond} {
+		var buf bytes.Buffer
+
+		arg := &outer.Argument{
+			Full:   true,
+			R:      "R",
+			S:      "S",
+			Rattrs: []string{"orderId"},
+			Sattrs: []string{"orderId"},
+			Cond:   e,
+		}
+		require.NoError(t, EncodeInstruction(vm.Instruction{Code: vm.BagOuterJoin, Arg: arg}, &buf))
+		in, data, err := DecodeInstruction(buf.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, 0, len(data))
+		narg := in.Arg.(*outer.Argument)
+		require.Equal(t, arg.Sattrs, narg.Sattrs)
+		if e == nil {
+			require.Nil(t, narg.Cond)
+		} else {
+			require.Equal(t, e.String(), narg.Cond.String())
+		}
+	}
+}
+
+func NewIntVector(v int64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T(types.T_int64), Size: 8})
+	vec.Append([]int64{v, v, v})
+	return vec
+}
+
 func NewStrVector(v []byte) *vector.Vector {
 	vec := vector.New(types.Type{Oid: types.T(types.T_varchar), Size: 24})
 	vec.Append([][]byte{v, v, v})
@@ -14,7 +14,10 @@
 
 package protocol
 
-import "github.com/matrixorigin/matrixone/pkg/vm"
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+)
 
 const ( // extend type
 	Attr = iota
@@ -30,6 +33,11 @@ const ( // table define type
 	Attribute
 )
 
+const ( // remote command, it must be in the order of registration
+	ProcessCommand = iota
+	CancelCommand
+)
+
 const ( // partition type
 	List = iota
 	Range
@@ -52,6 +60,9 @@ type Extend struct {
 	Op    int
 	Name  string
 	Alias string
+	// Distinct and Separator are the parameters of group_concat.
+	Distinct  bool
+	Separator string
 }
 
 type GroupArgument struct {
@@ -67,6 +78,18 @@ type JoinArgument struct {
 	Sattrs []string
 }
 
+type OuterJoinArgument struct {
+	Full   bool
+	R      string
+	S      string
+	Rattrs []string
+	Sattrs []string
+	Rrefer map[string]uint64
+	Srefer map[string]uint64
+	Rtyps  map[string]types.Type
+	Styps  map[string]types.Type
+}
+
 type ProjectionArgument struct {
 	Attrs []string
 	Refer map[string]uint64
@@ -80,14 +103,25 @@ type Segment struct {
 	TabletId string
 }
 
+type Filter struct {
+	Op   int
+	Attr string
+	Val  interface{}
+	Vals []interface{}
+}
+
 type Source struct {
-	ID    string
-	DB    string
-	Segs  []Segment
-	Refer map[string]uint64
+	ID        string
+	DB        string
+	Segs      []Segment
+	Refer     map[string]uint64
+	Filters   []Filter
+	IsSummary bool
 }
 
 type Scope struct {
+	// Id, identifies a remote execution so that it can be cancelled.
+	Id    string
 	Magic int
 	Data  Source
 	Ss    []Scope
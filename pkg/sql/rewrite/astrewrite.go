@@ -98,6 +98,15 @@ func rewriteFilterCondition(expr tree.Expr) tree.Expr {
 		if notExpr, ok := t.Expr.(*tree.NotExpr); ok {
 			return tree.NewNotExpr(rewriteFilterCondition(notExpr))
 		}
+		if subquery, ok := t.Expr.(*tree.Subquery); ok && subquery.Exists {
+			return t
+		}
+		if isNullExpr, ok := t.Expr.(*tree.IsNullExpr); ok {
+			return tree.NewIsNotNullExpr(isNullExpr.Expr)
+		}
+		if isNotNullExpr, ok := t.Expr.(*tree.IsNotNullExpr); ok {
+			return tree.NewIsNullExpr(isNotNullExpr.Expr)
+		}
 		return tree.NewComparisonExpr(tree.EQUAL, t.Expr, tree.NewNumVal(constant.MakeInt64(0), "0", false))
 	// rewrite to != 0
 	case *tree.UnresolvedName, *tree.NumVal, *tree.CastExpr:
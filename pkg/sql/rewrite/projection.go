@@ -62,7 +62,7 @@ func rewriteExpr(n tree.Expr) tree.Expr {
 		if name, ok := e.Func.FunctionReference.(*tree.UnresolvedName); ok {
 			if len(e.Exprs) > 0 {
 				name.Parts[0] = strings.ToLower(name.Parts[0])
-				if _, ok := e.Exprs[0].(*tree.NumVal); ok && name.Parts[0] == "count" {
+				if _, ok := e.Exprs[0].(*tree.NumVal); ok && name.Parts[0] == "count" && e.WindowSpec == nil {
 					e.Func.FunctionReference = &tree.UnresolvedName{
 						Parts: [4]string{"starcount"},
 					}
@@ -73,6 +73,17 @@ func rewriteExpr(n tree.Expr) tree.Expr {
 	case *tree.CastExpr:
 		e.Expr = rewriteExpr(e.Expr)
 		return e
+	case *tree.CaseExpr:
+		if e.Expr != nil {
+			e.Expr = rewriteExpr(e.Expr)
+		}
+		for _, w := range e.Whens {
+			w.Cond, w.Val = rewriteExpr(w.Cond), rewriteExpr(w.Val)
+		}
+		if e.Else != nil {
+			e.Else = rewriteExpr(e.Else)
+		}
+		return e
 	}
 	return n
 }
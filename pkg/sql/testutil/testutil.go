@@ -23,6 +23,7 @@ func NewTestServer(e engine.Engine, proc *process.Process) (rpcserver.Server, er
 	}
 	hp := handler.New(e, proc)
 	srv.Register(hp.Process)
+	srv.Register(hp.Cancel)
 	return srv, nil
 }
 
@@ -1,18 +1,36 @@
 package unittest
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregation"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
 	"github.com/matrixorigin/matrixone/pkg/sqlerror"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
+	"reflect"
+	gosort "sort"
 	"testing"
+	"time"
 )
 
 func Print(_ interface{}, bat *batch.Batch) error {
@@ -604,6 +622,97 @@ func TestSql(t *testing.T) {
 
 	println(">>>>>>>----------------------------------")
 
+	sql = "select R.orderId, S.orderId, S.price from R, S where R.uid = S.uid and S.price > 15.0 and R.price < 3.0;" +
+		"select R.orderId, S.orderId from R join S on R.uid = S.uid where R.price < 2.0;" +
+		"select a.orderId, b.orderId from R a, R b where a.orderId = b.orderId and a.price > 17.0;"
+	c = compile.New("test", sql, "tom", e, proc)
+	es, err = c.Build()
+	require.NoError(t, err)
+	for _, e := range es {
+		if err := e.Compile(nil, Print); err != nil {
+			require.NoError(t, err)
+		}
+		if err := e.Run(1); err != nil {
+			require.NoError(t, err)
+		}
+	}
+
+	println(">>>>>>>----------------------------------")
+
+	sql = "select orderId, uid, price from R where 3 > uid and price >= 2;" +
+		"select orderId, price from R where uid = 2 and uid <> 3 and price < 100000000000;" +
+		"select count(*), count(price), min(price), max(price) from R where price > 0;"
+	c = compile.New("test", sql, "tom", e, proc)
+	es, err = c.Build()
+	require.NoError(t, err)
+	for _, e := range es {
+		if err := e.Compile(nil, Print); err != nil {
+			require.NoError(t, err)
+		}
+		if err := e.Run(1); err != nil {
+			require.NoError(t, err)
+		}
+	}
+
+	println(">>>>>>>----------------------------------")
+
+	sql = "select R.orderId, R.uid, S.orderId from R left join S on R.uid = S.uid;" +
+		"select R.orderId, S.orderId, S.price from R right join S on R.orderId = S.orderId;" +
+		"select R.orderId, S.orderId from R full join S on R.orderId = S.orderId;"
+	c = compile.New("test", sql, "tom", e, proc)
+	es, err = c.Build()
+	require.NoError(t, err)
+	for _, e := range es {
+		if err := e.Compile(nil, Print); err != nil {
+			require.NoError(t, err)
+		}
+		if err := e.Run(1); err != nil {
+			require.NoError(t, err)
+		}
+	}
+
+	println(">>>>>>>----------------------------------")
+
+	for _, sql := range []string{
+		"create table fn (a int, d date, s varchar(10), f float, dt datetime);",
+		"insert into fn values (1, '2021-01-30', 'x', 1.5, '2021-01-30 10:00:00'), (-12, '2020-02-29', ' yz ', null, null);",
+	} {
+		c = compile.New("test", sql, "tom", e, proc)
+		es, err = c.Build()
+		require.NoError(t, err, sql)
+		for _, e := range es {
+			require.NoError(t, e.Compile(nil, Print), sql)
+			require.NoError(t, e.Run(1), sql)
+		}
+	}
+	for _, tc := range []struct {
+		sql  string
+		rows []string
+	}{
+		{"select upper(uid), lower(orderId), concat(orderId, '-', uid), substring(orderId, 2), length(uid) from R where price > 16;", []string{"1,17,17-1,7,1,", "2,18,18-2,8,1,", "3,19,19-3,9,1,"}},
+		{"select trim(uid), ltrim(uid), rtrim(uid), coalesce(uid, 'none'), ifnull(orderId, uid) from R where price < 3;", []string{"0,0,0,0,0,", "1,1,1,1,1,", "2,2,2,2,2,"}},
+		{"select abs(price), round(price), round(price, 1), floor(price), ceil(price), coalesce(price, 0) from R where price < 3;", []string{"0,0,0,0,0,0,", "1,1,1,1,1,1,", "2,2,2,2,2,2,"}},
+		{"select trim(s), ltrim(s), rtrim(s), length(s), coalesce(f, 0), ifnull(dt, d) from fn;", []string{"x,x,x,1,1.5,2021-01-30 10:00:00,", "yz,yz , yz,4,0,2020-02-29 00:00:00,"}},
+		{"select concat(a, '-', s), upper(a), lower(f), length(a), substring(a, 2), concat(a, 'a', 2.5), concat(s, null) from fn;", []string{"1-x,1,1.5,1,,1a2.5,null,", "-12- yz ,-12,null,3,12,-12a2.5,null,"}},
+		{"select upper(d), concat(d, ' ', dt) from fn;", []string{"2021-01-30,2021-01-30 2021-01-30 10:00:00,", "2020-02-29,null,"}},
+		{"select date_add(d, interval 1 day), date_sub(d, interval 1 month), date_add(dt, interval 2 hour) from fn;", []string{"2021-01-31,2020-12-30,2021-01-30 12:00:00,", "2020-03-01,2020-01-29,null,"}},
+		{"select a, length(now()) from fn;", []string{"1,19,", "-12,19,"}},
+		{"select a from fn where year(now()) - year(d) > 0;", []string{"1,", "-12,"}},
+		{"select a from fn where dt < now() and current_timestamp() > d;", []string{"1,"}},
+	} {
+		c = compile.New("test", tc.sql, "tom", e, proc)
+		es, err = c.Build()
+		require.NoError(t, err, tc.sql)
+		var rows []string
+		for _, e := range es {
+			require.NoError(t, e.Compile(nil, collect(&rows)), tc.sql)
+			require.NoError(t, e.Run(1), tc.sql)
+		}
+		requireRows(t, tc.sql, tc.rows, rows)
+	}
+
+	println(">>>>>>>----------------------------------")
+
 	sql = "SELECT DISTINCT price from R;"
 	c = compile.New("test", sql, "tom", e, proc)
 	es, err = c.Build()
@@ -619,6 +728,29 @@ func TestSql(t *testing.T) {
 
 	println(">>>>>>>----------------------------------")
 
+	for _, tc := range []struct {
+		sql  string
+		rows []string
+	}{
+		{"SELECT uid, COUNT(*) FROM R WHERE price < 6 GROUP BY uid HAVING COUNT(*) > 1;", []string{"0,2,", "1,2,"}},
+		{"SELECT uid FROM R GROUP BY uid HAVING SUM(price) > 45 AND MIN(price) < 3;", []string{"2,"}},
+		{"SELECT uid, AVG(price) AS a FROM R GROUP BY uid HAVING a > 9 ORDER BY uid;", []string{"2,10,", "3,11,"}},
+		{"SELECT COUNT(*) FROM R HAVING MAX(price) > 1;", []string{"20,"}},
+		{"SELECT COUNT(*) FROM R HAVING MAX(price) > 19;", nil},
+	} {
+		c = compile.New("test", tc.sql, "tom", e, proc)
+		es, err = c.Build()
+		require.NoError(t, err)
+		var rows []string
+		for _, e := range es {
+			require.NoError(t, e.Compile(nil, collect(&rows)), tc.sql)
+			require.NoError(t, e.Run(1), tc.sql)
+		}
+		requireRows(t, tc.sql, tc.rows, rows)
+	}
+
+	println(">>>>>>>----------------------------------")
+
 	sql = "SELECT unknownCol, price from R where uid = 1;"
 	c = compile.New("test", sql, "tom", e, proc)
 	es, err = c.Build()
@@ -644,6 +776,83 @@ func TestSql(t *testing.T) {
 
 }
 
+func TestJoin(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type joinTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		expectErr2 error // run err expected
+		rows       []string
+	}
+
+	// the rows of the build relation come from several batches, and some of them are filtered
+	testCases := []joinTestCase{
+		{"select R.orderId, S.orderId from R join S on R.uid = S.uid where R.price < 1.0;", nil, nil, []string{"0,0,", "0,4,", "0,8,", "0,12,", "0,16,", "0,20,", "0,24,", "0,28,", "0,32,", "0,36,"}},
+		{"select R.orderId, S.orderId from R join S on R.uid = S.uid where R.price < 2.0 and S.price > 5.0;", nil, nil, []string{"0,12,", "0,16,", "0,20,", "0,24,", "0,28,", "0,32,", "0,36,", "1,14,", "1,18,", "1,22,", "1,26,", "1,30,", "1,34,", "1,38,"}},
+		{"select R.orderId, S.orderId from R, S where R.orderId = S.orderId and R.uid = S.uid;", nil, nil, []string{"0,0,", "4,4,", "8,8,", "12,12,", "16,16,"}},
+		{"select a.orderId, b.uid from R a join R b on a.orderId = b.uid where a.price < 2.0 and b.price > 15.0;", nil, nil, []string{"0,0,", "1,1,"}},
+		{"select R.orderId, S.orderId from R left join S on R.orderId = S.orderId where R.price < 5.0;", nil, nil, []string{"0,0,", "1,null,", "2,2,", "3,null,", "4,4,"}},
+		{"select R.orderId, S.orderId from R right join S on R.orderId = S.orderId where S.price < 4.0;", nil, nil, []string{"0,0,", "2,2,", "4,4,", "6,6,"}},
+		{"select R.orderId, S.orderId from R full join S on R.orderId = S.uid where R.price < 4.0;", nil, nil, []string{"0,0,", "0,4,", "0,8,", "0,12,", "0,16,", "0,20,", "0,24,", "0,28,", "0,32,", "0,36,", "1,2,", "1,6,", "1,10,", "1,14,", "1,18,", "1,22,", "1,26,", "1,30,", "1,34,", "1,38,", "2,null,", "3,null,"}},
+		{"select R.orderId, S.orderId, T.orderId from R join S on R.orderId = S.orderId join R T on S.orderId = T.orderId;", nil, nil, []string{"0,0,0,", "2,2,2,", "4,4,4,", "6,6,6,", "8,8,8,", "10,10,10,", "12,12,12,", "14,14,14,", "16,16,16,", "18,18,18,"}},
+		{"select R.orderId, T.orderId from R, S, R T where T.uid = S.uid and R.orderId = S.orderId and T.price < 2.0;", nil, nil, []string{"0,0,", "2,1,", "4,0,", "6,1,", "8,0,", "10,1,", "12,0,", "14,1,", "16,0,", "18,1,"}},
+		{"select R.orderId, S.orderId, S.price from R left join S on R.orderId = S.orderId and S.price > 5.0 where R.price < 16.0;", nil, nil, []string{"0,null,null,", "1,null,null,", "2,null,null,", "3,null,null,", "4,null,null,", "5,null,null,", "6,null,null,", "7,null,null,", "8,null,null,", "9,null,null,", "10,null,null,", "11,null,null,", "12,12,6,", "13,null,null,", "14,14,7,", "15,null,null,"}},
+		{"select R.orderId, S.orderId from R left join S on R.uid = S.uid and R.price >= S.price where R.price < 6.0;", nil, nil, []string{"0,0,", "1,2,", "2,null,", "3,null,", "4,0,", "4,4,", "4,8,", "5,2,", "5,6,", "5,10,"}},
+		{"select R.orderId, S.orderId from R right join S on R.orderId = S.orderId and R.uid = '0' where S.price < 5.0;", nil, nil, []string{"0,0,", "null,2,", "4,4,", "null,6,", "8,8,"}},
+		{"select R.orderId, S.orderId from R full join S on R.orderId = S.orderId and S.price < 2.0 where R.price < 4.0 or S.price > 17.0;", nil, nil, []string{"0,0,", "1,null,", "2,2,", "3,null,", "null,36,", "null,38,"}},
+		{"select R.orderId, S.orderId from R left join S on R.orderId = S.orderId and 1 = 0 where R.price < 2.0;", nil, nil, []string{"0,null,", "1,null,"}},
+		{"select R.orderId, S.orderId from R left join S on R.price > S.price;", errors.New("[03000]unsupport join condition 'R.price > S.price' without an equality between the two relations"), nil, nil},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+		expected2 := tc.expectErr2
+
+		c := compile.New("test", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		var rows []string
+		for _, e := range es {
+			err := e.Compile(nil, collect(&rows))
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+			}
+			if expected1 != nil {
+				break
+			}
+			err = e.Run(1)
+			if expected2 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected2.Error(), sql)
+			}
+		}
+		if expected1 == nil && expected2 == nil {
+			requireRows(t, sql, tc.rows, rows)
+		}
+	}
+}
+
 func TestCreateTable(t *testing.T) {
 	hm := host.New(1 << 40)
 	gm := guest.New(1<<40, hm)
@@ -772,3 +981,1419 @@ func TestOperators(t *testing.T) {
 		}
 	}
 }
+
+func TestDecimal(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type decimalTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		expectErr2 error // run err expected
+		rows       []string
+	}
+
+	testCases := []decimalTestCase{
+		{"create database testdecimal;", nil, nil, nil},
+		{"create table dec1 (a decimal(10, 2), b decimal(30, 5) default 1.5, c int);", nil, nil, nil},
+		{"create table dec2 (a decimal(40, 2));", sqlerror.New(errno.InvalidColumnDefinition, "too big precision 40 specified, maximum is 38"), nil, nil},
+		{"create table dec3 (a decimal(5, 6));", sqlerror.New(errno.InvalidColumnDefinition, "scale 6 must not be greater than precision 5"), nil, nil},
+		{"insert into dec1 values (1.25, 12345678901234567890.12345, 3), (-0.5, null, 4), (null, 2, null);", nil, nil, nil},
+		{"insert into dec1 (a, c) values (99999999.99, 1), (0.004, 2);", nil, nil, nil},
+		{"insert into dec1 (a) values (123456789);", sqlerror.New(errno.DataException, "constant value out of range"), nil, nil},
+		{"select a, b, c, -a from dec1;", nil, nil, []string{"1.25,12345678901234567890.12345,3,-1.25,", "-0.50,null,4,0.50,", "null,2.00000,null,null,", "99999999.99,1.50000,1,-99999999.99,", "0.00,1.50000,2,0.00,"}},
+		{"select a + b, a - c, a * b, a / c, b / 3 from dec1;", nil, nil, []string{"12345678901234567891.37345,-1.75,15432098626543209862.6543125,0.416667,4115226300411522630.041150000,", "null,-4.50,null,-0.125000,null,", "null,null,null,null,0.666666667,", "100000001.49000,99999998.99,149999999.9850000,99999999.990000,0.500000000,", "1.50000,-2.00,0.0000000,0.000000,0.500000000,"}},
+		{"select a + 1.5, a * 2, c - a from dec1;", nil, nil, []string{"2.75,2.50,1.75,", "1.00,-1.00,4.50,", "null,null,null,", "100000001.49,199999999.98,-99999998.99,", "1.50,0.00,2.00,"}},
+		{"select a * 1.5, 1.5 - a, a / 0.5 from dec1;", nil, nil, []string{"1.875,0.25,2.500000,", "-0.750,2.00,-1.000000,", "null,null,null,", "149999999.985,-99999998.49,199999999.980000,", "0.000,1.50,0.000000,"}},
+		{"select * from dec1 where a > 1;", nil, nil, []string{"1.25,12345678901234567890.12345,3,", "99999999.99,1.50000,1,"}},
+		{"select * from dec1 where a = b or b <= 2;", nil, nil, []string{"null,2.00000,null,", "99999999.99,1.50000,1,", "0.00,1.50000,2,"}},
+		{"select cast(a as double) from dec1;", nil, nil, []string{"1.25,", "-0.5,", "null,", "9.999999999e+07,", "0,"}},
+		{"select cast(a as signed) from dec1;", nil, nil, []string{"1,", "-1,", "null,", "100000000,", "0,"}},
+		{"select cast(a as char(20)) from dec1;", nil, nil, []string{"1.25,", "-0.50,", "null,", "99999999.99,", "0.00,"}},
+		{"select cast(c as decimal(10, 3)) from dec1;", nil, nil, []string{"3.000,", "4.000,", "null,", "1.000,", "2.000,"}},
+		{"select * from dec1 where a >= cast('1.245' as decimal(5, 2));", nil, nil, []string{"1.25,12345678901234567890.12345,3,", "99999999.99,1.50000,1,"}},
+		{"select sum(a), avg(a), min(b), max(b), count(a) from dec1;", nil, nil, []string{"100000000.74,25000000.185000,1.50000,12345678901234567890.12345,4,"}},
+		{"select c, sum(b), avg(b), max(a) from dec1 group by c;", nil, nil, []string{"4,null,null,-0.50,", "3,12345678901234567890.12345,12345678901234567890.123450000,1.25,", "2,1.50000,1.500000000,0.00,", "1,1.50000,1.500000000,99999999.99,", "null,2.00000,2.000000000,null,"}},
+		{"select a, count(*) from dec1 group by a;", nil, nil, []string{"-0.50,1,", "null,1,", "0.00,1,", "1.25,1,", "99999999.99,1,"}},
+		{"select a / 0 from dec1;", errors.New("division by zero"), nil, nil},
+		{"drop database testdecimal;", nil, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+		expected2 := tc.expectErr2
+
+		c := compile.New("testdecimal", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		var rows []string
+		for _, e := range es {
+			err := e.Compile(nil, collect(&rows))
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+			}
+			if expected1 != nil {
+				break
+			}
+			err = e.Run(1)
+			if expected2 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected2.Error(), sql)
+			}
+		}
+		if expected1 == nil && expected2 == nil {
+			requireRows(t, sql, tc.rows, rows)
+		}
+	}
+}
+
+func TestDate(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type dateTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		expectErr2 error // run err expected
+		rows       []string
+	}
+
+	testCases := []dateTestCase{
+		{"create database testdate;", nil, nil, nil},
+		{"create table dt1 (a date, b datetime, c int default 1, d date default '2021-01-01');", nil, nil, nil},
+		{"insert into dt1 (a, b, c) values ('2021-08-13', '2021-08-13 17:55:34', 1), ('20200229', '2020-02-29 00:00:00.000120', 2), (null, null, 3);", nil, nil, nil},
+		{"insert into dt1 (a, b) values ('2019-06-09', '2019-06-09');", nil, nil, nil},
+		{"insert into dt1 (a) values ('2021-02-29');", sqlerror.New(errno.DataException, "incorrect date value: '2021-02-29'"), nil, nil},
+		{"select * from dt1;", nil, nil, []string{"2021-08-13,2021-08-13 17:55:34,1,2021-01-01,", "2020-02-29,2020-02-29 00:00:00.000120,2,2021-01-01,", "null,null,3,2021-01-01,", "2019-06-09,2019-06-09 00:00:00,1,2021-01-01,"}},
+		{"select a, b from dt1 where a > '2020-01-01';", nil, nil, []string{"2021-08-13,2021-08-13 17:55:34,", "2020-02-29,2020-02-29 00:00:00.000120,"}},
+		{"select a, b from dt1 where '2020-02-29' = a or b <= '2019-06-09 12:00:00';", nil, nil, []string{"2020-02-29,2020-02-29 00:00:00.000120,", "2019-06-09,2019-06-09 00:00:00,"}},
+		{"select a, c from dt1 where a < b;", nil, nil, []string{"2021-08-13,1,", "2020-02-29,2,"}},
+		{"select a, c from dt1 where a between '2020-01-01' and '2021-01-01';", nil, nil, []string{"2020-02-29,2,"}},
+		{"select a, c from dt1 where a >= date '2021-01-01';", nil, nil, []string{"2021-08-13,1,"}},
+		{"select b, c from dt1 where b > timestamp '2020-02-29 00:00:00';", nil, nil, []string{"2021-08-13 17:55:34,1,", "2020-02-29 00:00:00.000120,2,"}},
+		{"select a, b from dt1 order by a desc;", nil, nil, []string{"2021-08-13,2021-08-13 17:55:34,", "2020-02-29,2020-02-29 00:00:00.000120,", "2019-06-09,2019-06-09 00:00:00,", "null,null,"}},
+		{"select a, b from dt1 order by b;", nil, nil, []string{"null,null,", "2019-06-09,2019-06-09 00:00:00,", "2020-02-29,2020-02-29 00:00:00.000120,", "2021-08-13,2021-08-13 17:55:34,"}},
+		{"select cast(a as datetime), cast(b as date), cast(a as char(10)) from dt1;", nil, nil, []string{"2021-08-13 00:00:00,2021-08-13,2021-08-13,", "2020-02-29 00:00:00,2020-02-29,2020-02-29,", "null,null,null,", "2019-06-09 00:00:00,2019-06-09,2019-06-09,"}},
+		{"select a, count(*) from dt1 group by a;", nil, nil, []string{"2019-06-09,1,", "2021-08-13,1,", "2020-02-29,1,", "null,1,"}},
+		{"select count(*) from dt1 where d = '2021-01-01';", nil, nil, []string{"4,"}},
+		{"drop database testdate;", nil, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+		expected2 := tc.expectErr2
+
+		c := compile.New("testdate", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		var rows []string
+		for _, e := range es {
+			err := e.Compile(nil, collect(&rows))
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+			}
+			if expected1 != nil {
+				break
+			}
+			err = e.Run(1)
+			if expected2 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected2.Error(), sql)
+			}
+		}
+		if expected1 == nil && expected2 == nil {
+			requireRows(t, sql, tc.rows, rows)
+		}
+	}
+}
+
+func TestUnion(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type unionTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		expectErr2 error // run err expected
+		rows       []string
+	}
+
+	testCases := []unionTestCase{
+		{"create database testunion;", nil, nil, nil},
+		{"create table ut1 (a int, b int, c varchar(10));", nil, nil, nil},
+		{"create table ut2 (a bigint, d float, e varchar(10));", nil, nil, nil},
+		{"create table ut3 (a int, dt date);", nil, nil, nil},
+		{"insert into ut1 values (1, 10, 'x'), (1, 20, 'y'), (2, 5, 'x'), (3, 7, null), (3, 8, 'z'), (3, 9, 'z');", nil, nil, nil},
+		{"insert into ut2 values (1, 1.5, 'x'), (4, 2.5, 'w'), (3, 3.5, 'z'), (3, 3.5, 'z');", nil, nil, nil},
+		{"select a from ut1 union all select a from ut2;", nil, nil, []string{"1,", "1,", "2,", "3,", "3,", "3,", "1,", "4,", "3,", "3,"}},
+		{"select a from ut1 union select a from ut2;", nil, nil, []string{"1,", "2,", "3,", "4,"}},
+		{"select a, c from ut1 union distinct select a, e from ut2;", nil, nil, []string{"3,z,", "1,y,", "1,x,", "3,null,", "2,x,", "4,w,"}},
+		{"select a from ut1 intersect select a from ut2;", nil, nil, []string{"1,", "3,"}},
+		{"select a from ut1 intersect all select a from ut2;", nil, nil, []string{"1,", "3,", "3,"}},
+		{"select a from ut1 except select a from ut2;", nil, nil, []string{"2,"}},
+		{"select a from ut1 except all select a from ut2;", nil, nil, []string{"1,", "2,", "3,"}},
+		{"select a, c from ut1 except select a, e from ut2;", nil, nil, []string{"1,y,", "3,null,", "2,x,"}},
+		{"select a from ut1 intersect select a from ut3;", nil, nil, nil},
+		{"select a from ut1 except select a from ut3;", nil, nil, []string{"3,", "2,", "1,"}},
+		{"select a as x from ut1 union select d from ut2 order by x;", nil, nil, []string{"1,", "1.5,", "2,", "2.5,", "3,", "3.5,"}},
+		{"select a from ut1 union select e from ut2;", nil, nil, []string{"3,", "2,", "1,", "z,", "x,", "w,"}},
+		{"select a from ut1 union select a from ut2 union all select b from ut1 where b > 8;", nil, nil, []string{"1,", "2,", "3,", "4,", "10,", "20,", "9,"}},
+		{"select b from ut1 where a = 1 union all (select a from ut2 where a > 3) order by b;", nil, nil, []string{"4,", "10,", "20,"}},
+		{"select a from ut1 union select a from ut2 order by a desc limit 2;", nil, nil, []string{"4,", "3,"}},
+		{"select a from ut1 where a in (select a from ut2 intersect select a from ut1);", nil, nil, []string{"1,", "1,", "3,", "3,", "3,"}},
+		{"(select a from ut1) except (select a from ut2);", nil, nil, []string{"2,"}},
+		{"select a from ut1 union select a, b from ut1;", sqlerror.New(errno.CardinalityViolation, "The used SELECT statements have a different number of columns"), nil, nil},
+		{"select a from ut1 union select dt from ut3;", sqlerror.New(errno.DatatypeMismatch, "cannot union the types INT and DATE"), nil, nil},
+		{"drop database testunion;", nil, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+		expected2 := tc.expectErr2
+
+		c := compile.New("testunion", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		var rows []string
+		for _, e := range es {
+			err := e.Compile(nil, collect(&rows))
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+			}
+			if expected1 != nil {
+				break
+			}
+			err = e.Run(1)
+			if expected2 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected2.Error(), sql)
+			}
+		}
+		if expected1 == nil && expected2 == nil {
+			requireRows(t, sql, tc.rows, rows)
+		}
+	}
+}
+
+func TestCase(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type caseTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		expectErr2 error // run err expected
+		rows       []string
+	}
+
+	testCases := []caseTestCase{
+		{"create database testcase;", nil, nil, nil},
+		{"create table ct1 (a int, b int, c varchar(10), p decimal(10,2), f double, d date);", nil, nil, nil},
+		{"insert into ct1 values (1, 10, 'x', 1.25, 0.5, '2021-01-05'), (1, 20, 'y', null, 1.5, '2021-03-01'), (2, 5, 'x', 3.10, null, null), (3, null, null, 4.00, 2.5, '2020-12-31'), (null, 8, 'z', 5.55, 3.5, '2021-06-30');", nil, nil, nil},
+		{"select a, case when a > 1 then 'big' when a = 1 then 'one' else 'none' end from ct1;", nil, nil, []string{"1,one,", "1,one,", "2,big,", "3,big,", "null,none,"}},
+		{"select a, case a when 1 then 10 when 2 then 20 end from ct1;", nil, nil, []string{"1,10,", "1,10,", "2,20,", "3,null,", "null,null,"}},
+		{"select a, case when b > 8 then b else f end, case when a > 1 then p else 0 end from ct1;", nil, nil, []string{"1,10,0.00,", "1,20,0.00,", "2,null,3.10,", "3,2.5,4.00,", "null,3.5,0.00,"}},
+		{"select a, case when a > 2 then case when b is null then 'n' else 'v' end else 'small' end from ct1;", nil, nil, []string{"1,small,", "1,small,", "2,small,", "3,n,", "null,small,"}},
+		{"select a, case when d >= '2021-01-01' then 'new' else 'old' end, case when a = 1 then d end from ct1;", nil, nil, []string{"1,new,2021-01-05,", "1,new,2021-03-01,", "2,old,null,", "3,old,null,", "null,new,null,"}},
+		{"select a, if(b > 8, 1, 0), if(a, 'y', 'n'), if(a > 1, 'big', a) from ct1;", nil, nil, []string{"1,1,y,1,", "1,1,y,1,", "2,0,y,big,", "3,0,y,big,", "null,0,n,null,"}},
+		{"select a, coalesce(p, 0), coalesce(p, a), coalesce(b, f), ifnull(f, 1), coalesce(c, a) from ct1;", nil, nil, []string{"1,1.25,1.25,10,0.5,x,", "1,0.00,1.00,20,1.5,y,", "2,3.10,3.10,5,1,x,", "3,4.00,4.00,2.5,2.5,3,", "null,5.55,5.55,8,3.5,z,"}},
+		{"select a from ct1 where case when b > 8 then 1 else 0 end = 1;", nil, nil, []string{"1,", "1,"}},
+		{"select a, b from ct1 order by case when a is null then 0 else a end desc, b;", nil, nil, []string{"3,null,", "2,5,", "1,10,", "1,20,", "null,8,"}},
+		{"select case when a > 1 then 'hi' else 'lo' end as k, count(*), sum(b) from ct1 group by k;", nil, nil, []string{"lo,3,38,", "hi,2,5,"}},
+		{"select case when a > 1 then 'hi' else 'lo' end, count(*) from ct1 group by case when a > 1 then 'hi' else 'lo' end;", nil, nil, []string{"lo,3,", "hi,2,"}},
+		{"select sum(case when a = 1 then b else 0 end), count(case when c = 'x' then 1 end), sum(if(a > 1, p, 0)) from ct1;", nil, nil, []string{"30,2,7.10,"}},
+		{"select a, sum(b) from ct1 group by a having sum(case when b > 9 then 1 else 0 end) > 0;", nil, nil, []string{"1,30,"}},
+		{"select a, case when a > 1 then d else b end from ct1;", sqlerror.New(errno.DatatypeMismatch, "cannot unify the types DATE and INT in 'case when a > 1 then d else b end'"), nil, nil},
+		{"select if(a, b) from ct1;", sqlerror.New(errno.SyntaxErrororAccessRuleViolation, "incorrect parameter count in the call to function 'if'"), nil, nil},
+		{"drop database testcase;", nil, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+		expected2 := tc.expectErr2
+
+		c := compile.New("testcase", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		var rows []string
+		for _, e := range es {
+			err := e.Compile(nil, collect(&rows))
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+			}
+			if expected1 != nil {
+				break
+			}
+			err = e.Run(1)
+			if expected2 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected2.Error(), sql)
+			}
+		}
+		if expected1 == nil && expected2 == nil {
+			requireRows(t, sql, tc.rows, rows)
+		}
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type aggregateTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		expectErr2 error // run err expected
+		rows       []string
+	}
+
+	testCases := []aggregateTestCase{
+		{"create database testagg;", nil, nil, nil},
+		{"create table at1 (a int, b int, c varchar(10), p decimal(10,2), f double, d date);", nil, nil, nil},
+		{"insert into at1 values (1, 10, 'x', 1.25, 0.5, '2021-01-05'), (1, 20, 'y', null, 1.5, '2021-03-01'), (2, 5, 'x', 3.10, null, null), (3, null, null, 4.00, 2.5, '2020-12-31'), (null, 8, 'z', 5.55, 3.5, '2021-06-30'), (2, 5, 'x', 3.10, 4.5, '2021-01-05');", nil, nil, nil},
+		{"select count(distinct c), count(c), count(distinct b), count(distinct d) from at1;", nil, nil, []string{"3,5,4,4,"}},
+		{"select a, count(distinct c), count(distinct p) from at1 group by a;", nil, nil, []string{"1,2,1,", "2,1,1,", "3,0,1,", "null,1,1,"}},
+		{"select stddev_pop(b), stddev_samp(b), var_pop(b), var_samp(b), variance(p), std(f) from at1;", nil, nil, []string{"5.535341001239218,6.18869937870632,30.639999999999997,38.3,1.957,1.4142135623730951,"}},
+		{"select a, var_samp(b), stddev(p) from at1 group by a;", nil, nil, []string{"1,50,0,", "2,0,0,", "3,null,0,", "null,null,0,"}},
+		{"select group_concat(c), group_concat(distinct c order by c desc separator '|'), group_concat(b, c order by b) from at1;", nil, nil, []string{"x,y,x,z,x,z|y|x,5x,5x,8z,10x,20y,"}},
+		{"select a, group_concat(b order by f desc separator ';') from at1 group by a;", nil, nil, []string{"1,20;10,", "2,5;5,", "3,null,", "null,8,"}},
+		{"select bit_and(b), bit_or(b), bit_xor(b) from at1;", nil, nil, []string{"0,31,22,"}},
+		{"select a, bit_xor(b), any_value(c), any_value(d), any_value(p) from at1 group by a;", nil, nil, []string{"1,30,x,2021-01-05,1.25,", "2,0,x,2021-01-05,3.10,", "3,0,null,2020-12-31,4.00,", "null,8,z,2021-06-30,5.55,"}},
+		{"select approx_count_distinct(c), approx_count_distinct(b) from at1;", nil, nil, []string{"3,4,"}},
+		{"select a, approx_count_distinct(b) from at1 group by a having count(distinct b) > 0;", nil, nil, []string{"1,2,", "2,1,", "null,1,"}},
+		{"select count(distinct b) + 1, group_concat(a) from at1 where a > 1;", nil, nil, []string{"2,2,3,2,"}},
+		{"select sum(distinct b) from at1;", sqlerror.New(errno.FeatureNotSupported, "unimplemented aggregated functions 'sum(distinct)'"), nil, nil},
+		{"select bit_and(c) from at1;", sqlerror.New(errno.UndefinedFunction, "unimplemented aggregation 'bit_and' for 'VARCHAR'"), nil, nil},
+		{"drop database testagg;", nil, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+		expected2 := tc.expectErr2
+
+		c := compile.New("testagg", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		var rows []string
+		for _, e := range es {
+			err := e.Compile(nil, collect(&rows))
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+			}
+			if expected1 != nil {
+				break
+			}
+			err = e.Run(1)
+			if expected2 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected2.Error(), sql)
+			}
+		}
+		if expected1 == nil && expected2 == nil {
+			requireRows(t, sql, tc.rows, rows)
+		}
+	}
+}
+
+func TestWindow(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type windowTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		expectErr2 error // run err expected
+		limit      int64 // memory limit of the query, the rows are spilled beyond it
+		rows       []string
+	}
+
+	testCases := []windowTestCase{
+		{"create database testwin;", nil, nil, 0, nil},
+		{"create table wt1 (a bigint, b bigint, c varchar(10), f double, d date);", nil, nil, 0, nil},
+		{"insert into wt1 values (1, 10, 'x', 0.5, '2021-01-05'), (1, 20, 'y', 1.5, '2021-03-01'), (2, 5, 'x', null, null), (3, null, null, 2.5, '2020-12-31'), (null, 8, 'z', 3.5, '2021-06-30'), (2, 5, 'x', 4.5, '2021-01-05');", nil, nil, 0, nil},
+		{"select a, b, row_number() over (order by b), rank() over (partition by c order by b), dense_rank() over (partition by c order by b desc) from wt1;", nil, nil, 0, []string{"3,null,1,1,1,", "2,5,2,1,2,", "2,5,3,1,2,", "null,8,4,1,1,", "1,10,5,3,1,", "1,20,6,1,1,"}},
+		{"select a, b, row_number() over (order by b desc) from wt1;", nil, nil, 0, []string{"1,20,1,", "1,10,2,", "null,8,3,", "2,5,4,", "2,5,5,", "3,null,6,"}},
+		{"select a, b, rank() over (partition by c order by b) + 1 as r from wt1 where b > 5 order by r, b;", nil, nil, 0, []string{"null,8,2,", "1,10,2,", "1,20,2,"}},
+		{"select c, lag(b) over (partition by c order by f), lead(b, 2, 0) over (order by f), lag(c, 1, 'none') over (order by d, f) from wt1;", nil, nil, 0, []string{"x,5,null,null,", "y,null,8,x,", "x,null,20,none,", "null,null,5,x,", "z,null,0,y,", "x,10,0,x,"}},
+		{"select a, sum(b) over (partition by c order by b rows between 1 preceding and current row) from wt1;", nil, nil, 0, []string{"1,15,", "1,20,", "2,5,", "3,null,", "null,8,", "2,10,"}},
+		{"select a, avg(f) over (order by a, f rows between unbounded preceding and 1 following), count(*) over () from wt1;", nil, nil, 0, []string{"null,2,6,", "1,1.8333333333333333,6,", "1,1.8333333333333333,6,", "2,2.5,6,", "2,2.5,6,", "3,2.5,6,"}},
+		{"select a, sum(b) over (order by a range between 1 preceding and 1 following), max(f) over (order by d range between interval 60 day preceding and current row) from wt1;", nil, nil, 0, []string{"1,40,4.5,", "1,40,4.5,", "2,40,null,", "3,10,2.5,", "null,8,3.5,", "2,40,4.5,"}},
+		{"select a, sum(b) over (order by a desc range between current row and 1 following) from wt1;", nil, nil, 0, []string{"3,10,", "2,40,", "2,40,", "1,30,", "1,30,", "null,8,"}},
+		{"select distinct c, count(c) over (partition by c) from wt1;", nil, nil, 0, []string{"null,0,", "x,3,", "y,1,", "z,1,"}},
+		{"select a, sum(b) over (order by a rows between 1 following and 1 preceding) from wt1;", sqlerror.New(errno.WindowingError, "invalid window frame 'rows between 1 following and 1 preceding'"), nil, 0, nil},
+		{"select a, sum(b) over (order by a, b range between 1 preceding and current row) from wt1;", sqlerror.New(errno.WindowingError, "window 'sum(b) over (order by a, b range between 1 preceding and current row)' with range frame needs exactly one numeric or date order key"), nil, 0, nil},
+		{"select a, lag(b, -1) over (order by a) from wt1;", sqlerror.New(errno.WindowingError, "invalid offset '-1'"), nil, 0, nil},
+		{"select a, count(*), rank() over (order by a) from wt1;", sqlerror.New(errno.FeatureNotSupported, "window functions with aggregation are not support now"), nil, 0, nil},
+		{"select c, b, row_number() over (partition by c order by b), sum(f) over (partition by c, a) from wt1;", nil, nil, 1, []string{"null,null,1,2.5,", "x,5,1,4.5,", "x,5,2,4.5,", "x,10,3,0.5,", "y,20,1,1.5,", "z,8,1,3.5,"}},
+		{"select c, row_number() over (order by b) from wt1;", nil, sqlerror.New(errno.SyntaxErrororAccessRuleViolation, "out of memory"), 1, nil},
+		{"drop database testwin;", nil, nil, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+		expected2 := tc.expectErr2
+
+		proc.Lim.Size = 10 << 32
+		if tc.limit > 0 {
+			proc.Lim.Size = tc.limit
+		}
+		c := compile.New("testwin", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		var rows []string
+		for _, e := range es {
+			err := e.Compile(nil, collect(&rows))
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+			}
+			if expected1 != nil {
+				break
+			}
+			err = e.Run(1)
+			if expected2 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected2.Error(), sql)
+			}
+		}
+		fmt.Printf("ROWS %q %#v\n", sql, rows)
+		if expected1 == nil && expected2 == nil {
+			requireRows(t, sql, tc.rows, rows)
+		}
+	}
+}
+
+func TestJson(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type jsonTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		expectErr2 error // run err expected
+		rows       []string
+	}
+
+	testCases := []jsonTestCase{
+		{"create database testjson;", nil, nil, nil},
+		{"create table jt1 (a int, j json, s varchar(50));", nil, nil, nil},
+		{"insert into jt1 values (1, '{\"k\": 1, \"arr\": [1, 2, {\"c\": \"x\"}], \"name\": \"bob\"}', '[1, 2]'), (2, '[1, 2, 3]', '{x'), (3, null, null), (4, '\"str\"', '\"a\\\\tb\"');", nil, nil, nil},
+		{"insert into jt1 (a, j) values (5, '{bad');", sqlerror.New(errno.DataException, "invalid JSON text: '{bad'"), nil, nil},
+		{"select * from jt1;", nil, nil, []string{"1,{\"k\": 1, \"arr\": [1, 2, {\"c\": \"x\"}], \"name\": \"bob\"},[1, 2],", "2,[1, 2, 3],{x,", "3,null,null,", "4,\"str\",\"a\\tb\","}},
+		{"select a, json_extract(j, '$.k'), j->'$.arr[2].c', j->>'$.arr[2].c', j->>'$.name', j->'$.arr[last]' from jt1;", nil, nil, []string{"1,1,\"x\",x,bob,{\"c\": \"x\"},", "2,null,null,null,null,null,", "3,null,null,null,null,null,", "4,null,null,null,null,null,"}},
+		{"select json_extract(j, '$.arr[*]', '$.k'), json_extract(j, '$**.c') from jt1 where a = 1;", nil, nil, []string{"[1, 2, {\"c\": \"x\"}, 1],[\"x\"],"}},
+		{"select a, json_length(j), json_length(j, '$.arr'), json_keys(j), json_valid(j), json_valid(s) from jt1;", nil, nil, []string{"1,3,3,[\"k\", \"arr\", \"name\"],1,1,", "2,3,null,null,1,0,", "3,null,null,null,null,null,", "4,1,null,null,1,1,"}},
+		{"select a from jt1 where json_contains(j, '2') = 1 or json_contains(j, '\"x\"', '$.arr[2].c') = 1;", nil, nil, []string{"1,", "2,"}},
+		{"select json_unquote(j), json_unquote(s), cast(j as char) from jt1;", nil, nil, []string{"{\"k\": 1, \"arr\": [1, 2, {\"c\": \"x\"}], \"name\": \"bob\"},[1, 2],{\"k\": 1, \"arr\": [1, 2, {\"c\": \"x\"}], \"name\": \"bob\"},", "[1, 2, 3],{x,[1, 2, 3],", "null,null,null,", "str,a\tb,\"str\","}},
+		{"select cast(s as json) from jt1 where a = 1;", nil, nil, []string{"[1, 2],"}},
+		{"select j, count(*) from jt1 group by j;", nil, nil, []string{"{\"k\": 1, \"arr\": [1, 2, {\"c\": \"x\"}], \"name\": \"bob\"},1,", "[1, 2, 3],1,", "null,1,", "\"str\",1,"}},
+		{"drop database testjson;", nil, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+		expected2 := tc.expectErr2
+
+		c := compile.New("testjson", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		var rows []string
+		for _, e := range es {
+			err := e.Compile(nil, collect(&rows))
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+			}
+			if expected1 != nil {
+				break
+			}
+			err = e.Run(1)
+			if expected2 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected2.Error(), sql)
+			}
+		}
+		if expected1 == nil && expected2 == nil {
+			requireRows(t, sql, tc.rows, rows)
+		}
+	}
+}
+
+func TestCancel(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type cancelTestCase struct {
+		testSql   string
+		cancel    bool          // cancel the query before it runs
+		timeout   time.Duration // the execution time limit of the query
+		expectErr error         // run err expected
+	}
+
+	testCases := []cancelTestCase{
+		{"create database testcancel;", false, 0, nil},
+		{"create table ct1 (a int, b varchar(10));", false, 0, nil},
+		{"insert into ct1 values (1, 'a'), (2, 'b'), (3, 'c');", false, 0, nil},
+		{"select * from ct1;", false, 0, nil},
+		{"select * from ct1;", true, 0, process.ErrQueryInterrupted},
+		{"select b, count(*) from ct1 group by b;", true, 0, process.ErrQueryInterrupted},
+		{"select * from ct1 order by a;", false, time.Nanosecond, process.ErrQueryTimeout},
+		{"select * from ct1;", false, time.Hour, nil},
+		{"drop database testcancel;", false, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		if tc.timeout > 0 {
+			proc.Ctx, proc.Cancel = context.WithTimeout(context.Background(), tc.timeout)
+		} else {
+			proc.Ctx, proc.Cancel = context.WithCancel(context.Background())
+		}
+		c := compile.New("testcancel", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		for _, e := range es {
+			err := e.Compile(nil, Print)
+			require.NoError(t, err, sql)
+			if tc.cancel {
+				proc.Cancel()
+			} else if tc.expectErr != nil {
+				// wait for the execution time limit
+				<-proc.Ctx.Done()
+			}
+			err = e.Run(1)
+			if tc.expectErr == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.Error(t, err, sql)
+				require.Contains(t, err.Error(), tc.expectErr.Error(), sql)
+			}
+		}
+		proc.Cancel()
+	}
+}
+
+func TestSpill(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	run := func(sql string, limit int64) []string {
+		var rows []string
+
+		proc.Lim.Size = 10 << 32
+		if limit > 0 {
+			proc.Lim.Size = limit
+		}
+		c := compile.New("testspill", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err, sql)
+		for _, e := range es {
+			require.NoError(t, e.Compile(nil, collect(&rows)), sql)
+			require.NoError(t, e.Run(1), sql)
+		}
+		return rows
+	}
+
+	var buf bytes.Buffer
+	run("create database testspill;", 0)
+	run("create table st1 (a bigint, b varchar(10));", 0)
+	run("create table st2 (a bigint, c bigint);", 0)
+	// each insert makes a block, so the rows reach the operators in several batches
+	for i := 0; i < 4000; i++ {
+		if i%250 > 0 {
+			buf.WriteString(", ")
+		}
+		if i%100 == 0 {
+			buf.WriteString(fmt.Sprintf("(%d, null)", i))
+		} else {
+			buf.WriteString(fmt.Sprintf("(%d, 'v%d')", i, i%700))
+		}
+		if i%250 == 249 {
+			run("insert into st1 values "+buf.String()+";", 0)
+			buf.Reset()
+		}
+	}
+	for i := 0; i < 3000; i++ {
+		if i%250 > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("(%d, %d)", i%1500, i))
+		if i%250 == 249 {
+			run("insert into st2 values "+buf.String()+";", 0)
+			buf.Reset()
+		}
+	}
+
+	type spillTestCase struct {
+		testSql string
+		sorted  bool  // the order of the rows matters
+		limit   int64 // memory limit of the query, the rows are spilled beyond it
+	}
+
+	testCases := []spillTestCase{
+		{"select a, b from st1 order by a desc;", true, 4 << 10},
+		{"select b, a from st1 order by b, a;", true, 4 << 10},
+		{"select b, count(*), sum(a) from st1 group by b;", false, 8 << 10},
+		{"select st1.a, st1.b, st2.c from st1 join st2 on st1.a = st2.a;", false, 32 << 10},
+	}
+	for _, tc := range testCases {
+		expected := run(tc.testSql, 0)
+		rows := run(tc.testSql, tc.limit)
+		if !tc.sorted {
+			gosort.Strings(expected)
+			gosort.Strings(rows)
+		}
+		require.Equal(t, expected, rows, tc.testSql)
+	}
+
+	// the operators of the segments are also run alone on a single block of 10000 rows,
+	// the merge operators can't take the result of a spilled segment under the same limit.
+	buf.Reset()
+	for i := 0; i < 10000; i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if i%1000 == 0 {
+			buf.WriteString(fmt.Sprintf("(%d, null)", i*7919%10000))
+		} else {
+			buf.WriteString(fmt.Sprintf("(%d, %d)", i*7919%10000, i%2000))
+		}
+	}
+	run("create table st3 (a bigint, c bigint);", 0)
+	run("insert into st3 values "+buf.String()+";", 0)
+	db, err := e.Database("testspill")
+	require.NoError(t, err)
+	r, err := db.Relation("st3")
+	require.NoError(t, err)
+	segRun := func(ins vm.Instructions, limit int64) []string {
+		var rows []string
+
+		proc.Lim.Size = limit
+		ins = append(ins, vm.Instruction{Code: vm.Output, Arg: &output.Argument{Func: collect(&rows)}})
+		infos := r.Segments()
+		segs := make([]engine.Segment, len(infos))
+		for i, info := range infos {
+			segs[i] = r.Segment(info, proc)
+		}
+		_, err := pipeline.New([]uint64{1, 1}, []string{"a", "c"}, ins).Run(segs, proc)
+		require.NoError(t, err)
+		return rows
+	}
+	newOrder := func() vm.Instructions {
+		return vm.Instructions{{Code: vm.Order, Arg: &order.Argument{Fs: []order.Field{{Attr: "c"}, {Attr: "a", Type: order.Descending}}}}}
+	}
+	expected := segRun(newOrder(), 0)
+	require.Equal(t, 10000, len(expected))
+	require.Equal(t, "9000,null,", expected[0])
+	require.Equal(t, expected, segRun(newOrder(), 8<<10))
+	newGroup := func() vm.Instructions {
+		return vm.Instructions{{Code: vm.Group, Arg: &group.Argument{
+			Gs:    []string{"c"},
+			Refer: map[string]uint64{"x": 1, "y": 1},
+			Es: []aggregation.Extend{
+				{Op: aggregation.Sum, Name: "a", Alias: "x"},
+				{Op: aggregation.Count, Name: "a", Alias: "y"},
+			},
+		}}}
+	}
+	expected = segRun(newGroup(), 0)
+	require.Equal(t, 1999, len(expected))
+	rows := segRun(newGroup(), 8<<10)
+	gosort.Strings(expected)
+	gosort.Strings(rows)
+	require.Equal(t, expected, rows)
+	run("drop database testspill;", 0)
+}
+
+// collect returns an output function which records the rows of the batches.
+func collect(rows *[]string) func(interface{}, *batch.Batch) error {
+	return func(_ interface{}, bat *batch.Batch) error {
+		if bat == nil || len(bat.Vecs) == 0 {
+			return nil
+		}
+		sels := bat.Sels
+		if len(sels) == 0 {
+			for i := 0; i < bat.Vecs[0].Length(); i++ {
+				sels = append(sels, int64(i))
+			}
+		}
+		for _, sel := range sels {
+			var buf bytes.Buffer
+			for _, vec := range bat.Vecs {
+				switch {
+				case vec.Nsp.Contains(uint64(sel)):
+					buf.WriteString("null,")
+				case vec.Typ.Oid == types.T_char || vec.Typ.Oid == types.T_varchar:
+					buf.WriteString(fmt.Sprintf("%s,", vec.Col.(*types.Bytes).Get(sel)))
+				case vec.Typ.Oid == types.T_json:
+					buf.WriteString(fmt.Sprintf("%s,", bytejson.ByteJson(vec.Col.(*types.Bytes).Get(sel))))
+				case vec.Typ.Oid == types.T_decimal64:
+					buf.WriteString(fmt.Sprintf("%s,", vec.Col.([]types.Decimal64)[sel].Format(vec.Typ.Precision)))
+				case vec.Typ.Oid == types.T_decimal128:
+					buf.WriteString(fmt.Sprintf("%s,", vec.Col.([]types.Decimal128)[sel].Format(vec.Typ.Precision)))
+				default:
+					buf.WriteString(fmt.Sprintf("%v,", reflect.ValueOf(vec.Col).Index(int(sel))))
+				}
+			}
+			*rows = append(*rows, buf.String())
+		}
+		return nil
+	}
+}
+
+// requireRows checks the rows of the query, the order of the rows matters
+// only if the query is ordered, an order by within the query doesn't count.
+func requireRows(t *testing.T, sql string, expected, rows []string) {
+	stmt, err := mysql.ParseOne(sql)
+	require.NoError(t, err, sql)
+	if s, ok := stmt.(*tree.Select); !ok || len(s.OrderBy) == 0 {
+		expected = append([]string{}, expected...)
+		rows = append([]string{}, rows...)
+		gosort.Strings(expected)
+		gosort.Strings(rows)
+	}
+	require.Equal(t, expected, rows, sql)
+}
+
+func TestDeleteUpdate(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type mutationTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		rows       int   // affected rows of mutations, or rows of queries
+		values     []string
+	}
+
+	testCases := []mutationTestCase{
+		{"create database testmutation;", nil, 0, nil},
+		{"create table t1 (a int, b bigint, c double);", nil, 0, nil},
+		{"insert into t1 values (1, 10, 1.5), (2, 20, 2.5), (3, 30, 3.5), (4, 40, 4.5);", nil, 4, nil},
+		{"insert into t1 values (5, 50, 5.5), (6, 60, 6.5);", nil, 2, nil},
+		{"delete from t1 where a = 2;", nil, 1, nil},
+		{"select * from t1;", nil, 5, nil},
+		{"delete from t1 where b > 35 and c < 6;", nil, 2, nil},
+		{"select * from t1;", nil, 3, nil},
+		{"delete from t1 where 1 = 0;", nil, 0, nil},
+		{"select * from t1 where a = 3;", nil, 1, nil},
+		{"update t1 set b = b * 2, c = 0 where a >= 3;", nil, 2, nil},
+		{"select * from t1 where b = 60;", nil, 1, nil},
+		{"select * from t1 where b = 120 and c = 0;", nil, 1, nil},
+		{"select * from t1;", nil, 3, nil},
+		{"update t1 set a = 7;", nil, 3, nil},
+		{"select * from t1 where a = 7;", nil, 3, nil},
+		{"update t1 set b = b + 1 where a = 100;", nil, 0, nil},
+		{"update t1 set b = b + 1, c = b where b = 120;", nil, 1, nil},
+		{"select * from t1 where b = 121 and c = 120;", nil, 1, nil},
+		{"update t1 set d = 1;", sqlerror.New(errno.UndefinedColumn, "unknown column 'd' in 'field list'"), 0, nil},
+		{"update t1 set a = 1, a = 2;", sqlerror.New(errno.DuplicateColumn, "column 'a' specified twice"), 0, nil},
+		{"delete from t1;", nil, 3, nil},
+		{"select * from t1;", nil, 0, nil},
+		{"create table t2 (x int, s varchar(10));", nil, 0, nil},
+		{"insert into t2 values (1, 'a'), (2, 'b'), (3, 'c'), (4, 'd');", nil, 4, nil},
+		{"delete from t2 where x = 2;", nil, 1, nil},
+		{"select * from t2;", nil, 3, []string{"1,a,", "3,c,", "4,d,"}},
+		{"update t2 set s = 'cc' where x = 3;", nil, 1, nil},
+		{"select * from t2;", nil, 3, []string{"1,a,", "3,cc,", "4,d,"}},
+		{"update t2 set x = x + 10 where s = 'a';", nil, 1, nil},
+		{"delete from t2 where s = 'd';", nil, 1, nil},
+		{"select * from t2;", nil, 2, []string{"3,cc,", "11,a,"}},
+		{"drop database testmutation;", nil, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+
+		rows := 0
+		var values []string
+		collectValues := collect(&values)
+		fill := func(u interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				rows += bat.Vecs[0].Length()
+			}
+			return collectValues(u, bat)
+		}
+		c := compile.New("testmutation", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		for _, e := range es {
+			err := e.Compile(nil, fill)
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+				break
+			}
+			require.NoError(t, e.Run(1), sql)
+			if affected := e.GetAffectedRows(); affected > 0 {
+				rows = int(affected)
+			}
+		}
+		require.Equal(t, tc.rows, rows, sql)
+		if tc.values != nil {
+			requireRows(t, sql, tc.values, values)
+		}
+	}
+}
+
+func TestSubquery(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type subqueryTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		expectErr2 error // run err expected
+		rows       int   // affected rows of mutations, or rows of queries
+	}
+
+	testCases := []subqueryTestCase{
+		{"create database testsubquery;", nil, nil, 0},
+		{"create table t1 (a int, b float, c varchar(10));", nil, nil, 0},
+		{"create table t2 (a bigint, b double, c char(10));", nil, nil, 0},
+		{"insert into t1 values (1, 1.5, 'x'), (2, 2.5, 'y'), (3, 3.5, 'z'), (4, 4.5, 'w');", nil, nil, 4},
+		{"insert into t2 values (1, 10.0, 'x'), (3, 30.0, 'q'), (3, 31.0, 'z'), (5, 50.0, 'v');", nil, nil, 4},
+		// in lists
+		{"select a from t1 where a in (1, 3, 1000);", nil, nil, 2},
+		{"select a from t1 where a not in (1, 3);", nil, nil, 2},
+		{"select a from t1 where a in (1.5, 2);", nil, nil, 1},
+		{"select a from t1 where c in ('x', 'z');", nil, nil, 2},
+		{"select a from t1 where (a, c) in ((1, 'x'), (3, 'q'));", nil, nil, 1},
+		{"select a from t1 where a in (b, 2);", nil, nil, 1},
+		{"select a from t1 where a not in (1, 3) and b > 2;", nil, nil, 2},
+		{"select a from t1 where (a, c) in ((1, 'x'), (3));", sqlerror.New(errno.CardinalityViolation, "operand should contain 2 column(s)"), nil, 0},
+		// uncorrelated subqueries
+		{"select a from t1 where a in (select a from t2);", nil, nil, 2},
+		{"select a from t1 where a not in (select a from t2);", nil, nil, 2},
+		{"select a from t1 where a in (select a from t2 where b > 20);", nil, nil, 1},
+		{"select count(*) from t1 where a in (select a from t2);", nil, nil, 1},
+		{"select a from t1 where exists (select * from t2);", nil, nil, 4},
+		{"select a from t1 where exists (select * from t2 where a > 100);", nil, nil, 0},
+		{"select a from t1 where not exists (select * from t2 where a > 100);", nil, nil, 4},
+		{"select a from t1 where b > (select avg(b) from t1);", nil, nil, 2},
+		{"select a from t1 where a = (select min(a) from t2);", nil, nil, 1},
+		{"select a from t1 where a = (select a from t2 where a > 100);", nil, nil, 0},
+		{"select a from t1 where a = (select a from t2);", sqlerror.New(errno.CardinalityViolation, "subquery returns more than 1 row"), nil, 0},
+		{"select a from t1 where a = (select a, b from t2);", sqlerror.New(errno.CardinalityViolation, "operand should contain 1 column(s)"), nil, 0},
+		// correlated subqueries
+		{"select a from t1 where exists (select * from t2 where t2.a = t1.a);", nil, nil, 2},
+		{"select a from t1 where not exists (select * from t2 where t2.a = t1.a);", nil, nil, 2},
+		{"select a from t1 where exists (select * from t2 where t2.a = t1.a and t2.b > 30);", nil, nil, 1},
+		{"select a from t1 where c in (select c from t2 where t2.a = t1.a);", nil, nil, 2},
+		{"select a from t1 where b > (select max(b) - 20 from t2 where t2.a = t1.a);", nil, nil, 1},
+		{"select a from t1 where 0 = (select count(*) from t2 where t2.a = t1.a);", nil, nil, 2},
+		{"select a from t1 where b < (select b from t2 where t2.a = t1.a);", nil, overload.ErrMoreThanOneRow, 0},
+		{"select a from t1 where exists (select * from t2 where t2.a > t1.a);", sqlerror.New(errno.FeatureNotSupported, "correlated condition 't2.a > t1.a' is not support now"), nil, 0},
+		{"drop database testsubquery;", nil, nil, 0},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+		expected2 := tc.expectErr2
+
+		rows := 0
+		fill := func(_ interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				rows += bat.Vecs[0].Length()
+			}
+			return nil
+		}
+		c := compile.New("testsubquery", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		for _, e := range es {
+			err := e.Compile(nil, fill)
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+				break
+			}
+			err = e.Run(1)
+			if expected2 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				// errors of the pipelines are wrapped by each merge
+				require.Error(t, err, sql)
+				require.Contains(t, err.Error(), expected2.Error(), sql)
+				break
+			}
+			if affected := e.GetAffectedRows(); affected > 0 {
+				rows = int(affected)
+			}
+		}
+		require.Equal(t, tc.rows, rows, sql)
+	}
+}
+
+func TestNull(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type nullTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		expectErr2 error // run err expected
+		rows       int   // affected rows of mutations, or rows of queries
+	}
+
+	testCases := []nullTestCase{
+		{"create database testnull;", nil, nil, 0},
+		{"create table t1 (a int, b float, c varchar(10));", nil, nil, 0},
+		{"insert into t1 values (1, 1.5, 'x'), (null, 2.5, 'y'), (3, null, null), (4, 4.5, 'w');", nil, nil, 4},
+		{"select a from t1 where a is null;", nil, nil, 1},
+		{"select a from t1 where a is not null;", nil, nil, 3},
+		{"select a from t1 where not a is null;", nil, nil, 3},
+		{"select a from t1 where a is null or b is null;", nil, nil, 2},
+		{"select a from t1 where not (a is null or b is null);", nil, nil, 2},
+		{"select a from t1 where c is null and a > 2;", nil, nil, 1},
+		{"select count(*) from t1 where c is not null;", nil, nil, 1},
+		{"select a from t1 where null is null;", nil, nil, 4},
+		{"select a from t1 where a + null is null;", nil, nil, 4},
+		{"select a from t1 where coalesce(a, null) is null;", nil, nil, 1},
+		{"select a from t1 where (a > 2) is null;", sqlerror.New(errno.FeatureNotSupported, "'(a > 2) is null' is not support now, the operand of is [not] null can't be a predicate"), nil, 0},
+		{"select a from t1 where a in (1, 3) is not null;", sqlerror.New(errno.FeatureNotSupported, "'a in (1, 3) is not null' is not support now, the operand of is [not] null can't be a predicate"), nil, 0},
+		// three-valued logic
+		{"select a from t1 where a = null;", nil, nil, 0},
+		{"select a from t1 where not (a = null);", nil, nil, 0},
+		{"select a from t1 where null;", nil, nil, 0},
+		{"select a from t1 where not null;", nil, nil, 0},
+		{"select a from t1 where a <> 1;", nil, nil, 2},
+		{"select a from t1 where not (a > 2);", nil, nil, 1},
+		{"select a from t1 where not (not (a > 2));", nil, nil, 2},
+		{"select a from t1 where a > 2 or b > 2;", nil, nil, 3},
+		{"select a from t1 where not (a > 2 or b > 2);", nil, nil, 1},
+		{"select a from t1 where not (a > 2 and b > 2);", nil, nil, 1},
+		{"select a from t1 where b > 2 and not (a = 3 or c = 'y');", nil, nil, 1},
+		{"drop database testnull;", nil, nil, 0},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+		expected2 := tc.expectErr2
+
+		rows := 0
+		fill := func(_ interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				rows += bat.Vecs[0].Length()
+			}
+			return nil
+		}
+		c := compile.New("testnull", sql, "admin", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err)
+		for _, e := range es {
+			err := e.Compile(nil, fill)
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+				break
+			}
+			err = e.Run(1)
+			if expected2 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				// errors of the pipelines are wrapped by each merge
+				require.Error(t, err, sql)
+				require.Contains(t, err.Error(), expected2.Error(), sql)
+				break
+			}
+			if affected := e.GetAffectedRows(); affected > 0 {
+				rows = int(affected)
+			}
+		}
+		require.Equal(t, tc.rows, rows, sql)
+	}
+}
+
+func TestAlterTable(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type alterTestCase struct {
+		testSql    string
+		expectErr1 error // compile err expected
+		rows       int   // affected rows of mutations, or rows of queries
+	}
+
+	testCases := []alterTestCase{
+		{"create database testalter;", nil, 0},
+		{"create table t1 (a int, b bigint, c varchar(10));", nil, 0},
+		{"insert into t1 values (1, 10, 'x'), (2, 20, 'y'), (3, 30, 'z');", nil, 3},
+		{"alter table t1 add column d int default 7;", nil, 0},
+		{"select * from t1 where d = 7;", nil, 3},
+		{"insert into t1 values (4, 40, 'w', 8);", nil, 1},
+		{"select * from t1 where d = 7;", nil, 3},
+		{"select d from t1 where a = 4 and d = 8;", nil, 1},
+		{"alter table t1 add e varchar(10);", nil, 0},
+		{"select * from t1 where e is null;", nil, 4},
+		{"alter table t1 drop column b;", nil, 0},
+		{"select b from t1;", sqlerror.New(errno.SyntaxErrororAccessRuleViolation, "unknown column 'b' in expr"), 0},
+		{"alter table t1 rename column c to f;", nil, 0},
+		{"select * from t1 where f = 'y';", nil, 1},
+		{"alter table t1 add a int default 0;", sqlerror.New(errno.DuplicateColumn, "Duplicate column name 'a'"), 0},
+		{"alter table t1 drop column g;", sqlerror.New(errno.UndefinedColumn, "Can't DROP 'g'; check that column exists"), 0},
+		{"alter table t1 add g int not null;", sqlerror.New(errno.InvalidColumnDefinition, "column 'g' added must have a default value"), 0},
+		{"alter table t1 drop a, drop d, drop e, drop f;", sqlerror.New(errno.InvalidTableDefinition, "You can't delete all columns with ALTER TABLE; use DROP TABLE instead"), 0},
+		{"drop database testalter;", nil, 0},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		expected1 := tc.expectErr1
+
+		rows := 0
+		fill := func(_ interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				rows += bat.Vecs[0].Length()
+			}
+			return nil
+		}
+		c := compile.New("testalter", sql, "admin", e, proc)
+		es, err := c.Build()
+		if err != nil {
+			require.EqualError(t, err, expected1.Error(), sql)
+			continue
+		}
+		for _, e := range es {
+			err := e.Compile(nil, fill)
+			if expected1 == nil {
+				require.NoError(t, err, sql)
+			} else {
+				require.EqualError(t, err, expected1.Error(), sql)
+				break
+			}
+			require.NoError(t, e.Run(1), sql)
+			if affected := e.GetAffectedRows(); affected > 0 {
+				rows = int(affected)
+			}
+		}
+		require.Equal(t, tc.rows, rows, sql)
+	}
+}
+
+func TestAnalyzeTable(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	run := func(sql string) []*batch.Batch {
+		var bats []*batch.Batch
+		fill := func(_ interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				bats = append(bats, bat)
+			}
+			return nil
+		}
+		es, err := compile.New("testanalyze", sql, "admin", e, proc).Build()
+		require.NoError(t, err, sql)
+		for _, e := range es {
+			require.NoError(t, e.Compile(nil, fill), sql)
+			require.NoError(t, e.Run(1), sql)
+		}
+		return bats
+	}
+	str := func(vec *vector.Vector, i int) string {
+		return string(vec.Col.(*types.Bytes).Get(int64(i)))
+	}
+
+	run("create database testanalyze;")
+	run("create table t1 (a int, b varchar(10), c date);")
+	run("insert into t1 values (1, 'x', '2021-01-01'), (2, 'y', null), (2, null, null), (5, 'z', null);")
+
+	// the statistics are not collected before ANALYZE TABLE
+	bats := run("show stats from t1;")
+	require.Equal(t, 1, len(bats))
+	require.Equal(t, 0, bats[0].Vecs[0].Length())
+
+	bats = run("analyze table t1;")
+	require.Equal(t, 1, len(bats))
+	require.Equal(t, "testanalyze.t1", str(bats[0].Vecs[0], 0))
+	require.Equal(t, "OK", str(bats[0].Vecs[3], 0))
+
+	bats = run("show stats from t1;")
+	require.Equal(t, 1, len(bats))
+	bat := bats[0]
+	require.Equal(t, 3, bat.Vecs[0].Length())
+	require.Equal(t, []int64{4, 4, 4}, bat.Vecs[2].Col.([]int64))
+	require.Equal(t, []int64{0, 1, 3}, bat.Vecs[3].Col.([]int64))
+	require.Equal(t, []int64{3, 3, 1}, bat.Vecs[4].Col.([]int64))
+	for i, expected := range [][2]string{{"1", "5"}, {"x", "z"}, {"2021-01-01", "2021-01-01"}} {
+		require.Equal(t, expected[0], str(bat.Vecs[5], i))
+		require.Equal(t, expected[1], str(bat.Vecs[6], i))
+	}
+
+	// the statistics follow the column renamed
+	run("alter table t1 rename column a to d;")
+	bats = run("show stats from t1;")
+	names := make(map[string]bool)
+	for i := 0; i < bats[0].Vecs[1].Length(); i++ {
+		names[str(bats[0].Vecs[1], i)] = true
+	}
+	require.Equal(t, map[string]bool{"b": true, "c": true, "d": true}, names)
+
+	es, err := compile.New("testanalyze", "analyze table t1, t2;", "admin", e, proc).Build()
+	require.NoError(t, err)
+	require.Error(t, es[0].Compile(nil, func(_ interface{}, _ *batch.Batch) error { return nil }))
+	run("drop database testanalyze;")
+}
+
+func TestPartition(t *testing.T) {
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	{
+		proc.Id = "0"
+		proc.Lim.Size = 10 << 32
+		proc.Lim.BatchRows = 10 << 32
+		proc.Lim.PartitionRows = 10 << 32
+		proc.Refer = make(map[string]uint64)
+	}
+	e, err := testutil.NewTestEngine()
+	require.NoError(t, err)
+
+	srv, err := testutil.NewTestServer(e, proc)
+	require.NoError(t, err)
+	go srv.Run()
+	defer srv.Stop()
+
+	type partitionTestCase struct {
+		testSql string
+		err     string // part of the error expected
+		rows    int    // affected rows of mutations, or rows of queries
+		values  []string
+	}
+
+	testCases := []partitionTestCase{
+		{"create database testpartition;", "", 0, nil},
+		{"create table t1 (a int, b varchar(10)) partition by range(a) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than maxvalue);", "", 0, nil},
+		{"insert into t1 values (1, 'a'), (5, 'b'), (12, 'c'), (25, 'd'), (null, 'e');", "", 5, nil},
+		{"select * from t1;", "", 5, []string{"1,a,", "5,b,", "12,c,", "25,d,", "null,e,"}},
+		{"select * from t1 where a < 10;", "", 2, []string{"1,a,", "5,b,"}},
+		{"select * from t1 where a = 12;", "", 1, []string{"12,c,"}},
+		{"select * from t1 where a in (1, 25);", "", 2, nil},
+		{"select * from t1 where a >= 20;", "", 1, []string{"25,d,"}},
+		{"select * from t1 where a is null;", "", 1, []string{"null,e,"}},
+		{"select * from t1 where a = 12 and a < 10;", "", 0, nil},
+		{"alter table t1 drop partition p1;", "", 0, nil},
+		{"select * from t1;", "", 4, []string{"1,a,", "5,b,", "25,d,", "null,e,"}},
+		{"insert into t1 values (15, 'f');", "", 1, nil},
+		{"select * from t1 where a = 15;", "", 1, []string{"15,f,"}},
+		{"alter table t1 drop partition p9;", "Error in list of partitions to DROP", 0, nil},
+		{"alter table t1 add partition (partition p3 values less than (30));", "VALUES LESS THAN value must be strictly increasing for each partition", 0, nil},
+		{"alter table t1 drop column a;", "can't drop the partition field 'a'", 0, nil},
+		{"create table t2 (a int, b int) partition by list(a) (partition p0 values in (1, 3), partition p1 values in (2, 4));", "", 0, nil},
+		{"insert into t2 values (1, 1), (2, 2), (3, 3);", "", 3, nil},
+		{"insert into t2 values (5, 5);", "Table has no partition for value 5", 0, nil},
+		{"select * from t2 where a = 3;", "", 1, nil},
+		{"select * from t2 where a > 1;", "", 2, nil},
+		{"alter table t2 add partition (partition p2 values in (5));", "", 0, nil},
+		{"insert into t2 values (5, 5);", "", 1, nil},
+		{"select * from t2 where a = 5;", "", 1, nil},
+		{"alter table t2 drop partition p0, p1;", "", 0, nil},
+		{"select * from t2;", "", 1, nil},
+		{"alter table t2 drop partition p2;", "Cannot remove all partitions, use DROP TABLE instead", 0, nil},
+		{"create table t5 (a int, b varchar(10)) partition by list(a) (partition p0 values in (1, 3), partition p1 values in (2, 4));", "", 0, nil},
+		{"insert into t5 values (1, 'a'), (2, 'bb'), (3, 'ccc'), (4, 'dddd');", "", 4, nil},
+		{"select * from t5 where a in (1, 3);", "", 2, []string{"1,a,", "3,ccc,"}},
+		{"select * from t5 where a in (2, 4);", "", 2, []string{"2,bb,", "4,dddd,"}},
+		{"create table t3 (a int) partition by hash(a) partitions 3;", "", 0, nil},
+		{"insert into t3 values (1), (2), (3), (4), (5), (6);", "", 6, nil},
+		{"select * from t3 where a = 4;", "", 1, nil},
+		{"select * from t3 where a in (2, 6);", "", 2, nil},
+		{"create table t6 (a int, b varchar(10)) partition by hash(a) partitions 3;", "", 0, nil},
+		{"insert into t6 values (1, 'a'), (2, 'bb'), (3, 'ccc'), (4, 'dddd'), (5, 'eeeee');", "", 5, nil},
+		{"select * from t6 where a = 3;", "", 1, []string{"3,ccc,"}},
+		{"select * from t6;", "", 5, []string{"1,a,", "2,bb,", "3,ccc,", "4,dddd,", "5,eeeee,"}},
+		{"alter table t3 drop partition p0;", "DROP PARTITION can only be used on RANGE/LIST partitions", 0, nil},
+		{"create table t4 (a int) partition by range(a + 1) (partition p0 values less than (10));", "only columns can be used", 0, nil},
+		{"create table t4 (a int) partition by list(a) (partition p0 values in (1), partition p1 values in (1));", "Multiple definition of same constant in list partitioning", 0, nil},
+		{"create table t4 (a int) partition by range(a) (partition p0 values less than (10), partition p1 values less than (5));", "VALUES LESS THAN value must be strictly increasing for each partition", 0, nil},
+		{"create table t4 (a int) partition by range(b) (partition p0 values less than (10));", "Unknown column 'b' in 'partition function'", 0, nil},
+		{"create table t4 (a int);", "", 0, nil},
+		{"alter table t4 add partition (partition p0 values less than (10));", "Partition management on a not partitioned table is not possible", 0, nil},
+		{"drop database testpartition;", "", 0, nil},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.testSql
+		rows := 0
+		var values []string
+		collectValues := collect(&values)
+		fill := func(u interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				rows += bat.Vecs[0].Length()
+			}
+			return collectValues(u, bat)
+		}
+		run := func() error {
+			es, err := compile.New("testpartition", sql, "admin", e, proc).Build()
+			if err != nil {
+				return err
+			}
+			for _, e := range es {
+				if err := e.Compile(nil, fill); err != nil {
+					return err
+				}
+				if err := e.Run(1); err != nil {
+					return err
+				}
+				if affected := e.GetAffectedRows(); affected > 0 {
+					rows = int(affected)
+				}
+			}
+			return nil
+		}
+		if err := run(); tc.err != "" {
+			require.Error(t, err, sql)
+			require.Contains(t, err.Error(), tc.err, sql)
+			continue
+		} else {
+			require.NoError(t, err, sql)
+		}
+		require.Equal(t, tc.rows, rows, sql)
+		if tc.values != nil {
+			requireRows(t, sql, tc.values, values)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package abs
+
+import (
+	"math"
+)
+
+var (
+	Int8Abs    func([]int8, []int8) []int8
+	Int16Abs   func([]int16, []int16) []int16
+	Int32Abs   func([]int32, []int32) []int32
+	Int64Abs   func([]int64, []int64) []int64
+	Float32Abs func([]float32, []float32) []float32
+	Float64Abs func([]float64, []float64) []float64
+)
+
+func init() {
+	Int8Abs = int8Abs
+	Int16Abs = int16Abs
+	Int32Abs = int32Abs
+	Int64Abs = int64Abs
+	Float32Abs = float32Abs
+	Float64Abs = float64Abs
+}
+
+func int8Abs(xs, rs []int8) []int8 {
+	for i, x := range xs {
+		if x < 0 {
+			rs[i] = -x
+		} else {
+			rs[i] = x
+		}
+	}
+	return rs
+}
+
+func int16Abs(xs, rs []int16) []int16 {
+	for i, x := range xs {
+		if x < 0 {
+			rs[i] = -x
+		} else {
+			rs[i] = x
+		}
+	}
+	return rs
+}
+
+func int32Abs(xs, rs []int32) []int32 {
+	for i, x := range xs {
+		if x < 0 {
+			rs[i] = -x
+		} else {
+			rs[i] = x
+		}
+	}
+	return rs
+}
+
+func int64Abs(xs, rs []int64) []int64 {
+	for i, x := range xs {
+		if x < 0 {
+			rs[i] = -x
+		} else {
+			rs[i] = x
+		}
+	}
+	return rs
+}
+
+func float32Abs(xs, rs []float32) []float32 {
+	for i, x := range xs {
+		rs[i] = float32(math.Abs(float64(x)))
+	}
+	return rs
+}
+
+func float64Abs(xs, rs []float64) []float64 {
+	for i, x := range xs {
+		rs[i] = math.Abs(x)
+	}
+	return rs
+}
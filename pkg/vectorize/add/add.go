@@ -14,6 +14,8 @@
 
 package add
 
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
 var (
 	Int8Add              func([]int8, []int8, []int8) []int8
 	Int8AddSels          func([]int8, []int8, []int8, []int64) []int8
@@ -108,6 +110,9 @@ var (
 	Uint8Uint16AddScalar	func(uint8, []uint16, []uint16) []uint16
 	Uint8Uint16AddSels	func([]uint8, []uint16, []uint16, []int64) []uint16
 	Uint8Uint16AddScalarSels	func(uint8, []uint16, []uint16, []int64) []uint16
+
+	Decimal128Add     func([]types.Decimal128, []types.Decimal128, []types.Decimal128) []types.Decimal128
+	Decimal128AddSels func([]types.Decimal128, []types.Decimal128, []types.Decimal128, []int64) []types.Decimal128
 )
 
 func int8Add(xs, ys, rs []int8) []int8 {
@@ -753,3 +758,17 @@ func uint8Uint16AddScalarSels(x uint8, ys, rs []uint16, sels []int64) []uint16 {
 	}
 	return rs
 }
+
+func decimal128Add(xs, ys, rs []types.Decimal128) []types.Decimal128 {
+	for i, x := range xs {
+		rs[i] = types.Decimal128Add(x, ys[i])
+	}
+	return rs
+}
+
+func decimal128AddSels(xs, ys, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+	for _, sel := range sels {
+		rs[sel] = types.Decimal128Add(xs[sel], ys[sel])
+	}
+	return rs
+}
@@ -200,6 +200,9 @@ func init() {
 	Uint8Uint16AddScalar = uint8Uint16AddScalar
 	Uint8Uint16AddSels = uint8Uint16AddSels
 	Uint8Uint16AddScalarSels = uint8Uint16AddScalarSels
+
+	Decimal128Add = decimal128Add
+	Decimal128AddSels = decimal128AddSels
 }
 
 func int8AddAvx2(xs, ys, rs []int8) []int8 {
@@ -111,4 +111,7 @@ func init() {
 	Uint8Uint16AddScalar = uint8Uint16AddScalar
 	Uint8Uint16AddSels = uint8Uint16AddSels
 	Uint8Uint16AddScalarSels = uint8Uint16AddScalarSels
+
+	Decimal128Add = decimal128Add
+	Decimal128AddSels = decimal128AddSels
 }
@@ -0,0 +1,43 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ceil
+
+import (
+	"math"
+)
+
+var (
+	Float32Ceil func([]float32, []float32) []float32
+	Float64Ceil func([]float64, []float64) []float64
+)
+
+func init() {
+	Float32Ceil = float32Ceil
+	Float64Ceil = float64Ceil
+}
+
+func float32Ceil(xs, rs []float32) []float32 {
+	for i, x := range xs {
+		rs[i] = float32(math.Ceil(float64(x)))
+	}
+	return rs
+}
+
+func float64Ceil(xs, rs []float64) []float64 {
+	for i, x := range xs {
+		rs[i] = math.Ceil(x)
+	}
+	return rs
+}
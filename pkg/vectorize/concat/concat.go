@@ -0,0 +1,45 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concat
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	// Concat concatenates the i-th strings of xs row by row,
+	// the arguments marked as constant in cs only hold one value.
+	Concat func([]*types.Bytes, []bool, int, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Concat = concat
+}
+
+func concat(xs []*types.Bytes, cs []bool, n int, rs *types.Bytes) *types.Bytes {
+	for i := 0; i < n; i++ {
+		o := uint32(len(rs.Data))
+		for j, x := range xs {
+			if cs[j] {
+				rs.Data = append(rs.Data, x.Get(0)...)
+			} else {
+				rs.Data = append(rs.Data, x.Get(int64(i))...)
+			}
+		}
+		rs.Offsets = append(rs.Offsets, o)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-o)
+	}
+	return rs
+}
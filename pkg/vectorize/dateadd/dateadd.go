@@ -0,0 +1,151 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateadd
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// interval units
+const (
+	MicroSecond = iota
+	Second
+	Minute
+	Hour
+	Day
+	Week
+	Month
+	Quarter
+	Year
+)
+
+// Units contains the names of interval units.
+var Units = [...]string{"microsecond", "second", "minute", "hour", "day", "week", "month", "quarter", "year"}
+
+var (
+	// DateAdd adds n units to every date, a ns slice of length 1 is treated as a constant.
+	DateAdd func([]types.Date, []int64, int, []types.Date) []types.Date
+	// DatetimeAdd adds n units to every datetime, a ns slice of length 1 is treated as a constant.
+	DatetimeAdd func([]types.Datetime, []int64, int, []types.Datetime) []types.Datetime
+)
+
+var daysOfMonth = [...]uint8{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+func init() {
+	DateAdd = dateAdd
+	DatetimeAdd = datetimeAdd
+}
+
+// IsDateUnit returns true if unit can be added to a date without turning it into a datetime.
+func IsDateUnit(unit int) bool {
+	return unit >= Day
+}
+
+func dateAdd(xs []types.Date, ns []int64, unit int, rs []types.Date) []types.Date {
+	for i, x := range xs {
+		n := ns[0]
+		if len(ns) > 1 {
+			n = ns[i]
+		}
+		switch unit {
+		case Day:
+			rs[i] = x + types.Date(n)
+		case Week:
+			rs[i] = x + types.Date(n*7)
+		case Month:
+			rs[i] = addMonths(x, n)
+		case Quarter:
+			rs[i] = addMonths(x, n*3)
+		case Year:
+			rs[i] = addMonths(x, n*12)
+		default:
+			rs[i] = x
+		}
+	}
+	return rs
+}
+
+func datetimeAdd(xs []types.Datetime, ns []int64, unit int, rs []types.Datetime) []types.Datetime {
+	for i, x := range xs {
+		n := ns[0]
+		if len(ns) > 1 {
+			n = ns[i]
+		}
+		switch unit {
+		case MicroSecond:
+			rs[i] = addMicroSeconds(x, n)
+		case Second:
+			rs[i] = x + types.Datetime(n<<20)
+		case Minute:
+			rs[i] = x + types.Datetime((n*60)<<20)
+		case Hour:
+			rs[i] = x + types.Datetime((n*3600)<<20)
+		case Day:
+			rs[i] = x + types.Datetime((n*86400)<<20)
+		case Week:
+			rs[i] = x + types.Datetime((n*7*86400)<<20)
+		case Month:
+			rs[i] = addDatetimeMonths(x, n)
+		case Quarter:
+			rs[i] = addDatetimeMonths(x, n*3)
+		case Year:
+			rs[i] = addDatetimeMonths(x, n*12)
+		default:
+			rs[i] = x
+		}
+	}
+	return rs
+}
+
+// addMicroSeconds adds n microseconds to x, the low 20 bits of a datetime hold its microseconds.
+func addMicroSeconds(x types.Datetime, n int64) types.Datetime {
+	sec, usec := int64(x)>>20, int64(x)&(1<<20-1)
+	usec += n
+	sec += usec / 1000000
+	if usec %= 1000000; usec < 0 {
+		usec += 1000000
+		sec--
+	}
+	return types.Datetime(sec<<20 + usec)
+}
+
+func addDatetimeMonths(x types.Datetime, n int64) types.Datetime {
+	d := addMonths(x.ToDate(), n)
+	year, month, day, _ := d.Calendar(true)
+	hour, min, sec := x.Clock()
+	return types.FromClock(year, month, day, uint8(hour), uint8(min), uint8(sec), uint32(int64(x)&(1<<20-1)))
+}
+
+// addMonths adds n months to d, the day is clipped to the last day of the resulting month.
+func addMonths(d types.Date, n int64) types.Date {
+	year, month, day, _ := d.Calendar(true)
+	m := int64(year)*12 + int64(month-1) + n
+	y := m / 12
+	if m %= 12; m < 0 {
+		m += 12
+		y--
+	}
+	if days := daysIn(int32(y), uint8(m+1)); day > days {
+		day = days
+	}
+	return types.FromCalendar(int32(y), uint8(m+1), day)
+}
+
+func daysIn(year int32, month uint8) uint8 {
+	if month == 2 && year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+		return 29
+	}
+	return daysOfMonth[month-1]
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateadd
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDateAdd(t *testing.T) {
+	xs := []types.Date{types.FromCalendar(2020, 1, 31), types.FromCalendar(2021, 12, 31)}
+	rs := DateAdd(xs, []int64{1}, Month, make([]types.Date, len(xs)))
+	require.Equal(t, types.FromCalendar(2020, 2, 29), rs[0])
+	require.Equal(t, types.FromCalendar(2022, 1, 31), rs[1])
+	rs = DateAdd(xs, []int64{-1, 1}, Day, make([]types.Date, len(xs)))
+	require.Equal(t, types.FromCalendar(2020, 1, 30), rs[0])
+	require.Equal(t, types.FromCalendar(2022, 1, 1), rs[1])
+	rs = DateAdd(xs, []int64{-13}, Month, make([]types.Date, len(xs)))
+	require.Equal(t, types.FromCalendar(2018, 12, 31), rs[0])
+	require.Equal(t, types.FromCalendar(2020, 11, 30), rs[1])
+}
+
+func TestDatetimeAdd(t *testing.T) {
+	xs := []types.Datetime{types.FromClock(2021, 3, 31, 23, 59, 59, 999999)}
+	rs := DatetimeAdd(xs, []int64{1}, MicroSecond, make([]types.Datetime, len(xs)))
+	require.Equal(t, types.FromClock(2021, 4, 1, 0, 0, 0, 0), rs[0])
+	rs = DatetimeAdd(xs, []int64{-1}, Month, make([]types.Datetime, len(xs)))
+	require.Equal(t, types.FromClock(2021, 2, 28, 23, 59, 59, 999999), rs[0])
+	rs = DatetimeAdd(xs, []int64{2}, Hour, make([]types.Datetime, len(xs)))
+	require.Equal(t, types.FromClock(2021, 4, 1, 1, 59, 59, 999999), rs[0])
+}
@@ -14,6 +14,8 @@
 
 package div
 
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
 var (
 	Int8Div                func([]int8, []int8, []int8) []int8
 	Int8DivSels            func([]int8, []int8, []int8, []int64) []int8
@@ -82,6 +84,9 @@ var (
 	Float64IntegerDivScalarSels   func(float64, []float64, []int64, []int64) []int64
 	Float64IntegerDivByScalar     func(float64, []float64, []int64) []int64
 	Float64IntegerDivByScalarSels func(float64, []float64, []int64, []int64) []int64
+
+	Decimal128Div     func([]types.Decimal128, []types.Decimal128, []types.Decimal128) []types.Decimal128
+	Decimal128DivSels func([]types.Decimal128, []types.Decimal128, []types.Decimal128, []int64) []types.Decimal128
 )
 
 func init() {
@@ -152,6 +157,9 @@ func init() {
 	Float64IntegerDivScalarSels = float64IntegerDivScalarSels
 	Float64IntegerDivByScalar = float64IntegerDivByScalar
 	Float64IntegerDivByScalarSels = float64IntegerDivByScalarSels
+
+	Decimal128Div = decimal128Div
+	Decimal128DivSels = decimal128DivSels
 }
 
 func int8Div(xs, ys, rs []int8) []int8 {
@@ -615,3 +623,19 @@ func float64IntegerDivByScalarSels(x float64, ys []float64, rs []int64, sels []i
 	}
 	return rs
 }
+
+// decimal128Div expects the dividend to be already scaled up to the result
+// scale, and the caller to have rejected zero divisors.
+func decimal128Div(xs, ys, rs []types.Decimal128) []types.Decimal128 {
+	for i, x := range xs {
+		rs[i], _ = types.Decimal128Div(x, ys[i], 0)
+	}
+	return rs
+}
+
+func decimal128DivSels(xs, ys, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+	for _, sel := range sels {
+		rs[sel], _ = types.Decimal128Div(xs[sel], ys[sel], 0)
+	}
+	return rs
+}
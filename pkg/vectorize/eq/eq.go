@@ -22,94 +22,102 @@ import (
 )
 
 var (
-	Int8Eq                      func([]int8, []int8, []int64) []int64
-	Int8EqNullable              func([]int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8EqSels                  func([]int8, []int8, []int64, []int64) []int64
-	Int8EqNullableSels          func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int8EqScalar                func(int8, []int8, []int64) []int64
-	Int8EqNullableScalar        func(int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8EqScalarSels            func(int8, []int8, []int64, []int64) []int64
-	Int8EqNullableScalarSels    func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int16Eq                     func([]int16, []int16, []int64) []int64
-	Int16EqNullable             func([]int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16EqSels                 func([]int16, []int16, []int64, []int64) []int64
-	Int16EqNullableSels         func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int16EqScalar               func(int16, []int16, []int64) []int64
-	Int16EqNullableScalar       func(int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16EqScalarSels           func(int16, []int16, []int64, []int64) []int64
-	Int16EqNullableScalarSels   func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int32Eq                     func([]int32, []int32, []int64) []int64
-	Int32EqNullable             func([]int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32EqSels                 func([]int32, []int32, []int64, []int64) []int64
-	Int32EqNullableSels         func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int32EqScalar               func(int32, []int32, []int64) []int64
-	Int32EqNullableScalar       func(int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32EqScalarSels           func(int32, []int32, []int64, []int64) []int64
-	Int32EqNullableScalarSels   func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int64Eq                     func([]int64, []int64, []int64) []int64
-	Int64EqNullable             func([]int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64EqSels                 func([]int64, []int64, []int64, []int64) []int64
-	Int64EqNullableSels         func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Int64EqScalar               func(int64, []int64, []int64) []int64
-	Int64EqNullableScalar       func(int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64EqScalarSels           func(int64, []int64, []int64, []int64) []int64
-	Int64EqNullableScalarSels   func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8Eq                     func([]uint8, []uint8, []int64) []int64
-	Uint8EqNullable             func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8EqSels                 func([]uint8, []uint8, []int64, []int64) []int64
-	Uint8EqNullableSels         func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8EqScalar               func(uint8, []uint8, []int64) []int64
-	Uint8EqNullableScalar       func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8EqScalarSels           func(uint8, []uint8, []int64, []int64) []int64
-	Uint8EqNullableScalarSels   func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16Eq                    func([]uint16, []uint16, []int64) []int64
-	Uint16EqNullable            func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16EqSels                func([]uint16, []uint16, []int64, []int64) []int64
-	Uint16EqNullableSels        func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16EqScalar              func(uint16, []uint16, []int64) []int64
-	Uint16EqNullableScalar      func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16EqScalarSels          func(uint16, []uint16, []int64, []int64) []int64
-	Uint16EqNullableScalarSels  func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32Eq                    func([]uint32, []uint32, []int64) []int64
-	Uint32EqNullable            func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32EqSels                func([]uint32, []uint32, []int64, []int64) []int64
-	Uint32EqNullableSels        func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32EqScalar              func(uint32, []uint32, []int64) []int64
-	Uint32EqNullableScalar      func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32EqScalarSels          func(uint32, []uint32, []int64, []int64) []int64
-	Uint32EqNullableScalarSels  func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64Eq                    func([]uint64, []uint64, []int64) []int64
-	Uint64EqNullable            func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64EqSels                func([]uint64, []uint64, []int64, []int64) []int64
-	Uint64EqNullableSels        func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64EqScalar              func(uint64, []uint64, []int64) []int64
-	Uint64EqNullableScalar      func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64EqScalarSels          func(uint64, []uint64, []int64, []int64) []int64
-	Uint64EqNullableScalarSels  func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Float32Eq                   func([]float32, []float32, []int64) []int64
-	Float32EqNullable           func([]float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32EqSels               func([]float32, []float32, []int64, []int64) []int64
-	Float32EqNullableSels       func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float32EqScalar             func(float32, []float32, []int64) []int64
-	Float32EqNullableScalar     func(float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32EqScalarSels         func(float32, []float32, []int64, []int64) []int64
-	Float32EqNullableScalarSels func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float64Eq                   func([]float64, []float64, []int64) []int64
-	Float64EqNullable           func([]float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64EqSels               func([]float64, []float64, []int64, []int64) []int64
-	Float64EqNullableSels       func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	Float64EqScalar             func(float64, []float64, []int64) []int64
-	Float64EqNullableScalar     func(float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64EqScalarSels         func(float64, []float64, []int64, []int64) []int64
-	Float64EqNullableScalarSels func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	StrEq                       func(*types.Bytes, *types.Bytes, []int64) []int64
-	StrEqNullable               func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrEqSels                   func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
-	StrEqNullableSels           func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
-	StrEqScalar                 func([]byte, *types.Bytes, []int64) []int64
-	StrEqNullableScalar         func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrEqScalarSels             func([]byte, *types.Bytes, []int64, []int64) []int64
-	StrEqNullableScalarSels     func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	Int8Eq                         func([]int8, []int8, []int64) []int64
+	Int8EqNullable                 func([]int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8EqSels                     func([]int8, []int8, []int64, []int64) []int64
+	Int8EqNullableSels             func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int8EqScalar                   func(int8, []int8, []int64) []int64
+	Int8EqNullableScalar           func(int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8EqScalarSels               func(int8, []int8, []int64, []int64) []int64
+	Int8EqNullableScalarSels       func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int16Eq                        func([]int16, []int16, []int64) []int64
+	Int16EqNullable                func([]int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16EqSels                    func([]int16, []int16, []int64, []int64) []int64
+	Int16EqNullableSels            func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int16EqScalar                  func(int16, []int16, []int64) []int64
+	Int16EqNullableScalar          func(int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16EqScalarSels              func(int16, []int16, []int64, []int64) []int64
+	Int16EqNullableScalarSels      func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int32Eq                        func([]int32, []int32, []int64) []int64
+	Int32EqNullable                func([]int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32EqSels                    func([]int32, []int32, []int64, []int64) []int64
+	Int32EqNullableSels            func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int32EqScalar                  func(int32, []int32, []int64) []int64
+	Int32EqNullableScalar          func(int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32EqScalarSels              func(int32, []int32, []int64, []int64) []int64
+	Int32EqNullableScalarSels      func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int64Eq                        func([]int64, []int64, []int64) []int64
+	Int64EqNullable                func([]int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64EqSels                    func([]int64, []int64, []int64, []int64) []int64
+	Int64EqNullableSels            func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Int64EqScalar                  func(int64, []int64, []int64) []int64
+	Int64EqNullableScalar          func(int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64EqScalarSels              func(int64, []int64, []int64, []int64) []int64
+	Int64EqNullableScalarSels      func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8Eq                        func([]uint8, []uint8, []int64) []int64
+	Uint8EqNullable                func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8EqSels                    func([]uint8, []uint8, []int64, []int64) []int64
+	Uint8EqNullableSels            func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8EqScalar                  func(uint8, []uint8, []int64) []int64
+	Uint8EqNullableScalar          func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8EqScalarSels              func(uint8, []uint8, []int64, []int64) []int64
+	Uint8EqNullableScalarSels      func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16Eq                       func([]uint16, []uint16, []int64) []int64
+	Uint16EqNullable               func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16EqSels                   func([]uint16, []uint16, []int64, []int64) []int64
+	Uint16EqNullableSels           func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16EqScalar                 func(uint16, []uint16, []int64) []int64
+	Uint16EqNullableScalar         func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16EqScalarSels             func(uint16, []uint16, []int64, []int64) []int64
+	Uint16EqNullableScalarSels     func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32Eq                       func([]uint32, []uint32, []int64) []int64
+	Uint32EqNullable               func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32EqSels                   func([]uint32, []uint32, []int64, []int64) []int64
+	Uint32EqNullableSels           func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32EqScalar                 func(uint32, []uint32, []int64) []int64
+	Uint32EqNullableScalar         func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32EqScalarSels             func(uint32, []uint32, []int64, []int64) []int64
+	Uint32EqNullableScalarSels     func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64Eq                       func([]uint64, []uint64, []int64) []int64
+	Uint64EqNullable               func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64EqSels                   func([]uint64, []uint64, []int64, []int64) []int64
+	Uint64EqNullableSels           func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64EqScalar                 func(uint64, []uint64, []int64) []int64
+	Uint64EqNullableScalar         func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64EqScalarSels             func(uint64, []uint64, []int64, []int64) []int64
+	Uint64EqNullableScalarSels     func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Float32Eq                      func([]float32, []float32, []int64) []int64
+	Float32EqNullable              func([]float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32EqSels                  func([]float32, []float32, []int64, []int64) []int64
+	Float32EqNullableSels          func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float32EqScalar                func(float32, []float32, []int64) []int64
+	Float32EqNullableScalar        func(float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32EqScalarSels            func(float32, []float32, []int64, []int64) []int64
+	Float32EqNullableScalarSels    func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float64Eq                      func([]float64, []float64, []int64) []int64
+	Float64EqNullable              func([]float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64EqSels                  func([]float64, []float64, []int64, []int64) []int64
+	Float64EqNullableSels          func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Float64EqScalar                func(float64, []float64, []int64) []int64
+	Float64EqNullableScalar        func(float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64EqScalarSels            func(float64, []float64, []int64, []int64) []int64
+	Float64EqNullableScalarSels    func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128Eq                   func([]types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128EqNullable           func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128EqSels               func([]types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128EqNullableSels       func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128EqScalar             func(types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128EqNullableScalar     func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128EqScalarSels         func(types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128EqNullableScalarSels func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	StrEq                          func(*types.Bytes, *types.Bytes, []int64) []int64
+	StrEqNullable                  func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrEqSels                      func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
+	StrEqNullableSels              func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	StrEqScalar                    func([]byte, *types.Bytes, []int64) []int64
+	StrEqNullableScalar            func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrEqScalarSels                func([]byte, *types.Bytes, []int64, []int64) []int64
+	StrEqNullableScalarSels        func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
 )
 
 func init() {
@@ -193,6 +201,14 @@ func init() {
 	Float64EqNullableScalar = float64EqNullableScalar
 	Float64EqScalarSels = float64EqScalarSels
 	Float64EqNullableScalarSels = float64EqNullableScalarSels
+	Decimal128Eq = decimal128Eq
+	Decimal128EqNullable = decimal128EqNullable
+	Decimal128EqSels = decimal128EqSels
+	Decimal128EqNullableSels = decimal128EqNullableSels
+	Decimal128EqScalar = decimal128EqScalar
+	Decimal128EqNullableScalar = decimal128EqNullableScalar
+	Decimal128EqScalarSels = decimal128EqScalarSels
+	Decimal128EqNullableScalarSels = decimal128EqNullableScalarSels
 	StrEq = strEq
 	StrEqNullable = strEqNullable
 	StrEqSels = strEqSels
@@ -1500,3 +1516,121 @@ func strEqNullableScalarSels(x []byte, ys *types.Bytes, nulls *roaring.Bitmap, r
 	}
 	return rs[:rsi]
 }
+
+func decimal128Eq(xs, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x.Compare(ys[i]) == 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128EqNullable(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, x := range xs {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(ys[i]) == 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128EqSels(xs, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if xs[sel].Compare(ys[sel]) == 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128EqNullableSels(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && xs[sel].Compare(ys[sel]) == 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128EqScalar(x types.Decimal128, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, y := range ys {
+		if x.Compare(y) == 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128EqNullableScalar(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, y := range ys {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(y) == 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128EqScalarSels(x types.Decimal128, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if x.Compare(ys[sel]) == 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128EqNullableScalarSels(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && x.Compare(ys[sel]) == 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
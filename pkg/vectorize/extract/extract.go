@@ -0,0 +1,85 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extract
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	DateYear      func([]types.Date, []int64) []int64
+	DateMonth     func([]types.Date, []int64) []int64
+	DateDay       func([]types.Date, []int64) []int64
+	DatetimeYear  func([]types.Datetime, []int64) []int64
+	DatetimeMonth func([]types.Datetime, []int64) []int64
+	DatetimeDay   func([]types.Datetime, []int64) []int64
+)
+
+func init() {
+	DateYear = dateYear
+	DateMonth = dateMonth
+	DateDay = dateDay
+	DatetimeYear = datetimeYear
+	DatetimeMonth = datetimeMonth
+	DatetimeDay = datetimeDay
+}
+
+func dateYear(xs []types.Date, rs []int64) []int64 {
+	for i, x := range xs {
+		year, _, _, _ := x.Calendar(false)
+		rs[i] = int64(year)
+	}
+	return rs
+}
+
+func dateMonth(xs []types.Date, rs []int64) []int64 {
+	for i, x := range xs {
+		_, month, _, _ := x.Calendar(true)
+		rs[i] = int64(month)
+	}
+	return rs
+}
+
+func dateDay(xs []types.Date, rs []int64) []int64 {
+	for i, x := range xs {
+		_, _, day, _ := x.Calendar(true)
+		rs[i] = int64(day)
+	}
+	return rs
+}
+
+func datetimeYear(xs []types.Datetime, rs []int64) []int64 {
+	for i, x := range xs {
+		year, _, _, _ := x.ToDate().Calendar(false)
+		rs[i] = int64(year)
+	}
+	return rs
+}
+
+func datetimeMonth(xs []types.Datetime, rs []int64) []int64 {
+	for i, x := range xs {
+		_, month, _, _ := x.ToDate().Calendar(true)
+		rs[i] = int64(month)
+	}
+	return rs
+}
+
+func datetimeDay(xs []types.Datetime, rs []int64) []int64 {
+	for i, x := range xs {
+		_, _, day, _ := x.ToDate().Calendar(true)
+		rs[i] = int64(day)
+	}
+	return rs
+}
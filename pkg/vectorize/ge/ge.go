@@ -22,94 +22,102 @@ import (
 )
 
 var (
-	Int8Ge                      func([]int8, []int8, []int64) []int64
-	Int8GeNullable              func([]int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8GeSels                  func([]int8, []int8, []int64, []int64) []int64
-	Int8GeNullableSels          func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int8GeScalar                func(int8, []int8, []int64) []int64
-	Int8GeNullableScalar        func(int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8GeScalarSels            func(int8, []int8, []int64, []int64) []int64
-	Int8GeNullableScalarSels    func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int16Ge                     func([]int16, []int16, []int64) []int64
-	Int16GeNullable             func([]int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16GeSels                 func([]int16, []int16, []int64, []int64) []int64
-	Int16GeNullableSels         func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int16GeScalar               func(int16, []int16, []int64) []int64
-	Int16GeNullableScalar       func(int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16GeScalarSels           func(int16, []int16, []int64, []int64) []int64
-	Int16GeNullableScalarSels   func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int32Ge                     func([]int32, []int32, []int64) []int64
-	Int32GeNullable             func([]int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32GeSels                 func([]int32, []int32, []int64, []int64) []int64
-	Int32GeNullableSels         func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int32GeScalar               func(int32, []int32, []int64) []int64
-	Int32GeNullableScalar       func(int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32GeScalarSels           func(int32, []int32, []int64, []int64) []int64
-	Int32GeNullableScalarSels   func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int64Ge                     func([]int64, []int64, []int64) []int64
-	Int64GeNullable             func([]int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64GeSels                 func([]int64, []int64, []int64, []int64) []int64
-	Int64GeNullableSels         func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Int64GeScalar               func(int64, []int64, []int64) []int64
-	Int64GeNullableScalar       func(int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64GeScalarSels           func(int64, []int64, []int64, []int64) []int64
-	Int64GeNullableScalarSels   func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8Ge                     func([]uint8, []uint8, []int64) []int64
-	Uint8GeNullable             func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8GeSels                 func([]uint8, []uint8, []int64, []int64) []int64
-	Uint8GeNullableSels         func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8GeScalar               func(uint8, []uint8, []int64) []int64
-	Uint8GeNullableScalar       func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8GeScalarSels           func(uint8, []uint8, []int64, []int64) []int64
-	Uint8GeNullableScalarSels   func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16Ge                    func([]uint16, []uint16, []int64) []int64
-	Uint16GeNullable            func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16GeSels                func([]uint16, []uint16, []int64, []int64) []int64
-	Uint16GeNullableSels        func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16GeScalar              func(uint16, []uint16, []int64) []int64
-	Uint16GeNullableScalar      func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16GeScalarSels          func(uint16, []uint16, []int64, []int64) []int64
-	Uint16GeNullableScalarSels  func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32Ge                    func([]uint32, []uint32, []int64) []int64
-	Uint32GeNullable            func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32GeSels                func([]uint32, []uint32, []int64, []int64) []int64
-	Uint32GeNullableSels        func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32GeScalar              func(uint32, []uint32, []int64) []int64
-	Uint32GeNullableScalar      func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32GeScalarSels          func(uint32, []uint32, []int64, []int64) []int64
-	Uint32GeNullableScalarSels  func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64Ge                    func([]uint64, []uint64, []int64) []int64
-	Uint64GeNullable            func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64GeSels                func([]uint64, []uint64, []int64, []int64) []int64
-	Uint64GeNullableSels        func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64GeScalar              func(uint64, []uint64, []int64) []int64
-	Uint64GeNullableScalar      func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64GeScalarSels          func(uint64, []uint64, []int64, []int64) []int64
-	Uint64GeNullableScalarSels  func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Float32Ge                   func([]float32, []float32, []int64) []int64
-	Float32GeNullable           func([]float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32GeSels               func([]float32, []float32, []int64, []int64) []int64
-	Float32GeNullableSels       func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float32GeScalar             func(float32, []float32, []int64) []int64
-	Float32GeNullableScalar     func(float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32GeScalarSels         func(float32, []float32, []int64, []int64) []int64
-	Float32GeNullableScalarSels func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float64Ge                   func([]float64, []float64, []int64) []int64
-	Float64GeNullable           func([]float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64GeSels               func([]float64, []float64, []int64, []int64) []int64
-	Float64GeNullableSels       func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	Float64GeScalar             func(float64, []float64, []int64) []int64
-	Float64GeNullableScalar     func(float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64GeScalarSels         func(float64, []float64, []int64, []int64) []int64
-	Float64GeNullableScalarSels func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	StrGe                       func(*types.Bytes, *types.Bytes, []int64) []int64
-	StrGeNullable               func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrGeSels                   func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
-	StrGeNullableSels           func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
-	StrGeScalar                 func([]byte, *types.Bytes, []int64) []int64
-	StrGeNullableScalar         func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrGeScalarSels             func([]byte, *types.Bytes, []int64, []int64) []int64
-	StrGeNullableScalarSels     func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	Int8Ge                         func([]int8, []int8, []int64) []int64
+	Int8GeNullable                 func([]int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8GeSels                     func([]int8, []int8, []int64, []int64) []int64
+	Int8GeNullableSels             func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int8GeScalar                   func(int8, []int8, []int64) []int64
+	Int8GeNullableScalar           func(int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8GeScalarSels               func(int8, []int8, []int64, []int64) []int64
+	Int8GeNullableScalarSels       func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int16Ge                        func([]int16, []int16, []int64) []int64
+	Int16GeNullable                func([]int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16GeSels                    func([]int16, []int16, []int64, []int64) []int64
+	Int16GeNullableSels            func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int16GeScalar                  func(int16, []int16, []int64) []int64
+	Int16GeNullableScalar          func(int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16GeScalarSels              func(int16, []int16, []int64, []int64) []int64
+	Int16GeNullableScalarSels      func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int32Ge                        func([]int32, []int32, []int64) []int64
+	Int32GeNullable                func([]int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32GeSels                    func([]int32, []int32, []int64, []int64) []int64
+	Int32GeNullableSels            func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int32GeScalar                  func(int32, []int32, []int64) []int64
+	Int32GeNullableScalar          func(int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32GeScalarSels              func(int32, []int32, []int64, []int64) []int64
+	Int32GeNullableScalarSels      func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int64Ge                        func([]int64, []int64, []int64) []int64
+	Int64GeNullable                func([]int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64GeSels                    func([]int64, []int64, []int64, []int64) []int64
+	Int64GeNullableSels            func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Int64GeScalar                  func(int64, []int64, []int64) []int64
+	Int64GeNullableScalar          func(int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64GeScalarSels              func(int64, []int64, []int64, []int64) []int64
+	Int64GeNullableScalarSels      func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8Ge                        func([]uint8, []uint8, []int64) []int64
+	Uint8GeNullable                func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8GeSels                    func([]uint8, []uint8, []int64, []int64) []int64
+	Uint8GeNullableSels            func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8GeScalar                  func(uint8, []uint8, []int64) []int64
+	Uint8GeNullableScalar          func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8GeScalarSels              func(uint8, []uint8, []int64, []int64) []int64
+	Uint8GeNullableScalarSels      func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16Ge                       func([]uint16, []uint16, []int64) []int64
+	Uint16GeNullable               func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16GeSels                   func([]uint16, []uint16, []int64, []int64) []int64
+	Uint16GeNullableSels           func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16GeScalar                 func(uint16, []uint16, []int64) []int64
+	Uint16GeNullableScalar         func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16GeScalarSels             func(uint16, []uint16, []int64, []int64) []int64
+	Uint16GeNullableScalarSels     func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32Ge                       func([]uint32, []uint32, []int64) []int64
+	Uint32GeNullable               func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32GeSels                   func([]uint32, []uint32, []int64, []int64) []int64
+	Uint32GeNullableSels           func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32GeScalar                 func(uint32, []uint32, []int64) []int64
+	Uint32GeNullableScalar         func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32GeScalarSels             func(uint32, []uint32, []int64, []int64) []int64
+	Uint32GeNullableScalarSels     func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64Ge                       func([]uint64, []uint64, []int64) []int64
+	Uint64GeNullable               func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64GeSels                   func([]uint64, []uint64, []int64, []int64) []int64
+	Uint64GeNullableSels           func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64GeScalar                 func(uint64, []uint64, []int64) []int64
+	Uint64GeNullableScalar         func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64GeScalarSels             func(uint64, []uint64, []int64, []int64) []int64
+	Uint64GeNullableScalarSels     func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Float32Ge                      func([]float32, []float32, []int64) []int64
+	Float32GeNullable              func([]float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32GeSels                  func([]float32, []float32, []int64, []int64) []int64
+	Float32GeNullableSels          func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float32GeScalar                func(float32, []float32, []int64) []int64
+	Float32GeNullableScalar        func(float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32GeScalarSels            func(float32, []float32, []int64, []int64) []int64
+	Float32GeNullableScalarSels    func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float64Ge                      func([]float64, []float64, []int64) []int64
+	Float64GeNullable              func([]float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64GeSels                  func([]float64, []float64, []int64, []int64) []int64
+	Float64GeNullableSels          func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Float64GeScalar                func(float64, []float64, []int64) []int64
+	Float64GeNullableScalar        func(float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64GeScalarSels            func(float64, []float64, []int64, []int64) []int64
+	Float64GeNullableScalarSels    func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128Ge                   func([]types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128GeNullable           func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128GeSels               func([]types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128GeNullableSels       func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128GeScalar             func(types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128GeNullableScalar     func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128GeScalarSels         func(types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128GeNullableScalarSels func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	StrGe                          func(*types.Bytes, *types.Bytes, []int64) []int64
+	StrGeNullable                  func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrGeSels                      func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
+	StrGeNullableSels              func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	StrGeScalar                    func([]byte, *types.Bytes, []int64) []int64
+	StrGeNullableScalar            func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrGeScalarSels                func([]byte, *types.Bytes, []int64, []int64) []int64
+	StrGeNullableScalarSels        func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
 )
 
 func init() {
@@ -193,6 +201,14 @@ func init() {
 	Float64GeNullableScalar = float64GeNullableScalar
 	Float64GeScalarSels = float64GeScalarSels
 	Float64GeNullableScalarSels = float64GeNullableScalarSels
+	Decimal128Ge = decimal128Ge
+	Decimal128GeNullable = decimal128GeNullable
+	Decimal128GeSels = decimal128GeSels
+	Decimal128GeNullableSels = decimal128GeNullableSels
+	Decimal128GeScalar = decimal128GeScalar
+	Decimal128GeNullableScalar = decimal128GeNullableScalar
+	Decimal128GeScalarSels = decimal128GeScalarSels
+	Decimal128GeNullableScalarSels = decimal128GeNullableScalarSels
 	StrGe = strGe
 	StrGeNullable = strGeNullable
 	StrGeSels = strGeSels
@@ -1500,3 +1516,121 @@ func strGeNullableScalarSels(x []byte, ys *types.Bytes, nulls *roaring.Bitmap, r
 	}
 	return rs[:rsi]
 }
+
+func decimal128Ge(xs, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x.Compare(ys[i]) >= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GeNullable(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, x := range xs {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(ys[i]) >= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GeSels(xs, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if xs[sel].Compare(ys[sel]) >= 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GeNullableSels(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && xs[sel].Compare(ys[sel]) >= 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GeScalar(x types.Decimal128, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, y := range ys {
+		if x.Compare(y) >= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GeNullableScalar(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, y := range ys {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(y) >= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GeScalarSels(x types.Decimal128, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if x.Compare(ys[sel]) >= 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GeNullableScalarSels(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && x.Compare(ys[sel]) >= 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
@@ -22,94 +22,102 @@ import (
 )
 
 var (
-	Int8Gt                      func([]int8, []int8, []int64) []int64
-	Int8GtNullable              func([]int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8GtSels                  func([]int8, []int8, []int64, []int64) []int64
-	Int8GtNullableSels          func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int8GtScalar                func(int8, []int8, []int64) []int64
-	Int8GtNullableScalar        func(int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8GtScalarSels            func(int8, []int8, []int64, []int64) []int64
-	Int8GtNullableScalarSels    func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int16Gt                     func([]int16, []int16, []int64) []int64
-	Int16GtNullable             func([]int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16GtSels                 func([]int16, []int16, []int64, []int64) []int64
-	Int16GtNullableSels         func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int16GtScalar               func(int16, []int16, []int64) []int64
-	Int16GtNullableScalar       func(int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16GtScalarSels           func(int16, []int16, []int64, []int64) []int64
-	Int16GtNullableScalarSels   func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int32Gt                     func([]int32, []int32, []int64) []int64
-	Int32GtNullable             func([]int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32GtSels                 func([]int32, []int32, []int64, []int64) []int64
-	Int32GtNullableSels         func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int32GtScalar               func(int32, []int32, []int64) []int64
-	Int32GtNullableScalar       func(int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32GtScalarSels           func(int32, []int32, []int64, []int64) []int64
-	Int32GtNullableScalarSels   func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int64Gt                     func([]int64, []int64, []int64) []int64
-	Int64GtNullable             func([]int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64GtSels                 func([]int64, []int64, []int64, []int64) []int64
-	Int64GtNullableSels         func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Int64GtScalar               func(int64, []int64, []int64) []int64
-	Int64GtNullableScalar       func(int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64GtScalarSels           func(int64, []int64, []int64, []int64) []int64
-	Int64GtNullableScalarSels   func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8Gt                     func([]uint8, []uint8, []int64) []int64
-	Uint8GtNullable             func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8GtSels                 func([]uint8, []uint8, []int64, []int64) []int64
-	Uint8GtNullableSels         func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8GtScalar               func(uint8, []uint8, []int64) []int64
-	Uint8GtNullableScalar       func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8GtScalarSels           func(uint8, []uint8, []int64, []int64) []int64
-	Uint8GtNullableScalarSels   func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16Gt                    func([]uint16, []uint16, []int64) []int64
-	Uint16GtNullable            func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16GtSels                func([]uint16, []uint16, []int64, []int64) []int64
-	Uint16GtNullableSels        func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16GtScalar              func(uint16, []uint16, []int64) []int64
-	Uint16GtNullableScalar      func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16GtScalarSels          func(uint16, []uint16, []int64, []int64) []int64
-	Uint16GtNullableScalarSels  func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32Gt                    func([]uint32, []uint32, []int64) []int64
-	Uint32GtNullable            func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32GtSels                func([]uint32, []uint32, []int64, []int64) []int64
-	Uint32GtNullableSels        func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32GtScalar              func(uint32, []uint32, []int64) []int64
-	Uint32GtNullableScalar      func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32GtScalarSels          func(uint32, []uint32, []int64, []int64) []int64
-	Uint32GtNullableScalarSels  func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64Gt                    func([]uint64, []uint64, []int64) []int64
-	Uint64GtNullable            func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64GtSels                func([]uint64, []uint64, []int64, []int64) []int64
-	Uint64GtNullableSels        func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64GtScalar              func(uint64, []uint64, []int64) []int64
-	Uint64GtNullableScalar      func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64GtScalarSels          func(uint64, []uint64, []int64, []int64) []int64
-	Uint64GtNullableScalarSels  func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Float32Gt                   func([]float32, []float32, []int64) []int64
-	Float32GtNullable           func([]float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32GtSels               func([]float32, []float32, []int64, []int64) []int64
-	Float32GtNullableSels       func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float32GtScalar             func(float32, []float32, []int64) []int64
-	Float32GtNullableScalar     func(float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32GtScalarSels         func(float32, []float32, []int64, []int64) []int64
-	Float32GtNullableScalarSels func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float64Gt                   func([]float64, []float64, []int64) []int64
-	Float64GtNullable           func([]float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64GtSels               func([]float64, []float64, []int64, []int64) []int64
-	Float64GtNullableSels       func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	Float64GtScalar             func(float64, []float64, []int64) []int64
-	Float64GtNullableScalar     func(float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64GtScalarSels         func(float64, []float64, []int64, []int64) []int64
-	Float64GtNullableScalarSels func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	StrGt                       func(*types.Bytes, *types.Bytes, []int64) []int64
-	StrGtNullable               func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrGtSels                   func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
-	StrGtNullableSels           func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
-	StrGtScalar                 func([]byte, *types.Bytes, []int64) []int64
-	StrGtNullableScalar         func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrGtScalarSels             func([]byte, *types.Bytes, []int64, []int64) []int64
-	StrGtNullableScalarSels     func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	Int8Gt                         func([]int8, []int8, []int64) []int64
+	Int8GtNullable                 func([]int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8GtSels                     func([]int8, []int8, []int64, []int64) []int64
+	Int8GtNullableSels             func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int8GtScalar                   func(int8, []int8, []int64) []int64
+	Int8GtNullableScalar           func(int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8GtScalarSels               func(int8, []int8, []int64, []int64) []int64
+	Int8GtNullableScalarSels       func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int16Gt                        func([]int16, []int16, []int64) []int64
+	Int16GtNullable                func([]int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16GtSels                    func([]int16, []int16, []int64, []int64) []int64
+	Int16GtNullableSels            func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int16GtScalar                  func(int16, []int16, []int64) []int64
+	Int16GtNullableScalar          func(int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16GtScalarSels              func(int16, []int16, []int64, []int64) []int64
+	Int16GtNullableScalarSels      func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int32Gt                        func([]int32, []int32, []int64) []int64
+	Int32GtNullable                func([]int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32GtSels                    func([]int32, []int32, []int64, []int64) []int64
+	Int32GtNullableSels            func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int32GtScalar                  func(int32, []int32, []int64) []int64
+	Int32GtNullableScalar          func(int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32GtScalarSels              func(int32, []int32, []int64, []int64) []int64
+	Int32GtNullableScalarSels      func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int64Gt                        func([]int64, []int64, []int64) []int64
+	Int64GtNullable                func([]int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64GtSels                    func([]int64, []int64, []int64, []int64) []int64
+	Int64GtNullableSels            func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Int64GtScalar                  func(int64, []int64, []int64) []int64
+	Int64GtNullableScalar          func(int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64GtScalarSels              func(int64, []int64, []int64, []int64) []int64
+	Int64GtNullableScalarSels      func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8Gt                        func([]uint8, []uint8, []int64) []int64
+	Uint8GtNullable                func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8GtSels                    func([]uint8, []uint8, []int64, []int64) []int64
+	Uint8GtNullableSels            func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8GtScalar                  func(uint8, []uint8, []int64) []int64
+	Uint8GtNullableScalar          func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8GtScalarSels              func(uint8, []uint8, []int64, []int64) []int64
+	Uint8GtNullableScalarSels      func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16Gt                       func([]uint16, []uint16, []int64) []int64
+	Uint16GtNullable               func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16GtSels                   func([]uint16, []uint16, []int64, []int64) []int64
+	Uint16GtNullableSels           func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16GtScalar                 func(uint16, []uint16, []int64) []int64
+	Uint16GtNullableScalar         func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16GtScalarSels             func(uint16, []uint16, []int64, []int64) []int64
+	Uint16GtNullableScalarSels     func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32Gt                       func([]uint32, []uint32, []int64) []int64
+	Uint32GtNullable               func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32GtSels                   func([]uint32, []uint32, []int64, []int64) []int64
+	Uint32GtNullableSels           func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32GtScalar                 func(uint32, []uint32, []int64) []int64
+	Uint32GtNullableScalar         func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32GtScalarSels             func(uint32, []uint32, []int64, []int64) []int64
+	Uint32GtNullableScalarSels     func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64Gt                       func([]uint64, []uint64, []int64) []int64
+	Uint64GtNullable               func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64GtSels                   func([]uint64, []uint64, []int64, []int64) []int64
+	Uint64GtNullableSels           func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64GtScalar                 func(uint64, []uint64, []int64) []int64
+	Uint64GtNullableScalar         func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64GtScalarSels             func(uint64, []uint64, []int64, []int64) []int64
+	Uint64GtNullableScalarSels     func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Float32Gt                      func([]float32, []float32, []int64) []int64
+	Float32GtNullable              func([]float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32GtSels                  func([]float32, []float32, []int64, []int64) []int64
+	Float32GtNullableSels          func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float32GtScalar                func(float32, []float32, []int64) []int64
+	Float32GtNullableScalar        func(float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32GtScalarSels            func(float32, []float32, []int64, []int64) []int64
+	Float32GtNullableScalarSels    func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float64Gt                      func([]float64, []float64, []int64) []int64
+	Float64GtNullable              func([]float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64GtSels                  func([]float64, []float64, []int64, []int64) []int64
+	Float64GtNullableSels          func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Float64GtScalar                func(float64, []float64, []int64) []int64
+	Float64GtNullableScalar        func(float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64GtScalarSels            func(float64, []float64, []int64, []int64) []int64
+	Float64GtNullableScalarSels    func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128Gt                   func([]types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128GtNullable           func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128GtSels               func([]types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128GtNullableSels       func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128GtScalar             func(types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128GtNullableScalar     func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128GtScalarSels         func(types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128GtNullableScalarSels func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	StrGt                          func(*types.Bytes, *types.Bytes, []int64) []int64
+	StrGtNullable                  func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrGtSels                      func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
+	StrGtNullableSels              func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	StrGtScalar                    func([]byte, *types.Bytes, []int64) []int64
+	StrGtNullableScalar            func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrGtScalarSels                func([]byte, *types.Bytes, []int64, []int64) []int64
+	StrGtNullableScalarSels        func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
 )
 
 func init() {
@@ -193,6 +201,14 @@ func init() {
 	Float64GtNullableScalar = float64GtNullableScalar
 	Float64GtScalarSels = float64GtScalarSels
 	Float64GtNullableScalarSels = float64GtNullableScalarSels
+	Decimal128Gt = decimal128Gt
+	Decimal128GtNullable = decimal128GtNullable
+	Decimal128GtSels = decimal128GtSels
+	Decimal128GtNullableSels = decimal128GtNullableSels
+	Decimal128GtScalar = decimal128GtScalar
+	Decimal128GtNullableScalar = decimal128GtNullableScalar
+	Decimal128GtScalarSels = decimal128GtScalarSels
+	Decimal128GtNullableScalarSels = decimal128GtNullableScalarSels
 	StrGt = strGt
 	StrGtNullable = strGtNullable
 	StrGtSels = strGtSels
@@ -1500,3 +1516,121 @@ func strGtNullableScalarSels(x []byte, ys *types.Bytes, nulls *roaring.Bitmap, r
 	}
 	return rs[:rsi]
 }
+
+func decimal128Gt(xs, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x.Compare(ys[i]) > 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GtNullable(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, x := range xs {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(ys[i]) > 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GtSels(xs, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if xs[sel].Compare(ys[sel]) > 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GtNullableSels(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && xs[sel].Compare(ys[sel]) > 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GtScalar(x types.Decimal128, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, y := range ys {
+		if x.Compare(y) > 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GtNullableScalar(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, y := range ys {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(y) > 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GtScalarSels(x types.Decimal128, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if x.Compare(ys[sel]) > 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GtNullableScalarSels(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && x.Compare(ys[sel]) > 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
@@ -22,94 +22,102 @@ import (
 )
 
 var (
-	Int8Le                      func([]int8, []int8, []int64) []int64
-	Int8LeNullable              func([]int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8LeSels                  func([]int8, []int8, []int64, []int64) []int64
-	Int8LeNullableSels          func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int8LeScalar                func(int8, []int8, []int64) []int64
-	Int8LeNullableScalar        func(int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8LeScalarSels            func(int8, []int8, []int64, []int64) []int64
-	Int8LeNullableScalarSels    func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int16Le                     func([]int16, []int16, []int64) []int64
-	Int16LeNullable             func([]int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16LeSels                 func([]int16, []int16, []int64, []int64) []int64
-	Int16LeNullableSels         func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int16LeScalar               func(int16, []int16, []int64) []int64
-	Int16LeNullableScalar       func(int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16LeScalarSels           func(int16, []int16, []int64, []int64) []int64
-	Int16LeNullableScalarSels   func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int32Le                     func([]int32, []int32, []int64) []int64
-	Int32LeNullable             func([]int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32LeSels                 func([]int32, []int32, []int64, []int64) []int64
-	Int32LeNullableSels         func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int32LeScalar               func(int32, []int32, []int64) []int64
-	Int32LeNullableScalar       func(int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32LeScalarSels           func(int32, []int32, []int64, []int64) []int64
-	Int32LeNullableScalarSels   func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int64Le                     func([]int64, []int64, []int64) []int64
-	Int64LeNullable             func([]int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64LeSels                 func([]int64, []int64, []int64, []int64) []int64
-	Int64LeNullableSels         func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Int64LeScalar               func(int64, []int64, []int64) []int64
-	Int64LeNullableScalar       func(int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64LeScalarSels           func(int64, []int64, []int64, []int64) []int64
-	Int64LeNullableScalarSels   func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8Le                     func([]uint8, []uint8, []int64) []int64
-	Uint8LeNullable             func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8LeSels                 func([]uint8, []uint8, []int64, []int64) []int64
-	Uint8LeNullableSels         func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8LeScalar               func(uint8, []uint8, []int64) []int64
-	Uint8LeNullableScalar       func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8LeScalarSels           func(uint8, []uint8, []int64, []int64) []int64
-	Uint8LeNullableScalarSels   func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16Le                    func([]uint16, []uint16, []int64) []int64
-	Uint16LeNullable            func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16LeSels                func([]uint16, []uint16, []int64, []int64) []int64
-	Uint16LeNullableSels        func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16LeScalar              func(uint16, []uint16, []int64) []int64
-	Uint16LeNullableScalar      func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16LeScalarSels          func(uint16, []uint16, []int64, []int64) []int64
-	Uint16LeNullableScalarSels  func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32Le                    func([]uint32, []uint32, []int64) []int64
-	Uint32LeNullable            func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32LeSels                func([]uint32, []uint32, []int64, []int64) []int64
-	Uint32LeNullableSels        func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32LeScalar              func(uint32, []uint32, []int64) []int64
-	Uint32LeNullableScalar      func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32LeScalarSels          func(uint32, []uint32, []int64, []int64) []int64
-	Uint32LeNullableScalarSels  func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64Le                    func([]uint64, []uint64, []int64) []int64
-	Uint64LeNullable            func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64LeSels                func([]uint64, []uint64, []int64, []int64) []int64
-	Uint64LeNullableSels        func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64LeScalar              func(uint64, []uint64, []int64) []int64
-	Uint64LeNullableScalar      func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64LeScalarSels          func(uint64, []uint64, []int64, []int64) []int64
-	Uint64LeNullableScalarSels  func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Float32Le                   func([]float32, []float32, []int64) []int64
-	Float32LeNullable           func([]float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32LeSels               func([]float32, []float32, []int64, []int64) []int64
-	Float32LeNullableSels       func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float32LeScalar             func(float32, []float32, []int64) []int64
-	Float32LeNullableScalar     func(float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32LeScalarSels         func(float32, []float32, []int64, []int64) []int64
-	Float32LeNullableScalarSels func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float64Le                   func([]float64, []float64, []int64) []int64
-	Float64LeNullable           func([]float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64LeSels               func([]float64, []float64, []int64, []int64) []int64
-	Float64LeNullableSels       func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	Float64LeScalar             func(float64, []float64, []int64) []int64
-	Float64LeNullableScalar     func(float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64LeScalarSels         func(float64, []float64, []int64, []int64) []int64
-	Float64LeNullableScalarSels func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	StrLe                       func(*types.Bytes, *types.Bytes, []int64) []int64
-	StrLeNullable               func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrLeSels                   func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
-	StrLeNullableSels           func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
-	StrLeScalar                 func([]byte, *types.Bytes, []int64) []int64
-	StrLeNullableScalar         func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrLeScalarSels             func([]byte, *types.Bytes, []int64, []int64) []int64
-	StrLeNullableScalarSels     func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	Int8Le                         func([]int8, []int8, []int64) []int64
+	Int8LeNullable                 func([]int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8LeSels                     func([]int8, []int8, []int64, []int64) []int64
+	Int8LeNullableSels             func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int8LeScalar                   func(int8, []int8, []int64) []int64
+	Int8LeNullableScalar           func(int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8LeScalarSels               func(int8, []int8, []int64, []int64) []int64
+	Int8LeNullableScalarSels       func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int16Le                        func([]int16, []int16, []int64) []int64
+	Int16LeNullable                func([]int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16LeSels                    func([]int16, []int16, []int64, []int64) []int64
+	Int16LeNullableSels            func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int16LeScalar                  func(int16, []int16, []int64) []int64
+	Int16LeNullableScalar          func(int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16LeScalarSels              func(int16, []int16, []int64, []int64) []int64
+	Int16LeNullableScalarSels      func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int32Le                        func([]int32, []int32, []int64) []int64
+	Int32LeNullable                func([]int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32LeSels                    func([]int32, []int32, []int64, []int64) []int64
+	Int32LeNullableSels            func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int32LeScalar                  func(int32, []int32, []int64) []int64
+	Int32LeNullableScalar          func(int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32LeScalarSels              func(int32, []int32, []int64, []int64) []int64
+	Int32LeNullableScalarSels      func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int64Le                        func([]int64, []int64, []int64) []int64
+	Int64LeNullable                func([]int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64LeSels                    func([]int64, []int64, []int64, []int64) []int64
+	Int64LeNullableSels            func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Int64LeScalar                  func(int64, []int64, []int64) []int64
+	Int64LeNullableScalar          func(int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64LeScalarSels              func(int64, []int64, []int64, []int64) []int64
+	Int64LeNullableScalarSels      func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8Le                        func([]uint8, []uint8, []int64) []int64
+	Uint8LeNullable                func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8LeSels                    func([]uint8, []uint8, []int64, []int64) []int64
+	Uint8LeNullableSels            func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8LeScalar                  func(uint8, []uint8, []int64) []int64
+	Uint8LeNullableScalar          func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8LeScalarSels              func(uint8, []uint8, []int64, []int64) []int64
+	Uint8LeNullableScalarSels      func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16Le                       func([]uint16, []uint16, []int64) []int64
+	Uint16LeNullable               func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16LeSels                   func([]uint16, []uint16, []int64, []int64) []int64
+	Uint16LeNullableSels           func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16LeScalar                 func(uint16, []uint16, []int64) []int64
+	Uint16LeNullableScalar         func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16LeScalarSels             func(uint16, []uint16, []int64, []int64) []int64
+	Uint16LeNullableScalarSels     func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32Le                       func([]uint32, []uint32, []int64) []int64
+	Uint32LeNullable               func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32LeSels                   func([]uint32, []uint32, []int64, []int64) []int64
+	Uint32LeNullableSels           func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32LeScalar                 func(uint32, []uint32, []int64) []int64
+	Uint32LeNullableScalar         func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32LeScalarSels             func(uint32, []uint32, []int64, []int64) []int64
+	Uint32LeNullableScalarSels     func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64Le                       func([]uint64, []uint64, []int64) []int64
+	Uint64LeNullable               func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64LeSels                   func([]uint64, []uint64, []int64, []int64) []int64
+	Uint64LeNullableSels           func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64LeScalar                 func(uint64, []uint64, []int64) []int64
+	Uint64LeNullableScalar         func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64LeScalarSels             func(uint64, []uint64, []int64, []int64) []int64
+	Uint64LeNullableScalarSels     func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Float32Le                      func([]float32, []float32, []int64) []int64
+	Float32LeNullable              func([]float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32LeSels                  func([]float32, []float32, []int64, []int64) []int64
+	Float32LeNullableSels          func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float32LeScalar                func(float32, []float32, []int64) []int64
+	Float32LeNullableScalar        func(float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32LeScalarSels            func(float32, []float32, []int64, []int64) []int64
+	Float32LeNullableScalarSels    func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float64Le                      func([]float64, []float64, []int64) []int64
+	Float64LeNullable              func([]float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64LeSels                  func([]float64, []float64, []int64, []int64) []int64
+	Float64LeNullableSels          func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Float64LeScalar                func(float64, []float64, []int64) []int64
+	Float64LeNullableScalar        func(float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64LeScalarSels            func(float64, []float64, []int64, []int64) []int64
+	Float64LeNullableScalarSels    func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128Le                   func([]types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128LeNullable           func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128LeSels               func([]types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128LeNullableSels       func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128LeScalar             func(types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128LeNullableScalar     func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128LeScalarSels         func(types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128LeNullableScalarSels func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	StrLe                          func(*types.Bytes, *types.Bytes, []int64) []int64
+	StrLeNullable                  func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrLeSels                      func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
+	StrLeNullableSels              func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	StrLeScalar                    func([]byte, *types.Bytes, []int64) []int64
+	StrLeNullableScalar            func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrLeScalarSels                func([]byte, *types.Bytes, []int64, []int64) []int64
+	StrLeNullableScalarSels        func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
 )
 
 func init() {
@@ -193,6 +201,14 @@ func init() {
 	Float64LeNullableScalar = float64LeNullableScalar
 	Float64LeScalarSels = float64LeScalarSels
 	Float64LeNullableScalarSels = float64LeNullableScalarSels
+	Decimal128Le = decimal128Le
+	Decimal128LeNullable = decimal128LeNullable
+	Decimal128LeSels = decimal128LeSels
+	Decimal128LeNullableSels = decimal128LeNullableSels
+	Decimal128LeScalar = decimal128LeScalar
+	Decimal128LeNullableScalar = decimal128LeNullableScalar
+	Decimal128LeScalarSels = decimal128LeScalarSels
+	Decimal128LeNullableScalarSels = decimal128LeNullableScalarSels
 	StrLe = strLe
 	StrLeNullable = strLeNullable
 	StrLeSels = strLeSels
@@ -1500,3 +1516,121 @@ func strLeNullableScalarSels(x []byte, ys *types.Bytes, nulls *roaring.Bitmap, r
 	}
 	return rs[:rsi]
 }
+
+func decimal128Le(xs, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x.Compare(ys[i]) <= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LeNullable(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, x := range xs {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(ys[i]) <= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LeSels(xs, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if xs[sel].Compare(ys[sel]) <= 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LeNullableSels(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && xs[sel].Compare(ys[sel]) <= 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LeScalar(x types.Decimal128, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, y := range ys {
+		if x.Compare(y) <= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LeNullableScalar(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, y := range ys {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(y) <= 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LeScalarSels(x types.Decimal128, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if x.Compare(ys[sel]) <= 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LeNullableScalarSels(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && x.Compare(ys[sel]) <= 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
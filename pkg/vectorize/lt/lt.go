@@ -22,94 +22,102 @@ import (
 )
 
 var (
-	Int8Lt                      func([]int8, []int8, []int64) []int64
-	Int8LtNullable              func([]int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8LtSels                  func([]int8, []int8, []int64, []int64) []int64
-	Int8LtNullableSels          func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int8LtScalar                func(int8, []int8, []int64) []int64
-	Int8LtNullableScalar        func(int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8LtScalarSels            func(int8, []int8, []int64, []int64) []int64
-	Int8LtNullableScalarSels    func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int16Lt                     func([]int16, []int16, []int64) []int64
-	Int16LtNullable             func([]int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16LtSels                 func([]int16, []int16, []int64, []int64) []int64
-	Int16LtNullableSels         func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int16LtScalar               func(int16, []int16, []int64) []int64
-	Int16LtNullableScalar       func(int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16LtScalarSels           func(int16, []int16, []int64, []int64) []int64
-	Int16LtNullableScalarSels   func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int32Lt                     func([]int32, []int32, []int64) []int64
-	Int32LtNullable             func([]int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32LtSels                 func([]int32, []int32, []int64, []int64) []int64
-	Int32LtNullableSels         func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int32LtScalar               func(int32, []int32, []int64) []int64
-	Int32LtNullableScalar       func(int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32LtScalarSels           func(int32, []int32, []int64, []int64) []int64
-	Int32LtNullableScalarSels   func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int64Lt                     func([]int64, []int64, []int64) []int64
-	Int64LtNullable             func([]int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64LtSels                 func([]int64, []int64, []int64, []int64) []int64
-	Int64LtNullableSels         func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Int64LtScalar               func(int64, []int64, []int64) []int64
-	Int64LtNullableScalar       func(int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64LtScalarSels           func(int64, []int64, []int64, []int64) []int64
-	Int64LtNullableScalarSels   func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8Lt                     func([]uint8, []uint8, []int64) []int64
-	Uint8LtNullable             func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8LtSels                 func([]uint8, []uint8, []int64, []int64) []int64
-	Uint8LtNullableSels         func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8LtScalar               func(uint8, []uint8, []int64) []int64
-	Uint8LtNullableScalar       func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8LtScalarSels           func(uint8, []uint8, []int64, []int64) []int64
-	Uint8LtNullableScalarSels   func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16Lt                    func([]uint16, []uint16, []int64) []int64
-	Uint16LtNullable            func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16LtSels                func([]uint16, []uint16, []int64, []int64) []int64
-	Uint16LtNullableSels        func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16LtScalar              func(uint16, []uint16, []int64) []int64
-	Uint16LtNullableScalar      func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16LtScalarSels          func(uint16, []uint16, []int64, []int64) []int64
-	Uint16LtNullableScalarSels  func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32Lt                    func([]uint32, []uint32, []int64) []int64
-	Uint32LtNullable            func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32LtSels                func([]uint32, []uint32, []int64, []int64) []int64
-	Uint32LtNullableSels        func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32LtScalar              func(uint32, []uint32, []int64) []int64
-	Uint32LtNullableScalar      func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32LtScalarSels          func(uint32, []uint32, []int64, []int64) []int64
-	Uint32LtNullableScalarSels  func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64Lt                    func([]uint64, []uint64, []int64) []int64
-	Uint64LtNullable            func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64LtSels                func([]uint64, []uint64, []int64, []int64) []int64
-	Uint64LtNullableSels        func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64LtScalar              func(uint64, []uint64, []int64) []int64
-	Uint64LtNullableScalar      func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64LtScalarSels          func(uint64, []uint64, []int64, []int64) []int64
-	Uint64LtNullableScalarSels  func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Float32Lt                   func([]float32, []float32, []int64) []int64
-	Float32LtNullable           func([]float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32LtSels               func([]float32, []float32, []int64, []int64) []int64
-	Float32LtNullableSels       func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float32LtScalar             func(float32, []float32, []int64) []int64
-	Float32LtNullableScalar     func(float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32LtScalarSels         func(float32, []float32, []int64, []int64) []int64
-	Float32LtNullableScalarSels func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float64Lt                   func([]float64, []float64, []int64) []int64
-	Float64LtNullable           func([]float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64LtSels               func([]float64, []float64, []int64, []int64) []int64
-	Float64LtNullableSels       func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	Float64LtScalar             func(float64, []float64, []int64) []int64
-	Float64LtNullableScalar     func(float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64LtScalarSels         func(float64, []float64, []int64, []int64) []int64
-	Float64LtNullableScalarSels func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	StrLt                       func(*types.Bytes, *types.Bytes, []int64) []int64
-	StrLtNullable               func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrLtSels                   func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
-	StrLtNullableSels           func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
-	StrLtScalar                 func([]byte, *types.Bytes, []int64) []int64
-	StrLtNullableScalar         func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrLtScalarSels             func([]byte, *types.Bytes, []int64, []int64) []int64
-	StrLtNullableScalarSels     func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	Int8Lt                         func([]int8, []int8, []int64) []int64
+	Int8LtNullable                 func([]int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8LtSels                     func([]int8, []int8, []int64, []int64) []int64
+	Int8LtNullableSels             func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int8LtScalar                   func(int8, []int8, []int64) []int64
+	Int8LtNullableScalar           func(int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8LtScalarSels               func(int8, []int8, []int64, []int64) []int64
+	Int8LtNullableScalarSels       func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int16Lt                        func([]int16, []int16, []int64) []int64
+	Int16LtNullable                func([]int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16LtSels                    func([]int16, []int16, []int64, []int64) []int64
+	Int16LtNullableSels            func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int16LtScalar                  func(int16, []int16, []int64) []int64
+	Int16LtNullableScalar          func(int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16LtScalarSels              func(int16, []int16, []int64, []int64) []int64
+	Int16LtNullableScalarSels      func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int32Lt                        func([]int32, []int32, []int64) []int64
+	Int32LtNullable                func([]int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32LtSels                    func([]int32, []int32, []int64, []int64) []int64
+	Int32LtNullableSels            func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int32LtScalar                  func(int32, []int32, []int64) []int64
+	Int32LtNullableScalar          func(int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32LtScalarSels              func(int32, []int32, []int64, []int64) []int64
+	Int32LtNullableScalarSels      func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int64Lt                        func([]int64, []int64, []int64) []int64
+	Int64LtNullable                func([]int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64LtSels                    func([]int64, []int64, []int64, []int64) []int64
+	Int64LtNullableSels            func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Int64LtScalar                  func(int64, []int64, []int64) []int64
+	Int64LtNullableScalar          func(int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64LtScalarSels              func(int64, []int64, []int64, []int64) []int64
+	Int64LtNullableScalarSels      func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8Lt                        func([]uint8, []uint8, []int64) []int64
+	Uint8LtNullable                func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8LtSels                    func([]uint8, []uint8, []int64, []int64) []int64
+	Uint8LtNullableSels            func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8LtScalar                  func(uint8, []uint8, []int64) []int64
+	Uint8LtNullableScalar          func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8LtScalarSels              func(uint8, []uint8, []int64, []int64) []int64
+	Uint8LtNullableScalarSels      func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16Lt                       func([]uint16, []uint16, []int64) []int64
+	Uint16LtNullable               func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16LtSels                   func([]uint16, []uint16, []int64, []int64) []int64
+	Uint16LtNullableSels           func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16LtScalar                 func(uint16, []uint16, []int64) []int64
+	Uint16LtNullableScalar         func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16LtScalarSels             func(uint16, []uint16, []int64, []int64) []int64
+	Uint16LtNullableScalarSels     func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32Lt                       func([]uint32, []uint32, []int64) []int64
+	Uint32LtNullable               func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32LtSels                   func([]uint32, []uint32, []int64, []int64) []int64
+	Uint32LtNullableSels           func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32LtScalar                 func(uint32, []uint32, []int64) []int64
+	Uint32LtNullableScalar         func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32LtScalarSels             func(uint32, []uint32, []int64, []int64) []int64
+	Uint32LtNullableScalarSels     func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64Lt                       func([]uint64, []uint64, []int64) []int64
+	Uint64LtNullable               func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64LtSels                   func([]uint64, []uint64, []int64, []int64) []int64
+	Uint64LtNullableSels           func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64LtScalar                 func(uint64, []uint64, []int64) []int64
+	Uint64LtNullableScalar         func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64LtScalarSels             func(uint64, []uint64, []int64, []int64) []int64
+	Uint64LtNullableScalarSels     func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Float32Lt                      func([]float32, []float32, []int64) []int64
+	Float32LtNullable              func([]float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32LtSels                  func([]float32, []float32, []int64, []int64) []int64
+	Float32LtNullableSels          func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float32LtScalar                func(float32, []float32, []int64) []int64
+	Float32LtNullableScalar        func(float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32LtScalarSels            func(float32, []float32, []int64, []int64) []int64
+	Float32LtNullableScalarSels    func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float64Lt                      func([]float64, []float64, []int64) []int64
+	Float64LtNullable              func([]float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64LtSels                  func([]float64, []float64, []int64, []int64) []int64
+	Float64LtNullableSels          func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Float64LtScalar                func(float64, []float64, []int64) []int64
+	Float64LtNullableScalar        func(float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64LtScalarSels            func(float64, []float64, []int64, []int64) []int64
+	Float64LtNullableScalarSels    func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128Lt                   func([]types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128LtNullable           func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128LtSels               func([]types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128LtNullableSels       func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128LtScalar             func(types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128LtNullableScalar     func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128LtScalarSels         func(types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128LtNullableScalarSels func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	StrLt                          func(*types.Bytes, *types.Bytes, []int64) []int64
+	StrLtNullable                  func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrLtSels                      func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
+	StrLtNullableSels              func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	StrLtScalar                    func([]byte, *types.Bytes, []int64) []int64
+	StrLtNullableScalar            func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrLtScalarSels                func([]byte, *types.Bytes, []int64, []int64) []int64
+	StrLtNullableScalarSels        func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
 )
 
 func init() {
@@ -193,6 +201,14 @@ func init() {
 	Float64LtNullableScalar = float64LtNullableScalar
 	Float64LtScalarSels = float64LtScalarSels
 	Float64LtNullableScalarSels = float64LtNullableScalarSels
+	Decimal128Lt = decimal128Lt
+	Decimal128LtNullable = decimal128LtNullable
+	Decimal128LtSels = decimal128LtSels
+	Decimal128LtNullableSels = decimal128LtNullableSels
+	Decimal128LtScalar = decimal128LtScalar
+	Decimal128LtNullableScalar = decimal128LtNullableScalar
+	Decimal128LtScalarSels = decimal128LtScalarSels
+	Decimal128LtNullableScalarSels = decimal128LtNullableScalarSels
 	StrLt = strLt
 	StrLtNullable = strLtNullable
 	StrLtSels = strLtSels
@@ -1500,3 +1516,121 @@ func strLtNullableScalarSels(x []byte, ys *types.Bytes, nulls *roaring.Bitmap, r
 	}
 	return rs[:rsi]
 }
+
+func decimal128Lt(xs, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x.Compare(ys[i]) < 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LtNullable(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, x := range xs {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(ys[i]) < 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LtSels(xs, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if xs[sel].Compare(ys[sel]) < 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LtNullableSels(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && xs[sel].Compare(ys[sel]) < 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LtScalar(x types.Decimal128, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, y := range ys {
+		if x.Compare(y) < 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LtNullableScalar(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, y := range ys {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(y) < 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LtScalarSels(x types.Decimal128, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if x.Compare(ys[sel]) < 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128LtNullableScalarSels(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && x.Compare(ys[sel]) < 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
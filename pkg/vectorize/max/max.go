@@ -20,30 +20,34 @@ import (
 )
 
 var (
-	BoolMax        func([]bool) bool
-	BoolMaxSels    func([]bool, []int64) bool
-	Int8Max        func([]int8) int8
-	Int8MaxSels    func([]int8, []int64) int8
-	Int16Max       func([]int16) int16
-	Int16MaxSels   func([]int16, []int64) int16
-	Int32Max       func([]int32) int32
-	Int32MaxSels   func([]int32, []int64) int32
-	Int64Max       func([]int64) int64
-	Int64MaxSels   func([]int64, []int64) int64
-	Uint8Max       func([]uint8) uint8
-	Uint8MaxSels   func([]uint8, []int64) uint8
-	Uint16Max      func([]uint16) uint16
-	Uint16MaxSels  func([]uint16, []int64) uint16
-	Uint32Max      func([]uint32) uint32
-	Uint32MaxSels  func([]uint32, []int64) uint32
-	Uint64Max      func([]uint64) uint64
-	Uint64MaxSels  func([]uint64, []int64) uint64
-	Float32Max     func([]float32) float32
-	Float32MaxSels func([]float32, []int64) float32
-	Float64Max     func([]float64) float64
-	Float64MaxSels func([]float64, []int64) float64
-	StrMax         func(*types.Bytes) []byte
-	StrMaxSels     func(*types.Bytes, []int64) []byte
+	BoolMax           func([]bool) bool
+	BoolMaxSels       func([]bool, []int64) bool
+	Int8Max           func([]int8) int8
+	Int8MaxSels       func([]int8, []int64) int8
+	Int16Max          func([]int16) int16
+	Int16MaxSels      func([]int16, []int64) int16
+	Int32Max          func([]int32) int32
+	Int32MaxSels      func([]int32, []int64) int32
+	Int64Max          func([]int64) int64
+	Int64MaxSels      func([]int64, []int64) int64
+	Uint8Max          func([]uint8) uint8
+	Uint8MaxSels      func([]uint8, []int64) uint8
+	Uint16Max         func([]uint16) uint16
+	Uint16MaxSels     func([]uint16, []int64) uint16
+	Uint32Max         func([]uint32) uint32
+	Uint32MaxSels     func([]uint32, []int64) uint32
+	Uint64Max         func([]uint64) uint64
+	Uint64MaxSels     func([]uint64, []int64) uint64
+	Float32Max        func([]float32) float32
+	Float32MaxSels    func([]float32, []int64) float32
+	Float64Max        func([]float64) float64
+	Float64MaxSels    func([]float64, []int64) float64
+	StrMax            func(*types.Bytes) []byte
+	StrMaxSels        func(*types.Bytes, []int64) []byte
+	Decimal64Max      func([]types.Decimal64) types.Decimal64
+	Decimal64MaxSels  func([]types.Decimal64, []int64) types.Decimal64
+	Decimal128Max     func([]types.Decimal128) types.Decimal128
+	Decimal128MaxSels func([]types.Decimal128, []int64) types.Decimal128
 )
 
 func boolMax(xs []bool) bool {
@@ -295,3 +299,45 @@ func strMaxSels(xs *types.Bytes, sels []int64) []byte {
 	}
 	return res
 }
+
+func decimal64Max(xs []types.Decimal64) types.Decimal64 {
+	res := xs[0]
+	for _, x := range xs {
+		if x > res {
+			res = x
+		}
+	}
+	return res
+}
+
+func decimal64MaxSels(xs []types.Decimal64, sels []int64) types.Decimal64 {
+	res := xs[sels[0]]
+	for _, sel := range sels {
+		x := xs[sel]
+		if x > res {
+			res = x
+		}
+	}
+	return res
+}
+
+func decimal128Max(xs []types.Decimal128) types.Decimal128 {
+	res := xs[0]
+	for _, x := range xs {
+		if x.Compare(res) > 0 {
+			res = x
+		}
+	}
+	return res
+}
+
+func decimal128MaxSels(xs []types.Decimal128, sels []int64) types.Decimal128 {
+	res := xs[sels[0]]
+	for _, sel := range sels {
+		x := xs[sel]
+		if x.Compare(res) > 0 {
+			res = x
+		}
+	}
+	return res
+}
@@ -78,6 +78,8 @@ func init() {
 
 	BoolMax = boolMax
 	StrMax = strMax
+	Decimal64Max = decimal64Max
+	Decimal128Max = decimal128Max
 
 	BoolMaxSels = boolMaxSels
 	Int8MaxSels = int8MaxSels
@@ -91,6 +93,8 @@ func init() {
 	Float32MaxSels = float32MaxSels
 	Float64MaxSels = float64MaxSels
 	StrMaxSels = strMaxSels
+	Decimal64MaxSels = decimal64MaxSels
+	Decimal128MaxSels = decimal128MaxSels
 }
 
 func int8MaxAvx2(xs []int8) int8 {
@@ -30,6 +30,8 @@ func init() {
 	Float32Max = float32Max
 	Float64Max = float64Max
 	StrMax = strMax
+	Decimal64Max = decimal64Max
+	Decimal128Max = decimal128Max
 
 	BoolMaxSels = boolMaxSels
 	Int8MaxSels = int8MaxSels
@@ -43,4 +45,6 @@ func init() {
 	Float32MaxSels = float32MaxSels
 	Float64MaxSels = float64MaxSels
 	StrMaxSels = strMaxSels
+	Decimal64MaxSels = decimal64MaxSels
+	Decimal128MaxSels = decimal128MaxSels
 }
@@ -20,30 +20,34 @@ import (
 )
 
 var (
-	BoolMin func([]bool) bool
-	BoolMinSels func([]bool, []int64) bool
-	Int8Min func([]int8) int8
-	Int8MinSels func([]int8, []int64) int8
-	Int16Min func([]int16) int16
-	Int16MinSels func([]int16, []int64) int16
-	Int32Min func([]int32) int32
-	Int32MinSels func([]int32, []int64) int32
-	Int64Min func([]int64) int64
-	Int64MinSels func([]int64, []int64) int64
-	Uint8Min func([]uint8) uint8
-	Uint8MinSels func([]uint8, []int64) uint8
-	Uint16Min func([]uint16) uint16
-	Uint16MinSels func([]uint16, []int64) uint16
-	Uint32Min func([]uint32) uint32
-	Uint32MinSels func([]uint32, []int64) uint32
-	Uint64Min func([]uint64) uint64
-	Uint64MinSels func([]uint64, []int64) uint64
-	Float32Min func([]float32) float32
-	Float32MinSels func([]float32, []int64) float32
-	Float64Min func([]float64) float64
-	Float64MinSels func([]float64, []int64) float64
-	StrMin func(*types.Bytes) []byte
-	StrMinSels func(*types.Bytes, []int64) []byte
+	BoolMin           func([]bool) bool
+	BoolMinSels       func([]bool, []int64) bool
+	Int8Min           func([]int8) int8
+	Int8MinSels       func([]int8, []int64) int8
+	Int16Min          func([]int16) int16
+	Int16MinSels      func([]int16, []int64) int16
+	Int32Min          func([]int32) int32
+	Int32MinSels      func([]int32, []int64) int32
+	Int64Min          func([]int64) int64
+	Int64MinSels      func([]int64, []int64) int64
+	Uint8Min          func([]uint8) uint8
+	Uint8MinSels      func([]uint8, []int64) uint8
+	Uint16Min         func([]uint16) uint16
+	Uint16MinSels     func([]uint16, []int64) uint16
+	Uint32Min         func([]uint32) uint32
+	Uint32MinSels     func([]uint32, []int64) uint32
+	Uint64Min         func([]uint64) uint64
+	Uint64MinSels     func([]uint64, []int64) uint64
+	Float32Min        func([]float32) float32
+	Float32MinSels    func([]float32, []int64) float32
+	Float64Min        func([]float64) float64
+	Float64MinSels    func([]float64, []int64) float64
+	StrMin            func(*types.Bytes) []byte
+	StrMinSels        func(*types.Bytes, []int64) []byte
+	Decimal64Min      func([]types.Decimal64) types.Decimal64
+	Decimal64MinSels  func([]types.Decimal64, []int64) types.Decimal64
+	Decimal128Min     func([]types.Decimal128) types.Decimal128
+	Decimal128MinSels func([]types.Decimal128, []int64) types.Decimal128
 )
 
 func boolMin(xs []bool) bool {
@@ -295,3 +299,45 @@ func strMinSels(xs *types.Bytes, sels []int64) []byte {
 	}
 	return res
 }
+
+func decimal64Min(xs []types.Decimal64) types.Decimal64 {
+	res := xs[0]
+	for _, x := range xs {
+		if x < res {
+			res = x
+		}
+	}
+	return res
+}
+
+func decimal64MinSels(xs []types.Decimal64, sels []int64) types.Decimal64 {
+	res := xs[sels[0]]
+	for _, sel := range sels {
+		x := xs[sel]
+		if x < res {
+			res = x
+		}
+	}
+	return res
+}
+
+func decimal128Min(xs []types.Decimal128) types.Decimal128 {
+	res := xs[0]
+	for _, x := range xs {
+		if x.Compare(res) < 0 {
+			res = x
+		}
+	}
+	return res
+}
+
+func decimal128MinSels(xs []types.Decimal128, sels []int64) types.Decimal128 {
+	res := xs[sels[0]]
+	for _, sel := range sels {
+		x := xs[sel]
+		if x.Compare(res) < 0 {
+			res = x
+		}
+	}
+	return res
+}
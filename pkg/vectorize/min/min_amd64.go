@@ -78,6 +78,8 @@ func init() {
 
 	BoolMin = boolMin
 	StrMin = strMin
+	Decimal64Min = decimal64Min
+	Decimal128Min = decimal128Min
 
 	BoolMinSels = boolMinSels
 	Int8MinSels = int8MinSels
@@ -91,6 +93,8 @@ func init() {
 	Float32MinSels = float32MinSels
 	Float64MinSels = float64MinSels
 	StrMinSels = strMinSels
+	Decimal64MinSels = decimal64MinSels
+	Decimal128MinSels = decimal128MinSels
 }
 
 func int8MinAvx2(xs []int8) int8 {
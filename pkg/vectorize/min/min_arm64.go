@@ -30,6 +30,8 @@ func init() {
 	Float32Min = float32Min
 	Float64Min = float64Min
 	StrMin = strMin
+	Decimal64Min = decimal64Min
+	Decimal128Min = decimal128Min
 
 	BoolMinSels = boolMinSels
 	Int8MinSels = int8MinSels
@@ -43,4 +45,6 @@ func init() {
 	Float32MinSels = float32MinSels
 	Float64MinSels = float64MinSels
 	StrMinSels = strMinSels
+	Decimal64MinSels = decimal64MinSels
+	Decimal128MinSels = decimal128MinSels
 }
@@ -14,6 +14,8 @@
 
 package mul
 
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
 var (
 	Int8Mul              func([]int8, []int8, []int8) []int8
 	Int8MulSels          func([]int8, []int8, []int8, []int64) []int8
@@ -82,6 +84,9 @@ var (
 	Uint8Uint32MulSels func([]uint8, []uint32, []uint32, []int64) []uint32
 	Uint8Uint16Mul func([]uint8, []uint16, []uint16) []uint16
 	Uint8Uint16MulSels func([]uint8, []uint16, []uint16, []int64) []uint16
+
+	Decimal128Mul     func([]types.Decimal128, []types.Decimal128, []types.Decimal128) []types.Decimal128
+	Decimal128MulSels func([]types.Decimal128, []types.Decimal128, []types.Decimal128, []int64) []types.Decimal128
 )
 
 func init() {
@@ -152,6 +157,9 @@ func init() {
 	Uint8Uint32MulSels = uint8Uint32MulSels
 	Uint8Uint16Mul = uint8Uint16Mul
 	Uint8Uint16MulSels = uint8Uint16MulSels
+
+	Decimal128Mul = decimal128Mul
+	Decimal128MulSels = decimal128MulSels
 }
 
 func int8Mul(xs, ys, rs []int8) []int8 {
@@ -615,3 +623,17 @@ func uint8Uint16MulSels(xs []uint8, ys, rs []uint16, sels []int64) []uint16 {
 	}
 	return rs
 }
+
+func decimal128Mul(xs, ys, rs []types.Decimal128) []types.Decimal128 {
+	for i, x := range xs {
+		rs[i] = types.Decimal128Mul(x, ys[i])
+	}
+	return rs
+}
+
+func decimal128MulSels(xs, ys, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+	for _, sel := range sels {
+		rs[sel] = types.Decimal128Mul(xs[sel], ys[sel])
+	}
+	return rs
+}
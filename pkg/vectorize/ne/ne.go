@@ -22,94 +22,102 @@ import (
 )
 
 var (
-	Int8Ne                      func([]int8, []int8, []int64) []int64
-	Int8NeNullable              func([]int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8NeSels                  func([]int8, []int8, []int64, []int64) []int64
-	Int8NeNullableSels          func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int8NeScalar                func(int8, []int8, []int64) []int64
-	Int8NeNullableScalar        func(int8, []int8, *roaring.Bitmap, []int64) []int64
-	Int8NeScalarSels            func(int8, []int8, []int64, []int64) []int64
-	Int8NeNullableScalarSels    func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
-	Int16Ne                     func([]int16, []int16, []int64) []int64
-	Int16NeNullable             func([]int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16NeSels                 func([]int16, []int16, []int64, []int64) []int64
-	Int16NeNullableSels         func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int16NeScalar               func(int16, []int16, []int64) []int64
-	Int16NeNullableScalar       func(int16, []int16, *roaring.Bitmap, []int64) []int64
-	Int16NeScalarSels           func(int16, []int16, []int64, []int64) []int64
-	Int16NeNullableScalarSels   func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
-	Int32Ne                     func([]int32, []int32, []int64) []int64
-	Int32NeNullable             func([]int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32NeSels                 func([]int32, []int32, []int64, []int64) []int64
-	Int32NeNullableSels         func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int32NeScalar               func(int32, []int32, []int64) []int64
-	Int32NeNullableScalar       func(int32, []int32, *roaring.Bitmap, []int64) []int64
-	Int32NeScalarSels           func(int32, []int32, []int64, []int64) []int64
-	Int32NeNullableScalarSels   func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
-	Int64Ne                     func([]int64, []int64, []int64) []int64
-	Int64NeNullable             func([]int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64NeSels                 func([]int64, []int64, []int64, []int64) []int64
-	Int64NeNullableSels         func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Int64NeScalar               func(int64, []int64, []int64) []int64
-	Int64NeNullableScalar       func(int64, []int64, *roaring.Bitmap, []int64) []int64
-	Int64NeScalarSels           func(int64, []int64, []int64, []int64) []int64
-	Int64NeNullableScalarSels   func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8Ne                     func([]uint8, []uint8, []int64) []int64
-	Uint8NeNullable             func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8NeSels                 func([]uint8, []uint8, []int64, []int64) []int64
-	Uint8NeNullableSels         func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint8NeScalar               func(uint8, []uint8, []int64) []int64
-	Uint8NeNullableScalar       func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
-	Uint8NeScalarSels           func(uint8, []uint8, []int64, []int64) []int64
-	Uint8NeNullableScalarSels   func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16Ne                    func([]uint16, []uint16, []int64) []int64
-	Uint16NeNullable            func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16NeSels                func([]uint16, []uint16, []int64, []int64) []int64
-	Uint16NeNullableSels        func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint16NeScalar              func(uint16, []uint16, []int64) []int64
-	Uint16NeNullableScalar      func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
-	Uint16NeScalarSels          func(uint16, []uint16, []int64, []int64) []int64
-	Uint16NeNullableScalarSels  func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32Ne                    func([]uint32, []uint32, []int64) []int64
-	Uint32NeNullable            func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32NeSels                func([]uint32, []uint32, []int64, []int64) []int64
-	Uint32NeNullableSels        func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint32NeScalar              func(uint32, []uint32, []int64) []int64
-	Uint32NeNullableScalar      func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
-	Uint32NeScalarSels          func(uint32, []uint32, []int64, []int64) []int64
-	Uint32NeNullableScalarSels  func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64Ne                    func([]uint64, []uint64, []int64) []int64
-	Uint64NeNullable            func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64NeSels                func([]uint64, []uint64, []int64, []int64) []int64
-	Uint64NeNullableSels        func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Uint64NeScalar              func(uint64, []uint64, []int64) []int64
-	Uint64NeNullableScalar      func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
-	Uint64NeScalarSels          func(uint64, []uint64, []int64, []int64) []int64
-	Uint64NeNullableScalarSels  func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
-	Float32Ne                   func([]float32, []float32, []int64) []int64
-	Float32NeNullable           func([]float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32NeSels               func([]float32, []float32, []int64, []int64) []int64
-	Float32NeNullableSels       func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float32NeScalar             func(float32, []float32, []int64) []int64
-	Float32NeNullableScalar     func(float32, []float32, *roaring.Bitmap, []int64) []int64
-	Float32NeScalarSels         func(float32, []float32, []int64, []int64) []int64
-	Float32NeNullableScalarSels func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
-	Float64Ne                   func([]float64, []float64, []int64) []int64
-	Float64NeNullable           func([]float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64NeSels               func([]float64, []float64, []int64, []int64) []int64
-	Float64NeNullableSels       func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	Float64NeScalar             func(float64, []float64, []int64) []int64
-	Float64NeNullableScalar     func(float64, []float64, *roaring.Bitmap, []int64) []int64
-	Float64NeScalarSels         func(float64, []float64, []int64, []int64) []int64
-	Float64NeNullableScalarSels func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
-	StrNe                       func(*types.Bytes, *types.Bytes, []int64) []int64
-	StrNeNullable               func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrNeSels                   func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
-	StrNeNullableSels           func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
-	StrNeScalar                 func([]byte, *types.Bytes, []int64) []int64
-	StrNeNullableScalar         func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
-	StrNeScalarSels             func([]byte, *types.Bytes, []int64, []int64) []int64
-	StrNeNullableScalarSels     func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	Int8Ne                         func([]int8, []int8, []int64) []int64
+	Int8NeNullable                 func([]int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8NeSels                     func([]int8, []int8, []int64, []int64) []int64
+	Int8NeNullableSels             func([]int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int8NeScalar                   func(int8, []int8, []int64) []int64
+	Int8NeNullableScalar           func(int8, []int8, *roaring.Bitmap, []int64) []int64
+	Int8NeScalarSels               func(int8, []int8, []int64, []int64) []int64
+	Int8NeNullableScalarSels       func(int8, []int8, *roaring.Bitmap, []int64, []int64) []int64
+	Int16Ne                        func([]int16, []int16, []int64) []int64
+	Int16NeNullable                func([]int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16NeSels                    func([]int16, []int16, []int64, []int64) []int64
+	Int16NeNullableSels            func([]int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int16NeScalar                  func(int16, []int16, []int64) []int64
+	Int16NeNullableScalar          func(int16, []int16, *roaring.Bitmap, []int64) []int64
+	Int16NeScalarSels              func(int16, []int16, []int64, []int64) []int64
+	Int16NeNullableScalarSels      func(int16, []int16, *roaring.Bitmap, []int64, []int64) []int64
+	Int32Ne                        func([]int32, []int32, []int64) []int64
+	Int32NeNullable                func([]int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32NeSels                    func([]int32, []int32, []int64, []int64) []int64
+	Int32NeNullableSels            func([]int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int32NeScalar                  func(int32, []int32, []int64) []int64
+	Int32NeNullableScalar          func(int32, []int32, *roaring.Bitmap, []int64) []int64
+	Int32NeScalarSels              func(int32, []int32, []int64, []int64) []int64
+	Int32NeNullableScalarSels      func(int32, []int32, *roaring.Bitmap, []int64, []int64) []int64
+	Int64Ne                        func([]int64, []int64, []int64) []int64
+	Int64NeNullable                func([]int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64NeSels                    func([]int64, []int64, []int64, []int64) []int64
+	Int64NeNullableSels            func([]int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Int64NeScalar                  func(int64, []int64, []int64) []int64
+	Int64NeNullableScalar          func(int64, []int64, *roaring.Bitmap, []int64) []int64
+	Int64NeScalarSels              func(int64, []int64, []int64, []int64) []int64
+	Int64NeNullableScalarSels      func(int64, []int64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8Ne                        func([]uint8, []uint8, []int64) []int64
+	Uint8NeNullable                func([]uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8NeSels                    func([]uint8, []uint8, []int64, []int64) []int64
+	Uint8NeNullableSels            func([]uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint8NeScalar                  func(uint8, []uint8, []int64) []int64
+	Uint8NeNullableScalar          func(uint8, []uint8, *roaring.Bitmap, []int64) []int64
+	Uint8NeScalarSels              func(uint8, []uint8, []int64, []int64) []int64
+	Uint8NeNullableScalarSels      func(uint8, []uint8, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16Ne                       func([]uint16, []uint16, []int64) []int64
+	Uint16NeNullable               func([]uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16NeSels                   func([]uint16, []uint16, []int64, []int64) []int64
+	Uint16NeNullableSels           func([]uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint16NeScalar                 func(uint16, []uint16, []int64) []int64
+	Uint16NeNullableScalar         func(uint16, []uint16, *roaring.Bitmap, []int64) []int64
+	Uint16NeScalarSels             func(uint16, []uint16, []int64, []int64) []int64
+	Uint16NeNullableScalarSels     func(uint16, []uint16, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32Ne                       func([]uint32, []uint32, []int64) []int64
+	Uint32NeNullable               func([]uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32NeSels                   func([]uint32, []uint32, []int64, []int64) []int64
+	Uint32NeNullableSels           func([]uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint32NeScalar                 func(uint32, []uint32, []int64) []int64
+	Uint32NeNullableScalar         func(uint32, []uint32, *roaring.Bitmap, []int64) []int64
+	Uint32NeScalarSels             func(uint32, []uint32, []int64, []int64) []int64
+	Uint32NeNullableScalarSels     func(uint32, []uint32, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64Ne                       func([]uint64, []uint64, []int64) []int64
+	Uint64NeNullable               func([]uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64NeSels                   func([]uint64, []uint64, []int64, []int64) []int64
+	Uint64NeNullableSels           func([]uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Uint64NeScalar                 func(uint64, []uint64, []int64) []int64
+	Uint64NeNullableScalar         func(uint64, []uint64, *roaring.Bitmap, []int64) []int64
+	Uint64NeScalarSels             func(uint64, []uint64, []int64, []int64) []int64
+	Uint64NeNullableScalarSels     func(uint64, []uint64, *roaring.Bitmap, []int64, []int64) []int64
+	Float32Ne                      func([]float32, []float32, []int64) []int64
+	Float32NeNullable              func([]float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32NeSels                  func([]float32, []float32, []int64, []int64) []int64
+	Float32NeNullableSels          func([]float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float32NeScalar                func(float32, []float32, []int64) []int64
+	Float32NeNullableScalar        func(float32, []float32, *roaring.Bitmap, []int64) []int64
+	Float32NeScalarSels            func(float32, []float32, []int64, []int64) []int64
+	Float32NeNullableScalarSels    func(float32, []float32, *roaring.Bitmap, []int64, []int64) []int64
+	Float64Ne                      func([]float64, []float64, []int64) []int64
+	Float64NeNullable              func([]float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64NeSels                  func([]float64, []float64, []int64, []int64) []int64
+	Float64NeNullableSels          func([]float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Float64NeScalar                func(float64, []float64, []int64) []int64
+	Float64NeNullableScalar        func(float64, []float64, *roaring.Bitmap, []int64) []int64
+	Float64NeScalarSels            func(float64, []float64, []int64, []int64) []int64
+	Float64NeNullableScalarSels    func(float64, []float64, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128Ne                   func([]types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128NeNullable           func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128NeSels               func([]types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128NeNullableSels       func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	Decimal128NeScalar             func(types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128NeNullableScalar     func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+	Decimal128NeScalarSels         func(types.Decimal128, []types.Decimal128, []int64, []int64) []int64
+	Decimal128NeNullableScalarSels func(types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64, []int64) []int64
+	StrNe                          func(*types.Bytes, *types.Bytes, []int64) []int64
+	StrNeNullable                  func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrNeSels                      func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
+	StrNeNullableSels              func(*types.Bytes, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
+	StrNeScalar                    func([]byte, *types.Bytes, []int64) []int64
+	StrNeNullableScalar            func([]byte, *types.Bytes, *roaring.Bitmap, []int64) []int64
+	StrNeScalarSels                func([]byte, *types.Bytes, []int64, []int64) []int64
+	StrNeNullableScalarSels        func([]byte, *types.Bytes, *roaring.Bitmap, []int64, []int64) []int64
 )
 
 func init() {
@@ -193,6 +201,14 @@ func init() {
 	Float64NeNullableScalar = float64NeNullableScalar
 	Float64NeScalarSels = float64NeScalarSels
 	Float64NeNullableScalarSels = float64NeNullableScalarSels
+	Decimal128Ne = decimal128Ne
+	Decimal128NeNullable = decimal128NeNullable
+	Decimal128NeSels = decimal128NeSels
+	Decimal128NeNullableSels = decimal128NeNullableSels
+	Decimal128NeScalar = decimal128NeScalar
+	Decimal128NeNullableScalar = decimal128NeNullableScalar
+	Decimal128NeScalarSels = decimal128NeScalarSels
+	Decimal128NeNullableScalarSels = decimal128NeNullableScalarSels
 	StrNe = strNe
 	StrNeNullable = strNeNullable
 	StrNeSels = strNeSels
@@ -1500,3 +1516,121 @@ func strNeNullableScalarSels(x []byte, ys *types.Bytes, nulls *roaring.Bitmap, r
 	}
 	return rs[:rsi]
 }
+
+func decimal128Ne(xs, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x.Compare(ys[i]) != 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128NeNullable(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, x := range xs {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(ys[i]) != 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128NeSels(xs, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if xs[sel].Compare(ys[sel]) != 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128NeNullableSels(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && xs[sel].Compare(ys[sel]) != 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128NeScalar(x types.Decimal128, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, y := range ys {
+		if x.Compare(y) != 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128NeNullableScalar(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	nullsIter := nulls.Iterator()
+	nextNull := 0
+
+	if nullsIter.HasNext() {
+		nextNull = int(nullsIter.Next())
+	} else {
+		nextNull = -1
+	}
+
+	for i, y := range ys {
+		if i == nextNull {
+			if nullsIter.HasNext() {
+				nextNull = int(nullsIter.Next())
+			} else {
+				nextNull = -1
+			}
+		} else if x.Compare(y) != 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128NeScalarSels(x types.Decimal128, ys []types.Decimal128, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if x.Compare(ys[sel]) != 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128NeNullableScalarSels(x types.Decimal128, ys []types.Decimal128, nulls *roaring.Bitmap, rs, sels []int64) []int64 {
+	rsi := 0
+	for _, sel := range sels {
+		if !nulls.Contains(uint64(sel)) && x.Compare(ys[sel]) != 0 {
+			rs[rsi] = sel
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
@@ -14,6 +14,8 @@
 
 package neg
 
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
 var (
 	Int8Neg    func([]int8, []int8) []int8
 	Int16Neg   func([]int16, []int16) []int16
@@ -21,6 +23,9 @@ var (
 	Int64Neg   func([]int64, []int64) []int64
 	Float32Neg func([]float32, []float32) []float32
 	Float64Neg func([]float64, []float64) []float64
+
+	Decimal64Neg  func([]types.Decimal64, []types.Decimal64) []types.Decimal64
+	Decimal128Neg func([]types.Decimal128, []types.Decimal128) []types.Decimal128
 )
 
 func int8Neg(xs, rs []int8) []int8 {
@@ -64,3 +69,17 @@ func float64Neg(xs, rs []float64) []float64 {
 	}
 	return rs
 }
+
+func decimal64Neg(xs, rs []types.Decimal64) []types.Decimal64 {
+	for i, x := range xs {
+		rs[i] = -x
+	}
+	return rs
+}
+
+func decimal128Neg(xs, rs []types.Decimal128) []types.Decimal128 {
+	for i, x := range xs {
+		rs[i] = x.Neg()
+	}
+	return rs
+}
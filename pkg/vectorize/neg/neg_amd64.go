@@ -57,6 +57,8 @@ func init() {
 		Float32Neg = float32Neg
 		Float64Neg = float64Neg
 	}
+	Decimal64Neg = decimal64Neg
+	Decimal128Neg = decimal128Neg
 }
 
 func int8NegAvx2(xs, rs []int8) []int8 {
@@ -24,4 +24,6 @@ func init() {
 	Int64Neg = int64Neg
 	Float32Neg = float32Neg
 	Float64Neg = float64Neg
+	Decimal64Neg = decimal64Neg
+	Decimal128Neg = decimal128Neg
 }
@@ -0,0 +1,249 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package round
+
+import (
+	"math"
+)
+
+var (
+	Int8Round    func([]int8, []int8, int64) []int8
+	Int16Round   func([]int16, []int16, int64) []int16
+	Int32Round   func([]int32, []int32, int64) []int32
+	Int64Round   func([]int64, []int64, int64) []int64
+	Uint8Round   func([]uint8, []uint8, int64) []uint8
+	Uint16Round  func([]uint16, []uint16, int64) []uint16
+	Uint32Round  func([]uint32, []uint32, int64) []uint32
+	Uint64Round  func([]uint64, []uint64, int64) []uint64
+	Float32Round func([]float32, []float32, int64) []float32
+	Float64Round func([]float64, []float64, int64) []float64
+)
+
+// powers10 holds the powers of ten that fit in an uint64.
+var powers10 [20]uint64
+
+func init() {
+	powers10[0] = 1
+	for i := 1; i < len(powers10); i++ {
+		powers10[i] = powers10[i-1] * 10
+	}
+	Int8Round = int8Round
+	Int16Round = int16Round
+	Int32Round = int32Round
+	Int64Round = int64Round
+	Uint8Round = uint8Round
+	Uint16Round = uint16Round
+	Uint32Round = uint32Round
+	Uint64Round = uint64Round
+	Float32Round = float32Round
+	Float64Round = float64Round
+}
+
+func int8Round(xs, rs []int8, digits int64) []int8 {
+	if digits >= 0 {
+		copy(rs, xs)
+		return rs
+	}
+	if -digits >= int64(len(powers10)) {
+		for i := range rs[:len(xs)] {
+			rs[i] = 0
+		}
+		return rs
+	}
+	scale := powers10[-digits]
+	for i, x := range xs {
+		if x < 0 {
+			rs[i] = -int8(roundUint(uint64(-int64(x)), scale))
+		} else {
+			rs[i] = int8(roundUint(uint64(x), scale))
+		}
+	}
+	return rs
+}
+
+func int16Round(xs, rs []int16, digits int64) []int16 {
+	if digits >= 0 {
+		copy(rs, xs)
+		return rs
+	}
+	if -digits >= int64(len(powers10)) {
+		for i := range rs[:len(xs)] {
+			rs[i] = 0
+		}
+		return rs
+	}
+	scale := powers10[-digits]
+	for i, x := range xs {
+		if x < 0 {
+			rs[i] = -int16(roundUint(uint64(-int64(x)), scale))
+		} else {
+			rs[i] = int16(roundUint(uint64(x), scale))
+		}
+	}
+	return rs
+}
+
+func int32Round(xs, rs []int32, digits int64) []int32 {
+	if digits >= 0 {
+		copy(rs, xs)
+		return rs
+	}
+	if -digits >= int64(len(powers10)) {
+		for i := range rs[:len(xs)] {
+			rs[i] = 0
+		}
+		return rs
+	}
+	scale := powers10[-digits]
+	for i, x := range xs {
+		if x < 0 {
+			rs[i] = -int32(roundUint(uint64(-int64(x)), scale))
+		} else {
+			rs[i] = int32(roundUint(uint64(x), scale))
+		}
+	}
+	return rs
+}
+
+func int64Round(xs, rs []int64, digits int64) []int64 {
+	if digits >= 0 {
+		copy(rs, xs)
+		return rs
+	}
+	if -digits >= int64(len(powers10)) {
+		for i := range rs[:len(xs)] {
+			rs[i] = 0
+		}
+		return rs
+	}
+	scale := powers10[-digits]
+	for i, x := range xs {
+		if x < 0 {
+			rs[i] = -int64(roundUint(uint64(-int64(x)), scale))
+		} else {
+			rs[i] = int64(roundUint(uint64(x), scale))
+		}
+	}
+	return rs
+}
+
+func uint8Round(xs, rs []uint8, digits int64) []uint8 {
+	if digits >= 0 {
+		copy(rs, xs)
+		return rs
+	}
+	if -digits >= int64(len(powers10)) {
+		for i := range rs[:len(xs)] {
+			rs[i] = 0
+		}
+		return rs
+	}
+	scale := powers10[-digits]
+	for i, x := range xs {
+		rs[i] = uint8(roundUint(uint64(x), scale))
+	}
+	return rs
+}
+
+func uint16Round(xs, rs []uint16, digits int64) []uint16 {
+	if digits >= 0 {
+		copy(rs, xs)
+		return rs
+	}
+	if -digits >= int64(len(powers10)) {
+		for i := range rs[:len(xs)] {
+			rs[i] = 0
+		}
+		return rs
+	}
+	scale := powers10[-digits]
+	for i, x := range xs {
+		rs[i] = uint16(roundUint(uint64(x), scale))
+	}
+	return rs
+}
+
+func uint32Round(xs, rs []uint32, digits int64) []uint32 {
+	if digits >= 0 {
+		copy(rs, xs)
+		return rs
+	}
+	if -digits >= int64(len(powers10)) {
+		for i := range rs[:len(xs)] {
+			rs[i] = 0
+		}
+		return rs
+	}
+	scale := powers10[-digits]
+	for i, x := range xs {
+		rs[i] = uint32(roundUint(uint64(x), scale))
+	}
+	return rs
+}
+
+func uint64Round(xs, rs []uint64, digits int64) []uint64 {
+	if digits >= 0 {
+		copy(rs, xs)
+		return rs
+	}
+	if -digits >= int64(len(powers10)) {
+		for i := range rs[:len(xs)] {
+			rs[i] = 0
+		}
+		return rs
+	}
+	scale := powers10[-digits]
+	for i, x := range xs {
+		rs[i] = uint64(roundUint(uint64(x), scale))
+	}
+	return rs
+}
+
+func float32Round(xs, rs []float32, digits int64) []float32 {
+	scale := math.Pow10(int(digits))
+	for i, x := range xs {
+		rs[i] = float32(roundFloat(float64(x), scale))
+	}
+	return rs
+}
+
+func float64Round(xs, rs []float64, digits int64) []float64 {
+	scale := math.Pow10(int(digits))
+	for i, x := range xs {
+		rs[i] = roundFloat(x, scale)
+	}
+	return rs
+}
+
+// roundUint rounds x half up to a multiple of scale.
+func roundUint(x, scale uint64) uint64 {
+	r := x % scale
+	x -= r
+	if r >= scale-r {
+		x += scale
+	}
+	return x
+}
+
+// roundFloat rounds x half away from zero to a multiple of 1/scale.
+func roundFloat(x, scale float64) float64 {
+	if math.IsInf(scale, 0) || math.IsNaN(x) || math.IsInf(x, 0) {
+		return x
+	}
+	if scale == 0 {
+		return 0
+	}
+	return math.Round(x*scale) / scale
+}
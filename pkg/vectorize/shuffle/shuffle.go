@@ -32,10 +32,11 @@ var (
 	float32Shuffle func([]float32, []float32, []int64) []float32
 	float64Shuffle func([]float64, []float64, []int64) []float64
 
-	decimalShuffle func([]types.Decimal, []int64) []types.Decimal
+	decimal64Shuffle  func([]types.Decimal64, []types.Decimal64, []int64) []types.Decimal64
+	decimal128Shuffle func([]types.Decimal128, []types.Decimal128, []int64) []types.Decimal128
 
-	dateShuffle     func([]types.Date, []int64) []types.Date
-	datetimeShuffle func([]types.Datetime, []int64) []types.Datetime
+	dateShuffle     func([]types.Date, []types.Date, []int64) []types.Date
+	datetimeShuffle func([]types.Datetime, []types.Datetime, []int64) []types.Datetime
 
 	tupleShuffle func([][]interface{}, [][]interface{}, []int64) [][]interface{}
 
@@ -56,7 +57,8 @@ func init() {
 	float32Shuffle = float32ShufflePure
 	float64Shuffle = float64ShufflePure
 
-	decimalShuffle = decimalShufflePure
+	decimal64Shuffle = decimal64ShufflePure
+	decimal128Shuffle = decimal128ShufflePure
 
 	dateShuffle = dateShufflePure
 	datetimeShuffle = datetimeShufflePure
@@ -106,16 +108,20 @@ func Float64Shuffle(vs, ws []float64, sels []int64) []float64 {
 	return float64Shuffle(vs, ws, sels)
 }
 
-func DecimalShuffle(vs []types.Decimal, sels []int64) []types.Decimal {
-	return decimalShuffle(vs, sels)
+func Decimal64Shuffle(vs, ws []types.Decimal64, sels []int64) []types.Decimal64 {
+	return decimal64Shuffle(vs, ws, sels)
 }
 
-func DateShuffle(vs []types.Date, sels []int64) []types.Date {
-	return dateShuffle(vs, sels)
+func Decimal128Shuffle(vs, ws []types.Decimal128, sels []int64) []types.Decimal128 {
+	return decimal128Shuffle(vs, ws, sels)
 }
 
-func DatetimeShuffle(vs []types.Datetime, sels []int64) []types.Datetime {
-	return datetimeShuffle(vs, sels)
+func DateShuffle(vs, ws []types.Date, sels []int64) []types.Date {
+	return dateShuffle(vs, ws, sels)
+}
+
+func DatetimeShuffle(vs, ws []types.Datetime, sels []int64) []types.Datetime {
+	return datetimeShuffle(vs, ws, sels)
 }
 
 func TupleShuffle(vs, ws [][]interface{}, sels []int64) [][]interface{} {
@@ -206,24 +212,35 @@ func float64ShufflePure(vs, ws []float64, sels []int64) []float64 {
 	return vs[:len(sels)]
 }
 
-func decimalShufflePure(vs []types.Decimal, sels []int64) []types.Decimal {
+func decimal64ShufflePure(vs, ws []types.Decimal64, sels []int64) []types.Decimal64 {
 	for i, sel := range sels {
-		vs[i] = vs[sel]
+		ws[i] = vs[sel]
 	}
+	copy(vs, ws)
 	return vs[:len(sels)]
 }
 
-func dateShufflePure(vs []types.Date, sels []int64) []types.Date {
+func decimal128ShufflePure(vs, ws []types.Decimal128, sels []int64) []types.Decimal128 {
 	for i, sel := range sels {
-		vs[i] = vs[sel]
+		ws[i] = vs[sel]
 	}
+	copy(vs, ws)
 	return vs[:len(sels)]
 }
 
-func datetimeShufflePure(vs []types.Datetime, sels []int64) []types.Datetime {
+func dateShufflePure(vs, ws []types.Date, sels []int64) []types.Date {
 	for i, sel := range sels {
-		vs[i] = vs[sel]
+		ws[i] = vs[sel]
 	}
+	copy(vs, ws)
+	return vs[:len(sels)]
+}
+
+func datetimeShufflePure(vs, ws []types.Datetime, sels []int64) []types.Datetime {
+	for i, sel := range sels {
+		ws[i] = vs[sel]
+	}
+	copy(vs, ws)
 	return vs[:len(sels)]
 }
 
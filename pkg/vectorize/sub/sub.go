@@ -14,6 +14,8 @@
 
 package sub
 
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
 var (
 	Int8Sub                func([]int8, []int8, []int8) []int8
 	Int8SubSels            func([]int8, []int8, []int8, []int64) []int8
@@ -102,6 +104,9 @@ var (
 	Uint8Uint32SubSels func([]uint32, []uint8, []uint32, []int64) []uint32
 	Uint8Uint16Sub func([]uint16, []uint8, []uint16) []uint16
 	Uint8Uint16SubSels func([]uint16, []uint8, []uint16, []int64) []uint16
+
+	Decimal128Sub     func([]types.Decimal128, []types.Decimal128, []types.Decimal128) []types.Decimal128
+	Decimal128SubSels func([]types.Decimal128, []types.Decimal128, []types.Decimal128, []int64) []types.Decimal128
 )
 
 func int8Sub(xs, ys, rs []int8) []int8 {
@@ -705,3 +710,17 @@ func uint8Uint16SubSels(xs []uint16, ys []uint8, rs []uint16, sels []int64) []ui
 	}
 	return rs
 }
+
+func decimal128Sub(xs, ys, rs []types.Decimal128) []types.Decimal128 {
+	for i, x := range xs {
+		rs[i] = types.Decimal128Sub(x, ys[i])
+	}
+	return rs
+}
+
+func decimal128SubSels(xs, ys, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+	for _, sel := range sels {
+		rs[sel] = types.Decimal128Sub(xs[sel], ys[sel])
+	}
+	return rs
+}
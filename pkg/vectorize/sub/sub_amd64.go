@@ -234,6 +234,9 @@ func init() {
 	Uint8Uint32SubSels = uint8Uint32SubSels
 	Uint8Uint16Sub = uint8Uint16Sub
 	Uint8Uint16SubSels = uint8Uint16SubSels
+
+	Decimal128Sub = decimal128Sub
+	Decimal128SubSels = decimal128SubSels
 }
 
 func int8SubAvx2(xs, ys, rs []int8) []int8 {
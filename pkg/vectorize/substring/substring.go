@@ -0,0 +1,104 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package substring
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"unicode/utf8"
+)
+
+var (
+	// Substring extracts substrings with a start position and no length limit,
+	// a start slice of length 1 is treated as a constant.
+	Substring func(*types.Bytes, []int64, *types.Bytes) *types.Bytes
+	// SubstringWithLength is like Substring but also limits the number of characters,
+	// start and length slices of length 1 are treated as constants.
+	SubstringWithLength func(*types.Bytes, []int64, []int64, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Substring = substring
+	SubstringWithLength = substringWithLength
+}
+
+func substring(xs *types.Bytes, starts []int64, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		start := starts[0]
+		if len(starts) > 1 {
+			start = starts[i]
+		}
+		appendString(rs, slice(xs.Get(int64(i)), start, -1))
+	}
+	return rs
+}
+
+func substringWithLength(xs *types.Bytes, starts, lengths []int64, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		start, length := starts[0], lengths[0]
+		if len(starts) > 1 {
+			start = starts[i]
+		}
+		if len(lengths) > 1 {
+			length = lengths[i]
+		}
+		if length <= 0 {
+			appendString(rs, nil)
+			continue
+		}
+		appendString(rs, slice(xs.Get(int64(i)), start, length))
+	}
+	return rs
+}
+
+// slice returns the characters of x from the 1-based position start,
+// a negative start counts from the end of x and a negative length means no limit.
+func slice(x []byte, start, length int64) []byte {
+	if start == 0 {
+		return nil
+	}
+	n := int64(utf8.RuneCount(x))
+	if start < 0 {
+		start += n
+	} else {
+		start--
+	}
+	if start < 0 || start >= n {
+		return nil
+	}
+	end := n
+	if length >= 0 && start+length < n {
+		end = start + length
+	}
+	if n == int64(len(x)) {
+		return x[start:end]
+	}
+	var i, begin int64
+	for j := range string(x) {
+		switch i {
+		case start:
+			begin = int64(j)
+		case end:
+			return x[begin:j]
+		}
+		i++
+	}
+	return x[begin:]
+}
+
+func appendString(rs *types.Bytes, x []byte) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Lengths = append(rs.Lengths, uint32(len(x)))
+	rs.Data = append(rs.Data, x...)
+}
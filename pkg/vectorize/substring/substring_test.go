@@ -0,0 +1,37 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package substring
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSubstring(t *testing.T) {
+	xs := &types.Bytes{}
+	require.NoError(t, xs.Append([][]byte{[]byte("matrixone"), []byte("数据库"), []byte("")}))
+	rs := Substring(xs, []int64{2}, &types.Bytes{})
+	require.Equal(t, "atrixone", string(rs.Get(0)))
+	require.Equal(t, "据库", string(rs.Get(1)))
+	require.Equal(t, "", string(rs.Get(2)))
+	rs = SubstringWithLength(xs, []int64{-3}, []int64{2}, &types.Bytes{})
+	require.Equal(t, "on", string(rs.Get(0)))
+	require.Equal(t, "数据", string(rs.Get(1)))
+	rs = SubstringWithLength(xs, []int64{0, 7, 1}, []int64{3}, &types.Bytes{})
+	require.Equal(t, "", string(rs.Get(0)))
+	require.Equal(t, "", string(rs.Get(1)))
+	require.Equal(t, "", string(rs.Get(2)))
+}
@@ -14,27 +14,33 @@
 
 package sum
 
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
 var (
-	Int8Sum        func([]int8) int64
-	Int8SumSels    func([]int8, []int64) int64
-	Int16Sum       func([]int16) int64
-	Int16SumSels   func([]int16, []int64) int64
-	Int32Sum       func([]int32) int64
-	Int32SumSels   func([]int32, []int64) int64
-	Int64Sum       func([]int64) int64
-	Int64SumSels   func([]int64, []int64) int64
-	Uint8Sum       func([]uint8) uint64
-	Uint8SumSels   func([]uint8, []int64) uint64
-	Uint16Sum      func([]uint16) uint64
-	Uint16SumSels  func([]uint16, []int64) uint64
-	Uint32Sum      func([]uint32) uint64
-	Uint32SumSels  func([]uint32, []int64) uint64
-	Uint64Sum      func([]uint64) uint64
-	Uint64SumSels  func([]uint64, []int64) uint64
-	Float32Sum     func([]float32) float32
-	Float32SumSels func([]float32, []int64) float32
-	Float64Sum     func([]float64) float64
-	Float64SumSels func([]float64, []int64) float64
+	Int8Sum           func([]int8) int64
+	Int8SumSels       func([]int8, []int64) int64
+	Int16Sum          func([]int16) int64
+	Int16SumSels      func([]int16, []int64) int64
+	Int32Sum          func([]int32) int64
+	Int32SumSels      func([]int32, []int64) int64
+	Int64Sum          func([]int64) int64
+	Int64SumSels      func([]int64, []int64) int64
+	Uint8Sum          func([]uint8) uint64
+	Uint8SumSels      func([]uint8, []int64) uint64
+	Uint16Sum         func([]uint16) uint64
+	Uint16SumSels     func([]uint16, []int64) uint64
+	Uint32Sum         func([]uint32) uint64
+	Uint32SumSels     func([]uint32, []int64) uint64
+	Uint64Sum         func([]uint64) uint64
+	Uint64SumSels     func([]uint64, []int64) uint64
+	Float32Sum        func([]float32) float32
+	Float32SumSels    func([]float32, []int64) float32
+	Float64Sum        func([]float64) float64
+	Float64SumSels    func([]float64, []int64) float64
+	Decimal64Sum      func([]types.Decimal64) types.Decimal128
+	Decimal64SumSels  func([]types.Decimal64, []int64) types.Decimal128
+	Decimal128Sum     func([]types.Decimal128) types.Decimal128
+	Decimal128SumSels func([]types.Decimal128, []int64) types.Decimal128
 )
 
 func int8Sum(xs []int8) int64 {
@@ -216,3 +222,39 @@ func float64SumSels(xs []float64, sels []int64) float64 {
 	}
 	return res
 }
+
+func decimal64Sum(xs []types.Decimal64) types.Decimal128 {
+	var res types.Decimal128
+
+	for _, x := range xs {
+		res = types.Decimal128Add(res, x.ToDecimal128())
+	}
+	return res
+}
+
+func decimal64SumSels(xs []types.Decimal64, sels []int64) types.Decimal128 {
+	var res types.Decimal128
+
+	for _, sel := range sels {
+		res = types.Decimal128Add(res, xs[sel].ToDecimal128())
+	}
+	return res
+}
+
+func decimal128Sum(xs []types.Decimal128) types.Decimal128 {
+	var res types.Decimal128
+
+	for _, x := range xs {
+		res = types.Decimal128Add(res, x)
+	}
+	return res
+}
+
+func decimal128SumSels(xs []types.Decimal128, sels []int64) types.Decimal128 {
+	var res types.Decimal128
+
+	for _, sel := range sels {
+		res = types.Decimal128Add(res, xs[sel])
+	}
+	return res
+}
@@ -38,4 +38,8 @@ func init() {
 	Uint64SumSels = uint64SumSels
 	Float32SumSels = float32SumSels
 	Float64SumSels = float64SumSels
+	Decimal64Sum = decimal64Sum
+	Decimal64SumSels = decimal64SumSels
+	Decimal128Sum = decimal128Sum
+	Decimal128SumSels = decimal128SumSels
 }
@@ -0,0 +1,59 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trim
+
+import (
+	"bytes"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	Trim  func(*types.Bytes, *types.Bytes) *types.Bytes
+	LTrim func(*types.Bytes, *types.Bytes) *types.Bytes
+	RTrim func(*types.Bytes, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Trim = trim
+	LTrim = ltrim
+	RTrim = rtrim
+}
+
+func trim(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendString(rs, bytes.Trim(xs.Get(int64(i)), " "))
+	}
+	return rs
+}
+
+func ltrim(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendString(rs, bytes.TrimLeft(xs.Get(int64(i)), " "))
+	}
+	return rs
+}
+
+func rtrim(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendString(rs, bytes.TrimRight(xs.Get(int64(i)), " "))
+	}
+	return rs
+}
+
+func appendString(rs *types.Bytes, x []byte) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Lengths = append(rs.Lengths, uint32(len(x)))
+	rs.Data = append(rs.Data, x...)
+}
@@ -3257,3 +3257,192 @@ func float64ToBytesPure(xs []float64, rs *types.Bytes) (*types.Bytes, error) {
 	}
 	return rs, nil
 }
+
+// The decimal conversions take the scale of the decimal side, and for the
+// conversions between decimals n is the difference between the target scale
+// and the source scale.
+
+func Int64ToDecimal128(xs []int64, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+
+	for i, x := range xs {
+		if rs[i], err = types.Decimal128FromInt64(x).Scale(scale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func Uint64ToDecimal128(xs []uint64, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+
+	for i, x := range xs {
+		if rs[i], err = types.Decimal128FromUint64(x).Scale(scale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func Float64ToDecimal128(xs []float64, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+
+	for i, x := range xs {
+		if rs[i], err = types.Decimal128FromFloat64(x, scale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func BytesToDecimal128(xs *types.Bytes, precision, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+
+	for i, o := range xs.Offsets {
+		s := string(xs.Data[o : o+xs.Lengths[i]])
+		if rs[i], err = types.ParseStringToDecimal128(s, precision, scale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func Decimal64ToDecimal64(xs []types.Decimal64, n int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	var err error
+
+	for i, x := range xs {
+		if rs[i], err = x.Scale(n); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func Decimal64ToDecimal128(xs []types.Decimal64, n int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+
+	for i, x := range xs {
+		if rs[i], err = x.ToDecimal128().Scale(n); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func Decimal128ToDecimal64(xs []types.Decimal128, n int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	for i, x := range xs {
+		v, err := x.Scale(n)
+		if err != nil {
+			return nil, err
+		}
+		if rs[i], err = v.ToDecimal64(); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func Decimal128ToDecimal128(xs []types.Decimal128, n int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+
+	for i, x := range xs {
+		if rs[i], err = x.Scale(n); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func Decimal64ToInt64(xs []types.Decimal64, scale int32, rs []int64) ([]int64, error) {
+	for i, x := range xs {
+		rs[i] = x.ToInt64(scale)
+	}
+	return rs, nil
+}
+
+func Decimal128ToInt64(xs []types.Decimal128, scale int32, rs []int64) ([]int64, error) {
+	var err error
+
+	for i, x := range xs {
+		if rs[i], err = x.ToInt64(scale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func Decimal64ToFloat64(xs []types.Decimal64, scale int32, rs []float64) ([]float64, error) {
+	for i, x := range xs {
+		rs[i] = x.ToFloat64(scale)
+	}
+	return rs, nil
+}
+
+func Decimal128ToFloat64(xs []types.Decimal128, scale int32, rs []float64) ([]float64, error) {
+	for i, x := range xs {
+		rs[i] = x.ToFloat64(scale)
+	}
+	return rs, nil
+}
+
+func Decimal64ToBytes(xs []types.Decimal64, scale int32, rs *types.Bytes) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.Format(scale)...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
+
+func Decimal128ToBytes(xs []types.Decimal128, scale int32, rs *types.Bytes) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.Format(scale)...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
+
+func DateToDatetime(xs []types.Date, rs []types.Datetime) ([]types.Datetime, error) {
+	for i, x := range xs {
+		rs[i] = x.ToTime()
+	}
+	return rs, nil
+}
+
+func DatetimeToDate(xs []types.Datetime, rs []types.Date) ([]types.Date, error) {
+	for i, x := range xs {
+		rs[i] = x.ToDate()
+	}
+	return rs, nil
+}
+
+func DateToBytes(xs []types.Date, rs *types.Bytes) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.String()...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
+
+func DatetimeToBytes(xs []types.Datetime, rs *types.Bytes) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.String()...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/mutation"
 	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
 	errDriver "github.com/matrixorigin/matrixone/pkg/vm/driver/error"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/pb"
@@ -153,6 +154,19 @@ func (s *Storage) getSegmentIds(cmd []byte, shardId uint64) []byte {
 	return resp
 }
 
+//tabletStats returns the statistics of the table encoded in json.
+func (s *Storage) tabletStats(cmd []byte, shardId uint64) []byte {
+	customReq := &pb.TabletStatsRequest{}
+	protoc.MustUnmarshal(customReq, cmd)
+	rel, err := s.DB.Relation(aoedbName.ShardIdToName(shardId), customReq.TabletName)
+	if err != nil {
+		return errDriver.ErrorResp(err, "Call TabletStats Failed")
+	}
+	defer rel.Close()
+	resp, _ := json.Marshal(rel.Stats())
+	return resp
+}
+
 //GetShardPesistedId returns the smallest segmente id among the tables starts with prefix
 func (s *Storage) GetShardPesistedId(shardId uint64) uint64 {
 	return s.DB.GetShardCheckpointId(shardId)
@@ -236,6 +250,140 @@ func (s *Storage) dropTable(index uint64, offset, batchsize int, shardId uint64,
 	return writtenBytes, changedBytes, buf
 }
 
+//deleteRows deletes the rows of the table selected by the filter encoded in
+//the request, all the rows are deleted if there's no filter.
+//It returns the number of rows deleted.
+func (s *Storage) deleteRows(index uint64, offset, batchsize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
+	customReq := &pb.DeleteRowsRequest{}
+	protoc.MustUnmarshal(customReq, cmd)
+
+	f := mutation.NewFilter(nil)
+	if len(customReq.Data) > 0 {
+		var err error
+		if f, err = mutation.UnmarshalFilter(customReq.Data); err != nil {
+			return 0, 0, errDriver.ErrorResp(err)
+		}
+	}
+	proc := s.DB.NewProcess()
+	ctx := aoedb.DeleteCtx{
+		TableMutationCtx: aoedb.TableMutationCtx{
+			DBMutationCtx: aoedb.DBMutationCtx{
+				Id:     index,
+				Offset: offset,
+				Size:   batchsize,
+				DB:     aoedbName.ShardIdToName(shardId),
+			},
+			Table: customReq.TabletName,
+		},
+		Attrs: f.Attributes(),
+		Filter: func(bat *batch.Batch) ([]int64, error) {
+			return f.Filter(bat, proc)
+		},
+	}
+	cnt, err := s.DB.Delete(&ctx)
+	if err != nil && err != aoeMeta.IdempotenceErr {
+		buf := errDriver.ErrorResp(err, "Call DeleteRows Failed")
+		return 0, 0, buf
+	}
+	buf := codec.Uint642Bytes(cnt)
+	writtenBytes := uint64(len(key) + len(customReq.Data))
+	changedBytes := int64(writtenBytes)
+	return writtenBytes, changedBytes, buf
+}
+
+//updateRows replaces the rows of the table selected by the update encoded in
+//the request with their new rows, the rows are selected, deleted and the new
+//rows appended by the command.
+//It returns the number of rows updated.
+func (s *Storage) updateRows(index uint64, offset, batchsize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
+	customReq := &pb.UpdateRowsRequest{}
+	protoc.MustUnmarshal(customReq, cmd)
+
+	u, err := mutation.UnmarshalUpdate(customReq.Data)
+	if err != nil {
+		return 0, 0, errDriver.ErrorResp(err)
+	}
+	proc := s.DB.NewProcess()
+	ctx := aoedb.UpdateCtx{
+		TableMutationCtx: aoedb.TableMutationCtx{
+			DBMutationCtx: aoedb.DBMutationCtx{
+				Id:     index,
+				Offset: offset,
+				Size:   batchsize,
+				DB:     aoedbName.ShardIdToName(shardId),
+			},
+			Table: customReq.TabletName,
+		},
+		Filter: func(bat *batch.Batch) ([]int64, error) {
+			return u.Filter(bat, proc)
+		},
+		Update: func(bat *batch.Batch, sels []int64) (*batch.Batch, error) {
+			return u.Update(bat, sels, proc)
+		},
+	}
+	cnt, err := s.DB.Update(&ctx)
+	if err != nil && err != aoeMeta.IdempotenceErr {
+		buf := errDriver.ErrorResp(err, "Call UpdateRows Failed")
+		return 0, 0, buf
+	}
+	buf := codec.Uint642Bytes(cnt)
+	writtenBytes := uint64(len(key) + len(customReq.Data))
+	changedBytes := int64(writtenBytes)
+	return writtenBytes, changedBytes, buf
+}
+
+//alterTable alters the schema of the table to the columns of the table info.
+func (s *Storage) alterTable(index uint64, offset, batchsize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
+	customReq := &pb.AlterTabletRequest{}
+	protoc.MustUnmarshal(customReq, cmd)
+
+	tblInfo, err := helper.DecodeTable(customReq.TableInfo)
+	if err != nil {
+		return 0, 0, errDriver.ErrorResp(err)
+	}
+	schema, _ := adaptor.TableInfoToSchema(s.DB.Store.Catalog, &tblInfo)
+	schema.Name = customReq.Name
+	ctx := aoedb.AlterTableCtx{
+		DBMutationCtx: aoedb.DBMutationCtx{
+			Id:     index,
+			Offset: offset,
+			Size:   batchsize,
+			DB:     aoedbName.ShardIdToName(shardId),
+		},
+		Table:  customReq.Name,
+		Schema: schema,
+	}
+	if err = s.DB.AlterTable(&ctx); err != nil && err != aoeMeta.IdempotenceErr {
+		buf := errDriver.ErrorResp(err, "Call AlterTable Failed")
+		return 0, 0, buf
+	}
+	buf := codec.Uint642Bytes(tblInfo.Id)
+	writtenBytes := uint64(len(key) + len(customReq.TableInfo))
+	changedBytes := int64(writtenBytes)
+	return writtenBytes, changedBytes, buf
+}
+
+//analyzeTable recollects the statistics of the table, it is a write command
+//so that the statistics are recollected by all the replicas of the shard.
+//It returns the number of rows of the table.
+func (s *Storage) analyzeTable(index uint64, offset, batchsize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
+	customReq := &pb.TabletStatsRequest{}
+	protoc.MustUnmarshal(customReq, cmd)
+
+	rel, err := s.DB.Relation(aoedbName.ShardIdToName(shardId), customReq.TabletName)
+	if err != nil {
+		return 0, 0, errDriver.ErrorResp(err, "Call AnalyzeTablet Failed")
+	}
+	defer rel.Close()
+	if err = rel.Analyze(); err != nil {
+		return 0, 0, errDriver.ErrorResp(err, "Call AnalyzeTablet Failed")
+	}
+	buf := codec.Uint642Bytes(uint64(rel.Rows()))
+	writtenBytes := uint64(len(key) + len(customReq.TabletName))
+	changedBytes := int64(writtenBytes)
+	return writtenBytes, changedBytes, buf
+}
+
 //TableIDs returns the ids of all the tables in the storage.
 func (s *Storage) tableIDs() []byte {
 	var ids []uint64
@@ -316,7 +464,7 @@ func (s *Storage) GetInitialStates() ([]meta.ShardMetadata, error) {
 			segment := rel.Meta.SegmentSet[len(rel.Meta.SegmentSet)-1]
 			seg := rel.Segment(segment.Id, nil)
 			blks := seg.Blocks()
-			blk := seg.Block(blks[len(blks)-1], nil)
+			blk := seg.Block(blks[len(blks)-1], s.DB.NewProcess())
 			cds := make([]*bytes.Buffer, len(attrs))
 			dds := make([]*bytes.Buffer, len(attrs))
 			for i := range cds {
@@ -361,6 +509,14 @@ func (s *Storage) Write(ctx storage.WriteContext) error {
 			writtenBytes, changedBytes, rep = s.createTable(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		case uint64(pb.DropTablet):
 			writtenBytes, changedBytes, rep = s.dropTable(batch.Index, idx, batchSize, shard.ID, cmd, key)
+		case uint64(pb.DeleteRows):
+			writtenBytes, changedBytes, rep = s.deleteRows(batch.Index, idx, batchSize, shard.ID, cmd, key)
+		case uint64(pb.UpdateRows):
+			writtenBytes, changedBytes, rep = s.updateRows(batch.Index, idx, batchSize, shard.ID, cmd, key)
+		case uint64(pb.AlterTablet):
+			writtenBytes, changedBytes, rep = s.alterTable(batch.Index, idx, batchSize, shard.ID, cmd, key)
+		case uint64(pb.AnalyzeTablet):
+			writtenBytes, changedBytes, rep = s.analyzeTable(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		case uint64(pb.Append):
 			writtenBytes, changedBytes, rep = s.Append(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		}
@@ -384,6 +540,8 @@ func (s *Storage) Read(ctx storage.ReadContext) ([]byte, error) {
 		rep = s.getSegmentIds(cmd, ctx.Shard().ID)
 	case uint64(pb.GetSegmentedId):
 		rep = s.getSegmentedId(cmd)
+	case uint64(pb.TabletStats):
+		rep = s.tabletStats(cmd, ctx.Shard().ID)
 	case uint64(pb.TabletIds):
 		rep = s.tableIDs()
 	}
@@ -442,11 +600,13 @@ func (s *Storage) SaveShardMetadata(metadatas []meta.ShardMetadata) error {
 			ShardId.Type = types.Type{Oid: types.T_uint64, Size: 8}
 			mateTblInfo.Columns = append(mateTblInfo.Columns, ShardId)
 			LogIndex := aoe.ColumnInfo{
+				Id:   1,
 				Name: sLogIndex,
 			}
 			LogIndex.Type = types.Type{Oid: types.T_uint64, Size: 8}
 			mateTblInfo.Columns = append(mateTblInfo.Columns, LogIndex)
 			colInfo := aoe.ColumnInfo{
+				Id:   2,
 				Name: sMetadata,
 			}
 			colInfo.Type = types.Type{Oid: types.T(types.T_varchar)}
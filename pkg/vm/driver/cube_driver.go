@@ -105,6 +105,19 @@ type CubeDriver interface {
 	CreateTablet(name string, shardId uint64, tbl *aoe.TableInfo) error
 	//DropTablet drops the table in the storage.
 	DropTablet(string, uint64) (uint64, error)
+	//DeleteRows deletes the rows of the table selected by the encoded filter,
+	//it returns the number of rows deleted.
+	DeleteRows(string, uint64, []byte) (uint64, error)
+	//UpdateRows replaces the rows of the table selected by the encoded update
+	//with their new rows, it returns the number of rows updated.
+	UpdateRows(string, uint64, []byte) (uint64, error)
+	//AlterTablet alters the schema of the table in the storage to the columns of tbl.
+	AlterTablet(name string, shardId uint64, tbl *aoe.TableInfo) error
+	//TabletStats returns the number of rows of the table and the sizes and the
+	//statistics of its columns.
+	TabletStats(string, uint64) (dbi.TableStats, error)
+	//AnalyzeTablet recollects the statistics of the table.
+	AnalyzeTablet(string, uint64) error
 	// TabletIDs returns the ids of all the tables in the storage.
 	TabletIDs() ([]uint64, error)
 	// TabletNames returns the names of all the tables in the storage.
@@ -697,6 +710,108 @@ func (h *driver) DropTablet(name string, toShard uint64) (id uint64, err error)
 	return codec.Bytes2Uint64(value)
 }
 
+func (h *driver) DeleteRows(name string, toShard uint64, data []byte) (uint64, error) {
+	req := pb.Request{
+		Shard: toShard,
+		Type:  pb.DeleteRows,
+		Group: pb.AOEGroup,
+		DeleteRows: pb.DeleteRowsRequest{
+			TabletName: name,
+			Data:       data,
+		},
+	}
+	value, err := h.ExecWithGroup(req, pb.AOEGroup)
+	if err != nil {
+		return 0, err
+	}
+	cnt, err := codec.Bytes2Uint64(value)
+	if err != nil {
+		err = errors.New(string(value))
+	}
+	return cnt, err
+}
+
+func (h *driver) UpdateRows(name string, toShard uint64, data []byte) (uint64, error) {
+	req := pb.Request{
+		Shard: toShard,
+		Type:  pb.UpdateRows,
+		Group: pb.AOEGroup,
+		UpdateRows: pb.UpdateRowsRequest{
+			TabletName: name,
+			Data:       data,
+		},
+	}
+	value, err := h.ExecWithGroup(req, pb.AOEGroup)
+	if err != nil {
+		return 0, err
+	}
+	cnt, err := codec.Bytes2Uint64(value)
+	if err != nil {
+		err = errors.New(string(value))
+	}
+	return cnt, err
+}
+
+func (h *driver) AlterTablet(name string, toShard uint64, tbl *aoe.TableInfo) error {
+	info, _ := helper.EncodeTable(*tbl)
+	req := pb.Request{
+		Shard: toShard,
+		Group: pb.AOEGroup,
+		Type:  pb.AlterTablet,
+		AlterTablet: pb.AlterTabletRequest{
+			Name:      name,
+			TableInfo: info,
+		},
+	}
+	rsp, err := h.ExecWithGroup(req, pb.AOEGroup)
+	if err != nil {
+		return err
+	}
+	if _, err = codec.Bytes2Uint64(rsp); err != nil {
+		err = errors.New(string(rsp))
+	}
+	return err
+}
+
+func (h *driver) TabletStats(name string, toShard uint64) (dbi.TableStats, error) {
+	var s dbi.TableStats
+	req := pb.Request{
+		Shard: toShard,
+		Type:  pb.TabletStats,
+		Group: pb.AOEGroup,
+		TabletStats: pb.TabletStatsRequest{
+			TabletName: name,
+		},
+	}
+	value, err := h.ExecWithGroup(req, pb.AOEGroup)
+	if err != nil {
+		return s, err
+	}
+	if err = json.Unmarshal(value, &s); err != nil {
+		err = errors.New(string(value))
+	}
+	return s, err
+}
+
+func (h *driver) AnalyzeTablet(name string, toShard uint64) error {
+	req := pb.Request{
+		Shard: toShard,
+		Type:  pb.AnalyzeTablet,
+		Group: pb.AOEGroup,
+		TabletStats: pb.TabletStatsRequest{
+			TabletName: name,
+		},
+	}
+	value, err := h.ExecWithGroup(req, pb.AOEGroup)
+	if err != nil {
+		return err
+	}
+	if _, err = codec.Bytes2Uint64(value); err != nil {
+		err = errors.New(string(value))
+	}
+	return err
+}
+
 func (h *driver) TabletIDs() ([]uint64, error) {
 	req := pb.Request{
 		Type:      pb.TabletIds,
@@ -92,6 +92,30 @@ func (h *driver) BuildRequest(req *server.CustomRequest, cmd interface{}) error
 		req.CustomType = uint64(pb.DropTablet)
 		req.Write = true
 		req.Cmd = protoc.MustMarshal(&msg)
+	case pb.DeleteRows:
+		msg := customReq.DeleteRows
+		req.Group = uint64(customReq.Group)
+		req.CustomType = uint64(pb.DeleteRows)
+		req.Write = true
+		req.Cmd = protoc.MustMarshal(&msg)
+	case pb.UpdateRows:
+		msg := customReq.UpdateRows
+		req.Group = uint64(customReq.Group)
+		req.CustomType = uint64(pb.UpdateRows)
+		req.Write = true
+		req.Cmd = protoc.MustMarshal(&msg)
+	case pb.AlterTablet:
+		msg := customReq.AlterTablet
+		req.Group = uint64(customReq.Group)
+		req.CustomType = uint64(pb.AlterTablet)
+		req.Write = true
+		req.Cmd = protoc.MustMarshal(&msg)
+	case pb.AnalyzeTablet:
+		msg := customReq.TabletStats
+		req.Group = uint64(customReq.Group)
+		req.CustomType = uint64(pb.AnalyzeTablet)
+		req.Write = true
+		req.Cmd = protoc.MustMarshal(&msg)
 	case pb.Append:
 		msg := customReq.Append
 		req.Group = uint64(customReq.Group)
@@ -116,6 +140,12 @@ func (h *driver) BuildRequest(req *server.CustomRequest, cmd interface{}) error
 		req.CustomType = uint64(pb.GetSegmentedId)
 		req.Read = true
 		req.Cmd = protoc.MustMarshal(&msg)
+	case pb.TabletStats:
+		msg := customReq.TabletStats
+		req.Group = uint64(customReq.Group)
+		req.CustomType = uint64(pb.TabletStats)
+		req.Read = true
+		req.Cmd = protoc.MustMarshal(&msg)
 	}
 	return nil
 }
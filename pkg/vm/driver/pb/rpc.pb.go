@@ -43,6 +43,11 @@ const (
 	TabletNames    Type = 106
 	GetSegmentIds  Type = 107
 	GetSegmentedId Type = 108
+	DeleteRows     Type = 109
+	AlterTablet    Type = 110
+	UpdateRows     Type = 111
+	TabletStats    Type = 112
+	AnalyzeTablet  Type = 113
 )
 
 var Type_name = map[int32]string{
@@ -63,6 +68,11 @@ var Type_name = map[int32]string{
 	106: "TabletNames",
 	107: "GetSegmentIds",
 	108: "GetSegmentedId",
+	109: "DeleteRows",
+	110: "AlterTablet",
+	111: "UpdateRows",
+	112: "TabletStats",
+	113: "AnalyzeTablet",
 }
 
 var Type_value = map[string]int32{
@@ -83,6 +93,11 @@ var Type_value = map[string]int32{
 	"TabletNames":    106,
 	"GetSegmentIds":  107,
 	"GetSegmentedId": 108,
+	"DeleteRows":     109,
+	"AlterTablet":    110,
+	"UpdateRows":     111,
+	"TabletStats":    112,
+	"AnalyzeTablet":  113,
 }
 
 func (x Type) String() string {
@@ -111,6 +126,10 @@ type Request struct {
 	DropTablet           DropTabletRequest     `protobuf:"bytes,104,opt,name=dropTablet,proto3" json:"dropTablet"`
 	GetSegmentIds        GetSegmentIdsRequest  `protobuf:"bytes,105,opt,name=getSegmentIds,proto3" json:"getSegmentIds"`
 	GetSegmentedId       GetSegmentedIdRequest `protobuf:"bytes,106,opt,name=getSegmentedId,proto3" json:"getSegmentedId"`
+	DeleteRows           DeleteRowsRequest     `protobuf:"bytes,107,opt,name=deleteRows,proto3" json:"deleteRows"`
+	AlterTablet          AlterTabletRequest    `protobuf:"bytes,108,opt,name=alterTablet,proto3" json:"alterTablet"`
+	UpdateRows           UpdateRowsRequest     `protobuf:"bytes,109,opt,name=updateRows,proto3" json:"updateRows"`
+	TabletStats          TabletStatsRequest    `protobuf:"bytes,110,opt,name=tabletStats,proto3" json:"tabletStats"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -268,6 +287,34 @@ func (m *Request) GetGetSegmentedId() GetSegmentedIdRequest {
 	return GetSegmentedIdRequest{}
 }
 
+func (m *Request) GetDeleteRows() DeleteRowsRequest {
+	if m != nil {
+		return m.DeleteRows
+	}
+	return DeleteRowsRequest{}
+}
+
+func (m *Request) GetAlterTablet() AlterTabletRequest {
+	if m != nil {
+		return m.AlterTablet
+	}
+	return AlterTabletRequest{}
+}
+
+func (m *Request) GetUpdateRows() UpdateRowsRequest {
+	if m != nil {
+		return m.UpdateRows
+	}
+	return UpdateRowsRequest{}
+}
+
+func (m *Request) GetTabletStats() TabletStatsRequest {
+	if m != nil {
+		return m.TabletStats
+	}
+	return TabletStatsRequest{}
+}
+
 type Response struct {
 	ID                   uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type                 Type               `protobuf:"varint,2,opt,name=type,proto3,enum=pb.Type" json:"type,omitempty"`
@@ -1067,6 +1114,222 @@ func (m *DropTabletRequest) GetName() string {
 	return ""
 }
 
+//DeleteRowsRequest deletes the rows of the tablet selected by the data.
+type DeleteRowsRequest struct {
+	TabletName           string   `protobuf:"bytes,1,opt,name=tabletName,proto3" json:"tabletName,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRowsRequest) Reset()         { *m = DeleteRowsRequest{} }
+func (m *DeleteRowsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRowsRequest) ProtoMessage()    {}
+func (*DeleteRowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{15}
+}
+func (m *DeleteRowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteRowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRowsRequest.Merge(m, src)
+}
+func (m *DeleteRowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRowsRequest proto.InternalMessageInfo
+
+func (m *DeleteRowsRequest) GetTabletName() string {
+	if m != nil {
+		return m.TabletName
+	}
+	return ""
+}
+
+func (m *DeleteRowsRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+//AlterTabletRequest alters the schema of the tablet to the table info.
+type AlterTabletRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TableInfo            []byte   `protobuf:"bytes,2,opt,name=tableInfo,proto3" json:"tableInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTabletRequest) Reset()         { *m = AlterTabletRequest{} }
+func (m *AlterTabletRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterTabletRequest) ProtoMessage()    {}
+func (*AlterTabletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{16}
+}
+func (m *AlterTabletRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTabletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTabletRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTabletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTabletRequest.Merge(m, src)
+}
+func (m *AlterTabletRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlterTabletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTabletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTabletRequest proto.InternalMessageInfo
+
+func (m *AlterTabletRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AlterTabletRequest) GetTableInfo() []byte {
+	if m != nil {
+		return m.TableInfo
+	}
+	return nil
+}
+
+//UpdateRowsRequest updates the rows of the tablet selected by the data.
+type UpdateRowsRequest struct {
+	TabletName           string   `protobuf:"bytes,1,opt,name=tabletName,proto3" json:"tabletName,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateRowsRequest) Reset()         { *m = UpdateRowsRequest{} }
+func (m *UpdateRowsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRowsRequest) ProtoMessage()    {}
+func (*UpdateRowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{17}
+}
+func (m *UpdateRowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateRowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateRowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateRowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRowsRequest.Merge(m, src)
+}
+func (m *UpdateRowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateRowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRowsRequest proto.InternalMessageInfo
+
+func (m *UpdateRowsRequest) GetTabletName() string {
+	if m != nil {
+		return m.TabletName
+	}
+	return ""
+}
+
+func (m *UpdateRowsRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+//TabletStatsRequest gets or recollects the statistics of the tablet.
+type TabletStatsRequest struct {
+	TabletName           string   `protobuf:"bytes,1,opt,name=tabletName,proto3" json:"tabletName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TabletStatsRequest) Reset()         { *m = TabletStatsRequest{} }
+func (m *TabletStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*TabletStatsRequest) ProtoMessage()    {}
+func (*TabletStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{18}
+}
+func (m *TabletStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TabletStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TabletStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TabletStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TabletStatsRequest.Merge(m, src)
+}
+func (m *TabletStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TabletStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TabletStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TabletStatsRequest proto.InternalMessageInfo
+
+func (m *TabletStatsRequest) GetTabletName() string {
+	if m != nil {
+		return m.TabletName
+	}
+	return ""
+}
+
 // ErrorResponse error response
 type ErrorResponse struct {
 	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
@@ -1079,7 +1342,7 @@ func (m *ErrorResponse) Reset()         { *m = ErrorResponse{} }
 func (m *ErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*ErrorResponse) ProtoMessage()    {}
 func (*ErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{15}
+	return fileDescriptor_77a6da22d6a3feb1, []int{19}
 }
 func (m *ErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1126,7 +1389,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{16}
+	return fileDescriptor_77a6da22d6a3feb1, []int{20}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1167,7 +1430,7 @@ func (m *StringResponse) Reset()         { *m = StringResponse{} }
 func (m *StringResponse) String() string { return proto.CompactTextString(m) }
 func (*StringResponse) ProtoMessage()    {}
 func (*StringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{17}
+	return fileDescriptor_77a6da22d6a3feb1, []int{21}
 }
 func (m *StringResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1478,7 @@ func (m *BytesResponse) Reset()         { *m = BytesResponse{} }
 func (m *BytesResponse) String() string { return proto.CompactTextString(m) }
 func (*BytesResponse) ProtoMessage()    {}
 func (*BytesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{18}
+	return fileDescriptor_77a6da22d6a3feb1, []int{22}
 }
 func (m *BytesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1263,7 +1526,7 @@ func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
 func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
 func (*BoolResponse) ProtoMessage()    {}
 func (*BoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{19}
+	return fileDescriptor_77a6da22d6a3feb1, []int{23}
 }
 func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,7 +1574,7 @@ func (m *Uint64Response) Reset()         { *m = Uint64Response{} }
 func (m *Uint64Response) String() string { return proto.CompactTextString(m) }
 func (*Uint64Response) ProtoMessage()    {}
 func (*Uint64Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{20}
+	return fileDescriptor_77a6da22d6a3feb1, []int{24}
 }
 func (m *Uint64Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1361,7 +1624,7 @@ func (m *BytesSliceResponse) Reset()         { *m = BytesSliceResponse{} }
 func (m *BytesSliceResponse) String() string { return proto.CompactTextString(m) }
 func (*BytesSliceResponse) ProtoMessage()    {}
 func (*BytesSliceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{21}
+	return fileDescriptor_77a6da22d6a3feb1, []int{25}
 }
 func (m *BytesSliceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +1686,7 @@ func (m *Uint32Response) Reset()         { *m = Uint32Response{} }
 func (m *Uint32Response) String() string { return proto.CompactTextString(m) }
 func (*Uint32Response) ProtoMessage()    {}
 func (*Uint32Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{22}
+	return fileDescriptor_77a6da22d6a3feb1, []int{26}
 }
 func (m *Uint32Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1476,6 +1739,10 @@ func init() {
 	proto.RegisterType((*TabletIDsRequest)(nil), "pb.TabletIDsRequest")
 	proto.RegisterType((*CreateTabletRequest)(nil), "pb.CreateTabletRequest")
 	proto.RegisterType((*DropTabletRequest)(nil), "pb.DropTabletRequest")
+	proto.RegisterType((*DeleteRowsRequest)(nil), "pb.DeleteRowsRequest")
+	proto.RegisterType((*AlterTabletRequest)(nil), "pb.AlterTabletRequest")
+	proto.RegisterType((*UpdateRowsRequest)(nil), "pb.UpdateRowsRequest")
+	proto.RegisterType((*TabletStatsRequest)(nil), "pb.TabletStatsRequest")
 	proto.RegisterType((*ErrorResponse)(nil), "pb.ErrorResponse")
 	proto.RegisterType((*EmptyResponse)(nil), "pb.EmptyResponse")
 	proto.RegisterType((*StringResponse)(nil), "pb.StringResponse")
@@ -1489,76 +1756,84 @@ func init() {
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 1093 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x6f, 0x6f, 0xe3, 0xc4,
-	0x13, 0x6e, 0x12, 0x37, 0x7f, 0xa6, 0x49, 0xea, 0xee, 0xaf, 0xd7, 0x9f, 0xaf, 0x3a, 0xa5, 0xc5,
-	0x82, 0x52, 0x4e, 0xba, 0x56, 0xf4, 0x00, 0x55, 0x42, 0x42, 0x6a, 0x2e, 0x55, 0x14, 0x1d, 0x3a,
-	0x90, 0x53, 0x78, 0x07, 0x92, 0x63, 0x6f, 0x1c, 0x5f, 0x1d, 0xdb, 0xd8, 0x1b, 0x74, 0xf9, 0x86,
-	0xf7, 0x06, 0x74, 0x9f, 0xa0, 0x82, 0x7e, 0x11, 0xd0, 0xcc, 0xda, 0xb1, 0x37, 0x09, 0xbc, 0xe0,
-	0xdd, 0xcc, 0xb3, 0xcf, 0x33, 0x3b, 0xbb, 0xde, 0x79, 0x12, 0x68, 0x25, 0xb1, 0x73, 0x11, 0x27,
-	0x91, 0x88, 0x58, 0x35, 0x9e, 0x1c, 0xbf, 0xf0, 0x7c, 0x31, 0x5b, 0x4c, 0x2e, 0x9c, 0x68, 0x7e,
-	0xe9, 0x45, 0x5e, 0x74, 0x49, 0x4b, 0x93, 0xc5, 0x94, 0x32, 0x4a, 0x28, 0x92, 0x92, 0x63, 0x98,
-	0x73, 0x61, 0xcb, 0xd8, 0xfc, 0xad, 0x0e, 0x0d, 0x8b, 0xff, 0xb2, 0xe0, 0xa9, 0x60, 0x47, 0x50,
-	0xf5, 0x5d, 0xa3, 0x72, 0x5a, 0x39, 0xd7, 0xfa, 0xf5, 0xc7, 0x87, 0x93, 0xea, 0x68, 0x60, 0x55,
-	0x7d, 0x97, 0x3d, 0x03, 0x4d, 0x2c, 0x63, 0x6e, 0x54, 0x4f, 0x2b, 0xe7, 0xdd, 0xab, 0xe6, 0x45,
-	0x3c, 0xb9, 0xb8, 0x5b, 0xc6, 0xdc, 0x22, 0x94, 0x9d, 0xc0, 0xae, 0x97, 0x44, 0x8b, 0xd8, 0xa8,
-	0xd1, 0x72, 0x0b, 0x97, 0x87, 0x08, 0x58, 0x12, 0x67, 0x87, 0xb0, 0x9b, 0xce, 0xec, 0xc4, 0x35,
-	0x34, 0xac, 0x6c, 0xc9, 0x84, 0x9d, 0x41, 0x2d, 0xe5, 0xc2, 0xd8, 0x3d, 0xad, 0x9c, 0xef, 0x5d,
-	0x75, 0x51, 0x34, 0xe6, 0x22, 0xeb, 0xa4, 0xaf, 0xbd, 0x7f, 0x38, 0xd9, 0xb1, 0x90, 0x80, 0x3c,
-	0x8f, 0x0b, 0xa3, 0x5e, 0xf0, 0x86, 0x1b, 0x3c, 0x8f, 0x0b, 0x76, 0x09, 0x75, 0x97, 0x07, 0x5c,
-	0x70, 0xa3, 0x41, 0xd4, 0x03, 0xa4, 0x0e, 0x08, 0x51, 0xd9, 0x19, 0x8d, 0x7d, 0x06, 0x5a, 0xea,
-	0xd8, 0xa1, 0xd1, 0x24, 0xfa, 0x3e, 0x75, 0xe0, 0xd8, 0xa1, 0x4a, 0x26, 0x0a, 0xfb, 0x1a, 0x20,
-	0x4e, 0xf8, 0xd4, 0x7f, 0x87, 0x04, 0xa3, 0x45, 0x82, 0x27, 0x28, 0xf8, 0x7e, 0x85, 0xaa, 0xb2,
-	0x12, 0x9d, 0x5d, 0x41, 0xc3, 0x0e, 0x82, 0xc8, 0x19, 0x0d, 0x0c, 0x20, 0x25, 0x43, 0xe5, 0x8d,
-	0x84, 0x54, 0x59, 0x4e, 0xc4, 0xc3, 0xd8, 0x71, 0xcc, 0x43, 0xd7, 0x70, 0x8b, 0xc3, 0xdc, 0x10,
-	0xb2, 0x76, 0x18, 0x49, 0x63, 0xdf, 0xc0, 0x9e, 0xc7, 0xc5, 0x38, 0xb4, 0xe3, 0x74, 0x16, 0x09,
-	0x83, 0x93, 0xea, 0x28, 0xbb, 0xad, 0x1c, 0x56, 0xa5, 0x65, 0x01, 0xbb, 0x86, 0x96, 0xb0, 0x27,
-	0x01, 0x17, 0x23, 0x37, 0x35, 0xa6, 0xa4, 0x3e, 0xa4, 0xef, 0x2c, 0xc1, 0x41, 0xaa, 0x6a, 0x0b,
-	0x32, 0xbb, 0x81, 0xb6, 0x93, 0x70, 0x5b, 0x70, 0x49, 0x35, 0x3c, 0x12, 0xff, 0x1f, 0xc5, 0xaf,
-	0x4a, 0xb8, 0xaa, 0x57, 0x24, 0x78, 0xbd, 0x6e, 0x12, 0xc5, 0x59, 0x81, 0x59, 0x71, 0xbd, 0x83,
-	0x15, 0xba, 0x76, 0xbd, 0x05, 0x9d, 0x0d, 0xa0, 0x83, 0x07, 0xe1, 0xde, 0x9c, 0x87, 0xd4, 0xbd,
-	0x4f, 0x7a, 0x23, 0x3f, 0xfb, 0x6a, 0x41, 0x2d, 0xa1, 0x8a, 0xd8, 0x10, 0xba, 0x05, 0xc0, 0xdd,
-	0x91, 0x6b, 0xbc, 0xa5, 0x32, 0x4f, 0xd5, 0x32, 0xb8, 0xa2, 0xd6, 0x59, 0x93, 0x99, 0xbf, 0xd7,
-	0xa0, 0x69, 0xf1, 0x34, 0x8e, 0xc2, 0x94, 0xff, 0xc7, 0x81, 0x7a, 0x01, 0xbb, 0x3c, 0x49, 0xa2,
-	0x84, 0x06, 0x2a, 0xfb, 0xf6, 0xb7, 0x08, 0xe4, 0x75, 0xb3, 0xad, 0x25, 0x8b, 0x7d, 0x09, 0xad,
-	0xc9, 0x52, 0xf0, 0x14, 0x57, 0x69, 0xc4, 0x32, 0x49, 0x3f, 0x07, 0x4b, 0x92, 0x82, 0xc9, 0xae,
-	0xa0, 0x39, 0x89, 0xa2, 0x80, 0x54, 0x72, 0x08, 0x75, 0x52, 0x65, 0x58, 0x49, 0xb4, 0xe2, 0xb1,
-	0x6b, 0x80, 0x85, 0x1f, 0x8a, 0xaf, 0xbe, 0x20, 0x55, 0xbd, 0x78, 0xcd, 0x3f, 0xac, 0xd0, 0x92,
-	0xae, 0xc4, 0xcd, 0x95, 0x2f, 0xaf, 0x48, 0xd9, 0x50, 0x95, 0x12, 0x5d, 0x57, 0x4a, 0x94, 0x0d,
-	0xa0, 0x4b, 0x4d, 0x8f, 0x03, 0xdf, 0xe1, 0xa4, 0x6e, 0x16, 0x8f, 0xbb, 0xaf, 0xac, 0x94, 0x2a,
-	0xac, 0x69, 0x70, 0xff, 0x54, 0x24, 0x7e, 0xe8, 0x51, 0x85, 0x56, 0xb1, 0xff, 0x78, 0x85, 0x96,
-	0xf7, 0x2f, 0xb8, 0xe6, 0x77, 0x00, 0x85, 0x31, 0x31, 0x1d, 0x6a, 0xf7, 0x7c, 0x49, 0x9f, 0xb4,
-	0x6d, 0x61, 0x88, 0xee, 0xf6, 0xab, 0x1d, 0x2c, 0xe4, 0xc7, 0x6c, 0x5b, 0x32, 0x61, 0x4f, 0xa1,
-	0x26, 0x44, 0x40, 0x5f, 0xb0, 0xd6, 0x6f, 0x3c, 0x3e, 0x9c, 0xd4, 0xee, 0xee, 0xbe, 0xb5, 0x10,
-	0x33, 0x7b, 0x00, 0xc3, 0x7f, 0x29, 0x68, 0x7e, 0x04, 0x1d, 0xc5, 0xb6, 0xb6, 0x50, 0xae, 0xa1,
-	0xab, 0xfa, 0xc7, 0xf6, 0xbe, 0x26, 0xb6, 0x70, 0x66, 0xd4, 0x97, 0x66, 0xc9, 0xc4, 0x7c, 0x0d,
-	0x7b, 0x25, 0xb7, 0x22, 0x6b, 0x16, 0x76, 0x22, 0x32, 0xa1, 0x4c, 0xb0, 0x18, 0x5a, 0x8f, 0x3c,
-	0x10, 0x86, 0xc8, 0x0b, 0xfc, 0xb9, 0x2f, 0xe8, 0x40, 0x9a, 0x25, 0x13, 0xf3, 0x27, 0x38, 0xd8,
-	0x30, 0x40, 0x76, 0x04, 0x75, 0x69, 0x7e, 0x59, 0xcd, 0x2c, 0x63, 0xc7, 0xd0, 0xa4, 0xea, 0xaf,
-	0xf9, 0x32, 0xab, 0xbc, 0xca, 0xff, 0xa1, 0xfc, 0x2b, 0xe8, 0x28, 0x96, 0xc7, 0x7a, 0x00, 0xd2,
-	0x77, 0xde, 0xd8, 0x73, 0x4e, 0xe5, 0x5b, 0x56, 0x09, 0x61, 0x0c, 0x34, 0xd7, 0x16, 0x76, 0x56,
-	0x9e, 0x62, 0xf3, 0x0c, 0xd8, 0xa6, 0x03, 0xe2, 0x09, 0x1d, 0x91, 0x77, 0x88, 0xa1, 0xf9, 0x1c,
-	0x0e, 0xb7, 0xb9, 0x05, 0xd6, 0x0c, 0x8b, 0xdd, 0x28, 0x36, 0x3f, 0x87, 0x27, 0x5b, 0x2d, 0x81,
-	0x19, 0xd0, 0xa0, 0x1f, 0xb7, 0x51, 0x36, 0xf4, 0x56, 0x9e, 0x9a, 0x0c, 0xf4, 0x75, 0x2b, 0x35,
-	0x87, 0xf0, 0xbf, 0x2d, 0x0e, 0xb9, 0x6d, 0x47, 0xf6, 0x2c, 0xb3, 0xe7, 0x51, 0x38, 0x8d, 0xb2,
-	0xe3, 0x15, 0x80, 0xf9, 0x29, 0x1c, 0x6c, 0x38, 0xe5, 0xd6, 0xc6, 0x3f, 0x81, 0x8e, 0x62, 0x24,
-	0x78, 0xf1, 0xd2, 0x6a, 0x24, 0x4b, 0x26, 0xe6, 0x3e, 0x74, 0x6e, 0xe7, 0xb1, 0x58, 0xe6, 0x34,
-	0xf3, 0x0c, 0xba, 0xea, 0x9c, 0x14, 0xaf, 0x3e, 0x13, 0x52, 0x82, 0xf5, 0x15, 0xd7, 0x51, 0x69,
-	0xf9, 0x70, 0x98, 0x1f, 0x43, 0xbb, 0x6c, 0x33, 0x2a, 0xab, 0x99, 0xb3, 0xce, 0xa0, 0xab, 0xda,
-	0x8a, 0xca, 0xd3, 0x72, 0xde, 0xcf, 0xc0, 0x36, 0x6d, 0x00, 0x8f, 0x7f, 0xcf, 0x97, 0xa9, 0x51,
-	0x39, 0xad, 0xe1, 0x5b, 0xc0, 0x18, 0x9f, 0x26, 0x49, 0x52, 0xa3, 0x4a, 0x68, 0x96, 0xe1, 0xed,
-	0x06, 0x76, 0x2a, 0x7e, 0xa4, 0xda, 0xf2, 0x09, 0x16, 0x40, 0xde, 0x47, 0x61, 0x52, 0x6a, 0x1f,
-	0x9d, 0xac, 0x8f, 0xe7, 0x7f, 0x55, 0x40, 0x43, 0x17, 0x67, 0x0d, 0xa8, 0x8d, 0xb9, 0xd0, 0x77,
-	0x30, 0x18, 0xf0, 0x40, 0xaf, 0x60, 0x30, 0xe4, 0x42, 0xaf, 0xb2, 0x2e, 0x40, 0x31, 0x31, 0x7a,
-	0x8d, 0x35, 0x41, 0xa3, 0x48, 0xc3, 0x68, 0x14, 0x3a, 0x89, 0xbe, 0xcb, 0x0e, 0xa0, 0x33, 0xe6,
-	0x62, 0x34, 0x7d, 0x13, 0x89, 0xdb, 0x77, 0x7e, 0x2a, 0xf4, 0x3a, 0x42, 0x03, 0x1e, 0x94, 0xa0,
-	0x06, 0x03, 0xa8, 0xcb, 0xe1, 0xd0, 0x5d, 0xb6, 0x0f, 0x7b, 0xa5, 0x37, 0xae, 0x73, 0xa6, 0x43,
-	0xbb, 0xfc, 0xb2, 0xf4, 0x29, 0x6e, 0x5c, 0x3c, 0x11, 0xdd, 0x63, 0x6d, 0xfc, 0x95, 0x0a, 0x6c,
-	0xe1, 0x47, 0xa1, 0x3e, 0x63, 0x1d, 0x68, 0xdd, 0xe5, 0x3f, 0xe8, 0xba, 0x8f, 0xf5, 0xee, 0x56,
-	0x53, 0x95, 0xea, 0x6f, 0x71, 0x7f, 0x65, 0x38, 0xf4, 0x7b, 0xc6, 0xa0, 0xab, 0xce, 0x80, 0x1e,
-	0xf4, 0xf5, 0x0f, 0x7f, 0xf6, 0x76, 0xde, 0x3f, 0xf6, 0x2a, 0x1f, 0x1e, 0x7b, 0x95, 0x3f, 0x1e,
-	0x7b, 0x95, 0x49, 0x9d, 0xfe, 0x64, 0xbe, 0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x58, 0x88,
-	0xa0, 0xb0, 0x0a, 0x00, 0x00,
+	// 1224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x5f, 0x8f, 0xdb, 0xc4,
+	0x17, 0x6d, 0x12, 0x6f, 0xfe, 0xdc, 0x4d, 0xd2, 0xc9, 0xfc, 0xda, 0xfe, 0xdc, 0xaa, 0xca, 0x2e,
+	0x16, 0x2c, 0xa5, 0x52, 0xb7, 0x22, 0x5b, 0x50, 0x25, 0x24, 0xa4, 0x4d, 0xb3, 0x44, 0x51, 0x51,
+	0x41, 0x4e, 0xca, 0x1b, 0x48, 0x4e, 0x3c, 0x49, 0xdc, 0x75, 0x6c, 0xd7, 0x9e, 0x40, 0xc3, 0x17,
+	0xa4, 0x4f, 0xa8, 0x0f, 0x3c, 0xaf, 0x60, 0x3f, 0x09, 0xba, 0x33, 0xb6, 0xc7, 0x93, 0x04, 0x90,
+	0xf6, 0x6d, 0xee, 0x99, 0x73, 0xee, 0xbd, 0x33, 0xe3, 0x39, 0x93, 0x40, 0x23, 0x8e, 0x66, 0xa7,
+	0x51, 0x1c, 0xf2, 0x90, 0x96, 0xa3, 0xe9, 0x83, 0x27, 0x0b, 0x8f, 0x2f, 0xd7, 0xd3, 0xd3, 0x59,
+	0xb8, 0x7a, 0xba, 0x08, 0x17, 0xe1, 0x53, 0x31, 0x35, 0x5d, 0xcf, 0x45, 0x24, 0x02, 0x31, 0x92,
+	0x92, 0x07, 0xb0, 0x62, 0xdc, 0x91, 0x63, 0xeb, 0xb7, 0x3a, 0xd4, 0x6c, 0xf6, 0x76, 0xcd, 0x12,
+	0x4e, 0xef, 0x41, 0xd9, 0x73, 0xcd, 0xd2, 0x71, 0xe9, 0x91, 0xd1, 0xaf, 0x5e, 0x5f, 0x1d, 0x95,
+	0x47, 0x03, 0xbb, 0xec, 0xb9, 0xf4, 0x21, 0x18, 0x7c, 0x13, 0x31, 0xb3, 0x7c, 0x5c, 0x7a, 0xd4,
+	0xee, 0xd5, 0x4f, 0xa3, 0xe9, 0xe9, 0x64, 0x13, 0x31, 0x5b, 0xa0, 0xf4, 0x08, 0x0e, 0x16, 0x71,
+	0xb8, 0x8e, 0xcc, 0x8a, 0x98, 0x6e, 0xe0, 0xf4, 0x10, 0x01, 0x5b, 0xe2, 0xf4, 0x0e, 0x1c, 0x24,
+	0x4b, 0x27, 0x76, 0x4d, 0x03, 0x33, 0xdb, 0x32, 0xa0, 0x27, 0x50, 0x49, 0x18, 0x37, 0x0f, 0x8e,
+	0x4b, 0x8f, 0x0e, 0x7b, 0x6d, 0x14, 0x8d, 0x19, 0x4f, 0x3b, 0xe9, 0x1b, 0xef, 0xaf, 0x8e, 0x6e,
+	0xd9, 0x48, 0x40, 0xde, 0x82, 0x71, 0xb3, 0xaa, 0x78, 0xc3, 0x1d, 0xde, 0x82, 0x71, 0xfa, 0x14,
+	0xaa, 0x2e, 0xf3, 0x19, 0x67, 0x66, 0x4d, 0x50, 0x3b, 0x48, 0x1d, 0x08, 0x44, 0x67, 0xa7, 0x34,
+	0xfa, 0x19, 0x18, 0xc9, 0xcc, 0x09, 0xcc, 0xba, 0xa0, 0xdf, 0x16, 0x1d, 0xcc, 0x9c, 0x40, 0x27,
+	0x0b, 0x0a, 0xfd, 0x0a, 0x20, 0x8a, 0xd9, 0xdc, 0x7b, 0x87, 0x04, 0xb3, 0x21, 0x04, 0x77, 0x51,
+	0xf0, 0x7d, 0x8e, 0xea, 0xb2, 0x02, 0x9d, 0xf6, 0xa0, 0xe6, 0xf8, 0x7e, 0x38, 0x1b, 0x0d, 0x4c,
+	0x10, 0x4a, 0x8a, 0xca, 0x73, 0x09, 0xe9, 0xb2, 0x8c, 0x88, 0x8b, 0x71, 0xa2, 0x88, 0x05, 0xae,
+	0xe9, 0xaa, 0xc5, 0x9c, 0x0b, 0x64, 0x6b, 0x31, 0x92, 0x46, 0xbf, 0x86, 0xc3, 0x05, 0xe3, 0xe3,
+	0xc0, 0x89, 0x92, 0x65, 0xc8, 0x4d, 0x26, 0x54, 0xf7, 0xd2, 0xdd, 0xca, 0x60, 0x5d, 0x5a, 0x14,
+	0xd0, 0xe7, 0xd0, 0xe0, 0xce, 0xd4, 0x67, 0x7c, 0xe4, 0x26, 0xe6, 0x5c, 0xa8, 0xef, 0x88, 0x73,
+	0x96, 0xe0, 0x20, 0xd1, 0xb5, 0x8a, 0x4c, 0xcf, 0xa1, 0x39, 0x8b, 0x99, 0xc3, 0x99, 0xa4, 0x9a,
+	0x0b, 0x21, 0xfe, 0x3f, 0x8a, 0x5f, 0x14, 0x70, 0x5d, 0xaf, 0x49, 0x70, 0x7b, 0xdd, 0x38, 0x8c,
+	0xd2, 0x04, 0x4b, 0xb5, 0xbd, 0x83, 0x1c, 0xdd, 0xda, 0x5e, 0x45, 0xa7, 0x03, 0x68, 0xe1, 0x42,
+	0xd8, 0x62, 0xc5, 0x02, 0xd1, 0xbd, 0x27, 0xf4, 0x66, 0xb6, 0xf6, 0x7c, 0x42, 0x4f, 0xa1, 0x8b,
+	0xe8, 0x10, 0xda, 0x0a, 0x60, 0xee, 0xc8, 0x35, 0xdf, 0x88, 0x34, 0xf7, 0xf5, 0x34, 0x38, 0xa3,
+	0xe7, 0xd9, 0x92, 0x89, 0xb5, 0xc8, 0x8f, 0x2e, 0xfc, 0x25, 0x31, 0x2f, 0x0b, 0x6b, 0xc9, 0xd1,
+	0xed, 0xb5, 0xe4, 0x13, 0x78, 0x8a, 0x8e, 0xcf, 0x59, 0x9c, 0xee, 0x84, 0xaf, 0x4e, 0xf1, 0x5c,
+	0xc1, 0x5b, 0xa7, 0x58, 0x10, 0x60, 0xf1, 0x75, 0xe4, 0x3a, 0x69, 0xf1, 0x95, 0x2a, 0xfe, 0x3a,
+	0x47, 0xb7, 0x8a, 0x2b, 0x3a, 0x16, 0x97, 0xa7, 0x3a, 0xe6, 0x0e, 0x4f, 0xcc, 0x40, 0x15, 0x9f,
+	0x28, 0x78, 0xab, 0x78, 0x41, 0x60, 0xfd, 0x5e, 0x81, 0xba, 0xcd, 0x92, 0x28, 0x0c, 0x12, 0x76,
+	0x43, 0x2b, 0x79, 0x02, 0x07, 0x2c, 0x8e, 0xc3, 0xd8, 0xac, 0xa8, 0xaf, 0xfe, 0x02, 0x81, 0x2c,
+	0x6f, 0x5a, 0x57, 0xb2, 0xe8, 0x17, 0xd0, 0x98, 0x6e, 0x38, 0x4b, 0x70, 0xd6, 0x34, 0x94, 0xa4,
+	0x9f, 0x81, 0x05, 0x89, 0x62, 0xd2, 0x1e, 0xd4, 0xa7, 0x61, 0xe8, 0x0b, 0x95, 0xb4, 0x1f, 0x22,
+	0x54, 0x29, 0x56, 0x10, 0xe5, 0x3c, 0xfa, 0x1c, 0x60, 0xed, 0x05, 0xfc, 0xcb, 0x67, 0x42, 0x55,
+	0x55, 0xf7, 0xf8, 0x75, 0x8e, 0x16, 0x74, 0x05, 0x6e, 0xa6, 0x3c, 0xeb, 0x09, 0x65, 0x4d, 0x57,
+	0x9e, 0xf5, 0xf6, 0x29, 0x25, 0x4a, 0x07, 0xd0, 0x16, 0x4d, 0x8f, 0x7d, 0x6f, 0xc6, 0x84, 0xba,
+	0xae, 0xce, 0xa4, 0xaf, 0xcd, 0x14, 0x32, 0x6c, 0x69, 0xb0, 0x7e, 0xc2, 0x63, 0x2f, 0x58, 0x88,
+	0x0c, 0x0d, 0x55, 0x7f, 0x9c, 0xa3, 0xc5, 0xfa, 0x8a, 0x6b, 0x7d, 0x07, 0xa0, 0x2c, 0x99, 0x12,
+	0xa8, 0x5c, 0xb2, 0x8d, 0x38, 0xd2, 0xa6, 0x8d, 0x43, 0xf4, 0xf5, 0x9f, 0x1d, 0x7f, 0x2d, 0x0f,
+	0xb3, 0x69, 0xcb, 0x80, 0xde, 0x87, 0x0a, 0xe7, 0xbe, 0x38, 0xc1, 0x4a, 0xbf, 0x76, 0x7d, 0x75,
+	0x54, 0x99, 0x4c, 0xbe, 0xb5, 0x11, 0xb3, 0xba, 0x00, 0xc3, 0x7f, 0x49, 0x68, 0x7d, 0x04, 0x2d,
+	0xcd, 0xb0, 0xf7, 0x50, 0x9e, 0x43, 0x5b, 0x77, 0xce, 0xfd, 0x7d, 0x4d, 0x1d, 0x3e, 0x5b, 0x8a,
+	0xbe, 0x0c, 0x5b, 0x06, 0xd6, 0x4b, 0x38, 0x2c, 0xf8, 0x34, 0x92, 0x12, 0xee, 0xc4, 0x3c, 0x15,
+	0xca, 0x00, 0x93, 0xa1, 0xe9, 0xca, 0x05, 0xe1, 0x10, 0x79, 0xbe, 0xb7, 0xf2, 0xb8, 0x58, 0x90,
+	0x61, 0xcb, 0xc0, 0xfa, 0x11, 0x3a, 0x3b, 0xd6, 0x4f, 0xef, 0x41, 0x55, 0xda, 0x7e, 0x9a, 0x33,
+	0x8d, 0xe8, 0x03, 0xa8, 0x8b, 0xec, 0x2f, 0xd9, 0x26, 0xcd, 0x9c, 0xc7, 0xff, 0x90, 0xfe, 0x05,
+	0xb4, 0x34, 0xb3, 0xa7, 0x5d, 0x00, 0x79, 0xd5, 0x5e, 0x39, 0x2b, 0x26, 0xd2, 0x37, 0xec, 0x02,
+	0x42, 0x29, 0x18, 0xae, 0xc3, 0x9d, 0x34, 0xbd, 0x18, 0x5b, 0x27, 0x40, 0x77, 0xbd, 0x1f, 0x57,
+	0x38, 0xe3, 0x59, 0x87, 0x38, 0xb4, 0x1e, 0xc3, 0x9d, 0x7d, 0x3e, 0x89, 0x39, 0x03, 0x55, 0x4d,
+	0x8c, 0xad, 0xcf, 0xe1, 0xee, 0x5e, 0x33, 0xa4, 0x26, 0xd4, 0xc4, 0xb3, 0x3e, 0x4a, 0x2f, 0xbd,
+	0x9d, 0x85, 0x16, 0x05, 0xb2, 0xfd, 0x88, 0x58, 0x43, 0xf8, 0xdf, 0x9e, 0xb7, 0x61, 0x5f, 0x45,
+	0xfa, 0x30, 0x7d, 0x98, 0x46, 0xc1, 0x3c, 0x4c, 0x97, 0xa7, 0x00, 0xeb, 0x53, 0xe8, 0xec, 0xbc,
+	0x11, 0x7b, 0x1b, 0x1f, 0x42, 0x67, 0xc7, 0x80, 0x6f, 0xb4, 0xab, 0xdf, 0x00, 0xdd, 0xf5, 0xe2,
+	0x1b, 0x74, 0x3e, 0x84, 0xce, 0x8e, 0x29, 0xdf, 0xa8, 0xa1, 0x67, 0x40, 0x77, 0xfd, 0xf9, 0xbf,
+	0x32, 0x59, 0x9f, 0x40, 0x4b, 0x33, 0x56, 0xfc, 0x10, 0xa5, 0xf5, 0x4a, 0xae, 0x0c, 0xac, 0xdb,
+	0xd0, 0xba, 0x58, 0x45, 0x7c, 0x93, 0xd1, 0xac, 0x13, 0x68, 0xeb, 0xbe, 0xa1, 0x5c, 0x20, 0x15,
+	0x8a, 0x00, 0xf3, 0x6b, 0x2e, 0xac, 0xd3, 0x32, 0xb3, 0xb0, 0x3e, 0x86, 0x66, 0xd1, 0x76, 0x75,
+	0x56, 0x3d, 0x63, 0x9d, 0x40, 0x5b, 0xb7, 0x59, 0x9d, 0x67, 0x64, 0xbc, 0x9f, 0x80, 0xee, 0xda,
+	0x22, 0x6e, 0xda, 0x25, 0xdb, 0x24, 0x66, 0xe9, 0xb8, 0x82, 0x9b, 0x86, 0x63, 0xbc, 0xaa, 0x42,
+	0x92, 0x98, 0x65, 0x81, 0xa6, 0x11, 0x9e, 0x99, 0xef, 0x24, 0xfc, 0x07, 0x91, 0x5b, 0x5e, 0x49,
+	0x05, 0x64, 0x7d, 0x9c, 0xf5, 0xf6, 0xf7, 0xd1, 0x4a, 0xfb, 0x78, 0xfc, 0x47, 0x19, 0x0c, 0x7c,
+	0xd5, 0x68, 0x0d, 0x2a, 0x63, 0xc6, 0xc9, 0x2d, 0x1c, 0x0c, 0x98, 0x4f, 0x4a, 0x38, 0x18, 0x32,
+	0x4e, 0xca, 0xb4, 0x0d, 0xa0, 0x1c, 0x84, 0x54, 0x68, 0x1d, 0x0c, 0x31, 0x32, 0x70, 0x34, 0x0a,
+	0x66, 0x31, 0x39, 0xa0, 0x1d, 0x68, 0x8d, 0x19, 0x1f, 0xcd, 0x5f, 0x85, 0xfc, 0xe2, 0x9d, 0x97,
+	0x70, 0x52, 0x45, 0x68, 0xc0, 0xfc, 0x02, 0x54, 0xa3, 0x00, 0x55, 0x69, 0x16, 0xc4, 0xa5, 0xb7,
+	0xe1, 0xb0, 0x70, 0xe7, 0x09, 0xa3, 0x04, 0x9a, 0xc5, 0x9b, 0x46, 0xe6, 0x58, 0x58, 0x5d, 0x19,
+	0xb2, 0xa0, 0x4d, 0x7c, 0xb5, 0x7d, 0x87, 0x7b, 0x61, 0x40, 0x96, 0xb4, 0x05, 0x8d, 0x49, 0xf6,
+	0xd3, 0x8e, 0x78, 0x98, 0x6f, 0x92, 0x7f, 0x34, 0x09, 0x79, 0x83, 0xf5, 0x35, 0xb3, 0x20, 0x97,
+	0x94, 0x42, 0x5b, 0xf7, 0x04, 0xe2, 0x8b, 0x22, 0xf9, 0x75, 0x23, 0x2b, 0xcc, 0x53, 0xb8, 0x35,
+	0x24, 0x40, 0x82, 0xfa, 0xfc, 0x49, 0xa8, 0x0a, 0x89, 0xaf, 0x98, 0x44, 0x58, 0xe8, 0x3c, 0x70,
+	0xfc, 0xcd, 0xaf, 0x59, 0xe7, 0x6f, 0xfb, 0xe4, 0xc3, 0x5f, 0xdd, 0x5b, 0xef, 0xaf, 0xbb, 0xa5,
+	0x0f, 0xd7, 0xdd, 0xd2, 0x9f, 0xd7, 0xdd, 0xd2, 0xb4, 0x2a, 0xfe, 0xc3, 0x9c, 0xfd, 0x3d, 0x00,
+	0x0a, 0x7b, 0xde, 0x9d, 0x0f, 0x0d, 0x00, 0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -1585,6 +1860,54 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	{
+		size, err := m.TabletStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6
+	i--
+	dAtA[i] = 0xf2
+	{
+		size, err := m.UpdateRows.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6
+	i--
+	dAtA[i] = 0xea
+	{
+		size, err := m.AlterTablet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6
+	i--
+	dAtA[i] = 0xe2
+	{
+		size, err := m.DeleteRows.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6
+	i--
+	dAtA[i] = 0xda
 	{
 		size, err := m.GetSegmentedId.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2347,7 +2670,7 @@ func (m *DropTabletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ErrorResponse) Marshal() (dAtA []byte, err error) {
+func (m *DeleteRowsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2357,12 +2680,12 @@ func (m *ErrorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ErrorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteRowsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ErrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteRowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2371,17 +2694,24 @@ func (m *ErrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintRpc(dAtA, i, uint64(len(m.Error)))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TabletName) > 0 {
+		i -= len(m.TabletName)
+		copy(dAtA[i:], m.TabletName)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.TabletName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+func (m *AlterTabletRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2391,12 +2721,12 @@ func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTabletRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTabletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2405,10 +2735,24 @@ func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.TableInfo) > 0 {
+		i -= len(m.TableInfo)
+		copy(dAtA[i:], m.TableInfo)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.TableInfo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StringResponse) Marshal() (dAtA []byte, err error) {
+func (m *UpdateRowsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2418,12 +2762,12 @@ func (m *StringResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StringResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateRowsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StringResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateRowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2432,7 +2776,143 @@ func (m *StringResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Value) > 0 {
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TabletName) > 0 {
+		i -= len(m.TabletName)
+		copy(dAtA[i:], m.TabletName)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.TabletName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TabletStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TabletStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TabletStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TabletName) > 0 {
+		i -= len(m.TabletName)
+		copy(dAtA[i:], m.TabletName)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.TabletName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ErrorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StringResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StringResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StringResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
 		i = encodeVarintRpc(dAtA, i, uint64(len(m.Value)))
@@ -2682,6 +3162,14 @@ func (m *Request) Size() (n int) {
 	n += 2 + l + sovRpc(uint64(l))
 	l = m.GetSegmentedId.Size()
 	n += 2 + l + sovRpc(uint64(l))
+	l = m.DeleteRows.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.AlterTablet.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.UpdateRows.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.TabletStats.Size()
+	n += 2 + l + sovRpc(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2955,6 +3443,82 @@ func (m *DropTabletRequest) Size() (n int) {
 	return n
 }
 
+func (m *DeleteRowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TabletName)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTabletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.TableInfo)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TabletName)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TabletStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TabletName)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ErrorResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3627,35 +4191,167 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRows", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRpc
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Response) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+			if err := m.DeleteRows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterTablet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AlterTablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateRows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpdateRows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TabletStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowRpc
 			}
@@ -5263,6 +5959,440 @@ func (m *DropTabletRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeleteRowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TabletName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTabletRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTabletRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTabletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableInfo = append(m.TableInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.TableInfo == nil {
+				m.TableInfo = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TabletName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TabletStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TabletStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TabletStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TabletName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ErrorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
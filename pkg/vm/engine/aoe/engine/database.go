@@ -19,13 +19,11 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
-	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/codec"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v2"
-	aoedbName "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v1"
-	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
-	log "github.com/sirupsen/logrus"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/partition"
 )
 
 //Type return the type of the database
@@ -39,11 +37,27 @@ func (db *database) Delete(epoch uint64, name string) error {
 	defer func() {
 		logutil.Debugf("time cost %d ms", time.Since(t0).Milliseconds())
 	}()
-	_, err := db.catalog.DropTable(epoch, db.id, name)
+	tbl, err := db.catalog.GetTable(db.id, name)
+	if err != nil {
+		return err
+	}
+	if len(tbl.Partition) > 0 {
+		pdef, _, err := protocol.DecodePartition(tbl.Partition)
+		if err != nil {
+			return err
+		}
+		for _, p := range partition.Names(pdef) {
+			if _, err := db.catalog.DropTable(epoch, db.id, partition.TableName(name, p)); err != nil {
+				return err
+			}
+		}
+	}
+	_, err = db.catalog.DropTable(epoch, db.id, name)
 	return err
 }
 
-//Create creates the table
+//Create creates the table, the rows of each partition of a partitioned
+//table are kept in a table of its own.
 func (db *database) Create(epoch uint64, name string, defs []engine.TableDef, pdef *engine.PartitionBy, _ *engine.DistributionBy, comment string) error {
 	t0 := time.Now()
 	defer func() {
@@ -53,8 +67,19 @@ func (db *database) Create(epoch uint64, name string, defs []engine.TableDef, pd
 	if err != nil {
 		return err
 	}
-	_, err = db.catalog.CreateTable(epoch, db.id, tbl)
-	return err
+	if _, err = db.catalog.CreateTable(epoch, db.id, tbl); err != nil || pdef == nil {
+		return err
+	}
+	for _, p := range partition.Names(pdef) {
+		ptbl, err := helper.Transfer(db.id, 0, 0, partition.TableName(name, p), comment, defs, nil)
+		if err != nil {
+			return err
+		}
+		if _, err = db.catalog.CreateTable(epoch, db.id, ptbl); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 //Relations returns names of all the tables in the database.
@@ -69,6 +94,9 @@ func (db *database) Relations() []string {
 		return rs
 	}
 	for _, tb := range tbs {
+		if partition.IsPartitionTable(tb.Name) {
+			continue
+		}
 		rs = append(rs, tb.Name)
 	}
 	return rs
@@ -94,33 +122,8 @@ func (db *database) Relation(name string) (engine.Relation, error) {
 		catalog: db.catalog,
 		mp:      make(map[string]*aoedb.Relation),
 	}
-	r.tablets = tablets
-	ldb := db.catalog.Driver.AOEStore()
-	for _, tbl := range tablets {
-		if ids, err := db.catalog.Driver.GetSegmentIds(tbl.Name, tbl.ShardId); err != nil {
-			log.Errorf("get segmentInfos for tablet %s failed, %s", tbl.Name, err.Error())
-		} else {
-			if len(ids.Ids) == 0 {
-				continue
-			}
-			addr := db.catalog.Driver.RaftStore().GetRouter().LeaderReplicaStore(tbl.ShardId).ClientAddr
-			if lRelation, err := ldb.Relation(aoedbName.ShardIdToName(tbl.ShardId), tbl.Name); err == nil {
-				r.mp[tbl.Name] = lRelation
-			}
-			for _, id := range ids.Ids {
-				r.segments = append(r.segments, engine.SegmentInfo{
-					Version:  ids.Version,
-					Id:       string(codec.Uint642Bytes(id)),
-					GroupId:  string(codec.Uint642Bytes(tbl.ShardId)),
-					TabletId: tbl.Name,
-					Node: metadata.Node{
-						Id:   addr,
-						Addr: addr,
-					},
-				})
-			}
-
-		}
+	if err := r.open(tablets); err != nil {
+		return nil, err
 	}
 	return r, nil
 }
@@ -23,7 +23,9 @@ import (
 
 	catalog2 "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
 	aoe3 "github.com/matrixorigin/matrixone/pkg/vm/driver/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/config"
@@ -35,6 +37,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/adaptor"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/mock"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/partition"
 	"go.uber.org/zap/zapcore"
 
 	cConfig "github.com/matrixorigin/matrixcube/config"
@@ -214,6 +217,14 @@ func TestAOEEngine(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tb.ID(), mockTbl.Name)
 
+	//the statistics are collected from all the tablets
+	require.Equal(t, int64(blockRows*blockCnt), tb.Rows())
+	require.NoError(t, tb.Analyze(0))
+	s := tb.ColumnStats(attrs[0].Name)
+	require.NotNil(t, s)
+	require.Equal(t, int64(blockRows*blockCnt), s.Rows)
+	require.Nil(t, tb.ColumnStats("xxx"))
+
 	idxDef := vengine.IndexTableDef{Typ: 0, ColNames: []string{"mock_0"}, Name: "mock_idx"}
 	err = tb.CreateIndex(0, []vengine.TableDef{&idxDef})
 	require.NoError(t, err)
@@ -247,6 +258,54 @@ func TestAOEEngine(t *testing.T) {
 	tbls = db.Relations()
 	require.Equal(t, 0, len(tbls))
 
+	//test partitioned relation
+	bound := vector.New(attrs[0].Type)
+	require.NoError(t, bound.Append([]int32{10}))
+	pdef = &vengine.PartitionBy{
+		Fields: []string{attrs[0].Name},
+		Range: []vengine.RangePartition{
+			{Name: "p0", To: []extend.Extend{&extend.ValueExtend{V: bound}}},
+			{Name: "p1"},
+		},
+	}
+	err = db.Create(6, mockTbl.Name, defs, pdef, nil, comment)
+	require.NoError(t, err)
+	tbls = db.Relations()
+	require.Equal(t, 1, len(tbls))
+
+	tb, err = db.Relation(mockTbl.Name)
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0", "p1"}, partition.Names(tb.Partition()))
+	err = tb.Write(7, ibat)
+	require.NoError(t, err)
+	tb.Close()
+
+	tb, err = db.Relation(mockTbl.Name)
+	require.NoError(t, err)
+	parts := make(map[string]bool)
+	for _, seg := range tb.Segments() {
+		parts[seg.Partition] = true
+	}
+	require.Equal(t, map[string]bool{"p0": true, "p1": true}, parts)
+
+	err = tb.DropPartition(8, "p1")
+	require.NoError(t, err)
+	for _, seg := range tb.Segments() {
+		require.Equal(t, "p0", seg.Partition)
+	}
+	err = tb.AddPartition(9, &vengine.PartitionBy{
+		Fields: pdef.Fields,
+		Range:  []vengine.RangePartition{{Name: "p2"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0", "p2"}, partition.Names(tb.Partition()))
+	tb.Close()
+
+	err = db.Delete(10, mockTbl.Name)
+	require.NoError(t, err)
+	tbls = db.Relations()
+	require.Equal(t, 0, len(tbls))
+
 	if restart {
 		time.Sleep(3 * time.Second)
 		doRestartEngine(t)
@@ -18,14 +18,26 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"math/rand"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/codec"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
+	aoedbName "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/dbi"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/partition"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	log "github.com/sirupsen/logrus"
 	"time"
 )
 
@@ -71,10 +83,38 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 	defer func() {
 		logutil.Debugf("time cost %d ms", time.Since(t0).Milliseconds())
 	}()
-	if len(r.tablets) == 0 {
+	if r.pdef != nil {
+		return r.writePartitions(bat)
+	}
+	return r.write(r.tablets, bat)
+}
+
+//writePartitions writes the rows of the batch into the tablets of their partitions.
+func (r *relation) writePartitions(bat *batch.Batch) error {
+	proc := process.New(guest.New(1<<40, host.New(1<<40)))
+	proc.Mp = mempool.New()
+	bats, err := partition.Batches(r.pdef, bat, proc)
+	if err != nil {
+		return err
+	}
+	names := partition.Names(r.pdef)
+	for i, b := range bats {
+		if b == nil {
+			continue
+		}
+		if err := r.write(r.parts[names[i]], b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//write appends the batch to one of the tablets.
+func (r *relation) write(tablets []aoe.TabletInfo, bat *batch.Batch) error {
+	if len(tablets) == 0 {
 		return errors.New("no tablets exists")
 	}
-	targetTbl := r.tablets[rand.Intn(len(r.tablets))]
+	targetTbl := tablets[rand.Intn(len(tablets))]
 	var buf bytes.Buffer
 	if err := protocol.EncodeBatch(bat, &buf); err != nil {
 		return err
@@ -84,6 +124,42 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 	}
 	return r.catalog.Driver.Append(targetTbl.Name, targetTbl.ShardId, buf.Bytes())
 }
+
+//Delete deletes the rows selected by the filter from all the tablets of the table.
+func (r *relation) Delete(_ uint64, f engine.RowFilter, _ *process.Process) (uint64, error) {
+	data, err := f.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	var cnt uint64
+	for _, tbl := range r.tablets {
+		n, err := r.catalog.Driver.DeleteRows(tbl.Name, tbl.ShardId, data)
+		if err != nil {
+			return cnt, err
+		}
+		cnt += n
+	}
+	return cnt, nil
+}
+
+//Update replaces the rows selected by the update in all the tablets of the
+//table with their new rows, each tablet updates its rows in one command.
+func (r *relation) Update(_ uint64, u engine.RowUpdate, _ *process.Process) (uint64, error) {
+	data, err := u.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	var cnt uint64
+	for _, tbl := range r.tablets {
+		n, err := r.catalog.Driver.UpdateRows(tbl.Name, tbl.ShardId, data)
+		if err != nil {
+			return cnt, err
+		}
+		cnt += n
+	}
+	return cnt, nil
+}
+
 func (r *relation) CreateIndex(epoch uint64, defs []engine.TableDef) error{
 	idxInfo:= helper.IndexDefs(r.pid,r.tbl.Id,nil,defs)
 	//TODO
@@ -92,18 +168,248 @@ func (r *relation) CreateIndex(epoch uint64, defs []engine.TableDef) error{
 func (r *relation) DropIndex(epoch uint64, name string) error{
 	return r.catalog.DropIndex(epoch,r.tbl.Id,r.tbl.SchemaId,name)
 }
-func (r *relation) AddAttribute(_ uint64, _ engine.TableDef) error {
+//AddAttribute adds a column to the table, the blocks written before take
+//the default value of the column when they are read.
+func (r *relation) AddAttribute(epoch uint64, def engine.TableDef) error {
+	attr, ok := def.(*engine.AttributeDef)
+	if !ok {
+		return errors.New("not an attribute definition")
+	}
+	col := aoe.ColumnInfo{
+		Name:        attr.Attr.Name,
+		Alg:         attr.Attr.Alg,
+		Type:        attr.Attr.Type,
+		Default:     attr.Attr.Default,
+		NullAbility: attr.Attr.Nullability,
+	}
+	for _, name := range r.tables() {
+		if err := r.catalog.AddColumn(epoch, r.pid, name, col); err != nil {
+			return err
+		}
+	}
+	return r.refresh()
+}
+
+//DelAttribute drops a column of the table.
+func (r *relation) DelAttribute(epoch uint64, def engine.TableDef) error {
+	attr, ok := def.(*engine.AttributeDef)
+	if !ok {
+		return errors.New("not an attribute definition")
+	}
+	for _, name := range r.tables() {
+		if err := r.catalog.DropColumn(epoch, r.pid, name, attr.Attr.Name); err != nil {
+			return err
+		}
+	}
+	return r.refresh()
+}
+
+//RenameAttribute renames a column of the table.
+func (r *relation) RenameAttribute(epoch uint64, oldName, newName string) error {
+	for _, name := range r.tables() {
+		if err := r.catalog.RenameColumn(epoch, r.pid, name, oldName, newName); err != nil {
+			return err
+		}
+	}
+	return r.refresh()
+}
+
+//Partition returns the partition definition of the table.
+func (r *relation) Partition() *engine.PartitionBy {
+	return r.pdef
+}
+
+//AddPartition creates the tables of the partitions added.
+func (r *relation) AddPartition(epoch uint64, def *engine.PartitionBy) error {
+	if r.pdef == nil {
+		return fmt.Errorf("table '%s' is not partitioned", r.tbl.Name)
+	}
+	pdef, err := partition.Add(r.pdef, def)
+	if err != nil {
+		return err
+	}
+	data, err := helper.PartitionDef(pdef)
+	if err != nil {
+		return err
+	}
+	for _, p := range partition.Names(def) {
+		tbl := *r.tbl
+		tbl.Id = 0
+		tbl.Name = partition.TableName(r.tbl.Name, p)
+		tbl.Indices = nil
+		tbl.Partition = nil
+		if _, err := r.catalog.CreateTable(epoch, r.pid, tbl); err != nil {
+			return err
+		}
+	}
+	if err := r.catalog.UpdatePartition(epoch, r.pid, r.tbl.Name, data); err != nil {
+		return err
+	}
+	return r.reopen()
+}
+
+//DropPartition drops the table of the partition.
+func (r *relation) DropPartition(epoch uint64, name string) error {
+	if r.pdef == nil {
+		return fmt.Errorf("table '%s' is not partitioned", r.tbl.Name)
+	}
+	pdef, err := partition.Drop(r.pdef, name)
+	if err != nil {
+		return err
+	}
+	data, err := helper.PartitionDef(pdef)
+	if err != nil {
+		return err
+	}
+	if err := r.catalog.UpdatePartition(epoch, r.pid, r.tbl.Name, data); err != nil {
+		return err
+	}
+	name, _ = partition.Find(r.pdef, name)
+	if _, err := r.catalog.DropTable(epoch, r.pid, partition.TableName(r.tbl.Name, name)); err != nil {
+		return err
+	}
+	return r.reopen()
+}
+
+//tables returns the name of the table and the names of the tables of its partitions.
+func (r *relation) tables() []string {
+	names := []string{r.tbl.Name}
+	if r.pdef != nil {
+		for _, p := range partition.Names(r.pdef) {
+			names = append(names, partition.TableName(r.tbl.Name, p))
+		}
+	}
+	return names
+}
+
+//open collects the tablets and segments of the table, or of its partitions
+//if the table is partitioned.
+func (r *relation) open(tablets []aoe.TabletInfo) error {
+	if len(r.tbl.Partition) == 0 {
+		r.addTablets(tablets, "")
+		return nil
+	}
+	pdef, _, err := protocol.DecodePartition(r.tbl.Partition)
+	if err != nil {
+		return err
+	}
+	r.pdef = pdef
+	r.parts = make(map[string][]aoe.TabletInfo)
+	for _, p := range partition.Names(pdef) {
+		ts, err := r.catalog.GetTablets(r.pid, partition.TableName(r.tbl.Name, p))
+		if err != nil {
+			return err
+		}
+		r.parts[p] = ts
+		r.addTablets(ts, p)
+	}
 	return nil
 }
 
-func (r *relation) DelAttribute(_ uint64, _ engine.TableDef) error {
+//reopen reloads the table and its partitions after the partitions are altered.
+func (r *relation) reopen() error {
+	if err := r.refresh(); err != nil {
+		return err
+	}
+	r.Close()
+	r.mp = make(map[string]*aoedb.Relation)
+	r.segments = nil
+	r.tablets = nil
+	return r.open(nil)
+}
+
+//addTablets adds the tablets and their segments to the relation.
+func (r *relation) addTablets(tablets []aoe.TabletInfo, part string) {
+	r.tablets = append(r.tablets, tablets...)
+	ldb := r.catalog.Driver.AOEStore()
+	for _, tbl := range tablets {
+		if ids, err := r.catalog.Driver.GetSegmentIds(tbl.Name, tbl.ShardId); err != nil {
+			log.Errorf("get segmentInfos for tablet %s failed, %s", tbl.Name, err.Error())
+		} else {
+			if len(ids.Ids) == 0 {
+				continue
+			}
+			addr := r.catalog.Driver.RaftStore().GetRouter().LeaderReplicaStore(tbl.ShardId).ClientAddr
+			if lRelation, err := ldb.Relation(aoedbName.ShardIdToName(tbl.ShardId), tbl.Name); err == nil {
+				r.mp[tbl.Name] = lRelation
+			}
+			for _, id := range ids.Ids {
+				r.segments = append(r.segments, engine.SegmentInfo{
+					Version:   ids.Version,
+					Id:        string(codec.Uint642Bytes(id)),
+					GroupId:   string(codec.Uint642Bytes(tbl.ShardId)),
+					TabletId:  tbl.Name,
+					Partition: part,
+					Node: metadata.Node{
+						Id:   addr,
+						Addr: addr,
+					},
+				})
+			}
+
+		}
+	}
+}
+
+//refresh reloads the meta of the table after it is altered.
+func (r *relation) refresh() error {
+	tbl, err := r.catalog.GetTable(r.pid, r.tbl.Name)
+	if err != nil {
+		return err
+	}
+	r.tbl = tbl
 	return nil
 }
 
+//tabletStats returns the statistics of all the tablets of the table, the
+//tablets are asked through the driver as they may be kept by other stores.
+func (r *relation) tabletStats() []dbi.TableStats {
+	ss := make([]dbi.TableStats, 0, len(r.tablets))
+	for _, tbl := range r.tablets {
+		s, err := r.catalog.Driver.TabletStats(tbl.Name, tbl.ShardId)
+		if err != nil {
+			log.Errorf("get stats for tablet %s failed, %s", tbl.Name, err.Error())
+			continue
+		}
+		ss = append(ss, s)
+	}
+	return ss
+}
+
+//Rows returns the number of rows of all the tablets of the table.
 func (r *relation) Rows() int64 {
-	return 0
+	var rows int64
+	for _, s := range r.tabletStats() {
+		rows += s.Rows
+	}
+	return rows
+}
+
+//Size returns the size of the column of all the tablets of the table.
+func (r *relation) Size(attr string) int64 {
+	var size int64
+	for _, s := range r.tabletStats() {
+		size += s.Sizes[attr]
+	}
+	return size
 }
 
-func (r *relation) Size(_ string) int64 {
-	return 0
+//ColumnStats returns the statistics of the column merged from all the tablets of the table.
+func (r *relation) ColumnStats(attr string) *stats.ColumnStats {
+	ts := r.tabletStats()
+	ss := make([]*stats.ColumnStats, 0, len(ts))
+	for _, s := range ts {
+		ss = append(ss, s.Columns[attr])
+	}
+	return stats.Merge(ss...)
+}
+
+//Analyze recollects the statistics of all the tablets of the table.
+func (r *relation) Analyze(_ uint64) error {
+	for _, tbl := range r.tablets {
+		if err := r.catalog.Driver.AnalyzeTablet(tbl.Name, tbl.ShardId); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -33,10 +33,12 @@ type database struct {
 }
 
 type relation struct {
-	pid      uint64                     //database id
-	tbl      *aoe.TableInfo             //table of the tablets
-	catalog  *catalog3.Catalog          //the catalog
-	segments []engine.SegmentInfo       //segments of the table
-	tablets  []aoe.TabletInfo           //tablets of the table
-	mp       map[string]*aoedb.Relation //a map of each tablet and its relation
+	pid      uint64                      //database id
+	tbl      *aoe.TableInfo              //table of the tablets
+	catalog  *catalog3.Catalog           //the catalog
+	segments []engine.SegmentInfo        //segments of the table
+	tablets  []aoe.TabletInfo            //tablets of the table
+	mp       map[string]*aoedb.Relation  //a map of each tablet and its relation
+	pdef     *engine.PartitionBy         //partition definition of the table
+	parts    map[string][]aoe.TabletInfo //a map of each partition and its tablets
 }
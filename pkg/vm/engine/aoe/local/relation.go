@@ -15,11 +15,13 @@
 package local
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
 	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -50,6 +52,14 @@ func (r *localRoRelation) Size(attr string) int64 {
 	return r.impl.Size(attr)
 }
 
+func (r *localRoRelation) ColumnStats(attr string) *stats.ColumnStats {
+	return r.impl.ColumnStats(attr)
+}
+
+func (r *localRoRelation) Analyze(_ uint64) error {
+	return r.impl.Analyze()
+}
+
 func (r *localRoRelation) Close() {
 	r.impl.Close()
 }
@@ -74,6 +84,17 @@ func (r *localRoRelation) Write(_ uint64, _ *batch.Batch) error {
 	panic("not supported")
 }
 
+// ErrReadOnly is returned by the mutations of the read-only relation.
+var ErrReadOnly = errors.New("local relation is read-only")
+
+func (r *localRoRelation) Delete(_ uint64, _ engine.RowFilter, _ *process.Process) (uint64, error) {
+	return 0, ErrReadOnly
+}
+
+func (r *localRoRelation) Update(_ uint64, _ engine.RowUpdate, _ *process.Process) (uint64, error) {
+	return 0, ErrReadOnly
+}
+
 func (r *localRoRelation) AddAttribute(_ uint64, _ engine.TableDef) error {
 	panic("not supported")
 }
@@ -82,6 +103,22 @@ func (r *localRoRelation) DelAttribute(_ uint64, _ engine.TableDef) error {
 	panic("not supported")
 }
 
+func (r *localRoRelation) RenameAttribute(_ uint64, _, _ string) error {
+	panic("not supported")
+}
+
+func (r *localRoRelation) Partition() *engine.PartitionBy {
+	return nil
+}
+
+func (r *localRoRelation) AddPartition(_ uint64, _ *engine.PartitionBy) error {
+	panic("not supported")
+}
+
+func (r *localRoRelation) DropPartition(_ uint64, _ string) error {
+	panic("not supported")
+}
+
 func (r *localRoRelation) CreateIndex(_ uint64, _ []engine.TableDef) error {
 	panic("not supported")
 }
@@ -18,6 +18,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/dates"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/datetimes"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/decimal128s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/decimal64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/float32s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/float64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/int16s"
@@ -31,7 +35,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/varchar"
 )
 
-func SortBlockColumns(cols []*vector.Vector,pk int) error {
+// SortBlockColumns sorts the columns of a block by the column pk, and returns
+// the sorted positions: the i-th row of the sorted block is the sortedIdx[i]-th
+// row of the original block.
+func SortBlockColumns(cols []*vector.Vector,pk int) ([]uint32, error) {
 	sortedIdx := make([]uint32, cols[pk].Length())
 
 	switch cols[pk].Typ.Oid {
@@ -55,6 +62,14 @@ func SortBlockColumns(cols []*vector.Vector,pk int) error {
 		float32s.Sort(cols[pk], sortedIdx)
 	case types.T_float64:
 		float64s.Sort(cols[pk], sortedIdx)
+	case types.T_decimal64:
+		decimal64s.Sort(cols[pk], sortedIdx)
+	case types.T_decimal128:
+		decimal128s.Sort(cols[pk], sortedIdx)
+	case types.T_date:
+		dates.Sort(cols[pk], sortedIdx)
+	case types.T_datetime:
+		datetimes.Sort(cols[pk], sortedIdx)
 	case types.T_char, types.T_json, types.T_varchar:
 		varchar.Sort(cols[pk], sortedIdx)
 	}
@@ -84,15 +99,26 @@ func SortBlockColumns(cols []*vector.Vector,pk int) error {
 			float32s.Shuffle(cols[i], sortedIdx)
 		case types.T_float64:
 			float64s.Shuffle(cols[i], sortedIdx)
+		case types.T_decimal64:
+			decimal64s.Shuffle(cols[i], sortedIdx)
+		case types.T_decimal128:
+			decimal128s.Shuffle(cols[i], sortedIdx)
+		case types.T_date:
+			dates.Shuffle(cols[i], sortedIdx)
+		case types.T_datetime:
+			datetimes.Shuffle(cols[i], sortedIdx)
 		case types.T_char, types.T_json, types.T_varchar:
 			varchar.Shuffle(cols[i], sortedIdx)
 		}
 	}
 
-	return nil
+	return sortedIdx, nil
 }
 
-func MergeBlocksToSegment(blks []*batch.Batch) error {
+// MergeBlocksToSegment merges the sorted blocks of a segment, and returns
+// the source block of each merged row in order, rows of a source block are
+// consumed in their original order.
+func MergeBlocksToSegment(blks []*batch.Batch) ([]uint16, error) {
 	n := len(blks) * blks[0].Vecs[0].Length()
 	mergedSrc := make([]uint16, n)
 
@@ -122,6 +148,14 @@ func MergeBlocksToSegment(blks []*batch.Batch) error {
 		float32s.Merge(col, mergedSrc)
 	case types.T_float64:
 		float64s.Merge(col, mergedSrc)
+	case types.T_decimal64:
+		decimal64s.Merge(col, mergedSrc)
+	case types.T_decimal128:
+		decimal128s.Merge(col, mergedSrc)
+	case types.T_date:
+		dates.Merge(col, mergedSrc)
+	case types.T_datetime:
+		datetimes.Merge(col, mergedSrc)
 	case types.T_char, types.T_json, types.T_varchar:
 		varchar.Merge(col, mergedSrc)
 	}
@@ -152,10 +186,18 @@ func MergeBlocksToSegment(blks []*batch.Batch) error {
 			float32s.Multiplex(col, mergedSrc)
 		case types.T_float64:
 			float64s.Multiplex(col, mergedSrc)
+		case types.T_decimal64:
+			decimal64s.Multiplex(col, mergedSrc)
+		case types.T_decimal128:
+			decimal128s.Multiplex(col, mergedSrc)
+		case types.T_date:
+			dates.Multiplex(col, mergedSrc)
+		case types.T_datetime:
+			datetimes.Multiplex(col, mergedSrc)
 		case types.T_char, types.T_json, types.T_varchar:
 			varchar.Multiplex(col, mergedSrc)
 		}
 	}
 
-	return nil
-}
+	return mergedSrc, nil
+}
\ No newline at end of file
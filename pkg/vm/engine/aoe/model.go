@@ -62,6 +62,9 @@ type TableInfo struct {
 	State     SchemaState  `json:"state"`
 	Partition []byte       `json:"partition"`
 	Epoch     uint64       `json:"epoch"`
+	// NextColumnId is the id of the next column added to the table, the ids
+	// of the dropped columns are never reused.
+	NextColumnId uint64 `json:"next_column_id"`
 }
 
 type TabletInfo struct {
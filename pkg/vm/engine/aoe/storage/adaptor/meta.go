@@ -37,6 +37,7 @@ func MockTableInfo(colCnt int) *aoe.TableInfo {
 	for i := 0; i < colCnt; i++ {
 		name := fmt.Sprintf("%s%d", prefix, i)
 		colInfo := aoe.ColumnInfo{
+			Id:   uint64(i),
 			Name: name,
 		}
 		if i == 1 {
@@ -63,6 +64,13 @@ func TableInfoToSchema(catalog *metadata.Catalog, info *aoe.TableInfo) (*db.Tabl
 			Name: colInfo.Name,
 			Idx:  idx,
 			Type: colInfo.Type,
+			Id:   colInfo.Id,
+		}
+		if colInfo.Default.Exist {
+			newInfo.Default = &metadata.DefaultValue{IsNull: colInfo.Default.IsNull}
+			if !colInfo.Default.IsNull {
+				newInfo.Default.Value = fmt.Sprintf("%v", colInfo.Default.Value)
+			}
 		}
 		if colInfo.PrimaryKey {
 			schema.PrimaryKey = idx
@@ -76,8 +84,10 @@ func TableInfoToSchema(catalog *metadata.Catalog, info *aoe.TableInfo) (*db.Tabl
 	var err error
 	for _, indexInfo := range info.Indices {
 		cols = cols[:0]
+		// the columns of the index are the ids of the columns, which are not
+		// their positions once a column is dropped
 		for _, col := range indexInfo.Columns {
-			cols = append(cols, int(col))
+			cols = append(cols, schema.GetColIdxById(col))
 		}
 		if _, err = indice.MakeIndex(indexInfo.Name, metadata.IndexT(indexInfo.Type), cols...); err != nil {
 			panic(err)
@@ -79,11 +79,33 @@ type DropIndexCtx struct {
 	IndexNames []string
 }
 
+type AlterTableCtx struct {
+	DBMutationCtx
+	Table string
+	// Schema is the new schema of the table, the columns are identified
+	// by ColDef.Id across the versions of the schema
+	Schema *db.TableSchema
+}
+
 type AppendCtx struct {
 	TableMutationCtx
 	Data *batch.Batch
 }
 
+type DeleteCtx struct {
+	TableMutationCtx
+	// Attrs are the attributes the filter is evaluated over
+	Attrs  []string
+	Filter db.DeleteFilter
+}
+
+type UpdateCtx struct {
+	TableMutationCtx
+	// Filter is evaluated over all the columns of the table
+	Filter db.DeleteFilter
+	Update db.UpdateFunc
+}
+
 func (ctx *DBMutationCtx) ToLogIndex(database *metadata.Database) *db.LogIndex {
 	return &db.LogIndex{
 		ShardId: database.GetShardId(),
@@ -15,6 +15,7 @@
 package aoedb
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/util"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
@@ -258,21 +259,27 @@ func (d *DB) DropIndex(ctx *DropIndexCtx) error {
 	for _, segId := range segIds {
 		seg := tblData.StrongRefSegment(segId)
 		holder := seg.GetIndexHolder()
+		segSchema := seg.GetMeta().GetSchema()
 		for _, info := range meta.GetIndexSchema().Indice {
 			if !util.ContainsString(names, info.Name) {
 				continue
 			}
 			cols := info.Columns
-			for _, col := range cols {
+			for _, idx := range cols {
+				// the index files are named by the column of the segment schema
+				col := segSchema.GetColIdxById(meta.Schema.ColDefs[idx].Id)
+				if col == -1 {
+					continue
+				}
 				var currVersion uint64
 				holder.VersionAllocator.RLock()
-				if alloc, ok := holder.VersionAllocator.Allocators[int(col)]; ok {
+				if alloc, ok := holder.VersionAllocator.Allocators[col]; ok {
 					currVersion = alloc.Get()
 				} else {
 					currVersion = uint64(1)
 				}
 				holder.VersionAllocator.RUnlock()
-				bn := common.MakeBitSlicedIndexFileName(currVersion, tblId, segId, col)
+				bn := common.MakeBitSlicedIndexFileName(currVersion, tblId, segId, uint16(col))
 				fullname := filepath.Join(filepath.Join(d.Dir, "data"), bn)
 				holder.DropIndex(fullname)
 			}
@@ -287,6 +294,42 @@ func (d *DB) DropIndex(ctx *DropIndexCtx) error {
 	return nil
 }
 
+// AlterTable replaces the schema of the table with a new version. The data
+// written under the previous versions is not rewritten, the blocks are read
+// with the schema they are written under.
+func (d *DB) AlterTable(ctx *AlterTableCtx) error {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	database, err := d.Store.Catalog.SimpleGetDatabaseByName(ctx.DB)
+	if err != nil {
+		return err
+	}
+	index := ctx.ToLogIndex(database)
+	if err = d.Wal.SyncLog(index); err != nil {
+		return err
+	}
+	defer d.Wal.Checkpoint(index)
+
+	if database.InReplaying(index) {
+		if idx, ok := database.ConsumeIdempotentIndex(index); !ok {
+			err = db.ErrIdempotence
+			return err
+		} else if idx != nil {
+			if idx.IsApplied() {
+				err = db.ErrIdempotence
+				return err
+			}
+		}
+	}
+	meta := database.SimpleGetTableByName(ctx.Table)
+	if meta == nil {
+		err = metadata.TableNotFoundErr
+		return err
+	}
+	return meta.SimpleAlterSchema(ctx.Schema, index)
+}
+
 func (d *DB) Append(ctx *AppendCtx) (err error) {
 	if err := d.Closed.Load(); err != nil {
 		panic(err)
@@ -328,6 +371,86 @@ func (d *DB) Append(ctx *AppendCtx) (err error) {
 	return err
 }
 
+// Delete marks the rows of the table selected by the filter of ctx as
+// deleted, and returns the number of rows deleted.
+func (d *DB) Delete(ctx *DeleteCtx) (uint64, error) {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	database, err := d.Store.Catalog.SimpleGetDatabaseByName(ctx.DB)
+	if err != nil {
+		return 0, err
+	}
+	index := ctx.ToLogIndex(database)
+	if err = d.Wal.SyncLog(index); err != nil {
+		return 0, err
+	}
+	defer d.Wal.Checkpoint(index)
+
+	var meta *metadata.Table
+	if database.InReplaying(index) {
+		if meta, err = database.GetTableByNameAndLogIndex(ctx.Table, index); err != nil {
+			return 0, err
+		}
+		if meta.IsDeleted() {
+			return 0, metadata.TableNotFoundErr
+		}
+	} else if meta = database.SimpleGetTableByName(ctx.Table); meta == nil {
+		return 0, metadata.TableNotFoundErr
+	}
+	return d.DoDelete(meta, ctx.Attrs, ctx.Filter, index)
+}
+
+// Update replaces the rows of the table selected by the filter of ctx with
+// their new rows, and returns the number of rows updated. The rows are
+// deleted and the new rows are appended with the index of ctx.
+func (d *DB) Update(ctx *UpdateCtx) (cnt uint64, err error) {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	database, err := d.Store.Catalog.SimpleGetDatabaseByName(ctx.DB)
+	if err != nil {
+		return 0, err
+	}
+	index := ctx.ToLogIndex(database)
+	if err = d.Wal.SyncLog(index); err != nil {
+		return 0, err
+	}
+
+	var meta *metadata.Table
+	if database.InReplaying(index) {
+		if meta, err = database.GetTableByNameAndLogIndex(ctx.Table, index); err == nil && meta.IsDeleted() {
+			err = metadata.TableNotFoundErr
+		}
+	} else if meta = database.SimpleGetTableByName(ctx.Table); meta == nil {
+		err = metadata.TableNotFoundErr
+	}
+	var bat *batch.Batch
+	if err == nil {
+		bat, cnt, err = d.DoUpdate(meta, ctx.Filter, ctx.Update, index)
+	}
+	if bat == nil {
+		if err != metadata.IdempotenceErr {
+			d.Wal.Checkpoint(index)
+		}
+		return cnt, err
+	}
+	// the index is checkpointed when the new rows are flushed, the new rows
+	// returned again after a restart may have been appended already
+	index.Capacity = uint64(bat.Length())
+	if index, err = d.TableIdempotenceCheckAndIndexRewrite(meta, index); err != nil {
+		return cnt, err
+	}
+	defer func() {
+		if err != nil {
+			index.Count = index.Capacity - index.Start
+			d.Wal.Checkpoint(index)
+		}
+	}()
+	err = d.DoAppend(meta, bat, index.AsSlice())
+	return cnt, err
+}
+
 func (d *DB) CreateSnapshot(ctx *CreateSnapshotCtx) (uint64, error) {
 	return d.Impl.CreateSnapshot(ctx.DB, ctx.Path, ctx.Sync)
 }
@@ -30,6 +30,7 @@ import (
 	"time"
 
 	roaring "github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched"
@@ -1424,6 +1425,12 @@ func TestFilter(t *testing.T) {
 	assert.Equal(t, decodeBlockIds(res), []string{})
 	res, _ = sparseFilter.Ge("mock_0", int8(10))
 	assert.Equal(t, decodeBlockIds(res), []string{})
+	res, _ = sparseFilter.In("mock_0", []interface{}{int8(-1), int8(10)})
+	assert.Equal(t, decodeBlockIds(res), []string{})
+	res, _ = sparseFilter.In("mock_0", []interface{}{int8(-1), int8(3)})
+	assert.Equal(t, decodeBlockIds(res), []string{"1", "2", "3", "4"})
+	res, _ = sparseFilter.NotIn("mock_0", []interface{}{int8(3)})
+	assert.Equal(t, decodeBlockIds(res), []string{"1", "2", "3", "4"})
 
 	res, _ = sparseFilter.Eq("mock_1", int16(-1))
 	assert.Equal(t, decodeBlockIds(res), []string{})
@@ -1606,7 +1613,7 @@ func TestFilter(t *testing.T) {
 	res, _ = sparseFilter.Eq("mock_11", types.FromClock(1000, 1, 1, 1, 1, 1, 1))
 	assert.Equal(t, decodeBlockIds(res), []string{"1", "2", "3", "4"})
 	res, _ = sparseFilter.Ne("mock_11", types.FromClock(1000, 1, 1, 1, 1, 1, 1))
-	assert.Equal(t, decodeBlockIds(res), []string{})
+	assert.Equal(t, decodeBlockIds(res), []string{"1", "2", "3", "4"})
 	res, _ = sparseFilter.Btw("mock_11", types.FromClock(100, 1, 1, 1, 1, 1, 1), types.FromClock(1000, 1, 1, 1, 1, 1, 1))
 	assert.Equal(t, decodeBlockIds(res), []string{"1", "2", "3", "4"})
 
@@ -1695,6 +1702,18 @@ func TestFilter(t *testing.T) {
 	mockBM.AddRange(33, 39)
 	mockBM.Xor(res_)
 	assert.Equal(t, true, mockBM.IsEmpty())
+	res_, err = filter.In("mock_0", []interface{}{int8(-1), int8(3), int8(8)})
+	assert.Nil(t, err)
+	mockBM = roaring.NewBitmap()
+	for i := uint64(0); i < 40; i += 10 {
+		mockBM.Add(i + 3)
+		mockBM.Add(i + 8)
+	}
+	assert.True(t, mockBM.Equals(res_))
+	res_, err = filter.NotIn("mock_0", []interface{}{int8(3), int8(8)})
+	assert.Nil(t, err)
+	mockBM.Xor(res_)
+	assert.Equal(t, uint64(40), mockBM.GetCardinality())
 
 	mockBM = roaring.NewBitmap()
 	mockBM.AddRange(0, 40)
@@ -2879,3 +2898,407 @@ func TestRepeatCreateAndDropIndex(t *testing.T) {
 
 	inst.Close()
 }
+
+func TestDelete(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	// mock_0 is in descending order, so the rows are reordered when blocks are flushed
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows*5 + inst.Store.Catalog.Cfg.BlockMaxRows/2
+	bat := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	xs, ys := bat.Vecs[0].Col.([]int32), bat.Vecs[1].Col.([]int32)
+	for i := range xs {
+		xs[i], ys[i] = int32(int(rows)-1-i), int32(i)
+	}
+	appendCtx := CreateAppendCtx(database, gen, schema.Name, bat)
+	assert.Nil(t, inst.Append(appendCtx))
+
+	attrs := []string{"mock_0", "mock_1"}
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	check := func(inst *DB) int {
+		rel, err := inst.Relation(database.Name, schema.Name)
+		assert.Nil(t, err)
+		defer rel.Close()
+		cnt := 0
+		for _, segId := range rel.SegmentIds().Ids {
+			seg := rel.Segment(segId, proc)
+			for _, id := range seg.Blocks() {
+				cs := []uint64{1, 1}
+				compressed := []*bytes.Buffer{{}, {}}
+				deCompressed := []*bytes.Buffer{{}, {}}
+				b, err := seg.Block(id, proc).Read(cs, attrs, compressed, deCompressed)
+				assert.Nil(t, err)
+				xs, ys := b.Vecs[0].Col.([]int32), b.Vecs[1].Col.([]int32)
+				for i := range xs {
+					assert.NotEqual(t, int32(0), xs[i]%3)
+					assert.Equal(t, int32(rows)-1-xs[i], ys[i])
+				}
+				cnt += len(xs)
+
+				// read the rows at the even positions, the deleted ones are skipped
+				blk := seg.Block(id, proc).(*db.Block)
+				data := blk.Host.Data.StrongRefBlock(blk.Id)
+				deleted := blk.Host.Data.GetTombstones().Get(blk.Id, data.GetType())
+				var sels []int64
+				left := 0
+				for i := int64(0); i < int64(data.GetRowCount()); i += 2 {
+					sels = append(sels, i)
+					if deleted == nil || !deleted.Contains(uint32(i)) {
+						left++
+					}
+				}
+				data.Unref()
+				b, err = blk.ReadSels(sels, cs, attrs, compressed, deCompressed)
+				assert.Nil(t, err)
+				sxs, sys := b.Vecs[0].Col.([]int32), b.Vecs[1].Col.([]int32)
+				assert.Equal(t, left, len(sxs))
+				for i := range sxs {
+					assert.NotEqual(t, int32(0), sxs[i]%3)
+					assert.Equal(t, int32(rows)-1-sxs[i], sys[i])
+				}
+			}
+		}
+		return cnt
+	}
+
+	filter := func(bat *batch.Batch) ([]int64, error) {
+		var sels []int64
+		for i, x := range bat.Vecs[0].Col.([]int32) {
+			if x%3 == 0 {
+				sels = append(sels, int64(i))
+			}
+		}
+		return sels, nil
+	}
+	deleteCtx := &DeleteCtx{
+		TableMutationCtx: *CreateTableMutationCtx(database, gen, schema.Name),
+		Attrs:            attrs[:1],
+		Filter:           filter,
+	}
+	deleted, err := inst.Delete(deleteCtx)
+	assert.Nil(t, err)
+	assert.Equal(t, (rows+2)/3, deleted)
+	assert.Equal(t, int(rows-deleted), check(inst))
+
+	_, err = inst.Delete(deleteCtx)
+	assert.Equal(t, metadata.IdempotenceErr, err)
+
+	err = inst.FlushTable(database.Name, schema.Name)
+	assert.Nil(t, err)
+	testutils.WaitExpect(400, func() bool {
+		return gen.Get(database.GetShardId()) == database.GetCheckpointId()
+	})
+	assert.Equal(t, gen.Get(database.GetShardId()), database.GetCheckpointId())
+	assert.Equal(t, int(rows-deleted), check(inst))
+	inst.Close()
+
+	inst, _, _ = initTestDB1(t)
+	defer inst.Close()
+	assert.Equal(t, int(rows-deleted), check(inst))
+	_, err = inst.Delete(deleteCtx)
+	assert.Equal(t, metadata.IdempotenceErr, err)
+}
+
+func TestUpdate(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows*3 + inst.Store.Catalog.Cfg.BlockMaxRows/2
+	bat := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	xs, ys := bat.Vecs[0].Col.([]int32), bat.Vecs[1].Col.([]int32)
+	for i := range xs {
+		xs[i], ys[i] = int32(int(rows)-1-i), int32(i)
+	}
+	appendCtx := CreateAppendCtx(database, gen, schema.Name, bat)
+	assert.Nil(t, inst.Append(appendCtx))
+	err = inst.FlushTable(database.Name, schema.Name)
+	assert.Nil(t, err)
+	testutils.WaitExpect(400, func() bool {
+		return gen.Get(database.GetShardId()) == database.GetCheckpointId()
+	})
+	assert.Equal(t, gen.Get(database.GetShardId()), database.GetCheckpointId())
+
+	attrs := []string{"mock_0", "mock_1"}
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	check := func(inst *DB) int {
+		rel, err := inst.Relation(database.Name, schema.Name)
+		assert.Nil(t, err)
+		defer rel.Close()
+		cnt, updated := 0, 0
+		for _, segId := range rel.SegmentIds().Ids {
+			seg := rel.Segment(segId, proc)
+			for _, id := range seg.Blocks() {
+				cs := []uint64{1, 1}
+				compressed := []*bytes.Buffer{{}, {}}
+				deCompressed := []*bytes.Buffer{{}, {}}
+				b, err := seg.Block(id, proc).Read(cs, attrs, compressed, deCompressed)
+				assert.Nil(t, err)
+				xs, ys := b.Vecs[0].Col.([]int32), b.Vecs[1].Col.([]int32)
+				for i := range xs {
+					if xs[i]%3 == 0 {
+						assert.Equal(t, int32(-1), ys[i])
+						updated++
+					} else {
+						assert.Equal(t, int32(rows)-1-xs[i], ys[i])
+					}
+				}
+				cnt += len(xs)
+			}
+		}
+		assert.Equal(t, int((rows+2)/3), updated)
+		return cnt
+	}
+
+	filter := func(bat *batch.Batch) ([]int64, error) {
+		var sels []int64
+		for i, x := range bat.GetVector("mock_0").Col.([]int32) {
+			if x%3 == 0 {
+				sels = append(sels, int64(i))
+			}
+		}
+		return sels, nil
+	}
+	update := func(bat *batch.Batch, sels []int64) (*batch.Batch, error) {
+		xs := bat.GetVector("mock_0").Col.([]int32)
+		rbat := mock.MockBatch(tblMeta.Schema.Types(), uint64(len(sels)))
+		rxs, rys := rbat.Vecs[0].Col.([]int32), rbat.Vecs[1].Col.([]int32)
+		for i, sel := range sels {
+			rxs[i], rys[i] = xs[sel], -1
+		}
+		return rbat, nil
+	}
+	updateCtx := &UpdateCtx{
+		TableMutationCtx: *CreateTableMutationCtx(database, gen, schema.Name),
+		Filter:           filter,
+		Update:           update,
+	}
+	updated, err := inst.Update(updateCtx)
+	assert.Nil(t, err)
+	assert.Equal(t, (rows+2)/3, updated)
+	assert.Equal(t, int(rows), check(inst))
+
+	_, err = inst.Update(updateCtx)
+	assert.Equal(t, metadata.IdempotenceErr, err)
+	inst.Close()
+
+	// the new rows are not flushed, they are appended again when the
+	// update is replayed
+	inst, _, _ = initTestDB1(t)
+	_, err = inst.Update(updateCtx)
+	assert.True(t, err == nil || err == metadata.IdempotenceErr)
+	assert.Equal(t, int(rows), check(inst))
+	_, err = inst.Update(updateCtx)
+	assert.Equal(t, metadata.IdempotenceErr, err)
+
+	err = inst.FlushTable(database.Name, schema.Name)
+	assert.Nil(t, err)
+	testutils.WaitExpect(400, func() bool {
+		return updateCtx.Id == database.GetCheckpointId()
+	})
+	inst.Close()
+
+	inst, _, _ = initTestDB1(t)
+	defer inst.Close()
+	assert.Equal(t, int(rows), check(inst))
+	_, err = inst.Update(updateCtx)
+	assert.Equal(t, metadata.IdempotenceErr, err)
+}
+
+func TestAlterTable(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows*2 + inst.Store.Catalog.Cfg.BlockMaxRows/2
+	bat := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	xs := bat.Vecs[0].Col.([]int32)
+	for i := range xs {
+		xs[i] = int32(i)
+	}
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, bat)))
+
+	// rename mock_0 to a, drop mock_1 and add b with the default value 7
+	altered := metadata.NewEmptySchema(schema.Name)
+	typ := schema.ColDefs[0].Type
+	altered.AppendCol("a", typ)
+	altered.AppendCol("b", typ)
+	altered.ColDefs[1].Id = 2
+	altered.ColDefs[1].Default = &metadata.DefaultValue{Value: "7"}
+	alterCtx := &AlterTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Table:         schema.Name,
+		Schema:        altered,
+	}
+	assert.Nil(t, inst.AlterTable(alterCtx))
+	assert.Equal(t, uint32(1), tblMeta.Schema.Version)
+
+	bat = mock.MockBatch(tblMeta.Schema.Types(), rows)
+	bat.Attrs = []string{"a", "b"}
+	xs, ys := bat.Vecs[0].Col.([]int32), bat.Vecs[1].Col.([]int32)
+	for i := range xs {
+		xs[i], ys[i] = int32(int(rows)+i), 8
+	}
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, bat)))
+
+	attrs := []string{"a", "b"}
+	hm := host.New(1 << 40)
+	gm := guest.New(1<<40, hm)
+	proc := process.New(gm)
+	check := func(inst *DB) {
+		rel, err := inst.Relation(database.Name, schema.Name)
+		assert.Nil(t, err)
+		defer rel.Close()
+		cnt := 0
+		for _, segId := range rel.SegmentIds().Ids {
+			seg := rel.Segment(segId, proc)
+			for _, id := range seg.Blocks() {
+				cs := []uint64{1, 1}
+				compressed := []*bytes.Buffer{{}, {}}
+				deCompressed := []*bytes.Buffer{{}, {}}
+				b, err := seg.Block(id, proc).Read(cs, attrs, compressed, deCompressed)
+				assert.Nil(t, err)
+				xs, ys := b.Vecs[0].Col.([]int32), b.Vecs[1].Col.([]int32)
+				assert.Equal(t, len(xs), len(ys))
+				for i := range xs {
+					if xs[i] < int32(rows) {
+						assert.Equal(t, int32(7), ys[i])
+					} else {
+						assert.Equal(t, int32(8), ys[i])
+					}
+				}
+				cnt += len(xs)
+				_, err = seg.Block(id, proc).Read(cs[:1], []string{"mock_1"}, compressed[:1], deCompressed[:1])
+				assert.NotNil(t, err)
+			}
+		}
+		assert.Equal(t, int(rows*2), cnt)
+	}
+	check(inst)
+
+	err = inst.FlushTable(database.Name, schema.Name)
+	assert.Nil(t, err)
+	testutils.WaitExpect(400, func() bool {
+		return gen.Get(database.GetShardId()) == database.GetCheckpointId()
+	})
+	assert.Equal(t, gen.Get(database.GetShardId()), database.GetCheckpointId())
+	check(inst)
+	inst.Close()
+
+	inst, _, _ = initTestDB1(t)
+	defer inst.Close()
+	tblMeta, err = inst.Store.Catalog.SimpleGetTableByName(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), tblMeta.Schema.Version)
+	assert.Equal(t, 1, len(tblMeta.History))
+	check(inst)
+}
+
+func TestStats(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	// two full segments and a half block
+	segRows := inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks
+	rows := segRows*2 + inst.Store.Catalog.Cfg.BlockMaxRows/2
+	bat := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	xs := bat.Vecs[0].Col.([]int32)
+	for i := range xs {
+		xs[i] = int32(i % 1000)
+	}
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, bat)))
+
+	// the statistics of the segments are collected when they are sealed
+	testutils.WaitExpect(400, func() bool {
+		cnt := 0
+		for _, segId := range tblMeta.SimpleGetSegmentIds() {
+			if tblMeta.SimpleGetSegment(segId).GetStats() != nil {
+				cnt++
+			}
+		}
+		return cnt == 2
+	})
+	check := func(inst *DB, expected uint64, max int64) {
+		rel, err := inst.Relation(database.Name, schema.Name)
+		assert.Nil(t, err)
+		defer rel.Close()
+		s := rel.ColumnStats("mock_0")
+		assert.NotNil(t, s)
+		assert.Equal(t, int64(expected), s.Rows)
+		assert.Equal(t, int64(0), s.NullCount)
+		assert.Equal(t, int64(0), s.Min.Int)
+		assert.Equal(t, max, s.Max.Int)
+		ndv := s.NDV()
+		assert.True(t, ndv > (max+1)*9/10 && ndv <= max+1, ndv)
+		assert.Nil(t, rel.ColumnStats("xxx"))
+	}
+	check(inst, segRows*2, 999)
+
+	// ANALYZE collects the statistics of the unsealed segment as well and
+	// excludes the deleted rows
+	deleteCtx := &DeleteCtx{
+		TableMutationCtx: *CreateTableMutationCtx(database, gen, schema.Name),
+		Attrs:            []string{"mock_0"},
+		Filter: func(bat *batch.Batch) ([]int64, error) {
+			var sels []int64
+			for i, x := range bat.Vecs[0].Col.([]int32) {
+				if x >= 500 {
+					sels = append(sels, int64(i))
+				}
+			}
+			return sels, nil
+		},
+	}
+	_, err = inst.Delete(deleteCtx)
+	assert.Nil(t, err)
+	left := func(n uint64) uint64 {
+		cnt := uint64(0)
+		for _, x := range xs[:n] {
+			if x < 500 {
+				cnt++
+			}
+		}
+		return cnt
+	}
+	rel, err := inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Analyze())
+	rel.Close()
+	check(inst, left(rows), 499)
+	inst.Close()
+
+	// the analyzed statistics of the sealed segments are persisted
+	inst, _, _ = initTestDB1(t)
+	defer inst.Close()
+	check(inst, left(segRows*2), 499)
+}
@@ -15,6 +15,7 @@
 package aoedb
 
 import (
+	"bytes"
 	"sync"
 	"sync/atomic"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/dbi"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
 	md "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
 	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -58,6 +60,103 @@ func (r *Relation) Size(attr string) int64 {
 	return int64(r.Data.Size(attr))
 }
 
+// ColumnStats returns the statistics of the column merged from all the
+// segments whose statistics are collected.
+func (r *Relation) ColumnStats(attr string) *stats.ColumnStats {
+	schema := r.Data.GetMeta().Schema
+	idx := schema.GetColIdx(attr)
+	if idx == -1 {
+		return nil
+	}
+	id := schema.ColDefs[idx].Id
+	var ss []*stats.ColumnStats
+	for _, segId := range r.Data.SegmentIds() {
+		seg := r.Data.StrongRefSegment(segId)
+		if seg == nil {
+			continue
+		}
+		if m := seg.GetMeta().GetStats(); m != nil {
+			ss = append(ss, m[id])
+		}
+		seg.Unref()
+	}
+	return stats.Merge(ss...)
+}
+
+// Stats returns the number of rows of the table and the sizes and the
+// statistics of all its columns.
+func (r *Relation) Stats() dbi.TableStats {
+	colDefs := r.Data.GetMeta().Schema.ColDefs
+	s := dbi.TableStats{
+		Rows:    r.Rows(),
+		Sizes:   make(map[string]int64, len(colDefs)),
+		Columns: make(map[string]*stats.ColumnStats, len(colDefs)),
+	}
+	for _, colDef := range colDefs {
+		s.Sizes[colDef.Name] = r.Size(colDef.Name)
+		if cs := r.ColumnStats(colDef.Name); cs != nil {
+			s.Columns[colDef.Name] = cs
+		}
+	}
+	return s
+}
+
+// Analyze recollects the statistics of all the segments from their data,
+// the deleted rows are excluded. The statistics of the sealed segments are
+// persisted right away, the others when the segments are sealed.
+func (r *Relation) Analyze() error {
+	colDefs := r.Data.GetMeta().Schema.ColDefs
+	attrs := make([]string, len(colDefs))
+	cs := make([]uint64, len(colDefs))
+	compressed := make([]*bytes.Buffer, len(colDefs))
+	deCompressed := make([]*bytes.Buffer, len(colDefs))
+	for i, colDef := range colDefs {
+		attrs[i] = colDef.Name
+		cs[i] = 1
+		compressed[i] = bytes.NewBuffer(make([]byte, 0, 1024))
+		deCompressed[i] = bytes.NewBuffer(make([]byte, 0, 1024))
+	}
+	proc := r.DBImpl.NewProcess()
+	for _, segId := range r.Data.SegmentIds() {
+		data := r.Data.StrongRefSegment(segId)
+		if data == nil {
+			continue
+		}
+		seg := &db.Segment{
+			Ids:  new(atomic.Value),
+			Data: data,
+		}
+		collectors := make([]*stats.Collector, len(colDefs))
+		for i := range collectors {
+			collectors[i] = stats.NewCollector()
+		}
+		for _, blkId := range seg.Blocks() {
+			blk := seg.Block(blkId, proc)
+			if blk == nil {
+				continue
+			}
+			bat, err := blk.Read(cs, attrs, compressed, deCompressed)
+			if err != nil {
+				data.Unref()
+				return err
+			}
+			for i, vec := range bat.Vecs {
+				collectors[i].Collect(vec)
+			}
+		}
+		m := make(map[uint64]*stats.ColumnStats, len(colDefs))
+		for i, colDef := range colDefs {
+			m[colDef.Id] = collectors[i].Stats()
+		}
+		err := data.GetMeta().SimpleUpdateStats(m)
+		data.Unref()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *Relation) ID() string {
 	return r.Meta.Schema.Name
 }
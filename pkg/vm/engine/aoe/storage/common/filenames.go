@@ -30,6 +30,7 @@ const (
 	FTBlock
 	FTSegment
 	FTTransientNode
+	FTTombstone
 )
 
 const (
@@ -40,6 +41,7 @@ const (
 	LockSuffix = ".lock"
 	NodeSuffix = ".nod"
 	BSISuffix = ".bsi"
+	TombstoneSuffix = ".del"
 
 	SpillDirName = "spill"
 	TempDirName  = "temp"
@@ -77,6 +79,10 @@ func MakeSegmentFileName(dirname, name string, tableId uint64, isTmp bool) strin
 	return MakeFilename(dir, FTSegment, name, isTmp)
 }
 
+func MakeTombstoneFileName(dirname string, tableId uint64, isTmp bool) string {
+	return MakeFilename(dirname, FTTombstone, strconv.FormatUint(tableId, 10), isTmp)
+}
+
 func MakeLockFileName(dirname, name string) string {
 	return MakeFilename(dirname, FTLock, name, false)
 }
@@ -117,6 +123,18 @@ func ParseBitSlicedIndexFileName(filename string) (name string, ok bool) {
 	return name, true
 }
 
+func ParseTombstoneFileName(filename string) (tableId uint64, ok bool) {
+	name := strings.TrimSuffix(filename, TombstoneSuffix)
+	if len(name) == len(filename) {
+		return 0, false
+	}
+	tableId, err := strconv.ParseUint(name, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return tableId, true
+}
+
 func ParseBitSlicedIndexFileNameToInfo(filename string) (version, tblId, segId uint64, colIdx uint16, ok bool) {
 	filename = strings.Trim(filename, BSISuffix)
 	infos := strings.Split(filename, "_")
@@ -155,6 +173,8 @@ func MakeFilename(dirname string, ft FileT, name string, isTmp bool) string {
 		s = path.Join(MakeDataDir(dirname), fmt.Sprintf("%s%s", name, BlkSuffix))
 	case FTSegment:
 		s = path.Join(MakeDataDir(dirname), fmt.Sprintf("%s%s", name, SegSuffix))
+	case FTTombstone:
+		s = path.Join(MakeDataDir(dirname), fmt.Sprintf("%s%s", name, TombstoneSuffix))
 	default:
 		panic(fmt.Sprintf("unsupported %d", ft))
 	}
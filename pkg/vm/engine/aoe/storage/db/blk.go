@@ -21,6 +21,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+
+	"github.com/RoaringBitmap/roaring"
 )
 
 // Block is a high-level wrapper of the block type in memory. It
@@ -32,6 +36,8 @@ type Block struct {
 	Id    uint64
 	// string representation of block id
 	StrId string
+	// process used to remove the deleted rows
+	Proc  *process.Process
 }
 
 // Rows returns how many rows this block contains currently.
@@ -43,7 +49,8 @@ func (blk *Block) Rows() int64 {
 		return -1
 	}
 	defer data.Unref()
-	return int64(data.GetRowCount())
+	deleted := blk.Host.Data.GetTombstones().Count(blk.Id, data.GetType())
+	return int64(data.GetRowCount() - deleted)
 }
 
 // Size returns the memory usage of the certain column in a block.
@@ -102,6 +109,83 @@ func (blk *Block) Read(cs []uint64, attrs []string, compressed []*bytes.Buffer,
 		vec.Ref = cs[i]
 		bat.Vecs[i] = vec
 	}
+	if len(attrs) == 0 {
+		return bat, nil
+	}
+	deleted := blk.Host.Data.GetTombstones().Get(blk.Id, data.GetType())
+	if deleted == nil {
+		return bat, nil
+	}
+	return blk.removeDeleted(bat, deleted)
+}
+
+// ReadSels reads the given columns like Read, but only the rows at the
+// positions of sels which are not deleted are kept.
+func (blk *Block) ReadSels(sels []int64, cs []uint64, attrs []string, compressed []*bytes.Buffer, deCompressed []*bytes.Buffer) (*batch.Batch, error) {
+	data := blk.Host.Data.StrongRefBlock(blk.Id)
+	if data == nil {
+		return nil, errors.New(fmt.Sprintf("specified blk %d not found", blk.Id))
+	}
+	defer data.Unref()
+	if deleted := blk.Host.Data.GetTombstones().Get(blk.Id, data.GetType()); deleted != nil {
+		xs := make([]int64, 0, len(sels))
+		for _, sel := range sels {
+			if !deleted.Contains(uint32(sel)) {
+				xs = append(xs, sel)
+			}
+		}
+		sels = xs
+	}
+	proc, err := blk.process()
+	if err != nil {
+		return nil, err
+	}
+	bat := batch.New(true, attrs)
+	bat.Vecs = make([]*vector.Vector, len(attrs))
+	for i, attr := range attrs {
+		vec, err := data.GetVectorCopy(attr, compressed[i], deCompressed[i])
+		if err != nil {
+			return nil, err
+		}
+		if vec, err = vec.Shuffle(sels, proc); err != nil {
+			return nil, err
+		}
+		vec.Ref = cs[i]
+		bat.Vecs[i] = vec
+	}
+	return bat, nil
+}
+
+// removeDeleted removes the deleted rows from the batch read.
+func (blk *Block) removeDeleted(bat *batch.Batch, deleted *roaring.Bitmap) (*batch.Batch, error) {
+	proc, err := blk.process()
+	if err != nil {
+		return nil, err
+	}
+	n := bat.Vecs[0].Length()
+	sels := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		if !deleted.Contains(uint32(i)) {
+			sels = append(sels, int64(i))
+		}
+	}
+	for i, vec := range bat.Vecs {
+		if bat.Vecs[i], err = vec.Shuffle(sels, proc); err != nil {
+			return nil, err
+		}
+	}
 	return bat, nil
 }
 
+// process returns the process used to select the rows of the block.
+func (blk *Block) process() (*process.Process, error) {
+	proc := blk.Proc
+	if proc == nil {
+		return nil, errors.New(fmt.Sprintf("blk %d is read without process", blk.Id))
+	}
+	if proc.Mp == nil {
+		proc = process.NewFromProc(proc)
+		proc.Mp = mempool.New()
+	}
+	return proc, nil
+}
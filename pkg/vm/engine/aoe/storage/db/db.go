@@ -35,6 +35,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/sched"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
 	wb "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/worker/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 type DB struct {
@@ -80,6 +84,16 @@ func (d *DB) GetTempDir() string {
 	return common.MakeTempDir(d.Dir)
 }
 
+// NewProcess returns a process for the computation done by the DB itself,
+// such as the evaluation of the filter of a delete. Its memory is bounded
+// by the capacity of the data cache.
+func (d *DB) NewProcess() *process.Process {
+	limit := int64(d.Opts.CacheCfg.DataCapacity)
+	proc := process.New(guest.New(limit, host.New(limit)))
+	proc.Mp = mempool.New()
+	return proc
+}
+
 // FIXME: start txn should not accept log index. For create database, the index
 // is comfirmed until then end
 func (d *DB) StartTxn(index *metadata.LogIndex) *TxnCtx {
@@ -65,6 +65,7 @@ func (req *dropTblRequest) Execute() error {
 	} else {
 		e.Data.Unref()
 	}
+	table.RemoveTombstones(req.Meta.Database.Catalog.Cfg.Dir, req.Meta.Id)
 	if err = req.Meta.Database.SimpleHardDeleteTable(req.Meta.Id); err != nil {
 		panic(err)
 	}
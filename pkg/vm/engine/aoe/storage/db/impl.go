@@ -15,11 +15,20 @@
 package db
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/gcreqs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
 	tiface "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
@@ -119,6 +128,270 @@ func (d *DB) DoAppend(meta *metadata.Table, data *batch.Batch, index *shard.Slic
 	return handle.Append(data, index)
 }
 
+// DoDelete marks the rows of the table selected by filter as deleted, the
+// filter is evaluated over the attrs of each block, or over the first
+// column if attrs is empty. It returns the number of rows deleted.
+func (d *DB) DoDelete(meta *metadata.Table, attrs []string, filter DeleteFilter, index *LogIndex) (uint64, error) {
+	if len(attrs) == 0 {
+		attrs = []string{meta.Schema.ColDefs[0].Name}
+	}
+	data, err := d.GetTableData(meta)
+	if err != nil {
+		return 0, err
+	}
+	defer data.Unref()
+	tombstones := data.GetTombstones()
+	if tombstones.Applied(&index.Id) {
+		logutil.Infof("Table %s | %s | Stale Delete", meta.Repr(false), index.String())
+		return 0, metadata.IdempotenceErr
+	}
+	selected, err := selectRows(data, attrs, filter, nil)
+	if err != nil {
+		return 0, err
+	}
+	cnt, err := deleteSelectedRows(data, selected, attrs, filter, nil)
+	if err != nil {
+		return 0, err
+	}
+	if err = tombstones.Persist(&index.Id); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
+
+// DoUpdate marks the rows of the table selected by filter as deleted, and
+// returns the new rows of them computed by update, which are to be appended
+// with index. The new rows are persisted together with the deleted rows, so
+// they are returned again when the update is replayed after a restart, until
+// the append of them is durable. It returns nil rows if no row is updated.
+func (d *DB) DoUpdate(meta *metadata.Table, filter DeleteFilter, update UpdateFunc, index *LogIndex) (*batch.Batch, uint64, error) {
+	data, err := d.GetTableData(meta)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer data.Unref()
+	tombstones := data.GetTombstones()
+	if tombstones.Applied(&index.Id) {
+		// the new rows of an update checkpointed are never appended again
+		rows := tombstones.Pending(&index.Id)
+		if rows == nil || index.Id.Id <= d.Wal.GetShardCheckpointId(index.ShardId) {
+			logutil.Infof("Table %s | %s | Stale Update", meta.Repr(false), index.String())
+			return nil, 0, metadata.IdempotenceErr
+		}
+		logutil.Infof("Table %s | %s | Redo Update Append", meta.Repr(false), index.String())
+		bat, err := decodeRows(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		return bat, uint64(bat.Length()), nil
+	}
+	attrs := make([]string, len(meta.Schema.ColDefs))
+	for i, colDef := range meta.Schema.ColDefs {
+		attrs[i] = colDef.Name
+	}
+	selected, err := selectRows(data, attrs, filter, update)
+	if err != nil {
+		return nil, 0, err
+	}
+	cnt, err := deleteSelectedRows(data, selected, attrs, filter, update)
+	if err != nil {
+		return nil, 0, err
+	}
+	if cnt == 0 {
+		return nil, 0, tombstones.Persist(&index.Id)
+	}
+	bat := batch.New(true, attrs)
+	for i := range bat.Vecs {
+		bat.Vecs[i] = vector.New(meta.Schema.ColDefs[i].Type)
+	}
+	for _, br := range selected {
+		if err = appendRows(bat, br.bat); err != nil {
+			return nil, 0, err
+		}
+	}
+	rows, err := encodeRows(bat)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err = tombstones.PersistUpdate(&index.Id, rows, d.Wal.GetShardCheckpointId(index.ShardId)); err != nil {
+		return nil, 0, err
+	}
+	return bat, cnt, nil
+}
+
+// blockRows is the rows of a block selected by a mutation, and the new rows
+// of them if the mutation is an update.
+type blockRows struct {
+	segId, blkId uint64
+	typ          base.BlockType
+	rows         []uint32
+	bat          *batch.Batch
+}
+
+// selectRows selects the rows of all the blocks of the table, all the rows
+// are selected before any of them is deleted.
+func selectRows(data tiface.ITableData, attrs []string, filter DeleteFilter, update UpdateFunc) ([]*blockRows, error) {
+	var selected []*blockRows
+	for _, segId := range data.SegmentIds() {
+		seg := data.WeakRefSegment(segId)
+		if seg == nil {
+			continue
+		}
+		for _, blkId := range seg.BlockIds() {
+			br, err := selectBlockRows(data, segId, blkId, attrs, filter, update)
+			if err != nil {
+				return nil, err
+			}
+			if br != nil {
+				selected = append(selected, br)
+			}
+		}
+	}
+	return selected, nil
+}
+
+// selectBlockRows selects the rows of a block which are not deleted yet, it
+// returns nil if no row is selected.
+func selectBlockRows(data tiface.ITableData, segId, blkId uint64, attrs []string, filter DeleteFilter, update UpdateFunc) (*blockRows, error) {
+	blk := data.StrongRefBlock(segId, blkId)
+	if blk == nil {
+		return nil, nil
+	}
+	typ := blk.GetType()
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		vec, err := blk.GetVectorCopy(attr, &bytes.Buffer{}, &bytes.Buffer{})
+		if err != nil {
+			blk.Unref()
+			return nil, err
+		}
+		bat.Vecs[i] = vec
+	}
+	blk.Unref()
+	sels, err := filter(bat)
+	if err != nil {
+		return nil, err
+	}
+	if deleted := data.GetTombstones().Get(blkId, typ); deleted != nil {
+		rest := sels[:0]
+		for _, sel := range sels {
+			if !deleted.Contains(uint32(sel)) {
+				rest = append(rest, sel)
+			}
+		}
+		sels = rest
+	}
+	if len(sels) == 0 {
+		return nil, nil
+	}
+	br := &blockRows{segId: segId, blkId: blkId, typ: typ, rows: make([]uint32, len(sels))}
+	for i, sel := range sels {
+		br.rows[i] = uint32(sel)
+	}
+	if update != nil {
+		if br.bat, err = update(bat, sels); err != nil {
+			return nil, err
+		}
+	}
+	return br, nil
+}
+
+// deleteSelectedRows marks the selected rows as deleted and returns the
+// number of them, the rows of a block upgraded since they were selected
+// are selected again.
+func deleteSelectedRows(data tiface.ITableData, selected []*blockRows, attrs []string, filter DeleteFilter, update UpdateFunc) (uint64, error) {
+	var cnt uint64
+	for i := 0; i < len(selected); i++ {
+		br := selected[i]
+		n, err := data.GetTombstones().Delete(br.blkId, br.typ, br.rows)
+		if err == nil {
+			cnt += n
+			continue
+		}
+		if err != table.StaleTombstoneErr {
+			return 0, err
+		}
+		runtime.Gosched()
+		if br, err = selectBlockRows(data, br.segId, br.blkId, attrs, filter, update); err != nil {
+			return 0, err
+		}
+		if br == nil {
+			selected = append(selected[:i], selected[i+1:]...)
+		} else {
+			selected[i] = br
+		}
+		i--
+	}
+	return cnt, nil
+}
+
+// appendRows appends the rows of src to the columns of dst with the same
+// names.
+func appendRows(dst, src *batch.Batch) error {
+	for i, attr := range dst.Attrs {
+		vec, rvec := dst.Vecs[i], src.GetVector(attr)
+		if rvec == nil {
+			return errors.New(fmt.Sprintf("column %s of the new rows not found", attr))
+		}
+		n := uint64(vec.Length())
+		col := rvec.Col
+		if bs, ok := col.(*types.Bytes); ok {
+			vs := make([][]byte, len(bs.Offsets))
+			for j := range vs {
+				vs[j] = bs.Get(int64(j))
+			}
+			col = vs
+		}
+		if err := vec.Append(col); err != nil {
+			return err
+		}
+		if rvec.Nsp.Np != nil {
+			itr := rvec.Nsp.Np.Iterator()
+			for itr.HasNext() {
+				vec.Nsp.Add(n + itr.Next())
+			}
+		}
+	}
+	return nil
+}
+
+// encodeRows encodes the rows of bat column by column.
+func encodeRows(bat *batch.Batch) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(encoding.EncodeUint32(uint32(len(bat.Vecs))))
+	for i, vec := range bat.Vecs {
+		data, err := vec.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(bat.Attrs[i]))))
+		buf.WriteString(bat.Attrs[i])
+		buf.Write(encoding.EncodeUint32(uint32(len(data))))
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeRows decodes the rows encoded by encodeRows.
+func decodeRows(data []byte) (*batch.Batch, error) {
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	bat := batch.New(true, make([]string, n))
+	for i := range bat.Vecs {
+		size := encoding.DecodeUint32(data[:4])
+		bat.Attrs[i] = string(data[4 : 4+size])
+		data = data[4+size:]
+		size = encoding.DecodeUint32(data[:4])
+		vdata := data[4 : 4+size]
+		data = data[4+size:]
+		bat.Vecs[i] = vector.New(encoding.DecodeType(vdata[:encoding.TypeSize]))
+		if err := bat.Vecs[i].Read(vdata); err != nil {
+			return nil, err
+		}
+	}
+	return bat, nil
+}
+
 func (d *DB) MakeMutationHandle(meta *metadata.Table) (iface.MutationHandle, error) {
 	handle, err := d.Store.DataTables.MakeTableMutationHandle(meta.Id)
 	if err != nil {
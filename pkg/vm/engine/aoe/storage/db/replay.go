@@ -389,6 +389,7 @@ type replayHandle struct {
 	compactdbs []*metadata.Database
 	observer   IReplayObserver
 	cbs        []func() error
+	tombstones map[uint64]string
 }
 
 func NewReplayHandle(workDir string, catalog *metadata.Catalog, tables *table.Tables, observer IReplayObserver) *replayHandle {
@@ -405,6 +406,7 @@ func NewReplayHandle(workDir string, catalog *metadata.Catalog, tables *table.Ta
 		compactdbs: make([]*metadata.Database, 0),
 		observer:   observer,
 		cbs:        make([]func() error, 0),
+		tombstones: make(map[uint64]string),
 	}
 	empty := false
 	var err error
@@ -594,6 +596,10 @@ func (h *replayHandle) addDataFile(fname string) {
 	if _, ok := common.ParseBitSlicedIndexFileName(fname); ok {
 		return
 	}
+	if tid, ok := common.ParseTombstoneFileName(fname); ok {
+		h.tombstones[tid] = path.Join(h.dataDir, fname)
+		return
+	}
 	h.others = append(h.others, path.Join(h.dataDir, fname))
 }
 
@@ -629,6 +635,10 @@ func (h *replayHandle) addIndexFile(fname string) {
 
 func (h *replayHandle) rebuildTable(meta *metadata.Table) error {
 	var err error
+	if !meta.IsDeleted() {
+		// The tombstones are loaded with the table data
+		delete(h.tombstones, meta.Id)
+	}
 	tablesFiles, ok := h.files[meta.Id]
 	if !ok {
 		// No need to change the table metadata if there is no table files
@@ -781,6 +791,9 @@ func (h *replayHandle) Replay() error {
 			h.compactdbs = append(h.compactdbs, database)
 		}
 	}
+	for _, name := range h.tombstones {
+		h.others = append(h.others, name)
+	}
 	h.Cleanup()
 	logutil.Infof(h.String())
 	return nil
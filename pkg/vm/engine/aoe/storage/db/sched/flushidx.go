@@ -54,16 +54,23 @@ func (e *flushIndexEvent) Execute() error {
 	dir := e.Segment.GetSegmentFile().GetDir()
 	bsiEnabled := make([]int, 0)
 	indice := meta.Table.GetIndexSchema()
+	// The columns of the indice are of the current table schema, they are
+	// mapped to the schema the segment is written under
+	schema, segSchema := meta.Table.Schema, meta.GetSchema()
 	for _, idx := range indice.Indice {
 		if idx.Type == metadata.NumBsi || idx.Type == metadata.FixStrBsi {
 			for _, col := range idx.Columns {
+				colIdx := segSchema.GetColIdxById(schema.ColDefs[col].Id)
+				if colIdx == -1 {
+					continue
+				}
 				if e.FlushAll {
-					bsiEnabled = append(bsiEnabled, int(col))
+					bsiEnabled = append(bsiEnabled, colIdx)
 					continue
 				}
 				for _, include := range e.Cols {
 					if col == include {
-						bsiEnabled = append(bsiEnabled, int(col))
+						bsiEnabled = append(bsiEnabled, colIdx)
 					}
 				}
 			}
@@ -86,7 +93,7 @@ func (e *flushIndexEvent) Execute() error {
 			vecs = append(vecs, &vec.Vector)
 			nodes = append(nodes, vec.MNode)
 		}
-		bsi, err := index.BuildNumericBsiIndex(vecs, meta.Table.Schema.ColDefs[colIdx].Type, int16(colIdx))
+		bsi, err := index.BuildNumericBsiIndex(vecs, meta.GetSchema().ColDefs[colIdx].Type, int16(colIdx))
 		if err != nil {
 			panic(err)
 		}
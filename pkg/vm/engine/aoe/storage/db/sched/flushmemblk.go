@@ -16,6 +16,7 @@ package sched
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/dataio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
@@ -66,6 +67,12 @@ func (e *flushMemblockEvent) Execute() error {
 		bw.SetPostExecutor(func() {
 			logutil.Infof(" %s | Memtable | Flushed", bw.GetFileName())
 		})
+		// The rows are sorted in the block file, the deleted rows are
+		// remapped and persisted before the block file is visible
+		bw.SetPreCommiter(func() error {
+			tombstones := e.Block.WeakRefSegment().GetTombstones()
+			return tombstones.Sort(meta.Id, base.TRANSIENT_BLK, base.PERSISTENT_BLK, bw.GetSortedIdx())
+		})
 		err := bw.Execute()
 		meta.Segment.Table.UpdateFlushTS()
 		meta.SetSize(bw.GetSize())
@@ -17,8 +17,11 @@ package sched
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/dataio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
 )
 
 type flushSegEvent struct {
@@ -37,7 +40,7 @@ func (e *flushSegEvent) Execute() error {
 	ids := e.Segment.BlockIds()
 	meta := e.Segment.GetMeta()
 	batches := make([]*batch.Batch, len(ids))
-	colCnt := len(meta.Table.Schema.ColDefs)
+	colCnt := len(meta.GetSchema().ColDefs)
 	nodes := make([]*common.MemNode, 0)
 	for i := 0; i < len(ids); i++ {
 		blk := e.Segment.WeakRefBlock(ids[i])
@@ -64,5 +67,30 @@ func (e *flushSegEvent) Execute() error {
 	defer release()
 
 	w := dataio.NewSegmentWriter(batches, meta, meta.Table.Database.Catalog.Cfg.Dir)
-	return w.Execute()
+	// The deleted rows are remapped and persisted before the segment
+	// file is visible
+	w.SetPreCommiter(func() error {
+		tombstones := e.Segment.GetTombstones()
+		return tombstones.Merge(ids, base.PERSISTENT_BLK, base.PERSISTENT_SORTED_BLK, w.GetMergedSrc())
+	})
+	if err := w.Execute(); err != nil {
+		return err
+	}
+	// The statistics are persisted when the segment is upgraded
+	meta.SetStats(collectStats(meta, batches))
+	return nil
+}
+
+// collectStats collects the statistics of each column of the segment.
+func collectStats(meta *metadata.Segment, batches []*batch.Batch) map[uint64]*stats.ColumnStats {
+	colDefs := meta.GetSchema().ColDefs
+	m := make(map[uint64]*stats.ColumnStats, len(colDefs))
+	for colIdx, colDef := range colDefs {
+		c := stats.NewCollector()
+		for _, bat := range batches {
+			c.Collect(bat.Vecs[colIdx])
+		}
+		m[colDef.Id] = c.Stats()
+	}
+	return m
 }
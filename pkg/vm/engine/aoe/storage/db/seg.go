@@ -63,20 +63,29 @@ func (seg *Segment) Block(id string, proc *process.Process) engine.Block {
 		StrId: id,
 		Id:    iid,
 		Host:  seg,
+		Proc:  proc,
 	}
 	return blk
 }
 
-// NewFilter generates a Filter for segment.
+// NewFilter generates a Filter for segment. The indexes cover the
+// deleted rows, so it returns nil if rows are deleted from segment.
 func (seg *Segment) NewFilter() engine.Filter {
+	if seg.hasDeleted() {
+		return nil
+	}
 	if !seg.Data.GetIndexHolder().Inited {
 		seg.Data.GetIndexHolder().Init(seg.Data.GetSegmentFile())
 	}
 	return NewSegmentFilter(seg)
 }
 
-// NewSummarizer generates a Summarizer for segment.
+// NewSummarizer generates a Summarizer for segment, it returns nil
+// if rows are deleted from segment.
 func (seg *Segment) NewSummarizer() engine.Summarizer {
+	if seg.hasDeleted() {
+		return nil
+	}
 	if !seg.Data.GetIndexHolder().Inited {
 		seg.Data.GetIndexHolder().Init(seg.Data.GetSegmentFile())
 	}
@@ -93,7 +102,23 @@ func (seg *Segment) NewSparseFilter() engine.SparseFilter {
 
 // Rows returns how many rows this segment contains currently.
 func (seg *Segment) Rows() int64 {
-	return int64(seg.Data.GetRowCount())
+	return int64(seg.Data.GetRowCount() - seg.deleted())
+}
+
+// deleted returns how many rows are deleted from this segment.
+func (seg *Segment) deleted() uint64 {
+	var cnt uint64
+	tombstones := seg.Data.GetTombstones()
+	for _, id := range seg.Data.BlockIds() {
+		if blk := seg.Data.WeakRefBlock(id); blk != nil {
+			cnt += tombstones.Count(id, blk.GetType())
+		}
+	}
+	return cnt
+}
+
+func (seg *Segment) hasDeleted() bool {
+	return seg.deleted() > 0
 }
 
 // Size returns the memory usage of the certain column in a segment.
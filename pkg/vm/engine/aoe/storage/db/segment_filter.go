@@ -37,12 +37,12 @@ func NewSegmentFilter(s *Segment) engine.Filter {
 }
 
 func (f *SegmentFilter) Eq(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
 	bmRes := roaring.NewBitmap()
-	bmRes.AddRange(0, uint64(f.segment.Rows()))
+	bmRes.AddRange(0, f.segment.Data.GetRowCount())
 	ctx := index.FilterCtx{
 		Op:    index.OpEq,
 		Val:   val,
@@ -65,12 +65,12 @@ func (f *SegmentFilter) Eq(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Ne(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
 	bmRes := roaring.NewBitmap()
-	bmRes.AddRange(0, uint64(f.segment.Rows()))
+	bmRes.AddRange(0, f.segment.Data.GetRowCount())
 	ctx := index.FilterCtx{
 		Op:    index.OpNe,
 		Val:   val,
@@ -90,12 +90,12 @@ func (f *SegmentFilter) Ne(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Lt(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
 	bmRes := roaring.NewBitmap()
-	bmRes.AddRange(0, uint64(f.segment.Rows()))
+	bmRes.AddRange(0, f.segment.Data.GetRowCount())
 	ctx := index.FilterCtx{
 		Op:    index.OpLt,
 		Val:   val,
@@ -118,12 +118,12 @@ func (f *SegmentFilter) Lt(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Le(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
 	bmRes := roaring.NewBitmap()
-	bmRes.AddRange(0, uint64(f.segment.Rows()))
+	bmRes.AddRange(0, f.segment.Data.GetRowCount())
 	ctx := index.FilterCtx{
 		Op:    index.OpLe,
 		Val:   val,
@@ -146,12 +146,12 @@ func (f *SegmentFilter) Le(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Gt(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
 	bmRes := roaring.NewBitmap()
-	bmRes.AddRange(0, uint64(f.segment.Rows()))
+	bmRes.AddRange(0, f.segment.Data.GetRowCount())
 	ctx := index.FilterCtx{
 		Op:    index.OpGt,
 		Val:   val,
@@ -174,12 +174,12 @@ func (f *SegmentFilter) Gt(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Ge(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
 	bmRes := roaring.NewBitmap()
-	bmRes.AddRange(0, uint64(f.segment.Rows()))
+	bmRes.AddRange(0, f.segment.Data.GetRowCount())
 	ctx := index.FilterCtx{
 		Op:    index.OpGe,
 		Val:   val,
@@ -202,12 +202,12 @@ func (f *SegmentFilter) Ge(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Btw(attr string, minv interface{}, maxv interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
 	bmRes := roaring.NewBitmap()
-	bmRes.AddRange(0, uint64(f.segment.Rows()))
+	bmRes.AddRange(0, f.segment.Data.GetRowCount())
 	ctx := index.FilterCtx{
 		Op:     index.OpIn,
 		ValMin: minv,
@@ -229,3 +229,43 @@ func (f *SegmentFilter) Btw(attr string, minv interface{}, maxv interface{}) (*r
 	_, err = ret.FromBase64(buf)
 	return ret, err
 }
+
+func (f *SegmentFilter) In(attr string, vals []interface{}) (*roaring64.Bitmap, error) {
+	return f.evalSet(attr, index.OpIn, vals)
+}
+
+func (f *SegmentFilter) NotIn(attr string, vals []interface{}) (*roaring64.Bitmap, error) {
+	return f.evalSet(attr, index.OpOut, vals)
+}
+
+// evalSet returns the rows whose values are (not) in the set of values.
+func (f *SegmentFilter) evalSet(attr string, op index.OpType, vals []interface{}) (*roaring64.Bitmap, error) {
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
+	if colIdx == -1 {
+		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	}
+	if len(vals) == 0 {
+		return nil, errors.New("empty set of values")
+	}
+	bmRes := roaring.NewBitmap()
+	bmRes.AddRange(0, f.segment.Data.GetRowCount())
+	ctx := index.NewFilterCtx(op)
+	ctx.BMRes = bmRes
+	for _, v := range vals {
+		ctx.ValSet[v] = true
+	}
+	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ctx.BoolRes {
+		return roaring64.NewBitmap(), nil
+	}
+	buf, err := ctx.BMRes.ToBase64()
+	if err != nil {
+		return nil, err
+	}
+	ret := roaring64.NewBitmap()
+	_, err = ret.FromBase64(buf)
+	return ret, err
+}
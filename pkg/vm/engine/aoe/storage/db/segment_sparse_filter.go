@@ -37,7 +37,7 @@ func NewSegmentSparseFilter(s *Segment) engine.SparseFilter {
 }
 
 func (f *SegmentSparseFilter) Eq(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -61,7 +61,7 @@ func (f *SegmentSparseFilter) Eq(attr string, val interface{}) ([]string, error)
 	}
 	for idx := 0; idx < blkCnt; idx++ {
 		strID := f.segment.Blocks()[idx]
-		typ := f.segment.Data.GetMeta().Table.Schema.ColDefs[colIdx].Type
+		typ := f.segment.Data.GetMeta().GetSchema().ColDefs[colIdx].Type
 		//log.Info(blkMin[idx], " ", blkMax[idx], " ", val)
 		if compare(blkMin[idx], val, typ) > 0 || compare(blkMax[idx], val, typ) < 0 {
 			continue
@@ -73,7 +73,7 @@ func (f *SegmentSparseFilter) Eq(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Ne(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -85,8 +85,9 @@ func (f *SegmentSparseFilter) Ne(attr string, val interface{}) ([]string, error)
 	}
 	for idx := 0; idx < blkCnt; idx++ {
 		strID := f.segment.Blocks()[idx]
-		typ := f.segment.Data.GetMeta().Table.Schema.ColDefs[colIdx].Type
-		if compare(blkMin[idx], val, typ) <= 0 && compare(blkMax[idx], val, typ) >= 0 {
+		typ := f.segment.Data.GetMeta().GetSchema().ColDefs[colIdx].Type
+		// only blocks whose values are all equal to val can be skipped
+		if compare(blkMin[idx], val, typ) == 0 && compare(blkMax[idx], val, typ) == 0 {
 			continue
 		}
 		res = append(res, strID)
@@ -96,7 +97,7 @@ func (f *SegmentSparseFilter) Ne(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Lt(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -120,7 +121,7 @@ func (f *SegmentSparseFilter) Lt(attr string, val interface{}) ([]string, error)
 	}
 	for idx := 0; idx < blkCnt; idx++ {
 		strID := f.segment.Blocks()[idx]
-		typ := f.segment.Data.GetMeta().Table.Schema.ColDefs[colIdx].Type
+		typ := f.segment.Data.GetMeta().GetSchema().ColDefs[colIdx].Type
 		if compare(blkMin[idx], val, typ) >= 0 {
 			continue
 		}
@@ -131,7 +132,7 @@ func (f *SegmentSparseFilter) Lt(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Le(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -155,7 +156,7 @@ func (f *SegmentSparseFilter) Le(attr string, val interface{}) ([]string, error)
 	}
 	for idx := 0; idx < blkCnt; idx++ {
 		strID := f.segment.Blocks()[idx]
-		typ := f.segment.Data.GetMeta().Table.Schema.ColDefs[colIdx].Type
+		typ := f.segment.Data.GetMeta().GetSchema().ColDefs[colIdx].Type
 		if compare(blkMin[idx], val, typ) > 0 {
 			continue
 		}
@@ -166,7 +167,7 @@ func (f *SegmentSparseFilter) Le(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Gt(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -190,7 +191,7 @@ func (f *SegmentSparseFilter) Gt(attr string, val interface{}) ([]string, error)
 	}
 	for idx := 0; idx < blkCnt; idx++ {
 		strID := f.segment.Blocks()[idx]
-		typ := f.segment.Data.GetMeta().Table.Schema.ColDefs[colIdx].Type
+		typ := f.segment.Data.GetMeta().GetSchema().ColDefs[colIdx].Type
 		if compare(blkMax[idx], val, typ) <= 0 {
 			continue
 		}
@@ -201,7 +202,7 @@ func (f *SegmentSparseFilter) Gt(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Ge(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -225,7 +226,7 @@ func (f *SegmentSparseFilter) Ge(attr string, val interface{}) ([]string, error)
 	}
 	for idx := 0; idx < blkCnt; idx++ {
 		strID := f.segment.Blocks()[idx]
-		typ := f.segment.Data.GetMeta().Table.Schema.ColDefs[colIdx].Type
+		typ := f.segment.Data.GetMeta().GetSchema().ColDefs[colIdx].Type
 		if compare(blkMax[idx], val, typ) < 0 {
 			continue
 		}
@@ -236,7 +237,7 @@ func (f *SegmentSparseFilter) Ge(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Btw(attr string, minv interface{}, maxv interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -261,7 +262,7 @@ func (f *SegmentSparseFilter) Btw(attr string, minv interface{}, maxv interface{
 	}
 	for idx := 0; idx < blkCnt; idx++ {
 		strID := f.segment.Blocks()[idx]
-		typ := f.segment.Data.GetMeta().Table.Schema.ColDefs[colIdx].Type
+		typ := f.segment.Data.GetMeta().GetSchema().ColDefs[colIdx].Type
 		if compare(blkMin[idx], minv, typ) > 0 || compare(blkMax[idx], maxv, typ) < 0 {
 			continue
 		}
@@ -271,36 +272,127 @@ func (f *SegmentSparseFilter) Btw(attr string, minv interface{}, maxv interface{
 	return res, nil
 }
 
+func (f *SegmentSparseFilter) In(attr string, vals []interface{}) ([]string, error) {
+	return f.evalSet(attr, index.OpIn, vals)
+}
+
+func (f *SegmentSparseFilter) NotIn(attr string, vals []interface{}) ([]string, error) {
+	return f.evalSet(attr, index.OpOut, vals)
+}
+
+// evalSet returns the blocks which may have values (not) in the set of
+// values, a block is only skipped by NotIn if all its values are the same
+// value of the set.
+func (f *SegmentSparseFilter) evalSet(attr string, op index.OpType, vals []interface{}) ([]string, error) {
+	colIdx := f.segment.Data.GetMeta().GetColIdx(attr)
+	if colIdx == -1 {
+		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	}
+	if len(vals) == 0 {
+		return nil, errors.New("empty set of values")
+	}
+	ctx := index.NewFilterCtx(op)
+	ctx.BMRes = roaring.NewBitmap()
+	for _, v := range vals {
+		ctx.ValSet[v] = true
+	}
+	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ctx.BoolRes {
+		return []string{}, nil
+	}
+	blkCnt := len(f.segment.Blocks())
+	var res []string
+	blkMin, blkMax, err := f.segment.Data.GetIndexHolder().CollectMinMax(colIdx)
+	if err != nil {
+		return nil, err
+	}
+	typ := f.segment.Data.GetMeta().GetSchema().ColDefs[colIdx].Type
+	for idx := 0; idx < blkCnt; idx++ {
+		strID := f.segment.Blocks()[idx]
+		if op == index.OpOut {
+			if compare(blkMin[idx], blkMax[idx], typ) != 0 || !ctx.ValSet[blkMin[idx]] {
+				res = append(res, strID)
+			}
+			continue
+		}
+		for _, v := range vals {
+			if compare(blkMin[idx], v, typ) <= 0 && compare(blkMax[idx], v, typ) >= 0 {
+				res = append(res, strID)
+				break
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// compare returns -1, 0 or 1, the difference of two values can not be
+// used directly because it may overflow or be truncated.
 func compare(val1, val2 interface{}, typ types.Type) int {
 	switch typ.Oid {
 	case types.T_int8:
-		return int(val1.(int8) - val2.(int8))
+		return compareInt(int64(val1.(int8)), int64(val2.(int8)))
 	case types.T_int16:
-		return int(val1.(int16) - val2.(int16))
+		return compareInt(int64(val1.(int16)), int64(val2.(int16)))
 	case types.T_int32:
-		return int(val1.(int32) - val2.(int32))
+		return compareInt(int64(val1.(int32)), int64(val2.(int32)))
 	case types.T_int64:
-		return int(val1.(int64) - val2.(int64))
+		return compareInt(val1.(int64), val2.(int64))
 	case types.T_uint8:
-		return int(val1.(uint8)) - int(val2.(uint8))
+		return compareUint(uint64(val1.(uint8)), uint64(val2.(uint8)))
 	case types.T_uint16:
-		return int(val1.(uint16)) - int(val2.(uint16))
+		return compareUint(uint64(val1.(uint16)), uint64(val2.(uint16)))
 	case types.T_uint32:
-		return int(val1.(uint32)) - int(val2.(uint32))
+		return compareUint(uint64(val1.(uint32)), uint64(val2.(uint32)))
 	case types.T_uint64:
-		return int(val1.(uint64)) - int(val2.(uint64))
+		return compareUint(val1.(uint64), val2.(uint64))
 	case types.T_float32:
-		return int(val1.(float32) - val2.(float32))
+		return compareFloat(float64(val1.(float32)), float64(val2.(float32)))
 	case types.T_float64:
-		return int(val1.(float64) - val2.(float64))
+		return compareFloat(val1.(float64), val2.(float64))
+	case types.T_decimal64:
+		return val1.(types.Decimal64).Compare(val2.(types.Decimal64))
+	case types.T_decimal128:
+		return val1.(types.Decimal128).Compare(val2.(types.Decimal128))
 	case types.T_char, types.T_json, types.T_varchar:
 		return bytes.Compare(val1.([]byte), val2.([]byte))
 	case types.T_datetime:
-		return int(val1.(types.Datetime) - val2.(types.Datetime))
+		return compareInt(int64(val1.(types.Datetime)), int64(val2.(types.Datetime)))
 	case types.T_date:
-		return int(val1.(types.Date) - val2.(types.Date))
+		return compareInt(int64(val1.(types.Date)), int64(val2.(types.Date)))
 	}
 	panic("unsupported")
 }
 
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
 
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -37,7 +37,7 @@ func NewSegmentSummarizer(s *Segment) engine.Summarizer {
 }
 
 func (s *SegmentSummarizer) Count(attr string, filter *roaring.Bitmap) (uint64, error) {
-	colIdx := s.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := s.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -45,7 +45,7 @@ func (s *SegmentSummarizer) Count(attr string, filter *roaring.Bitmap) (uint64,
 }
 
 func (s *SegmentSummarizer) NullCount(attr string, filter *roaring.Bitmap) (uint64, error) {
-	colIdx := s.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := s.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -53,7 +53,7 @@ func (s *SegmentSummarizer) NullCount(attr string, filter *roaring.Bitmap) (uint
 }
 
 func (s *SegmentSummarizer) Max(attr string, filter *roaring.Bitmap) (interface{}, error) {
-	colIdx := s.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := s.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -61,7 +61,7 @@ func (s *SegmentSummarizer) Max(attr string, filter *roaring.Bitmap) (interface{
 }
 
 func (s *SegmentSummarizer) Min(attr string, filter *roaring.Bitmap) (interface{}, error) {
-	colIdx := s.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := s.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -69,7 +69,7 @@ func (s *SegmentSummarizer) Min(attr string, filter *roaring.Bitmap) (interface{
 }
 
 func (s *SegmentSummarizer) Sum(attr string, filter *roaring.Bitmap) (int64, uint64, error) {
-	colIdx := s.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
+	colIdx := s.segment.Data.GetMeta().GetColIdx(attr)
 	if colIdx == -1 {
 		return 0, 0, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
@@ -14,7 +14,10 @@
 
 package db
 
-import "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+)
 
 type TxnCtx = metadata.TxnCtx
 type IndexId = metadata.IndexId
@@ -25,3 +28,10 @@ type IndexInfo = metadata.IndexInfo
 type TableSchema = metadata.Schema
 type IndexSchema = metadata.IndexSchema
 type ColDef = metadata.ColDef
+
+// DeleteFilter returns the positions of the rows of bat to be deleted
+type DeleteFilter = func(bat *batch.Batch) ([]int64, error)
+
+// UpdateFunc returns the new rows of the rows of bat at sels, bat holds all
+// the columns of the table
+type UpdateFunc = func(bat *batch.Batch, sels []int64) (*batch.Batch, error)
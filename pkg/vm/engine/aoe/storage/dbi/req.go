@@ -16,6 +16,7 @@ package dbi
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
 )
 
 type OnTableDroppedCB = func(error)
@@ -81,3 +82,11 @@ type IDS struct {
 	Version uint64
 	Ids     []uint64
 }
+
+// TableStats are the number of rows of a table and the sizes and the
+// statistics of its columns keyed by the column names.
+type TableStats struct {
+	Rows    int64
+	Sizes   map[string]int64
+	Columns map[string]*stats.ColumnStats
+}
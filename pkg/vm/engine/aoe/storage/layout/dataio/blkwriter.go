@@ -76,6 +76,13 @@ type BlockWriter struct {
 
 	preExecutor  func()
 	postExecutor func()
+
+	// preCommiter runs before the file is committed, the state depending
+	// on the content of the file is persisted there
+	preCommiter func() error
+
+	// sortedIdx is the row positions of the data before it was sorted
+	sortedIdx []uint32
 }
 
 // NewBlockWriter make a BlockWriter, which will be used when the memtable is full.
@@ -128,6 +135,12 @@ func (bw *BlockWriter) SetPostExecutor(f func()) {
 	bw.postExecutor = f
 }
 
+// SetPreCommiter sets f to run before the block file is committed, the
+// block file is not committed if f fails.
+func (bw *BlockWriter) SetPreCommiter(f func() error) {
+	bw.preCommiter = f
+}
+
 func (bw *BlockWriter) SetFileGetter(f func(string, *metadata.Block) (*os.File, error)) {
 	bw.fileGetter = f
 }
@@ -144,6 +157,12 @@ func (bw *BlockWriter) GetSize() int64 {
 	return bw.size
 }
 
+// GetSortedIdx returns the original row positions of the rows written, it
+// returns nil if the rows were written in their original order.
+func (bw *BlockWriter) GetSortedIdx() []uint32 {
+	return bw.sortedIdx
+}
+
 func (bw *BlockWriter) commitFile(fname string) error {
 	name, err := common.FilenameFromTmpfile(fname)
 	if err != nil {
@@ -168,7 +187,8 @@ func (bw *BlockWriter) createIOWriter(dir string, meta *metadata.Block) (*os.Fil
 }
 
 func (bw *BlockWriter) defaultPreprocessor(data []*gvector.Vector, meta *metadata.Block) error {
-	err := mergesort.SortBlockColumns(data,meta.Segment.Table.Schema.PrimaryKey)
+	idx, err := mergesort.SortBlockColumns(data,meta.Segment.GetSchema().PrimaryKey)
+	bw.sortedIdx = idx
 	return err
 }
 
@@ -206,7 +226,7 @@ func (bw *BlockWriter) executeIVecs() error {
 	if bw.preExecutor != nil {
 		bw.preExecutor()
 	}
-	data := make([]vector.IVectorNode, len(bw.meta.Segment.Table.Schema.ColDefs))
+	data := make([]vector.IVectorNode, len(bw.meta.Segment.GetSchema().ColDefs))
 	for i := 0; i < len(data); i++ {
 		ivec, err := bw.idata.GetVectorByAttr(i)
 		if err != nil {
@@ -270,6 +290,11 @@ func (bw *BlockWriter) excuteVecs() error {
 	closeFunc()
 	stat, _ := os.Stat(filename)
 	bw.size = stat.Size()
+	if bw.preCommiter != nil {
+		if err = bw.preCommiter(); err != nil {
+			return err
+		}
+	}
 	return bw.fileCommiter(filename)
 }
 
@@ -282,7 +307,7 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 	if err = binary.Write(&buf, binary.BigEndian, uint8(algo)); err != nil {
 		return err
 	}
-	colCnt := len(meta.Segment.Table.Schema.ColDefs)
+	colCnt := len(meta.Segment.GetSchema().ColDefs)
 	if err = binary.Write(&buf, binary.BigEndian, uint16(colCnt)); err != nil {
 		return err
 	}
@@ -361,7 +386,7 @@ func noCompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block)
 	if err = binary.Write(&buf, binary.BigEndian, uint8(algo)); err != nil {
 		return err
 	}
-	colCnt := len(meta.Segment.Table.Schema.ColDefs)
+	colCnt := len(meta.Segment.GetSchema().ColDefs)
 	if err = binary.Write(&buf, binary.BigEndian, uint16(colCnt)); err != nil {
 		return err
 	}
@@ -405,7 +430,7 @@ func lz4CompressionIVecs(w *os.File, data []vector.IVectorNode, meta *metadata.B
 	if err = binary.Write(&buf, binary.BigEndian, uint8(algo)); err != nil {
 		return err
 	}
-	colCnt := len(meta.Segment.Table.Schema.ColDefs)
+	colCnt := len(meta.Segment.GetSchema().ColDefs)
 	if err = binary.Write(&buf, binary.BigEndian, uint16(colCnt)); err != nil {
 		return err
 	}
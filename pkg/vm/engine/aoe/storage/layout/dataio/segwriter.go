@@ -74,6 +74,13 @@ type SegmentWriter struct {
 	dataFlusher  func(*os.File, []*batch.Batch, *metadata.Segment) error
 	preExecutor  func()
 	postExecutor func()
+
+	// preCommiter runs before the file is committed, the state depending
+	// on the content of the file is persisted there
+	preCommiter func() error
+
+	// mergedSrc is the source block of each row written if blocks were merged
+	mergedSrc []uint16
 }
 
 var FlushIndex = false
@@ -101,6 +108,12 @@ func (sw *SegmentWriter) SetPostExecutor(f func()) {
 	sw.postExecutor = f
 }
 
+// SetPreCommiter sets f to run before the segment file is committed, the
+// segment file is not committed if f fails.
+func (sw *SegmentWriter) SetPreCommiter(f func() error) {
+	sw.preCommiter = f
+}
+
 func (sw *SegmentWriter) SetFileGetter(f func(string, *metadata.Segment) (*os.File, error)) {
 	sw.fileGetter = f
 }
@@ -114,7 +127,8 @@ func (sw *SegmentWriter) SetDataFlusher(f func(*os.File, []*batch.Batch, *metada
 }
 
 func (sw *SegmentWriter) defaultPreprocessor(data []*batch.Batch, meta *metadata.Segment) error {
-	err := mergesort.MergeBlocksToSegment(data)
+	src, err := mergesort.MergeBlocksToSegment(data)
+	sw.mergedSrc = src
 	return err
 }
 
@@ -153,7 +167,7 @@ func (sw *SegmentWriter) flushIndices(w *os.File, data []*batch.Batch, meta *met
 	}
 	var indices []index.Index
 
-	for idx, colDef := range meta.Table.Schema.ColDefs {
+	for idx, colDef := range meta.GetSchema().ColDefs {
 		switch colDef.Type.Oid {
 		case types.T_int8:
 			// build segment zone map index
@@ -476,6 +490,70 @@ func (sw *SegmentWriter) flushIndices(w *os.File, data []*batch.Batch, meta *met
 			}
 			zmi := index.NewSegmentZoneMap(colDef.Type, minv, maxv, int16(idx), blkMin, blkMax)
 			indices = append(indices, zmi)
+		case types.T_decimal64:
+			var minv, maxv, blkMaxv, blkMinv types.Decimal64
+			var blkMin, blkMax []interface{}
+			for i, blk := range data {
+				column := blk.Vecs[idx].Col.([]types.Decimal64)
+				if i == 0 {
+					minv = column[0]
+					maxv = column[0]
+				}
+				for j, v := range column {
+					if j == 0 {
+						blkMaxv = v
+						blkMinv = v
+					}
+					if minv > v {
+						minv = v
+					}
+					if maxv < v {
+						maxv = v
+					}
+					if blkMinv > v {
+						blkMinv = v
+					}
+					if blkMaxv < v {
+						blkMaxv = v
+					}
+				}
+				blkMin = append(blkMin, blkMinv)
+				blkMax = append(blkMax, blkMaxv)
+			}
+			zmi := index.NewSegmentZoneMap(colDef.Type, minv, maxv, int16(idx), blkMin, blkMax)
+			indices = append(indices, zmi)
+		case types.T_decimal128:
+			var minv, maxv, blkMaxv, blkMinv types.Decimal128
+			var blkMin, blkMax []interface{}
+			for i, blk := range data {
+				column := blk.Vecs[idx].Col.([]types.Decimal128)
+				if i == 0 {
+					minv = column[0]
+					maxv = column[0]
+				}
+				for j, v := range column {
+					if j == 0 {
+						blkMaxv = v
+						blkMinv = v
+					}
+					if minv.Compare(v) > 0 {
+						minv = v
+					}
+					if maxv.Compare(v) < 0 {
+						maxv = v
+					}
+					if blkMinv.Compare(v) > 0 {
+						blkMinv = v
+					}
+					if blkMaxv.Compare(v) < 0 {
+						blkMaxv = v
+					}
+				}
+				blkMin = append(blkMin, blkMinv)
+				blkMax = append(blkMax, blkMaxv)
+			}
+			zmi := index.NewSegmentZoneMap(colDef.Type, minv, maxv, int16(idx), blkMin, blkMax)
+			indices = append(indices, zmi)
 		case types.T_char, types.T_json, types.T_varchar:
 			var minv, maxv, blkMaxv, blkMinv []byte
 			var blkMin, blkMax []interface{}
@@ -623,6 +701,11 @@ func (sw *SegmentWriter) Execute() error {
 	w.Close()
 	stat, _ := os.Stat(filename)
 	sw.size = stat.Size()
+	if sw.preCommiter != nil {
+		if err = sw.preCommiter(); err != nil {
+			return err
+		}
+	}
 	return sw.fileCommiter(filename)
 }
 
@@ -630,6 +713,12 @@ func (sw *SegmentWriter) GetSize() int64 {
 	return sw.size
 }
 
+// GetMergedSrc returns the source block of each row written, it returns
+// nil if the blocks were written without being merged.
+func (sw *SegmentWriter) GetMergedSrc() []uint16 {
+	return sw.mergedSrc
+}
+
 // flushBlocks does not read the .blk file, and writes the incoming
 // data&meta into the segemnt file.
 func flushBlocks(w *os.File, data []*batch.Batch, meta *metadata.Segment) error {
@@ -653,7 +742,7 @@ func flushBlocks(w *os.File, data []*batch.Batch, meta *metadata.Segment) error
 	if err != nil {
 		return err
 	}
-	colDefs := meta.Table.Schema.ColDefs
+	colDefs := meta.GetSchema().ColDefs
 	colCnt := len(colDefs)
 	if err = binary.Write(&metaBuf, binary.BigEndian, uint32(colCnt)); err != nil {
 		return err
@@ -198,10 +198,10 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 	f.mu.RLock()
 	if len(f.files) == 0 {
 		f.mu.RUnlock()
-		colcnt := len(meta.Segment.Table.Schema.ColDefs)
+		colcnt := len(meta.Segment.GetSchema().ColDefs)
 		attrs := make([]int, colcnt)
 		vecs := make([]vector.IVector, colcnt)
-		for i, colDef := range meta.Segment.Table.Schema.ColDefs {
+		for i, colDef := range meta.Segment.GetSchema().ColDefs {
 			vec := vector.NewVector(colDef.Type, meta.Segment.Table.Schema.BlockMaxRows)
 			vecs[i] = vec
 			attrs[i] = i
@@ -218,10 +218,10 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 	f.mu.RUnlock()
 	defer file.Unref()
 	id := *meta.AsCommonID()
-	colcnt := len(meta.Segment.Table.Schema.ColDefs)
+	colcnt := len(meta.Segment.GetSchema().ColDefs)
 	vecs := make([]vector.IVector, colcnt)
 	cols := make([]int, colcnt)
-	for i, colDef := range meta.Segment.Table.Schema.ColDefs {
+	for i, colDef := range meta.Segment.GetSchema().ColDefs {
 		cols[i] = i
 		sz := file.PartSize(uint64(i), id, false)
 		osz := file.PartSize(uint64(i), id, true)
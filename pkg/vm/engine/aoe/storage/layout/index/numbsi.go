@@ -25,6 +25,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/index/bsi"
 	"io"
 	// log "github.com/sirupsen/logrus"
+
+	"github.com/RoaringBitmap/roaring"
 )
 
 func NumericBsiIndexConstructor(vf common.IVFile, useCompress bool, freeFunc buf.MemoryFreeFunc) buf.IMemoryNode {
@@ -43,7 +45,7 @@ type NumericBsiIndex struct {
 func initNumericBsi(t types.Type, bitSize int) *bsi.NumericBSI {
 	var bsiIdx bsi.BitSlicedIndex
 	switch t.Oid {
-	case types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_date, types.T_datetime:
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_date, types.T_datetime, types.T_decimal64:
 		bsiIdx = bsi.NewNumericBSI(bitSize, bsi.SignedInt)
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 		bsiIdx = bsi.NewNumericBSI(bitSize, bsi.UnsignedInt)
@@ -117,6 +119,24 @@ func (i *NumericBsiIndex) Eval(ctx *FilterCtx) error {
 			ctx.ValMax = v
 		}()
 	}
+	if v, ok := ctx.Val.(types.Decimal64); ok {
+		ctx.Val = int64(v)
+		defer func() {
+			ctx.Val = v
+		}()
+	}
+	if v, ok := ctx.ValMin.(types.Decimal64); ok {
+		ctx.ValMin = int64(v)
+		defer func() {
+			ctx.ValMin = v
+		}()
+	}
+	if v, ok := ctx.ValMax.(types.Decimal64); ok {
+		ctx.ValMax = int64(v)
+		defer func() {
+			ctx.ValMax = v
+		}()
+	}
 	switch ctx.Op {
 	case OpEq:
 		ctx.BMRes, err = i.Eq(ctx.Val, ctx.BMRes)
@@ -131,6 +151,18 @@ func (i *NumericBsiIndex) Eval(ctx *FilterCtx) error {
 	case OpLt:
 		ctx.BMRes, err = i.Lt(ctx.Val, ctx.BMRes)
 	case OpIn:
+		if len(ctx.ValSet) > 0 {
+			bm := roaring.NewBitmap()
+			for v := range ctx.ValSet {
+				x, err := i.Eq(bsiValue(v), ctx.BMRes.Clone())
+				if err != nil {
+					return err
+				}
+				bm.Or(x)
+			}
+			ctx.BMRes = bm
+			return nil
+		}
 		bm := ctx.BMRes.Clone()
 		ctx.BMRes, err = i.Ge(ctx.ValMin, ctx.BMRes)
 		if err != nil {
@@ -142,6 +174,14 @@ func (i *NumericBsiIndex) Eval(ctx *FilterCtx) error {
 		}
 		ctx.BMRes.And(bm)
 	case OpOut:
+		if len(ctx.ValSet) > 0 {
+			for v := range ctx.ValSet {
+				if ctx.BMRes, err = i.Ne(bsiValue(v), ctx.BMRes); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
 		bm := ctx.BMRes.Clone()
 		ctx.BMRes, err = i.Gt(ctx.ValMax, ctx.BMRes)
 		if err != nil {
@@ -156,6 +196,19 @@ func (i *NumericBsiIndex) Eval(ctx *FilterCtx) error {
 	return err
 }
 
+// bsiValue converts a value of the set of a filter into the value stored.
+func bsiValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case types.Date:
+		return int32(x)
+	case types.Datetime:
+		return int64(x)
+	case types.Decimal64:
+		return int64(x)
+	}
+	return v
+}
+
 func (i *NumericBsiIndex) FreeMemory() {
 	if i.FreeFunc != nil {
 		i.FreeFunc(i)
@@ -362,6 +415,19 @@ func BuildNumericBsiIndex(data []*vector.Vector, t types.Type, colIdx int16) (bs
 			}
 		}
 		return bsiIdx, nil
+	case types.T_decimal64:
+		bsiIdx := NewNumericBsiIndex(t, 64, colIdx)
+		row := 0
+		for _, part := range data {
+			column := part.Col.([]types.Decimal64)
+			for _, val := range column {
+				if err := bsiIdx.Set(uint64(row), int64(val)); err != nil {
+					return nil, err
+				}
+				row++
+			}
+		}
+		return bsiIdx, nil
 	case types.T_datetime:
 		bsiIdx := NewNumericBsiIndex(t, 64, colIdx)
 		row := 0
@@ -78,9 +78,17 @@ func (i *SegmentZoneMapIndex) Eval(ctx *FilterCtx) error {
 	case OpLt:
 		ctx.BoolRes = i.Lt(ctx.Val)
 	case OpIn:
-		ctx.BoolRes = i.Ge(ctx.ValMax) && i.Le(ctx.ValMin)
+		if len(ctx.ValSet) > 0 {
+			ctx.BoolRes = anyIn(i.Eq, ctx.ValSet)
+		} else {
+			ctx.BoolRes = i.Ge(ctx.ValMax) && i.Le(ctx.ValMin)
+		}
 	case OpOut:
-		ctx.BoolRes = i.Lt(ctx.ValMin) || i.Gt(ctx.ValMax)
+		if len(ctx.ValSet) > 0 {
+			ctx.BoolRes = !onlyIn(i.MinV, i.MaxV, ctx.ValSet)
+		} else {
+			ctx.BoolRes = i.Lt(ctx.ValMin) || i.Gt(ctx.ValMax)
+		}
 	}
 	return nil
 }
@@ -300,6 +308,38 @@ func (i *SegmentZoneMapIndex) Unmarshal(data []byte) error {
 			buf = buf[8:]
 		}
 		return nil
+	case types.T_decimal64:
+		i.MinV = encoding.DecodeDecimal64(buf[:8])
+		buf = buf[8:]
+		i.MaxV = encoding.DecodeDecimal64(buf[:8])
+		buf = buf[8:]
+		len := encoding.DecodeInt32(buf[:4])
+		buf = buf[4:]
+		i.BlkMax = make([]interface{}, len)
+		i.BlkMin = make([]interface{}, len)
+		for j := 0; j < int(len); j++ {
+			i.BlkMin[j] = encoding.DecodeDecimal64(buf[:8])
+			buf = buf[8:]
+			i.BlkMax[j] = encoding.DecodeDecimal64(buf[:8])
+			buf = buf[8:]
+		}
+		return nil
+	case types.T_decimal128:
+		i.MinV = encoding.DecodeDecimal128(buf[:16])
+		buf = buf[16:]
+		i.MaxV = encoding.DecodeDecimal128(buf[:16])
+		buf = buf[16:]
+		len := encoding.DecodeInt32(buf[:4])
+		buf = buf[4:]
+		i.BlkMax = make([]interface{}, len)
+		i.BlkMin = make([]interface{}, len)
+		for j := 0; j < int(len); j++ {
+			i.BlkMin[j] = encoding.DecodeDecimal128(buf[:16])
+			buf = buf[16:]
+			i.BlkMax[j] = encoding.DecodeDecimal128(buf[:16])
+			buf = buf[16:]
+		}
+		return nil
 	case types.T_char, types.T_varchar, types.T_json:
 		lenminv := encoding.DecodeInt16(buf[:2])
 		buf = buf[2:]
@@ -474,6 +514,26 @@ func (i *SegmentZoneMapIndex) Marshal() ([]byte, error) {
 			buf.Write(encoding.EncodeFloat64(i.BlkMax[j].(float64)))
 		}
 		return buf.Bytes(), nil
+	case types.T_decimal64:
+		buf.Write(encoding.EncodeType(i.T))
+		buf.Write(encoding.EncodeDecimal64(i.MinV.(types.Decimal64)))
+		buf.Write(encoding.EncodeDecimal64(i.MaxV.(types.Decimal64)))
+		buf.Write(encoding.EncodeInt32(int32(len(i.BlkMin))))
+		for j := 0; j < len(i.BlkMin); j++ {
+			buf.Write(encoding.EncodeDecimal64(i.BlkMin[j].(types.Decimal64)))
+			buf.Write(encoding.EncodeDecimal64(i.BlkMax[j].(types.Decimal64)))
+		}
+		return buf.Bytes(), nil
+	case types.T_decimal128:
+		buf.Write(encoding.EncodeType(i.T))
+		buf.Write(encoding.EncodeDecimal128(i.MinV.(types.Decimal128)))
+		buf.Write(encoding.EncodeDecimal128(i.MaxV.(types.Decimal128)))
+		buf.Write(encoding.EncodeInt32(int32(len(i.BlkMin))))
+		for j := 0; j < len(i.BlkMin); j++ {
+			buf.Write(encoding.EncodeDecimal128(i.BlkMin[j].(types.Decimal128)))
+			buf.Write(encoding.EncodeDecimal128(i.BlkMax[j].(types.Decimal128)))
+		}
+		return buf.Bytes(), nil
 	case types.T_char, types.T_varchar, types.T_json:
 		buf.Write(encoding.EncodeType(i.T))
 		minv := i.MinV.([]byte)
@@ -536,12 +596,14 @@ func (i *SegmentZoneMapIndex) Eq(v interface{}) bool {
 		return v.(uint32) >= i.MinV.(uint32) && v.(uint32) <= i.MaxV.(uint32)
 	case types.T_uint64:
 		return v.(uint64) >= i.MinV.(uint64) && v.(uint64) <= i.MaxV.(uint64)
-	case types.T_decimal:
-		panic("not supported")
 	case types.T_float32:
 		return v.(float32) >= i.MinV.(float32) && v.(float32) <= i.MaxV.(float32)
 	case types.T_float64:
 		return v.(float64) >= i.MinV.(float64) && v.(float64) <= i.MaxV.(float64)
+	case types.T_decimal64:
+		return v.(types.Decimal64) >= i.MinV.(types.Decimal64) && v.(types.Decimal64) <= i.MaxV.(types.Decimal64)
+	case types.T_decimal128:
+		return v.(types.Decimal128).Compare(i.MinV.(types.Decimal128)) >= 0 && v.(types.Decimal128).Compare(i.MaxV.(types.Decimal128)) <= 0
 	case types.T_date:
 		return v.(types.Date) >= i.MinV.(types.Date) && v.(types.Date) <= i.MaxV.(types.Date)
 	case types.T_datetime:
@@ -584,12 +646,14 @@ func (i *SegmentZoneMapIndex) Lt(v interface{}) bool {
 		return v.(uint32) > i.MinV.(uint32)
 	case types.T_uint64:
 		return v.(uint64) > i.MinV.(uint64)
-	case types.T_decimal:
-		panic("not supported")
 	case types.T_float32:
 		return v.(float32) > i.MinV.(float32)
 	case types.T_float64:
 		return v.(float64) > i.MinV.(float64)
+	case types.T_decimal64:
+		return v.(types.Decimal64) > i.MinV.(types.Decimal64)
+	case types.T_decimal128:
+		return v.(types.Decimal128).Compare(i.MinV.(types.Decimal128)) > 0
 	case types.T_date:
 		return v.(types.Date) > i.MinV.(types.Date)
 	case types.T_datetime:
@@ -622,12 +686,14 @@ func (i *SegmentZoneMapIndex) Le(v interface{}) bool {
 		return v.(uint32) >= i.MinV.(uint32)
 	case types.T_uint64:
 		return v.(uint64) >= i.MinV.(uint64)
-	case types.T_decimal:
-		panic("not supported")
 	case types.T_float32:
 		return v.(float32) >= i.MinV.(float32)
 	case types.T_float64:
 		return v.(float64) >= i.MinV.(float64)
+	case types.T_decimal64:
+		return v.(types.Decimal64) >= i.MinV.(types.Decimal64)
+	case types.T_decimal128:
+		return v.(types.Decimal128).Compare(i.MinV.(types.Decimal128)) >= 0
 	case types.T_date:
 		return v.(types.Date) >= i.MinV.(types.Date)
 	case types.T_datetime:
@@ -660,12 +726,14 @@ func (i *SegmentZoneMapIndex) Gt(v interface{}) bool {
 		return v.(uint32) < i.MaxV.(uint32)
 	case types.T_uint64:
 		return v.(uint64) < i.MaxV.(uint64)
-	case types.T_decimal:
-		panic("not supported")
 	case types.T_float32:
 		return v.(float32) < i.MaxV.(float32)
 	case types.T_float64:
 		return v.(float64) < i.MaxV.(float64)
+	case types.T_decimal64:
+		return v.(types.Decimal64) < i.MaxV.(types.Decimal64)
+	case types.T_decimal128:
+		return v.(types.Decimal128).Compare(i.MaxV.(types.Decimal128)) < 0
 	case types.T_date:
 		return v.(types.Date) < i.MaxV.(types.Date)
 	case types.T_datetime:
@@ -698,12 +766,14 @@ func (i *SegmentZoneMapIndex) Ge(v interface{}) bool {
 		return v.(uint32) <= i.MaxV.(uint32)
 	case types.T_uint64:
 		return v.(uint64) <= i.MaxV.(uint64)
-	case types.T_decimal:
-		panic("not supported")
 	case types.T_float32:
 		return v.(float32) <= i.MaxV.(float32)
 	case types.T_float64:
 		return v.(float64) <= i.MaxV.(float64)
+	case types.T_decimal64:
+		return v.(types.Decimal64) <= i.MaxV.(types.Decimal64)
+	case types.T_decimal128:
+		return v.(types.Decimal128).Compare(i.MaxV.(types.Decimal128)) <= 0
 	case types.T_date:
 		return v.(types.Date) <= i.MaxV.(types.Date)
 	case types.T_datetime:
@@ -72,6 +72,26 @@ func (ctx *FilterCtx) Reset() {
 	ctx.Err = nil
 }
 
+// anyIn returns true if any value of the set may be in the zone of an index.
+func anyIn(eq func(interface{}) bool, set map[interface{}]bool) bool {
+	for v := range set {
+		if eq(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// onlyIn returns true if all the values in the zone [min, max] of an index
+// are in the set, which is only known when the zone holds a single value.
+func onlyIn(min, max interface{}, set map[interface{}]bool) bool {
+	switch min.(type) {
+	case []byte, nil:
+		return false
+	}
+	return min == max && set[min]
+}
+
 func (ctx *FilterCtx) Eval(i Index) error {
 	return i.Eval(ctx)
 }
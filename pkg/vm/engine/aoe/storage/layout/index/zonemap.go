@@ -86,9 +86,17 @@ func (i *ZoneMapIndex) Eval(ctx *FilterCtx) error {
 	case OpLt:
 		ctx.BoolRes = i.Lt(ctx.Val)
 	case OpIn:
-		ctx.BoolRes = i.Ge(ctx.ValMin) && i.Le(ctx.ValMax)
+		if len(ctx.ValSet) > 0 {
+			ctx.BoolRes = anyIn(i.Eq, ctx.ValSet)
+		} else {
+			ctx.BoolRes = i.Ge(ctx.ValMin) && i.Le(ctx.ValMax)
+		}
 	case OpOut:
-		ctx.BoolRes = i.Lt(ctx.ValMin) || i.Gt(ctx.ValMax)
+		if len(ctx.ValSet) > 0 {
+			ctx.BoolRes = !onlyIn(i.MinV, i.MaxV, ctx.ValSet)
+		} else {
+			ctx.BoolRes = i.Lt(ctx.ValMin) || i.Gt(ctx.ValMax)
+		}
 	}
 	return nil
 }
@@ -194,6 +202,16 @@ func (i *ZoneMapIndex) Unmarshal(data []byte) error {
 		buf = buf[4:]
 		i.MaxV = encoding.DecodeFloat64(buf[:8])
 		return nil
+	case types.T_decimal64:
+		i.MinV = encoding.DecodeDecimal64(buf[:8])
+		buf = buf[4:]
+		i.MaxV = encoding.DecodeDecimal64(buf[:8])
+		return nil
+	case types.T_decimal128:
+		i.MinV = encoding.DecodeDecimal128(buf[:16])
+		buf = buf[4:]
+		i.MaxV = encoding.DecodeDecimal128(buf[:16])
+		return nil
 	case types.T_char, types.T_varchar, types.T_json:
 		lenminv := encoding.DecodeInt16(buf[:2])
 		buf = buf[2:]
@@ -266,6 +284,16 @@ func (i *ZoneMapIndex) Marshal() ([]byte, error) {
 		buf.Write(encoding.EncodeFloat64(i.MinV.(float64)))
 		buf.Write(encoding.EncodeFloat64(i.MaxV.(float64)))
 		return buf.Bytes(), nil
+	case types.T_decimal64:
+		buf.Write(encoding.EncodeType(i.T))
+		buf.Write(encoding.EncodeDecimal64(i.MinV.(types.Decimal64)))
+		buf.Write(encoding.EncodeDecimal64(i.MaxV.(types.Decimal64)))
+		return buf.Bytes(), nil
+	case types.T_decimal128:
+		buf.Write(encoding.EncodeType(i.T))
+		buf.Write(encoding.EncodeDecimal128(i.MinV.(types.Decimal128)))
+		buf.Write(encoding.EncodeDecimal128(i.MaxV.(types.Decimal128)))
+		return buf.Bytes(), nil
 	case types.T_char, types.T_varchar, types.T_json:
 		buf.Write(encoding.EncodeType(i.T))
 		minv := i.MinV.([]byte)
@@ -301,12 +329,14 @@ func (i *ZoneMapIndex) Eq(v interface{}) bool {
 		return v.(uint32) >= i.MinV.(uint32) && v.(uint32) <= i.MaxV.(uint32)
 	case types.T_uint64:
 		return v.(uint64) >= i.MinV.(uint64) && v.(uint64) <= i.MaxV.(uint64)
-	case types.T_decimal:
-		panic("not supported")
 	case types.T_float32:
 		return v.(float32) >= i.MinV.(float32) && v.(float32) <= i.MaxV.(float32)
 	case types.T_float64:
 		return v.(float64) >= i.MinV.(float64) && v.(float64) <= i.MaxV.(float64)
+	case types.T_decimal64:
+		return v.(types.Decimal64) >= i.MinV.(types.Decimal64) && v.(types.Decimal64) <= i.MaxV.(types.Decimal64)
+	case types.T_decimal128:
+		return v.(types.Decimal128).Compare(i.MinV.(types.Decimal128)) >= 0 && v.(types.Decimal128).Compare(i.MaxV.(types.Decimal128)) <= 0
 	case types.T_date:
 		panic("not supported")
 	case types.T_datetime:
@@ -96,13 +96,34 @@ func (c *tableAppender) onImmut() {
 	c.onNoMut()
 }
 
+// onStale persists the block created under the previous schema of the table
+// as it is, and the rows appended afterwards go to a new segment
+func (c *tableAppender) onStale() error {
+	blkHandle := c.blkAppender.MakeHandle()
+	err := blkHandle.GetNode().(mb.IMutableBlock).Flush()
+	blkHandle.Close()
+	if err != nil {
+		return err
+	}
+	prev := c.blkAppender
+	err = c.onNoMut()
+	prev.Unref()
+	return err
+}
+
+// isStale returns true if the schema of the table is altered after the
+// block being appended is created
+func (c *tableAppender) isStale() bool {
+	return c.blkAppender.GetMeta().Segment.SchemaVersion != c.meta.Schema.Version
+}
+
 func (c *tableAppender) doAppend(mutblk mb.IMutableBlock, bat *batch.Batch, offset uint64, index *shard.SliceIndex) (n uint64, err error) {
 	var na int
 	meta := mutblk.GetMeta()
 	data := mutblk.GetData()
 	for idx, attr := range data.GetAttrs() {
 		for i, a := range bat.Attrs {
-			if a == meta.Segment.Table.Schema.ColDefs[idx].Name {
+			if a == meta.Segment.GetSchema().ColDefs[idx].Name {
 				vec, err := data.GetVectorByAttr(attr)
 				if err != nil {
 					return 0, err
@@ -148,6 +169,10 @@ func (c *tableAppender) Append(bat *batch.Batch, index *shard.SliceIndex) (err e
 		c.onNoMut()
 	} else if c.blkAppender.GetMeta().HasMaxRowsLocked() {
 		c.onImmut()
+	} else if c.isStale() {
+		if err = c.onStale(); err != nil {
+			return err
+		}
 	}
 
 	offset := index.Start
@@ -129,5 +129,6 @@ func (blk *baseBlock) upgrade(host iface.ISegment, meta *metadata.Block) (*baseB
 	default:
 		panic("logic error")
 	}
+	host.GetTombstones().Upgrade(meta.Id, upgraded.typ)
 	return upgraded, nil
 }
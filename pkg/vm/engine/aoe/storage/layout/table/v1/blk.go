@@ -64,7 +64,7 @@ func newBlock(host iface.ISegment, meta *metadata.Block) (iface.IBlock, error) {
 
 func (blk *block) initColumns() error {
 	blk.data.sizes = make([]uint64, 0)
-	for idx, _ := range blk.meta.Segment.Table.Schema.ColDefs {
+	for idx, _ := range blk.meta.Segment.GetSchema().ColDefs {
 		blk.Ref()
 		colBlk := col.NewStdColumnBlock(blk, idx)
 		blk.data.cols = append(blk.data.cols, colBlk)
@@ -84,8 +84,12 @@ func (blk *block) close() {
 }
 
 func (blk *block) Size(attr string) uint64 {
-	idx := blk.meta.Segment.Table.Schema.GetColIdx(attr)
+	idx := blk.meta.Segment.GetColIdx(attr)
 	if idx < 0 {
+		if blk.meta.Segment.Table.Schema.GetColIdx(attr) >= 0 {
+			// the column is added after the block is created
+			return 0
+		}
 		panic(fmt.Sprintf("Specified attr %s not found", attr))
 	}
 	if !blk.IsMutable() {
@@ -149,9 +153,9 @@ func (blk *block) GetVectorWrapper(attrid int) (*vector.VectorWrapper, error) {
 }
 
 func (blk *block) GetVectorCopy(attr string, compressed, deCompressed *bytes.Buffer) (*ro.Vector, error) {
-	colIdx := blk.meta.Segment.Table.Schema.GetColIdx(attr)
+	colIdx := blk.meta.Segment.GetColIdx(attr)
 	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+		return getAddedColumnVector(blk.meta, attr, int(blk.GetRowCount()))
 	}
 	vec, err := blk.data.cols[colIdx].ForceLoad(compressed, deCompressed)
 	if err != nil {
@@ -161,8 +165,11 @@ func (blk *block) GetVectorCopy(attr string, compressed, deCompressed *bytes.Buf
 }
 
 func (blk *block) Prefetch(attr string) error {
-	colIdx := blk.meta.Segment.Table.Schema.GetColIdx(attr)
+	colIdx := blk.meta.Segment.GetColIdx(attr)
 	if colIdx == -1 {
+		if blk.meta.Segment.Table.Schema.GetColIdx(attr) >= 0 {
+			return nil
+		}
 		return errors.New(fmt.Sprintf("column %s not found", attr))
 	}
 	return blk.data.cols[colIdx].Prefetch()
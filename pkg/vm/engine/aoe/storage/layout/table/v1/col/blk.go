@@ -73,7 +73,7 @@ func (blk *columnBlock) GetColIdx() int {
 }
 
 func (blk *columnBlock) GetColType() types.Type {
-	return blk.meta.Segment.Table.Schema.ColDefs[blk.colIdx].Type
+	return blk.meta.Segment.GetSchema().ColDefs[blk.colIdx].Type
 }
 
 func (blk *columnBlock) GetMeta() *metadata.Block {
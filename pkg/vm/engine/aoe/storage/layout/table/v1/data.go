@@ -43,6 +43,7 @@ func newTableData(host *Tables, meta *metadata.Table) *tableData {
 		meta:        meta,
 		host:        host,
 		indexHolder: index.NewTableHolder(host.IndexBufMgr, meta.Id),
+		tombstones:  newTombstones(meta.Database.Catalog.Cfg.Dir, meta.Id),
 	}
 	data.blkFactory = newBlockFactory(host.MutFactory, data)
 	data.tree.segments = make([]iface.ISegment, 0)
@@ -66,6 +67,7 @@ type tableData struct {
 	host        *Tables
 	meta        *metadata.Table
 	indexHolder *index.TableHolder
+	tombstones  *tombstones
 	blkFactory  iface.IBlockFactory
 	appender    *tableAppender
 }
@@ -112,6 +114,10 @@ func (td *tableData) GetIndexHolder() *index.TableHolder {
 	return td.indexHolder
 }
 
+func (td *tableData) GetTombstones() iface.ITombstones {
+	return td.tombstones
+}
+
 func (td *tableData) WeakRefRoot() iface.ISegment {
 	if atomic.LoadUint32(&td.tree.segmentCnt) == 0 {
 		return nil
@@ -397,6 +403,21 @@ func (td *tableData) UpgradeSegment(id uint64) (seg iface.ISegment, err error) {
 	return upgradeSeg, nil
 }
 
+// loadTombstones loads the deleted rows of the registered blocks.
+func (td *tableData) loadTombstones() error {
+	types := make(map[uint64]base.BlockType)
+	td.tree.RLock()
+	for _, seg := range td.tree.segments {
+		for _, id := range seg.BlockIds() {
+			if blk := seg.WeakRefBlock(id); blk != nil {
+				types[id] = blk.GetType()
+			}
+		}
+	}
+	td.tree.RUnlock()
+	return td.tombstones.load(types)
+}
+
 func MockSegments(meta *metadata.Table, tblData iface.ITableData) []uint64 {
 	segs := make([]uint64, 0)
 	for _, segMeta := range meta.SegmentSet {
@@ -518,6 +539,10 @@ func (ts *Tables) StrongRefTable(tid uint64) (tbl iface.ITableData, err error) {
 func (ts *Tables) RegisterTable(meta *metadata.Table) (iface.ITableData, error) {
 	tbl := newTableData(ts, meta)
 	tbl.InitAppender()
+	if err := tbl.loadTombstones(); err != nil {
+		tbl.Unref()
+		return nil, err
+	}
 	ts.Lock()
 	defer ts.Unlock()
 	if meta.IsCloseLocked() {
@@ -633,6 +658,9 @@ func (ts *Tables) PrepareInstallTable(meta *metadata.Table, ctx InstallContext)
 			defer blkData.Unref()
 		}
 	}
+	if err := data.loadTombstones(); err != nil {
+		return nil, err
+	}
 	data.InitReplay()
 	logutil.Info(data.String())
 	logutil.Infof("%s, %d", meta.Repr(false), data.GetRowCount())
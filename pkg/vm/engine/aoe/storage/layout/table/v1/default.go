@@ -0,0 +1,241 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	ro "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+)
+
+// getAddedColumnVector returns the vector of a column which is added after
+// the block is created. The rows of the block take the default value of the
+// column, which is filled lazily on reading.
+func getAddedColumnVector(meta *metadata.Block, attr string, rows int) (*ro.Vector, error) {
+	schema := meta.Segment.Table.Schema
+	colIdx := schema.GetColIdx(attr)
+	if colIdx == -1 {
+		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	}
+	return newDefaultVector(schema.ColDefs[colIdx], rows)
+}
+
+// newDefaultVector returns a vector of rows filled with the default value of
+// the column, the rows are null if the column has no default value.
+func newDefaultVector(colDef *metadata.ColDef, rows int) (*ro.Vector, error) {
+	vec := ro.New(colDef.Type)
+	if colDef.Default == nil || colDef.Default.IsNull {
+		if err := fillVector(vec, colDef.Type, "", true, rows); err != nil {
+			return nil, err
+		}
+		for i := 0; i < rows; i++ {
+			vec.Nsp.Add(uint64(i))
+		}
+		return vec, nil
+	}
+	if err := fillVector(vec, colDef.Type, colDef.Default.Value, false, rows); err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
+func fillVector(vec *ro.Vector, typ types.Type, value string, isNull bool, rows int) error {
+	var err error
+	switch typ.Oid {
+	case types.T_int8:
+		var v int64
+		if !isNull {
+			if v, err = strconv.ParseInt(value, 10, 8); err != nil {
+				return err
+			}
+		}
+		col := make([]int8, rows)
+		for i := range col {
+			col[i] = int8(v)
+		}
+		vec.Col = col
+	case types.T_int16:
+		var v int64
+		if !isNull {
+			if v, err = strconv.ParseInt(value, 10, 16); err != nil {
+				return err
+			}
+		}
+		col := make([]int16, rows)
+		for i := range col {
+			col[i] = int16(v)
+		}
+		vec.Col = col
+	case types.T_int32:
+		var v int64
+		if !isNull {
+			if v, err = strconv.ParseInt(value, 10, 32); err != nil {
+				return err
+			}
+		}
+		col := make([]int32, rows)
+		for i := range col {
+			col[i] = int32(v)
+		}
+		vec.Col = col
+	case types.T_int64:
+		var v int64
+		if !isNull {
+			if v, err = strconv.ParseInt(value, 10, 64); err != nil {
+				return err
+			}
+		}
+		col := make([]int64, rows)
+		for i := range col {
+			col[i] = v
+		}
+		vec.Col = col
+	case types.T_uint8:
+		var v uint64
+		if !isNull {
+			if v, err = strconv.ParseUint(value, 10, 8); err != nil {
+				return err
+			}
+		}
+		col := make([]uint8, rows)
+		for i := range col {
+			col[i] = uint8(v)
+		}
+		vec.Col = col
+	case types.T_uint16:
+		var v uint64
+		if !isNull {
+			if v, err = strconv.ParseUint(value, 10, 16); err != nil {
+				return err
+			}
+		}
+		col := make([]uint16, rows)
+		for i := range col {
+			col[i] = uint16(v)
+		}
+		vec.Col = col
+	case types.T_uint32:
+		var v uint64
+		if !isNull {
+			if v, err = strconv.ParseUint(value, 10, 32); err != nil {
+				return err
+			}
+		}
+		col := make([]uint32, rows)
+		for i := range col {
+			col[i] = uint32(v)
+		}
+		vec.Col = col
+	case types.T_uint64:
+		var v uint64
+		if !isNull {
+			if v, err = strconv.ParseUint(value, 10, 64); err != nil {
+				return err
+			}
+		}
+		col := make([]uint64, rows)
+		for i := range col {
+			col[i] = v
+		}
+		vec.Col = col
+	case types.T_float32:
+		var v float64
+		if !isNull {
+			if v, err = strconv.ParseFloat(value, 32); err != nil {
+				return err
+			}
+		}
+		col := make([]float32, rows)
+		for i := range col {
+			col[i] = float32(v)
+		}
+		vec.Col = col
+	case types.T_float64:
+		var v float64
+		if !isNull {
+			if v, err = strconv.ParseFloat(value, 64); err != nil {
+				return err
+			}
+		}
+		col := make([]float64, rows)
+		for i := range col {
+			col[i] = v
+		}
+		vec.Col = col
+	case types.T_decimal64:
+		var v types.Decimal64
+		if !isNull {
+			if v, err = types.ParseStringToDecimal64(value, typ.Width, typ.Precision); err != nil {
+				return err
+			}
+		}
+		col := make([]types.Decimal64, rows)
+		for i := range col {
+			col[i] = v
+		}
+		vec.Col = col
+	case types.T_decimal128:
+		var v types.Decimal128
+		if !isNull {
+			if v, err = types.ParseStringToDecimal128(value, typ.Width, typ.Precision); err != nil {
+				return err
+			}
+		}
+		col := make([]types.Decimal128, rows)
+		for i := range col {
+			col[i] = v
+		}
+		vec.Col = col
+	case types.T_date:
+		var v types.Date
+		if !isNull {
+			if v, err = types.ParseDate(value); err != nil {
+				return err
+			}
+		}
+		col := make([]types.Date, rows)
+		for i := range col {
+			col[i] = v
+		}
+		vec.Col = col
+	case types.T_datetime:
+		var v types.Datetime
+		if !isNull {
+			if v, err = types.ParseDatetime(value); err != nil {
+				return err
+			}
+		}
+		col := make([]types.Datetime, rows)
+		for i := range col {
+			col[i] = v
+		}
+		vec.Col = col
+	case types.T_char, types.T_varchar, types.T_json:
+		// the default value of json is kept in its binary encoding
+		v := []byte(value)
+		col := make([][]byte, rows)
+		for i := range col {
+			col[i] = v
+		}
+		return vec.Append(col)
+	default:
+		return errors.New(fmt.Sprintf("unsupported type %s", typ))
+	}
+	return nil
+}
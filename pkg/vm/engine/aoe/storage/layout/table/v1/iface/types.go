@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"io"
 
+	"github.com/RoaringBitmap/roaring"
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	bmgrif "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/buffer/manager/iface"
@@ -69,6 +70,9 @@ type ITableData interface {
 
 	GetIndexHolder() *index.TableHolder
 
+	// GetTombstones to get the rows deleted from the blocks of the table
+	GetTombstones() ITombstones
+
 	// init ReplayIndex and rowCount
 	InitReplay()
 	InitAppender()
@@ -151,6 +155,9 @@ type ISegment interface {
 	GetFsManager() base.IManager
 	GetIndexHolder() *index.SegmentHolder
 
+	// GetTombstones to get the rows deleted from the blocks of the table
+	GetTombstones() ITombstones
+
 	// GetSegmentFile gets the segment file,
 	// the newly created segments are all UNSORTED_SEG
 	GetSegmentFile() base.ISegmentFile
@@ -252,6 +259,58 @@ type IBlock interface {
 	Size(string) uint64
 }
 
+// ITombstones holds the rows deleted from the blocks of a table. The rows
+// of a block are identified by their positions, which change when the block
+// is sorted or merged, so the deleted rows are kept for each block type and
+// remapped when a block is upgraded.
+type ITombstones interface {
+	// Get returns the rows deleted from the block of type typ, it
+	// returns nil if no row of the block is deleted
+	Get(blk uint64, typ base.BlockType) *roaring.Bitmap
+
+	// Count returns the number of rows deleted from the block of type typ
+	Count(blk uint64, typ base.BlockType) uint64
+
+	// Delete marks the rows of the block of type typ as deleted, and returns
+	// the number of rows which were not deleted before. It returns an error
+	// if the block was upgraded and the rows have to be selected again
+	Delete(blk uint64, typ base.BlockType, rows []uint32) (uint64, error)
+
+	// Sort records that the rows of the block of type from are reordered by
+	// idx when it is upgraded to type to, the i-th row of the upgraded block
+	// is the idx[i]-th row of the block, or the rows keep their positions if
+	// idx is nil. It has to be called before the upgraded block file is
+	// committed
+	Sort(blk uint64, from, to base.BlockType, idx []uint32) error
+
+	// Merge records that the rows of the blocks of type from are merged
+	// when they are upgraded to type to, src is the source block of each
+	// merged row in order, or the rows keep their positions if src is nil.
+	// It has to be called before the upgraded segment file is committed
+	Merge(blks []uint64, from, to base.BlockType, src []uint16) error
+
+	// Upgrade switches the deleted rows of the block to the type typ, it
+	// is called before the upgraded block is visible
+	Upgrade(blk uint64, typ base.BlockType)
+
+	// Applied returns true if the mutation logged at index was persisted
+	Applied(index *shard.IndexId) bool
+
+	// Persist persists the deleted rows and the index of the last mutation
+	Persist(index *shard.IndexId) error
+
+	// PersistUpdate persists the deleted rows with the encoded new rows of
+	// the update logged at index. The new rows of the updates logged at or
+	// before safeId are durable, they are dropped
+	PersistUpdate(index *shard.IndexId, rows []byte, safeId uint64) error
+
+	// Pending returns the encoded new rows of the update logged at index
+	// loaded from the persisted tombstones, so the append of them can be
+	// redone after a restart. The rows are returned only once, it returns
+	// nil if they were dropped
+	Pending(index *shard.IndexId) []byte
+}
+
 type IBlockFactory interface {
 	CreateBlock(ISegment, *metadata.Block) (IBlock, error)
 }
@@ -363,6 +363,10 @@ func (seg *segment) CloneWithUpgrade(td iface.ITableData, meta *metadata.Segment
 	return cloned, nil
 }
 
+func (seg *segment) GetTombstones() iface.ITombstones {
+	return seg.host.GetTombstones()
+}
+
 func (seg *segment) UpgradeBlock(meta *metadata.Block) (iface.IBlock, error) {
 	if seg.typ != base.UNSORTED_SEG {
 		panic("logic error")
@@ -46,7 +46,7 @@ func newTBlock(host iface.ISegment, meta *metadata.Block, factory fb.NodeFactory
 		nodeMgr:    factory.GetManager(),
 		coarseSize: make(map[string]uint64),
 	}
-	for i, colDef := range meta.Segment.Table.Schema.ColDefs {
+	for i, colDef := range meta.Segment.GetSchema().ColDefs {
 		blk.coarseSize[colDef.Name] = metadata.EstimateColumnBlockSize(i, meta)
 	}
 	blk.GetObject = func() interface{} { return blk }
@@ -98,12 +98,21 @@ func (blk *tblock) ProcessData(fn func(batch.IBatch) error) error {
 }
 
 func (blk *tblock) Size(attr string) uint64 {
-	return blk.coarseSize[attr]
+	colIdx := blk.meta.Segment.GetColIdx(attr)
+	if colIdx == -1 {
+		return 0
+	}
+	return blk.coarseSize[blk.meta.Segment.GetSchema().ColDefs[colIdx].Name]
 }
 
 func (blk *tblock) CloneWithUpgrade(host iface.ISegment, meta *metadata.Block) (iface.IBlock, error) {
 	defer host.Unref()
-	return newBlock(host, meta)
+	upgraded, err := newBlock(host, meta)
+	if err != nil {
+		return nil, err
+	}
+	host.GetTombstones().Upgrade(meta.Id, upgraded.GetType())
+	return upgraded, nil
 }
 
 func (blk *tblock) String() string {
@@ -117,7 +126,14 @@ func (blk *tblock) GetVectorWrapper(attrid int) (*vector.VectorWrapper, error) {
 
 func (blk *tblock) getVectorCopyFactory(attr string, compressed, deCompressed *bytes.Buffer) func(batch.IBatch) (*gvec.Vector, error) {
 	return func(bat batch.IBatch) (*gvec.Vector, error) {
-		colIdx := blk.meta.Segment.Table.Schema.GetColIdx(attr)
+		colIdx := blk.meta.Segment.GetColIdx(attr)
+		if colIdx == -1 {
+			vec, err := bat.GetVectorByAttr(0)
+			if err != nil {
+				return nil, err
+			}
+			return getAddedColumnVector(blk.meta, attr, vec.GetLatestView().Length())
+		}
 		vec, err := bat.GetVectorByAttr(colIdx)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,492 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
+
+	"github.com/RoaringBitmap/roaring"
+)
+
+var (
+	StaleTombstoneErr = errors.New("stale tombstone error")
+)
+
+// version is the deleted rows of a block of a certain type.
+type version struct {
+	typ  base.BlockType
+	rows *roaring.Bitmap
+}
+
+// location is the position of a row in the upgraded blocks.
+type location struct {
+	blk uint64
+	row uint32
+}
+
+// blockTombstone is the deleted rows of a block. Between the flush of a block
+// and its upgrade, the rows deleted from the current version are also marked
+// in the next version according to moves. After the upgrade, the previous
+// version is kept for the readers which still hold the old block.
+type blockTombstone struct {
+	prev, cur, next *version
+	moves           []location
+}
+
+// pendingRows is the encoded new rows of an update, which are kept until
+// the append of them is durable.
+type pendingRows struct {
+	index shard.IndexId
+	rows  []byte
+	// loaded is true if the rows were loaded and not returned by Pending yet
+	loaded bool
+}
+
+type tombstones struct {
+	sync.RWMutex
+	dir     string
+	tableId uint64
+	blocks  map[uint64]*blockTombstone
+	// index of the last mutation persisted
+	index   shard.IndexId
+	applied bool
+	pending []pendingRows
+}
+
+func newTombstones(dir string, tableId uint64) *tombstones {
+	return &tombstones{
+		dir:     dir,
+		tableId: tableId,
+		blocks:  make(map[uint64]*blockTombstone),
+	}
+}
+
+func newVersion(typ base.BlockType) *version {
+	return &version{typ: typ, rows: roaring.New()}
+}
+
+func (v *version) clone(typ base.BlockType) *version {
+	return &version{typ: typ, rows: v.rows.Clone()}
+}
+
+// lookup returns the version of typ of the block
+func (bt *blockTombstone) lookup(typ base.BlockType) *version {
+	for _, v := range []*version{bt.cur, bt.next, bt.prev} {
+		if v != nil && v.typ == typ {
+			return v
+		}
+	}
+	return nil
+}
+
+// getOrCreate returns the tombstone of blk, a block without tombstone has
+// not been upgraded since the table was loaded, so typ is its current type.
+func (ts *tombstones) getOrCreate(blk uint64, typ base.BlockType) *blockTombstone {
+	bt, ok := ts.blocks[blk]
+	if !ok {
+		bt = &blockTombstone{cur: newVersion(typ)}
+		ts.blocks[blk] = bt
+	}
+	return bt
+}
+
+func (ts *tombstones) Get(blk uint64, typ base.BlockType) *roaring.Bitmap {
+	ts.RLock()
+	defer ts.RUnlock()
+	bt, ok := ts.blocks[blk]
+	if !ok {
+		return nil
+	}
+	v := bt.lookup(typ)
+	if v == nil || v.rows.IsEmpty() {
+		return nil
+	}
+	return v.rows.Clone()
+}
+
+func (ts *tombstones) Count(blk uint64, typ base.BlockType) uint64 {
+	ts.RLock()
+	defer ts.RUnlock()
+	bt, ok := ts.blocks[blk]
+	if !ok {
+		return 0
+	}
+	if v := bt.lookup(typ); v != nil {
+		return v.rows.GetCardinality()
+	}
+	return 0
+}
+
+func (ts *tombstones) Delete(blk uint64, typ base.BlockType, rows []uint32) (uint64, error) {
+	ts.Lock()
+	defer ts.Unlock()
+	bt := ts.getOrCreate(blk, typ)
+	if bt.cur.typ != typ {
+		return 0, StaleTombstoneErr
+	}
+	var cnt uint64
+	for _, row := range rows {
+		if !bt.cur.rows.CheckedAdd(row) {
+			continue
+		}
+		cnt++
+		if bt.next == nil {
+			continue
+		}
+		// the rows keep their positions without moves
+		if bt.moves == nil {
+			bt.next.rows.Add(row)
+			continue
+		}
+		if int(row) >= len(bt.moves) {
+			continue
+		}
+		loc := bt.moves[row]
+		ts.blocks[loc.blk].next.rows.Add(loc.row)
+	}
+	return cnt, nil
+}
+
+func (ts *tombstones) Sort(blk uint64, from, to base.BlockType, idx []uint32) error {
+	ts.Lock()
+	defer ts.Unlock()
+	bt := ts.getOrCreate(blk, from)
+	if bt.cur.typ != from {
+		return StaleTombstoneErr
+	}
+	if idx == nil {
+		bt.next, bt.moves = bt.cur.clone(to), nil
+		return ts.persistIfDeleted(bt)
+	}
+	bt.next = newVersion(to)
+	bt.moves = make([]location, len(idx))
+	for i, row := range idx {
+		bt.moves[row] = location{blk: blk, row: uint32(i)}
+	}
+	itr := bt.cur.rows.Iterator()
+	for itr.HasNext() {
+		bt.next.rows.Add(bt.moves[itr.Next()].row)
+	}
+	return ts.persistIfDeleted(bt)
+}
+
+func (ts *tombstones) Merge(blks []uint64, from, to base.BlockType, src []uint16) error {
+	ts.Lock()
+	defer ts.Unlock()
+	if len(blks) == 0 {
+		return nil
+	}
+	bts := make([]*blockTombstone, len(blks))
+	for i, blk := range blks {
+		if bts[i] = ts.getOrCreate(blk, from); bts[i].cur.typ != from {
+			return StaleTombstoneErr
+		}
+	}
+	if src == nil {
+		for _, bt := range bts {
+			bt.next, bt.moves = bt.cur.clone(to), nil
+		}
+		return ts.persistIfDeleted(bts...)
+	}
+	n := len(src) / len(blks)
+	cursors := make([]uint32, len(blks))
+	for _, bt := range bts {
+		bt.next = newVersion(to)
+		bt.moves = make([]location, n)
+	}
+	for k, i := range src {
+		row := cursors[i]
+		cursors[i]++
+		loc := location{blk: blks[k/n], row: uint32(k % n)}
+		bts[i].moves[row] = loc
+		if bts[i].cur.rows.Contains(row) {
+			bts[k/n].next.rows.Add(loc.row)
+		}
+	}
+	return ts.persistIfDeleted(bts...)
+}
+
+func (ts *tombstones) Upgrade(blk uint64, typ base.BlockType) {
+	ts.Lock()
+	defer ts.Unlock()
+	bt, ok := ts.blocks[blk]
+	switch {
+	case !ok:
+		ts.blocks[blk] = &blockTombstone{cur: newVersion(typ)}
+	case bt.next != nil && bt.next.typ == typ:
+		bt.prev, bt.cur, bt.next, bt.moves = bt.cur, bt.next, nil, nil
+	case bt.cur.typ != typ:
+		// the rows are not reordered by the upgrade
+		bt.prev, bt.cur = bt.cur, bt.cur.clone(typ)
+	}
+}
+
+func (ts *tombstones) Applied(index *shard.IndexId) bool {
+	ts.RLock()
+	defer ts.RUnlock()
+	return ts.applied && index.Compare(&ts.index) <= 0
+}
+
+func (ts *tombstones) Persist(index *shard.IndexId) error {
+	ts.Lock()
+	defer ts.Unlock()
+	ts.index, ts.applied = *index, true
+	return ts.persist()
+}
+
+func (ts *tombstones) PersistUpdate(index *shard.IndexId, rows []byte, safeId uint64) error {
+	ts.Lock()
+	defer ts.Unlock()
+	pending := ts.pending[:0]
+	for _, p := range ts.pending {
+		if p.index.Id > safeId {
+			pending = append(pending, p)
+		}
+	}
+	ts.pending = append(pending, pendingRows{index: *index, rows: rows})
+	ts.index, ts.applied = *index, true
+	return ts.persist()
+}
+
+func (ts *tombstones) Pending(index *shard.IndexId) []byte {
+	ts.Lock()
+	defer ts.Unlock()
+	for i, p := range ts.pending {
+		if p.loaded && p.index.Compare(index) == 0 {
+			ts.pending[i].loaded = false
+			return p.rows
+		}
+	}
+	return nil
+}
+
+// persistIfDeleted persists the tombstones if rows were remapped to the next
+// version of bts. It is called before the file of the upgraded blocks is
+// committed, so the remapped rows are found whichever block file is loaded
+// after a restart.
+func (ts *tombstones) persistIfDeleted(bts ...*blockTombstone) error {
+	for _, bt := range bts {
+		if !bt.next.rows.IsEmpty() {
+			return ts.persist()
+		}
+	}
+	return nil
+}
+
+// persist writes the tombstones into a temp file which then replaces the
+// tombstone file of the table. The layout of the file is:
+//  index id | index offset | applied | block count | [block id | version count | [type | length | bitmap]...]... |
+//  pending count | [index id | index offset | length | rows]...
+func (ts *tombstones) persist() error {
+	tmpName := common.MakeTombstoneFileName(ts.dir, ts.tableId, true)
+	f, err := os.Create(tmpName)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err = ts.writeTo(w); err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	f.Close()
+	if err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	name, err := common.FilenameFromTmpfile(tmpName)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpName, name)
+}
+
+func (ts *tombstones) writeTo(w io.Writer) error {
+	var applied uint8
+	if ts.applied {
+		applied = 1
+	}
+	if err := binary.Write(w, binary.BigEndian, ts.index.Id); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.BigEndian, ts.index.Offset); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.BigEndian, applied); err != nil {
+		return err
+	}
+	// only the versions with deleted rows are written
+	blks := make(map[uint64][]*version)
+	for blk, bt := range ts.blocks {
+		for _, v := range []*version{bt.prev, bt.cur, bt.next} {
+			if v != nil && !v.rows.IsEmpty() {
+				blks[blk] = append(blks[blk], v)
+			}
+		}
+	}
+	if err := binary.Write(w, binary.BigEndian, uint32(len(blks))); err != nil {
+		return err
+	}
+	for blk, vs := range blks {
+		if err := binary.Write(w, binary.BigEndian, blk); err != nil {
+			return err
+		}
+		if err := binary.Write(w, binary.BigEndian, uint8(len(vs))); err != nil {
+			return err
+		}
+		for _, v := range vs {
+			data, err := v.rows.ToBytes()
+			if err != nil {
+				return err
+			}
+			if err = binary.Write(w, binary.BigEndian, uint8(v.typ)); err != nil {
+				return err
+			}
+			if err = binary.Write(w, binary.BigEndian, uint32(len(data))); err != nil {
+				return err
+			}
+			if _, err = w.Write(data); err != nil {
+				return err
+			}
+		}
+	}
+	if err := binary.Write(w, binary.BigEndian, uint32(len(ts.pending))); err != nil {
+		return err
+	}
+	for _, p := range ts.pending {
+		if err := binary.Write(w, binary.BigEndian, p.index.Id); err != nil {
+			return err
+		}
+		if err := binary.Write(w, binary.BigEndian, p.index.Offset); err != nil {
+			return err
+		}
+		if err := binary.Write(w, binary.BigEndian, uint32(len(p.rows))); err != nil {
+			return err
+		}
+		if _, err := w.Write(p.rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// load reads the tombstone file of the table, types maps the id of each
+// loaded block to its type, only the version of that type is kept. The
+// blocks not loaded are replayed as transient blocks.
+func (ts *tombstones) load(types map[uint64]base.BlockType) error {
+	name := common.MakeTombstoneFileName(ts.dir, ts.tableId, false)
+	f, err := os.Open(name)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	var applied uint8
+	var cnt uint32
+	if err = binary.Read(r, binary.BigEndian, &ts.index.Id); err != nil {
+		return err
+	}
+	if err = binary.Read(r, binary.BigEndian, &ts.index.Offset); err != nil {
+		return err
+	}
+	if err = binary.Read(r, binary.BigEndian, &applied); err != nil {
+		return err
+	}
+	ts.applied = applied == 1
+	if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
+		return err
+	}
+	for i := uint32(0); i < cnt; i++ {
+		var blk uint64
+		var vcnt uint8
+		if err = binary.Read(r, binary.BigEndian, &blk); err != nil {
+			return err
+		}
+		if err = binary.Read(r, binary.BigEndian, &vcnt); err != nil {
+			return err
+		}
+		typ, ok := types[blk]
+		if !ok {
+			typ = base.TRANSIENT_BLK
+		}
+		for j := uint8(0); j < vcnt; j++ {
+			var vtyp uint8
+			var length uint32
+			if err = binary.Read(r, binary.BigEndian, &vtyp); err != nil {
+				return err
+			}
+			if err = binary.Read(r, binary.BigEndian, &length); err != nil {
+				return err
+			}
+			data := make([]byte, length)
+			if _, err = io.ReadFull(r, data); err != nil {
+				return err
+			}
+			if base.BlockType(vtyp) != typ {
+				continue
+			}
+			v := newVersion(typ)
+			if err = v.rows.UnmarshalBinary(data); err != nil {
+				return err
+			}
+			ts.blocks[blk] = &blockTombstone{cur: v}
+		}
+	}
+	if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
+		return err
+	}
+	for i := uint32(0); i < cnt; i++ {
+		p := pendingRows{loaded: true}
+		var length uint32
+		if err = binary.Read(r, binary.BigEndian, &p.index.Id); err != nil {
+			return err
+		}
+		if err = binary.Read(r, binary.BigEndian, &p.index.Offset); err != nil {
+			return err
+		}
+		if err = binary.Read(r, binary.BigEndian, &length); err != nil {
+			return err
+		}
+		p.rows = make([]byte, length)
+		if _, err = io.ReadFull(r, p.rows); err != nil {
+			return err
+		}
+		ts.pending = append(ts.pending, p)
+	}
+	logutil.Infof("Table %d | Tombstones | Loaded %d blocks", ts.tableId, len(ts.blocks))
+	return nil
+}
+
+// RemoveTombstones removes the tombstone file of a dropped table.
+func RemoveTombstones(dir string, tableId uint64) {
+	name := common.MakeTombstoneFileName(dir, tableId, false)
+	if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+		logutil.Warnf("%s | Remove tombstones failed: %s", name, err)
+	}
+}
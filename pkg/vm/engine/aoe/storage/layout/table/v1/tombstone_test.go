@@ -0,0 +1,118 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"os"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal/shard"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTombstones(t *testing.T) {
+	dir := testutils.InitTestEnv(moduleName, t)
+	err := os.MkdirAll(common.MakeDataDir(dir), os.FileMode(0755))
+	assert.Nil(t, err)
+	tableId := uint64(1)
+	ts := newTombstones(dir, tableId)
+
+	index := func(id uint64) *shard.IndexId {
+		idx := shard.SimpleIndexId(id)
+		return &idx
+	}
+
+	// restart loads the tombstones with the block types of types
+	restart := func(types map[uint64]base.BlockType) *tombstones {
+		loaded := newTombstones(dir, tableId)
+		assert.Nil(t, loaded.load(types))
+		return loaded
+	}
+
+	// block 1 is sorted in reverse order when it is flushed
+	_, err = ts.Delete(1, base.TRANSIENT_BLK, []uint32{0, 1})
+	assert.Nil(t, err)
+	assert.Nil(t, ts.Persist(index(1)))
+	err = ts.Sort(1, base.TRANSIENT_BLK, base.PERSISTENT_BLK, []uint32{3, 2, 1, 0})
+	assert.Nil(t, err)
+	_, err = ts.Delete(1, base.TRANSIENT_BLK, []uint32{2})
+	assert.Nil(t, err)
+	assert.Nil(t, ts.Persist(index(2)))
+	assert.Equal(t, []uint32{1, 2, 3}, ts.Get(1, base.PERSISTENT_BLK).ToArray())
+
+	// the block file is committed but the block is not upgraded
+	loaded := restart(map[uint64]base.BlockType{1: base.PERSISTENT_BLK})
+	assert.Equal(t, []uint32{1, 2, 3}, loaded.Get(1, base.PERSISTENT_BLK).ToArray())
+	assert.True(t, loaded.Applied(index(2)))
+	assert.False(t, loaded.Applied(index(3)))
+	// the block file is not committed
+	loaded = restart(map[uint64]base.BlockType{})
+	assert.Equal(t, []uint32{0, 1, 2}, loaded.Get(1, base.TRANSIENT_BLK).ToArray())
+
+	ts.Upgrade(1, base.PERSISTENT_BLK)
+	_, err = ts.Delete(1, base.TRANSIENT_BLK, []uint32{3})
+	assert.Equal(t, StaleTombstoneErr, err)
+
+	// block 1 and 2 keep their rows when the segment is flushed
+	_, err = ts.Delete(2, base.PERSISTENT_BLK, []uint32{0})
+	assert.Nil(t, err)
+	err = ts.Merge([]uint64{1, 2}, base.PERSISTENT_BLK, base.PERSISTENT_SORTED_BLK, nil)
+	assert.Nil(t, err)
+	_, err = ts.Delete(2, base.PERSISTENT_BLK, []uint32{1})
+	assert.Nil(t, err)
+	assert.Nil(t, ts.Persist(index(3)))
+
+	// the segment file is committed but the blocks are not upgraded
+	loaded = restart(map[uint64]base.BlockType{
+		1: base.PERSISTENT_SORTED_BLK,
+		2: base.PERSISTENT_SORTED_BLK,
+	})
+	assert.Equal(t, []uint32{1, 2, 3}, loaded.Get(1, base.PERSISTENT_SORTED_BLK).ToArray())
+	assert.Equal(t, []uint32{0, 1}, loaded.Get(2, base.PERSISTENT_SORTED_BLK).ToArray())
+	assert.Equal(t, uint64(2), loaded.Count(2, base.PERSISTENT_SORTED_BLK))
+
+	ts.Upgrade(1, base.PERSISTENT_SORTED_BLK)
+	ts.Upgrade(2, base.PERSISTENT_SORTED_BLK)
+	assert.Equal(t, []uint32{0, 1}, ts.Get(2, base.PERSISTENT_SORTED_BLK).ToArray())
+	// the readers holding the previous block still see its deleted rows
+	assert.Equal(t, []uint32{0, 1}, ts.Get(2, base.PERSISTENT_BLK).ToArray())
+
+	// the new rows of an update are kept until the append of them is durable
+	first, second := shard.CreateIndexId(4, 0, 2), shard.CreateIndexId(4, 1, 2)
+	_, err = ts.Delete(1, base.PERSISTENT_SORTED_BLK, []uint32{0})
+	assert.Nil(t, err)
+	assert.Nil(t, ts.PersistUpdate(&first, []byte("rows of 4"), 3))
+	loaded = restart(map[uint64]base.BlockType{
+		1: base.PERSISTENT_SORTED_BLK,
+		2: base.PERSISTENT_SORTED_BLK,
+	})
+	assert.True(t, loaded.Applied(&first))
+	assert.False(t, loaded.Applied(&second))
+	assert.Equal(t, []byte("rows of 4"), loaded.Pending(&first))
+	assert.Nil(t, loaded.Pending(&first))
+	assert.Nil(t, loaded.Pending(&second))
+	// the rows of the current run are not pending
+	assert.Nil(t, ts.Pending(&first))
+	assert.Equal(t, []uint32{0, 1, 2, 3}, loaded.Get(1, base.PERSISTENT_SORTED_BLK).ToArray())
+
+	assert.Nil(t, ts.PersistUpdate(index(5), []byte("rows of 5"), 4))
+	loaded = restart(nil)
+	assert.Nil(t, loaded.Pending(&first))
+	assert.Equal(t, []byte("rows of 5"), loaded.Pending(index(5)))
+}
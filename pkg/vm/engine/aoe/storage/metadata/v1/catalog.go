@@ -633,7 +633,9 @@ func (catalog *Catalog) prepareSplit(ctx *splitDBCtx) (LogEntry, error) {
 	}
 	for _, spec := range ctx.spec.Specs {
 		table := ctx.spec.splitted[spec.Index]
-		table.Splite(catalog, ctx.tranId, spec, ctx.renameTable, dbs)
+		if err := table.Splite(catalog, ctx.tranId, spec, ctx.renameTable, dbs); err != nil {
+			return nil, err
+		}
 	}
 	rCtx := new(addReplaceCommitCtx)
 	rCtx.tranId = ctx.tranId
@@ -696,10 +698,23 @@ func (catalog *Catalog) onReplayTableOperation(entry *tableLogEntry) error {
 	return tbl.onCommit(entry.CommitInfo)
 }
 
+func (catalog *Catalog) onReplayAlterSchema(entry *tableLogEntry) error {
+	db := catalog.Databases[entry.DatabaseId]
+	tbl := db.TableSet[entry.Id]
+	if err := tbl.onCommit(entry.CommitInfo); err != nil {
+		return err
+	}
+	tbl.onNewSchema(entry.Schema)
+	return nil
+}
+
 func (catalog *Catalog) onReplayCreateSegment(entry *segmentLogEntry) error {
 	db := catalog.Databases[entry.DatabaseId]
 	tbl := db.TableSet[entry.TableId]
-	seg := newCommittedSegmentEntry(tbl, entry.BaseEntry)
+	seg, err := newCommittedSegmentEntry(tbl, entry.BaseEntry, entry.SchemaVersion)
+	if err != nil {
+		return err
+	}
 	tbl.onNewSegment(seg)
 	return nil
 }
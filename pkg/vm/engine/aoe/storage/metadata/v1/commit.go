@@ -55,10 +55,14 @@ func (p *commitPipeline) prepare(ctx interface{}) (LogEntry, error) {
 		return v.table.prepareAddIndice(v)
 	case *dropIndiceCtx:
 		return v.table.prepareDropIndice(v)
+	case *alterSchemaCtx:
+		return v.table.prepareAlterSchema(v)
 	case *createSegmentCtx:
 		return v.table.prepareCreateSegment(v)
 	case *upgradeSegmentCtx:
 		return v.segment.prepareUpgrade(v)
+	case *segmentStatsCtx:
+		return v.segment.prepareUpdateStats(v)
 	case *createBlockCtx:
 		return v.segment.prepareCreateBlock(v)
 	case *upgradeBlockCtx:
@@ -14,6 +14,8 @@
 
 package metadata
 
+import "github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
+
 type writeCtx struct {
 	exIndex *LogIndex
 	tranId  uint64
@@ -77,6 +79,12 @@ type dropIndiceCtx struct {
 	names []string
 }
 
+type alterSchemaCtx struct {
+	writeCtx
+	table  *Table
+	schema *Schema
+}
+
 type createSegmentCtx struct {
 	writeCtx
 	segment *Segment
@@ -90,6 +98,12 @@ type upgradeSegmentCtx struct {
 	size     int64
 }
 
+type segmentStatsCtx struct {
+	writeCtx
+	segment *Segment
+	stats   map[uint64]*stats.ColumnStats
+}
+
 type createBlockCtx struct {
 	writeCtx
 	segment *Segment
@@ -915,7 +915,9 @@ func (db *Database) rebuild(stats, replay bool) error {
 		if replay {
 			db.Catalog.TryUpdateTableId(table.Id)
 		}
-		table.rebuild(db, replay)
+		if err := table.rebuild(db, replay); err != nil {
+			return err
+		}
 		if stats {
 			db.AddSize(table.GetCoarseSize())
 			db.AddCount(table.GetCoarseCount())
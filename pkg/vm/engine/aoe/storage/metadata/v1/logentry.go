@@ -35,6 +35,7 @@ const (
 	ETHardDeleteTable
 	ETAddIndice
 	ETDropIndice
+	ETAlterSchema
 	ETCreateSegment
 	ETUpgradeSegment
 	ETDropSegment
@@ -455,6 +455,45 @@ func TestSegment(t *testing.T) {
 	wg.Wait()
 }
 
+func TestSchemaVersion(t *testing.T) {
+	dir := initTestEnv(t)
+	cfg := new(CatalogCfg)
+	cfg.Dir = dir
+	cfg.BlockMaxRows, cfg.SegmentMaxBlocks = uint64(100), uint64(4)
+	catalog, err := OpenCatalog(new(sync.RWMutex), cfg)
+	assert.Nil(t, err)
+	catalog.Start()
+
+	gen := shard.NewMockIndexAllocator()
+	idx := gen.Next(0)
+	db, err := catalog.SimpleCreateDatabase("db1", idx)
+	assert.Nil(t, err)
+	tbl, err := db.SimpleCreateTable(MockSchema(2), nil, gen.Next(db.GetShardId()))
+	assert.Nil(t, err)
+	seg0 := tbl.SimpleCreateSegment()
+
+	err = tbl.SimpleAlterSchema(MockSchema(3), gen.Next(db.GetShardId()))
+	assert.Nil(t, err)
+	seg1 := tbl.SimpleCreateSegment()
+	assert.Equal(t, uint32(0), seg0.GetSchema().Version)
+	assert.Equal(t, 2, len(seg0.GetSchema().ColDefs))
+	assert.Equal(t, uint32(1), seg1.GetSchema().Version)
+	assert.Equal(t, 3, len(seg1.GetSchema().ColDefs))
+
+	_, err = tbl.GetSchema(2)
+	assert.NotNil(t, err)
+	catalog.Close()
+
+	catalog, err = OpenCatalog(new(sync.RWMutex), cfg)
+	assert.Nil(t, err)
+	catalog.Start()
+	defer catalog.Close()
+	tbl, err = catalog.SimpleGetTableByName("db1", tbl.Schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tbl.SimpleGetSegment(seg0.Id).GetSchema().ColDefs))
+	assert.Equal(t, 3, len(tbl.SimpleGetSegment(seg1.Id).GetSchema().ColDefs))
+}
+
 func TestBlock(t *testing.T) {
 	dir := initTestEnv(t)
 	cfg := new(CatalogCfg)
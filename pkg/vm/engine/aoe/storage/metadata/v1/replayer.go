@@ -160,6 +160,8 @@ func (cache *replayCache) applyReplayEntry(entry *replayEntry, catalog *Catalog,
 		err = catalog.onReplayCreateTable(entry.tblEntry)
 	case ETAddIndice, ETDropIndice, ETSoftDeleteTable, ETHardDeleteTable:
 		err = catalog.onReplayTableOperation(entry.tblEntry)
+	case ETAlterSchema:
+		err = catalog.onReplayAlterSchema(entry.tblEntry)
 	case ETCreateSegment:
 		catalog.Sequence.TryUpdateSegmentId(entry.segEntry.Id)
 		err = catalog.onReplayCreateSegment(entry.segEntry)
@@ -417,7 +419,7 @@ func (replayer *catalogReplayer) onReplayEntry(entry LogEntry, observer logstore
 			tblEntry: tbl,
 			commitId: GetCommitIdFromLogEntry(entry),
 		})
-	case ETAddIndice, ETDropIndice, ETSoftDeleteTable, ETHardDeleteTable:
+	case ETAddIndice, ETDropIndice, ETAlterSchema, ETSoftDeleteTable, ETHardDeleteTable:
 		tbl := &tableLogEntry{}
 		tbl.Unmarshal(entry.GetPayload())
 		replayer.cache.Append(&replayEntry{
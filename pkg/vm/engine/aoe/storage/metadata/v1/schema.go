@@ -53,6 +53,17 @@ type ColDef struct {
 	Name string     `json:"name"`
 	Idx  int        `json:"idx"`
 	Type types.Type `json:"type"`
+	// Id identifies the column across the versions of a schema. It is kept
+	// by a renamed column and never reused after the column is dropped
+	Id uint64 `json:"id"`
+	// Default is the value of the column for the rows written before the
+	// column was added
+	Default *DefaultValue `json:"default,omitempty"`
+}
+
+type DefaultValue struct {
+	Value  string `json:"value"`
+	IsNull bool   `json:"null"`
 }
 
 type IndexSchema struct {
@@ -166,6 +177,7 @@ type Schema struct {
 	BlockMaxRows     uint64         `json:"blkrows"`
 	PrimaryKey       int            `json:"primarykey"`
 	SegmentMaxBlocks uint64         `json:"segblocks"`
+	Version          uint32         `json:"version"`
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -181,6 +193,7 @@ func (s *Schema) AppendCol(name string, typ types.Type) {
 		Name: name,
 		Type: typ,
 		Idx:  len(s.ColDefs),
+		Id:   uint64(len(s.ColDefs)),
 	}
 	s.ColDefs = append(s.ColDefs, colDef)
 	s.NameIndex[name] = colDef.Idx
@@ -208,6 +221,7 @@ func (s *Schema) Valid() bool {
 	}
 
 	names := make(map[string]bool)
+	ids := make(map[uint64]bool)
 	for idx, colDef := range s.ColDefs {
 		if idx != colDef.Idx {
 			return false
@@ -217,6 +231,10 @@ func (s *Schema) Valid() bool {
 			return false
 		}
 		names[colDef.Name] = true
+		if _, ok = ids[colDef.Id]; ok {
+			return false
+		}
+		ids[colDef.Id] = true
 	}
 	return true
 }
@@ -231,6 +249,28 @@ func (s *Schema) GetColIdx(attr string) int {
 	return idx
 }
 
+// GetColIdxById returns column index for the given column id
+// if found, otherwise returns -1.
+func (s *Schema) GetColIdxById(id uint64) int {
+	for idx, colDef := range s.ColDefs {
+		if colDef.Id == id {
+			return idx
+		}
+	}
+	return -1
+}
+
+// MaxColId returns the max column id of the schema
+func (s *Schema) MaxColId() uint64 {
+	var id uint64
+	for _, colDef := range s.ColDefs {
+		if colDef.Id > id {
+			id = colDef.Id
+		}
+	}
+	return id
+}
+
 func MockSchema(colCnt int) *Schema {
 	rand.Seed(time.Now().UnixNano())
 	schema := NewEmptySchema(fmt.Sprintf("%d", rand.Intn(1000000)))
@@ -21,6 +21,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
 )
 
 var (
@@ -30,9 +31,10 @@ var (
 
 type segmentLogEntry struct {
 	*BaseEntry
-	DatabaseId uint64
-	TableId    uint64
-	Catalog    *Catalog `json:"-"`
+	DatabaseId    uint64
+	TableId       uint64
+	SchemaVersion uint32
+	Catalog       *Catalog `json:"-"`
 }
 
 func (e *segmentLogEntry) Marshal() ([]byte, error) {
@@ -48,13 +50,24 @@ type Segment struct {
 	Table    *Table         `json:"-"`
 	IdIndex  map[uint64]int `json:"-"`
 	BlockSet []*Block       `json:"blocks"`
+	// SchemaVersion is the version of the table schema the segment is
+	// written under
+	SchemaVersion uint32 `json:"sver"`
+	// stats is the column statistics collected from the data of the
+	// segment, it is persisted once the segment is upgraded
+	stats map[uint64]*stats.ColumnStats
+	// schema is the table schema of SchemaVersion, it is resolved once the
+	// segment is created or rebuilt
+	schema *Schema
 }
 
 func newSegmentEntry(table *Table, tranId uint64, exIndex *LogIndex) *Segment {
 	e := &Segment{
-		Table:    table,
-		BlockSet: make([]*Block, 0),
-		IdIndex:  make(map[uint64]int),
+		Table:         table,
+		BlockSet:      make([]*Block, 0),
+		IdIndex:       make(map[uint64]int),
+		SchemaVersion: table.Schema.Version,
+		schema:        table.Schema,
 		BaseEntry: &BaseEntry{
 			Id: table.Database.Catalog.NextSegmentId(),
 			CommitInfo: &CommitInfo{
@@ -69,14 +82,20 @@ func newSegmentEntry(table *Table, tranId uint64, exIndex *LogIndex) *Segment {
 	return e
 }
 
-func newCommittedSegmentEntry(table *Table, base *BaseEntry) *Segment {
+func newCommittedSegmentEntry(table *Table, base *BaseEntry, version uint32) (*Segment, error) {
+	schema, err := table.GetSchema(version)
+	if err != nil {
+		return nil, err
+	}
 	e := &Segment{
-		Table:     table,
-		BlockSet:  make([]*Block, 0),
-		IdIndex:   make(map[uint64]int),
-		BaseEntry: base,
+		Table:         table,
+		BlockSet:      make([]*Block, 0),
+		IdIndex:       make(map[uint64]int),
+		BaseEntry:     base,
+		SchemaVersion: version,
+		schema:        schema,
 	}
-	return e
+	return e, nil
 }
 
 func (e *Segment) DebugCheckReplayedState() {
@@ -104,8 +123,13 @@ func (e *Segment) LE(o *Segment) bool {
 	return e.Id <= o.Id
 }
 
-func (e *Segment) rebuild(table *Table, replay bool) {
+func (e *Segment) rebuild(table *Table, replay bool) error {
+	schema, err := table.GetSchema(e.SchemaVersion)
+	if err != nil {
+		return err
+	}
 	e.Table = table
+	e.schema = schema
 	e.IdIndex = make(map[uint64]int)
 	for i, blk := range e.BlockSet {
 		if replay {
@@ -114,6 +138,7 @@ func (e *Segment) rebuild(table *Table, replay bool) {
 		blk.rebuild(e)
 		e.IdIndex[blk.Id] = i
 	}
+	return nil
 }
 
 // Safe
@@ -131,8 +156,10 @@ func (e *Segment) fillView(filter *Filter) *Segment {
 		return nil
 	}
 	view := &Segment{
-		BaseEntry: baseEntry,
-		BlockSet:  make([]*Block, 0),
+		BaseEntry:     baseEntry,
+		BlockSet:      make([]*Block, 0),
+		SchemaVersion: e.SchemaVersion,
+		schema:        e.schema,
 	}
 	e.RLock()
 	blks := make([]*Block, 0, len(e.BlockSet))
@@ -159,10 +186,28 @@ func (e *Segment) Marshal() ([]byte, error) {
 
 func (e *Segment) toLogEntry() *segmentLogEntry {
 	return &segmentLogEntry{
-		BaseEntry:  e.BaseEntry,
-		TableId:    e.Table.Id,
-		DatabaseId: e.Table.Database.Id,
+		BaseEntry:     e.BaseEntry,
+		TableId:       e.Table.Id,
+		DatabaseId:    e.Table.Database.Id,
+		SchemaVersion: e.SchemaVersion,
+	}
+}
+
+// GetSchema returns the table schema the segment is written under
+func (e *Segment) GetSchema() *Schema {
+	return e.schema
+}
+
+// GetColIdx returns the column index in the segment schema for the given
+// column name of the current table schema. It returns -1 if the column
+// is not found in the table or it is added after the segment is created.
+func (e *Segment) GetColIdx(attr string) int {
+	schema := e.Table.Schema
+	idx := schema.GetColIdx(attr)
+	if idx == -1 || schema.Version == e.SchemaVersion {
+		return idx
 	}
+	return e.GetSchema().GetColIdxById(schema.ColDefs[idx].Id)
 }
 
 func (e *Segment) Unmarshal(buf []byte) error {
@@ -234,6 +279,9 @@ func (e *Segment) SimpleCreateBlock() *Block {
 }
 
 func (e *Segment) AppendableLocked() bool {
+	if e.SchemaVersion != e.Table.Schema.Version {
+		return false
+	}
 	if e.HasMaxBlocks() {
 		return !e.BlockSet[len(e.BlockSet)-1].IsFull()
 	}
@@ -403,6 +451,7 @@ func (e *Segment) prepareUpgrade(ctx *upgradeSegmentCtx) (LogEntry, error) {
 	if ctx.exIndice != nil {
 		cInfo.LogIndex = ctx.exIndice[0]
 	}
+	cInfo.Stats = e.stats
 	if err := e.onCommit(cInfo); err != nil {
 		return nil, err
 	}
@@ -410,6 +459,72 @@ func (e *Segment) prepareUpgrade(ctx *upgradeSegmentCtx) (LogEntry, error) {
 	return logEntry, nil
 }
 
+// SetStats sets the column statistics of the segment keyed by the column
+// ids. The statistics set before the segment is upgraded are persisted
+// with the upgrade.
+func (e *Segment) SetStats(s map[uint64]*stats.ColumnStats) {
+	e.Lock()
+	defer e.Unlock()
+	e.stats = s
+}
+
+// Safe
+// SimpleUpdateStats replaces the column statistics of the segment. The
+// statistics of a sorted segment are persisted by a commit of their own,
+// the others are persisted with the upgrade.
+func (e *Segment) SimpleUpdateStats(s map[uint64]*stats.ColumnStats) error {
+	e.RLock()
+	sorted := e.IsSortedLocked()
+	e.RUnlock()
+	if !sorted {
+		e.SetStats(s)
+		return nil
+	}
+	ctx := &segmentStatsCtx{
+		writeCtx: writeCtx{
+			tranId: e.Table.Database.Catalog.NextUncommitId(),
+		},
+		segment: e,
+		stats:   s,
+	}
+	return e.Table.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+func (e *Segment) prepareUpdateStats(ctx *segmentStatsCtx) (LogEntry, error) {
+	e.Lock()
+	defer e.Unlock()
+	cInfo := &CommitInfo{
+		TranId:   ctx.tranId,
+		CommitId: ctx.tranId,
+		Op:       e.CommitInfo.Op,
+		Size:     e.CommitInfo.Size,
+		Stats:    ctx.stats,
+		SSLLNode: *common.NewSSLLNode(),
+	}
+	if err := e.onCommit(cInfo); err != nil {
+		return nil, err
+	}
+	e.stats = ctx.stats
+	logEntry := e.Table.Database.Catalog.prepareCommitEntry(e, ETUpgradeSegment, e)
+	return logEntry, nil
+}
+
+// GetStats returns the column statistics of the segment keyed by the
+// column ids, or nil if they are not collected yet.
+func (e *Segment) GetStats() map[uint64]*stats.ColumnStats {
+	e.RLock()
+	defer e.RUnlock()
+	if e.stats != nil {
+		return e.stats
+	}
+	var found map[uint64]*stats.ColumnStats
+	e.ForEachCommitLocked(func(info *CommitInfo) bool {
+		found = info.Stats
+		return found == nil
+	})
+	return found
+}
+
 func (e *Segment) DryUpgrade(size int64) {
 	e.CommitInfo.Op = OpUpgradeSorted
 	e.CommitInfo.Size = size
@@ -228,7 +228,9 @@ func (splitter *ShardSplitter) prepareReplaceCtx() error {
 	}
 	for _, spec := range splitter.Spec.Specs {
 		table := splitter.Spec.splitted[spec.Index]
-		table.Splite(splitter.Catalog, splitter.TranId, spec, splitter.RenameTable, dbs)
+		if err := table.Splite(splitter.Catalog, splitter.TranId, spec, splitter.RenameTable, dbs); err != nil {
+			return err
+		}
 	}
 	rCtx := new(addReplaceCommitCtx)
 	rCtx.tranId = splitter.TranId
@@ -29,6 +29,7 @@ import (
 type tableLogEntry struct {
 	Table      *Table
 	DatabaseId uint64
+	Schema     *Schema `json:",omitempty"`
 	*BaseEntry
 }
 
@@ -44,6 +45,7 @@ type Table struct {
 	*BaseEntry
 	IdempotentChecker `json:"-"`
 	Schema            *Schema        `json:"schema"`
+	History           []*Schema      `json:"history,omitempty"`
 	SegmentSet        []*Segment     `json:"segments"`
 	IdIndex           map[uint64]int `json:"-"`
 	Database          *Database      `json:"-"`
@@ -215,6 +217,7 @@ func (e *Table) fillView(filter *Filter) *Table {
 	}
 	view := &Table{
 		Schema:     e.Schema,
+		History:    e.History,
 		BaseEntry:  baseEntry,
 		SegmentSet: make([]*Segment, 0),
 	}
@@ -235,16 +238,19 @@ func (e *Table) fillView(filter *Filter) *Table {
 }
 
 // Not threadsafe, and not needed
-func (e *Table) rebuild(db *Database, replay bool) {
+func (e *Table) rebuild(db *Database, replay bool) error {
 	e.Database = db
 	e.IdIndex = make(map[uint64]int)
 	for i, seg := range e.SegmentSet {
 		if replay {
 			db.Catalog.Sequence.TryUpdateSegmentId(seg.Id)
 		}
-		seg.rebuild(e, replay)
+		if err := seg.rebuild(e, replay); err != nil {
+			return err
+		}
 		e.IdIndex[seg.Id] = i
 	}
+	return nil
 }
 
 // Threadsafe
@@ -435,6 +441,103 @@ func (e *Table) prepareDropIndice(ctx *dropIndiceCtx) (LogEntry, error) {
 	return logEntry, nil
 }
 
+// Simple* wrappes simple usage of wrapped operation
+// It replaces the schema of the table with a new version. The columns are
+// identified by ColDef.Id, and the segments created before keep being read
+// with the schema they are written under.
+func (e *Table) SimpleAlterSchema(schema *Schema, index *LogIndex) error {
+	tranId := e.Database.Catalog.NextUncommitId()
+	ctx := new(alterSchemaCtx)
+	ctx.tranId = tranId
+	ctx.table = e
+	ctx.schema = schema
+	ctx.exIndex = index
+	return e.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+func (e *Table) prepareAlterSchema(ctx *alterSchemaCtx) (LogEntry, error) {
+	cInfo := &CommitInfo{
+		TranId:   ctx.tranId,
+		CommitId: ctx.tranId,
+		LogIndex: ctx.exIndex,
+		Op:       OpAlterSchema,
+		SSLLNode: *common.NewSSLLNode(),
+	}
+	e.Lock()
+	if e.IsDeletedInTxnLocked(ctx.txn) {
+		e.Unlock()
+		return nil, TableNotFoundErr
+	}
+	if !ctx.schema.Valid() {
+		e.Unlock()
+		return nil, InvalidSchemaErr
+	}
+	schema := *ctx.schema
+	schema.Name = e.Schema.Name
+	schema.BlockMaxRows = e.Schema.BlockMaxRows
+	schema.SegmentMaxBlocks = e.Schema.SegmentMaxBlocks
+	schema.Version = e.Schema.Version + 1
+	cInfo.Indice = alterIndice(e.CommitInfo.Indice, e.Schema, &schema)
+	err := e.onCommit(cInfo)
+	if err != nil {
+		e.Unlock()
+		return nil, err
+	}
+	e.onNewSchema(&schema)
+	e.Unlock()
+
+	logEntry := e.Database.Catalog.prepareCommitEntry(e, ETAlterSchema, nil)
+	return logEntry, nil
+}
+
+// alterIndice remaps the columns of the indice from the old schema to the new
+// one. The index on a dropped column is dropped.
+func alterIndice(indice *IndexSchema, prev, schema *Schema) *IndexSchema {
+	altered := NewIndexSchema()
+	if indice == nil {
+		return altered
+	}
+	for _, index := range indice.Indice {
+		cols := make([]uint16, 0, len(index.Columns))
+		for _, col := range index.Columns {
+			idx := schema.GetColIdxById(prev.ColDefs[col].Id)
+			if idx == -1 {
+				break
+			}
+			cols = append(cols, uint16(idx))
+		}
+		if len(cols) != len(index.Columns) {
+			continue
+		}
+		cloned := *index
+		cloned.Columns = cols
+		altered.Indice = append(altered.Indice, &cloned)
+	}
+	return altered
+}
+
+func (e *Table) onNewSchema(schema *Schema) {
+	history := make([]*Schema, 0, len(e.History)+1)
+	history = append(history, e.History...)
+	history = append(history, e.Schema)
+	e.History = history
+	e.Schema = schema
+}
+
+// GetSchema returns the schema of the given version
+func (e *Table) GetSchema(version uint32) (*Schema, error) {
+	schema := e.Schema
+	if schema.Version == version {
+		return schema, nil
+	}
+	for _, prev := range e.History {
+		if prev.Version == version {
+			return prev, nil
+		}
+	}
+	return nil, fmt.Errorf("schema version %d of %s not found", version, e.Repr(false))
+}
+
 // Not safe
 func (e *Table) Marshal() ([]byte, error) {
 	return json.Marshal(e)
@@ -469,6 +572,13 @@ func (e *Table) ToLogEntry(eType LogEntryType) LogEntry {
 			DatabaseId: e.Database.Id,
 		}
 		buf, _ = entry.Marshal()
+	case ETAlterSchema:
+		entry := tableLogEntry{
+			BaseEntry:  e.BaseEntry,
+			DatabaseId: e.Database.Id,
+			Schema:     e.Schema,
+		}
+		buf, _ = entry.Marshal()
 	case ETSoftDeleteTable:
 		if !e.IsSoftDeletedLocked() {
 			panic("logic error")
@@ -642,7 +752,7 @@ func (e *Table) SimpleGetSegment(id uint64) *Segment {
 	return e.GetSegment(id, MinUncommitId)
 }
 
-func (e *Table) Splite(catalog *Catalog, tranId uint64, splitSpec *TableSplitSpec, renameTable RenameTableFactory, dbs map[uint64]*Database) {
+func (e *Table) Splite(catalog *Catalog, tranId uint64, splitSpec *TableSplitSpec, renameTable RenameTableFactory, dbs map[uint64]*Database) error {
 	splitSpec.InitTrace()
 	specs := splitSpec.Specs
 	tables := make([]*Table, len(specs))
@@ -660,6 +770,7 @@ func (e *Table) Splite(catalog *Catalog, tranId uint64, splitSpec *TableSplitSpe
 		schema.Name = renameTable(schema.Name, spec.DBSpec.Name)
 		table := &Table{
 			Schema:     &schema,
+			History:    e.History,
 			BaseEntry:  baseEntry,
 			SegmentSet: make([]*Segment, 0),
 			Database:   db,
@@ -705,7 +816,9 @@ func (e *Table) Splite(catalog *Catalog, tranId uint64, splitSpec *TableSplitSpe
 			splitSpec.BlockTrace[*obid] = nbid
 			logutil.Infof("[Trace] %s -> %s", obid.BlockString(), nbid.BlockString())
 		}
-		segment.rebuild(table, false)
+		if err := segment.rebuild(table, false); err != nil {
+			return err
+		}
 		table.onNewSegment(segment)
 		// table.SegmentSet = append(table.SegmentSet, segment)
 		nsid := &common.ID{
@@ -715,6 +828,7 @@ func (e *Table) Splite(catalog *Catalog, tranId uint64, splitSpec *TableSplitSpe
 		splitSpec.SegmentTrace[*osid] = nsid
 		logutil.Infof("[Trace] %s -> %s", osid.SegmentString(), nsid.SegmentString())
 	}
+	return nil
 }
 
 func (e *Table) GetSegment(id, tranId uint64) *Segment {
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/wal"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
 )
 
 type Wal = wal.ShardAwareWal
@@ -60,6 +61,7 @@ const (
 	OpUpgradeSorted
 	OpAddIndice
 	OpDropIndice
+	OpAlterSchema
 	OpSoftDelete
 	OpReplaced
 	OpHardDelete
@@ -75,6 +77,7 @@ var OpNames = map[OpT]string{
 	OpHardDelete:    "HardDelete",
 	OpAddIndice:     "AddIndice",
 	OpDropIndice:    "DropIndice",
+	OpAlterSchema:   "AlterSchema",
 }
 
 func OpName(op OpT) string {
@@ -125,6 +128,9 @@ type CommitInfo struct {
 	PrevIndex       *LogIndex    `json:"pidx"`
 	LogRange        *LogRange    `json:"range"`
 	Indice          *IndexSchema `json:"indice"`
+	// Stats is the column statistics of an upgraded segment keyed by
+	// the column ids
+	Stats map[uint64]*stats.ColumnStats `json:"stats,omitempty"`
 }
 
 func (info *CommitInfo) Clone() *CommitInfo {
@@ -324,17 +330,17 @@ func (s *Sequence) TryUpdateIndexId(id uint64) bool {
 }
 
 func EstimateColumnBlockSize(colIdx int, meta *Block) uint64 {
-	switch meta.Segment.Table.Schema.ColDefs[colIdx].Type.Oid {
+	switch meta.Segment.GetSchema().ColDefs[colIdx].Type.Oid {
 	case types.T_json, types.T_char, types.T_varchar:
 		return meta.Segment.Table.Schema.BlockMaxRows * 2 * 4
 	default:
-		return meta.Segment.Table.Schema.BlockMaxRows * uint64(meta.Segment.Table.Schema.ColDefs[colIdx].Type.Size)
+		return meta.Segment.Table.Schema.BlockMaxRows * uint64(meta.Segment.GetSchema().ColDefs[colIdx].Type.Size)
 	}
 }
 
 func EstimateBlockSize(meta *Block) uint64 {
 	size := uint64(0)
-	for colIdx, _ := range meta.Segment.Table.Schema.ColDefs {
+	for colIdx, _ := range meta.Segment.GetSchema().ColDefs {
 		size += EstimateColumnBlockSize(colIdx, meta)
 	}
 	return size
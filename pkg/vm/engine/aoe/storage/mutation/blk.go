@@ -81,11 +81,11 @@ func (n *MutableBlockNode) Flush() error {
 		n.RUnlock()
 		return nil
 	}
-	cols := len(n.Meta.Segment.Table.Schema.ColDefs)
+	cols := len(n.Meta.Segment.GetSchema().ColDefs)
 	attrs := make([]int, cols)
 	vecs := make([]vector.IVector, cols)
 	var err error
-	for i, _ := range n.Meta.Segment.Table.Schema.ColDefs {
+	for i, _ := range n.Meta.Segment.GetSchema().ColDefs {
 		attrs[i] = i
 		vec, err := n.Data.GetVectorByAttr(i)
 		if err != nil {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/meta"
 	"strings"
@@ -34,7 +35,13 @@ func (d *database) Relation(name string) (engine.Relation, error) {
 	if err := encoding.Decode(data, &md); err != nil {
 		return nil, err
 	}
-	return &relation{rid: d.id, id: name, db: d.db, n: d.n, md: md}, nil
+	r := &relation{rid: d.id, id: name, db: d.db, n: d.n, md: md}
+	if len(md.Partition) > 0 {
+		if r.pdef, _, err = protocol.DecodePartition(md.Partition); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
 
 }
 
@@ -52,12 +59,20 @@ func (d *database) Delete(_ uint64, name string) error {
 	return nil
 }
 
-func (d *database) Create(_ uint64, name string, defs []engine.TableDef, _ *engine.PartitionBy, _ *engine.DistributionBy, _ string) error {
+func (d *database) Create(_ uint64, name string, defs []engine.TableDef, pdef *engine.PartitionBy, _ *engine.DistributionBy, _ string) error {
 	var md meta.Metadata
 
 	for _, def := range defs {
 		md.Attrs = append(md.Attrs, def.(*engine.AttributeDef).Attr)
 	}
+	if pdef != nil {
+		var buf bytes.Buffer
+
+		if err := protocol.EncodePartition(pdef, &buf); err != nil {
+			return err
+		}
+		md.Partition = buf.Bytes()
+	}
 	data, err := encoding.Encode(md)
 	if err != nil {
 		return err
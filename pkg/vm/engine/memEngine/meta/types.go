@@ -1,6 +1,7 @@
 package meta
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
 	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
 )
 
@@ -9,4 +10,11 @@ type Metadata struct {
 	Rows  int64
 	Name  string
 	Attrs []metadata.Attribute
+	// Stats is the statistics of the attributes collected by Analyze
+	Stats map[string]*stats.ColumnStats
+	// Partition is the encoded partition definition, it is empty if the
+	// relation is not partitioned
+	Partition []byte
+	// Parts is the partition of each segment of a partitioned relation
+	Parts []string
 }
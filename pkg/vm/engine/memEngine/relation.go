@@ -1,14 +1,25 @@
 package memEngine
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/block"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/segment"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/partition"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"strconv"
 
 	"github.com/pierrec/lz4"
 )
@@ -27,11 +38,53 @@ func (r *relation) Size(_ string) int64 {
 	return 0
 }
 
+func (r *relation) ColumnStats(attr string) *stats.ColumnStats {
+	return r.md.Stats[attr]
+}
+
+// Analyze recollects the statistics of all the attributes, the statistics
+// are not updated by the writes until the next Analyze.
+func (r *relation) Analyze(_ uint64) error {
+	mp := make(map[string]metadata.Attribute)
+	attrs := make([]string, len(r.md.Attrs))
+	collectors := make([]*stats.Collector, len(r.md.Attrs))
+	for i, attr := range r.md.Attrs {
+		attrs[i] = attr.Name
+		mp[attr.Name] = attr
+		collectors[i] = stats.NewCollector()
+	}
+	cs := make([]uint64, len(attrs))
+	cds := make([]*bytes.Buffer, len(attrs))
+	dds := make([]*bytes.Buffer, len(attrs))
+	for i := range attrs {
+		cs[i] = 1
+		cds[i] = bytes.NewBuffer(make([]byte, 0, 1024))
+		dds[i] = bytes.NewBuffer(make([]byte, 0, 1024))
+	}
+	for i := 0; i < int(r.md.Segs); i++ {
+		bat, err := block.New(r.sKey(i), r.db, mp).Read(cs, attrs, cds, dds)
+		if err != nil {
+			return err
+		}
+		for j, vec := range bat.Vecs {
+			collectors[j].Collect(vec)
+		}
+	}
+	r.md.Stats = make(map[string]*stats.ColumnStats, len(attrs))
+	for i, attr := range attrs {
+		r.md.Stats[attr] = collectors[i].Stats()
+	}
+	return r.writeMetadata()
+}
+
 func (r *relation) Segments() []engine.SegmentInfo {
 	segs := make([]engine.SegmentInfo, r.md.Segs)
 	for i := range segs {
 		segs[i].Node = r.n
 		segs[i].Id = r.sKey(i)
+		if r.pdef != nil {
+			segs[i].Partition = r.md.Parts[i]
+		}
 	}
 	return segs
 }
@@ -40,38 +93,280 @@ func (r *relation) Segment(si engine.SegmentInfo, _ *process.Process) engine.Seg
 	return segment.New(si.Id, r.db, r.md.Attrs)
 }
 
+// Write writes the batch as a new segment, or one new segment for each
+// partition getting rows if the relation is partitioned.
 func (r *relation) Write(_ uint64, bat *batch.Batch) error {
-	key := r.sKey(int(r.md.Segs))
-	for i, attr := range bat.Attrs {
-		v, err := bat.Vecs[i].Show()
+	if r.pdef != nil {
+		proc := process.New(guest.New(1<<40, host.New(1<<40)))
+		proc.Mp = mempool.New()
+		bats, err := partition.Batches(r.pdef, bat, proc)
 		if err != nil {
 			return err
 		}
-		if r.md.Attrs[i].Alg == compress.Lz4 {
-			data := make([]byte, lz4.CompressBlockBound(len(v)))
-			if data, err = compress.Compress(v, data, compress.Lz4); err != nil {
+		names := partition.Names(r.pdef)
+		for i, b := range bats {
+			if b == nil {
+				continue
+			}
+			if err := r.writeSegment(r.sKey(int(r.md.Segs)), b); err != nil {
 				return err
 			}
-			data = append(data, encoding.EncodeInt32(int32(len(v)))...)
-			v = data
+			r.md.Segs++
+			r.md.Parts = append(r.md.Parts, names[i])
 		}
-		if err := r.db.Set(key+"."+attr, v); err != nil {
-			return err
+		r.md.Rows += int64(bat.Length())
+		return r.writeMetadata()
+	}
+	if err := r.writeSegment(r.sKey(int(r.md.Segs)), bat); err != nil {
+		return err
+	}
+	r.md.Segs++
+	r.md.Rows += int64(bat.Length())
+	return r.writeMetadata()
+}
+
+// Delete rewrites the segments without the rows deleted.
+func (r *relation) Delete(_ uint64, f engine.RowFilter, proc *process.Process) (uint64, error) {
+	cnt, _, err := r.mutate(f, nil, proc)
+	return cnt, err
+}
+
+// Update rewrites the segments without the rows updated, then writes the
+// new rows.
+func (r *relation) Update(ts uint64, u engine.RowUpdate, proc *process.Process) (uint64, error) {
+	cnt, bats, err := r.mutate(u, u, proc)
+	if err != nil {
+		return 0, err
+	}
+	for _, bat := range bats {
+		if err := r.Write(ts, bat); err != nil {
+			return 0, err
 		}
 	}
-	{
-		r.md.Segs++
-		data, err := encoding.Encode(r.md)
+	return cnt, nil
+}
+
+// mutate removes the rows selected by f from the segments, and returns the
+// new rows of them computed by u if u is not nil. The segments are only
+// rewritten after all the rows are selected.
+func (r *relation) mutate(f engine.RowFilter, u engine.RowUpdate, proc *process.Process) (uint64, []*batch.Batch, error) {
+	mp := make(map[string]metadata.Attribute)
+	attrs := make([]string, len(r.md.Attrs))
+	for i, attr := range r.md.Attrs {
+		attrs[i] = attr.Name
+		mp[attr.Name] = attr
+	}
+	cs := make([]uint64, len(attrs))
+	for i := range attrs {
+		cs[i] = 1
+	}
+	var cnt uint64
+	var bats []*batch.Batch
+	segs := make(map[string]*batch.Batch)
+	for i := 0; i < int(r.md.Segs); i++ {
+		// the columns read refer to the buffers, which are kept until the
+		// segment is rewritten
+		cds := make([]*bytes.Buffer, len(attrs))
+		dds := make([]*bytes.Buffer, len(attrs))
+		for j := range attrs {
+			cds[j] = bytes.NewBuffer(make([]byte, 0, 1024))
+			dds[j] = bytes.NewBuffer(make([]byte, 0, 1024))
+		}
+		key := r.sKey(i)
+		bat, err := block.New(key, r.db, mp).Read(cs, attrs, cds, dds)
 		if err != nil {
-			return err
+			return 0, nil, err
+		}
+		sels, err := f.Filter(bat, proc)
+		if err != nil {
+			return 0, nil, err
+		}
+		if len(sels) == 0 {
+			continue
+		}
+		if u != nil {
+			nbat, err := u.Update(bat, sels, proc)
+			if err != nil {
+				return 0, nil, err
+			}
+			bats = append(bats, nbat)
+		}
+		n := bat.Length()
+		deleted := make([]bool, n)
+		for _, sel := range sels {
+			deleted[sel] = true
+		}
+		rest := make([]int64, 0, n-len(sels))
+		for j := range deleted {
+			if !deleted[j] {
+				rest = append(rest, int64(j))
+			}
+		}
+		for j, vec := range bat.Vecs {
+			if bat.Vecs[j], err = compact(vec, rest, proc); err != nil {
+				return 0, nil, err
+			}
+		}
+		segs[key] = bat
+		cnt += uint64(n - len(rest))
+	}
+	if cnt == 0 {
+		return 0, nil, nil
+	}
+	for key, bat := range segs {
+		err := r.writeSegment(key, bat)
+		for _, vec := range bat.Vecs {
+			vec.Clean(proc)
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	r.md.Rows -= int64(cnt)
+	return cnt, bats, r.writeMetadata()
+}
+
+// compact returns the rows of vec at sels in a new vector, the strings
+// are copied one after another as the segments expect.
+func compact(vec *vector.Vector, sels []int64, proc *process.Process) (*vector.Vector, error) {
+	v := vector.New(vec.Typ)
+	for _, sel := range sels {
+		if err := v.UnionOne(vec, sel, proc); err != nil {
+			v.Clean(proc)
+			return nil, err
+		}
+	}
+	v.Ref = vec.Ref
+	return v, nil
+}
+
+func (r *relation) Partition() *engine.PartitionBy {
+	return r.pdef
+}
+
+func (r *relation) AddPartition(_ uint64, def *engine.PartitionBy) error {
+	if r.pdef == nil {
+		return fmt.Errorf("table '%s' is not partitioned", r.id)
+	}
+	pdef, err := partition.Add(r.pdef, def)
+	if err != nil {
+		return err
+	}
+	return r.writePartition(pdef)
+}
+
+// DropPartition removes the segments of the partition and renumbers the
+// segments left.
+func (r *relation) DropPartition(_ uint64, name string) error {
+	if r.pdef == nil {
+		return fmt.Errorf("table '%s' is not partitioned", r.id)
+	}
+	pdef, err := partition.Drop(r.pdef, name)
+	if err != nil {
+		return err
+	}
+	name, _ = partition.Find(r.pdef, name)
+	mp := map[string]metadata.Attribute{r.md.Attrs[0].Name: r.md.Attrs[0]}
+	cs := []uint64{1}
+	attrs := []string{r.md.Attrs[0].Name}
+	cds := []*bytes.Buffer{bytes.NewBuffer(make([]byte, 0, 1024))}
+	dds := []*bytes.Buffer{bytes.NewBuffer(make([]byte, 0, 1024))}
+	var parts []string
+	for i := 0; i < int(r.md.Segs); i++ {
+		key := r.sKey(i)
+		if r.md.Parts[i] == name {
+			bat, err := block.New(key, r.db, mp).Read(cs, attrs, cds, dds)
+			if err != nil {
+				return err
+			}
+			r.md.Rows -= int64(bat.Vecs[0].Length())
+			for _, attr := range r.md.Attrs {
+				if err := r.db.Del(key + "." + attr.Name); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if newKey := r.sKey(len(parts)); newKey != key {
+			for _, attr := range r.md.Attrs {
+				data, err := r.db.Get(key+"."+attr.Name, bytes.NewBuffer(make([]byte, 0, 1024)))
+				if err != nil {
+					return err
+				}
+				if err := r.db.Set(newKey+"."+attr.Name, data); err != nil {
+					return err
+				}
+				if err := r.db.Del(key + "." + attr.Name); err != nil {
+					return err
+				}
+			}
+		}
+		parts = append(parts, r.md.Parts[i])
+	}
+	r.md.Segs = int64(len(parts))
+	r.md.Parts = parts
+	return r.writePartition(pdef)
+}
+
+func (r *relation) writePartition(pdef *engine.PartitionBy) error {
+	var buf bytes.Buffer
+
+	if err := protocol.EncodePartition(pdef, &buf); err != nil {
+		return err
+	}
+	r.md.Partition = buf.Bytes()
+	r.pdef = pdef
+	return r.writeMetadata()
+}
+
+// writeSegment stores every vector of the batch under the name of its
+// attribute, the order of the batch may differ from the metadata.
+func (r *relation) writeSegment(key string, bat *batch.Batch) error {
+	for i, name := range bat.Attrs {
+		attr, ok := r.attribute(name)
+		if !ok {
+			return fmt.Errorf("attribute '%s' not exist", name)
 		}
-		if err := r.db.Set(r.key(), data); err != nil {
+		if err := r.writeVector(key, attr, bat.Vecs[i]); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+func (r *relation) attribute(name string) (metadata.Attribute, bool) {
+	for _, attr := range r.md.Attrs {
+		if attr.Name == name {
+			return attr, true
+		}
+	}
+	return metadata.Attribute{}, false
+}
+
+func (r *relation) writeVector(key string, attr metadata.Attribute, vec *vector.Vector) error {
+	v, err := vec.Show()
+	if err != nil {
+		return err
+	}
+	if attr.Alg == compress.Lz4 {
+		data := make([]byte, lz4.CompressBlockBound(len(v)))
+		if data, err = compress.Compress(v, data, compress.Lz4); err != nil {
+			return err
+		}
+		data = append(data, encoding.EncodeInt32(int32(len(v)))...)
+		v = data
+	}
+	return r.db.Set(key+"."+attr.Name, v)
+}
+
+func (r *relation) writeMetadata() error {
+	data, err := encoding.Encode(r.md)
+	if err != nil {
+		return err
+	}
+	return r.db.Set(r.key(), data)
+}
+
 func (r *relation) sKey(num int) string {
 	return fmt.Sprintf("%v.%v.%v", r.rid, r.id, num)
 }
@@ -92,12 +387,99 @@ func (r *relation) Attribute() []metadata.Attribute {
 	return defs
 }
 
-func (r *relation) AddAttribute(u uint64, def engine.TableDef) error {
-	return nil
+// AddAttribute adds an attribute, the segments written before are
+// filled with its default value.
+func (r *relation) AddAttribute(_ uint64, def engine.TableDef) error {
+	d, ok := def.(*engine.AttributeDef)
+	if !ok {
+		return fmt.Errorf("'%v' is not an attribute definition", def)
+	}
+	attr := d.Attr
+	for _, a := range r.md.Attrs {
+		if a.Name == attr.Name {
+			return fmt.Errorf("attribute '%s' already exists", attr.Name)
+		}
+	}
+	if len(r.md.Attrs) > 0 {
+		mp := map[string]metadata.Attribute{r.md.Attrs[0].Name: r.md.Attrs[0]}
+		cs := []uint64{1}
+		attrs := []string{r.md.Attrs[0].Name}
+		cds := []*bytes.Buffer{bytes.NewBuffer(make([]byte, 0, 1024))}
+		dds := []*bytes.Buffer{bytes.NewBuffer(make([]byte, 0, 1024))}
+		for i := 0; i < int(r.md.Segs); i++ {
+			key := r.sKey(i)
+			bat, err := block.New(key, r.db, mp).Read(cs, attrs, cds, dds)
+			if err != nil {
+				return err
+			}
+			vec, err := defaultVector(attr, bat.Vecs[0].Length())
+			if err != nil {
+				return err
+			}
+			if err := r.writeVector(key, attr, vec); err != nil {
+				return err
+			}
+		}
+	}
+	r.md.Attrs = append(r.md.Attrs, attr)
+	return r.writeMetadata()
 }
 
-func (r *relation) DelAttribute(u uint64, def engine.TableDef) error {
-	return nil
+func (r *relation) DelAttribute(_ uint64, def engine.TableDef) error {
+	d, ok := def.(*engine.AttributeDef)
+	if !ok {
+		return fmt.Errorf("'%v' is not an attribute definition", def)
+	}
+	for i, attr := range r.md.Attrs {
+		if attr.Name == d.Attr.Name {
+			if len(r.md.Attrs) == 1 {
+				return fmt.Errorf("can't drop all attributes of '%s'", r.id)
+			}
+			for j := 0; j < int(r.md.Segs); j++ {
+				if err := r.db.Del(r.sKey(j) + "." + attr.Name); err != nil {
+					return err
+				}
+			}
+			r.md.Attrs = append(r.md.Attrs[:i], r.md.Attrs[i+1:]...)
+			delete(r.md.Stats, attr.Name)
+			return r.writeMetadata()
+		}
+	}
+	return fmt.Errorf("attribute '%s' not exist", d.Attr.Name)
+}
+
+func (r *relation) RenameAttribute(_ uint64, oldName, newName string) error {
+	idx := -1
+	for i, attr := range r.md.Attrs {
+		if attr.Name == newName {
+			return fmt.Errorf("attribute '%s' already exists", newName)
+		}
+		if attr.Name == oldName {
+			idx = i
+		}
+	}
+	if idx == -1 {
+		return fmt.Errorf("attribute '%s' not exist", oldName)
+	}
+	for i := 0; i < int(r.md.Segs); i++ {
+		key := r.sKey(i)
+		data, err := r.db.Get(key+"."+oldName, bytes.NewBuffer(make([]byte, 0, 1024)))
+		if err != nil {
+			return err
+		}
+		if err := r.db.Set(key+"."+newName, data); err != nil {
+			return err
+		}
+		if err := r.db.Del(key + "." + oldName); err != nil {
+			return err
+		}
+	}
+	r.md.Attrs[idx].Name = newName
+	if s, ok := r.md.Stats[oldName]; ok {
+		r.md.Stats[newName] = s
+		delete(r.md.Stats, oldName)
+	}
+	return r.writeMetadata()
 }
 
 func (r *relation) CreateIndex(_ uint64, _ []engine.TableDef) error{
@@ -106,4 +488,112 @@ func (r *relation) CreateIndex(_ uint64, _ []engine.TableDef) error{
 
 func (r *relation) DropIndex(epoch uint64, name string) error{
 	return nil
-}
\ No newline at end of file
+}
+// defaultVector returns a vector of n rows which take the default value of
+// attr, the rows are null if attr has no default value.
+func defaultVector(attr metadata.Attribute, n int) (*vector.Vector, error) {
+	var err error
+	var one interface{}
+
+	value, isNull := attr.GetDefaultExpr()
+	isNull = isNull || !attr.HasDefaultExpr()
+	s := fmt.Sprintf("%v", value)
+	vec := vector.New(attr.Type)
+	switch attr.Type.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		var v int64
+		if !isNull {
+			if v, err = strconv.ParseInt(s, 10, 64); err != nil {
+				return nil, err
+			}
+		}
+		switch attr.Type.Oid {
+		case types.T_int8:
+			one = []int8{int8(v)}
+		case types.T_int16:
+			one = []int16{int16(v)}
+		case types.T_int32:
+			one = []int32{int32(v)}
+		default:
+			one = []int64{v}
+		}
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		var v uint64
+		if !isNull {
+			if v, err = strconv.ParseUint(s, 10, 64); err != nil {
+				return nil, err
+			}
+		}
+		switch attr.Type.Oid {
+		case types.T_uint8:
+			one = []uint8{uint8(v)}
+		case types.T_uint16:
+			one = []uint16{uint16(v)}
+		case types.T_uint32:
+			one = []uint32{uint32(v)}
+		default:
+			one = []uint64{v}
+		}
+	case types.T_float32, types.T_float64:
+		var v float64
+		if !isNull {
+			if v, err = strconv.ParseFloat(s, 64); err != nil {
+				return nil, err
+			}
+		}
+		if attr.Type.Oid == types.T_float32 {
+			one = []float32{float32(v)}
+		} else {
+			one = []float64{v}
+		}
+	case types.T_decimal64:
+		var v types.Decimal64
+		if !isNull {
+			if v, err = types.ParseStringToDecimal64(s, attr.Type.Width, attr.Type.Precision); err != nil {
+				return nil, err
+			}
+		}
+		one = []types.Decimal64{v}
+	case types.T_decimal128:
+		var v types.Decimal128
+		if !isNull {
+			if v, err = types.ParseStringToDecimal128(s, attr.Type.Width, attr.Type.Precision); err != nil {
+				return nil, err
+			}
+		}
+		one = []types.Decimal128{v}
+	case types.T_date:
+		var v types.Date
+		if !isNull {
+			if v, err = types.ParseDate(s); err != nil {
+				return nil, err
+			}
+		}
+		one = []types.Date{v}
+	case types.T_datetime:
+		var v types.Datetime
+		if !isNull {
+			if v, err = types.ParseDatetime(s); err != nil {
+				return nil, err
+			}
+		}
+		one = []types.Datetime{v}
+	case types.T_char, types.T_varchar, types.T_json:
+		var v []byte
+		if !isNull {
+			v = []byte(s)
+		}
+		one = [][]byte{v}
+	default:
+		return nil, fmt.Errorf("unsupported type %s", attr.Type)
+	}
+	for i := 0; i < n; i++ {
+		if err := vec.Append(one); err != nil {
+			return nil, err
+		}
+		if isNull {
+			vec.Nsp.Add(uint64(i))
+		}
+	}
+	return vec, nil
+}
@@ -1,6 +1,7 @@
 package memEngine
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/kv"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/meta"
 	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
@@ -24,4 +25,6 @@ type relation struct {
 	db  *kv.KV
 	n   metadata.Node
 	md  meta.Metadata
+	// pdef is the decoded md.Partition
+	pdef *engine.PartitionBy
 }
@@ -0,0 +1,190 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package partition places the rows of a partitioned relation into its
+// partitions, and prunes the partitions which can not hold the rows
+// selected by a filter. It is shared by the engines which store the
+// partitions of a relation.
+package partition
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// Separator separates the name of a table and the name of its partition
+// in the name of the table holding the rows of the partition.
+const Separator = "#P#"
+
+// TableName returns the name of the table holding the rows of a partition.
+func TableName(table, partition string) string {
+	return table + Separator + partition
+}
+
+// IsPartitionTable tells whether the table holds the rows of a partition.
+func IsPartitionTable(name string) bool {
+	return strings.Contains(name, Separator)
+}
+
+// Names returns the names of the partitions in order.
+func Names(def *engine.PartitionBy) []string {
+	var names []string
+
+	for _, p := range def.Range {
+		names = append(names, p.Name)
+	}
+	for _, p := range def.List {
+		names = append(names, p.Name)
+	}
+	return append(names, def.Hash...)
+}
+
+// Validate checks that the partitions of def are well defined, the bounds
+// of range partitions have to be strictly increasing and a value list can
+// only belong to one list partition.
+func Validate(def *engine.PartitionBy) error {
+	kinds := 0
+	for _, n := range []int{len(def.Range), len(def.List), len(def.Hash)} {
+		if n > 0 {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return fmt.Errorf("a partitioned table must have partitions of one kind")
+	}
+	if len(def.Fields) == 0 {
+		return fmt.Errorf("a partitioned table must have partition fields")
+	}
+	names := make(map[string]struct{})
+	for _, name := range Names(def) {
+		if _, ok := names[strings.ToLower(name)]; ok {
+			return fmt.Errorf("Duplicate partition name %s", name)
+		}
+		names[strings.ToLower(name)] = struct{}{}
+	}
+	var prev []interface{}
+	for i, p := range def.Range {
+		if len(p.To) > len(def.Fields) {
+			return fmt.Errorf("Inconsistency in usage of column lists for partitioning")
+		}
+		bound, err := values(p.To)
+		if err != nil {
+			return err
+		}
+		for _, v := range bound {
+			if v == nil {
+				return fmt.Errorf("Not allowed to use NULL value in VALUES LESS THAN")
+			}
+		}
+		if i > 0 && compareBounds(prev, bound) >= 0 {
+			return fmt.Errorf("VALUES LESS THAN value must be strictly increasing for each partition")
+		}
+		prev = bound
+	}
+	var lists [][]interface{}
+	for _, p := range def.List {
+		if len(p.Extends) == 0 || len(p.Extends)%len(def.Fields) != 0 {
+			return fmt.Errorf("Inconsistency in usage of column lists for partitioning")
+		}
+		vs, err := values(p.Extends)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(vs); i += len(def.Fields) {
+			list := vs[i : i+len(def.Fields)]
+			for _, l := range lists {
+				if compareTuples(l, list) == 0 {
+					return fmt.Errorf("Multiple definition of same constant in list partitioning")
+				}
+			}
+			lists = append(lists, list)
+		}
+	}
+	return nil
+}
+
+// Add returns the definition with the partitions of add appended, range
+// partitions can only be added after the existing ones.
+func Add(def, add *engine.PartitionBy) (*engine.PartitionBy, error) {
+	if len(def.Hash) > 0 || len(add.Hash) > 0 {
+		return nil, fmt.Errorf("ADD PARTITION can only be used on RANGE/LIST partitions")
+	}
+	if (len(def.Range) > 0) != (len(add.Range) > 0) || (len(def.List) > 0) != (len(add.List) > 0) {
+		return nil, fmt.Errorf("Only LIST PARTITIONING can use VALUES IN in partition definition")
+	}
+	r := &engine.PartitionBy{
+		Fields: def.Fields,
+		Range:  append(append([]engine.RangePartition{}, def.Range...), add.Range...),
+		List:   append(append([]engine.ListPartition{}, def.List...), add.List...),
+	}
+	if err := Validate(r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Drop returns the definition without the partition, the rows of a range
+// partition dropped are not moved, the rows written later fall into the
+// next partition.
+func Drop(def *engine.PartitionBy, name string) (*engine.PartitionBy, error) {
+	if len(def.Hash) > 0 {
+		return nil, fmt.Errorf("DROP PARTITION can only be used on RANGE/LIST partitions")
+	}
+	r := &engine.PartitionBy{Fields: def.Fields}
+	for _, p := range def.Range {
+		if !strings.EqualFold(p.Name, name) {
+			r.Range = append(r.Range, p)
+		}
+	}
+	for _, p := range def.List {
+		if !strings.EqualFold(p.Name, name) {
+			r.List = append(r.List, p)
+		}
+	}
+	switch n := len(r.Range) + len(r.List); {
+	case n == len(def.Range)+len(def.List):
+		return nil, fmt.Errorf("Error in list of partitions to DROP")
+	case n == 0:
+		return nil, fmt.Errorf("Cannot remove all partitions, use DROP TABLE instead")
+	}
+	return r, nil
+}
+
+// Find returns the name of the partition as defined, and false if def has
+// no such partition.
+func Find(def *engine.PartitionBy, name string) (string, bool) {
+	for _, n := range Names(def) {
+		if strings.EqualFold(n, name) {
+			return n, true
+		}
+	}
+	return "", false
+}
+
+// values returns the constants of the extends, a constant is a value
+// extend of one row whose value is nil if it is null.
+func values(es []extend.Extend) ([]interface{}, error) {
+	vs := make([]interface{}, len(es))
+	for i, e := range es {
+		v, ok := e.(*extend.ValueExtend)
+		if !ok || v.V.Length() != 1 {
+			return nil, fmt.Errorf("'%s' is not a constant", e)
+		}
+		vs[i] = value(v.V, 0)
+	}
+	return vs, nil
+}
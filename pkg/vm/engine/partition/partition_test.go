@@ -0,0 +1,204 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package partition
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+var int64Type = types.Type{Oid: types.T_int64, Size: 8}
+
+func constants(xs ...int64) []extend.Extend {
+	es := make([]extend.Extend, len(xs))
+	for i, x := range xs {
+		vec := vector.New(int64Type)
+		vec.Append([]int64{x})
+		es[i] = &extend.ValueExtend{V: vec}
+	}
+	return es
+}
+
+func newBatch(xs []int64, nullRows ...uint64) *batch.Batch {
+	bat := batch.New(true, []string{"a"})
+	bat.Vecs[0] = vector.New(int64Type)
+	bat.Vecs[0].Append(xs)
+	bat.Vecs[0].Nsp.Add(nullRows...)
+	return bat
+}
+
+func rangeDef() *engine.PartitionBy {
+	return &engine.PartitionBy{
+		Fields: []string{"a"},
+		Range: []engine.RangePartition{
+			{Name: "p0", To: constants(10)},
+			{Name: "p1", To: constants(20)},
+			{Name: "p2"},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(rangeDef()))
+
+	def := rangeDef()
+	def.Range[1].To = constants(5)
+	require.Error(t, Validate(def))
+
+	def = rangeDef()
+	def.Range[1].Name = "P0"
+	require.Error(t, Validate(def))
+
+	def = &engine.PartitionBy{
+		Fields: []string{"a"},
+		List: []engine.ListPartition{
+			{Name: "p0", Extends: constants(1, 3)},
+			{Name: "p1", Extends: constants(2, 3)},
+		},
+	}
+	require.Error(t, Validate(def))
+	def.List[1].Extends = constants(2, 4)
+	require.NoError(t, Validate(def))
+
+	def.Hash = []string{"p2"}
+	require.Error(t, Validate(def))
+}
+
+func TestSplit(t *testing.T) {
+	sels, err := Split(rangeDef(), newBatch([]int64{1, 15, 30, 10, 0}, 4))
+	require.NoError(t, err)
+	require.Equal(t, [][]int64{{0, 4}, {1, 3}, {2}}, sels)
+
+	def := &engine.PartitionBy{
+		Fields: []string{"a"},
+		List: []engine.ListPartition{
+			{Name: "p0", Extends: constants(1, 3)},
+			{Name: "p1", Extends: constants(2)},
+		},
+	}
+	sels, err = Split(def, newBatch([]int64{1, 2, 3}))
+	require.NoError(t, err)
+	require.Equal(t, [][]int64{{0, 2}, {1}}, sels)
+	_, err = Split(def, newBatch([]int64{1, 5}))
+	require.EqualError(t, err, "Table has no partition for value 5")
+
+	def = &engine.PartitionBy{Fields: []string{"a"}, Hash: []string{"p0", "p1", "p2"}}
+	sels, err = Split(def, newBatch([]int64{3, 4, -5}))
+	require.NoError(t, err)
+	require.Equal(t, [][]int64{{0}, {1}, {2}}, sels)
+
+	proc := process.New(guest.New(1<<40, host.New(1<<40)))
+	proc.Mp = mempool.New()
+	bats, err := Batches(rangeDef(), newBatch([]int64{1, 15, 30, 10, 0}, 4), proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 0}, bats[0].Vecs[0].Col.([]int64))
+	require.True(t, bats[0].Vecs[0].Nsp.Contains(1))
+	require.Equal(t, []int64{15, 10}, bats[1].Vecs[0].Col.([]int64))
+	require.False(t, bats[1].Vecs[0].Nsp.Any())
+	require.Equal(t, []int64{30}, bats[2].Vecs[0].Col.([]int64))
+}
+
+func TestBatchesString(t *testing.T) {
+	bat := newBatch([]int64{1, 15, 30, 10, 0})
+	bat.Attrs = append(bat.Attrs, "b")
+	bat.Vecs = append(bat.Vecs, vector.New(types.Type{Oid: types.T_varchar, Size: 24, Width: 10}))
+	require.NoError(t, bat.Vecs[1].Append([][]byte{[]byte("a"), []byte("bb"), []byte("ccc"), []byte("dd"), []byte("e")}))
+
+	proc := process.New(guest.New(1<<40, host.New(1<<40)))
+	proc.Mp = mempool.New()
+	bats, err := Batches(rangeDef(), bat, proc)
+	require.NoError(t, err)
+	expected := [][]string{{"a", "e"}, {"bb", "dd"}, {"ccc"}}
+	for i, b := range bats {
+		vec := b.Vecs[1]
+		col := vec.Col.(*types.Bytes)
+		rows := make([]string, len(col.Offsets))
+		for j := range rows {
+			rows[j] = string(col.Get(int64(j)))
+		}
+		require.Equal(t, expected[i], rows)
+		// the strings are stored one after another
+		require.Equal(t, strings.Join(expected[i], ""), string(col.Data))
+	}
+}
+
+func TestPrune(t *testing.T) {
+	def := rangeDef()
+	for _, tc := range []struct {
+		op    int
+		vals  []interface{}
+		names []string
+	}{
+		{overload.EQ, []interface{}{int32(15)}, []string{"p1"}},
+		{overload.LT, []interface{}{int64(10)}, []string{"p0"}},
+		{overload.LE, []interface{}{int64(10)}, []string{"p0", "p1"}},
+		{overload.GE, []interface{}{int64(20)}, []string{"p2"}},
+		{overload.GT, []interface{}{int64(19)}, []string{"p1", "p2"}},
+		{overload.In, []interface{}{int64(1), int64(30)}, []string{"p0", "p2"}},
+		{overload.IsNull, nil, []string{"p0"}},
+	} {
+		names, ok := Prune(def, "a", tc.op, tc.vals)
+		require.True(t, ok)
+		require.Equal(t, tc.names, names)
+	}
+	_, ok := Prune(def, "b", overload.EQ, []interface{}{int64(1)})
+	require.False(t, ok)
+	_, ok = Prune(def, "a", overload.NE, []interface{}{int64(1)})
+	require.False(t, ok)
+
+	def = &engine.PartitionBy{Fields: []string{"a"}, Hash: []string{"p0", "p1", "p2"}}
+	names, ok := Prune(def, "a", overload.In, []interface{}{int64(4), int64(7)})
+	require.True(t, ok)
+	require.Equal(t, []string{"p1"}, names)
+	_, ok = Prune(def, "a", overload.LT, []interface{}{int64(4)})
+	require.False(t, ok)
+}
+
+func TestAddDrop(t *testing.T) {
+	def, err := Drop(rangeDef(), "P1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0", "p2"}, Names(def))
+	_, err = Drop(def, "p1")
+	require.Error(t, err)
+
+	_, err = Add(rangeDef(), &engine.PartitionBy{
+		Fields: []string{"a"},
+		Range:  []engine.RangePartition{{Name: "p3", To: constants(30)}},
+	})
+	require.Error(t, err)
+
+	def.Range = def.Range[:1]
+	def, err = Add(def, &engine.PartitionBy{
+		Fields: []string{"a"},
+		Range:  []engine.RangePartition{{Name: "p3", To: constants(30)}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0", "p3"}, Names(def))
+	name, ok := Find(def, "P3")
+	require.True(t, ok)
+	require.Equal(t, "p3", name)
+}
@@ -0,0 +1,204 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package partition
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// Prune returns the partitions which may hold the rows satisfying the
+// comparison between the attribute and vals, op is one of overload.EQ, LT,
+// LE, GT, GE, In and IsNull, vals are of the type of the attribute. It
+// returns false if the partitions can not be pruned by the comparison.
+func Prune(def *engine.PartitionBy, attr string, op int, vals []interface{}) ([]string, bool) {
+	idx := -1
+	for i, field := range def.Fields {
+		if field == attr {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return nil, false
+	}
+	switch op {
+	case overload.EQ, overload.LT, overload.LE, overload.GT, overload.GE, overload.In:
+		if len(vals) == 0 {
+			return nil, false
+		}
+	case overload.IsNull:
+		vals = []interface{}{nil}
+	default:
+		return nil, false
+	}
+	vs := make([]interface{}, len(vals))
+	for i, v := range vals {
+		vs[i] = normalize(v)
+	}
+	vals = vs
+	switch {
+	case len(def.Range) > 0:
+		// only the first field orders the range partitions
+		if idx != 0 {
+			return nil, false
+		}
+		return pruneRange(def, op, vals), true
+	case len(def.List) > 0:
+		return pruneList(def, idx, op, vals), true
+	}
+	// a row is hashed over all the fields
+	if len(def.Fields) != 1 || (op != overload.EQ && op != overload.In && op != overload.IsNull) {
+		return nil, false
+	}
+	mp := make(map[string]struct{})
+	var names []string
+	for _, v := range vals {
+		name := def.Hash[hashTuple([]interface{}{v})%uint64(len(def.Hash))]
+		if _, ok := mp[name]; !ok {
+			mp[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+	return names, true
+}
+
+// pruneRange prunes the range partitions by the first field, a partition
+// holds the values from the bound of the previous partition to its own
+// bound, which is included if the partitions have more than one field.
+func pruneRange(def *engine.PartitionBy, op int, vals []interface{}) []string {
+	var names []string
+	var lo []interface{}
+
+	closed := len(def.Fields) > 1
+	for i, p := range def.Range {
+		hi, _ := values(p.To)
+		if i == 0 && op == overload.IsNull {
+			// null is less than the other values
+			names = append(names, p.Name)
+		}
+		if op != overload.IsNull && mayHold(lo, hi, closed, op, vals) {
+			names = append(names, p.Name)
+		}
+		lo = hi
+	}
+	return names
+}
+
+func mayHold(lo, hi []interface{}, closed bool, op int, vals []interface{}) bool {
+	// the values above lo, lo is included
+	above := func(v interface{}, strict bool) bool {
+		if len(lo) == 0 {
+			return true
+		}
+		if strict {
+			return compare(lo[0], v) < 0
+		}
+		return compare(lo[0], v) <= 0
+	}
+	// the values below hi, hi is included if the partition is closed
+	below := func(v interface{}, strict bool) bool {
+		if len(hi) == 0 {
+			return true
+		}
+		if r := compare(v, hi[0]); r < 0 || (r == 0 && closed && !strict) {
+			return true
+		}
+		return false
+	}
+	for _, v := range vals {
+		if v == nil {
+			continue
+		}
+		switch op {
+		case overload.EQ, overload.In:
+			if above(v, false) && below(v, false) {
+				return true
+			}
+		case overload.LT:
+			if above(v, true) {
+				return true
+			}
+		case overload.LE:
+			if above(v, false) {
+				return true
+			}
+		case overload.GT:
+			if below(v, true) {
+				return true
+			}
+		case overload.GE:
+			if below(v, false) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pruneList keeps the list partitions which have a value list satisfying
+// the comparison at the field of idx.
+func pruneList(def *engine.PartitionBy, idx, op int, vals []interface{}) []string {
+	var names []string
+
+	n := len(def.Fields)
+	for _, p := range def.List {
+		vs, _ := values(p.Extends)
+		for i := idx; i < len(vs); i += n {
+			if satisfy(vs[i], op, vals) {
+				names = append(names, p.Name)
+				break
+			}
+		}
+	}
+	return names
+}
+
+func satisfy(x interface{}, op int, vals []interface{}) bool {
+	if op == overload.IsNull {
+		return x == nil
+	}
+	if x == nil {
+		return false
+	}
+	for _, v := range vals {
+		if v == nil {
+			continue
+		}
+		r := compare(x, v)
+		switch op {
+		case overload.EQ, overload.In:
+			if r == 0 {
+				return true
+			}
+		case overload.LT:
+			if r < 0 {
+				return true
+			}
+		case overload.LE:
+			if r <= 0 {
+				return true
+			}
+		case overload.GT:
+			if r > 0 {
+				return true
+			}
+		case overload.GE:
+			if r >= 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
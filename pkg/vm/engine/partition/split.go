@@ -0,0 +1,364 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package partition
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Split returns the rows of bat in each partition, in the order of Names.
+// It fails if a row belongs to no partition.
+func Split(def *engine.PartitionBy, bat *batch.Batch) ([][]int64, error) {
+	vecs := make([]*vector.Vector, len(def.Fields))
+	for i, field := range def.Fields {
+		for j, attr := range bat.Attrs {
+			if attr == field {
+				vecs[i] = bat.Vecs[j]
+			}
+		}
+		if vecs[i] == nil {
+			return nil, fmt.Errorf("partition field '%s' is not in the batch", field)
+		}
+	}
+	bounds := make([][]interface{}, len(def.Range))
+	for i, p := range def.Range {
+		vs, err := values(p.To)
+		if err != nil {
+			return nil, err
+		}
+		bounds[i] = vs
+	}
+	lists := make([][]interface{}, len(def.List))
+	for i, p := range def.List {
+		vs, err := values(p.Extends)
+		if err != nil {
+			return nil, err
+		}
+		lists[i] = vs
+	}
+	sels := make([][]int64, len(bounds)+len(lists)+len(def.Hash))
+	row := make([]interface{}, len(vecs))
+	for i, n := 0, vecs[0].Length(); i < n; i++ {
+		for j, vec := range vecs {
+			row[j] = value(vec, i)
+		}
+		idx := -1
+		switch {
+		case len(bounds) > 0:
+			for j, bound := range bounds {
+				if lessThan(row, bound) {
+					idx = j
+					break
+				}
+			}
+		case len(lists) > 0:
+			for j, list := range lists {
+				for k := 0; k < len(list); k += len(row) {
+					if compareTuples(row, list[k:k+len(row)]) == 0 {
+						idx = j
+						break
+					}
+				}
+				if idx >= 0 {
+					break
+				}
+			}
+		default:
+			idx = int(hashTuple(row) % uint64(len(def.Hash)))
+		}
+		if idx < 0 {
+			return nil, fmt.Errorf("Table has no partition for value %s", formatTuple(row, vecs))
+		}
+		sels[idx] = append(sels[idx], int64(i))
+	}
+	return sels, nil
+}
+
+// Batches splits bat into the batches of the partitions, in the order of
+// Names, the batch of a partition is nil if it gets no rows.
+func Batches(def *engine.PartitionBy, bat *batch.Batch, proc *process.Process) ([]*batch.Batch, error) {
+	sels, err := Split(def, bat)
+	if err != nil {
+		return nil, err
+	}
+	bats := make([]*batch.Batch, len(sels))
+	for i, sel := range sels {
+		switch {
+		case len(sel) == 0:
+			continue
+		case len(sel) == bat.Length():
+			bats[i] = bat
+			continue
+		}
+		b := batch.New(true, bat.Attrs)
+		for j, vec := range bat.Vecs {
+			if b.Vecs[j], err = compact(vec, sel, proc); err != nil {
+				return nil, err
+			}
+		}
+		bats[i] = b
+	}
+	return bats, nil
+}
+
+// compact returns the rows of vec at sels in a new vector, the strings are
+// copied one after another, as a shuffled vector keeps the data of all the
+// rows and can not be encoded.
+func compact(vec *vector.Vector, sels []int64, proc *process.Process) (*vector.Vector, error) {
+	v := vector.New(vec.Typ)
+	for _, sel := range sels {
+		if err := v.UnionOne(vec, sel, proc); err != nil {
+			v.Clean(proc)
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// lessThan tells whether the row is below the bound of a range partition,
+// the fields cut off from the bound are taken as MAXVALUE.
+func lessThan(row, bound []interface{}) bool {
+	r := compareTuples(row[:len(bound)], bound)
+	if len(bound) < len(row) {
+		return r <= 0
+	}
+	return r < 0
+}
+
+// compareBounds compares two bounds of range partitions.
+func compareBounds(a, b []interface{}) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	if r := compareTuples(a[:n], b[:n]); r != 0 {
+		return r
+	}
+	// the shorter one is cut off at MAXVALUE
+	switch {
+	case len(a) < len(b):
+		return 1
+	case len(a) > len(b):
+		return -1
+	}
+	return 0
+}
+
+func compareTuples(a, b []interface{}) int {
+	for i := range a {
+		if r := compare(a[i], b[i]); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// compare compares two values of the same type, null is less than the
+// other values as in the range partitions of mysql.
+func compare(a, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	switch x := a.(type) {
+	case int64:
+		return compareInt64(x, b.(int64))
+	case uint64:
+		switch y := b.(uint64); {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	case float64:
+		switch y := b.(float64); {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	case types.Decimal128:
+		return x.Compare(b.(types.Decimal128))
+	case []byte:
+		return bytes.Compare(x, b.([]byte))
+	}
+	return 0
+}
+
+func compareInt64(x, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// hashTuple returns the hash of a row, a single integer hashes to its
+// absolute value, so that HASH(id) places the rows as MOD(id, n) does.
+func hashTuple(row []interface{}) uint64 {
+	if len(row) == 1 {
+		switch x := row[0].(type) {
+		case int64:
+			if x < 0 {
+				return uint64(-x)
+			}
+			return uint64(x)
+		case uint64:
+			return x
+		}
+	}
+	var buf [16]byte
+
+	h := fnv.New64a()
+	for _, v := range row {
+		switch x := v.(type) {
+		case nil:
+			h.Write([]byte{0})
+		case int64:
+			binary.LittleEndian.PutUint64(buf[:], uint64(x))
+			h.Write(buf[:8])
+		case uint64:
+			binary.LittleEndian.PutUint64(buf[:], x)
+			h.Write(buf[:8])
+		case float64:
+			binary.LittleEndian.PutUint64(buf[:], math.Float64bits(x))
+			h.Write(buf[:8])
+		case types.Decimal128:
+			binary.LittleEndian.PutUint64(buf[:], x.Lo)
+			binary.LittleEndian.PutUint64(buf[8:], uint64(x.Hi))
+			h.Write(buf[:])
+		case []byte:
+			h.Write(x)
+		}
+	}
+	return h.Sum64()
+}
+
+func formatTuple(row []interface{}, vecs []*vector.Vector) string {
+	var buf bytes.Buffer
+
+	for i, v := range row {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		switch x := v.(type) {
+		case nil:
+			buf.WriteString("NULL")
+		case []byte:
+			buf.Write(x)
+		case int64:
+			switch typ := vecs[i].Typ; typ.Oid {
+			case types.T_date:
+				buf.WriteString(types.Date(x).String())
+			case types.T_datetime:
+				buf.WriteString(types.Datetime(x).String())
+			case types.T_decimal64:
+				buf.WriteString(types.Decimal64(x).Format(typ.Precision))
+			default:
+				fmt.Fprintf(&buf, "%v", x)
+			}
+		default:
+			fmt.Fprintf(&buf, "%v", x)
+		}
+	}
+	return buf.String()
+}
+
+// value returns the i-th value of vec normalized for the comparisons, the
+// signed integers, dates, datetimes and decimal64s are kept in int64 and
+// the unsigned integers in uint64, it returns nil if the value is null.
+func value(vec *vector.Vector, i int) interface{} {
+	if vec.Nsp.Contains(uint64(i)) {
+		return nil
+	}
+	switch col := vec.Col.(type) {
+	case []int8:
+		return int64(col[i])
+	case []int16:
+		return int64(col[i])
+	case []int32:
+		return int64(col[i])
+	case []int64:
+		return col[i]
+	case []uint8:
+		return uint64(col[i])
+	case []uint16:
+		return uint64(col[i])
+	case []uint32:
+		return uint64(col[i])
+	case []uint64:
+		return col[i]
+	case []float32:
+		return float64(col[i])
+	case []float64:
+		return col[i]
+	case []types.Date:
+		return int64(col[i])
+	case []types.Datetime:
+		return int64(col[i])
+	case []types.Decimal64:
+		return int64(col[i])
+	case []types.Decimal128:
+		return col[i]
+	case *types.Bytes:
+		return col.Get(int64(i))
+	}
+	return nil
+}
+
+// normalize converts a value of a filter into the form of value.
+func normalize(v interface{}) interface{} {
+	switch x := v.(type) {
+	case int8:
+		return int64(x)
+	case int16:
+		return int64(x)
+	case int32:
+		return int64(x)
+	case uint8:
+		return uint64(x)
+	case uint16:
+		return uint64(x)
+	case uint32:
+		return uint64(x)
+	case float32:
+		return float64(x)
+	case types.Date:
+		return int64(x)
+	case types.Datetime:
+		return int64(x)
+	case types.Decimal64:
+		return int64(x)
+	}
+	return v
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/spillEngine/segment"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
 	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"path"
@@ -103,14 +104,46 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 	return nil
 }
 
+func (r *relation) Delete(_ uint64, _ engine.RowFilter, _ *process.Process) (uint64, error) {
+	return 0, fmt.Errorf("delete is not supported by spill engine")
+}
+
+func (r *relation) Update(_ uint64, _ engine.RowUpdate, _ *process.Process) (uint64, error) {
+	return 0, fmt.Errorf("update is not supported by spill engine")
+}
+
 func (r *relation) AddAttribute(_ uint64, _ engine.TableDef) error {
-	return nil
+	return fmt.Errorf("alter table is not supported by spill engine")
 }
 
 func (r *relation) DelAttribute(_ uint64, _ engine.TableDef) error {
+	return fmt.Errorf("alter table is not supported by spill engine")
+}
+
+func (r *relation) RenameAttribute(_ uint64, _, _ string) error {
+	return fmt.Errorf("alter table is not supported by spill engine")
+}
+
+func (r *relation) Partition() *engine.PartitionBy {
 	return nil
 }
 
+func (r *relation) AddPartition(_ uint64, _ *engine.PartitionBy) error {
+	return fmt.Errorf("partition is not supported by spill engine")
+}
+
+func (r *relation) DropPartition(_ uint64, _ string) error {
+	return fmt.Errorf("partition is not supported by spill engine")
+}
+
+func (r *relation) ColumnStats(_ string) *stats.ColumnStats {
+	return nil
+}
+
+func (r *relation) Analyze(_ uint64) error {
+	return fmt.Errorf("analyze table is not supported by spill engine")
+}
+
 func (r *relation) clean(seg string, attrs []string) {
 	r.db.Del(seg)
 	for _, attr := range attrs {
@@ -0,0 +1,186 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// MaxSamples is the maximum number of values sampled by a collector to
+// build the histogram of a column.
+const MaxSamples = 8192
+
+// ColumnStats is the statistics of a column, Min, Max and Histogram are
+// nil if the column has no non-null value or the type of the column is
+// not ordered by the statistics.
+type ColumnStats struct {
+	Rows      int64      `json:"rows"`
+	NullCount int64      `json:"nulls"`
+	Min       *Value     `json:"min,omitempty"`
+	Max       *Value     `json:"max,omitempty"`
+	Sketch    *Sketch    `json:"sketch"`
+	Histogram *Histogram `json:"histogram,omitempty"`
+}
+
+// NDV returns the estimated number of distinct non-null values.
+func (s *ColumnStats) NDV() int64 {
+	if s.Sketch == nil {
+		return 0
+	}
+	n := s.Sketch.Estimate()
+	if n > s.Rows-s.NullCount {
+		n = s.Rows - s.NullCount
+	}
+	return n
+}
+
+// Merge merges the statistics of the same column collected from different
+// parts of a table, the nil ones are skipped. It returns nil if all of
+// them are nil.
+func Merge(ss ...*ColumnStats) *ColumnStats {
+	var r *ColumnStats
+	var hs []*Histogram
+
+	for _, s := range ss {
+		if s == nil {
+			continue
+		}
+		if r == nil {
+			r = &ColumnStats{Sketch: NewSketch()}
+		}
+		r.Rows += s.Rows
+		r.NullCount += s.NullCount
+		if s.Min != nil && (r.Min == nil || s.Min.Compare(*r.Min) < 0) {
+			r.Min = s.Min
+		}
+		if s.Max != nil && (r.Max == nil || s.Max.Compare(*r.Max) > 0) {
+			r.Max = s.Max
+		}
+		if s.Sketch != nil {
+			r.Sketch.Merge(s.Sketch)
+		}
+		hs = append(hs, s.Histogram)
+	}
+	if r != nil {
+		r.Histogram = mergeHistograms(hs)
+	}
+	return r
+}
+
+// Collector collects the statistics of a column from its vectors.
+type Collector struct {
+	rows    int64
+	nulls   int64
+	seen    int64
+	min     *Value
+	max     *Value
+	sketch  *Sketch
+	samples []Value
+	rnd     *rand.Rand
+}
+
+func NewCollector() *Collector {
+	return &Collector{
+		sketch: NewSketch(),
+		// a fixed seed keeps the statistics of the same data stable
+		rnd: rand.New(rand.NewSource(1)),
+	}
+}
+
+// Collect adds the values of vec to the statistics.
+func (c *Collector) Collect(vec *vector.Vector) {
+	n := vec.Length()
+	hasNull := vec.Nsp.Any()
+	for i := 0; i < n; i++ {
+		c.rows++
+		if hasNull && vec.Nsp.Contains(uint64(i)) {
+			c.nulls++
+			continue
+		}
+		switch col := vec.Col.(type) {
+		case []int8:
+			c.add(Value{Kind: Int, Int: int64(col[i])})
+		case []int16:
+			c.add(Value{Kind: Int, Int: int64(col[i])})
+		case []int32:
+			c.add(Value{Kind: Int, Int: int64(col[i])})
+		case []int64:
+			c.add(Value{Kind: Int, Int: col[i]})
+		case []uint8:
+			c.add(Value{Kind: Uint, Uint: uint64(col[i])})
+		case []uint16:
+			c.add(Value{Kind: Uint, Uint: uint64(col[i])})
+		case []uint32:
+			c.add(Value{Kind: Uint, Uint: uint64(col[i])})
+		case []uint64:
+			c.add(Value{Kind: Uint, Uint: col[i]})
+		case []float32:
+			c.add(Value{Kind: Float, Float: float64(col[i])})
+		case []float64:
+			c.add(Value{Kind: Float, Float: col[i]})
+		case []types.Date:
+			c.add(Value{Kind: Int, Int: int64(col[i])})
+		case []types.Datetime:
+			c.add(Value{Kind: Int, Int: int64(col[i])})
+		case []types.Decimal64:
+			c.add(Value{Kind: Int, Int: int64(col[i])})
+		case []types.Decimal128:
+			// decimal128 is not ordered by the statistics, only its
+			// distinct values are counted
+			c.sketch.Insert(Value{Kind: Uint, Uint: col[i].Lo ^ mix(uint64(col[i].Hi))}.hash())
+		case *types.Bytes:
+			c.add(Value{Kind: Bytes, Bytes: col.Get(int64(i))})
+		}
+	}
+}
+
+func (c *Collector) add(v Value) {
+	c.sketch.Insert(v.hash())
+	if c.min == nil || v.Compare(*c.min) < 0 {
+		min := v.Copy()
+		c.min = &min
+	}
+	if c.max == nil || v.Compare(*c.max) > 0 {
+		max := v.Copy()
+		c.max = &max
+	}
+	// reservoir sampling keeps every value with the same probability
+	c.seen++
+	if len(c.samples) < MaxSamples {
+		c.samples = append(c.samples, v.Copy())
+	} else if j := c.rnd.Int63n(c.seen); j < MaxSamples {
+		c.samples[j] = v.Copy()
+	}
+}
+
+// Stats returns the statistics collected.
+func (c *Collector) Stats() *ColumnStats {
+	s := &ColumnStats{
+		Rows:      c.rows,
+		NullCount: c.nulls,
+		Min:       c.min,
+		Max:       c.max,
+		Sketch:    c.sketch,
+	}
+	if len(c.samples) > 0 {
+		sort.Slice(c.samples, func(i, j int) bool { return c.samples[i].Compare(c.samples[j]) < 0 })
+		s.Histogram = newHistogram(c.samples, float64(c.seen)/float64(len(c.samples)))
+	}
+	return s
+}
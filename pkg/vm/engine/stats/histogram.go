@@ -0,0 +1,113 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import "sort"
+
+// MaxBuckets is the maximum number of buckets of a histogram.
+const MaxBuckets = 64
+
+// Bucket is a bucket of an equi-depth histogram, it covers the values
+// greater than the upper bound of the previous bucket and not greater
+// than its own upper bound.
+type Bucket struct {
+	Upper Value `json:"upper"`
+	// Count is the number of values in the bucket
+	Count int64 `json:"count"`
+	// Repeats is the number of values equal to the upper bound
+	Repeats int64 `json:"repeats"`
+}
+
+// Histogram is an equi-depth histogram, the buckets are ordered by
+// their upper bounds and hold about the same number of values.
+type Histogram struct {
+	Buckets []Bucket `json:"buckets"`
+}
+
+// newHistogram builds a histogram of the sorted values vs, each value
+// stands for scale values of the column.
+func newHistogram(vs []Value, scale float64) *Histogram {
+	if len(vs) == 0 {
+		return nil
+	}
+	depth := (len(vs) + MaxBuckets - 1) / MaxBuckets
+	h := &Histogram{}
+	// the counts are scaled cumulatively so that their rounding errors
+	// do not add up
+	var scaled int64
+	count, repeats := 0, 0
+	for i, v := range vs {
+		count++
+		if i > 0 && v.Compare(vs[i-1]) == 0 {
+			repeats++
+		} else {
+			repeats = 1
+		}
+		// the equal values never span two buckets
+		if i == len(vs)-1 || (count >= depth && v.Compare(vs[i+1]) != 0) {
+			cum := int64(float64(i+1)*scale + 0.5)
+			h.Buckets = append(h.Buckets, Bucket{
+				Upper:   v.Copy(),
+				Count:   cum - scaled,
+				Repeats: int64(float64(repeats)*scale + 0.5),
+			})
+			scaled = cum
+			count = 0
+		}
+	}
+	return h
+}
+
+// mergeHistograms merges the histograms into a new one of at most
+// MaxBuckets buckets, the values in a bucket are treated as if they
+// were all at its upper bound, so the result is an approximation.
+func mergeHistograms(hs []*Histogram) *Histogram {
+	var bs []Bucket
+	var total int64
+
+	for _, h := range hs {
+		if h == nil {
+			continue
+		}
+		for _, b := range h.Buckets {
+			bs = append(bs, b)
+			total += b.Count
+		}
+	}
+	if len(bs) == 0 {
+		return nil
+	}
+	sort.SliceStable(bs, func(i, j int) bool { return bs[i].Upper.Compare(bs[j].Upper) < 0 })
+	depth := (total + MaxBuckets - 1) / MaxBuckets
+	h := &Histogram{}
+	var count, repeats int64
+	for i, b := range bs {
+		count += b.Count
+		if i > 0 && b.Upper.Compare(bs[i-1].Upper) == 0 {
+			repeats += b.Repeats
+		} else {
+			repeats = b.Repeats
+		}
+		if i == len(bs)-1 || (count >= depth && b.Upper.Compare(bs[i+1].Upper) != 0) {
+			h.Buckets = append(h.Buckets, Bucket{
+				Upper:   b.Upper,
+				Count:   count,
+				Repeats: repeats,
+			})
+			count = 0
+		}
+	}
+	return h
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"math"
+	"math/bits"
+)
+
+const (
+	// sketchPrecision is the number of hash bits used to pick a register,
+	// the standard error of the estimate is 1.04 / sqrt(2^precision).
+	sketchPrecision = 10
+	sketchRegisters = 1 << sketchPrecision
+)
+
+// Sketch is a HyperLogLog sketch estimating the number of distinct values,
+// the sketches of different segments can be merged without loss.
+type Sketch struct {
+	Registers []uint8 `json:"regs"`
+}
+
+func NewSketch() *Sketch {
+	return &Sketch{Registers: make([]uint8, sketchRegisters)}
+}
+
+// Insert adds the hash of a value to the sketch.
+func (s *Sketch) Insert(h uint64) {
+	idx := h >> (64 - sketchPrecision)
+	// the guard bit bounds the rank when the remaining bits are all zero
+	rank := uint8(bits.LeadingZeros64(h<<sketchPrecision|1<<(sketchPrecision-1))) + 1
+	if rank > s.Registers[idx] {
+		s.Registers[idx] = rank
+	}
+}
+
+// Merge merges o into s, s then estimates the union of both.
+func (s *Sketch) Merge(o *Sketch) {
+	for i, r := range o.Registers {
+		if r > s.Registers[i] {
+			s.Registers[i] = r
+		}
+	}
+}
+
+// Estimate returns the estimated number of distinct values.
+func (s *Sketch) Estimate() int64 {
+	m := float64(sketchRegisters)
+	sum := 0.0
+	zeros := 0
+	for _, r := range s.Registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+	est := 0.7213 / (1 + 1.079/m) * m * m / sum
+	// linear counting is much more accurate for the small cardinalities
+	if est <= 2.5*m && zeros > 0 {
+		est = m * math.Log(m/float64(zeros))
+	}
+	return int64(est + 0.5)
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/assert"
+)
+
+func int64Vector(xs []int64, nullRows ...uint64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Append(xs)
+	vec.Nsp.Add(nullRows...)
+	return vec
+}
+
+func TestSketch(t *testing.T) {
+	s := NewSketch()
+	for i := 0; i < 3; i++ {
+		s.Insert(Value{Kind: Int, Int: int64(i)}.hash())
+	}
+	assert.Equal(t, int64(3), s.Estimate())
+
+	s = NewSketch()
+	for i := 0; i < 100000; i++ {
+		s.Insert(Value{Kind: Int, Int: int64(i % 50000)}.hash())
+	}
+	est := s.Estimate()
+	assert.True(t, est > 45000 && est < 55000, est)
+}
+
+func TestCollector(t *testing.T) {
+	xs := make([]int64, 1000)
+	for i := range xs {
+		xs[i] = int64(i % 100)
+	}
+	c := NewCollector()
+	c.Collect(int64Vector(xs, 0, 1))
+	s := c.Stats()
+	assert.Equal(t, int64(1000), s.Rows)
+	assert.Equal(t, int64(2), s.NullCount)
+	assert.Equal(t, int64(0), s.Min.Int)
+	assert.Equal(t, int64(99), s.Max.Int)
+	ndv := s.NDV()
+	assert.True(t, ndv > 90 && ndv <= 100, ndv)
+	var total int64
+	for i, b := range s.Histogram.Buckets {
+		if i > 0 {
+			assert.True(t, b.Upper.Compare(s.Histogram.Buckets[i-1].Upper) > 0)
+		}
+		total += b.Count
+	}
+	assert.Equal(t, int64(998), total)
+	assert.True(t, len(s.Histogram.Buckets) <= MaxBuckets)
+
+	vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	vec.Append([][]byte{[]byte("b"), []byte("a"), []byte("c"), []byte("a")})
+	c = NewCollector()
+	c.Collect(vec)
+	s = c.Stats()
+	assert.Equal(t, "a", s.Min.Format(vec.Typ))
+	assert.Equal(t, "c", s.Max.Format(vec.Typ))
+	assert.Equal(t, int64(3), s.NDV())
+}
+
+func TestMerge(t *testing.T) {
+	var ss []*ColumnStats
+	for i := 0; i < 4; i++ {
+		xs := make([]int64, 10000)
+		for j := range xs {
+			xs[j] = int64(i*10000 + j)
+		}
+		c := NewCollector()
+		c.Collect(int64Vector(xs))
+		ss = append(ss, c.Stats(), nil)
+	}
+	s := Merge(ss...)
+	assert.Equal(t, int64(40000), s.Rows)
+	assert.Equal(t, int64(0), s.Min.Int)
+	assert.Equal(t, int64(39999), s.Max.Int)
+	ndv := s.NDV()
+	assert.True(t, ndv > 36000 && ndv <= 40000, ndv)
+	var total int64
+	for _, b := range s.Histogram.Buckets {
+		total += b.Count
+	}
+	assert.Equal(t, int64(40000), total)
+	assert.True(t, len(s.Histogram.Buckets) <= MaxBuckets)
+	assert.Nil(t, Merge(nil, nil))
+}
+
+func TestMarshal(t *testing.T) {
+	xs := []int64{-1 << 62, 3, 1<<62 + 1}
+	c := NewCollector()
+	c.Collect(int64Vector(xs))
+	s := c.Stats()
+	buf, err := json.Marshal(s)
+	assert.Nil(t, err)
+	var r ColumnStats
+	assert.Nil(t, json.Unmarshal(buf, &r))
+	assert.Equal(t, s.Min.Int, r.Min.Int)
+	assert.Equal(t, s.Max.Int, r.Max.Int)
+	assert.Equal(t, s.NDV(), r.NDV())
+	assert.Equal(t, fmt.Sprint(s.Histogram), fmt.Sprint(r.Histogram))
+}
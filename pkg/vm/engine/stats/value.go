@@ -0,0 +1,140 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Kind is the kind of a normalized value.
+type Kind uint8
+
+const (
+	Int Kind = iota + 1
+	Uint
+	Float
+	Bytes
+)
+
+// Value is a column value normalized for the statistics, the signed
+// integers, dates, datetimes and decimal64s are kept in Int, the unsigned
+// integers in Uint, the floats in Float and the strings in Bytes.
+type Value struct {
+	Kind  Kind    `json:"k"`
+	Int   int64   `json:"i,omitempty"`
+	Uint  uint64  `json:"u,omitempty"`
+	Float float64 `json:"f,omitempty"`
+	Bytes []byte  `json:"b,omitempty"`
+}
+
+// Compare compares two values of the same kind.
+func (a Value) Compare(b Value) int {
+	switch a.Kind {
+	case Int:
+		switch {
+		case a.Int < b.Int:
+			return -1
+		case a.Int > b.Int:
+			return 1
+		}
+	case Uint:
+		switch {
+		case a.Uint < b.Uint:
+			return -1
+		case a.Uint > b.Uint:
+			return 1
+		}
+	case Float:
+		switch {
+		case a.Float < b.Float:
+			return -1
+		case a.Float > b.Float:
+			return 1
+		}
+	case Bytes:
+		return bytes.Compare(a.Bytes, b.Bytes)
+	}
+	return 0
+}
+
+// Copy returns a value which does not share memory with a.
+func (a Value) Copy() Value {
+	if a.Bytes != nil {
+		a.Bytes = append([]byte{}, a.Bytes...)
+	}
+	return a
+}
+
+// Format returns the string representation of the value as a value of typ.
+func (a Value) Format(typ types.Type) string {
+	switch a.Kind {
+	case Int:
+		switch typ.Oid {
+		case types.T_date:
+			return types.Date(a.Int).String()
+		case types.T_datetime:
+			return types.Datetime(a.Int).String()
+		case types.T_decimal64:
+			return types.Decimal64(a.Int).Format(typ.Precision)
+		}
+		return strconv.FormatInt(a.Int, 10)
+	case Uint:
+		return strconv.FormatUint(a.Uint, 10)
+	case Float:
+		return strconv.FormatFloat(a.Float, 'g', -1, 64)
+	case Bytes:
+		return string(a.Bytes)
+	}
+	return ""
+}
+
+// hash returns the hash of the value used by the sketch, it has to be
+// stable across processes because the sketches are persisted.
+func (a Value) hash() uint64 {
+	var buf [8]byte
+
+	h := fnv.New64a()
+	switch a.Kind {
+	case Int:
+		binary.LittleEndian.PutUint64(buf[:], uint64(a.Int))
+		h.Write(buf[:])
+	case Uint:
+		binary.LittleEndian.PutUint64(buf[:], a.Uint)
+		h.Write(buf[:])
+	case Float:
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(a.Float))
+		h.Write(buf[:])
+	case Bytes:
+		h.Write(a.Bytes)
+	}
+	return mix(h.Sum64())
+}
+
+// mix is the finalizer of splitmix64, fnv alone spreads the small
+// integers poorly over the high bits the sketch relies on.
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
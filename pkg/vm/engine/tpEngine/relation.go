@@ -68,6 +68,14 @@ func (tr *tpRelation) Write(_ uint64, batch *batch.Batch) error {
 	return nil
 }
 
+func (tr *tpRelation) Delete(_ uint64, _ engine.RowFilter, _ *process.Process) (uint64, error) {
+	return 0, nil
+}
+
+func (tr *tpRelation) Update(_ uint64, _ engine.RowUpdate, _ *process.Process) (uint64, error) {
+	return 0, nil
+}
+
 func (tr *tpRelation) AddTableDef(_ uint64, def engine.TableDef) error {
 	return nil
 }
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/stats"
 	"github.com/matrixorigin/matrixone/pkg/vm/metadata"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 
@@ -42,6 +43,9 @@ type SegmentInfo struct {
 	GroupId  string
 	TabletId string
 	Node     metadata.Node
+	// Partition is the partition of the segment, it is empty if the
+	// relation is not partitioned.
+	Partition string
 }
 
 type Unit struct {
@@ -58,12 +62,31 @@ type Statistics interface {
 	Size(string) int64
 }
 
+// TableStatistics is the statistics of a relation used by the planner.
+type TableStatistics interface {
+	Statistics
+
+	// ColumnStats returns the statistics of an attribute, or nil if they
+	// are not collected yet.
+	ColumnStats(string) *stats.ColumnStats
+	// Analyze recollects the statistics of all the attributes.
+	Analyze(uint64) error
+}
+
+// ListPartition holds the rows whose fields are equal to one of its value
+// lists, the value lists are flattened into Extends, so each one takes
+// len(Fields) extends.
 type ListPartition struct {
 	Name         string
 	Extends      []extend.Extend
 	Subpartition *PartitionBy
 }
 
+// RangePartition holds the rows whose fields are less than To and not less
+// than the To of the previous partition. To is cut off at the first
+// MAXVALUE, the fields are compared with the remaining bounds by less or
+// equal if some bounds are cut off, and a partition without bounds holds
+// all the rows left.
 type RangePartition struct {
 	Name         string
 	From         []extend.Extend
@@ -75,6 +98,9 @@ type PartitionBy struct {
 	Fields []string
 	List   []ListPartition
 	Range  []RangePartition
+	// Hash is the names of the partitions of a table partitioned by
+	// hash, a row goes to the partition picked by the hash of its fields.
+	Hash []string
 }
 
 type DistributionBy struct {
@@ -101,7 +127,7 @@ func (*AttributeDef) tableDef()  {}
 func (*IndexTableDef) tableDef() {}
 
 type Relation interface {
-	Statistics
+	TableStatistics
 
 	Close()
 
@@ -115,11 +141,51 @@ type Relation interface {
 	Segment(SegmentInfo, *process.Process) Segment
 
 	Write(uint64, *batch.Batch) error
-
+	// Delete deletes the rows selected by the filter, and returns the
+	// number of rows deleted.
+	Delete(uint64, RowFilter, *process.Process) (uint64, error)
+	// Update replaces the rows selected by the update with their new rows
+	// in a single step, and returns the number of rows updated.
+	Update(uint64, RowUpdate, *process.Process) (uint64, error)
+
+	// AddAttribute adds an attribute after the existing ones, the rows
+	// written before take the default value of the attribute.
 	AddAttribute(uint64, TableDef) error
 	DelAttribute(uint64, TableDef) error
+	// RenameAttribute renames an attribute and keeps its data.
+	RenameAttribute(epoch uint64, oldName, newName string) error
 	CreateIndex(epoch uint64, defs []TableDef) error
 	DropIndex(epoch uint64, name string) error
+
+	// Partition returns the partition definition of the relation, or nil
+	// if the relation is not partitioned.
+	Partition() *PartitionBy
+	// AddPartition adds the partitions of the definition after the
+	// existing ones.
+	AddPartition(uint64, *PartitionBy) error
+	// DropPartition drops a partition with the rows in it.
+	DropPartition(epoch uint64, name string) error
+}
+
+// RowFilter selects the rows of a relation, it is built by the caller and
+// evaluated by the engine over the rows it holds.
+type RowFilter interface {
+	// Attributes returns the attributes the filter is evaluated over, the
+	// first attribute of the relation is used if it is empty.
+	Attributes() []string
+	// Filter returns the positions of the selected rows of the batch.
+	Filter(*batch.Batch, *process.Process) ([]int64, error)
+	// Marshal encodes the filter for the engines evaluating it remotely.
+	Marshal() ([]byte, error)
+}
+
+// RowUpdate is a RowFilter computing the new rows of the rows it selects,
+// its attributes are all the attributes of the relation.
+type RowUpdate interface {
+	RowFilter
+	// Update returns the new rows of the rows of the batch at sels, the
+	// batch is left unchanged.
+	Update(bat *batch.Batch, sels []int64, proc *process.Process) (*batch.Batch, error)
 }
 
 type Filter interface {
@@ -130,6 +196,8 @@ type Filter interface {
 	Gt(string, interface{}) (*roaring.Bitmap, error)
 	Ge(string, interface{}) (*roaring.Bitmap, error)
 	Btw(string, interface{}, interface{}) (*roaring.Bitmap, error)
+	In(string, []interface{}) (*roaring.Bitmap, error)
+	NotIn(string, []interface{}) (*roaring.Bitmap, error)
 }
 
 type Summarizer interface {
@@ -148,6 +216,8 @@ type SparseFilter interface {
 	Gt(string, interface{}) ([]string, error)
 	Ge(string, interface{}) ([]string, error)
 	Btw(string, interface{}, interface{}) ([]string, error)
+	In(string, []interface{}) ([]string, error)
+	NotIn(string, []interface{}) ([]string, error)
 }
 
 type Segment interface {
@@ -171,6 +241,15 @@ type Block interface {
 	Read([]uint64, []string, []*bytes.Buffer, []*bytes.Buffer) (*batch.Batch, error) // read only arguments
 }
 
+// SelectBlock is a Block which can read only some of its rows, the rows
+// not selected are never returned to the caller.
+type SelectBlock interface {
+	Block
+	// ReadSels reads the rows at the positions of sels, which are in
+	// ascending order and count the deleted rows of the block.
+	ReadSels([]int64, []uint64, []string, []*bytes.Buffer, []*bytes.Buffer) (*batch.Batch, error)
+}
+
 // Database consists of functions that reference the session database
 type Database interface {
 	// Type returns the engine type of database.
@@ -16,7 +16,10 @@ package vm
 
 import (
 	"bytes"
+	bdifference "github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/difference"
 	binner "github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/inner"
+	bintersect "github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/intersect"
+	bouter "github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/outer"
 	bunion "github.com/matrixorigin/matrixone/pkg/sql/colexec/bag/union"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dedup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/group"
@@ -35,6 +38,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/summarize"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/transfer"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -61,11 +65,12 @@ var formatFunc = [...]func(interface{}, *bytes.Buffer){
 	SetNaturalJoin:    nil,
 	SetSemiDifference: nil,
 	BagUnion:          bunion.String,
-	BagIntersect:      nil,
-	BagDifference:     nil,
+	BagIntersect:      bintersect.String,
+	BagDifference:     bdifference.String,
 	BagDifferenceR:    nil,
 	BagInnerJoin:      binner.String,
 	BagNaturalJoin:    nil,
+	BagOuterJoin:      bouter.String,
 	Output:            output.String,
 	Merge:             merge.String,
 	MergeTop:          mergetop.String,
@@ -73,6 +78,7 @@ var formatFunc = [...]func(interface{}, *bytes.Buffer){
 	MergeOrder:        mergeorder.String,
 	MergeGroup:        mergegroup.String,
 	MergeSummarize:    mergesum.String,
+	Window:            window.String,
 }
 
 var prepareFunc = [...]func(*process.Process, interface{}) error{
@@ -98,11 +104,12 @@ var prepareFunc = [...]func(*process.Process, interface{}) error{
 	SetNaturalJoin:    nil,
 	SetSemiDifference: nil,
 	BagUnion:          bunion.Prepare,
-	BagIntersect:      nil,
-	BagDifference:     nil,
+	BagIntersect:      bintersect.Prepare,
+	BagDifference:     bdifference.Prepare,
 	BagDifferenceR:    nil,
 	BagInnerJoin:      binner.Prepare,
 	BagNaturalJoin:    nil,
+	BagOuterJoin:      bouter.Prepare,
 	Output:            output.Prepare,
 	Merge:             merge.Prepare,
 	MergeTop:          mergetop.Prepare,
@@ -110,6 +117,7 @@ var prepareFunc = [...]func(*process.Process, interface{}) error{
 	MergeOrder:        mergeorder.Prepare,
 	MergeGroup:        mergegroup.Prepare,
 	MergeSummarize:    mergesum.Prepare,
+	Window:            window.Prepare,
 }
 
 var execFunc = [...]func(*process.Process, interface{}) (bool, error){
@@ -135,11 +143,12 @@ var execFunc = [...]func(*process.Process, interface{}) (bool, error){
 	SetNaturalJoin:    nil,
 	SetSemiDifference: nil,
 	BagUnion:          bunion.Call,
-	BagIntersect:      nil,
-	BagDifference:     nil,
+	BagIntersect:      bintersect.Call,
+	BagDifference:     bdifference.Call,
 	BagDifferenceR:    nil,
 	BagInnerJoin:      binner.Call,
 	BagNaturalJoin:    nil,
+	BagOuterJoin:      bouter.Call,
 	Output:            output.Call,
 	Merge:             merge.Call,
 	MergeTop:          mergetop.Call,
@@ -147,4 +156,5 @@ var execFunc = [...]func(*process.Process, interface{}) (bool, error){
 	MergeOrder:        mergeorder.Call,
 	MergeGroup:        mergegroup.Call,
 	MergeSummarize:    mergesum.Call,
+	Window:            window.Call,
 }
@@ -18,10 +18,12 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"runtime/debug"
 )
 
 func New(cs []uint64, attrs []string, ins vm.Instructions) *Pipeline {
@@ -69,6 +71,9 @@ func (p *Pipeline) Run(segs []engine.Segment, proc *process.Process) (bool, erro
 		}
 	}
 	for i, j := 0, len(q.blocks); i < j; i++ {
+		if err := proc.Err(); err != nil {
+			return false, err
+		}
 		if err := q.prefetch(p.attrs); err != nil {
 			return false, err
 		}
@@ -84,35 +89,88 @@ func (p *Pipeline) Run(segs []engine.Segment, proc *process.Process) (bool, erro
 			break
 		}
 	}
-	return end, err
+	return end, proc.Err()
 }
 
 func (p *Pipeline) RunMerge(proc *process.Process) (bool, error) {
-	var end bool
-	var err error
-
 	proc.Mp = mempool.New()
 	defer func() {
-		proc.Reg.InputBatch = nil
-		vm.Run(p.instructions, proc)
 		proc.Mp = nil
 	}()
 	if err := vm.Prepare(p.instructions, proc); err != nil {
 		vm.Clean(p.instructions, proc)
+		p.finish(proc)
 		return false, err
 	}
+	end, err := p.runMerge(proc)
+	if rerr := p.finish(proc); err == nil {
+		err = rerr
+	}
+	return end, err
+}
+
+func (p *Pipeline) runMerge(proc *process.Process) (bool, error) {
 	for {
 		proc.Reg.InputBatch = nil
-		if end, err = vm.Run(p.instructions, proc); err != nil || end {
+		end, panicked, err := p.run(proc)
+		if panicked {
+			p.abandon(proc)
+			return true, err
+		}
+		if err != nil || end {
 			{
 				fmt.Printf("+++%p begin clean\n", p)
 			}
 			p.clean(proc)
+			if err == nil {
+				// the merge pipeline is not interrupted, it drains the
+				// previous pipelines which stop reading once cancelled.
+				err = proc.Err()
+			}
 			return end, err
 		}
 	}
 }
 
+// finish notifies the instructions that the pipeline ends,
+// it only fails if the instructions panic.
+func (p *Pipeline) finish(proc *process.Process) error {
+	proc.Reg.InputBatch = nil
+	_, panicked, err := p.run(proc)
+	if !panicked {
+		return nil
+	}
+	if len(p.instructions) > 1 {
+		p.abandon(proc)
+		proc.Reg.InputBatch = nil
+		p.run(proc)
+	}
+	return err
+}
+
+// run runs the instructions once, a panic of the instructions is turned into an error.
+func (p *Pipeline) run(proc *process.Process) (end bool, panicked bool, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			end, panicked, err = true, true, fmt.Errorf("panic in merge pipeline: %v", e)
+			logutil.Errorf("%v\n%s", err, debug.Stack())
+		}
+	}()
+	end, err = vm.Run(p.instructions, proc)
+	return end, false, err
+}
+
+// abandon gives up the instructions whose state is broken by a panic, the query
+// is cancelled to release the previous pipelines, and only the last instruction
+// is kept to pass the end on.
+func (p *Pipeline) abandon(proc *process.Process) {
+	if proc.Cancel != nil {
+		proc.Cancel()
+	}
+	p.clean(proc)
+	p.instructions = p.instructions[len(p.instructions)-1:]
+}
+
 // prefetch generates a prefetch queue
 func (p *Pipeline) prefetch(segs []engine.Segment, proc *process.Process) *queue {
 	q := new(queue)
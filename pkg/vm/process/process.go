@@ -15,6 +15,8 @@
 package process
 
 import (
+	"context"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 )
@@ -22,8 +24,56 @@ import (
 // New creates a new Process.
 // A process stores the execution context.
 func New(gm *guest.Mmu) *Process {
+	ctx, cancel := context.WithCancel(context.Background())
 	return &Process{
-		Gm: gm,
+		Gm:     gm,
+		Ctx:    ctx,
+		Cancel: cancel,
+	}
+}
+
+// NewFromProc creates a child process of p, the child process has its own
+// guest mmu and shares the cancellation signal of p.
+func NewFromProc(p *Process) *Process {
+	return &Process{
+		Gm:     guest.New(p.Gm.Limit, p.Gm.Mmu),
+		Ctx:    p.Ctx,
+		Cancel: p.Cancel,
+	}
+}
+
+// Err returns a non-nil error if the query of p has been cancelled.
+func (p *Process) Err() error {
+	if p.Ctx == nil {
+		return nil
+	}
+	select {
+	case <-p.Ctx.Done():
+		if p.Ctx.Err() == context.DeadlineExceeded {
+			return ErrQueryTimeout
+		}
+		return ErrQueryInterrupted
+	default:
+		return nil
+	}
+}
+
+// WaitReceived waits for the receiver of reg to finish the value sent,
+// it returns early once the query is cancelled, as the receiver may quit
+// without finishing the value, for example, after a panic.
+func (p *Process) WaitReceived(reg *WaitRegister) {
+	if p.Ctx == nil {
+		reg.Wg.Wait()
+		return
+	}
+	done := make(chan struct{})
+	go func() {
+		reg.Wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-p.Ctx.Done():
 	}
 }
 
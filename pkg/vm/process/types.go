@@ -15,6 +15,9 @@
 package process
 
 import (
+	"context"
+	"errors"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"sync"
@@ -77,4 +80,16 @@ type Process struct {
 	Mp *mempool.Mempool
 	// Refer, traces the count of the reference of variables.
 	Refer map[string]uint64
+	// Ctx, carries the cancellation signal of current query,
+	// it is shared by all the processes that belong to the same query.
+	Ctx context.Context
+	// Cancel, stops the execution of current query.
+	Cancel context.CancelFunc
 }
+
+var (
+	// ErrQueryInterrupted, query is killed or its client is gone.
+	ErrQueryInterrupted = errors.New("query execution was interrupted")
+	// ErrQueryTimeout, query runs longer than its execution time limit.
+	ErrQueryTimeout = errors.New("query execution was interrupted, maximum statement execution time exceeded")
+)
@@ -42,6 +42,7 @@ const (
 	BagDifferenceR
 	BagInnerJoin
 	BagNaturalJoin
+	BagOuterJoin
 	Output
 	Exchange
 	Merge
@@ -50,6 +51,7 @@ const (
 	MergeOrder
 	MergeGroup
 	MergeSummarize
+	Window
 )
 
 // Instruction contains relational algebra
@@ -47,6 +47,13 @@ func Run(ins Instructions, proc *process.Process) (bool, error) {
 	var err error
 
 	for _, in := range ins {
+		// a nil input batch notifies the instructions to finish,
+		// it must reach the end even if the query is cancelled.
+		if proc.Reg.InputBatch != nil {
+			if err = proc.Err(); err != nil {
+				return end, err
+			}
+		}
 		if ok, err = execFunc[in.Code](proc, in.Arg); err != nil {
 			return ok || end, err
 		}